@@ -0,0 +1,577 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/walletlib"
+	"github.com/project-illium/walletlib/pb"
+)
+
+var (
+	// ErrAccountExists is returned when creating an account with a
+	// name that is already in use.
+	ErrAccountExists = errors.New("account already exists")
+
+	// ErrAccountNotFound is returned when the requested account does
+	// not exist.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrInsufficientFunds is returned when an account does not have
+	// enough spendable coins to cover a spend.
+	ErrInsufficientFunds = errors.New("insufficient account funds")
+
+	// ErrAccountNote is returned when a spend of unassigned coins selects
+	// a note that belongs to an account.
+	ErrAccountNote = errors.New("note belongs to an account")
+
+	// ErrNotAccountNote is returned when a spend from an account selects
+	// a note that does not belong to the account.
+	ErrNotAccountNote = errors.New("note does not belong to the account")
+)
+
+// Wallet is the subset of the walletlib.Wallet functionality used
+// by the AccountManager.
+type Wallet interface {
+	NewAddress() (walletlib.Address, error)
+	Notes() ([]*pb.SpendNote, error)
+	Spend(toAddr walletlib.Address, amount types.Amount, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error)
+	TimelockCoins(amount types.Amount, lockUntil time.Time, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error)
+	Stake(commitments []types.ID) error
+	CreateRawTransaction(inputs []*walletlib.RawInput, outputs []*walletlib.RawOutput, addChangeOutput bool, feePerKB types.Amount) (*walletlib.RawTransaction, error)
+	CreateRawStakeTransaction(in *walletlib.RawInput) (*walletlib.RawTransaction, error)
+}
+
+// Account is a named set of wallet addresses. Each address belongs
+// to at most one account and the account's balance is the sum of the
+// notes paying to its addresses.
+type Account struct {
+	Name      string   `json:"name"`
+	Label     string   `json:"label"`
+	Addresses []string `json:"addresses"`
+}
+
+// AccountManager segregates the wallet's funds into multiple named
+// accounts. The underlying wallet knows nothing about accounts. Instead
+// the manager tracks which addresses belong to which account and restricts
+// the inputs selected for spends to the account's own notes.
+//
+// The wallet derives all addresses from a single key index chain and sends
+// change to the most recently derived address. Each account's branch is
+// therefore the set of indices the manager hands out to it, and every
+// derivation from the wallet must go through the manager so that no address
+// can be derived between an account's change address and its spend. Spends
+// of coins outside of any account go through the manager as well so that they
+// never select an account's notes or send change to an account address.
+type AccountManager struct {
+	ds       repo.Datastore
+	wallet   Wallet
+	feePerKB types.Amount
+	accounts map[string]*Account
+	addrs    map[string]string
+	mtx      sync.RWMutex
+}
+
+// NewAccountManager returns a new AccountManager, loading any previously
+// created accounts from the datastore. The feePerKB is used to estimate the
+// fee of account spends which do not specify their own fee rate and should
+// match the wallet's default.
+func NewAccountManager(ds repo.Datastore, wallet Wallet, feePerKB types.Amount) (*AccountManager, error) {
+	am := &AccountManager{
+		ds:       ds,
+		wallet:   wallet,
+		feePerKB: feePerKB,
+		accounts: make(map[string]*Account),
+		addrs:    make(map[string]string),
+		mtx:      sync.RWMutex{},
+	}
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix: repo.WalletAccountKeyPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		var acct Account
+		if err := json.Unmarshal(result.Value, &acct); err != nil {
+			return nil, err
+		}
+		am.accounts[acct.Name] = &acct
+		for _, addr := range acct.Addresses {
+			am.addrs[addr] = acct.Name
+		}
+	}
+	return am, nil
+}
+
+// CreateAccount creates a new, empty account with the given name and label.
+func (am *AccountManager) CreateAccount(name, label string) error {
+	if name == "" {
+		return errors.New("account name is empty")
+	}
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	if _, ok := am.accounts[name]; ok {
+		return ErrAccountExists
+	}
+	acct := &Account{
+		Name:      name,
+		Label:     label,
+		Addresses: []string{},
+	}
+	if err := am.putAccount(acct); err != nil {
+		return err
+	}
+	am.accounts[name] = acct
+	return nil
+}
+
+// SetLabel updates the label of the given account.
+func (am *AccountManager) SetLabel(name, label string) error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	acct, ok := am.accounts[name]
+	if !ok {
+		return ErrAccountNotFound
+	}
+	cpy := *acct
+	cpy.Label = label
+	if err := am.putAccount(&cpy); err != nil {
+		return err
+	}
+	acct.Label = label
+	return nil
+}
+
+// Account returns a copy of the account with the given name.
+func (am *AccountManager) Account(name string) (*Account, error) {
+	am.mtx.RLock()
+	defer am.mtx.RUnlock()
+
+	acct, ok := am.accounts[name]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	return copyAccount(acct), nil
+}
+
+// Accounts returns a copy of all accounts sorted by name.
+func (am *AccountManager) Accounts() []*Account {
+	am.mtx.RLock()
+	defer am.mtx.RUnlock()
+
+	ret := make([]*Account, 0, len(am.accounts))
+	for _, acct := range am.accounts {
+		ret = append(ret, copyAccount(acct))
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// NewAddress derives a new wallet address and assigns it to the account.
+func (am *AccountManager) NewAddress(name string) (walletlib.Address, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.newAddress(name)
+}
+
+// NewUnassignedAddress derives a new wallet address that does not belong to
+// any account. It must be used instead of calling NewAddress on the wallet
+// directly so that the derivation is serialized with account spends.
+func (am *AccountManager) NewUnassignedAddress() (walletlib.Address, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.wallet.NewAddress()
+}
+
+// Balance returns the sum of all notes paying to the account's addresses.
+func (am *AccountManager) Balance(name string) (types.Amount, error) {
+	notes, err := am.Notes(name)
+	if err != nil {
+		return 0, err
+	}
+	total := types.Amount(0)
+	for _, n := range notes {
+		total, err = total.Add(types.Amount(n.Amount))
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// Notes returns the wallet notes belonging to the account.
+func (am *AccountManager) Notes(name string) ([]*pb.SpendNote, error) {
+	am.mtx.RLock()
+	defer am.mtx.RUnlock()
+
+	return am.notes(name)
+}
+
+// Spend sends coins from the account to the given address. Only the account's
+// own notes are used as inputs and a new account address is derived beforehand
+// so that the change output remains in the account.
+func (am *AccountManager) Spend(name string, toAddr walletlib.Address, amount types.Amount, feePerKB types.Amount) (types.ID, error) {
+	// The write lock is held for the duration of the spend so that no other
+	// address can be derived in between. The wallet sends change to its most
+	// recent address.
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	notes, err := am.notes(name)
+	if err != nil {
+		return types.ID{}, err
+	}
+	fpkb := am.feeRate(feePerKB)
+	commitments, total, err := spendable(notes, fpkb)
+	if err != nil {
+		return types.ID{}, err
+	}
+	// The wallet pays the fee out of the selected inputs so the account
+	// must cover it as well as the amount. This is the same estimate the
+	// wallet uses when selecting inputs.
+	fee := walletlib.ComputeFee(len(commitments), 2, fpkb)
+	required, err := amount.Add(fee)
+	if err != nil {
+		return types.ID{}, err
+	}
+	if len(commitments) == 0 || total < required {
+		return types.ID{}, ErrInsufficientFunds
+	}
+
+	if _, err := am.newAddress(name); err != nil {
+		return types.ID{}, err
+	}
+	return am.wallet.Spend(toAddr, amount, feePerKB, commitments...)
+}
+
+// SpendUnassigned sends coins which do not belong to any account to the given
+// address. If no input commitments are provided the wallet selects from the
+// unassigned notes only. A new unassigned address is derived beforehand so
+// that the change output does not land in an account.
+func (am *AccountManager) SpendUnassigned(toAddr walletlib.Address, amount types.Amount, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	commitments, err := am.unassignedInputs(feePerKB, inputCommitments)
+	if err != nil {
+		return types.ID{}, err
+	}
+	if _, err := am.wallet.NewAddress(); err != nil {
+		return types.ID{}, err
+	}
+	return am.wallet.Spend(toAddr, amount, feePerKB, commitments...)
+}
+
+// SweepUnassigned sends all spendable coins which do not belong to any account
+// to the given address. The fee is subtracted from the swept amount.
+func (am *AccountManager) SweepUnassigned(toAddr walletlib.Address, feePerKB types.Amount) (types.ID, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	notes, err := am.unassignedNotes()
+	if err != nil {
+		return types.ID{}, err
+	}
+	fpkb := am.feeRate(feePerKB)
+	commitments, total, err := spendable(notes, fpkb)
+	if err != nil {
+		return types.ID{}, err
+	}
+	// The wallet's input selection always budgets for a change output so
+	// the same estimate is used here. The amount plus the fee then equals
+	// the total and no change output is created.
+	fee := walletlib.ComputeFee(len(commitments), 2, fpkb)
+	if len(commitments) == 0 || total <= fee {
+		return types.ID{}, ErrInsufficientFunds
+	}
+	return am.wallet.Spend(toAddr, total-fee, feePerKB, commitments...)
+}
+
+// TimelockUnassigned moves coins which do not belong to any account into a
+// timelocked address. Like SpendUnassigned it only selects unassigned notes
+// and sends the change to a new unassigned address.
+func (am *AccountManager) TimelockUnassigned(amount types.Amount, lockUntil time.Time, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	commitments, err := am.unassignedInputs(feePerKB, inputCommitments)
+	if err != nil {
+		return types.ID{}, err
+	}
+	if _, err := am.wallet.NewAddress(); err != nil {
+		return types.ID{}, err
+	}
+	return am.wallet.TimelockCoins(amount, lockUntil, feePerKB, commitments...)
+}
+
+// StakeUnassigned stakes the given notes. None of them may belong to an
+// account.
+func (am *AccountManager) StakeUnassigned(commitments []types.ID) error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	if _, err := am.unassignedInputs(0, commitments); err != nil {
+		return err
+	}
+	return am.wallet.Stake(commitments)
+}
+
+// CreateRawTransaction creates an unsigned transaction from the inputs. If
+// the account name is empty none of the wallet's inputs may belong to an
+// account, otherwise they must all belong to the named account. Private
+// inputs which are not known to the wallet belong to no account and are
+// always allowed. If no inputs are provided they are selected from the
+// account's notes, or from the unassigned notes if no account is named.
+//
+// If a change output is appended a new address is derived beforehand, in
+// the account if one is named, so that the change stays with the coins it
+// came from.
+func (am *AccountManager) CreateRawTransaction(name string, inputs []*walletlib.RawInput, outputs []*walletlib.RawOutput, addChangeOutput bool, feePerKB types.Amount) (*walletlib.RawTransaction, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	inputs, err := am.rawInputs(name, inputs, feePerKB)
+	if err != nil {
+		return nil, err
+	}
+	if addChangeOutput {
+		if name == "" {
+			_, err = am.wallet.NewAddress()
+		} else {
+			_, err = am.newAddress(name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return am.wallet.CreateRawTransaction(inputs, outputs, addChangeOutput, feePerKB)
+}
+
+// CreateRawStakeTransaction creates an unsigned stake transaction for the
+// input. As with CreateRawTransaction, the input must belong to the named
+// account, or to no account if the name is empty.
+func (am *AccountManager) CreateRawStakeTransaction(name string, in *walletlib.RawInput) (*walletlib.RawTransaction, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	if in == nil {
+		return nil, errors.New("input is nil")
+	}
+	if _, err := am.rawInputs(name, []*walletlib.RawInput{in}, 0); err != nil {
+		return nil, err
+	}
+	return am.wallet.CreateRawStakeTransaction(in)
+}
+
+// rawInputs checks that the wallet's inputs belong to the named account, or
+// to no account if the name is empty. If there are no inputs, the spendable
+// notes of the account, or the unassigned notes, are returned as inputs for
+// the wallet to select among.
+func (am *AccountManager) rawInputs(name string, inputs []*walletlib.RawInput, feePerKB types.Amount) ([]*walletlib.RawInput, error) {
+	if name != "" {
+		if _, ok := am.accounts[name]; !ok {
+			return nil, ErrAccountNotFound
+		}
+	}
+	if len(inputs) > 0 {
+		notes, err := am.wallet.Notes()
+		if err != nil {
+			return nil, err
+		}
+		owners := make(map[types.ID]string, len(notes))
+		for _, n := range notes {
+			owners[types.NewID(n.Commitment)] = n.Address
+		}
+		for _, in := range inputs {
+			if in.Commitment == nil {
+				continue
+			}
+			owner := am.addrs[owners[types.NewID(in.Commitment)]]
+			if name == "" && owner != "" {
+				return nil, ErrAccountNote
+			}
+			if owner != name {
+				return nil, ErrNotAccountNote
+			}
+		}
+		return inputs, nil
+	}
+
+	var (
+		notes []*pb.SpendNote
+		err   error
+	)
+	if name == "" {
+		notes, err = am.unassignedNotes()
+	} else {
+		notes, err = am.notes(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	commitments, _, err := spendable(notes, am.feeRate(feePerKB))
+	if err != nil {
+		return nil, err
+	}
+	if len(commitments) == 0 {
+		return nil, ErrInsufficientFunds
+	}
+	ret := make([]*walletlib.RawInput, 0, len(commitments))
+	for _, c := range commitments {
+		ret = append(ret, &walletlib.RawInput{Commitment: c.Bytes()})
+	}
+	return ret, nil
+}
+
+// unassignedInputs returns the input commitments for a spend of unassigned
+// coins. The provided commitments are checked against the account addresses.
+// If there are none, all spendable unassigned notes are returned and the
+// wallet selects among them.
+func (am *AccountManager) unassignedInputs(feePerKB types.Amount, inputCommitments []types.ID) ([]types.ID, error) {
+	if len(inputCommitments) > 0 {
+		notes, err := am.wallet.Notes()
+		if err != nil {
+			return nil, err
+		}
+		owners := make(map[types.ID]string, len(notes))
+		for _, n := range notes {
+			owners[types.NewID(n.Commitment)] = n.Address
+		}
+		for _, c := range inputCommitments {
+			if _, ok := am.addrs[owners[c]]; ok {
+				return nil, ErrAccountNote
+			}
+		}
+		return inputCommitments, nil
+	}
+	notes, err := am.unassignedNotes()
+	if err != nil {
+		return nil, err
+	}
+	commitments, _, err := spendable(notes, am.feeRate(feePerKB))
+	if err != nil {
+		return nil, err
+	}
+	if len(commitments) == 0 {
+		return nil, ErrInsufficientFunds
+	}
+	return commitments, nil
+}
+
+func (am *AccountManager) unassignedNotes() ([]*pb.SpendNote, error) {
+	notes, err := am.wallet.Notes()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*pb.SpendNote, 0, len(notes))
+	for _, n := range notes {
+		if _, ok := am.addrs[n.Address]; !ok {
+			ret = append(ret, n)
+		}
+	}
+	return ret, nil
+}
+
+func (am *AccountManager) feeRate(feePerKB types.Amount) types.Amount {
+	if feePerKB == 0 {
+		return am.feePerKB
+	}
+	return feePerKB
+}
+
+func (am *AccountManager) newAddress(name string) (walletlib.Address, error) {
+	acct, ok := am.accounts[name]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	addr, err := am.wallet.NewAddress()
+	if err != nil {
+		return nil, err
+	}
+	cpy := copyAccount(acct)
+	cpy.Addresses = append(cpy.Addresses, addr.String())
+	if err := am.putAccount(cpy); err != nil {
+		return nil, err
+	}
+	am.accounts[name] = cpy
+	am.addrs[addr.String()] = name
+	return addr, nil
+}
+
+func (am *AccountManager) notes(name string) ([]*pb.SpendNote, error) {
+	if _, ok := am.accounts[name]; !ok {
+		return nil, ErrAccountNotFound
+	}
+	notes, err := am.wallet.Notes()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*pb.SpendNote, 0, len(notes))
+	for _, n := range notes {
+		if am.addrs[n.Address] == name {
+			ret = append(ret, n)
+		}
+	}
+	return ret, nil
+}
+
+func (am *AccountManager) putAccount(acct *Account) error {
+	ser, err := json.Marshal(acct)
+	if err != nil {
+		return err
+	}
+	return am.ds.Put(context.Background(), datastore.NewKey(repo.WalletAccountKeyPrefix+acct.Name), ser)
+}
+
+// spendable returns the commitments and total amount of the notes which the
+// wallet may select as inputs at the given fee rate.
+func spendable(notes []*pb.SpendNote, feePerKB types.Amount) ([]types.ID, types.Amount, error) {
+	var (
+		commitments []types.ID
+		total       types.Amount
+		err         error
+	)
+	for _, n := range notes {
+		if n.WatchOnly || n.Staked || time.Unix(n.LockedUntil, 0).After(time.Now()) {
+			continue
+		}
+		if walletlib.IsDustInput(types.Amount(n.Amount), feePerKB) {
+			continue
+		}
+		commitments = append(commitments, types.NewID(n.Commitment))
+		total, err = total.Add(types.Amount(n.Amount))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return commitments, total, nil
+}
+
+func copyAccount(acct *Account) *Account {
+	addrs := make([]string, len(acct.Addresses))
+	copy(addrs, acct.Addresses)
+	return &Account{
+		Name:      acct.Name,
+		Label:     acct.Label,
+		Addresses: addrs,
+	}
+}
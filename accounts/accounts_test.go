@@ -0,0 +1,290 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package accounts
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/walletlib"
+	"github.com/project-illium/walletlib/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWallet struct {
+	notes       []*pb.SpendNote
+	addrs       []walletlib.Address
+	spentInput  []types.ID
+	spentAmount types.Amount
+	staked      []types.ID
+	rawInputs   []*walletlib.RawInput
+}
+
+func (w *mockWallet) NewAddress() (walletlib.Address, error) {
+	_, viewKey, err := icrypto.GenerateCurve25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	var commitment types.ID
+	rand.Read(commitment[:])
+	addr, err := walletlib.NewBasicAddress(types.LockingScript{ScriptCommitment: commitment}, viewKey, &params.RegestParams)
+	if err != nil {
+		return nil, err
+	}
+	w.addrs = append(w.addrs, addr)
+	return addr, nil
+}
+
+func (w *mockWallet) Notes() ([]*pb.SpendNote, error) {
+	return w.notes, nil
+}
+
+func (w *mockWallet) Spend(toAddr walletlib.Address, amount types.Amount, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error) {
+	w.spentInput = inputCommitments
+	w.spentAmount = amount
+	return types.ID{0x01}, nil
+}
+
+func (w *mockWallet) TimelockCoins(amount types.Amount, lockUntil time.Time, feePerKB types.Amount, inputCommitments ...types.ID) (types.ID, error) {
+	w.spentInput = inputCommitments
+	w.spentAmount = amount
+	return types.ID{0x02}, nil
+}
+
+func (w *mockWallet) Stake(commitments []types.ID) error {
+	w.staked = commitments
+	return nil
+}
+
+func (w *mockWallet) CreateRawTransaction(inputs []*walletlib.RawInput, outputs []*walletlib.RawOutput, addChangeOutput bool, feePerKB types.Amount) (*walletlib.RawTransaction, error) {
+	w.rawInputs = inputs
+	return &walletlib.RawTransaction{}, nil
+}
+
+func (w *mockWallet) CreateRawStakeTransaction(in *walletlib.RawInput) (*walletlib.RawTransaction, error) {
+	w.rawInputs = []*walletlib.RawInput{in}
+	return &walletlib.RawTransaction{}, nil
+}
+
+func (w *mockWallet) addNote(addr walletlib.Address, amount uint64) *pb.SpendNote {
+	commitment := make([]byte, 32)
+	rand.Read(commitment)
+	n := &pb.SpendNote{
+		Address:    addr.String(),
+		Commitment: commitment,
+		Amount:     amount,
+	}
+	w.notes = append(w.notes, n)
+	return n
+}
+
+func TestAccountManager(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	w := &mockWallet{}
+	am, err := NewAccountManager(ds, w, 10)
+	assert.NoError(t, err)
+
+	assert.NoError(t, am.CreateAccount("savings", "Savings"))
+	assert.NoError(t, am.CreateAccount("checking", "Checking"))
+	assert.ErrorIs(t, am.CreateAccount("savings", ""), ErrAccountExists)
+
+	savingsAddr, err := am.NewAddress("savings")
+	assert.NoError(t, err)
+	checkingAddr, err := am.NewAddress("checking")
+	assert.NoError(t, err)
+	_, err = am.NewAddress("unknown")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// A note paying to an address not assigned to any account
+	unassigned, err := am.NewUnassignedAddress()
+	assert.NoError(t, err)
+	w.addNote(unassigned, 1000)
+
+	savingsNote := w.addNote(savingsAddr, 500)
+	staked := w.addNote(savingsAddr, 300)
+	staked.Staked = true
+	locked := w.addNote(savingsAddr, 200)
+	locked.LockedUntil = time.Now().Add(time.Hour).Unix()
+	w.addNote(checkingAddr, 50)
+
+	balance, err := am.Balance("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(1000), balance)
+
+	balance, err = am.Balance("checking")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(50), balance)
+
+	// Only the unstaked and unlocked savings note may be spent
+	_, err = am.Spend("savings", unassigned, 600, 0)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// The note must cover the fee as well as the amount
+	fee := walletlib.ComputeFee(1, 2, 10)
+	assert.NotZero(t, fee)
+	_, err = am.Spend("savings", unassigned, 500-fee+1, 0)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+	txid, err := am.Spend("savings", unassigned, 400, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ID{0x01}, txid)
+	assert.Equal(t, []types.ID{types.NewID(savingsNote.Commitment)}, w.spentInput)
+
+	// The wallet's most recent address receives the change and must
+	// belong to the spending account.
+	acct, err := am.Account("savings")
+	assert.NoError(t, err)
+	assert.Len(t, acct.Addresses, 2)
+	assert.Equal(t, w.addrs[len(w.addrs)-1].String(), acct.Addresses[1])
+
+	assert.NoError(t, am.SetLabel("checking", "Everyday"))
+
+	// Reload from the datastore
+	am2, err := NewAccountManager(ds, w, 10)
+	assert.NoError(t, err)
+	accts := am2.Accounts()
+	assert.Len(t, accts, 2)
+	assert.Equal(t, "checking", accts[0].Name)
+	assert.Equal(t, "Everyday", accts[0].Label)
+	assert.Equal(t, []string{checkingAddr.String()}, accts[0].Addresses)
+	assert.Equal(t, "savings", accts[1].Name)
+	assert.Equal(t, acct.Addresses, accts[1].Addresses)
+
+	balance, err = am2.Balance("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(1000), balance)
+}
+
+func TestAccountManagerUnassigned(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	w := &mockWallet{}
+	am, err := NewAccountManager(ds, w, 10)
+	assert.NoError(t, err)
+
+	assert.NoError(t, am.CreateAccount("savings", "Savings"))
+	savingsAddr, err := am.NewAddress("savings")
+	assert.NoError(t, err)
+	savingsNote := w.addNote(savingsAddr, 500)
+
+	unassigned, err := am.NewUnassignedAddress()
+	assert.NoError(t, err)
+	unassignedNote := w.addNote(unassigned, 1000)
+
+	// Without unassigned coins a plain spend must not fall back to the
+	// account's notes.
+	w2 := &mockWallet{}
+	am2, err := NewAccountManager(mock.NewMapDatastore(), w2, 10)
+	assert.NoError(t, err)
+	assert.NoError(t, am2.CreateAccount("savings", ""))
+	addr2, err := am2.NewAddress("savings")
+	assert.NoError(t, err)
+	w2.addNote(addr2, 500)
+	_, err = am2.SpendUnassigned(unassigned, 100, 0)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.Nil(t, w2.spentInput)
+
+	// A plain spend only selects unassigned notes and its change goes to
+	// a new unassigned address.
+	_, err = am.SpendUnassigned(unassigned, 400, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.ID{types.NewID(unassignedNote.Commitment)}, w.spentInput)
+
+	change := w.addrs[len(w.addrs)-1]
+	w.addNote(change, 500)
+	for i, n := range w.notes {
+		if types.NewID(n.Commitment) == types.NewID(unassignedNote.Commitment) {
+			w.notes = append(w.notes[:i], w.notes[i+1:]...)
+			break
+		}
+	}
+
+	acct, err := am.Account("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{savingsAddr.String()}, acct.Addresses)
+	balance, err := am.Balance("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(500), balance)
+
+	// Account notes may not be selected explicitly
+	_, err = am.SpendUnassigned(unassigned, 100, 0, types.NewID(savingsNote.Commitment))
+	assert.ErrorIs(t, err, ErrAccountNote)
+	_, err = am.TimelockUnassigned(100, time.Now().Add(time.Hour), 0, types.NewID(savingsNote.Commitment))
+	assert.ErrorIs(t, err, ErrAccountNote)
+	assert.ErrorIs(t, am.StakeUnassigned([]types.ID{types.NewID(savingsNote.Commitment)}), ErrAccountNote)
+	assert.Nil(t, w.staked)
+
+	// The sweep only covers the unassigned change note less the fee
+	_, err = am.SweepUnassigned(unassigned, 0)
+	assert.NoError(t, err)
+	assert.Len(t, w.spentInput, 1)
+	assert.Equal(t, types.Amount(500)-walletlib.ComputeFee(1, 2, 10), w.spentAmount)
+
+	balance, err = am.Balance("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(500), balance)
+}
+
+func TestAccountManagerRawTransactions(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	w := &mockWallet{}
+	am, err := NewAccountManager(ds, w, 10)
+	assert.NoError(t, err)
+
+	assert.NoError(t, am.CreateAccount("savings", "Savings"))
+	savingsAddr, err := am.NewAddress("savings")
+	assert.NoError(t, err)
+	savingsNote := w.addNote(savingsAddr, 500)
+
+	unassigned, err := am.NewUnassignedAddress()
+	assert.NoError(t, err)
+	unassignedNote := w.addNote(unassigned, 1000)
+
+	savingsInput := &walletlib.RawInput{Commitment: savingsNote.Commitment}
+	unassignedInput := &walletlib.RawInput{Commitment: unassignedNote.Commitment}
+
+	// Account notes may not be used unless the account is named
+	_, err = am.CreateRawTransaction("", []*walletlib.RawInput{savingsInput}, nil, true, 0)
+	assert.ErrorIs(t, err, ErrAccountNote)
+	_, err = am.CreateRawStakeTransaction("", savingsInput)
+	assert.ErrorIs(t, err, ErrAccountNote)
+	_, err = am.CreateRawTransaction("savings", []*walletlib.RawInput{unassignedInput}, nil, true, 0)
+	assert.ErrorIs(t, err, ErrNotAccountNote)
+	_, err = am.CreateRawTransaction("unknown", nil, nil, true, 0)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	assert.Nil(t, w.rawInputs)
+
+	// Without inputs only unassigned notes are selected and the change
+	// goes to a new unassigned address.
+	_, err = am.CreateRawTransaction("", nil, nil, true, 0)
+	assert.NoError(t, err)
+	assert.Len(t, w.rawInputs, 1)
+	assert.Equal(t, unassignedNote.Commitment, w.rawInputs[0].Commitment)
+
+	acct, err := am.Account("savings")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{savingsAddr.String()}, acct.Addresses)
+	_, ok := am.addrs[w.addrs[len(w.addrs)-1].String()]
+	assert.False(t, ok)
+
+	// Naming the account selects its notes and keeps the change in it
+	_, err = am.CreateRawTransaction("savings", nil, nil, true, 0)
+	assert.NoError(t, err)
+	assert.Len(t, w.rawInputs, 1)
+	assert.Equal(t, savingsNote.Commitment, w.rawInputs[0].Commitment)
+
+	acct, err = am.Account("savings")
+	assert.NoError(t, err)
+	assert.Len(t, acct.Addresses, 2)
+	assert.Equal(t, w.addrs[len(w.addrs)-1].String(), acct.Addresses[1])
+
+	_, err = am.CreateRawStakeTransaction("savings", savingsInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []*walletlib.RawInput{savingsInput}, w.rawInputs)
+}
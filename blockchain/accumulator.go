@@ -92,7 +92,8 @@ func NewAccumulatorFromData(hashes [][]byte, nElements uint64) *Accumulator {
 //
 // If you wish to keep track of an InclusionProof for this data element set
 // 'protect' true. This must be done at the time of adding as it's not possible
-// to go back and protect previous items after the accumulator has been mutated.
+// to go back and protect previous items after the accumulator has been mutated
+// unless you have a valid inclusion proof for the item. See Protect.
 func (a *Accumulator) Insert(data []byte, protect bool) {
 	datacpy := make([]byte, len(data))
 	copy(datacpy, data)
@@ -228,6 +229,73 @@ func (a *Accumulator) GetProof(data []byte) (*InclusionProof, error) {
 	return newProof, nil
 }
 
+// Protect begins tracking the inclusion proof for a data element which
+// was previously inserted without protection, using an inclusion proof
+// that is valid against the current state of the accumulator. As new
+// elements are inserted the proof is updated incrementally and the
+// current proof can be retrieved with GetProof.
+//
+// This allows callers, such as wallets, which have been issued a proof
+// for an element to keep it up to date as blocks are connected without
+// requesting a full proof again.
+//
+// This is NOT safe for concurrent access.
+func (a *Accumulator) Protect(data []byte, proof *InclusionProof) error {
+	if proof.Index >= a.nElements {
+		return errors.New("proof index out of range")
+	}
+
+	// Find the height of the tree containing the element. Each
+	// set bit in nElements corresponds to a peak, with the
+	// highest peak containing the earliest elements.
+	var (
+		start  uint64
+		height = -1
+	)
+	for h := 63; h >= 0; h-- {
+		size := uint64(1) << h
+		if a.nElements&size == 0 {
+			continue
+		}
+		if proof.Index < start+size {
+			height = h
+			break
+		}
+		start += size
+	}
+	if height < 0 || height >= len(a.acc) || len(proof.Hashes) < height {
+		return errors.New("proof does not match accumulator")
+	}
+
+	// Only the hashes up to the peak are tracked. The rest
+	// of the proof is recalculated from the peaks by GetProof.
+	n := hash.HashWithIndex(data, proof.Index)
+	key := types.NewID(n)
+	ip := &InclusionProof{
+		ID:     types.NewID(data),
+		Hashes: make([][]byte, height),
+		Flags:  proof.Flags & (uint64(1)<<height - 1),
+		Index:  proof.Index,
+	}
+	for i := 0; i < height; i++ {
+		ip.Hashes[i] = make([]byte, len(proof.Hashes[i]))
+		copy(ip.Hashes[i], proof.Hashes[i])
+		if ip.Flags&(1<<i) > 0 {
+			n = hash.HashMerkleBranches(n, ip.Hashes[i])
+		} else {
+			n = hash.HashMerkleBranches(ip.Hashes[i], n)
+		}
+	}
+	if !bytes.Equal(n, a.acc[height]) {
+		return errors.New("proof does not match accumulator")
+	}
+	ip.last = n
+
+	a.proofs[key] = ip
+	a.lookupMap[ip.ID] = ip.Index
+	return nil
+}
+
 // DropProof ceases tracking of the inclusion proof for the given
 // element and deletes all tree branches related to the proof.
 //
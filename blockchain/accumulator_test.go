@@ -214,3 +214,40 @@ func deepEqualProofMap(a, b map[types.ID]*InclusionProof) bool {
 	}
 	return true
 }
+
+func TestAccumulator_Protect(t *testing.T) {
+	a := NewAccumulator()
+	server := NewAccumulator()
+	n := 128
+	elements := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, 32)
+		rand.Read(b)
+		elements = append(elements, b)
+		a.Insert(b, false)
+		server.Insert(b, true)
+
+		// Protect each element using the proof issued by the
+		// server a few elements after it was inserted.
+		if i >= 3 {
+			c := elements[i-3]
+			proof, err := server.GetProof(c)
+			assert.NoError(t, err)
+			assert.NoError(t, a.Protect(c, proof))
+		}
+
+		for _, c := range elements[:len(elements)-min(len(elements), 3)] {
+			proof, err := a.GetProof(c)
+			assert.NoError(t, err)
+			assert.True(t, standard.ValidateInclusionProof(proof.ID.Bytes(), proof.Index, proof.Hashes, proof.Flags, a.Root().Bytes()))
+		}
+	}
+
+	// A proof which doesn't match the accumulator is rejected.
+	proof, err := server.GetProof(elements[n-1])
+	assert.NoError(t, err)
+	proof.Hashes[0][0] ^= 0xff
+	assert.Error(t, a.Protect(elements[n-1], proof))
+	proof.Index = uint64(n)
+	assert.Error(t, a.Protect(elements[n-1], proof))
+}
@@ -13,6 +13,12 @@ import (
 	"time"
 )
 
+// maxBlocksBetweenAccumulatorFlushes is the maximum number of blocks
+// which will be connected before the accumulator is flushed to disk.
+// This bounds the number of blocks which need to be replayed on startup
+// after an unclean shutdown.
+const maxBlocksBetweenAccumulatorFlushes = 1000
+
 var ErrNoCheckpoint = errors.New("no accumulator checkpoint")
 
 // AccumulatorDB is responsible for persisting the accumulator on disk.
@@ -20,10 +26,11 @@ var ErrNoCheckpoint = errors.New("no accumulator checkpoint")
 // speed up writes as we don't have to write to disk every block but
 // rather only periodically.
 type AccumulatorDB struct {
-	acc       *Accumulator
-	ds        repo.Datastore
-	lastFlush time.Time
-	mtx       sync.RWMutex
+	acc             *Accumulator
+	ds              repo.Datastore
+	lastFlush       time.Time
+	lastFlushHeight uint32
+	mtx             sync.RWMutex
 }
 
 // NewAccumulatorDB returns a new AccumulatorDB
@@ -49,74 +56,83 @@ func (adb *AccumulatorDB) Init(tip *blockNode) error {
 	}
 
 	switch consistencyStatus {
+	case scsFlushOngoing:
+		// The accumulator and last flush height are written in a single
+		// database transaction so even if we shut down mid flush the
+		// accumulator on disk is consistent with the last flush height.
+		log.Warn("AccumulatorDB shut down mid flush. Loading last committed accumulator.")
+		fallthrough
 	case scsConsistent:
 		acc, err := dsFetchAccumulator(adb.ds)
 		if err != nil {
 			return err
 		}
 		adb.lastFlush = time.Now()
+		adb.lastFlushHeight = lastFlushHeight
 		adb.acc = acc
-		if tip != nil {
-			if lastFlushHeight == tip.Height() {
-				// We're good
-				return nil
-			} else if lastFlushHeight < tip.Height() {
-				// Load the missing blocks from disk and
-				// apply any changes to the accumulator.
-				var (
-					node = tip
-					err  error
-				)
-				for {
-					if node.height == lastFlushHeight+1 {
-						break
-					}
-					node, err = node.Parent()
-					if err != nil {
-						return err
-					}
-
+		if tip == nil {
+			return nil
+		}
+		if lastFlushHeight > tip.Height() {
+			// We can't remove the changes from the accumulator so
+			// load the most recent checkpoint at or before the tip
+			// and roll forward from there.
+			checkpointHeight := tip.Height() - tip.Height()%accumulatorCheckpointInterval
+			log.Warnf("AccumulatorDB last flush ahead of chain tip. Loading checkpoint at height %d.", checkpointHeight)
+			acc, err := dsFetchAccumulatorCheckpoint(adb.ds, checkpointHeight)
+			if err != nil {
+				return fmt.Errorf("accumulator db last flush ahead of chain tip and no checkpoint found. Use --reindexchainstate: %w", err)
+			}
+			adb.acc = acc
+			lastFlushHeight = checkpointHeight
+		}
+		if lastFlushHeight < tip.Height() {
+			// Load the missing blocks from disk and
+			// apply any changes to the accumulator.
+			node := tip
+			for node.height != lastFlushHeight+1 {
+				node, err = node.Parent()
+				if err != nil {
+					return err
 				}
-				for {
-					blk, err := node.Block()
-					if err != nil {
-						return err
-					}
-					for _, out := range blk.Outputs() {
-						// FIXME: the init function will ultimately need
-						// to take in a list of commitments to protect.
-						adb.acc.Insert(out.Commitment, false)
-					}
-					if node.height == tip.height {
-						break
-					}
-					node, err = node.Child()
-					if err != nil {
-						return err
-					}
+			}
+			for {
+				blk, err := node.Block()
+				if err != nil {
+					return err
+				}
+				for _, out := range blk.Outputs() {
+					// FIXME: the init function will ultimately need
+					// to take in a list of commitments to protect.
+					adb.acc.Insert(out.Commitment, false)
+				}
+				if node.height == tip.height {
+					break
 				}
-				if err := adb.Flush(FlushRequired, tip.height); err != nil {
+				node, err = node.Child()
+				if err != nil {
 					return err
 				}
-			} else if lastFlushHeight > tip.Height() {
-				// This really should never happen.
-				// If we're here it's unlikely the tip node
-				// has any attached children that we can use
-				// to load the blocks and remove the changes
-				// from the accumulator. Panic?
-				log.Fatal("AccumulatorDB last flush ahead of chain tip. Unable to repair. Use --reindexchainstate")
 			}
 		}
-	case scsFlushOngoing:
-		// Let's hope this never happens because at present we
-		// don't have any way to rollback the accumulator. The
-		// only way to get the accumulator to the current state
-		// is to recalculate it from genesis.
-		log.Fatal("AccumulatorDB shut down mid flush. Unable to repair. Use --reindexchainstate")
+		if adb.lastFlushHeight != tip.Height() || consistencyStatus == scsFlushOngoing {
+			if err := adb.Flush(FlushRequired, tip.height); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// LastFlushHeight returns the chain height at which the accumulator
+// was last flushed to disk.
+func (adb *AccumulatorDB) LastFlushHeight() uint32 {
+	adb.mtx.RLock()
+	defer adb.mtx.RUnlock()
+
+	return adb.lastFlushHeight
+}
+
 // Accumulator returns a clone of the current accumulator.
 func (adb *AccumulatorDB) Accumulator() *Accumulator {
 	adb.mtx.RLock()
@@ -140,6 +156,19 @@ func (adb *AccumulatorDB) Commit(accumulator *Accumulator, chainHeight uint32, m
 	return nil
 }
 
+// restore sets the accumulator after it has been written to disk at the
+// chain height in the transaction which disconnected a block.
+//
+// This is safe for concurrent access
+func (adb *AccumulatorDB) restore(accumulator *Accumulator, chainHeight uint32) {
+	adb.mtx.Lock()
+	defer adb.mtx.Unlock()
+
+	adb.acc = accumulator
+	adb.lastFlush = time.Now()
+	adb.lastFlushHeight = chainHeight
+}
+
 // Flush will trigger a manual flush of the accumulator DB to disk.
 //
 // This is safe for concurrent access
@@ -155,7 +184,8 @@ func (adb *AccumulatorDB) flush(mode flushMode, acc *Accumulator, chainHeight ui
 	case FlushRequired:
 		return adb.flushToDisk(acc, chainHeight)
 	case FlushPeriodic:
-		if adb.lastFlush.Add(maxTimeBetweenFlushes).Before(time.Now()) {
+		if adb.lastFlush.Add(maxTimeBetweenFlushes).Before(time.Now()) ||
+			chainHeight >= adb.lastFlushHeight+maxBlocksBetweenAccumulatorFlushes {
 			return adb.flushToDisk(acc, chainHeight)
 		}
 		return nil
@@ -193,5 +223,6 @@ func (adb *AccumulatorDB) flushToDisk(acc *Accumulator, chainHeight uint32) erro
 	}
 
 	adb.lastFlush = time.Now()
+	adb.lastFlushHeight = chainHeight
 	return nil
 }
@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"context"
 	"crypto/rand"
 	"github.com/project-illium/ilxd/repo/mock"
 	"github.com/stretchr/testify/assert"
@@ -122,3 +123,44 @@ func TestAccumulatorDB_Init(t *testing.T) {
 	// plus 8 mock blocks worth.
 	assert.EqualValues(t, 80+1000, adb.Accumulator().NumElements())
 }
+
+func TestAccumulatorDB_InitRecovery(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	index, err := mockBlockIndex(ds, 10)
+	assert.NoError(t, err)
+
+	// Simulate a shutdown mid flush. The last committed
+	// accumulator should be loaded and rolled forward.
+	adb := NewAccumulatorDB(ds)
+	assert.NoError(t, adb.Commit(NewAccumulator(), 7, FlushRequired))
+	assert.NoError(t, dsPutAccumulatorConsistencyStatus(ds, scsFlushOngoing))
+
+	adb = NewAccumulatorDB(ds)
+	assert.NoError(t, adb.Init(index.Tip()))
+	assert.EqualValues(t, 2*10, adb.Accumulator().NumElements())
+	assert.EqualValues(t, 9, adb.LastFlushHeight())
+
+	cs, err := dsFetchAccumulatorConsistencyStatus(ds)
+	assert.NoError(t, err)
+	assert.EqualValues(t, scsConsistent, cs)
+
+	// If the last flush is ahead of the tip the accumulator
+	// is loaded from the most recent checkpoint.
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsPutAccumulatorCheckpoint(dbtx, 0, NewAccumulator()))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	assert.NoError(t, adb.Commit(NewAccumulator(), 20, FlushRequired))
+
+	adb = NewAccumulatorDB(ds)
+	assert.NoError(t, adb.Init(index.Tip()))
+	assert.EqualValues(t, 9*10, adb.Accumulator().NumElements())
+	assert.EqualValues(t, 9, adb.LastFlushHeight())
+
+	// Periodic commits flush once enough blocks have been
+	// connected since the last flush.
+	assert.NoError(t, adb.Commit(NewAccumulator(), 10, FlushPeriodic))
+	assert.EqualValues(t, 9, adb.LastFlushHeight())
+	assert.NoError(t, adb.Commit(NewAccumulator(), 9+maxBlocksBetweenAccumulatorFlushes, FlushPeriodic))
+	assert.EqualValues(t, 9+maxBlocksBetweenAccumulatorFlushes, adb.LastFlushHeight())
+}
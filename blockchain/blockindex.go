@@ -6,6 +6,7 @@ package blockchain
 
 import (
 	"errors"
+	datastore "github.com/ipfs/go-datastore"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
@@ -130,8 +131,12 @@ func (bi *blockIndex) Init() error {
 		return err
 	}
 	bi.tip = tip
+	// A chain bootstrapped from a snapshot has no blocks between
+	// genesis and the snapshot so we only cache the blocks we have.
 	parent, err := tip.Parent()
-	if err != nil {
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil
+	} else if err != nil {
 		return err
 	}
 	if parent == nil {
@@ -140,7 +145,9 @@ func (bi *blockIndex) Init() error {
 
 	for i := 0; i < blockIndexCacheSize-1; i++ {
 		parent, err = parent.Parent()
-		if err != nil {
+		if errors.Is(err, datastore.ErrNotFound) {
+			break
+		} else if err != nil {
 			return err
 		}
 		if parent == nil {
@@ -183,6 +190,31 @@ func (bi *blockIndex) ExtendIndex(header *blocks.BlockHeader) {
 	bi.limitCache()
 }
 
+// RollbackIndex removes the tip from the in-memory index and sets
+// the header, which must be the parent of the tip, as the new tip.
+func (bi *blockIndex) RollbackIndex(header *blocks.BlockHeader) {
+	bi.mtx.Lock()
+	defer bi.mtx.Unlock()
+
+	delete(bi.cacheByID, bi.tip.blockID)
+	delete(bi.cacheByHeight, bi.tip.height)
+
+	node := bi.tip.parent
+	if node == nil || node.blockID != header.ID() {
+		node = &blockNode{
+			ds:      bi.ds,
+			blockID: header.ID(),
+			height:  header.Height,
+		}
+		bi.cacheByID[node.blockID] = node
+		bi.cacheByHeight[node.height] = node
+		bi.limitCache()
+	}
+	node.timestamp = header.Timestamp
+	node.child = nil
+	bi.tip = node
+}
+
 // GetNodeByHeight returns a blockNode at the provided height. It will be
 // returned from cache if it exists, otherwise it will be loaded from the
 // database.
@@ -14,14 +14,21 @@ import (
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
-	"math"
+	"github.com/project-illium/ilxd/zk"
 	"sync"
 	"time"
 )
 
 const (
 	accumulatorCheckpointInterval = 100000
-	pruneDepth                    = 10
+
+	// iterateBlocksBatchSize is the number of block IDs read from
+	// the height index at a time by IterateBlocks.
+	iterateBlocksBatchSize = 500
+
+	// pruneBatchSize is the maximum number of blocks deleted in
+	// a single database transaction when pruning.
+	pruneBatchSize = 1000
 )
 
 type flushMode uint8
@@ -51,11 +58,40 @@ type Blockchain struct {
 	txoRootSet        *TxoRootSet
 	sigCache          *SigCache
 	proofCache        *ProofCache
+	prover            zk.Prover
 	indexManager      IndexManager
 	notifications     []NotificationCallback
+	validationWorkers int
+	checkpoints       []params.Checkpoint
 	prune             bool
+	pruneDepth        uint32
 	notificationsLock sync.RWMutex
 
+	// prunedHeight is the height of the lowest block which has
+	// not been pruned.
+	prunedHeight uint32
+	// txoRootHeights holds the heights of the blocks which
+	// created the txo roots in the txo root set's cache, oldest
+	// first. These blocks are not pruned.
+	txoRootHeights []uint32
+
+	// checkpointAncestors holds the IDs and heights of the blocks
+	// which are known to lead up to a checkpoint and are not yet
+	// connected.
+	checkpointAncestors map[types.ID]uint32
+
+	// snapshots tracks the snapshots which are being built or are
+	// stored by the block ID of the snapshot block. snapshotWg is
+	// used to wait for the snapshots being built on shutdown.
+	snapshots    map[types.ID]*snapshotBuild
+	snapshotLock sync.Mutex
+	snapshotWg   sync.WaitGroup
+
+	// halted is set when a reorganization fails and the original
+	// branch cannot be restored. No further changes are made to
+	// the chain state.
+	halted bool
+
 	// stateLock protects concurrent access to the chain state
 	stateLock sync.RWMutex
 }
@@ -73,19 +109,31 @@ func NewBlockchain(opts ...Option) (*Blockchain, error) {
 		return nil, err
 	}
 
+	checkpoints, err := mergeCheckpoints(cfg.params, cfg.checkpoints)
+	if err != nil {
+		return nil, err
+	}
+
 	b := &Blockchain{
-		params:            cfg.params,
-		ds:                cfg.datastore,
-		index:             NewBlockIndex(cfg.datastore),
-		accumulatorDB:     NewAccumulatorDB(cfg.datastore),
-		validatorSet:      NewValidatorSet(cfg.params, cfg.datastore),
-		nullifierSet:      NewNullifierSet(cfg.datastore, cfg.maxNullifiers),
-		txoRootSet:        NewTxoRootSet(cfg.datastore, cfg.maxTxoRoots),
-		indexManager:      cfg.indexManager,
-		sigCache:          cfg.sigCache,
-		proofCache:        cfg.proofCache,
-		stateLock:         sync.RWMutex{},
-		notificationsLock: sync.RWMutex{},
+		params:              cfg.params,
+		ds:                  cfg.datastore,
+		index:               NewBlockIndex(cfg.datastore),
+		accumulatorDB:       NewAccumulatorDB(cfg.datastore),
+		validatorSet:        NewValidatorSet(cfg.params, cfg.datastore),
+		nullifierSet:        NewNullifierSet(cfg.datastore, cfg.maxNullifiers),
+		txoRootSet:          NewTxoRootSet(cfg.datastore, cfg.maxTxoRoots),
+		indexManager:        cfg.indexManager,
+		sigCache:            cfg.sigCache,
+		proofCache:          cfg.proofCache,
+		prover:              cfg.prover,
+		validationWorkers:   cfg.validationWorkers,
+		checkpoints:         checkpoints,
+		checkpointAncestors: make(map[types.ID]uint32),
+		snapshots:           make(map[types.ID]*snapshotBuild),
+		prune:               cfg.prune,
+		pruneDepth:          cfg.pruneDepth,
+		stateLock:           sync.RWMutex{},
+		notificationsLock:   sync.RWMutex{},
 	}
 
 	initialized, err := b.isInitialized()
@@ -113,7 +161,7 @@ func NewBlockchain(opts ...Option) (*Blockchain, error) {
 		}
 
 		if b.indexManager != nil {
-			if err := b.indexManager.Init(b.index.Tip().Height(), b.GetBlockByHeight); err != nil {
+			if err := b.indexManager.Init(b.index.Tip().Height(), b.fetchBlockByHeight); err != nil {
 				return nil, err
 			}
 		}
@@ -122,46 +170,145 @@ func NewBlockchain(opts ...Option) (*Blockchain, error) {
 		return nil, err
 	}
 
-	node := b.index.Tip()
-	if b.prune {
-		if err := dsPutPrunedFlag(b.ds); err != nil {
+	// Finish loading a snapshot if the node shut down part way through.
+	manifest, err := dsFetchSnapshotLoad(b.ds)
+	if err == nil {
+		if err := b.applySnapshot(manifest); err != nil {
 			return nil, err
 		}
+	} else if !errors.Is(err, datastore.ErrNotFound) {
+		return nil, err
+	}
+	if err := b.loadSnapshots(); err != nil {
+		return nil, err
+	}
 
-		if node.Height() >= pruneDepth {
-			_, err = dsFetchBlockIDFromHeight(b.ds, 0)
-			if err == nil {
-				dbtx, err := b.ds.NewTransaction(context.Background(), false)
-				if err != nil {
-					return nil, err
-				}
-				defer dbtx.Discard(context.Background())
-				for {
-					if err := dsDeleteBlock(dbtx, node.blockID); err != nil {
-						return nil, err
-					}
-					if err := dsDeleteBlockIDFromHeight(dbtx, node.height); err != nil {
-						return nil, err
-					}
-					if node.height+pruneDepth >= b.index.Tip().height {
-						break
-					}
-					node, err = node.Child()
-					if err != nil {
-						return nil, err
-					}
-				}
-				if err := dbtx.Commit(context.Background()); err != nil {
-					return nil, err
-				}
+	b.txoRootHeights, err = dsFetchTxoRootHeights(b.ds)
+	if errors.Is(err, datastore.ErrNotFound) {
+		b.txoRootHeights, err = b.rebuildTxoRootHeights()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if b.prune {
+		b.prunedHeight, err = dsFetchPrunedHeight(b.ds)
+		if err != nil {
+			return nil, err
+		}
+		// Prune in batches so that enabling pruning on a long
+		// chain doesn't delete everything in one transaction.
+		for {
+			dbtx, err := b.ds.NewTransaction(context.Background(), false)
+			if err != nil {
+				return nil, err
+			}
+			prunedHeight, err := b.pruneBlocks(dbtx, b.index.Tip().Height(), b.txoRootHeights)
+			if err != nil {
+				dbtx.Discard(context.Background())
+				return nil, err
 			}
+			if err := dbtx.Commit(context.Background()); err != nil {
+				return nil, err
+			}
+			if prunedHeight == b.prunedHeight {
+				break
+			}
+			b.prunedHeight = prunedHeight
 		}
 	}
+
+	// Build the snapshot at the tip again if the node shut down before
+	// it was stored. The block which commits to it cannot be connected
+	// without it.
+	if err := b.maybeSnapshot(); err != nil {
+		return nil, err
+	}
+	// Likewise rebuild any snapshots whose builds did not finish if the
+	// tip has since moved on.
+	if err := b.rebuildSnapshots(); err != nil {
+		return nil, err
+	}
 	return b, nil
 }
 
+// rebuildTxoRootHeights returns the heights of the most recent blocks
+// containing outputs. It is used for chains which were created before
+// the heights were persisted.
+func (b *Blockchain) rebuildTxoRootHeights() ([]uint32, error) {
+	var heights []uint32
+	height := b.index.Tip().Height()
+	for uint(len(heights)) < b.txoRootSet.maxEntries {
+		blk, err := b.fetchBlockByHeight(height)
+		if errors.Is(err, datastore.ErrNotFound) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(blk.Outputs()) > 0 {
+			heights = append([]uint32{height}, heights...)
+		}
+		if height == 0 {
+			break
+		}
+		height--
+	}
+	return heights, nil
+}
+
+// pruneBlocks deletes the blocks from the lowest unpruned height up to the
+// blocks which are retained and returns the new lowest unpruned height.
+// The most recent pruneDepth blocks are retained as are the blocks at the
+// txoRootHeights, and any blocks after them, as transactions may still
+// reference their txo roots. Blocks after the last accumulator flush are
+// also retained as they are needed to roll the accumulator forward on
+// startup.
+//
+// At most pruneBatchSize blocks are deleted per call.
+func (b *Blockchain) pruneBlocks(dbtx datastore.Txn, tipHeight uint32, txoRootHeights []uint32) (uint32, error) {
+	if tipHeight+1 <= b.pruneDepth {
+		return b.prunedHeight, nil
+	}
+	pruneTo := tipHeight + 1 - b.pruneDepth
+	if len(txoRootHeights) > 0 && txoRootHeights[0] < pruneTo {
+		pruneTo = txoRootHeights[0]
+	}
+	if flushHeight := b.accumulatorDB.LastFlushHeight(); flushHeight+1 < pruneTo {
+		pruneTo = flushHeight + 1
+	}
+	if pruneTo <= b.prunedHeight {
+		return b.prunedHeight, nil
+	}
+	if pruneTo-b.prunedHeight > pruneBatchSize {
+		pruneTo = b.prunedHeight + pruneBatchSize
+	}
+	for height := b.prunedHeight; height < pruneTo; height++ {
+		blockID, err := dsFetchBlockIDFromHeightWithTx(dbtx, height)
+		if errors.Is(err, datastore.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		if err := dsDeleteBlockIDFromHeight(dbtx, height); err != nil {
+			return 0, err
+		}
+		if err := dsDeleteBlock(dbtx, blockID); err != nil {
+			return 0, err
+		}
+		if err := dsDeleteBlockUndo(dbtx, blockID); err != nil {
+			return 0, err
+		}
+	}
+	if err := dsPutPrunedHeight(dbtx, pruneTo); err != nil {
+		return 0, err
+	}
+	return pruneTo, nil
+}
+
 // Close flushes all caches to disk and makes the node safe to shutdown.
 func (b *Blockchain) Close() error {
+	b.snapshotWg.Wait()
+
 	b.stateLock.Lock()
 	defer b.stateLock.Unlock()
 
@@ -207,6 +354,16 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 	b.stateLock.Lock()
 	defer b.stateLock.Unlock()
 
+	if b.halted {
+		return ErrChainHalted
+	}
+	return b.connectBlock(blk, flags)
+}
+
+// connectBlock connects the block to the chain.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) connectBlock(blk *blocks.Block, flags BehaviorFlags) error {
 	if !flags.HasFlag(BFGenesisValidation) {
 		if err := b.checkBlockContext(blk.Header); err != nil {
 			return err
@@ -246,6 +403,23 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 	}
 
 	accumulator := b.accumulatorDB.Accumulator()
+
+	// Record the state prior to the block so that it can be disconnected
+	// in the event of a reorg. There is nothing to undo for genesis.
+	var undo *blockUndo
+	if !flags.HasFlag(BFGenesisValidation) {
+		undo = &blockUndo{accumulator: accumulator.Clone()}
+		undo.validators, undo.epochBlocks = b.validatorSet.copyValidators()
+		undo.treasuryBalance, err = dsFetchTreasuryBalance(b.ds)
+		if err != nil {
+			return err
+		}
+		undo.currentSupply, err = dsFetchCurrentSupply(dbtx)
+		if err != nil {
+			return err
+		}
+	}
+
 	blockCointainsOutputs := false
 	treasuryWidthdrawl := types.Amount(0)
 	for _, tx := range blk.Transactions {
@@ -266,6 +440,10 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 		if err := b.txoRootSet.AddRoot(dbtx, accumulator.Root()); err != nil {
 			return err
 		}
+		if undo != nil {
+			root := accumulator.Root()
+			undo.txoRoot = &root
+		}
 	}
 
 	var (
@@ -284,20 +462,20 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 		if b.params.Name == params.RegestParams.Name && prevHeader.Height == 0 {
 			prevHeader.Timestamp = time.Now().Unix()
 		}
-		prevEpoch := (prevHeader.Timestamp - b.params.GenesisBlock.Header.Timestamp) / b.params.EpochLength
-		blkEpoch := (blk.Header.Timestamp - b.params.GenesisBlock.Header.Timestamp) / b.params.EpochLength
+		prevEpoch := b.params.EpochForTimestamp(prevHeader.Timestamp)
+		blkEpoch := b.params.EpochForTimestamp(blk.Header.Timestamp)
 		if blkEpoch > prevEpoch {
-			coinbase := calculateNextCoinbaseDistribution(b.params, blkEpoch)
+			coinbase := b.params.CalculateSubsidy(blkEpoch)
 			if err := dsIncrementCurrentSupply(dbtx, coinbase); err != nil {
 				return err
 			}
 
-			treasuryCredit := float64(coinbase) / (float64(100) / b.params.TreasuryPercentage)
-			if err := dsCreditTreasury(dbtx, types.Amount(treasuryCredit)); err != nil {
+			treasuryCredit := b.params.TreasuryCredit(coinbase)
+			if err := dsCreditTreasury(dbtx, treasuryCredit); err != nil {
 				return err
 			}
 
-			validatorReward = coinbase - types.Amount(treasuryCredit)
+			validatorReward = coinbase - treasuryCredit
 			newEpoch = true
 		}
 	}
@@ -314,15 +492,19 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 		}
 	}
 
-	if b.prune && blk.Header.Height >= pruneDepth {
-		blockID, err := dsFetchBlockIDFromHeightWithTx(dbtx, blk.Header.Height-pruneDepth)
-		if err != nil {
-			return err
+	prunedHeight, txoRootHeights := b.prunedHeight, b.txoRootHeights
+	if blockCointainsOutputs && b.txoRootSet.maxEntries > 0 {
+		txoRootHeights = append(txoRootHeights[:len(txoRootHeights):len(txoRootHeights)], blk.Header.Height)
+		if uint(len(txoRootHeights)) > b.txoRootSet.maxEntries {
+			txoRootHeights = txoRootHeights[1:]
 		}
-		if err := dsDeleteBlockIDFromHeight(dbtx, blk.Header.Height-pruneDepth); err != nil {
+		if err := dsPutTxoRootHeights(dbtx, txoRootHeights); err != nil {
 			return err
 		}
-		if err := dsDeleteBlock(dbtx, blockID); err != nil {
+	}
+	if b.prune {
+		prunedHeight, err = b.pruneBlocks(dbtx, blk.Header.Height, txoRootHeights)
+		if err != nil {
 			return err
 		}
 	}
@@ -332,10 +514,28 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 		return err
 	}
 
-	if err := b.nullifierSet.AddNullifiers(dbtx, append(blk.Nullifiers(), vstx.NullifiersToBan()...)); err != nil {
+	nullifiers := append(blk.Nullifiers(), vstx.NullifiersToBan()...)
+	if err := b.nullifierSet.AddNullifiers(dbtx, nullifiers); err != nil {
 		return err
 	}
 
+	if undo != nil {
+		undo.nullifiers = nullifiers
+		if err := dsPutBlockUndo(dbtx, blk.ID(), undo); err != nil {
+			return err
+		}
+	}
+	if blk.Header.Height > MaxReorgDepth {
+		blockID, err := dsFetchBlockIDFromHeightWithTx(dbtx, blk.Header.Height-MaxReorgDepth)
+		if err != nil && !errors.Is(err, datastore.ErrNotFound) {
+			return err
+		} else if err == nil {
+			if err := dsDeleteBlockUndo(dbtx, blockID); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := dbtx.Commit(context.Background()); err != nil {
 		return err
 	}
@@ -344,6 +544,8 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 	if blockCointainsOutputs {
 		b.txoRootSet.UpdateCache(accumulator.Root())
 	}
+	b.prunedHeight, b.txoRootHeights = prunedHeight, txoRootHeights
+	b.removeCheckpointAncestors(blk.Header)
 
 	b.index.ExtendIndex(blk.Header)
 
@@ -359,10 +561,21 @@ func (b *Blockchain) ConnectBlock(blk *blocks.Block, flags BehaviorFlags) (err e
 		log.Errorf("Commit Block: Error flushing validator set: %s", err.Error())
 	}
 
-	if err := b.accumulatorDB.Commit(accumulator, blk.Header.Height, flushMode); err != nil {
+	// Pruning won't delete blocks after the last accumulator flush so
+	// when pruning we flush once the blocks beyond the prune depth are
+	// only being retained for the accumulator.
+	accFlushMode := flushMode
+	if b.prune && flushMode == FlushPeriodic && blk.Header.Height >= b.accumulatorDB.LastFlushHeight()+b.pruneDepth {
+		accFlushMode = FlushRequired
+	}
+	if err := b.accumulatorDB.Commit(accumulator, blk.Header.Height, accFlushMode); err != nil {
 		log.Errorf("Commit Block: Error flushing accumulator: %s", err.Error())
 	}
 
+	if err := b.maybeSnapshot(); err != nil {
+		log.Errorf("Commit Block: Error starting snapshot: %s", err.Error())
+	}
+
 	// Notify subscribers of new block.
 	b.sendNotification(NTBlockConnected, blk)
 	if newEpoch {
@@ -402,10 +615,14 @@ func (b *Blockchain) ReindexChainState() error {
 	if err := dsDeleteValidatorSet(dbtx); err != nil {
 		return err
 	}
+	if err := dsPutTxoRootHeights(dbtx, nil); err != nil {
+		return err
+	}
 
 	if err := dbtx.Commit(context.Background()); err != nil {
 		return err
 	}
+	b.txoRootHeights = nil
 
 	if err := dsInitCurrentSupply(b.ds); err != nil {
 		return err
@@ -433,7 +650,7 @@ func (b *Blockchain) ReindexChainState() error {
 			flags = BFNoDupBlockCheck | BFFastAdd | BFGenesisValidation
 		}
 
-		if err := b.ConnectBlock(blk, flags); err != nil {
+		if err := b.connectBlock(blk, flags); err != nil {
 			return err
 		}
 		i++
@@ -471,6 +688,97 @@ func (b *Blockchain) GetBlockByHeight(height uint32) (*blocks.Block, error) {
 	return node.Block()
 }
 
+// IterateBlocks calls fn with each block from fromHeight to toHeight,
+// inclusive, in order. Iteration stops and the error is returned if fn
+// returns an error.
+//
+// The block IDs are read from the datastore in batches using a range scan
+// of the height index and the blocks are loaded without holding the state
+// lock so fn may call other methods on the Blockchain. If the chain is
+// reorganized during iteration such that the blocks no longer connect,
+// ErrDoesNotConnect is returned.
+func (b *Blockchain) IterateBlocks(fromHeight, toHeight uint32, fn func(blk *blocks.Block) error) error {
+	if err := b.checkHeightRange(fromHeight, toHeight); err != nil {
+		return err
+	}
+
+	var prevID types.ID
+	for height := fromHeight; height <= toHeight; {
+		count := toHeight - height + 1
+		if count > iterateBlocksBatchSize {
+			count = iterateBlocksBatchSize
+		}
+		ids, err := dsFetchBlockIDsFromHeight(b.ds, height, int(count))
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			blk, err := dsFetchBlock(b.ds, id)
+			if err != nil {
+				return err
+			}
+			if height > fromHeight && types.NewID(blk.Header.Parent) != prevID {
+				return ruleError(ErrDoesNotConnect, "chain reorganized during iteration")
+			}
+			prevID = id
+			if err := fn(blk); err != nil {
+				return err
+			}
+			height++
+		}
+	}
+	return nil
+}
+
+// GetBlocksByRange returns the blocks from fromHeight to toHeight, inclusive.
+func (b *Blockchain) GetBlocksByRange(fromHeight, toHeight uint32) ([]*blocks.Block, error) {
+	if err := b.checkHeightRange(fromHeight, toHeight); err != nil {
+		return nil, err
+	}
+	// The range is bounded by the chain tip, but don't trust it to size
+	// the allocation up front.
+	size := toHeight - fromHeight + 1
+	if size > iterateBlocksBatchSize {
+		size = iterateBlocksBatchSize
+	}
+	blks := make([]*blocks.Block, 0, size)
+	err := b.IterateBlocks(fromHeight, toHeight, func(blk *blocks.Block) error {
+		blks = append(blks, blk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blks, nil
+}
+
+// checkHeightRange returns an error if the range is inverted or extends
+// beyond the chain tip.
+func (b *Blockchain) checkHeightRange(fromHeight, toHeight uint32) error {
+	b.stateLock.RLock()
+	tipHeight := b.index.Tip().Height()
+	b.stateLock.RUnlock()
+
+	if fromHeight > toHeight {
+		return errors.New("from height greater than to height")
+	}
+	if toHeight > tipHeight {
+		return errors.New("height beyond chain tip")
+	}
+	return nil
+}
+
+// fetchBlockByHeight loads the block at the height from the datastore
+// without acquiring the stateLock. It is used by the index manager
+// which may load blocks in the background while the stateLock is held.
+func (b *Blockchain) fetchBlockByHeight(height uint32) (*blocks.Block, error) {
+	blockID, err := dsFetchBlockIDFromHeight(b.ds, height)
+	if err != nil {
+		return nil, err
+	}
+	return dsFetchBlock(b.ds, blockID)
+}
+
 // GetBlockByID returns the block with the given ID. The block will be loaded from disk.
 func (b *Blockchain) GetBlockByID(blockID types.ID) (*blocks.Block, error) {
 	b.stateLock.RLock()
@@ -637,6 +945,11 @@ func (b *Blockchain) Params() *params.NetworkParams {
 	return b.params
 }
 
+// Prover returns the backend used to verify the zk-snark proofs.
+func (b *Blockchain) Prover() zk.Prover {
+	return b.prover
+}
+
 // CurrentSupply returns the current circulating supply of coins.
 func (b *Blockchain) CurrentSupply() (types.Amount, error) {
 	b.stateLock.RLock()
@@ -712,7 +1025,9 @@ func (b *Blockchain) IsProducerUnderLimit(validatorID peer.ID) (bool, error) {
 }
 
 func (b *Blockchain) isInitialized() (bool, error) {
-	_, err := dsFetchBlockIDFromHeight(b.ds, 0)
+	// The genesis block may have been pruned so we check
+	// for the block index state instead.
+	_, err := dsFetchBlockIndexState(b.ds)
 	if err == datastore.ErrNotFound {
 		return false, nil
 	} else if err != nil {
@@ -720,32 +1035,3 @@ func (b *Blockchain) isInitialized() (bool, error) {
 	}
 	return true, nil
 }
-
-/*func calculateNextCoinbaseDistributionOld(params *params.NetworkParams, epoch int64) types.Amount {
-	if epoch > params.InitialDistributionPeriods {
-		a := float64(params.TargetDistribution) * params.LongTermInflationRate
-		return types.Amount(a * math.Pow(1.0+params.LongTermInflationRate, float64(epoch-params.InitialDistributionPeriods)))
-	}
-
-	return types.Amount((params.AValue * math.Pow(1.0-params.DecayFactor, float64(epoch))) + (float64(params.TargetDistribution) * (params.LongTermInflationRate * params.RValue)))
-}*/
-
-func calculateNextCoinbaseDistribution(params *params.NetworkParams, epoch int64) types.Amount {
-	if epoch > params.InitialDistributionPeriods {
-		a := float64(params.TargetDistribution) * params.LongTermInflationRate
-		return types.Amount(a * math.Pow(1.0+params.LongTermInflationRate, float64(epoch-params.InitialDistributionPeriods)))
-	}
-
-	n := float64(params.TargetDistribution) - float64(params.GenesisBlock.Transactions[0].GetCoinbaseTransaction().NewCoins)
-	periods := float64(params.InitialDistributionPeriods)
-	w0 := (n / periods) * params.AValue
-	wl := float64(params.TargetDistribution) * params.LongTermInflationRate
-	r := math.Pow((wl / w0), (1 / periods))
-	return types.Amount(w0 * math.Pow(r, float64(epoch)))
-}
-
-func calculateNextValidatorReward(params *params.NetworkParams, epoch int64) types.Amount {
-	coinbase := calculateNextCoinbaseDistribution(params, epoch)
-	treasuryCredit := float64(coinbase) * (params.TreasuryPercentage / 100)
-	return coinbase - types.Amount(treasuryCredit)
-}
@@ -6,6 +6,7 @@ package blockchain
 
 import (
 	"context"
+	"errors"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/params"
@@ -142,43 +143,6 @@ func TestBlockchain(t *testing.T) {
 	assert.Greater(t, val.UnclaimedCoins, uint64(0))
 }
 
-func TestCalculateNextCoinbaseDistribution(t *testing.T) {
-	var prevCoinbase, total types.Amount
-	for i := int64(0); i < params.MainnetParams.InitialDistributionPeriods; i++ {
-		coinbase := calculateNextCoinbaseDistribution(&params.MainnetParams, i)
-		if i > 0 {
-			assert.Less(t, coinbase, prevCoinbase)
-		}
-		prevCoinbase = coinbase
-		total += coinbase
-	}
-	initalCoins := types.Amount(params.MainnetParams.GenesisBlock.Transactions[0].GetCoinbaseTransaction().NewCoins)
-	// The algorithm doesn't hit the nail on the head perfectly in terms of distributing
-	// the target supply in the initial distribution periods. But let's just check make
-	// sure it's within some tolerable amount. In this case around three tenths of
-	// a percent.
-	assert.Less(t, (float64(total+initalCoins)-float64(params.MainnetParams.TargetDistribution))/float64(params.MainnetParams.TargetDistribution), float64(.0035))
-
-	prevCoinbase, total = 0, 0
-	for i := params.MainnetParams.InitialDistributionPeriods + 1; i < params.MainnetParams.InitialDistributionPeriods+53; i++ {
-		coinbase := calculateNextCoinbaseDistribution(&params.MainnetParams, i)
-		assert.Greater(t, coinbase, prevCoinbase)
-		prevCoinbase = coinbase
-		total += coinbase
-	}
-
-	// Same here. Make sure the long term inflation rate is within some tolerable margin of 2%.
-	assert.Less(t, (float64(total)/float64(params.MainnetParams.TargetDistribution))-float64(.02), float64(.00001))
-}
-
-func TestCalculateNextValidatorReward(t *testing.T) {
-	for i := int64(0); i < params.MainnetParams.InitialDistributionPeriods; i++ {
-		coinbase := calculateNextCoinbaseDistribution(&params.MainnetParams, i)
-		validatorReward := calculateNextValidatorReward(&params.MainnetParams, i)
-		assert.Equal(t, math.Round(float64(validatorReward)/float64(coinbase)*100)/100, (float64(100)-params.MainnetParams.TreasuryPercentage)/100)
-	}
-}
-
 func finalizeAndSignBlock(blk *blocks.Block, privKey crypto.PrivKey) error {
 	merkleRoot := TransactionsMerkleRoot(blk.Transactions)
 	blk.Header.TxRoot = merkleRoot[:]
@@ -223,3 +187,74 @@ func finalizeAndSignBlock(blk *blocks.Block, privKey crypto.PrivKey) error {
 	fmt.Println(w_520)
 	fmt.Println(total / (1 << 60) * .8)
 */
+
+func TestIterateBlocks(t *testing.T) {
+	b, err := NewBlockchain(DefaultOptions())
+	assert.NoError(t, err)
+
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	genesis := params.RegestParams.GenesisBlock
+	ids := []types.ID{genesis.ID()}
+	for i := uint32(1); i <= 3; i++ {
+		parentID := ids[i-1]
+		blk := &blocks.Block{
+			Header: &blocks.BlockHeader{
+				Version:   1,
+				Height:    i,
+				Parent:    parentID[:],
+				Timestamp: genesis.Header.Timestamp + int64(i),
+			},
+			Transactions: []*transactions.Transaction{
+				transactions.WrapTransaction(&transactions.TreasuryTransaction{
+					Amount: 1000,
+					Outputs: []*transactions.Output{
+						{
+							Commitment: append([]byte{byte(i)}, make([]byte, types.CommitmentLen-1)...),
+							Ciphertext: make([]byte, CiphertextLen),
+						},
+					},
+					ProposalHash: make([]byte, MaxDocumentHashLen),
+				}),
+			},
+		}
+		assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+		assert.NoError(t, b.ConnectBlock(blk, BFFastAdd))
+		ids = append(ids, blk.ID())
+	}
+
+	var iterated []types.ID
+	assert.NoError(t, b.IterateBlocks(0, 3, func(blk *blocks.Block) error {
+		iterated = append(iterated, blk.ID())
+		return nil
+	}))
+	assert.Equal(t, ids, iterated)
+
+	// Iteration stops at the first error.
+	errStop := errors.New("stop")
+	iterated = nil
+	assert.ErrorIs(t, b.IterateBlocks(1, 3, func(blk *blocks.Block) error {
+		iterated = append(iterated, blk.ID())
+		return errStop
+	}), errStop)
+	assert.Equal(t, ids[1:2], iterated)
+
+	blks, err := b.GetBlocksByRange(2, 3)
+	assert.NoError(t, err)
+	assert.Len(t, blks, 2)
+	assert.Equal(t, ids[2], blks[0].ID())
+	assert.Equal(t, ids[3], blks[1].ID())
+
+	_, err = b.GetBlocksByRange(2, 4)
+	assert.Error(t, err)
+	_, err = b.GetBlocksByRange(3, 2)
+	assert.Error(t, err)
+	_, err = b.GetBlocksByRange(0, math.MaxUint32)
+	assert.Error(t, err)
+}
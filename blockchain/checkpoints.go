@@ -0,0 +1,118 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"sort"
+)
+
+// mergeCheckpoints returns the checkpoints in the params together with
+// the configured checkpoints, sorted by height. An error is returned if
+// any of them conflict with each other.
+func mergeCheckpoints(netParams *params.NetworkParams, checkpoints []params.Checkpoint) ([]params.Checkpoint, error) {
+	sorted := make([]params.Checkpoint, 0, len(netParams.Checkpoints)+len(checkpoints))
+	sorted = append(sorted, netParams.Checkpoints...)
+	sorted = append(sorted, checkpoints...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Height < sorted[j].Height
+	})
+	merged := sorted[:0]
+	for _, checkpoint := range sorted {
+		if len(merged) > 0 && merged[len(merged)-1].Height == checkpoint.Height {
+			if merged[len(merged)-1].BlockID != checkpoint.BlockID {
+				return nil, AssertError("NewBlockchain: conflicting checkpoints")
+			}
+			continue
+		}
+		merged = append(merged, checkpoint)
+	}
+	return merged, nil
+}
+
+// Checkpoints returns the checkpoints in the params together with those
+// the chain was configured with, sorted by height.
+func (b *Blockchain) Checkpoints() []params.Checkpoint {
+	checkpoints := make([]params.Checkpoint, len(b.checkpoints))
+	copy(checkpoints, b.checkpoints)
+	return checkpoints
+}
+
+// CheckpointAtHeight returns the checkpoint at the height if there is one
+// in either the checkpoints the chain was configured with or the params.
+func (b *Blockchain) CheckpointAtHeight(height uint32) (params.Checkpoint, bool) {
+	i := sort.Search(len(b.checkpoints), func(i int) bool {
+		return b.checkpoints[i].Height >= height
+	})
+	if i < len(b.checkpoints) && b.checkpoints[i].Height == height {
+		return b.checkpoints[i], true
+	}
+	return params.Checkpoint{}, false
+}
+
+// AddCheckpointHeaders records the headers as ancestors of a checkpoint.
+// The headers must build on the current tip, connect to each other and end
+// at a checkpoint block. The blocks with these headers are then known to be
+// valid so their signatures and proofs are not verified when connected.
+// Any other block is fully validated, no matter its height.
+func (b *Blockchain) AddCheckpointHeaders(headers []*blocks.BlockHeader) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	b.stateLock.Lock()
+	defer b.stateLock.Unlock()
+
+	tip := b.index.Tip()
+	if types.NewID(headers[0].Parent) != tip.blockID || headers[0].Height != tip.height+1 {
+		return ruleError(ErrDoesNotConnect, "checkpoint headers do not connect to tip")
+	}
+	for i := 1; i < len(headers); i++ {
+		if types.NewID(headers[i].Parent) != headers[i-1].ID() || headers[i].Height != headers[i-1].Height+1 {
+			return ruleError(ErrDoesNotConnect, "checkpoint headers do not connect")
+		}
+	}
+	last := headers[len(headers)-1]
+	checkpoint, ok := b.CheckpointAtHeight(last.Height)
+	if !ok || checkpoint.BlockID != last.ID() {
+		return ruleError(ErrInvalidCheckpoint, "headers do not end at a checkpoint")
+	}
+	for _, header := range headers {
+		b.checkpointAncestors[header.ID()] = header.Height
+	}
+	return nil
+}
+
+// isCheckpointAncestor returns whether the block was proven to be an
+// ancestor of a checkpoint by AddCheckpointHeaders.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) isCheckpointAncestor(blockID types.ID) bool {
+	_, ok := b.checkpointAncestors[blockID]
+	return ok
+}
+
+// removeCheckpointAncestors is called when a block is connected. The block
+// is no longer tracked as an ancestor of a checkpoint. If the block is not
+// one of the ancestors the chain has moved past the ancestors at or below
+// its height, so they can never be connected and are dropped as well.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) removeCheckpointAncestors(header *blocks.BlockHeader) {
+	if len(b.checkpointAncestors) == 0 {
+		return
+	}
+	blockID := header.ID()
+	if _, ok := b.checkpointAncestors[blockID]; ok {
+		delete(b.checkpointAncestors, blockID)
+		return
+	}
+	for id, height := range b.checkpointAncestors {
+		if height <= header.Height {
+			delete(b.checkpointAncestors, id)
+		}
+	}
+}
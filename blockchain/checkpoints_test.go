@@ -0,0 +1,136 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestCheckpoints(t *testing.T) {
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	// The block has an invalid signature so it is only
+	// valid if signature verification is skipped.
+	genesisID := params.RegestParams.GenesisBlock.ID()
+	blk := &blocks.Block{
+		Header: &blocks.BlockHeader{
+			Version:   1,
+			Height:    1,
+			Parent:    genesisID[:],
+			Timestamp: params.RegestParams.GenesisBlock.Header.Timestamp + 1,
+		},
+		Transactions: []*transactions.Transaction{
+			transactions.WrapTransaction(&transactions.TreasuryTransaction{
+				Amount: 10000,
+				Outputs: []*transactions.Output{
+					{
+						Commitment: make([]byte, types.CommitmentLen),
+						Ciphertext: make([]byte, CiphertextLen),
+					},
+				},
+				ProposalHash: make([]byte, MaxDocumentHashLen),
+			}),
+		},
+	}
+	assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+	blk.Header.Signature[0] ^= 0xff
+
+	newChain := func(opts ...Option) *Blockchain {
+		b, err := NewBlockchain(append([]Option{DefaultOptions()}, opts...)...)
+		assert.NoError(t, err)
+		dbtx, err := b.ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err)
+		assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+		assert.NoError(t, dbtx.Commit(context.Background()))
+		return b
+	}
+
+	b := newChain()
+	assert.Error(t, b.ConnectBlock(blk, BFNone))
+
+	// Matching the checkpoint is not enough to skip verification.
+	b = newChain(Checkpoints([]params.Checkpoint{{BlockID: blk.ID(), Height: 1}}))
+	assert.Error(t, b.ConnectBlock(blk, BFNone))
+
+	// Once the headers prove the block leads up to the checkpoint
+	// its signature is no longer verified.
+	assert.NoError(t, b.AddCheckpointHeaders([]*blocks.BlockHeader{blk.Header}))
+	assert.Len(t, b.checkpointAncestors, 1)
+	assert.NoError(t, b.ConnectBlock(blk, BFNone))
+	assert.Empty(t, b.checkpointAncestors)
+
+	// A forged block below the checkpoint which was not proven to be
+	// one of its ancestors is fully validated and rejected.
+	b = newChain(Checkpoints([]params.Checkpoint{{BlockID: types.ID{0x01}, Height: 5}}))
+	assert.Error(t, b.CheckConnectBlock(blk))
+	assert.Error(t, b.ConnectBlock(blk, BFNone))
+
+	// Headers which do not end at a checkpoint prove nothing.
+	err = b.AddCheckpointHeaders([]*blocks.BlockHeader{blk.Header})
+	assert.True(t, ErrorIs(err, ErrInvalidCheckpoint))
+	assert.Error(t, b.ConnectBlock(blk, BFNone))
+
+	// Nor do headers which do not build on the tip.
+	orphan := proto.Clone(blk.Header).(*blocks.BlockHeader)
+	orphan.Parent = make([]byte, 32)
+	b = newChain(Checkpoints([]params.Checkpoint{{BlockID: orphan.ID(), Height: 1}}))
+	err = b.AddCheckpointHeaders([]*blocks.BlockHeader{orphan})
+	assert.True(t, ErrorIs(err, ErrDoesNotConnect))
+
+	// Blocks conflicting with a checkpoint are rejected.
+	b = newChain(Checkpoints([]params.Checkpoint{{BlockID: types.ID{0x01}, Height: 1}}))
+	err = b.ConnectBlock(blk, BFNone)
+	assert.True(t, ErrorIs(err, ErrInvalidCheckpoint))
+
+	_, err = NewBlockchain(DefaultOptions(), Checkpoints([]params.Checkpoint{
+		{BlockID: types.ID{0x01}, Height: 1},
+		{BlockID: types.ID{0x02}, Height: 1},
+	}))
+	assert.Error(t, err)
+	// Duplicate checkpoints are merged and the result is sorted by height.
+	b = newChain(Checkpoints([]params.Checkpoint{
+		{BlockID: types.ID{0x02}, Height: 7},
+		{BlockID: types.ID{0x01}, Height: 5},
+		{BlockID: types.ID{0x02}, Height: 7},
+	}))
+	assert.Equal(t, []params.Checkpoint{
+		{BlockID: types.ID{0x01}, Height: 5},
+		{BlockID: types.ID{0x02}, Height: 7},
+	}, b.Checkpoints())
+	checkpoint, ok := b.CheckpointAtHeight(7)
+	assert.True(t, ok)
+	assert.Equal(t, types.ID{0x02}, checkpoint.BlockID)
+	_, ok = b.CheckpointAtHeight(6)
+	assert.False(t, ok)
+}
+
+func TestRemoveCheckpointAncestors(t *testing.T) {
+	b := &Blockchain{checkpointAncestors: map[types.ID]uint32{
+		{0x01}: 1,
+		{0x02}: 2,
+		{0x03}: 3,
+	}}
+
+	// Connecting an ancestor only removes that ancestor.
+	header := &blocks.BlockHeader{Height: 1, Timestamp: 1}
+	b.checkpointAncestors[header.ID()] = 1
+	b.removeCheckpointAncestors(header)
+	assert.Len(t, b.checkpointAncestors, 3)
+
+	// Any other block drops the ancestors the tip has passed.
+	b.removeCheckpointAncestors(&blocks.BlockHeader{Height: 2, Timestamp: 2})
+	assert.Equal(t, map[types.ID]uint32{{0x03}: 3}, b.checkpointAncestors)
+	b.removeCheckpointAncestors(&blocks.BlockHeader{Height: 3, Timestamp: 3})
+	assert.Empty(t, b.checkpointAncestors)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+)
+
+// circuitRegistry holds the verifiers of the circuit versions known to
+// the node. The heights at which each version is valid are set by the
+// CircuitVersions of the network params so that every node agrees on
+// which proofs are valid.
+var circuitRegistry = DefaultCircuitRegistry()
+
+// DefaultCircuitRegistry returns a registry containing every version of
+// each circuit known to the node. The standard circuit is registered
+// without a size limit so transactions with any number of inputs and
+// outputs are valid under consensus.
+func DefaultCircuitRegistry() *zk.CircuitRegistry {
+	registry := zk.NewCircuitRegistry()
+	for _, circuit := range []*zk.Circuit{
+		{
+			ID:       zk.StandardCircuitID,
+			Version:  1,
+			Verifier: standard.StandardCircuit,
+			Layout:   macros.DefaultParamLayout,
+		},
+		{
+			ID:       zk.StakeCircuitID,
+			Version:  1,
+			Verifier: stake.StakeCircuit,
+		},
+	} {
+		if err := registry.Register(circuit); err != nil {
+			panic(err)
+		}
+	}
+	return registry
+}
+
+// activeCircuits returns the versions of the circuit in the registry
+// which are valid at the height according to the network params, latest
+// first.
+func activeCircuits(registry *zk.CircuitRegistry, netParams *params.NetworkParams, id zk.CircuitID, height uint32) ([]*zk.Circuit, error) {
+	versions, err := registry.Versions(id)
+	if err != nil {
+		return nil, err
+	}
+	schedule := netParams.CircuitVersions[string(id)]
+	active := make([]*zk.Circuit, 0, len(versions))
+	for _, circuit := range versions {
+		if schedule.ValidateForHeight(circuit.Version, height) == nil {
+			active = append(active, circuit)
+		}
+	}
+	if len(active) == 0 {
+		return nil, ruleError(ErrInvalidProof, fmt.Sprintf("no version of the %s circuit is active at height %d", id, height))
+	}
+	return active, nil
+}
@@ -56,27 +56,35 @@ const (
 	ErrBlockSort
 	ErrRestakeTooEarly
 	ErrInvalidCheckpoint
+	ErrInvalidVersion
+	ErrInvalidVRFProof
+	ErrInvalidProof
+	ErrInvalidSnapshotCommitment
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
 var errorCodeStrings = map[ErrorCode]string{
-	ErrDuplicateBlock:         "ErrDuplicateBlock",
-	ErrInvalidProducer:        "ErrInvalidProducer",
-	ErrDoesNotConnect:         "ErrDoesNotConnect",
-	ErrInvalidHeight:          "ErrInvalidHeight",
-	ErrInvalidTimestamp:       "ErrInvalidTimestamp",
-	ErrInvalidHeaderSignature: "ErrInvalidHeaderSignature",
-	ErrEmptyBlock:             "ErrEmptyBlock",
-	ErrInvalidTxRoot:          "ErrInvalidTxRoot",
-	ErrDoubleSpend:            "ErrDoubleSpend",
-	ErrDuplicateCoinbase:      "ErrDuplicateCoinbase",
-	ErrBlockStakeSpend:        "ErrBlockStakeSpend",
-	ErrInvalidTx:              "ErrInvalidTx",
-	ErrInvalidGenesis:         "ErrInvalidGenesis",
-	ErrUnknownTxEnum:          "ErrUnknownTxEnum",
-	ErrBlockSort:              "ErrBlockSort",
-	ErrRestakeTooEarly:        "ErrRestakeTooEarly",
-	ErrInvalidCheckpoint:      "ErrInvalidCheckpoint",
+	ErrDuplicateBlock:            "ErrDuplicateBlock",
+	ErrInvalidProducer:           "ErrInvalidProducer",
+	ErrDoesNotConnect:            "ErrDoesNotConnect",
+	ErrInvalidHeight:             "ErrInvalidHeight",
+	ErrInvalidTimestamp:          "ErrInvalidTimestamp",
+	ErrInvalidHeaderSignature:    "ErrInvalidHeaderSignature",
+	ErrEmptyBlock:                "ErrEmptyBlock",
+	ErrInvalidTxRoot:             "ErrInvalidTxRoot",
+	ErrDoubleSpend:               "ErrDoubleSpend",
+	ErrDuplicateCoinbase:         "ErrDuplicateCoinbase",
+	ErrBlockStakeSpend:           "ErrBlockStakeSpend",
+	ErrInvalidTx:                 "ErrInvalidTx",
+	ErrInvalidGenesis:            "ErrInvalidGenesis",
+	ErrUnknownTxEnum:             "ErrUnknownTxEnum",
+	ErrBlockSort:                 "ErrBlockSort",
+	ErrRestakeTooEarly:           "ErrRestakeTooEarly",
+	ErrInvalidCheckpoint:         "ErrInvalidCheckpoint",
+	ErrInvalidVersion:            "ErrInvalidVersion",
+	ErrInvalidVRFProof:           "ErrInvalidVRFProof",
+	ErrInvalidProof:              "ErrInvalidProof",
+	ErrInvalidSnapshotCommitment: "ErrInvalidSnapshotCommitment",
 }
 
 // String returns the ErrorCode as a human-readable name.
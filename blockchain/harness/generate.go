@@ -63,10 +63,18 @@ func (h *TestHarness) generateBlocks(nBlocks int) ([]*blocks.Block, map[types.Nu
 
 			toDelete = append(toDelete, inNullifier)
 
-			var (
-				outputs     = make([]*transactions.Output, 0, outputsPerTx)
-				outputNotes = make([]*SpendableNote, 0, outputsPerTx)
-			)
+			builder := transactions.NewTxBuilder().
+				SetTxoRoot(acc.Root()).
+				SetFee(types.Amount(fee)).
+				AddInput(types.SpendNote{
+					Amount:  sn.Note.Amount,
+					Salt:    sn.Note.Salt,
+					AssetID: sn.Note.AssetID,
+					State:   types.State{},
+				}, *sn.LockingScript, inclusionProof.Index, standard.InclusionProof{
+					Hashes: inclusionProof.Hashes,
+					Flags:  inclusionProof.Flags,
+				})
 
 			for x := 0; x < outputsPerTx; x++ {
 				nCommitments++
@@ -98,18 +106,6 @@ func (h *TestHarness) generateBlocks(nBlocks int) ([]*blocks.Block, map[types.Nu
 					Salt:       salt,
 					State:      types.State{},
 				}
-				outputNotes = append(outputNotes, &SpendableNote{
-					Note:             outputNote,
-					PrivateKey:       privKey,
-					LockingScript:    lockingScript,
-					cachedScriptHash: scriptHash,
-				})
-
-				outputCommitment, err := outputNote.Commitment()
-				if err != nil {
-					return nil, nil, err
-				}
-
 				outNullifier, err := types.CalculateNullifier(nCommitments-1, outputNote.Salt, lockingScript.ScriptCommitment.Bytes(), lockingScript.LockingParams...)
 				if err != nil {
 					return nil, nil, err
@@ -121,78 +117,16 @@ func (h *TestHarness) generateBlocks(nBlocks int) ([]*blocks.Block, map[types.Nu
 					PrivateKey:    privKey,
 				}
 
-				outputs = append(outputs, &transactions.Output{
-					Commitment: outputCommitment[:],
-					Ciphertext: make([]byte, blockchain.CiphertextLen),
-				})
+				builder.AddOutput(*outputNote, make([]byte, blockchain.CiphertextLen))
 			}
-			standardTx := &transactions.StandardTransaction{
-				Outputs:    outputs,
-				Fee:        1,
-				Nullifiers: [][]byte{inNullifier.Bytes()},
-				TxoRoot:    acc.Root().Bytes(),
-				Proof:      nil,
-			}
-
-			sigHash, err := standardTx.SigHash()
+			standardTx, privateParams, publicPrams, err := builder.Build()
 			if err != nil {
 				return nil, nil, err
 			}
 
 			mockUnlockingSig := make([]byte, 32)
 			rand.Read(mockUnlockingSig)
-
-			privateParams := &standard.PrivateParams{
-				Inputs: []standard.PrivateInput{
-					{
-						SpendNote: types.SpendNote{
-							Amount:  sn.Note.Amount,
-							Salt:    sn.Note.Salt,
-							AssetID: sn.Note.AssetID,
-							State:   types.State{},
-						},
-						CommitmentIndex: inclusionProof.Index,
-						InclusionProof: standard.InclusionProof{
-							Hashes: inclusionProof.Hashes,
-							Flags:  inclusionProof.Flags,
-						},
-						ScriptCommitment: sn.LockingScript.ScriptCommitment.Bytes(),
-						ScriptParams:     sn.LockingScript.LockingParams,
-						UnlockingParams:  mockUnlockingSig,
-					},
-				},
-			}
-			for _, outNote := range outputNotes {
-				privateParams.Outputs = append(privateParams.Outputs, standard.PrivateOutput{
-					SpendNote: types.SpendNote{
-						State:      types.State{},
-						Amount:     outNote.Note.Amount,
-						Salt:       outNote.Note.Salt,
-						AssetID:    outNote.Note.AssetID,
-						ScriptHash: outNote.cachedScriptHash,
-					},
-				})
-			}
-
-			publicOutputs := make([]standard.PublicOutput, len(outputNotes))
-			for i, output := range outputs {
-				publicOutputs[i] = standard.PublicOutput{
-					Commitment: output.Commitment,
-					CipherText: output.Ciphertext,
-				}
-			}
-
-			publicPrams := &standard.PublicParams{
-				TXORoot:    acc.Root().Bytes(),
-				SigHash:    sigHash,
-				Outputs:    publicOutputs,
-				Nullifiers: [][]byte{inNullifier.Bytes()},
-				Fee:        fee,
-				Coinbase:   0,
-				MintID:     nil,
-				MintAmount: 0,
-				Locktime:   time.Time{},
-			}
+			privateParams.Inputs[0].UnlockingParams = mockUnlockingSig
 
 			_, err = zk.CreateSnark(standard.StandardCircuit, privateParams, publicPrams)
 			if err != nil {
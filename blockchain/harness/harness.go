@@ -19,10 +19,9 @@ import (
 )
 
 type SpendableNote struct {
-	Note             *types.SpendNote
-	LockingScript    *types.LockingScript
-	PrivateKey       crypto.PrivKey
-	cachedScriptHash types.ID
+	Note          *types.SpendNote
+	LockingScript *types.LockingScript
+	PrivateKey    crypto.PrivKey
 }
 
 type validator struct {
@@ -300,12 +299,10 @@ func (h *TestHarness) Clone() (*TestHarness, error) {
 		return nil, err
 	}
 	_, bestH, _ := h.chain.BestBlock()
-	for i := uint32(1); i <= bestH; i++ {
-		blk, err := h.chain.GetBlockByHeight(i)
-		if err != nil {
-			return nil, err
-		}
-		err = chain.ConnectBlock(blk, blockchain.BFFastAdd)
+	if bestH > 0 {
+		err = h.chain.IterateBlocks(1, bestH, func(blk *blocks.Block) error {
+			return chain.ConnectBlock(blk, blockchain.BFFastAdd)
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -9,6 +9,9 @@ import (
 	"github.com/project-illium/ilxd/types/blocks"
 )
 
+// IndexManager maintains the indexes of the chain. The getBlock function
+// passed into Init may be used in the background to catch up indexes which
+// are behind the tip of the chain.
 type IndexManager interface {
 	Init(tipHeight uint32, getBlock func(height uint32) (*blocks.Block, error)) error
 	ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error
@@ -27,6 +27,12 @@ type Indexer interface {
 	// each block or, if the index is doing some caching, on Close().
 	ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error
 
+	// DisconnectBlock is called when the block at the tip of the chain
+	// is disconnected during a reorganization. The indexer must remove
+	// anything it stored for the block and set the height of the index
+	// to the block's parent. The database transaction must be respected.
+	DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error
+
 	// Close is called when the index manager shuts down and gives the indexer
 	// an opportunity to do some cleanup.
 	Close(ds repo.Datastore) error
@@ -9,5 +9,5 @@ import "go.uber.org/zap"
 var log = zap.S()
 
 func UpdateLogger() {
-	log = zap.S()
+	log = zap.S().Named("indexers")
 }
@@ -7,67 +7,129 @@ package indexers
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	datastore "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types/blocks"
+	"sync"
+	"time"
 )
 
+// catchUpBatchSize is the number of blocks an indexer that is catching
+// up connects in each database transaction. The indexer height is
+// committed with each batch so catch up resumes from the last batch
+// if the node is restarted.
+const catchUpBatchSize = 500
+
+// catchUpRetryInterval is how long to wait before retrying after an
+// error loading blocks during catch up.
+const catchUpRetryInterval = time.Second * 10
+
+// errBatchDisconnected is returned when a block is disconnected from the
+// chain while an indexer is catching up.
+var errBatchDisconnected = errors.New("block disconnected during catch up batch")
+
+// IndexProgress reports the progress of an indexer catching up to the
+// tip of the chain.
+type IndexProgress struct {
+	// Name is the human-readable name of the index.
+	Name string
+	// Height is the height the index has been built to.
+	Height uint32
+	// TipHeight is the height of the chain tip.
+	TipHeight uint32
+	// Current is true once the index has caught up to the tip.
+	Current bool
+}
+
 // IndexManager maintains the blockchain indexes and ensures they are current
 // with the blockchain.
 type IndexManager struct {
-	indexers []Indexer
-	ds       repo.Datastore
+	indexers      []Indexer
+	ds            repo.Datastore
+	catchingUp    map[string]bool
+	tipHeight     uint32
+	disconnects   uint64
+	subscriptions []func(*IndexProgress)
+	mtx           sync.Mutex
+	wg            sync.WaitGroup
+	quit          chan struct{}
 }
 
 // NewIndexManager returns a new IndexManager.
 func NewIndexManager(ds repo.Datastore, indexers []Indexer) *IndexManager {
 	return &IndexManager{
-		indexers: indexers,
-		ds:       ds,
+		indexers:   indexers,
+		ds:         ds,
+		catchingUp: make(map[string]bool),
+		mtx:        sync.Mutex{},
+		wg:         sync.WaitGroup{},
+		quit:       make(chan struct{}),
 	}
 }
 
 // Init iterates over each indexer and checks to see if the indexer height is
-// the same height as the tip of the chain. If not, it will roll the index
-// forward until it is current.
+// the same height as the tip of the chain. If not, the indexer is rolled
+// forward in the background until it is current. Blocks connected in the
+// meantime are not passed to the indexer until it has caught up.
+//
+// getBlock may be called from another goroutine after Init returns.
 func (im *IndexManager) Init(tipHeight uint32, getBlock func(height uint32) (*blocks.Block, error)) error {
-	dbtx, err := im.ds.NewTransaction(context.Background(), false)
+	dbtx, err := im.ds.NewTransaction(context.Background(), true)
 	if err != nil {
 		return err
 	}
+	defer dbtx.Discard(context.Background())
+
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+
+	im.tipHeight = tipHeight
 	for _, indexer := range im.indexers {
 		height, err := dsFetchIndexHeight(dbtx, indexer)
-		if err == datastore.ErrNotFound { // New index
-			genesis, err := getBlock(0)
-			if err != nil {
-				return err
-			}
-			if err := indexer.ConnectBlock(dbtx, genesis); err != nil {
-				return err
-			}
-		} else if err != nil {
+		isNew := err == datastore.ErrNotFound
+		if err != nil && !isNew {
 			return err
 		}
-
-		if height < tipHeight {
-			for n := height + 1; n <= tipHeight; n++ {
-				blk, err := getBlock(n)
-				if err != nil {
-					return err
-				}
-				if err := indexer.ConnectBlock(dbtx, blk); err != nil {
-					return err
-				}
-			}
+		if !isNew && height >= tipHeight {
+			continue
 		}
+		im.catchingUp[indexer.Key()] = true
+		im.wg.Add(1)
+		go im.catchUp(indexer, height, isNew, getBlock)
 	}
-	return dbtx.Commit(context.Background())
+	return nil
+}
+
+// SubscribeProgress registers a callback which is called with the progress
+// of each indexer that is catching up to the tip of the chain.
+func (im *IndexManager) SubscribeProgress(callback func(*IndexProgress)) {
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+
+	im.subscriptions = append(im.subscriptions, callback)
 }
 
-// ConnectBlock connects the block to each indexer.
+// IsCurrent returns whether the indexer with the given key has caught up
+// to the tip of the chain.
+func (im *IndexManager) IsCurrent(key string) bool {
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+
+	return !im.catchingUp[key]
+}
+
+// ConnectBlock connects the block to each indexer that is current.
 func (im *IndexManager) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+
+	im.tipHeight = blk.Header.Height
 	for _, indexer := range im.indexers {
+		if im.catchingUp[indexer.Key()] {
+			continue
+		}
 		if err := indexer.ConnectBlock(dbtx, blk); err != nil {
 			return err
 		}
@@ -75,8 +137,39 @@ func (im *IndexManager) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) erro
 	return nil
 }
 
+// DisconnectBlock disconnects the block from each indexer which has
+// indexed it. Indexers which are catching up restart their current
+// batch so that they do not commit blocks from the disconnected branch.
+func (im *IndexManager) DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+
+	for _, indexer := range im.indexers {
+		if im.catchingUp[indexer.Key()] {
+			height, err := dsFetchIndexHeight(dbtx, indexer)
+			if errors.Is(err, datastore.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			if height < blk.Header.Height {
+				continue
+			}
+		}
+		if err := indexer.DisconnectBlock(dbtx, blk); err != nil {
+			return err
+		}
+	}
+	im.tipHeight = blk.Header.Height - 1
+	im.disconnects++
+	return nil
+}
+
 // Close shuts down all the indexers.
 func (im *IndexManager) Close() error {
+	close(im.quit)
+	im.wg.Wait()
+
 	for _, indexer := range im.indexers {
 		if err := indexer.Close(im.ds); err != nil {
 			return err
@@ -85,6 +178,143 @@ func (im *IndexManager) Close() error {
 	return nil
 }
 
+// catchUp connects blocks to the indexer, starting after height, until
+// it reaches the tip of the chain. If isNew is true the indexer has no
+// height and is started from genesis.
+func (im *IndexManager) catchUp(indexer Indexer, height uint32, isNew bool, getBlock func(height uint32) (*blocks.Block, error)) {
+	defer im.wg.Done()
+
+	next := height + 1
+	if isNew {
+		next = 0
+	}
+	im.mtx.Lock()
+	disconnects := im.disconnects
+	im.mtx.Unlock()
+
+	log.Infof("Building %s from height %d", indexer.Name(), next)
+	for {
+		select {
+		case <-im.quit:
+			return
+		default:
+		}
+
+		// The indexer is marked current while holding the lock
+		// so that the next call to ConnectBlock passes the next
+		// block to the indexer.
+		im.mtx.Lock()
+		if im.disconnects != disconnects {
+			// Blocks the indexer has already built may have
+			// been disconnected so resume from its height.
+			h, err := im.fetchIndexHeight(indexer)
+			if err != nil {
+				im.mtx.Unlock()
+				log.Errorf("Error building %s: %s", indexer.Name(), err)
+				return
+			}
+			next = h
+			disconnects = im.disconnects
+		}
+		tipHeight := im.tipHeight
+		if next > tipHeight {
+			delete(im.catchingUp, indexer.Key())
+			im.notifyProgress(&IndexProgress{
+				Name:      indexer.Name(),
+				Height:    next - 1,
+				TipHeight: tipHeight,
+				Current:   true,
+			})
+			im.mtx.Unlock()
+			log.Infof("Finished building %s", indexer.Name())
+			return
+		}
+		im.mtx.Unlock()
+
+		end := next + catchUpBatchSize - 1
+		if end > tipHeight {
+			end = tipHeight
+		}
+		if err := im.connectBlocks(indexer, next, end, disconnects, getBlock); errors.Is(err, errBatchDisconnected) {
+			continue
+		} else if err != nil {
+			log.Errorf("Error building %s: %s", indexer.Name(), err)
+			select {
+			case <-im.quit:
+				return
+			case <-time.After(catchUpRetryInterval):
+				continue
+			}
+		}
+		next = end + 1
+
+		im.mtx.Lock()
+		im.notifyProgress(&IndexProgress{
+			Name:      indexer.Name(),
+			Height:    end,
+			TipHeight: im.tipHeight,
+		})
+		im.mtx.Unlock()
+		log.Infof("Building %s: height %d of %d", indexer.Name(), end, tipHeight)
+	}
+}
+
+// connectBlocks connects the blocks from start to end, inclusive, to the
+// indexer in a single database transaction. If a block has been
+// disconnected from the chain since disconnects was read the batch is
+// discarded and errBatchDisconnected is returned.
+func (im *IndexManager) connectBlocks(indexer Indexer, start, end uint32, disconnects uint64, getBlock func(height uint32) (*blocks.Block, error)) error {
+	dbtx, err := im.ds.NewTransaction(context.Background(), false)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Discard(context.Background())
+
+	for n := start; n <= end; n++ {
+		blk, err := getBlock(n)
+		if err != nil {
+			return err
+		}
+		if err := indexer.ConnectBlock(dbtx, blk); err != nil {
+			return err
+		}
+	}
+
+	im.mtx.Lock()
+	defer im.mtx.Unlock()
+	if im.disconnects != disconnects {
+		return errBatchDisconnected
+	}
+	return dbtx.Commit(context.Background())
+}
+
+// fetchIndexHeight returns the height the indexer should resume
+// building from.
+func (im *IndexManager) fetchIndexHeight(indexer Indexer) (uint32, error) {
+	dbtx, err := im.ds.NewTransaction(context.Background(), true)
+	if err != nil {
+		return 0, err
+	}
+	defer dbtx.Discard(context.Background())
+
+	height, err := dsFetchIndexHeight(dbtx, indexer)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return height + 1, nil
+}
+
+// notifyProgress sends the progress to the subscribers.
+//
+// The caller must hold the mtx.
+func (im *IndexManager) notifyProgress(progress *IndexProgress) {
+	for _, callback := range im.subscriptions {
+		go callback(progress)
+	}
+}
+
 func dsPutIndexerHeight(dbtx datastore.Txn, indexer Indexer, height uint32) error {
 	heightBytes := make([]byte, 4)
 	binary.BigEndian.PutUint32(heightBytes, height)
@@ -0,0 +1,102 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestIndexManagerCatchUp(t *testing.T) {
+	ds := mock.NewMapDatastore()
+
+	makeBlock := func(height uint32) *blocks.Block {
+		return &blocks.Block{
+			Header: &blocks.BlockHeader{Height: height},
+			Transactions: []*transactions.Transaction{
+				transactions.WrapTransaction(&transactions.StandardTransaction{
+					Fee: uint64(height),
+				}),
+			},
+		}
+	}
+	getBlock := func(height uint32) (*blocks.Block, error) {
+		return makeBlock(height), nil
+	}
+
+	// Simulate an index which was interrupted part way through
+	// catching up.
+	txIndex := NewTxIndex()
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsPutIndexerHeight(dbtx, txIndex, 100))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	tipHeight := uint32(catchUpBatchSize*2 + 10)
+	im := NewIndexManager(ds, []Indexer{txIndex})
+	progress := make(chan *IndexProgress, 10)
+	im.SubscribeProgress(func(p *IndexProgress) {
+		progress <- p
+	})
+	assert.NoError(t, im.Init(tipHeight, getBlock))
+
+	var last *IndexProgress
+	for last == nil || !last.Current {
+		select {
+		case last = <-progress:
+			assert.Equal(t, TxIndexName, last.Name)
+		case <-time.After(time.Second * 10):
+			t.Fatal("timed out waiting for index to catch up")
+		}
+	}
+	assert.Equal(t, tipHeight, last.Height)
+	assert.True(t, im.IsCurrent(txIndex.Key()))
+
+	// Blocks before the interruption are not indexed.
+	_, err = txIndex.GetContainingBlockID(ds, makeBlock(100).Transactions[0].ID())
+	assert.Error(t, err)
+	_, err = txIndex.GetContainingBlockID(ds, makeBlock(101).Transactions[0].ID())
+	assert.NoError(t, err)
+	_, err = txIndex.GetContainingBlockID(ds, makeBlock(tipHeight).Transactions[0].ID())
+	assert.NoError(t, err)
+
+	// Once current, new blocks are indexed by ConnectBlock.
+	blk := makeBlock(tipHeight + 1)
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, im.ConnectBlock(dbtx, blk))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	_, err = txIndex.GetContainingBlockID(ds, blk.Transactions[0].ID())
+	assert.NoError(t, err)
+
+	dbtx, err = ds.NewTransaction(context.Background(), true)
+	assert.NoError(t, err)
+	height, err := dsFetchIndexHeight(dbtx, txIndex)
+	assert.NoError(t, err)
+	assert.Equal(t, tipHeight+1, height)
+	dbtx.Discard(context.Background())
+
+	// Disconnecting the block removes it from the index.
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, im.DisconnectBlock(dbtx, blk))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	_, err = txIndex.GetContainingBlockID(ds, blk.Transactions[0].ID())
+	assert.Error(t, err)
+
+	dbtx, err = ds.NewTransaction(context.Background(), true)
+	assert.NoError(t, err)
+	height, err = dsFetchIndexHeight(dbtx, txIndex)
+	assert.NoError(t, err)
+	assert.Equal(t, tipHeight, height)
+	dbtx.Discard(context.Background())
+
+	assert.NoError(t, im.Close())
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"sync"
+
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/walletlib"
+)
+
+type scanBatch struct {
+	keys    []*icrypto.Curve25519PrivateKey
+	outputs []*transactions.Output
+	results chan<- []*walletlib.ScanMatch
+}
+
+// outputScanner is a long-lived pool of workers which attempt to decrypt
+// output ciphertexts using a set of view keys. Outputs are handed to the
+// workers in batches rather than one at a time to cut down on the channel
+// overhead when scanning blocks with a large number of outputs.
+type outputScanner struct {
+	batchSize int
+	workChan  chan *scanBatch
+	quit      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// newOutputScanner starts the worker pool and returns a new outputScanner.
+func newOutputScanner(workers, batchSize int) *outputScanner {
+	if workers <= 0 {
+		workers = 1
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	s := &outputScanner{
+		batchSize: batchSize,
+		workChan:  make(chan *scanBatch),
+		quit:      make(chan struct{}),
+		wg:        sync.WaitGroup{},
+	}
+	s.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go s.worker()
+	}
+	return s
+}
+
+// Scan attempts to decrypt each output with each of the keys and returns
+// a map of the outputs which decrypted successfully.
+func (s *outputScanner) Scan(keys []*icrypto.Curve25519PrivateKey, outputs []*transactions.Output) map[types.ID]*walletlib.ScanMatch {
+	ret := make(map[types.ID]*walletlib.ScanMatch)
+	if len(keys) == 0 || len(outputs) == 0 {
+		return ret
+	}
+
+	nBatches := (len(outputs) + s.batchSize - 1) / s.batchSize
+	results := make(chan []*walletlib.ScanMatch, nBatches)
+	sent := 0
+	for i := 0; i < len(outputs); i += s.batchSize {
+		end := i + s.batchSize
+		if end > len(outputs) {
+			end = len(outputs)
+		}
+		select {
+		case s.workChan <- &scanBatch{keys: keys, outputs: outputs[i:end], results: results}:
+			sent++
+		case <-s.quit:
+			return ret
+		}
+	}
+	for i := 0; i < sent; i++ {
+		for _, match := range <-results {
+			ret[match.Commitment] = match
+		}
+	}
+	return ret
+}
+
+// Close shuts down the workers.
+func (s *outputScanner) Close() {
+	close(s.quit)
+	s.wg.Wait()
+}
+
+func (s *outputScanner) worker() {
+	defer s.wg.Done()
+	for {
+		select {
+		case batch := <-s.workChan:
+			var matches []*walletlib.ScanMatch
+			for _, out := range batch.outputs {
+				for _, k := range batch.keys {
+					decrypted, err := k.Decrypt(out.Ciphertext)
+					if err == nil {
+						matches = append(matches, &walletlib.ScanMatch{
+							Key:           k,
+							Commitment:    types.NewID(out.Commitment),
+							DecryptedNote: decrypted,
+						})
+						break
+					}
+				}
+			}
+			batch.results <- matches
+		case <-s.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"crypto/rand"
+	"testing"
+
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputScanner(t *testing.T) {
+	scanner := newOutputScanner(3, 4)
+	defer scanner.Close()
+
+	var keys []*icrypto.Curve25519PrivateKey
+	for i := 0; i < 2; i++ {
+		priv, _, err := icrypto.GenerateCurve25519Key(rand.Reader)
+		assert.NoError(t, err)
+		keys = append(keys, priv.(*icrypto.Curve25519PrivateKey))
+	}
+	otherKey, _, err := icrypto.GenerateCurve25519Key(rand.Reader)
+	assert.NoError(t, err)
+
+	var (
+		outputs  []*transactions.Output
+		expected = make(map[types.ID]*icrypto.Curve25519PrivateKey)
+	)
+	for i := 0; i < 25; i++ {
+		var (
+			commitment types.ID
+			key        = otherKey.(*icrypto.Curve25519PrivateKey)
+		)
+		rand.Read(commitment[:])
+		if i%5 == 0 {
+			key = keys[i%2]
+			expected[commitment] = key
+		}
+		ciphertext, err := key.GetPublic().(*icrypto.Curve25519PublicKey).Encrypt(commitment[:])
+		assert.NoError(t, err)
+		outputs = append(outputs, &transactions.Output{
+			Commitment: commitment[:],
+			Ciphertext: ciphertext,
+		})
+	}
+
+	matches := scanner.Scan(keys, outputs)
+	assert.Len(t, matches, len(expected))
+	for commitment, key := range expected {
+		match, ok := matches[commitment]
+		assert.True(t, ok)
+		assert.True(t, match.Key.Equals(key))
+		assert.Equal(t, commitment[:], match.DecryptedNote)
+	}
+
+	assert.Len(t, scanner.Scan(nil, outputs), 0)
+	assert.Len(t, scanner.Scan(keys, nil), 0)
+}
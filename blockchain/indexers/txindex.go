@@ -65,6 +65,17 @@ func (idx *TxIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
 	return nil
 }
 
+// DisconnectBlock is called when a block is disconnected from the chain.
+// The transactions in the block are removed from the index.
+func (idx *TxIndex) DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	for _, tx := range blk.Transactions {
+		if err := dsDeleteIndexValue(dbtx, idx, tx.ID().String()); err != nil {
+			return err
+		}
+	}
+	return dsPutIndexerHeight(dbtx, idx, blk.Header.Height-1)
+}
+
 // GetTransaction looks up the block id and position in the transaction index then fetches the
 // transaction from the db and returns it.
 func (idx *TxIndex) GetTransaction(ds repo.Datastore, txid types.ID) (*transactions.Transaction, error) {
@@ -101,6 +112,60 @@ func (idx *TxIndex) GetContainingBlockID(ds repo.Datastore, txid types.ID) (type
 	return types.NewID(valueBytes[4:]), nil
 }
 
+// IndexedTransaction is a transaction along with the ID of the
+// block containing it.
+type IndexedTransaction struct {
+	BlockID types.ID
+	Tx      *transactions.Transaction
+}
+
+// GetTransactions looks up many transactions at once. Each block's
+// transactions are loaded from the db only once no matter how many of
+// the transactions it contains. Transactions that are not in the index
+// are omitted from the returned map.
+func (idx *TxIndex) GetTransactions(ds repo.Datastore, txids []types.ID) (map[types.ID]*IndexedTransaction, error) {
+	dbtx, err := ds.NewTransaction(context.Background(), true)
+	if err != nil {
+		return nil, err
+	}
+	defer dbtx.Discard(context.Background())
+
+	blockTxs := make(map[types.ID][]*transactions.Transaction)
+	ret := make(map[types.ID]*IndexedTransaction, len(txids))
+	for _, txid := range txids {
+		valueBytes, err := dsFetchIndexValueWithTx(dbtx, idx, txid.String())
+		if errors.Is(err, datastore.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		pos := binary.BigEndian.Uint32(valueBytes[:4])
+		blockID := types.NewID(valueBytes[4:])
+
+		txs, ok := blockTxs[blockID]
+		if !ok {
+			ser, err := dbtx.Get(context.Background(), datastore.NewKey(repo.BlockTxsKeyPrefix+blockID.String()))
+			if err != nil {
+				return nil, err
+			}
+			var dsTxs pb.DBTxs
+			if err := proto.Unmarshal(ser, &dsTxs); err != nil {
+				return nil, err
+			}
+			txs = dsTxs.Transactions
+			blockTxs[blockID] = txs
+		}
+		if int(pos) > len(txs)-1 {
+			return nil, errors.New("tx index position out of range")
+		}
+		ret[txid] = &IndexedTransaction{
+			BlockID: blockID,
+			Tx:      txs[pos],
+		}
+	}
+	return ret, nil
+}
+
 func (idx *TxIndex) Close(ds repo.Datastore) error {
 	return nil
 }
@@ -0,0 +1,77 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/project-illium/ilxd/blockchain/pb"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestTxIndex_GetTransactions(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	idx := NewTxIndex()
+
+	connect := func(height uint32, txs ...*transactions.Transaction) *blocks.Block {
+		blk := &blocks.Block{
+			Header:       &blocks.BlockHeader{Height: height},
+			Transactions: txs,
+		}
+		ser, err := proto.Marshal(&pb.DBTxs{Transactions: txs})
+		assert.NoError(t, err)
+
+		dbtx, err := ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err)
+		assert.NoError(t, dbtx.Put(context.Background(), datastore.NewKey(repo.BlockTxsKeyPrefix+blk.ID().String()), ser))
+		assert.NoError(t, idx.ConnectBlock(dbtx, blk))
+		assert.NoError(t, dbtx.Commit(context.Background()))
+		return blk
+	}
+
+	tx1 := transactions.WrapTransaction(&transactions.StandardTransaction{Fee: 1})
+	tx2 := transactions.WrapTransaction(&transactions.StandardTransaction{Fee: 2})
+	tx3 := transactions.WrapTransaction(&transactions.StandardTransaction{Fee: 3})
+	blk1 := connect(1, tx1, tx2)
+	blk2 := connect(2, tx3)
+
+	missing := types.ID{0xff}
+	ret, err := idx.GetTransactions(ds, []types.ID{tx1.ID(), tx2.ID(), tx3.ID(), missing})
+	assert.NoError(t, err)
+	assert.Len(t, ret, 3)
+
+	assert.Equal(t, blk1.ID(), ret[tx1.ID()].BlockID)
+	assert.Equal(t, tx1.ID(), ret[tx1.ID()].Tx.ID())
+	assert.Equal(t, blk1.ID(), ret[tx2.ID()].BlockID)
+	assert.Equal(t, tx2.ID(), ret[tx2.ID()].Tx.ID())
+	assert.Equal(t, blk2.ID(), ret[tx3.ID()].BlockID)
+	assert.Equal(t, tx3.ID(), ret[tx3.ID()].Tx.ID())
+	assert.NotContains(t, ret, missing)
+
+	// Disconnected transactions are removed from the index.
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, idx.DisconnectBlock(dbtx, blk2))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	ret, err = idx.GetTransactions(ds, []types.ID{tx1.ID(), tx3.ID()})
+	assert.NoError(t, err)
+	assert.Len(t, ret, 1)
+	assert.Contains(t, ret, tx1.ID())
+
+	dbtx, err = ds.NewTransaction(context.Background(), true)
+	assert.NoError(t, err)
+	height, err := dsFetchIndexHeight(dbtx, idx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), height)
+	dbtx.Discard(context.Background())
+}
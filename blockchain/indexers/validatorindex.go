@@ -0,0 +1,374 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var _ Indexer = (*ValidatorIndex)(nil)
+
+const (
+	validatorIndexKey  = "validatorindex"
+	ValidatorIndexName = "validator index"
+
+	validatorIndexStakePrefix = "stake/"
+	validatorIndexEventPrefix = "event/"
+
+	validatorEventLen = 1 + 4 + 32 + 8 + 32
+)
+
+// ValidatorEventType is the type of event recorded for a validator.
+type ValidatorEventType uint8
+
+const (
+	// ValidatorEventStake is recorded when coins are staked
+	// to the validator.
+	ValidatorEventStake ValidatorEventType = iota
+	// ValidatorEventRestake is recorded when an existing stake
+	// is restaked.
+	ValidatorEventRestake
+	// ValidatorEventUnstake is recorded when a staked nullifier
+	// is spent.
+	ValidatorEventUnstake
+	// ValidatorEventCoinbase is recorded when the validator
+	// claims its coinbase.
+	ValidatorEventCoinbase
+)
+
+// String returns the ValidatorEventType in human-readable form.
+func (t ValidatorEventType) String() string {
+	switch t {
+	case ValidatorEventStake:
+		return "stake"
+	case ValidatorEventRestake:
+		return "restake"
+	case ValidatorEventUnstake:
+		return "unstake"
+	case ValidatorEventCoinbase:
+		return "coinbase"
+	default:
+		return fmt.Sprintf("unknown validator event (%d)", int(t))
+	}
+}
+
+// ValidatorEvent is an entry in a validator's history.
+type ValidatorEvent struct {
+	Type   ValidatorEventType
+	Height uint32
+	Txid   types.ID
+	Amount types.Amount
+	// Nullifier is the staked nullifier. It is empty for
+	// coinbase events.
+	Nullifier types.Nullifier
+}
+
+func (e *ValidatorEvent) serialize() []byte {
+	ser := make([]byte, validatorEventLen)
+	ser[0] = byte(e.Type)
+	binary.BigEndian.PutUint32(ser[1:5], e.Height)
+	copy(ser[5:37], e.Txid[:])
+	binary.BigEndian.PutUint64(ser[37:45], uint64(e.Amount))
+	copy(ser[45:], e.Nullifier[:])
+	return ser
+}
+
+func deserializeValidatorEvent(ser []byte) (*ValidatorEvent, error) {
+	if len(ser) != validatorEventLen {
+		return nil, errors.New("invalid validator event length")
+	}
+	return &ValidatorEvent{
+		Type:      ValidatorEventType(ser[0]),
+		Height:    binary.BigEndian.Uint32(ser[1:5]),
+		Txid:      types.NewID(ser[5:37]),
+		Amount:    types.Amount(binary.BigEndian.Uint64(ser[37:45])),
+		Nullifier: types.NewNullifier(ser[45:]),
+	}, nil
+}
+
+// ValidatorIndex is an implementation of the Indexer which records every
+// stake, unstake and coinbase event for each validator. This allows the
+// history of a validator to be looked up, for example, by staking
+// dashboards, without replaying the chain.
+//
+// The index tracks which validator each nullifier is staked to so that
+// spending the nullifier can be recorded as an unstake. Stakes which are
+// removed from the validator set by expiration or banning are not
+// recorded as unstake events.
+type ValidatorIndex struct{}
+
+// NewValidatorIndex returns a new ValidatorIndex.
+func NewValidatorIndex() *ValidatorIndex {
+	return &ValidatorIndex{}
+}
+
+// Key returns the key of the index as a string.
+func (idx *ValidatorIndex) Key() string {
+	return validatorIndexKey
+}
+
+// Name returns the human-readable name of the index.
+func (idx *ValidatorIndex) Name() string {
+	return ValidatorIndexName
+}
+
+// ConnectBlock is called when a block is connected to the chain.
+// The indexer can use this opportunity to parse it and store it in
+// the database. The database transaction must be respected.
+func (idx *ValidatorIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	n := 0
+	putEvent := func(validatorID peer.ID, event *ValidatorEvent) error {
+		key := fmt.Sprintf("%s%s/%010d/%06d", validatorIndexEventPrefix, validatorID.String(), event.Height, n)
+		n++
+		return dsPutIndexValue(dbtx, idx, key, event.serialize())
+	}
+	unstake := func(txid types.ID, nullifiers [][]byte) error {
+		for _, nb := range nullifiers {
+			nullifier := types.NewNullifier(nb)
+			val, err := dsFetchIndexValueWithTx(dbtx, idx, validatorIndexStakePrefix+nullifier.String())
+			if errors.Is(err, datastore.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			validatorID, amount, err := decodeValidatorStake(val)
+			if err != nil {
+				return err
+			}
+			if err := dsDeleteIndexValue(dbtx, idx, validatorIndexStakePrefix+nullifier.String()); err != nil {
+				return err
+			}
+			if err := putEvent(validatorID, &ValidatorEvent{
+				Type:      ValidatorEventUnstake,
+				Height:    blk.Header.Height,
+				Txid:      txid,
+				Amount:    amount,
+				Nullifier: nullifier,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, t := range blk.Transactions {
+		switch tx := t.GetTx().(type) {
+		case *transactions.Transaction_CoinbaseTransaction:
+			validatorID, err := peer.IDFromBytes(tx.CoinbaseTransaction.Validator_ID)
+			if err != nil {
+				return err
+			}
+			if err := putEvent(validatorID, &ValidatorEvent{
+				Type:   ValidatorEventCoinbase,
+				Height: blk.Header.Height,
+				Txid:   t.ID(),
+				Amount: types.Amount(tx.CoinbaseTransaction.NewCoins),
+			}); err != nil {
+				return err
+			}
+		case *transactions.Transaction_StakeTransaction:
+			validatorID, err := peer.IDFromBytes(tx.StakeTransaction.Validator_ID)
+			if err != nil {
+				return err
+			}
+			nullifier := types.NewNullifier(tx.StakeTransaction.Nullifier)
+			typ := ValidatorEventStake
+			_, err = dsFetchIndexValueWithTx(dbtx, idx, validatorIndexStakePrefix+nullifier.String())
+			if err == nil {
+				typ = ValidatorEventRestake
+			} else if !errors.Is(err, datastore.ErrNotFound) {
+				return err
+			}
+			if err := dsPutIndexValue(dbtx, idx, validatorIndexStakePrefix+nullifier.String(), encodeValidatorStake(validatorID, types.Amount(tx.StakeTransaction.Amount))); err != nil {
+				return err
+			}
+			if err := putEvent(validatorID, &ValidatorEvent{
+				Type:      typ,
+				Height:    blk.Header.Height,
+				Txid:      t.ID(),
+				Amount:    types.Amount(tx.StakeTransaction.Amount),
+				Nullifier: nullifier,
+			}); err != nil {
+				return err
+			}
+		case *transactions.Transaction_StandardTransaction:
+			if err := unstake(t.ID(), tx.StandardTransaction.Nullifiers); err != nil {
+				return err
+			}
+		case *transactions.Transaction_MintTransaction:
+			if err := unstake(t.ID(), tx.MintTransaction.Nullifiers); err != nil {
+				return err
+			}
+		}
+	}
+	return dsPutIndexerHeight(dbtx, idx, blk.Header.Height)
+}
+
+// DisconnectBlock is called when a block is disconnected from the chain.
+// The events recorded for the block are removed and the staked nullifiers
+// are returned to the state they were in prior to the block.
+//
+// The events are not indexed by height so this iterates over every event
+// in the index. Blocks are only disconnected during a reorganization so
+// this is expected to be rare.
+func (idx *ValidatorIndex) DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	type keyedEvent struct {
+		key         string
+		validatorID peer.ID
+		n           int
+		event       *ValidatorEvent
+	}
+	results, err := dsPrefixQueryIndexValue(dbtx, idx, validatorIndexEventPrefix)
+	if err != nil {
+		return err
+	}
+	var (
+		toRemove []*keyedEvent
+		// lastStake holds the last stake or restake event for each
+		// nullifier prior to the block.
+		lastStake = make(map[types.Nullifier]*keyedEvent)
+	)
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			results.Close()
+			return result.Error
+		}
+		parts := strings.Split(result.Key, "/")
+		if len(parts) < 3 {
+			results.Close()
+			return errors.New("invalid validator event key")
+		}
+		validatorID, err := peer.Decode(parts[len(parts)-3])
+		if err != nil {
+			results.Close()
+			return err
+		}
+		n, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			results.Close()
+			return err
+		}
+		event, err := deserializeValidatorEvent(result.Value)
+		if err != nil {
+			results.Close()
+			return err
+		}
+		ke := &keyedEvent{
+			key:         strings.Join(parts[len(parts)-4:], "/"),
+			validatorID: validatorID,
+			n:           n,
+			event:       event,
+		}
+		if event.Height >= blk.Header.Height {
+			toRemove = append(toRemove, ke)
+		} else if event.Type == ValidatorEventStake || event.Type == ValidatorEventRestake {
+			last, ok := lastStake[event.Nullifier]
+			if !ok || event.Height > last.event.Height || (event.Height == last.event.Height && n > last.n) {
+				lastStake[event.Nullifier] = ke
+			}
+		}
+	}
+	results.Close()
+
+	// Undo the events in the reverse of the order in which they
+	// were recorded.
+	sort.Slice(toRemove, func(i, j int) bool {
+		return toRemove[i].n > toRemove[j].n
+	})
+	for _, ke := range toRemove {
+		if err := dsDeleteIndexValue(dbtx, idx, ke.key); err != nil {
+			return err
+		}
+		stakeKey := validatorIndexStakePrefix + ke.event.Nullifier.String()
+		switch ke.event.Type {
+		case ValidatorEventStake:
+			if err := dsDeleteIndexValue(dbtx, idx, stakeKey); err != nil {
+				return err
+			}
+		case ValidatorEventRestake:
+			last, ok := lastStake[ke.event.Nullifier]
+			if !ok {
+				if err := dsDeleteIndexValue(dbtx, idx, stakeKey); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := dsPutIndexValue(dbtx, idx, stakeKey, encodeValidatorStake(last.validatorID, last.event.Amount)); err != nil {
+				return err
+			}
+		case ValidatorEventUnstake:
+			if err := dsPutIndexValue(dbtx, idx, stakeKey, encodeValidatorStake(ke.validatorID, ke.event.Amount)); err != nil {
+				return err
+			}
+		}
+	}
+	return dsPutIndexerHeight(dbtx, idx, blk.Header.Height-1)
+}
+
+// GetValidatorHistory returns the events recorded for the validator
+// in the order in which they occurred.
+func (idx *ValidatorIndex) GetValidatorHistory(ds repo.Datastore, validatorID peer.ID) ([]*ValidatorEvent, error) {
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix: repo.IndexKeyPrefix + idx.Key() + "/" + validatorIndexEventPrefix + validatorID.String() + "/",
+		Orders: []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	var events []*ValidatorEvent
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		event, err := deserializeValidatorEvent(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (idx *ValidatorIndex) Close(ds repo.Datastore) error {
+	return nil
+}
+
+func DropValidatorIndex(ds repo.Datastore) error {
+	return dsDropIndex(ds, &ValidatorIndex{})
+}
+
+func encodeValidatorStake(validatorID peer.ID, amount types.Amount) []byte {
+	idBytes := []byte(validatorID)
+	ser := make([]byte, 8+len(idBytes))
+	binary.BigEndian.PutUint64(ser[:8], uint64(amount))
+	copy(ser[8:], idBytes)
+	return ser
+}
+
+func decodeValidatorStake(ser []byte) (peer.ID, types.Amount, error) {
+	if len(ser) < 8 {
+		return "", 0, errors.New("invalid validator stake length")
+	}
+	validatorID, err := peer.IDFromBytes(ser[8:])
+	if err != nil {
+		return "", 0, err
+	}
+	return validatorID, types.Amount(binary.BigEndian.Uint64(ser[:8])), nil
+}
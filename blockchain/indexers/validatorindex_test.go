@@ -0,0 +1,116 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"crypto/rand"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidatorIndex(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	idx := NewValidatorIndex()
+
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	validatorID, err := peer.IDFromPublicKey(pub)
+	assert.NoError(t, err)
+	validatorIDBytes, err := validatorID.Marshal()
+	assert.NoError(t, err)
+
+	nullifier := types.Nullifier{0x01}
+	connect := func(height uint32, txs ...*transactions.Transaction) {
+		dbtx, err := ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err)
+		assert.NoError(t, idx.ConnectBlock(dbtx, &blocks.Block{
+			Header:       &blocks.BlockHeader{Height: height},
+			Transactions: txs,
+		}))
+		assert.NoError(t, dbtx.Commit(context.Background()))
+	}
+
+	stakeTx := transactions.WrapTransaction(&transactions.StakeTransaction{
+		Validator_ID: validatorIDBytes,
+		Amount:       1000,
+		Nullifier:    nullifier[:],
+	})
+	connect(1, stakeTx)
+
+	coinbaseTx := transactions.WrapTransaction(&transactions.CoinbaseTransaction{
+		Validator_ID: validatorIDBytes,
+		NewCoins:     50,
+	})
+	restakeTx := transactions.WrapTransaction(&transactions.StakeTransaction{
+		Validator_ID: validatorIDBytes,
+		Amount:       1500,
+		Nullifier:    nullifier[:],
+		LockedUntil:  1,
+	})
+	connect(2, coinbaseTx, restakeTx)
+
+	spendTx := transactions.WrapTransaction(&transactions.StandardTransaction{
+		Nullifiers: [][]byte{nullifier[:], {0x02}},
+	})
+	connect(3, spendTx)
+
+	expected := []*ValidatorEvent{
+		{Type: ValidatorEventStake, Height: 1, Txid: stakeTx.ID(), Amount: 1000, Nullifier: nullifier},
+		{Type: ValidatorEventCoinbase, Height: 2, Txid: coinbaseTx.ID(), Amount: 50},
+		{Type: ValidatorEventRestake, Height: 2, Txid: restakeTx.ID(), Amount: 1500, Nullifier: nullifier},
+		{Type: ValidatorEventUnstake, Height: 3, Txid: spendTx.ID(), Amount: 1500, Nullifier: nullifier},
+	}
+	events, err := idx.GetValidatorHistory(ds, validatorID)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	disconnect := func(height uint32, txs ...*transactions.Transaction) {
+		dbtx, err := ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err)
+		assert.NoError(t, idx.DisconnectBlock(dbtx, &blocks.Block{
+			Header:       &blocks.BlockHeader{Height: height},
+			Transactions: txs,
+		}))
+		assert.NoError(t, dbtx.Commit(context.Background()))
+	}
+	stake := func() []byte {
+		val, err := dsFetchIndexValue(ds, idx, validatorIndexStakePrefix+nullifier.String())
+		assert.NoError(t, err)
+		return val
+	}
+
+	// Disconnecting the spend restores the restaked amount and
+	// disconnecting the restake restores the original stake.
+	disconnect(3, spendTx)
+	events, err = idx.GetValidatorHistory(ds, validatorID)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:3], events)
+	assert.Equal(t, encodeValidatorStake(validatorID, 1500), stake())
+
+	disconnect(2, coinbaseTx, restakeTx)
+	events, err = idx.GetValidatorHistory(ds, validatorID)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:1], events)
+	assert.Equal(t, encodeValidatorStake(validatorID, 1000), stake())
+
+	// Reconnecting arrives at the same history.
+	connect(2, coinbaseTx, restakeTx)
+	connect(3, spendTx)
+	events, err = idx.GetValidatorHistory(ds, validatorID)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	assert.NoError(t, DropValidatorIndex(ds))
+	events, err = idx.GetValidatorHistory(ds, validatorID)
+	assert.NoError(t, err)
+	assert.Len(t, events, 0)
+}
@@ -5,10 +5,12 @@
 package indexers
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/project-illium/ilxd/blockchain"
@@ -19,6 +21,7 @@ import (
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/walletlib"
 	"math/rand"
+	"runtime"
 	"strings"
 	"sync"
 	"time"
@@ -50,10 +53,35 @@ const (
 	walletServerLockingScriptPrefix = "lockingscript/"
 	walletServerNullifierKeyPrefix  = "nullifier/"
 	walletServerTxKeyPrefix         = "tx/"
+	walletServerUndoKeyPrefix       = "undo/"
 	walletServerIndexKey            = "walletserverindex"
 	WalletServerIndexName           = "wallet server index"
 )
 
+// WalletServerIndexOption is a configuration option for the WalletServerIndex.
+type WalletServerIndexOption func(cfg *walletServerIndexConfig)
+
+type walletServerIndexConfig struct {
+	scanWorkers   int
+	scanBatchSize int
+}
+
+// ScanWorkers sets the number of goroutines used to trial decrypt block
+// outputs. The default is the number of CPUs.
+func ScanWorkers(n int) WalletServerIndexOption {
+	return func(cfg *walletServerIndexConfig) {
+		cfg.scanWorkers = n
+	}
+}
+
+// ScanBatchSize sets the number of outputs handed to a scan worker at
+// a time. The default is 64.
+func ScanBatchSize(n int) WalletServerIndexOption {
+	return func(cfg *walletServerIndexConfig) {
+		cfg.scanBatchSize = n
+	}
+}
+
 type commitmentWithKey struct {
 	commitment types.ID
 	viewKey    crypto.PrivKey
@@ -63,9 +91,18 @@ type commitmentWithKey struct {
 // transactions on behalf of wallets. It allows for building lite wallets
 // that tradeoff privacy vis-à-vis the server for fast syncing and instant
 // access to coins.
+//
+// A worker pool decrypts the outputs of each connected block in batches
+// so that a large number of registered users does not stall the connection
+// of new blocks. The height and ID of the last connected block are persisted
+// alongside the accumulator and used as a cursor to avoid connecting the
+// same block twice.
 type WalletServerIndex struct {
+	ds              repo.Datastore
 	acc             *blockchain.Accumulator
-	scanner         *walletlib.TransactionScanner
+	scanner         *outputScanner
+	keys            []*icrypto.Curve25519PrivateKey
+	keyMtx          sync.RWMutex
 	nullifiers      map[types.Nullifier]commitmentWithKey
 	stateMtx        sync.RWMutex
 	bestBlockID     types.ID
@@ -76,7 +113,15 @@ type WalletServerIndex struct {
 }
 
 // NewWalletServerIndex returns a new WalletServerIndex.
-func NewWalletServerIndex(ds repo.Datastore) (*WalletServerIndex, error) {
+func NewWalletServerIndex(ds repo.Datastore, opts ...WalletServerIndexOption) (*WalletServerIndex, error) {
+	cfg := &walletServerIndexConfig{
+		scanWorkers:   runtime.NumCPU(),
+		scanBatchSize: 64,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	dbtx, err := ds.NewTransaction(context.Background(), true)
 	if err != nil {
 		return nil, err
@@ -86,8 +131,7 @@ func NewWalletServerIndex(ds repo.Datastore) (*WalletServerIndex, error) {
 		return nil, err
 	}
 
-	scanner := walletlib.NewTransactionScanner()
-
+	var keys []*icrypto.Curve25519PrivateKey
 	for r := range query.Next() {
 		v := strings.Split(r.Key, "/")
 		keyBytes, err := hex.DecodeString(v[len(v)-1])
@@ -103,7 +147,7 @@ func NewWalletServerIndex(ds repo.Datastore) (*WalletServerIndex, error) {
 		if _, ok := key.(*icrypto.Curve25519PrivateKey); !ok {
 			return nil, errors.New("viewkey is not curve25519 private key")
 		}
-		scanner.AddKeys(key.(*icrypto.Curve25519PrivateKey))
+		keys = append(keys, key.(*icrypto.Curve25519PrivateKey))
 	}
 	query.Close()
 
@@ -166,8 +210,11 @@ func NewWalletServerIndex(ds repo.Datastore) (*WalletServerIndex, error) {
 	}
 
 	idx := &WalletServerIndex{
+		ds:              ds,
 		acc:             acc,
-		scanner:         scanner,
+		scanner:         newOutputScanner(cfg.scanWorkers, cfg.scanBatchSize),
+		keys:            keys,
+		keyMtx:          sync.RWMutex{},
 		bestBlockID:     types.NewID(bestBlock[4:]),
 		bestBlockHeight: binary.BigEndian.Uint32(bestBlock[:4]),
 		nullifiers:      nullifiers,
@@ -193,12 +240,63 @@ func (idx *WalletServerIndex) Name() string {
 // ConnectBlock is called when a block is connected to the chain.
 // The indexer can use this opportunity to parse it and store it in
 // the database. The database transaction must be respected.
+//
+// The block's outputs are decrypted by the scan worker pool before
+// the block is connected.
 func (idx *WalletServerIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	idx.stateMtx.RLock()
+	// Skip the block if it was already connected prior to the last
+	// flush. This may happen if blocks are replayed on start up.
+	replayed := idx.bestBlockID != (types.ID{}) && blk.Header.Height <= idx.bestBlockHeight
+	idx.stateMtx.RUnlock()
+	if replayed {
+		return nil
+	}
+
+	idx.keyMtx.RLock()
+	keys := make([]*icrypto.Curve25519PrivateKey, len(idx.keys))
+	copy(keys, idx.keys)
+	idx.keyMtx.RUnlock()
+
+	matches := idx.scanner.Scan(keys, blk.Outputs())
+
 	idx.stateMtx.Lock()
-	defer idx.stateMtx.Unlock()
+	notifications, err := idx.connectBlock(dbtx, blk, matches)
+	idx.stateMtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) > 0 {
+		go func() {
+			idx.subMtx.RLock()
+			for _, n := range notifications {
+				for _, sub := range idx.subs {
+					sub.C <- n
+				}
+			}
+			idx.subMtx.RUnlock()
+		}()
+	}
+	return nil
+}
 
-	matches := idx.scanner.ScanOutputs(blk)
+// connectBlock updates the index state with the block using the provided
+// scan matches and returns any notifications that should be sent to the
+// subscribers. The state lock must be held when calling this method.
+func (idx *WalletServerIndex) connectBlock(dbtx datastore.Txn, blk *blocks.Block, matches map[types.ID]*walletlib.ScanMatch) ([]*UserTransaction, error) {
+	acc, err := blockchain.SerializeAccumulator(idx.acc)
+	if err != nil {
+		return nil, err
+	}
+	undo := &walletServerUndo{
+		blockID:     blk.ID(),
+		prevBlockID: idx.bestBlockID,
+		prevHeight:  idx.bestBlockHeight,
+		accumulator: acc,
+	}
 
+	var notifications []*UserTransaction
 	for _, tx := range blk.Transactions {
 		notifiedKeys := make(map[crypto.PrivKey]bool)
 		for _, out := range tx.Outputs() {
@@ -208,16 +306,17 @@ func (idx *WalletServerIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block
 				idx.acc.Insert(out.Commitment, true)
 				viewKey, err := crypto.MarshalPrivateKey(match.Key)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				serializedViewKey := hex.EncodeToString(viewKey)
 				dsKey := walletServerTxKeyPrefix + serializedViewKey + "/" + tx.ID().String()
 				if err := dsPutIndexValue(dbtx, idx, dsKey, nil); err != nil {
-					return err
+					return nil, err
 				}
+				undo.txKeys = append(undo.txKeys, dsKey)
 
-				var note types.SpendNote
-				if err := note.Deserialize(match.DecryptedNote); err != nil {
+				desc := out.ToDescriptor()
+				if err := desc.SetDecryptedNote(match.DecryptedNote); err != nil {
 					continue
 				}
 
@@ -228,41 +327,38 @@ func (idx *WalletServerIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block
 				ul := new(types.LockingScript)
 				if err := ul.Deserialize(serializedLockingScript); err != nil {
 					log.Errorf("Wallet server index error rescanning chain: %s", err)
-					return err
-				}
-				scriptHash, err := ul.Hash()
-				if err != nil {
-					log.Errorf("Wallet server index error rescanning chain: %s", err)
-					return err
+					return nil, err
 				}
-				if scriptHash.Compare(note.ScriptHash) != 0 {
+				if err := desc.SetLockingScript(ul); errors.Is(err, types.ErrScriptHashMismatch) {
 					continue
+				} else if err != nil {
+					log.Errorf("Wallet server index error rescanning chain: %s", err)
+					return nil, err
 				}
 
-				nullifier, err := types.CalculateNullifier(commitmentIndex, note.Salt, ul.ScriptCommitment.Bytes(), ul.LockingParams...)
+				nullifier, err := desc.Nullifier(commitmentIndex)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				dsKey = walletServerNullifierKeyPrefix + serializedViewKey + "/" + nullifier.String()
 				if err := dsPutIndexValue(dbtx, idx, dsKey, out.Commitment); err != nil {
 					continue
 				}
+				undo.added = append(undo.added, &walletServerNullifier{
+					viewKey:    serializedViewKey,
+					nullifier:  nullifier,
+					commitment: types.NewID(out.Commitment),
+				})
 				idx.nullifiers[nullifier] = commitmentWithKey{
 					commitment: types.NewID(out.Commitment),
 					viewKey:    match.Key,
 				}
 
 				if !notifiedKeys[match.Key] {
-					go func() {
-						idx.subMtx.RLock()
-						for _, sub := range idx.subs {
-							sub.C <- &UserTransaction{
-								Tx:      tx,
-								ViewKey: match.Key,
-							}
-						}
-						idx.subMtx.RUnlock()
-					}()
+					notifications = append(notifications, &UserTransaction{
+						Tx:      tx,
+						ViewKey: match.Key,
+					})
 					notifiedKeys[match.Key] = true
 				}
 			} else {
@@ -280,33 +376,131 @@ func (idx *WalletServerIndex) ConnectBlock(dbtx datastore.Txn, blk *blocks.Block
 				if err := dsPutIndexValue(dbtx, idx, dsKey, nil); err != nil {
 					continue
 				}
+				undo.txKeys = append(undo.txKeys, dsKey)
 
 				dsKey = walletServerNullifierKeyPrefix + serializedViewKey + "/" + n.String()
 				if err := dsDeleteIndexValue(dbtx, idx, dsKey); err != nil {
 					continue
 				}
+				undo.removed = append(undo.removed, &walletServerNullifier{
+					viewKey:    serializedViewKey,
+					nullifier:  n,
+					commitment: cwk.commitment,
+				})
 
 				idx.acc.DropProof(cwk.commitment.Bytes())
 				delete(idx.nullifiers, n)
 
 				if !notifiedKeys[cwk.viewKey] {
-					go func() {
-						idx.subMtx.RLock()
-						for _, sub := range idx.subs {
-							sub.C <- &UserTransaction{
-								Tx:      tx,
-								ViewKey: cwk.viewKey,
-							}
-						}
-						idx.subMtx.RUnlock()
-					}()
+					notifications = append(notifications, &UserTransaction{
+						Tx:      tx,
+						ViewKey: cwk.viewKey,
+					})
 					notifiedKeys[cwk.viewKey] = true
 				}
 			}
 		}
 	}
+	if err := dsPutIndexValue(dbtx, idx, walletServerUndoKey(blk.Header.Height), undo.serialize()); err != nil {
+		return nil, err
+	}
+	if blk.Header.Height > blockchain.MaxReorgDepth {
+		if err := dsDeleteIndexValue(dbtx, idx, walletServerUndoKey(blk.Header.Height-blockchain.MaxReorgDepth)); err != nil {
+			return nil, err
+		}
+	}
 	idx.bestBlockID = blk.ID()
 	idx.bestBlockHeight = blk.Header.Height
+	return notifications, nil
+}
+
+// DisconnectBlock is called when a block is disconnected from the chain.
+// The index is restored to its state prior to the block using the undo
+// data stored when the block was connected. The restored accumulator is
+// written in the database transaction so the index is not left ahead of
+// the chain if the node shuts down before the next flush.
+func (idx *WalletServerIndex) DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error {
+	idx.stateMtx.Lock()
+	defer idx.stateMtx.Unlock()
+
+	if blk.Header.Height > idx.bestBlockHeight {
+		// The block was never connected to the index.
+		return nil
+	}
+	if idx.bestBlockID != blk.ID() {
+		return errors.New("wallet server index: disconnected block is not the best block")
+	}
+	ser, err := dsFetchIndexValueWithTx(dbtx, idx, walletServerUndoKey(blk.Header.Height))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return blockchain.ErrNoUndoData
+	} else if err != nil {
+		return err
+	}
+	undo, err := deserializeWalletServerUndo(ser)
+	if err != nil {
+		return err
+	}
+	if undo.blockID != blk.ID() {
+		return blockchain.ErrNoUndoData
+	}
+	acc, err := blockchain.DeserializeAccumulator(undo.accumulator)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range undo.txKeys {
+		if err := dsDeleteIndexValue(dbtx, idx, key); err != nil {
+			return err
+		}
+	}
+	for _, n := range undo.added {
+		if err := dsDeleteIndexValue(dbtx, idx, walletServerNullifierKeyPrefix+n.viewKey+"/"+n.nullifier.String()); err != nil {
+			return err
+		}
+	}
+	restored := make(map[types.Nullifier]commitmentWithKey, len(undo.removed))
+	for _, n := range undo.removed {
+		if err := dsPutIndexValue(dbtx, idx, walletServerNullifierKeyPrefix+n.viewKey+"/"+n.nullifier.String(), n.commitment.Bytes()); err != nil {
+			return err
+		}
+		keyBytes, err := hex.DecodeString(n.viewKey)
+		if err != nil {
+			return err
+		}
+		viewKey, err := crypto.UnmarshalPrivateKey(keyBytes)
+		if err != nil {
+			return err
+		}
+		restored[n.nullifier] = commitmentWithKey{
+			commitment: n.commitment,
+			viewKey:    viewKey,
+		}
+	}
+	if err := dsDeleteIndexValue(dbtx, idx, walletServerUndoKey(blk.Header.Height)); err != nil {
+		return err
+	}
+
+	heightBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(heightBytes, undo.prevHeight)
+	if err := dsPutIndexValue(dbtx, idx, walletServerAccumulatorKey, undo.accumulator); err != nil {
+		return err
+	}
+	if err := dsPutIndexValue(dbtx, idx, walletServerBestBlockKey, append(heightBytes, undo.prevBlockID.Bytes()...)); err != nil {
+		return err
+	}
+	if err := dsPutIndexerHeight(dbtx, idx, undo.prevHeight); err != nil {
+		return err
+	}
+
+	for _, n := range undo.added {
+		delete(idx.nullifiers, n.nullifier)
+	}
+	for n, cwk := range restored {
+		idx.nullifiers[n] = cwk
+	}
+	idx.acc = acc
+	idx.bestBlockID = undo.prevBlockID
+	idx.bestBlockHeight = undo.prevHeight
 	return nil
 }
 
@@ -380,6 +574,7 @@ func (idx *WalletServerIndex) GetTxoProofs(commitments []types.ID) ([]*blockchai
 // Close closes the wallet server index
 func (idx *WalletServerIndex) Close(ds repo.Datastore) error {
 	close(idx.quit)
+	idx.scanner.Close()
 
 	return idx.flush(ds)
 }
@@ -394,7 +589,7 @@ func (idx *WalletServerIndex) RegisterViewKey(ds repo.Datastore, viewKey crypto.
 	if err != nil {
 		return err
 	}
-	idx.scanner.AddKeys(viewKey.(*icrypto.Curve25519PrivateKey))
+	idx.addKey(viewKey.(*icrypto.Curve25519PrivateKey))
 
 	dbtx, err := ds.NewTransaction(context.Background(), false)
 	if err != nil {
@@ -448,7 +643,7 @@ func (idx *WalletServerIndex) RescanViewkey(ds repo.Datastore, viewKey crypto.Pr
 	bestHeight := idx.bestBlockHeight
 	idx.stateMtx.RUnlock()
 
-	scanner := walletlib.NewTransactionScanner(viewKey.(*icrypto.Curve25519PrivateKey))
+	scanKeys := []*icrypto.Curve25519PrivateKey{viewKey.(*icrypto.Curve25519PrivateKey)}
 	height := checkpointHeight + 1
 	nullifiers := make(map[types.Nullifier]commitmentWithKey)
 	for {
@@ -457,7 +652,7 @@ func (idx *WalletServerIndex) RescanViewkey(ds repo.Datastore, viewKey crypto.Pr
 			log.Errorf("Wallet server index error rescanning chain: %s", err)
 			return err
 		}
-		matches := scanner.ScanOutputs(blk)
+		matches := idx.scanner.Scan(scanKeys, blk.Outputs())
 		for _, tx := range blk.Transactions {
 			for _, out := range tx.Outputs() {
 				match, ok := matches[types.NewID(out.Commitment)]
@@ -480,8 +675,8 @@ func (idx *WalletServerIndex) RescanViewkey(ds repo.Datastore, viewKey crypto.Pr
 						return err
 					}
 
-					var note types.SpendNote
-					if err := note.Deserialize(match.DecryptedNote); err != nil {
+					desc := out.ToDescriptor()
+					if err := desc.SetDecryptedNote(match.DecryptedNote); err != nil {
 						log.Errorf("Wallet server index error rescanning chain: %s", err)
 						return err
 					}
@@ -496,17 +691,12 @@ func (idx *WalletServerIndex) RescanViewkey(ds repo.Datastore, viewKey crypto.Pr
 						log.Errorf("Wallet server index error rescanning chain: %s", err)
 						return err
 					}
-					scriptHash, err := ul.Hash()
-					if err != nil {
-						log.Errorf("Wallet server index error rescanning chain: %s", err)
-						return err
-					}
-					if scriptHash.Compare(note.ScriptHash) != 0 {
+					if err := desc.SetLockingScript(ul); err != nil {
 						log.Errorf("Wallet server index error rescanning chain: %s", err)
 						return err
 					}
 
-					nullifier, err := types.CalculateNullifier(commitmentIndex, note.Salt, ul.ScriptCommitment.Bytes(), ul.LockingParams...)
+					nullifier, err := desc.Nullifier(commitmentIndex)
 					if err != nil {
 						return err
 					}
@@ -640,9 +830,7 @@ func (idx *WalletServerIndex) run(ds repo.Datastore) {
 						continue
 					}
 
-					idx.stateMtx.Lock()
-					idx.scanner.RemoveKey(viewKey.(*icrypto.Curve25519PrivateKey))
-					idx.stateMtx.Unlock()
+					idx.removeKey(viewKey.(*icrypto.Curve25519PrivateKey))
 
 					if err := dsDeleteIndexValue(dbtx, idx, r.Key); err != nil {
 						log.Errorf("Error deleting stale users %s", err)
@@ -671,6 +859,30 @@ func (idx *WalletServerIndex) run(ds repo.Datastore) {
 	}
 }
 
+func (idx *WalletServerIndex) addKey(key *icrypto.Curve25519PrivateKey) {
+	idx.keyMtx.Lock()
+	defer idx.keyMtx.Unlock()
+
+	for _, k := range idx.keys {
+		if k.Equals(key) {
+			return
+		}
+	}
+	idx.keys = append(idx.keys, key)
+}
+
+func (idx *WalletServerIndex) removeKey(key *icrypto.Curve25519PrivateKey) {
+	idx.keyMtx.Lock()
+	defer idx.keyMtx.Unlock()
+
+	for i, k := range idx.keys {
+		if k.Equals(key) {
+			idx.keys = append(idx.keys[:i], idx.keys[i+1:]...)
+			return
+		}
+	}
+}
+
 func (idx *WalletServerIndex) flush(ds repo.Datastore) error {
 	idx.stateMtx.Lock()
 	defer idx.stateMtx.Unlock()
@@ -699,6 +911,150 @@ func (idx *WalletServerIndex) flush(ds repo.Datastore) error {
 	return dbtx.Commit(context.Background())
 }
 
+func walletServerUndoKey(height uint32) string {
+	return fmt.Sprintf("%s%010d", walletServerUndoKeyPrefix, height)
+}
+
+// walletServerNullifier is a nullifier tracked by the index for a view key.
+type walletServerNullifier struct {
+	viewKey    string
+	nullifier  types.Nullifier
+	commitment types.ID
+}
+
+// walletServerUndo holds the index state changed by connecting a block
+// so that the block can be disconnected.
+type walletServerUndo struct {
+	blockID     types.ID
+	prevBlockID types.ID
+	prevHeight  uint32
+	// accumulator is the serialized accumulator before the
+	// block was connected.
+	accumulator []byte
+	txKeys      []string
+	added       []*walletServerNullifier
+	removed     []*walletServerNullifier
+}
+
+func (u *walletServerUndo) serialize() []byte {
+	var buf bytes.Buffer
+	writeUint32 := func(n int) {
+		var l [4]byte
+		binary.BigEndian.PutUint32(l[:], uint32(n))
+		buf.Write(l[:])
+	}
+	writeBytes := func(b []byte) {
+		writeUint32(len(b))
+		buf.Write(b)
+	}
+	writeNullifiers := func(nullifiers []*walletServerNullifier) {
+		writeUint32(len(nullifiers))
+		for _, n := range nullifiers {
+			writeBytes([]byte(n.viewKey))
+			buf.Write(n.nullifier[:])
+			buf.Write(n.commitment[:])
+		}
+	}
+	buf.Write(u.blockID[:])
+	buf.Write(u.prevBlockID[:])
+	writeUint32(int(u.prevHeight))
+	writeBytes(u.accumulator)
+	writeUint32(len(u.txKeys))
+	for _, key := range u.txKeys {
+		writeBytes([]byte(key))
+	}
+	writeNullifiers(u.added)
+	writeNullifiers(u.removed)
+	return buf.Bytes()
+}
+
+func deserializeWalletServerUndo(ser []byte) (*walletServerUndo, error) {
+	errMalformed := errors.New("malformed wallet server undo data")
+	next := func(n int) ([]byte, error) {
+		if len(ser) < n {
+			return nil, errMalformed
+		}
+		b := ser[:n]
+		ser = ser[n:]
+		return b, nil
+	}
+	readBytes := func() ([]byte, error) {
+		l, err := next(4)
+		if err != nil {
+			return nil, err
+		}
+		return next(int(binary.BigEndian.Uint32(l)))
+	}
+	readCount := func() (int, error) {
+		l, err := next(4)
+		if err != nil {
+			return 0, err
+		}
+		n := int(binary.BigEndian.Uint32(l))
+		if n > len(ser) {
+			return 0, errMalformed
+		}
+		return n, nil
+	}
+	readNullifiers := func() ([]*walletServerNullifier, error) {
+		count, err := readCount()
+		if err != nil {
+			return nil, err
+		}
+		nullifiers := make([]*walletServerNullifier, 0, count)
+		for i := 0; i < count; i++ {
+			viewKey, err := readBytes()
+			if err != nil {
+				return nil, err
+			}
+			b, err := next(64)
+			if err != nil {
+				return nil, err
+			}
+			nullifiers = append(nullifiers, &walletServerNullifier{
+				viewKey:    string(viewKey),
+				nullifier:  types.NewNullifier(b[:32]),
+				commitment: types.NewID(b[32:]),
+			})
+		}
+		return nullifiers, nil
+	}
+
+	b, err := next(68)
+	if err != nil {
+		return nil, err
+	}
+	u := &walletServerUndo{
+		blockID:     types.NewID(b[:32]),
+		prevBlockID: types.NewID(b[32:64]),
+		prevHeight:  binary.BigEndian.Uint32(b[64:]),
+	}
+	if u.accumulator, err = readBytes(); err != nil {
+		return nil, err
+	}
+	count, err := readCount()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < count; i++ {
+		key, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		u.txKeys = append(u.txKeys, string(key))
+	}
+	if u.added, err = readNullifiers(); err != nil {
+		return nil, err
+	}
+	if u.removed, err = readNullifiers(); err != nil {
+		return nil, err
+	}
+	if len(ser) > 0 {
+		return nil, errMalformed
+	}
+	return u, nil
+}
+
 // DropWalletServerIndex deletes the wallet server index from the datastore
 func DropWalletServerIndex(ds repo.Datastore) error {
 	return dsDropIndex(ds, &WalletServerIndex{})
@@ -8,6 +8,8 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
+	datastore "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/repo/mock"
@@ -158,6 +160,45 @@ func TestWalletServerIndex(t *testing.T) {
 	assert.Error(t, err)
 	sub.Close()
 
+	// Disconnecting the spend restores the note and its proof.
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, idx.DisconnectBlock(dbtx, blk2))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	txids, err = idx.GetTransactionsIDs(ds, viewKey)
+	assert.NoError(t, err)
+	assert.Len(t, txids, 1)
+	assert.Len(t, idx.nullifiers, 1)
+	assert.Equal(t, uint32(1), idx.bestBlockHeight)
+	proofs, merkleRoot, err = idx.GetTxoProofs([]types.ID{commitment})
+	assert.NoError(t, err)
+	assert.Len(t, proofs, 1)
+	assert.True(t, standard.ValidateInclusionProof(commitment.Bytes(), proofs[0].Index, proofs[0].Hashes, proofs[0].Flags, merkleRoot[:]))
+
+	// The undo data for the first block was stored before the
+	// index was reopened.
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, idx.DisconnectBlock(dbtx, blk))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	txids, err = idx.GetTransactionsIDs(ds, viewKey)
+	assert.NoError(t, err)
+	assert.Len(t, txids, 0)
+	assert.Len(t, idx.nullifiers, 0)
+	assert.Equal(t, uint64(0), idx.acc.NumElements())
+
+	// A block which is not the best block cannot be disconnected.
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, idx.ConnectBlock(dbtx, blk))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Error(t, idx.DisconnectBlock(dbtx, &blocks.Block{Header: &blocks.BlockHeader{Height: 1, Timestamp: 1}}))
+	dbtx.Discard(context.Background())
+
 	// Test rescanning
 	ds = mock.NewMapDatastore()
 	idx, err = NewWalletServerIndex(ds)
@@ -199,3 +240,60 @@ func randSpendNote() types.SpendNote {
 	note.Salt = salt
 	return note
 }
+
+type failingPutTxn struct {
+	datastore.Txn
+}
+
+func (f *failingPutTxn) Put(ctx context.Context, key datastore.Key, value []byte) error {
+	return errors.New("put failed")
+}
+
+func TestWalletServerIndexConnectBlockError(t *testing.T) {
+	ds := mock.NewMapDatastore()
+
+	idx, err := NewWalletServerIndex(ds)
+	assert.NoError(t, err)
+	defer idx.Close(ds)
+
+	viewKey, _, err := icrypto.GenerateCurve25519Key(rand.Reader)
+	assert.NoError(t, err)
+	ul := types.LockingScript{
+		ScriptCommitment: types.ID{},
+		LockingParams:    [][]byte{make([]byte, 32)},
+	}
+	assert.NoError(t, idx.RegisterViewKey(ds, viewKey, ul.Serialize()))
+
+	note := randSpendNote()
+	note.ScriptHash, err = ul.Hash()
+	assert.NoError(t, err)
+	commitment, err := note.Commitment()
+	assert.NoError(t, err)
+	ser, err := note.Serialize()
+	assert.NoError(t, err)
+	cipherText, err := viewKey.GetPublic().(*icrypto.Curve25519PublicKey).Encrypt(ser)
+	assert.NoError(t, err)
+
+	blk := &blocks.Block{
+		Header: &blocks.BlockHeader{
+			Height: 1,
+		},
+		Transactions: []*transactions.Transaction{
+			transactions.WrapTransaction(&transactions.StandardTransaction{
+				Outputs: []*transactions.Output{
+					{
+						Commitment: commitment[:],
+						Ciphertext: cipherText,
+					},
+				},
+			}),
+		},
+	}
+
+	// The index must write through the caller's transaction and
+	// return its errors.
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Error(t, idx.ConnectBlock(&failingPutTxn{dbtx}, blk))
+	dbtx.Discard(context.Background())
+}
@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/project-illium/ilxd/types/blocks"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
 )
 
 func serializeValidator(v *Validator) ([]byte, error) {
@@ -264,6 +266,75 @@ func dsFetchBlockIDFromHeight(ds repo.Datastore, height uint32) (types.ID, error
 	return types.NewID(blockIDBytes), nil
 }
 
+// dsFetchBlockIDsFromHeight returns the IDs of the count blocks starting at
+// fromHeight using an ordered scan of the height index. The heights are
+// stored contiguously from the lowest unpruned block so the scan skips to
+// fromHeight by key without loading any values.
+func dsFetchBlockIDsFromHeight(ds repo.Datastore, fromHeight uint32, count int) ([]types.ID, error) {
+	lowest, err := dsFetchLowestBlockHeight(ds)
+	if err != nil {
+		return nil, err
+	}
+	if fromHeight < lowest {
+		return nil, datastore.ErrNotFound
+	}
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix: repo.BlockByHeightKeyPrefix,
+		Orders: []query.Order{query.OrderByKey{}},
+		Offset: int(fromHeight - lowest),
+		Limit:  count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	ids := make([]types.ID, 0, count)
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		height, err := strconv.ParseUint(datastore.NewKey(result.Key).BaseNamespace(), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if uint32(height) != fromHeight+uint32(len(ids)) {
+			return nil, datastore.ErrNotFound
+		}
+		ids = append(ids, types.NewID(result.Value))
+	}
+	if len(ids) != count {
+		return nil, datastore.ErrNotFound
+	}
+	return ids, nil
+}
+
+func dsFetchLowestBlockHeight(ds repo.Datastore) (uint32, error) {
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix:   repo.BlockByHeightKeyPrefix,
+		Orders:   []query.Order{query.OrderByKey{}},
+		Limit:    1,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+
+	result, ok := results.NextSync()
+	if !ok {
+		return 0, datastore.ErrNotFound
+	}
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	height, err := strconv.ParseUint(datastore.NewKey(result.Key).BaseNamespace(), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(height), nil
+}
+
 func dsFetchBlockIDFromHeightWithTx(dbtx datastore.Txn, height uint32) (types.ID, error) {
 	blockIDBytes, err := dbtx.Get(context.Background(), datastore.NewKey(repo.BlockByHeightKeyPrefix+fmt.Sprintf("%010d", int(height))))
 	if err != nil {
@@ -399,6 +470,15 @@ func dsPutNullifiers(dbtx datastore.Txn, nullifiers []types.Nullifier) error {
 	return nil
 }
 
+func dsDeleteNullifiers(dbtx datastore.Txn, nullifiers []types.Nullifier) error {
+	for _, n := range nullifiers {
+		if err := dbtx.Delete(context.Background(), datastore.NewKey(repo.NullifierKeyPrefix+n.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func dsDeleteNullifierSet(dbtx datastore.Txn) error {
 	q := query.Query{
 		Prefix: repo.NullifierKeyPrefix,
@@ -421,6 +501,10 @@ func dsPutTxoSetRoot(dbtx datastore.Txn, txoRoot types.ID) error {
 	return dbtx.Put(context.Background(), datastore.NewKey(repo.TxoRootKeyPrefix+txoRoot.String()), []byte{})
 }
 
+func dsDeleteTxoSetRoot(dbtx datastore.Txn, txoRoot types.ID) error {
+	return dbtx.Delete(context.Background(), datastore.NewKey(repo.TxoRootKeyPrefix+txoRoot.String()))
+}
+
 func dsTxoSetRootExists(ds repo.Datastore, txoRoot types.ID) (bool, error) {
 	return ds.Has(context.Background(), datastore.NewKey(repo.TxoRootKeyPrefix+txoRoot.String()))
 }
@@ -474,7 +558,13 @@ func dsCreditTreasury(dbtx datastore.Txn, amount types.Amount) error {
 	return dbtx.Put(context.Background(), datastore.NewKey(repo.TreasuryBalanceKey), newBalance)
 }
 
-func dsFetchTreasuryBalance(ds repo.Datastore) (types.Amount, error) {
+func dsPutTreasuryBalance(dbtx datastore.Txn, balance types.Amount) error {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(balance))
+	return dbtx.Put(context.Background(), datastore.NewKey(repo.TreasuryBalanceKey), b)
+}
+
+func dsFetchTreasuryBalance(ds datastore.Read) (types.Amount, error) {
 	balance, err := ds.Get(context.Background(), datastore.NewKey(repo.TreasuryBalanceKey))
 	if err == datastore.ErrNotFound {
 		return 0, nil
@@ -521,6 +611,10 @@ func dsFetchAccumulatorCheckpoint(ds repo.Datastore, height uint32) (*Accumulato
 	return DeserializeAccumulator(ser)
 }
 
+func dsDeleteAccumulatorCheckpoint(dbtx datastore.Txn, height uint32) error {
+	return dbtx.Delete(context.Background(), datastore.NewKey(repo.AccumulatorCheckpointKey+fmt.Sprintf("%010d", int(height))))
+}
+
 func dsDeleteAccumulatorCheckpoints(dbtx datastore.Txn) error {
 	q := query.Query{
 		Prefix: repo.AccumulatorCheckpointKey,
@@ -589,6 +683,12 @@ func dsInitCurrentSupply(ds datastore.Datastore) error {
 	return ds.Put(context.Background(), datastore.NewKey(repo.CoinSupplyKey), zero)
 }
 
+func dsPutCurrentSupply(dbtx datastore.Txn, supply types.Amount) error {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(supply))
+	return dbtx.Put(context.Background(), datastore.NewKey(repo.CoinSupplyKey), b)
+}
+
 func dsFetchCurrentSupply(dbtx datastore.Txn) (types.Amount, error) {
 	b, err := dbtx.Get(context.Background(), datastore.NewKey(repo.CoinSupplyKey))
 	if err != nil {
@@ -597,8 +697,48 @@ func dsFetchCurrentSupply(dbtx datastore.Txn) (types.Amount, error) {
 	return types.Amount(binary.BigEndian.Uint64(b)), nil
 }
 
-func dsPutPrunedFlag(ds repo.Datastore) error {
-	return ds.Put(context.Background(), datastore.NewKey(repo.PrunedBlockchainDatastoreKey), []byte{})
+func dsPutPrunedHeight(ds datastore.Write, height uint32) error {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, height)
+	return ds.Put(context.Background(), datastore.NewKey(repo.PrunedBlockchainDatastoreKey), b)
+}
+
+func dsPutTxoRootHeights(dbtx datastore.Txn, heights []uint32) error {
+	b := make([]byte, 0, len(heights)*4)
+	for _, height := range heights {
+		b = binary.BigEndian.AppendUint32(b, height)
+	}
+	return dbtx.Put(context.Background(), datastore.NewKey(repo.TxoRootHeightsKey), b)
+}
+
+func dsFetchTxoRootHeights(ds repo.Datastore) ([]uint32, error) {
+	b, err := ds.Get(context.Background(), datastore.NewKey(repo.TxoRootHeightsKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(b)%4 != 0 {
+		return nil, errors.New("invalid txo root heights length")
+	}
+	heights := make([]uint32, 0, len(b)/4)
+	for i := 0; i < len(b); i += 4 {
+		heights = append(heights, binary.BigEndian.Uint32(b[i:i+4]))
+	}
+	return heights, nil
+}
+
+func dsFetchPrunedHeight(ds repo.Datastore) (uint32, error) {
+	b, err := ds.Get(context.Background(), datastore.NewKey(repo.PrunedBlockchainDatastoreKey))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	// Chains pruned before the height was stored
+	// only stored the flag.
+	if len(b) < 4 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint32(b), nil
 }
 
 func dsFetchPrunedFlag(ds repo.Datastore) (bool, error) {
@@ -608,3 +748,67 @@ func dsFetchPrunedFlag(ds repo.Datastore) (bool, error) {
 	}
 	return !errors.Is(err, datastore.ErrNotFound), nil
 }
+
+func writeUint32(buf *bytes.Buffer, n uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], n)
+	buf.Write(b[:])
+}
+
+func writeUint64(buf *bytes.Buffer, n uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], n)
+	buf.Write(b[:])
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	writeUint32(buf, uint32(len(b)))
+	buf.Write(b)
+}
+
+// byteReader reads values written with the write functions above.
+// Reads past the end of the data set err and return zero values.
+type byteReader struct {
+	b   []byte
+	err bool
+}
+
+func (r *byteReader) next(n int) []byte {
+	if r.err || n < 0 || len(r.b) < n {
+		r.err = true
+		if n > 0 && n <= 32 {
+			return make([]byte, n)
+		}
+		return nil
+	}
+	ret := r.b[:n]
+	r.b = r.b[n:]
+	return ret
+}
+
+func (r *byteReader) byte() byte {
+	return r.next(1)[0]
+}
+
+func (r *byteReader) uint32() uint32 {
+	return binary.BigEndian.Uint32(r.next(4))
+}
+
+func (r *byteReader) uint64() uint64 {
+	return binary.BigEndian.Uint64(r.next(8))
+}
+
+func (r *byteReader) bytes() []byte {
+	return r.next(int(r.uint32()))
+}
+
+// count reads a number of elements of at least minSize bytes each and
+// returns zero if there are not enough bytes left for them.
+func (r *byteReader) count(minSize int) int {
+	n := int(r.uint32())
+	if r.err || n > len(r.b)/minSize {
+		r.err = true
+		return 0
+	}
+	return n
+}
@@ -7,6 +7,7 @@ package blockchain
 import (
 	"context"
 	"github.com/go-test/deep"
+	datastore "github.com/ipfs/go-datastore"
 	"github.com/project-illium/ilxd/repo/mock"
 	"github.com/project-illium/ilxd/types"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,34 @@ func TestPutGetBlockIDByHeight(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestFetchBlockIDsFromHeight(t *testing.T) {
+	ds := mock.NewMapDatastore()
+
+	// Heights below the lowest are pruned.
+	ids := make([]types.ID, 0, 10)
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	for height := uint32(5); height < 15; height++ {
+		id := randomID()
+		ids = append(ids, id)
+		assert.NoError(t, dsPutBlockIDFromHeight(dbtx, id, height))
+	}
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	fetched, err := dsFetchBlockIDsFromHeight(ds, 7, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[2:5], fetched)
+
+	fetched, err = dsFetchBlockIDsFromHeight(ds, 5, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, ids, fetched)
+
+	_, err = dsFetchBlockIDsFromHeight(ds, 4, 2)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	_, err = dsFetchBlockIDsFromHeight(ds, 13, 3)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
 func TestPutGetDeleteBlockIndexState(t *testing.T) {
 	ds := mock.NewMapDatastore()
 	header := randomBlockHeader(5, randomID())
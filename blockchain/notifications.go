@@ -26,6 +26,8 @@ const (
 	NTRemoveValidator
 	NTValidatorSetUpdate
 	NTNewEpoch
+	// NTBlockDisconnected indicates the associated block was disconnected from the chain.
+	NTBlockDisconnected
 )
 
 // notificationTypeStrings is a map of notification types back to their constant
@@ -36,6 +38,7 @@ var notificationTypeStrings = map[NotificationType]string{
 	NTRemoveValidator:    "NTRemoveValidator",
 	NTValidatorSetUpdate: "NTValidatorSetUpdate",
 	NTNewEpoch:           "NTNewEpoch",
+	NTBlockDisconnected:  "NTBlockDisconnected",
 }
 
 // String returns the NotificationType in human-readable form.
@@ -50,6 +53,7 @@ func (n NotificationType) String() string {
 // function provided during the call to New and consists of a notification type
 // as well as associated data that depends on the type as follows:
 //   - NTBlockConnected:    *blocks.Block
+//   - NTBlockDisconnected: *blocks.Block
 type Notification struct {
 	Type NotificationType
 	Data interface{}
@@ -39,14 +39,15 @@ func NewNullifierSet(ds repo.Datastore, maxEntries uint) *NullifierSet {
 // the nullifier, we cache it, then the blockchain doesn't need to hit the disk
 // a second time when validating the block.
 func (ns *NullifierSet) NullifierExists(nullifier types.Nullifier) (bool, error) {
-	ns.mtx.Lock()
-	defer ns.mtx.Unlock()
-
+	ns.mtx.RLock()
 	exists, ok := ns.cachedEntries[nullifier]
+	ns.mtx.RUnlock()
 	if ok {
 		return exists, nil
 	}
 
+	// The disk is read without holding the lock so that the
+	// block validation workers can look up nullifiers in parallel.
 	exists, err := dsNullifierExists(ns.ds, nullifier)
 	if err != nil {
 		return false, err
@@ -56,6 +57,8 @@ func (ns *NullifierSet) NullifierExists(nullifier types.Nullifier) (bool, error)
 		return exists, nil
 	}
 
+	ns.mtx.Lock()
+	defer ns.mtx.Unlock()
 	ns.limitCache(1)
 	ns.cachedEntries[nullifier] = exists
 	return exists, nil
@@ -82,6 +85,20 @@ func (ns *NullifierSet) AddNullifiers(dbtx datastore.Txn, nullifiers []types.Nul
 	return dsPutNullifiers(dbtx, nullifiers)
 }
 
+// RemoveNullifiers deletes the nullifiers from the database using the
+// provided database transaction. This is only used when disconnecting a
+// block. The cached entries are deleted so the next lookup goes to disk.
+func (ns *NullifierSet) RemoveNullifiers(dbtx datastore.Txn, nullifiers []types.Nullifier) error {
+	ns.mtx.Lock()
+	defer ns.mtx.Unlock()
+
+	for _, n := range nullifiers {
+		delete(ns.cachedEntries, n)
+	}
+
+	return dsDeleteNullifiers(dbtx, nullifiers)
+}
+
 // Clone returns a copy of the NullifierSet
 func (ns *NullifierSet) Clone() *NullifierSet {
 	return &NullifierSet{
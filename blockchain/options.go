@@ -8,6 +8,8 @@ import (
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/zk"
+	"runtime"
 )
 
 const (
@@ -15,6 +17,7 @@ const (
 	DefaultMaxNullifiers  = 100000
 	DefaultSigCacheSize   = 100000
 	DefaultProofCacheSize = 100000
+	DefaultPruneDepth     = 10
 )
 
 // DefaultOptions returns a blockchain configure option that fills in
@@ -26,8 +29,10 @@ func DefaultOptions() Option {
 		cfg.datastore = mock.NewMapDatastore()
 		cfg.sigCache = NewSigCache(DefaultSigCacheSize)
 		cfg.proofCache = NewProofCache(DefaultProofCacheSize)
+		cfg.prover = zk.DefaultProver()
 		cfg.maxNullifiers = DefaultMaxNullifiers
 		cfg.maxTxoRoots = DefaultMaxTxoRoots
+		cfg.validationWorkers = runtime.NumCPU()
 		return nil
 	}
 }
@@ -78,6 +83,16 @@ func SnarkProofCache(proofCache *ProofCache) Option {
 	}
 }
 
+// Prover is the backend used to verify the zk-snark proofs.
+//
+// If this is not provided the default prover is used.
+func Prover(prover zk.Prover) Option {
+	return func(cfg *config) error {
+		cfg.prover = prover
+		return nil
+	}
+}
+
 // Indexer sets an IndexManager that is already configured with the desired
 // indexers.
 // These indexers will be notified whenever a new block is connected.
@@ -106,9 +121,33 @@ func MaxTxoRoots(maxTxoRoots uint) Option {
 	}
 }
 
+// ValidationWorkers is the number of workers used to validate the
+// transactions of a block in parallel. One or fewer validates the
+// transactions serially.
+func ValidationWorkers(workers int) Option {
+	return func(cfg *config) error {
+		cfg.validationWorkers = workers
+		return nil
+	}
+}
+
+// Checkpoints adds checkpoints to those in the params. Blocks at the
+// checkpoint heights must match the checkpoint block IDs and blocks
+// proven to lead up to a checkpoint with AddCheckpointHeaders skip
+// signature and proof verification.
+func Checkpoints(checkpoints []params.Checkpoint) Option {
+	return func(cfg *config) error {
+		cfg.checkpoints = checkpoints
+		return nil
+	}
+}
+
 // Prune enables pruning of the blockchain. All historical blocks will be
 // deleted from disk. This affects the ability to load these blocks from
 // the API.
+//
+// The most recent DefaultPruneDepth blocks are retained unless PruneDepth
+// is used.
 func Prune() Option {
 	return func(cfg *config) error {
 		cfg.prune = true
@@ -116,16 +155,32 @@ func Prune() Option {
 	}
 }
 
+// PruneDepth enables pruning of the blockchain and retains the most recent
+// depth blocks on disk so that they can be served to syncing peers. Blocks
+// which created one of the recent txo roots are also retained. A depth of
+// zero uses DefaultPruneDepth.
+func PruneDepth(depth uint32) Option {
+	return func(cfg *config) error {
+		cfg.prune = true
+		cfg.pruneDepth = depth
+		return nil
+	}
+}
+
 // Config specifies the blockchain configuration.
 type config struct {
-	params        *params.NetworkParams
-	datastore     repo.Datastore
-	sigCache      *SigCache
-	proofCache    *ProofCache
-	indexManager  IndexManager
-	maxNullifiers uint
-	maxTxoRoots   uint
-	prune         bool
+	params            *params.NetworkParams
+	datastore         repo.Datastore
+	sigCache          *SigCache
+	proofCache        *ProofCache
+	prover            zk.Prover
+	indexManager      IndexManager
+	maxNullifiers     uint
+	maxTxoRoots       uint
+	validationWorkers int
+	checkpoints       []params.Checkpoint
+	prune             bool
+	pruneDepth        uint32
 }
 
 func (cfg *config) validate() error {
@@ -144,5 +199,8 @@ func (cfg *config) validate() error {
 	if cfg.proofCache == nil {
 		return AssertError("NewBlockchain: proof cache cannot be nil")
 	}
+	if cfg.prune && cfg.pruneDepth == 0 {
+		cfg.pruneDepth = DefaultPruneDepth
+	}
 	return nil
 }
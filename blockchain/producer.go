@@ -0,0 +1,128 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/binary"
+	"math/big"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+)
+
+const (
+	// ProducerRoundDuration is the length, in seconds, of each producer
+	// round. The round is derived from the time since the parent block.
+	ProducerRoundDuration = 1
+
+	// ProducerRoundsPerBlock is the expected number of rounds between
+	// blocks. Each round that passes without a block makes another
+	// stake / (totalStake * ProducerRoundsPerBlock) share of the VRF
+	// outputs eligible, so by ProducerRoundsPerBlock * totalStake / stake
+	// rounds the validator is eligible no matter its VRF output.
+	ProducerRoundsPerBlock = 5
+
+	// MaxProducerRoundDrift is the number of rounds that a producer's
+	// clock may run ahead of ours. The round is derived from the header
+	// timestamp, which may be up to MaxBlockFutureTime ahead, so blocks
+	// claiming a later round are not accepted until our clock catches
+	// up. Otherwise a producer could make itself eligible early.
+	MaxProducerRoundDrift = 1
+
+	producerVRFPrefix = "illium/producer"
+)
+
+// ProducerRound returns the producer round for a block with the timestamp
+// building on a parent with the parent timestamp.
+func ProducerRound(parentTimestamp, timestamp int64) uint32 {
+	if timestamp <= parentTimestamp {
+		return 0
+	}
+	return uint32((timestamp - parentTimestamp) / ProducerRoundDuration)
+}
+
+// ProducerVRFInput returns the VRF input for producing the block at the
+// height. It only depends on the chain state, with the parent block ID
+// used as the chain randomness, so each validator has a single VRF output
+// per height and cannot grind for a better one by picking a timestamp.
+func ProducerVRFInput(parent types.ID, height uint32) []byte {
+	alpha := make([]byte, 0, len(producerVRFPrefix)+len(parent)+4)
+	alpha = append(alpha, []byte(producerVRFPrefix)...)
+	alpha = append(alpha, parent[:]...)
+	alpha = binary.BigEndian.AppendUint32(alpha, height)
+	return alpha
+}
+
+// IsEligibleProducer returns whether a VRF output makes a validator with
+// the given stake eligible to produce a block in the round. The first
+// eight bytes of the output are treated as a uniform random number in
+// [0, 2^64) which must fall below
+// 2^64 * stake * (round + 1) / (totalStake * ProducerRoundsPerBlock).
+//
+// The round only raises the threshold. Validators with lower outputs are
+// eligible in earlier rounds and a later round never changes the output.
+func IsEligibleProducer(vrfOutput []byte, stake, totalStake types.Amount, round uint32) bool {
+	if len(vrfOutput) < 8 || stake == 0 || totalStake == 0 {
+		return false
+	}
+	v := new(big.Int).SetUint64(binary.BigEndian.Uint64(vrfOutput[:8]))
+	v.Mul(v, new(big.Int).SetUint64(uint64(totalStake)))
+	v.Mul(v, big.NewInt(ProducerRoundsPerBlock))
+
+	threshold := new(big.Int).Lsh(new(big.Int).SetUint64(uint64(stake)), 64)
+	threshold.Mul(threshold, new(big.Int).SetUint64(uint64(round)+1))
+	return v.Cmp(threshold) < 0
+}
+
+// ProducerStake returns the validator's stake and the total stake in the
+// validator set for use in computing producer eligibility.
+func (b *Blockchain) ProducerStake(validatorID peer.ID) (types.Amount, types.Amount, error) {
+	b.stateLock.RLock()
+	defer b.stateLock.RUnlock()
+
+	validator, err := b.validatorSet.GetValidator(validatorID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return validator.TotalStake, b.validatorSet.TotalStaked(), nil
+}
+
+// checkProducerEligibility validates the VRF proof in the header and that
+// the producer was eligible to produce in the header's round.
+func (b *Blockchain) checkProducerEligibility(header *blocks.BlockHeader, prevHeader *blocks.BlockHeader) error {
+	producerID, err := peer.IDFromBytes(header.Producer_ID)
+	if err != nil {
+		return ruleError(ErrInvalidProducer, "block producer ID does not decode")
+	}
+	producerPubkey, err := producerID.ExtractPublicKey()
+	if err != nil {
+		return ruleError(ErrInvalidProducer, "block producer pubkey invalid")
+	}
+
+	alpha := ProducerVRFInput(types.NewID(header.Parent), header.Height)
+	output, valid, err := icrypto.VRFVerify(producerPubkey, alpha, header.VrfProof)
+	if err != nil || !valid {
+		return ruleError(ErrInvalidVRFProof, "invalid vrf proof")
+	}
+
+	validator, err := b.validatorSet.GetValidator(producerID)
+	if err != nil {
+		return ruleError(ErrInvalidProducer, "block producer not in validator set")
+	}
+	round := ProducerRound(prevHeader.Timestamp, header.Timestamp)
+	if !IsEligibleProducer(output, validator.TotalStake, b.validatorSet.TotalStaked(), round) {
+		return ruleError(ErrInvalidVRFProof, "block producer not eligible in round")
+	}
+	// Like a block too far in the future, a block from a round which
+	// has not started yet may become valid as our clock advances.
+	localRound := ProducerRound(prevHeader.Timestamp, time.Now().Unix()) + MaxProducerRoundDrift
+	if round > localRound && !IsEligibleProducer(output, validator.TotalStake, b.validatorSet.TotalStaked(), localRound) {
+		return OrphanBlockError("block producer not yet eligible")
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIsEligibleProducer(t *testing.T) {
+	assert.True(t, IsEligibleProducer(make([]byte, 64), 1, 100, 0))
+	assert.False(t, IsEligibleProducer(bytes.Repeat([]byte{0xff}, 64), 100, 100, 0))
+	assert.False(t, IsEligibleProducer(make([]byte, 64), 0, 100, 0))
+	assert.False(t, IsEligibleProducer(make([]byte, 4), 100, 100, 0))
+
+	// Enough rounds make any output eligible.
+	assert.False(t, IsEligibleProducer(bytes.Repeat([]byte{0xff}, 64), 50, 100, 8))
+	assert.True(t, IsEligibleProducer(bytes.Repeat([]byte{0xff}, 64), 50, 100, 9))
+
+	eligible, eligibleLater := 0, 0
+	for i := 0; i < 10000; i++ {
+		output := make([]byte, 64)
+		rand.Read(output)
+		if IsEligibleProducer(output, 50, 100, 0) {
+			eligible++
+			// Later rounds only add eligible outputs.
+			assert.True(t, IsEligibleProducer(output, 50, 100, 1))
+		}
+		if IsEligibleProducer(output, 50, 100, 1) {
+			eligibleLater++
+		}
+	}
+	// Expected 10000 / 2 / ProducerRoundsPerBlock = 1000
+	assert.InDelta(t, 1000, eligible, 200)
+	assert.InDelta(t, 2000, eligibleLater, 300)
+
+	assert.Equal(t, uint32(0), ProducerRound(100, 100))
+	assert.Equal(t, uint32(5), ProducerRound(100, 105))
+}
+
+func TestCheckProducerEligibility(t *testing.T) {
+	netParams := params.RegestParams
+	netParams.BlockVersions = types.VersionSchedule{
+		{Version: params.BlockVersionVRFProducer},
+	}
+
+	ds := mock.NewMapDatastore()
+	b := Blockchain{
+		params:       &netParams,
+		validatorSet: NewValidatorSet(&netParams, ds),
+	}
+	b.validatorSet.validators[randomPeerID()] = &Validator{
+		TotalStake: 100000,
+	}
+
+	parent := randomID()
+	prevHeader := &blocks.BlockHeader{Timestamp: 1000}
+
+	// The VRF output only depends on the parent and height. Find the
+	// first round in which the validator is eligible, using a new key
+	// until that is a few rounds in so the rounds before can be
+	// checked as well.
+	var (
+		sk       crypto.PrivKey
+		pk       crypto.PubKey
+		err      error
+		proof    []byte
+		minRound uint32
+	)
+	for minRound < MaxProducerRoundDrift+2 {
+		minRound = 0
+		sk, pk, err = crypto.GenerateEd25519Key(rand.Reader)
+		assert.NoError(t, err)
+		var output []byte
+		proof, output, err = icrypto.VRFProve(sk, ProducerVRFInput(parent, 1))
+		assert.NoError(t, err)
+		for !IsEligibleProducer(output, 100000, 200000, minRound) {
+			minRound++
+		}
+	}
+	pid, err := peer.IDFromPublicKey(pk)
+	assert.NoError(t, err)
+	pidBytes, err := pid.Marshal()
+	assert.NoError(t, err)
+	b.validatorSet.validators[pid] = &Validator{
+		PeerID:     pid,
+		TotalStake: 100000,
+	}
+
+	header := &blocks.BlockHeader{
+		Version:     params.BlockVersionVRFProducer,
+		Height:      1,
+		Parent:      parent[:],
+		Timestamp:   prevHeader.Timestamp + int64(minRound),
+		Producer_ID: pidBytes,
+		VrfProof:    proof,
+	}
+	assert.NoError(t, b.checkProducerEligibility(header, prevHeader))
+	assert.NoError(t, b.validateHeader(header, BFFastAdd))
+
+	// Later rounds remain eligible.
+	header.Timestamp += 3
+	assert.NoError(t, b.checkProducerEligibility(header, prevHeader))
+
+	// An earlier timestamp is not eligible.
+	header.Timestamp = prevHeader.Timestamp + int64(minRound) - 1
+	err = b.checkProducerEligibility(header, prevHeader)
+	assert.Equal(t, ErrorCode(ErrInvalidVRFProof), err.(RuleError).ErrorCode)
+
+	// A future timestamp cannot claim a round which has not started
+	// yet by our clock.
+	future := &blocks.BlockHeader{Timestamp: time.Now().Unix()}
+	header.Timestamp = future.Timestamp + int64(minRound)
+	err = b.checkProducerEligibility(header, future)
+	assert.IsType(t, OrphanBlockError(""), err)
+	future.Timestamp -= int64(minRound)
+	assert.NoError(t, b.checkProducerEligibility(header, future))
+	header.Timestamp = prevHeader.Timestamp + int64(minRound)
+
+	// Proof for a different parent
+	header.Timestamp = prevHeader.Timestamp + int64(minRound)
+	otherParent := randomID()
+	header.Parent = otherParent[:]
+	err = b.checkProducerEligibility(header, prevHeader)
+	assert.Equal(t, ErrorCode(ErrInvalidVRFProof), err.(RuleError).ErrorCode)
+	header.Parent = parent[:]
+
+	// Missing proof
+	proofless := proto.Clone(header).(*blocks.BlockHeader)
+	proofless.VrfProof = nil
+	err = b.validateHeader(proofless, BFFastAdd)
+	assert.Equal(t, ErrorCode(ErrInvalidVRFProof), err.(RuleError).ErrorCode)
+
+	// Proof before activation
+	b.params = &params.RegestParams
+	header.Version = 1
+	err = b.validateHeader(header, BFFastAdd)
+	assert.Equal(t, ErrorCode(ErrInvalidVRFProof), err.(RuleError).ErrorCode)
+
+}
@@ -11,8 +11,9 @@ import (
 )
 
 type proofCacheEntry struct {
-	proof []byte
-	txid  types.ID
+	proof   []byte
+	txid    types.ID
+	version uint32
 }
 
 // ProofCache is used to cache the validation of zero knowledge proofs.
@@ -20,6 +21,10 @@ type proofCacheEntry struct {
 // mempool and once again when a block is connected to the chain. We
 // cache the validated proofs here to avoid having to redo expensive
 // computation.
+//
+// Each proof is cached along with the version of the circuit it was
+// validated against as the version may not be valid at the height of
+// the block the proof is later included in.
 type ProofCache struct {
 	sync.RWMutex
 	validProofs map[types.ID]proofCacheEntry
@@ -35,13 +40,14 @@ func NewProofCache(maxEntries uint) *ProofCache {
 	}
 }
 
-// Exists returns whether the proof exists in the cache.
-func (p *ProofCache) Exists(proofHash types.ID, proof []byte, txid types.ID) bool {
+// Exists returns whether the proof exists in the cache and was validated
+// against the version of its circuit.
+func (p *ProofCache) Exists(proofHash types.ID, proof []byte, txid types.ID, version uint32) bool {
 	p.RLock()
 	entry, ok := p.validProofs[proofHash]
 	p.RUnlock()
 
-	return ok && entry.txid == txid && bytes.Equal(entry.proof, proof)
+	return ok && entry.txid == txid && entry.version == version && bytes.Equal(entry.proof, proof)
 }
 
 // Add will add a new proof to the cache. If the new proof would exceed maxEntries
@@ -49,7 +55,7 @@ func (p *ProofCache) Exists(proofHash types.ID, proof []byte, txid types.ID) boo
 //
 // NOTE: Proofs should be validated before adding to this cache and only valid
 // proofs should ever be added.
-func (p *ProofCache) Add(proofHash types.ID, proof []byte, txid types.ID) {
+func (p *ProofCache) Add(proofHash types.ID, proof []byte, txid types.ID, version uint32) {
 	p.Lock()
 	defer p.Unlock()
 
@@ -74,5 +80,5 @@ func (p *ProofCache) Add(proofHash types.ID, proof []byte, txid types.ID) {
 			break
 		}
 	}
-	p.validProofs[proofHash] = proofCacheEntry{proof, txid}
+	p.validProofs[proofHash] = proofCacheEntry{proof, txid, version}
 }
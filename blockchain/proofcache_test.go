@@ -23,8 +23,9 @@ func TestProofCache(t *testing.T) {
 		txid := randomID()
 
 		proofHash := hash.HashFunc(proof)
-		cache.Add(types.NewID(proofHash), proof, txid)
-		assert.True(t, cache.Exists(types.NewID(proofHash), proof, txid))
+		cache.Add(types.NewID(proofHash), proof, txid, 1)
+		assert.True(t, cache.Exists(types.NewID(proofHash), proof, txid, 1))
+		assert.False(t, cache.Exists(types.NewID(proofHash), proof, txid, 2))
 		assert.LessOrEqual(t, len(cache.validProofs), max)
 	}
 }
@@ -5,298 +5,200 @@
 package blockchain
 
 import (
+	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
-	"github.com/project-illium/ilxd/zk/circuits/stake"
-	"github.com/project-illium/ilxd/zk/circuits/standard"
-	"runtime"
-	"time"
 )
 
-// ValidateTransactionProof validates the zero knowledge proof for a single transaction.
-// proofCache must not be nil. The validator will check whether the proof already exists
-// in the cache. If it does the proof will be assumed to be valid. If not it will
-// validate the proof and add the proof to the cache if valid.
-func ValidateTransactionProof(tx *transactions.Transaction, proofCache *ProofCache) <-chan error {
+// ValidateTransactionProof validates the zero knowledge proof for a single transaction
+// to be included in a block at the height. proofCache must not be nil. The validator
+// will check whether the proof already exists in the cache. If it does the proof will
+// be assumed to be valid. If not it will validate the proof and add the proof to the
+// cache if valid.
+//
+// If prover is nil the default prover is used.
+func ValidateTransactionProof(tx *transactions.Transaction, proofCache *ProofCache, prover zk.Prover, netParams *params.NetworkParams, height uint32) <-chan error {
 	errChan := make(chan error)
 	go func() {
-		validator := NewProofValidator(proofCache)
-		errChan <- validator.Validate([]*transactions.Transaction{tx})
+		validator := NewProofValidator(proofCache, prover, netParams)
+		errChan <- validator.Validate([]*transactions.Transaction{tx}, height)
 		close(errChan)
 	}()
 	return errChan
 }
 
-// proofValidator is used to validate transaction zero knowledge proofs in parallel.
+// proofValidator is used to validate transaction zero knowledge proofs
+// in parallel.
 type proofValidator struct {
 	proofCache *ProofCache
-	workChan   chan *transactions.Transaction
-	resultChan chan error
-	done       chan struct{}
+	prover     zk.Prover
+	params     *params.NetworkParams
+	registry   *zk.CircuitRegistry
 }
 
 // NewProofValidator returns a new ProofValidator.
-// The proofCache must NOT be nil.
-func NewProofValidator(proofCache *ProofCache) *proofValidator {
+// The proofCache and params must NOT be nil. If
+// prover is nil the default prover is used.
+func NewProofValidator(proofCache *ProofCache, prover zk.Prover, netParams *params.NetworkParams) *proofValidator {
+	if prover == nil {
+		prover = zk.DefaultProver()
+	}
 	return &proofValidator{
 		proofCache: proofCache,
-		workChan:   make(chan *transactions.Transaction),
-		resultChan: make(chan error),
-		done:       make(chan struct{}),
+		prover:     prover,
+		params:     netParams,
+		registry:   circuitRegistry,
 	}
 }
 
-// Validate validates the transactions proofs in parallel for fast validation.
-// If a proof already exists in the proofCache, the validation will be skipped.
-// If a proof is valid and does not exist in the cache, it will be added to the
-// cache.
-func (p *proofValidator) Validate(txs []*transactions.Transaction) error {
-	defer close(p.done)
-
-	if len(txs) == 0 {
-		return nil
+// Validate validates the proofs of transactions to be included in a
+// block at the height in parallel for fast validation. If a proof
+// already exists in the proofCache, the validation will be skipped. If
+// the proofs are valid, those which do not exist in the cache will be
+// added to it.
+//
+// The proofs are verified against the latest version of their circuit
+// which is active at the height. Proofs which are invalid for the latest
+// version are then verified against the legacy versions which are still
+// active, if any, so that proofs created before a circuit upgrade remain
+// valid until the legacy version expires.
+func (p *proofValidator) Validate(txs []*transactions.Transaction, height uint32) error {
+	heights := make([]uint32, len(txs))
+	for i := range heights {
+		heights[i] = height
 	}
+	return p.validate(txs, heights)
+}
 
-	maxGoRoutines := runtime.NumCPU() * 3
-	if maxGoRoutines <= 0 {
-		maxGoRoutines = 1
-	}
-	if maxGoRoutines > len(txs) {
-		maxGoRoutines = len(txs)
+// ValidateBlocks is Validate for the transactions of several blocks,
+// each of which is validated at the height of its block.
+func (p *proofValidator) ValidateBlocks(blks []*blocks.Block) error {
+	var (
+		txs     []*transactions.Transaction
+		heights []uint32
+	)
+	for _, blk := range blks {
+		for _, tx := range blk.Transactions {
+			txs = append(txs, tx)
+			heights = append(heights, blk.Header.Height)
+		}
 	}
+	return p.validate(txs, heights)
+}
 
-	for i := 0; i < maxGoRoutines; i++ {
-		go p.validateHandler()
+func (p *proofValidator) validate(txs []*transactions.Transaction, heights []uint32) error {
+	type batchProof struct {
+		proofHash types.ID
+		proof     []byte
+		txid      types.ID
 	}
 
-	go func() {
-		for _, tx := range txs {
-			p.workChan <- tx
+	var (
+		batch    []zk.SnarkProof
+		versions [][]*zk.Circuit
+		proofs   []batchProof
+	)
+	for i, t := range txs {
+		var (
+			proof  []byte
+			txid   types.ID
+			hasZKP = true
+		)
+		switch tx := t.GetTx().(type) {
+		case *transactions.Transaction_StandardTransaction:
+			proof, txid = tx.StandardTransaction.Proof, tx.StandardTransaction.ID()
+		case *transactions.Transaction_CoinbaseTransaction:
+			proof, txid = tx.CoinbaseTransaction.Proof, tx.CoinbaseTransaction.ID()
+		case *transactions.Transaction_TreasuryTransaction:
+			proof, txid = tx.TreasuryTransaction.Proof, tx.TreasuryTransaction.ID()
+		case *transactions.Transaction_MintTransaction:
+			proof, txid = tx.MintTransaction.Proof, tx.MintTransaction.ID()
+		case *transactions.Transaction_StakeTransaction:
+			proof, txid = tx.StakeTransaction.Proof, tx.StakeTransaction.ID()
+		default:
+			hasZKP = false
 		}
-		close(p.workChan)
-	}()
-
-	for i := 0; i < len(txs); i++ {
-		err := <-p.resultChan
+		if !hasZKP {
+			continue
+		}
+		id, snark, err := txSnarkProof(t, p.params, heights[i])
+		if err != nil {
+			return err
+		}
+		circuits, err := activeCircuits(p.registry, p.params, id, heights[i])
 		if err != nil {
 			return err
 		}
+		proofHash := types.NewIDFromData(proof)
+		cached := false
+		for _, circuit := range circuits {
+			if p.proofCache.Exists(proofHash, proof, txid, circuit.Version) {
+				cached = true
+				break
+			}
+		}
+		if cached {
+			continue
+		}
+		snark.Circuit = circuits[0].Verifier
+		batch = append(batch, snark)
+		versions = append(versions, circuits)
+		proofs = append(proofs, batchProof{proofHash, proof, txid})
 	}
-	return nil
-}
-
-func (p *proofValidator) validateHandler() {
-	for {
-		select {
-		case t := <-p.workChan:
-			switch tx := t.GetTx().(type) {
-			case *transactions.Transaction_StandardTransaction:
-				proofHash := types.NewIDFromData(tx.StandardTransaction.Proof)
-				exists := p.proofCache.Exists(proofHash, tx.StandardTransaction.Proof, tx.StandardTransaction.ID())
-				if exists {
-					p.resultChan <- nil
-					break
-				}
-
-				sigHash, err := tx.StandardTransaction.SigHash()
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				outputs := make([]standard.PublicOutput, 0, len(tx.StandardTransaction.Outputs))
-				for _, out := range tx.StandardTransaction.Outputs {
-					outputs = append(outputs, standard.PublicOutput{
-						Commitment: out.Commitment,
-						CipherText: out.Ciphertext,
-					})
-				}
 
-				params := standard.PublicParams{
-					TXORoot:    tx.StandardTransaction.TxoRoot,
-					SigHash:    sigHash,
-					Outputs:    outputs,
-					Nullifiers: tx.StandardTransaction.Nullifiers,
-					Fee:        tx.StandardTransaction.Fee,
-					Coinbase:   0,
-					MintID:     nil,
-					MintAmount: 0,
-				}
-				if tx.StandardTransaction.Locktime != nil {
-					params.Locktime = time.Unix(tx.StandardTransaction.Locktime.Timestamp, 0)
-					params.LocktimePrecision = time.Duration(tx.StandardTransaction.Locktime.Precision)
-				}
-
-				valid, err := zk.ValidateSnark(standard.StandardCircuit, &params, tx.StandardTransaction.Proof)
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				if !valid {
-					p.resultChan <- ruleError(ErrInvalidTx, "invalid zk-snark proof")
-					break
-				}
-				p.proofCache.Add(proofHash, tx.StandardTransaction.Proof, tx.StandardTransaction.ID())
-				p.resultChan <- nil
-			case *transactions.Transaction_CoinbaseTransaction:
-				proofHash := types.NewIDFromData(tx.CoinbaseTransaction.Proof)
-				exists := p.proofCache.Exists(proofHash, tx.CoinbaseTransaction.Proof, tx.CoinbaseTransaction.ID())
-				if exists {
-					p.resultChan <- nil
-					break
-				}
-				sigHash, err := tx.CoinbaseTransaction.SigHash()
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				outputs := make([]standard.PublicOutput, 0, len(tx.CoinbaseTransaction.Outputs))
-				for _, out := range tx.CoinbaseTransaction.Outputs {
-					outputs = append(outputs, standard.PublicOutput{
-						Commitment: out.Commitment,
-						CipherText: out.Ciphertext,
-					})
-				}
-				params := standard.PublicParams{
-					TXORoot:    nil,
-					SigHash:    sigHash,
-					Outputs:    outputs,
-					Nullifiers: nil,
-					Fee:        0,
-					Coinbase:   tx.CoinbaseTransaction.NewCoins,
-					MintID:     nil,
-					MintAmount: 0,
-					Locktime:   time.Time{},
-				}
-				valid, err := zk.ValidateSnark(standard.StandardCircuit, &params, tx.CoinbaseTransaction.Proof)
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				if !valid {
-					p.resultChan <- ruleError(ErrInvalidTx, "invalid zk-snark proof")
-					break
-				}
-				p.proofCache.Add(proofHash, tx.CoinbaseTransaction.Proof, tx.CoinbaseTransaction.ID())
-				p.resultChan <- nil
-			case *transactions.Transaction_TreasuryTransaction:
-				proofHash := types.NewIDFromData(tx.TreasuryTransaction.Proof)
-				exists := p.proofCache.Exists(proofHash, tx.TreasuryTransaction.Proof, tx.TreasuryTransaction.ID())
-				if exists {
-					p.resultChan <- nil
-					break
-				}
-				sigHash, err := tx.TreasuryTransaction.SigHash()
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				outputs := make([]standard.PublicOutput, 0, len(tx.TreasuryTransaction.Outputs))
-				for _, out := range tx.TreasuryTransaction.Outputs {
-					outputs = append(outputs, standard.PublicOutput{
-						Commitment: out.Commitment,
-						CipherText: out.Ciphertext,
-					})
-				}
-				params := standard.PublicParams{
-					TXORoot:    nil,
-					SigHash:    sigHash,
-					Outputs:    outputs,
-					Nullifiers: nil,
-					Fee:        0,
-					Coinbase:   tx.TreasuryTransaction.Amount,
-					MintID:     nil,
-					MintAmount: 0,
-					Locktime:   time.Time{},
-				}
-				valid, err := zk.ValidateSnark(standard.StandardCircuit, &params, tx.TreasuryTransaction.Proof)
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				if !valid {
-					p.resultChan <- ruleError(ErrInvalidTx, "invalid zk-snark proof")
-					break
-				}
-				p.proofCache.Add(proofHash, tx.TreasuryTransaction.Proof, tx.TreasuryTransaction.ID())
-				p.resultChan <- nil
-			case *transactions.Transaction_MintTransaction:
-				proofHash := types.NewIDFromData(tx.MintTransaction.Proof)
-				exists := p.proofCache.Exists(proofHash, tx.MintTransaction.Proof, tx.MintTransaction.ID())
-				if exists {
-					p.resultChan <- nil
-					break
-				}
-				sigHash, err := tx.MintTransaction.SigHash()
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				outputs := make([]standard.PublicOutput, 0, len(tx.MintTransaction.Outputs))
-				for _, out := range tx.MintTransaction.Outputs {
-					outputs = append(outputs, standard.PublicOutput{
-						Commitment: out.Commitment,
-						CipherText: out.Ciphertext,
-					})
-				}
-				params := standard.PublicParams{
-					TXORoot:    tx.MintTransaction.TxoRoot,
-					SigHash:    sigHash,
-					Outputs:    outputs,
-					Nullifiers: tx.MintTransaction.Nullifiers,
-					Fee:        tx.MintTransaction.Fee,
-					Coinbase:   0,
-					MintID:     tx.MintTransaction.Asset_ID,
-					MintAmount: tx.MintTransaction.NewTokens,
-				}
-				if tx.MintTransaction.Locktime != nil {
-					params.Locktime = time.Unix(tx.MintTransaction.Locktime.Timestamp, 0)
-					params.LocktimePrecision = time.Duration(tx.MintTransaction.Locktime.Precision)
-				}
-				valid, err := zk.ValidateSnark(standard.StandardCircuit, &params, tx.MintTransaction.Proof)
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				if !valid {
-					p.resultChan <- ruleError(ErrInvalidTx, "invalid zk-snark proof")
-					break
-				}
-				p.proofCache.Add(proofHash, tx.MintTransaction.Proof, tx.MintTransaction.ID())
-				p.resultChan <- nil
-			case *transactions.Transaction_StakeTransaction:
-				proofHash := types.NewIDFromData(tx.StakeTransaction.Proof)
-				exists := p.proofCache.Exists(proofHash, tx.StakeTransaction.Proof, tx.StakeTransaction.ID())
-				if exists {
-					p.resultChan <- nil
-					break
-				}
-				sigHash, err := tx.StakeTransaction.SigHash()
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				params := stake.PublicParams{
-					TXORoot:     tx.StakeTransaction.TxoRoot,
-					LockedUntil: time.Unix(tx.StakeTransaction.LockedUntil, 0),
-					SigHash:     sigHash,
-					Amount:      tx.StakeTransaction.Amount,
-					Nullifier:   tx.StakeTransaction.Nullifier,
-				}
-				valid, err := zk.ValidateSnark(stake.StakeCircuit, &params, tx.StakeTransaction.Proof)
-				if err != nil {
-					p.resultChan <- err
-					break
-				}
-				if !valid {
-					p.resultChan <- ruleError(ErrInvalidTx, "invalid zk-snark proof")
-					break
-				}
-				p.proofCache.Add(proofHash, tx.StakeTransaction.Proof, tx.StakeTransaction.ID())
-				p.resultChan <- nil
+	_, invalid, err := zk.VerifyProofsConcurrently(p.prover, batch)
+	if err != nil {
+		return err
+	}
+	verified := make([]uint32, len(batch))
+	for i := range batch {
+		verified[i] = versions[i][0].Version
+	}
+	for _, i := range invalid {
+		valid := false
+		for _, circuit := range versions[i][1:] {
+			valid, err = p.prover.VerifyProof(circuit.Verifier, batch[i].PublicParams, batch[i].Proof)
+			if err != nil {
+				return err
+			}
+			if valid {
+				verified[i] = circuit.Version
+				break
 			}
-		case <-p.done:
-			return
 		}
+		if !valid {
+			return ruleError(ErrInvalidProof, "invalid zk-snark proof")
+		}
+	}
+	for i, proof := range proofs {
+		p.proofCache.Add(proof.proofHash, proof.proof, proof.txid, verified[i])
+	}
+	return nil
+}
+
+// txSnarkProof returns the transaction's proof along with its public
+// params at the height and the ID of the circuit it is verified against.
+// The circuit of the proof is left for the caller to select from the
+// registry.
+func txSnarkProof(t *transactions.Transaction, netParams *params.NetworkParams, height uint32) (zk.CircuitID, zk.SnarkProof, error) {
+	id, pub, err := transactions.PublicParamsFromTx(t, transactions.LocktimeSeconds(netParams.LocktimeSecondsActive(height)))
+	if err != nil {
+		return "", zk.SnarkProof{}, err
+	}
+	var proof []byte
+	switch tx := t.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		proof = tx.StandardTransaction.Proof
+	case *transactions.Transaction_CoinbaseTransaction:
+		proof = tx.CoinbaseTransaction.Proof
+	case *transactions.Transaction_TreasuryTransaction:
+		proof = tx.TreasuryTransaction.Proof
+	case *transactions.Transaction_MintTransaction:
+		proof = tx.MintTransaction.Proof
+	case *transactions.Transaction_StakeTransaction:
+		proof = tx.StakeTransaction.Proof
 	}
+	return id, zk.SnarkProof{PublicParams: pub, Proof: proof}, nil
 }
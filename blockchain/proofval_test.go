@@ -6,16 +6,22 @@ package blockchain
 
 import (
 	"crypto/rand"
+	"encoding/binary"
+	"errors"
 	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
 	"github.com/stretchr/testify/assert"
+	"reflect"
 	"testing"
 )
 
 func TestProofValidator(t *testing.T) {
 	proofCache := NewProofCache(10)
-	proofValidator := NewProofValidator(proofCache)
+	proofValidator := NewProofValidator(proofCache, nil, &params.RegestParams)
 
 	salt1, err := types.RandomSalt()
 	assert.NoError(t, err)
@@ -128,10 +134,84 @@ func TestProofValidator(t *testing.T) {
 		transactions.WrapTransaction(mintTx),
 		transactions.WrapTransaction(treasuryTx),
 		transactions.WrapTransaction(coinbaseTx),
-	})
+	}, 1)
 	assert.NoError(t, err)
 
-	c := ValidateTransactionProof(transactions.WrapTransaction(coinbaseTx), NewProofCache(10))
+	c := ValidateTransactionProof(transactions.WrapTransaction(coinbaseTx), NewProofCache(10), nil, &params.RegestParams, 1)
 	err = <-c
 	assert.NoError(t, err)
+
+	c = ValidateTransactionProof(transactions.WrapTransaction(coinbaseTx), NewProofCache(10), &rejectingProver{}, &params.RegestParams, 1)
+	err = <-c
+	assert.Error(t, err)
+
+	// Proofs of the legacy version of a circuit are valid until the
+	// version expires.
+	netParams := params.RegestParams
+	netParams.CircuitVersions = map[string]types.VersionSchedule{
+		string(zk.StandardCircuitID): {
+			{Version: 1, ActivationHeight: 0, ExpirationHeight: 100},
+			{Version: 2, ActivationHeight: 50},
+		},
+		string(zk.StakeCircuitID): {{Version: 1}},
+	}
+	registry := DefaultCircuitRegistry()
+	upgraded := func(privateParams, publicParams interface{}) bool { return true }
+	assert.NoError(t, registry.Register(&zk.Circuit{ID: zk.StandardCircuitID, Version: 2, Verifier: upgraded}))
+	validator := NewProofValidator(NewProofCache(10), &rejectingProver{accept: standard.StandardCircuit}, &netParams)
+	validator.registry = registry
+
+	coinbase := []*transactions.Transaction{transactions.WrapTransaction(coinbaseTx)}
+	assert.NoError(t, validator.Validate(coinbase, 10))
+	assert.NoError(t, validator.Validate(coinbase, 60))
+
+	// The proof is cached as valid for the legacy version which is no
+	// longer valid once it expires.
+	err = validator.Validate(coinbase, 100)
+	assert.IsType(t, RuleError{}, err)
+	assert.Equal(t, ErrorCode(ErrInvalidProof), err.(RuleError).ErrorCode)
+
+	// No version of the circuit is active.
+	netParams.CircuitVersions[string(zk.StandardCircuitID)] = types.VersionSchedule{{Version: 2, ActivationHeight: 50}}
+	err = validator.Validate(coinbase, 10)
+	assert.IsType(t, RuleError{}, err)
+	assert.Equal(t, ErrorCode(ErrInvalidProof), err.(RuleError).ErrorCode)
+}
+
+// rejectingProver rejects all proofs except those of the accepted circuit.
+type rejectingProver struct {
+	accept zk.CircuitFunc
+}
+
+func (p *rejectingProver) CreateProof(circuit zk.CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *rejectingProver) VerifyProof(circuit zk.CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+	if p.accept == nil {
+		return false, nil
+	}
+	return reflect.ValueOf(circuit).Pointer() == reflect.ValueOf(p.accept).Pointer(), nil
+}
+
+func TestTxSnarkProofLocktimePrecision(t *testing.T) {
+	netParams := params.RegestParams
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeLocktimeSeconds: 10}
+
+	tx := transactions.WrapTransaction(&transactions.StandardTransaction{
+		TxoRoot:  make([]byte, 32),
+		Locktime: &transactions.Locktime{Timestamp: 1700000000, Precision: 600},
+	})
+	serializedPrecision := func(height uint32) uint64 {
+		_, snark, err := txSnarkProof(tx, &netParams, height)
+		assert.NoError(t, err)
+		ser, err := snark.PublicParams.(*standard.PublicParams).Serialize()
+		assert.NoError(t, err)
+		return binary.BigEndian.Uint64(ser[len(ser)-8:])
+	}
+
+	// Before the upgrade the precision is passed to the circuit
+	// unscaled and serializes as zero seconds.
+	assert.Equal(t, uint64(0), serializedPrecision(9))
+	assert.Equal(t, uint64(600), serializedPrecision(10))
 }
@@ -0,0 +1,187 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPruneDepth(t *testing.T) {
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	makeChain := func() []*blocks.Block {
+		genesis := params.RegestParams.GenesisBlock
+		var chain []*blocks.Block
+		parent := genesis.ID()
+		for i := uint32(1); i <= 4; i++ {
+			parentID := parent
+			blk := &blocks.Block{
+				Header: &blocks.BlockHeader{
+					Version:   1,
+					Height:    i,
+					Parent:    parentID[:],
+					Timestamp: genesis.Header.Timestamp + int64(i),
+				},
+				Transactions: []*transactions.Transaction{
+					transactions.WrapTransaction(&transactions.TreasuryTransaction{
+						Amount: 1000,
+						Outputs: []*transactions.Output{
+							{
+								Commitment: append([]byte{byte(i)}, make([]byte, types.CommitmentLen-1)...),
+								Ciphertext: make([]byte, CiphertextLen),
+							},
+						},
+						ProposalHash: make([]byte, MaxDocumentHashLen),
+					}),
+				},
+			}
+			assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+			chain = append(chain, blk)
+			parent = blk.ID()
+		}
+		return chain
+	}
+
+	tests := []struct {
+		name        string
+		maxTxoRoots uint
+		retained    uint32
+	}{
+		{
+			name:        "prune depth",
+			maxTxoRoots: 1,
+			retained:    3,
+		},
+		{
+			name:        "recent txo roots",
+			maxTxoRoots: 3,
+			retained:    2,
+		},
+	}
+
+	for _, test := range tests {
+		ds := mock.NewMapDatastore()
+		b, err := NewBlockchain(DefaultOptions(), Datastore(ds), MaxTxoRoots(test.maxTxoRoots), PruneDepth(2))
+		assert.NoError(t, err, test.name)
+
+		dbtx, err := b.ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err, test.name)
+		assert.NoError(t, dsCreditTreasury(dbtx, 20000), test.name)
+		assert.NoError(t, dbtx.Commit(context.Background()), test.name)
+
+		for _, blk := range makeChain() {
+			assert.NoError(t, b.ConnectBlock(blk, BFFastAdd), test.name)
+		}
+
+		pruned, err := b.IsPruned()
+		assert.NoError(t, err, test.name)
+		assert.True(t, pruned, test.name)
+
+		for i := uint32(0); i <= 4; i++ {
+			_, err := b.GetBlockByHeight(i)
+			if i < test.retained {
+				assert.Error(t, err, test.name)
+			} else {
+				assert.NoError(t, err, test.name)
+			}
+		}
+
+		// The recent txo root heights are persisted so restarting
+		// the chain doesn't prune blocks they still reference.
+		assert.NoError(t, b.Close(), test.name)
+		b, err = NewBlockchain(DefaultOptions(), Datastore(ds), MaxTxoRoots(test.maxTxoRoots), PruneDepth(2))
+		assert.NoError(t, err, test.name)
+		for i := uint32(0); i <= 4; i++ {
+			_, err := b.GetBlockByHeight(i)
+			if i < test.retained {
+				assert.Error(t, err, test.name)
+			} else {
+				assert.NoError(t, err, test.name)
+			}
+		}
+	}
+
+	// Enabling pruning on a chain which never persisted the txo
+	// root heights rebuilds them from the blocks and prunes in
+	// batches.
+	ds := mock.NewMapDatastore()
+	b, err := NewBlockchain(DefaultOptions(), Datastore(ds), MaxTxoRoots(3))
+	assert.NoError(t, err)
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	for _, blk := range makeChain() {
+		assert.NoError(t, b.ConnectBlock(blk, BFFastAdd))
+	}
+	assert.NoError(t, b.Close())
+
+	dbtx, err = ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dbtx.Delete(context.Background(), datastore.NewKey(repo.TxoRootHeightsKey)))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	b, err = NewBlockchain(DefaultOptions(), Datastore(ds), MaxTxoRoots(3), PruneDepth(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2, 3, 4}, b.txoRootHeights)
+	for i := uint32(0); i <= 4; i++ {
+		_, err := b.GetBlockByHeight(i)
+		if i < 2 {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestPruneBlocksBatch(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	b := &Blockchain{
+		ds:            ds,
+		pruneDepth:    10,
+		accumulatorDB: &AccumulatorDB{lastFlushHeight: 5000},
+	}
+
+	tipHeight := uint32(pruneBatchSize*2 + 500)
+	dbtx, err := ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	for height := uint32(0); height <= tipHeight; height++ {
+		assert.NoError(t, dsPutBlockIDFromHeight(dbtx, randomID(), height))
+	}
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	var batches int
+	for {
+		dbtx, err := ds.NewTransaction(context.Background(), false)
+		assert.NoError(t, err)
+		prunedHeight, err := b.pruneBlocks(dbtx, tipHeight, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, dbtx.Commit(context.Background()))
+		if prunedHeight == b.prunedHeight {
+			break
+		}
+		assert.LessOrEqual(t, prunedHeight-b.prunedHeight, uint32(pruneBatchSize))
+		b.prunedHeight = prunedHeight
+		batches++
+	}
+	assert.Equal(t, 3, batches)
+	assert.Equal(t, tipHeight+1-b.pruneDepth, b.prunedHeight)
+
+	_, err = dsFetchBlockIDFromHeight(ds, b.prunedHeight-1)
+	assert.Error(t, err)
+	_, err = dsFetchBlockIDFromHeight(ds, b.prunedHeight)
+	assert.NoError(t, err)
+}
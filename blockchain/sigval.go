@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"runtime"
 )
@@ -27,6 +28,91 @@ func ValidateTransactionSig(tx *transactions.Transaction, sigCache *SigCache) <-
 	return errChan
 }
 
+// batchValidateSignatures verifies the block header signature and the
+// signatures of all coinbase and stake transactions in the block
+// concurrently. Signatures that already exist in the sigCache are skipped
+// and valid signatures are added to the cache.
+func (b *Blockchain) batchValidateSignatures(blk *blocks.Block, flags BehaviorFlags) error {
+	type batchSig struct {
+		sigHash    []byte
+		sig        []byte
+		pubkey     crypto.PubKey
+		invalidErr error
+	}
+
+	var (
+		batch = icrypto.NewConcurrentVerifier()
+		sigs  []batchSig
+	)
+	add := func(sigHash, sig []byte, pubkey crypto.PubKey, invalidErr error) {
+		if b.sigCache.Exists(types.NewID(sigHash), sig, pubkey) {
+			return
+		}
+		batch.Add(pubkey, sigHash, sig)
+		sigs = append(sigs, batchSig{sigHash, sig, pubkey, invalidErr})
+	}
+
+	if !flags.HasFlag(BFGenesisValidation) {
+		producerID, err := peer.IDFromBytes(blk.Header.Producer_ID)
+		if err != nil {
+			return ruleError(ErrInvalidProducer, "block producer ID does not decode")
+		}
+		producerPubkey, err := producerID.ExtractPublicKey()
+		if err != nil {
+			return ruleError(ErrInvalidProducer, "block producer pubkey invalid")
+		}
+		sigHash, err := blk.Header.SigHash()
+		if err != nil {
+			return err
+		}
+		add(sigHash, blk.Header.Signature, producerPubkey, ruleError(ErrInvalidHeaderSignature, "invalid signature in header"))
+	}
+
+	for _, t := range blk.Transactions {
+		var (
+			validatorIDBytes []byte
+			sigHash          []byte
+			sig              []byte
+			name             string
+			err              error
+		)
+		switch tx := t.GetTx().(type) {
+		case *transactions.Transaction_CoinbaseTransaction:
+			validatorIDBytes = tx.CoinbaseTransaction.Validator_ID
+			sig = tx.CoinbaseTransaction.Signature
+			name = "coinbase"
+			sigHash, err = tx.CoinbaseTransaction.SigHash()
+		case *transactions.Transaction_StakeTransaction:
+			validatorIDBytes = tx.StakeTransaction.Validator_ID
+			sig = tx.StakeTransaction.Signature
+			name = "stake"
+			sigHash, err = tx.StakeTransaction.SigHash()
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		validatorID, err := peer.IDFromBytes(validatorIDBytes)
+		if err != nil {
+			return ruleError(ErrInvalidTx, name+" tx validator ID does not decode")
+		}
+		validatorPubkey, err := validatorID.ExtractPublicKey()
+		if err != nil {
+			return ruleError(ErrInvalidTx, name+" tx validator pubkey invalid")
+		}
+		add(sigHash, sig, validatorPubkey, ruleError(ErrInvalidTx, name+" tx invalid signature"))
+	}
+
+	if valid, invalid := batch.Verify(); !valid {
+		return sigs[invalid[0]].invalidErr
+	}
+	for _, s := range sigs {
+		b.sigCache.Add(types.NewID(s.sigHash), s.sig, s.pubkey)
+	}
+	return nil
+}
+
 // sigValidator is used to validate transaction signatures in parallel.
 type sigValidator struct {
 	sigCache   *SigCache
@@ -0,0 +1,1217 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/project-illium/ilxd/blockchain/pb"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"sort"
+)
+
+const (
+	// snapshotVersion is the version of the snapshot serialization.
+	snapshotVersion = 1
+
+	// SnapshotCommitmentDelay is the number of blocks between a snapshot
+	// and the block which commits to it. Snapshots are built in the
+	// background so this gives nodes time to finish building them.
+	SnapshotCommitmentDelay = 10
+
+	// SnapshotChunkSize is the maximum size of a snapshot chunk. Snapshots
+	// are stored and served in chunks so that neither the node serving a
+	// snapshot nor the node loading it hold the full snapshot in memory.
+	SnapshotChunkSize = 1 << 20
+
+	// snapshotsRetained is the number of the most recent snapshots kept
+	// on disk. The previous snapshot is kept so that peers which started
+	// downloading it can finish.
+	snapshotsRetained = 2
+
+	// snapshotNullifierBatchSize is the maximum number of nullifiers
+	// written in a single database transaction when loading a snapshot.
+	snapshotNullifierBatchSize = 10000
+
+	// maxSnapshotFieldSize is the maximum size of a length prefixed
+	// field in the snapshot serialization.
+	maxSnapshotFieldSize = 16 * SnapshotChunkSize
+)
+
+var (
+	// ErrInvalidSnapshot is returned when a snapshot fails to deserialize or
+	// its contents are inconsistent.
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+	// ErrSnapshotMismatch is returned when a snapshot does not match the
+	// commitment it is verified against.
+	ErrSnapshotMismatch = errors.New("snapshot does not match commitment")
+
+	// ErrNoSnapshotCheckpoint is returned when loading a snapshot committed
+	// to by a block which is not a checkpoint.
+	ErrNoSnapshotCheckpoint = errors.New("snapshot commitment block is not a checkpoint")
+
+	// ErrSnapshotUnavailable is returned when the node does not have the
+	// requested snapshot.
+	ErrSnapshotUnavailable = errors.New("snapshot not available")
+
+	// ErrSnapshotChainNotNew is returned when loading a snapshot into a
+	// chain which has connected blocks after genesis.
+	ErrSnapshotChainNotNew = errors.New("snapshot can only be loaded into a new chain")
+)
+
+// SnapshotManifest describes a snapshot of the chain state as of a block.
+// The snapshot holds everything a node needs to validate the blocks
+// following it, so a new node can start from a snapshot rather than
+// connecting every block from genesis.
+//
+// The serialized snapshot is split into chunks of at most SnapshotChunkSize
+// bytes and the manifest lists the hash of each chunk. The hash of the
+// serialized manifest is the snapshot commitment which block producers
+// include in the header of the block SnapshotCommitmentDelay blocks after
+// the snapshot.
+type SnapshotManifest struct {
+	Height  uint32
+	BlockID types.ID
+	Chunks  []types.ID
+}
+
+// Commitment returns the snapshot commitment.
+func (m *SnapshotManifest) Commitment() types.ID {
+	return types.NewIDFromData(m.Serialize())
+}
+
+// Serialize serializes the manifest.
+func (m *SnapshotManifest) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(snapshotVersion)
+	writeUint32(&buf, m.Height)
+	buf.Write(m.BlockID[:])
+	writeUint32(&buf, uint32(len(m.Chunks)))
+	for _, h := range m.Chunks {
+		buf.Write(h[:])
+	}
+	return buf.Bytes()
+}
+
+// DeserializeSnapshotManifest deserializes a manifest serialized with
+// Serialize.
+func DeserializeSnapshotManifest(ser []byte) (*SnapshotManifest, error) {
+	r := &byteReader{b: ser}
+	if r.byte() != snapshotVersion {
+		return nil, ErrInvalidSnapshot
+	}
+	m := &SnapshotManifest{
+		Height:  r.uint32(),
+		BlockID: types.NewID(r.next(32)),
+	}
+	m.Chunks = make([]types.ID, r.count(32))
+	for i := range m.Chunks {
+		m.Chunks[i] = types.NewID(r.next(32))
+	}
+	if r.err || len(r.b) > 0 || len(m.Chunks) == 0 {
+		return nil, ErrInvalidSnapshot
+	}
+	return m, nil
+}
+
+// SnapshotCommitmentHeight returns the height of the snapshot which the
+// header of the block at the height must commit to. False is returned if
+// the block does not commit to a snapshot.
+func SnapshotCommitmentHeight(params *params.NetworkParams, height uint32) (uint32, bool) {
+	if params.SnapshotInterval == 0 || height <= SnapshotCommitmentDelay || !params.SnapshotCommitmentsActive(height) {
+		return 0, false
+	}
+	snapshotHeight := height - SnapshotCommitmentDelay
+	return snapshotHeight, snapshotHeight%params.SnapshotInterval == 0
+}
+
+// SnapshotCommitment returns the snapshot commitment which the header of
+// the block at the height must include. If the snapshot is still being
+// built this waits for it to finish. ErrSnapshotUnavailable is returned if
+// the node does not have the snapshot.
+func (b *Blockchain) SnapshotCommitment(height uint32) (types.ID, error) {
+	snapshotHeight, ok := SnapshotCommitmentHeight(b.params, height)
+	if !ok {
+		return types.ID{}, ErrSnapshotUnavailable
+	}
+	b.stateLock.RLock()
+	defer b.stateLock.RUnlock()
+	return b.snapshotCommitment(snapshotHeight)
+}
+
+// SnapshotManifest returns the manifest of the stored snapshot with the
+// commitment.
+func (b *Blockchain) SnapshotManifest(commitment types.ID) (*SnapshotManifest, error) {
+	b.snapshotLock.Lock()
+	defer b.snapshotLock.Unlock()
+
+	for _, build := range b.snapshots {
+		if build.manifest != nil && build.manifest.Commitment() == commitment {
+			return build.manifest, nil
+		}
+	}
+	return nil, ErrSnapshotUnavailable
+}
+
+// SnapshotChunk returns the chunk at the index of the stored snapshot with
+// the commitment.
+func (b *Blockchain) SnapshotChunk(commitment types.ID, index uint32) ([]byte, error) {
+	manifest, err := b.SnapshotManifest(commitment)
+	if err != nil {
+		return nil, err
+	}
+	if index >= uint32(len(manifest.Chunks)) {
+		return nil, ErrSnapshotUnavailable
+	}
+	chunk, err := b.ds.Get(context.Background(), snapshotChunkKey(manifest.BlockID, int(index)))
+	if errors.Is(err, datastore.ErrNotFound) {
+		// The snapshot was pruned after we fetched the manifest.
+		return nil, ErrSnapshotUnavailable
+	}
+	return chunk, err
+}
+
+// LoadSnapshot initializes a new chain from a snapshot rather than by
+// connecting every block from genesis. The commitHeader is the header of
+// the block which commits to the snapshot and must match a checkpoint.
+// fetchChunk is called for each chunk of the manifest which is not already
+// stored and each chunk is verified against the manifest before it is
+// stored.
+//
+// The chain state is replaced in several database transactions. If the
+// node shuts down part way through, NewBlockchain finishes loading the
+// snapshot on the next start.
+//
+// Like a pruned chain, the blocks prior to the snapshot are not available
+// on a chain loaded from a snapshot so it cannot be used with an Indexer.
+func (b *Blockchain) LoadSnapshot(commitHeader *blocks.BlockHeader, manifest *SnapshotManifest, fetchChunk func(index int) ([]byte, error)) error {
+	b.stateLock.Lock()
+	defer b.stateLock.Unlock()
+
+	if b.indexManager != nil {
+		return AssertError("LoadSnapshot: cannot load snapshot with an indexer")
+	}
+	if b.index.Tip().Height() != 0 {
+		return ErrSnapshotChainNotNew
+	}
+	checkpoint, ok := b.CheckpointAtHeight(commitHeader.Height)
+	if !ok || checkpoint.BlockID != commitHeader.ID() {
+		return ErrNoSnapshotCheckpoint
+	}
+	snapshotHeight, ok := SnapshotCommitmentHeight(b.params, commitHeader.Height)
+	if !ok || manifest.Height != snapshotHeight || manifest.Commitment() != types.NewID(commitHeader.SnapshotCommitment) {
+		return ErrSnapshotMismatch
+	}
+
+	// Chunks stored by a previous attempt are not fetched again.
+	for i, h := range manifest.Chunks {
+		key := snapshotChunkKey(manifest.BlockID, i)
+		chunk, err := b.ds.Get(context.Background(), key)
+		if err == nil && types.NewIDFromData(chunk) == h {
+			continue
+		} else if err != nil && !errors.Is(err, datastore.ErrNotFound) {
+			return err
+		}
+		chunk, err = fetchChunk(i)
+		if err != nil {
+			return err
+		}
+		if len(chunk) > SnapshotChunkSize || types.NewIDFromData(chunk) != h {
+			return ErrSnapshotMismatch
+		}
+		if err := b.ds.Put(context.Background(), key, chunk); err != nil {
+			return err
+		}
+	}
+
+	// Check the snapshot decodes before changing the chain state.
+	if _, err := decodeSnapshot(b.snapshotReader(manifest), manifest, nil); err != nil {
+		return err
+	}
+
+	ser := manifest.Serialize()
+	if err := b.ds.Put(context.Background(), snapshotManifestKey(manifest.BlockID), ser); err != nil {
+		return err
+	}
+	if err := b.ds.Put(context.Background(), datastore.NewKey(repo.SnapshotLoadKey), ser); err != nil {
+		return err
+	}
+	return b.applySnapshot(manifest)
+}
+
+// applySnapshot replaces the chain state with the stored snapshot. The
+// nullifiers are written first in batches. As these are only ever added
+// to the set, writing them again when resuming an interrupted load is
+// harmless. The rest of the state is then written in a single transaction
+// which also deletes the key marking the load as in progress.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) applySnapshot(manifest *SnapshotManifest) error {
+	state, err := decodeSnapshot(b.snapshotReader(manifest), manifest, func(nullifiers []types.Nullifier) error {
+		dbtx, err := b.ds.NewTransaction(context.Background(), false)
+		if err != nil {
+			return err
+		}
+		defer dbtx.Discard(context.Background())
+
+		if err := dsPutNullifiers(dbtx, nullifiers); err != nil {
+			return err
+		}
+		return dbtx.Commit(context.Background())
+	})
+	if err != nil {
+		return err
+	}
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Discard(context.Background())
+
+	// Keys are not deleted and put in the same transaction as not every
+	// datastore applies the operations in order.
+	height := state.header.Height
+	txoRoots := make(map[types.ID]bool, len(state.txoRoots))
+	for _, root := range state.txoRoots {
+		txoRoots[root] = true
+	}
+	currentRoots, err := dsFetchTxoRoots(b.ds)
+	if err != nil {
+		return err
+	}
+	for _, root := range currentRoots {
+		if !txoRoots[root] {
+			if err := dsDeleteTxoSetRoot(dbtx, root); err != nil {
+				return err
+			}
+		}
+	}
+	if err := dsDeleteAccumulatorCheckpoints(dbtx); err != nil {
+		return err
+	}
+	if err := dsPutHeader(dbtx, state.header); err != nil {
+		return err
+	}
+	if err := dsPutBlockIDFromHeight(dbtx, manifest.BlockID, height); err != nil {
+		return err
+	}
+	if err := dsPutBlockIndexState(dbtx, &blockNode{blockID: manifest.BlockID, height: height, timestamp: state.header.Timestamp}); err != nil {
+		return err
+	}
+	if err := dsPutAccumulator(dbtx, state.accumulator); err != nil {
+		return err
+	}
+	if err := dsPutAccumulatorLastFlushHeight(dbtx, height); err != nil {
+		return err
+	}
+	if err := b.validatorSet.putRestore(dbtx, state.validators, height); err != nil {
+		return err
+	}
+	for _, root := range state.txoRoots {
+		if err := dsPutTxoSetRoot(dbtx, root); err != nil {
+			return err
+		}
+	}
+	if err := dsPutTreasuryBalance(dbtx, state.treasury); err != nil {
+		return err
+	}
+	if err := dsPutCurrentSupply(dbtx, state.supply); err != nil {
+		return err
+	}
+	if err := dsPutTxoRootHeights(dbtx, nil); err != nil {
+		return err
+	}
+	if err := dsPutPrunedHeight(dbtx, height); err != nil {
+		return err
+	}
+	if err := dbtx.Delete(context.Background(), datastore.NewKey(repo.SnapshotLoadKey)); err != nil {
+		return err
+	}
+	if err := dbtx.Commit(context.Background()); err != nil {
+		return err
+	}
+
+	if err := dsPutAccumulatorConsistencyStatus(b.ds, scsConsistent); err != nil {
+		return err
+	}
+	if err := dsPutValidatorSetConsistencyStatus(b.ds, scsConsistent); err != nil {
+		return err
+	}
+
+	index := NewBlockIndex(b.ds)
+	if err := index.Init(); err != nil {
+		return err
+	}
+	b.index = index
+	b.accumulatorDB.restore(state.accumulator, height)
+	epochBlocks := uint32(0)
+	for _, v := range state.validators {
+		epochBlocks += v.EpochBlocks
+	}
+	b.validatorSet.restore(state.validators, epochBlocks)
+	b.txoRootSet = NewTxoRootSet(b.ds, b.txoRootSet.maxEntries)
+	b.txoRootHeights = nil
+	b.prunedHeight = height
+
+	// The loaded snapshot can be served to other nodes.
+	done := make(chan struct{})
+	close(done)
+	b.snapshotLock.Lock()
+	b.snapshots[manifest.BlockID] = &snapshotBuild{height: height, done: done, manifest: manifest}
+	b.snapshotLock.Unlock()
+	return nil
+}
+
+// snapshotBuild tracks a snapshot which is being or has been built. The
+// done channel is closed once the build finishes, after which either the
+// manifest or err is set.
+type snapshotBuild struct {
+	height   uint32
+	done     chan struct{}
+	manifest *SnapshotManifest
+	err      error
+}
+
+// snapshotState is the chain state captured for a snapshot. The nullifier
+// set is too large to hold in memory so it is streamed from a read only
+// database transaction instead.
+type snapshotState struct {
+	header      *blocks.BlockHeader
+	accumulator *Accumulator
+	txoRoots    []types.ID
+	validators  []*Validator
+	treasury    types.Amount
+	supply      types.Amount
+}
+
+// isSnapshotHeight returns whether a snapshot is taken at the height.
+func (b *Blockchain) isSnapshotHeight(height uint32) bool {
+	interval := b.params.SnapshotInterval
+	return interval > 0 && height > 0 && height%interval == 0 &&
+		b.params.SnapshotCommitmentsActive(height+SnapshotCommitmentDelay)
+}
+
+// maybeSnapshot starts building a snapshot of the chain state if the tip
+// is at a snapshot height. The state is captured here but the snapshot is
+// serialized and stored in the background so that connecting blocks is
+// not held up.
+//
+// Everything but the nullifier set is stored before returning so that the
+// snapshot can be rebuilt by rebuildSnapshot if the build does not finish
+// before the tip moves on.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) maybeSnapshot() error {
+	tip := b.index.Tip()
+	if !b.isSnapshotHeight(tip.Height()) {
+		return nil
+	}
+
+	b.snapshotLock.Lock()
+	_, exists := b.snapshots[tip.ID()]
+	b.snapshotLock.Unlock()
+	if exists {
+		return nil
+	}
+
+	// The nullifiers are read from a read only transaction opened now so
+	// that they match the rest of the captured state.
+	dbtx, err := b.ds.NewTransaction(context.Background(), true)
+	if err != nil {
+		return err
+	}
+	state, err := b.snapshotState(dbtx)
+	if err != nil {
+		dbtx.Discard(context.Background())
+		return err
+	}
+	var buf bytes.Buffer
+	if err := encodeSnapshotState(&buf, state); err != nil {
+		dbtx.Discard(context.Background())
+		return err
+	}
+	if err := b.ds.Put(context.Background(), snapshotStateKey(tip.ID()), buf.Bytes()); err != nil {
+		dbtx.Discard(context.Background())
+		return err
+	}
+	b.buildSnapshot(tip.ID(), tip.Height(), buf.Bytes(), dbtx, nil)
+	return nil
+}
+
+// rebuildSnapshot starts building the snapshot of the block again from the
+// state stored by maybeSnapshot. The nullifier set is taken back to the
+// snapshot block by leaving out the nullifiers recorded in the undo data of
+// the blocks connected since. ErrSnapshotUnavailable is returned if the
+// state is not stored or the block is no longer in the chain.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) rebuildSnapshot(blockID types.ID) error {
+	ser, err := b.ds.Get(context.Background(), snapshotStateKey(blockID))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return ErrSnapshotUnavailable
+	} else if err != nil {
+		return err
+	}
+	r := &streamReader{r: bufio.NewReader(bytes.NewReader(ser))}
+	header := &blocks.BlockHeader{}
+	if r.byte() != snapshotVersion || header.Deserialize(r.bytes()) != nil || r.err != nil || header.ID() != blockID {
+		return ErrInvalidSnapshot
+	}
+	chainID, err := dsFetchBlockIDFromHeight(b.ds, header.Height)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return ErrSnapshotUnavailable
+	} else if err != nil {
+		return err
+	}
+	if chainID != blockID {
+		return ErrSnapshotUnavailable
+	}
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), true)
+	if err != nil {
+		return err
+	}
+	exclude := make(map[types.Nullifier]struct{})
+	for height := header.Height + 1; height <= b.index.Tip().Height(); height++ {
+		id, err := dsFetchBlockIDFromHeightWithTx(dbtx, height)
+		if err != nil {
+			dbtx.Discard(context.Background())
+			return err
+		}
+		undo, err := dsFetchBlockUndo(b.ds, id)
+		if errors.Is(err, ErrNoUndoData) {
+			dbtx.Discard(context.Background())
+			return ErrSnapshotUnavailable
+		} else if err != nil {
+			dbtx.Discard(context.Background())
+			return err
+		}
+		for _, n := range undo.nullifiers {
+			exclude[n] = struct{}{}
+		}
+	}
+	b.buildSnapshot(blockID, header.Height, ser, dbtx, exclude)
+	return nil
+}
+
+// rebuildSnapshots rebuilds the snapshots whose builds did not finish
+// before the node shut down. The stored state of snapshots which can no
+// longer be rebuilt is deleted.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) rebuildSnapshots() error {
+	results, err := b.ds.Query(context.Background(), query.Query{
+		Prefix:   repo.SnapshotStateKeyPrefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		blockID, err := types.NewIDFromString(datastore.NewKey(entry.Key).BaseNamespace())
+		if err != nil {
+			return err
+		}
+		b.snapshotLock.Lock()
+		_, exists := b.snapshots[blockID]
+		b.snapshotLock.Unlock()
+		if exists {
+			continue
+		}
+		err = b.rebuildSnapshot(blockID)
+		if errors.Is(err, ErrSnapshotUnavailable) || errors.Is(err, ErrInvalidSnapshot) {
+			err = b.ds.Delete(context.Background(), snapshotStateKey(blockID))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSnapshot serializes and stores the snapshot of the block in the
+// background. The state is the serialization of everything but the
+// nullifiers, which are streamed from dbtx less those in exclude. The
+// dbtx is discarded once the build finishes. Nothing is done if the
+// snapshot is already being built.
+func (b *Blockchain) buildSnapshot(blockID types.ID, height uint32, state []byte, dbtx datastore.Txn, exclude map[types.Nullifier]struct{}) {
+	build := &snapshotBuild{height: height, done: make(chan struct{})}
+	b.snapshotLock.Lock()
+	if _, exists := b.snapshots[blockID]; exists {
+		b.snapshotLock.Unlock()
+		dbtx.Discard(context.Background())
+		return
+	}
+	b.snapshots[blockID] = build
+	b.snapshotLock.Unlock()
+
+	b.snapshotWg.Add(1)
+	go func() {
+		defer b.snapshotWg.Done()
+		defer dbtx.Discard(context.Background())
+
+		manifest, err := b.writeSnapshot(blockID, height, state, dbtx, exclude)
+		if err != nil {
+			log.Errorf("Error building snapshot at height %d: %s", height, err)
+		}
+
+		b.snapshotLock.Lock()
+		build.manifest, build.err = manifest, err
+		close(build.done)
+		if err != nil {
+			delete(b.snapshots, blockID)
+		}
+		b.snapshotLock.Unlock()
+
+		if err == nil {
+			if err := b.pruneSnapshots(); err != nil {
+				log.Errorf("Error pruning snapshots: %s", err)
+			}
+		}
+	}()
+}
+
+// snapshotState captures the state of the chain at the tip. The dbtx is
+// used to read the state that is stored on disk.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) snapshotState(dbtx datastore.Txn) (*snapshotState, error) {
+	header, err := b.index.Tip().Header()
+	if err != nil {
+		return nil, err
+	}
+	txoRoots, err := dsFetchTxoRoots(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	treasury, err := dsFetchTreasuryBalance(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := dsFetchCurrentSupply(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	validators, _ := b.validatorSet.copyValidators()
+	return &snapshotState{
+		header:      header,
+		accumulator: b.accumulatorDB.Accumulator(),
+		txoRoots:    txoRoots,
+		validators:  validators,
+		treasury:    treasury,
+		supply:      supply,
+	}, nil
+}
+
+// writeSnapshot serializes the snapshot, stores its chunks and finally its
+// manifest. The manifest is only stored once all the chunks are, so a
+// snapshot with a manifest on disk is complete.
+func (b *Blockchain) writeSnapshot(blockID types.ID, height uint32, state []byte, dbtx datastore.Txn, exclude map[types.Nullifier]struct{}) (*SnapshotManifest, error) {
+	w := &chunkWriter{ds: b.ds, blockID: blockID}
+	if _, err := w.Write(state); err != nil {
+		b.deleteSnapshot(blockID)
+		return nil, err
+	}
+	if err := encodeSnapshotNullifiers(w, dbtx, exclude); err != nil {
+		b.deleteSnapshot(blockID)
+		return nil, err
+	}
+	if err := w.flush(); err != nil {
+		b.deleteSnapshot(blockID)
+		return nil, err
+	}
+	manifest := &SnapshotManifest{
+		Height:  height,
+		BlockID: blockID,
+		Chunks:  w.hashes,
+	}
+	if err := b.ds.Put(context.Background(), snapshotManifestKey(blockID), manifest.Serialize()); err != nil {
+		b.deleteSnapshot(blockID)
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// snapshotCommitment returns the commitment of the snapshot of the block at
+// the height in the current chain. If the snapshot is still being built
+// this waits for it to finish.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) snapshotCommitment(height uint32) (types.ID, error) {
+	blockID, err := dsFetchBlockIDFromHeight(b.ds, height)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return types.ID{}, ErrSnapshotUnavailable
+	} else if err != nil {
+		return types.ID{}, err
+	}
+
+	b.snapshotLock.Lock()
+	build, ok := b.snapshots[blockID]
+	b.snapshotLock.Unlock()
+	if !ok {
+		// The build failed or did not finish before the node shut
+		// down. Without the snapshot the block committing to it
+		// cannot be connected so build it again.
+		if err := b.rebuildSnapshot(blockID); err != nil {
+			return types.ID{}, err
+		}
+		b.snapshotLock.Lock()
+		build, ok = b.snapshots[blockID]
+		b.snapshotLock.Unlock()
+		if !ok {
+			return types.ID{}, ErrSnapshotUnavailable
+		}
+	}
+	<-build.done
+	if build.err != nil {
+		return types.ID{}, fmt.Errorf("%w: %s", ErrSnapshotUnavailable, build.err)
+	}
+	return build.manifest.Commitment(), nil
+}
+
+// pruneSnapshots deletes all but the most recent snapshots from disk.
+func (b *Blockchain) pruneSnapshots() error {
+	b.snapshotLock.Lock()
+	defer b.snapshotLock.Unlock()
+
+	type built struct {
+		blockID types.ID
+		height  uint32
+	}
+	var snapshots []built
+	for blockID, build := range b.snapshots {
+		if build.manifest != nil {
+			snapshots = append(snapshots, built{blockID, build.height})
+		}
+	}
+	if len(snapshots) <= snapshotsRetained {
+		return nil
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].height > snapshots[j].height
+	})
+	for _, s := range snapshots[snapshotsRetained:] {
+		if err := b.deleteSnapshot(s.blockID); err != nil {
+			return err
+		}
+		if err := b.ds.Delete(context.Background(), snapshotStateKey(s.blockID)); err != nil {
+			return err
+		}
+		delete(b.snapshots, s.blockID)
+	}
+	return nil
+}
+
+// loadSnapshots loads the manifests of the stored snapshots and deletes
+// any chunks left behind by a build which did not finish. Chunks being
+// downloaded are kept on a new chain so that loading a snapshot can pick
+// up where it left off.
+func (b *Blockchain) loadSnapshots() error {
+	manifests, err := dsFetchSnapshotManifests(b.ds)
+	if err != nil {
+		return err
+	}
+	for _, manifest := range manifests {
+		done := make(chan struct{})
+		close(done)
+		b.snapshots[manifest.BlockID] = &snapshotBuild{height: manifest.Height, done: done, manifest: manifest}
+	}
+	if b.index.Tip().Height() == 0 {
+		return nil
+	}
+
+	results, err := b.ds.Query(context.Background(), query.Query{
+		Prefix:   repo.SnapshotChunkKeyPrefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	orphans := make(map[types.ID]bool)
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return result.Error
+		}
+		blockID, err := types.NewIDFromString(datastore.NewKey(result.Key).Parent().BaseNamespace())
+		if err != nil {
+			return err
+		}
+		if _, ok := b.snapshots[blockID]; !ok {
+			orphans[blockID] = true
+		}
+	}
+	for blockID := range orphans {
+		if err := b.deleteSnapshot(blockID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSnapshot deletes the manifest and chunks of the snapshot of the
+// block from disk.
+func (b *Blockchain) deleteSnapshot(blockID types.ID) error {
+	results, err := b.ds.Query(context.Background(), query.Query{
+		Prefix:   repo.SnapshotChunkKeyPrefix + blockID.String(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	batch, err := b.ds.Batch(context.Background())
+	if err != nil {
+		return err
+	}
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := batch.Delete(context.Background(), datastore.NewKey(result.Key)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Delete(context.Background(), snapshotManifestKey(blockID)); err != nil {
+		return err
+	}
+	return batch.Commit(context.Background())
+}
+
+// snapshotReader returns a reader over the stored chunks of the snapshot.
+// The chunks are checked against the manifest as they are read.
+func (b *Blockchain) snapshotReader(manifest *SnapshotManifest) io.Reader {
+	return &chunkReader{
+		n: len(manifest.Chunks),
+		fetch: func(i int) ([]byte, error) {
+			chunk, err := b.ds.Get(context.Background(), snapshotChunkKey(manifest.BlockID, i))
+			if err != nil {
+				return nil, err
+			}
+			if types.NewIDFromData(chunk) != manifest.Chunks[i] {
+				return nil, ErrSnapshotMismatch
+			}
+			return chunk, nil
+		},
+	}
+}
+
+// encodeSnapshot writes the serialized snapshot to w. The txo roots,
+// validators and nullifiers are sorted so the serialization is canonical
+// and any two nodes taking a snapshot at the same block arrive at the same
+// commitment.
+//
+// The nullifiers are last, with no count, so that they can be streamed from
+// the datastore in a single pass.
+func encodeSnapshot(w io.Writer, state *snapshotState, nullifiers datastore.Read) error {
+	if err := encodeSnapshotState(w, state); err != nil {
+		return err
+	}
+	return encodeSnapshotNullifiers(w, nullifiers, nil)
+}
+
+// encodeSnapshotState writes the serialized snapshot up to the nullifiers
+// to w.
+func encodeSnapshotState(w io.Writer, state *snapshotState) error {
+	header, err := state.header.Serialize()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(snapshotVersion)
+	writeBytes(&buf, header)
+	writeUint64(&buf, uint64(state.treasury))
+	writeUint64(&buf, uint64(state.supply))
+
+	writeUint64(&buf, state.accumulator.nElements)
+	writeUint32(&buf, uint32(len(state.accumulator.acc)))
+	for _, h := range state.accumulator.acc {
+		writeBytes(&buf, h)
+	}
+
+	txoRoots := make([]types.ID, len(state.txoRoots))
+	copy(txoRoots, state.txoRoots)
+	sort.Slice(txoRoots, func(i, j int) bool {
+		return bytes.Compare(txoRoots[i][:], txoRoots[j][:]) < 0
+	})
+	writeUint32(&buf, uint32(len(txoRoots)))
+	for _, r := range txoRoots {
+		buf.Write(r[:])
+	}
+
+	validators := make([]*Validator, len(state.validators))
+	copy(validators, state.validators)
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].PeerID < validators[j].PeerID
+	})
+	writeUint32(&buf, uint32(len(validators)))
+	for _, v := range validators {
+		ser, err := serializeValidatorCanonical(v)
+		if err != nil {
+			return err
+		}
+		writeBytes(&buf, ser)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// encodeSnapshotNullifiers writes the nullifier set, less the nullifiers
+// in exclude, to w in order.
+func encodeSnapshotNullifiers(w io.Writer, nullifiers datastore.Read, exclude map[types.Nullifier]struct{}) error {
+
+	results, err := nullifiers.Query(context.Background(), query.Query{
+		Prefix:   repo.NullifierKeyPrefix,
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	var prev []byte
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return result.Error
+		}
+		n, err := types.NewNullifierFromString(datastore.NewKey(result.Key).BaseNamespace())
+		if err != nil {
+			return err
+		}
+		if prev != nil && bytes.Compare(prev, n[:]) >= 0 {
+			return errors.New("nullifiers not returned in order")
+		}
+		if _, ok := exclude[n]; ok {
+			continue
+		}
+		if _, err := w.Write(n[:]); err != nil {
+			return err
+		}
+		prev = n[:]
+	}
+	return nil
+}
+
+// decodeSnapshot reads a snapshot serialized with encodeSnapshot and checks
+// it is consistent with the manifest. The nullifiers are passed to the
+// nullifiers function in batches as they are read. It may be nil.
+func decodeSnapshot(rd io.Reader, manifest *SnapshotManifest, nullifiers func([]types.Nullifier) error) (*snapshotState, error) {
+	r := &streamReader{r: bufio.NewReader(rd)}
+	if r.byte() != snapshotVersion {
+		return nil, r.error(ErrInvalidSnapshot)
+	}
+
+	state := &snapshotState{header: &blocks.BlockHeader{}}
+	if err := state.header.Deserialize(r.bytes()); err != nil || r.err != nil {
+		return nil, r.error(ErrInvalidSnapshot)
+	}
+	if state.header.ID() != manifest.BlockID || state.header.Height != manifest.Height {
+		return nil, ErrSnapshotMismatch
+	}
+	state.treasury = types.Amount(r.uint64())
+	state.supply = types.Amount(r.uint64())
+
+	nElements := r.uint64()
+	acc := make([][]byte, r.count())
+	for i := range acc {
+		if h := r.bytes(); len(h) > 0 {
+			acc[i] = h
+		}
+	}
+	state.accumulator = NewAccumulatorFromData(acc, nElements)
+
+	state.txoRoots = make([]types.ID, r.count())
+	for i := range state.txoRoots {
+		state.txoRoots[i] = types.NewID(r.next(32))
+		if i > 0 && bytes.Compare(state.txoRoots[i-1][:], state.txoRoots[i][:]) >= 0 {
+			return nil, ErrInvalidSnapshot
+		}
+	}
+	state.validators = make([]*Validator, r.count())
+	for i := range state.validators {
+		v, err := deserializeValidator(r.bytes())
+		if err != nil || r.err != nil {
+			return nil, r.error(ErrInvalidSnapshot)
+		}
+		if i > 0 && state.validators[i-1].PeerID >= v.PeerID {
+			return nil, ErrInvalidSnapshot
+		}
+		state.validators[i] = v
+	}
+	if r.err != nil {
+		return nil, r.error(ErrInvalidSnapshot)
+	}
+
+	// The root of the accumulator must be in the txo root set
+	// as of any block which created outputs.
+	if state.accumulator.NumElements() > 0 {
+		root := state.accumulator.Root()
+		i := sort.Search(len(state.txoRoots), func(i int) bool {
+			return bytes.Compare(state.txoRoots[i][:], root[:]) >= 0
+		})
+		if i == len(state.txoRoots) || state.txoRoots[i] != root {
+			return nil, ErrInvalidSnapshot
+		}
+	}
+
+	var (
+		batch = make([]types.Nullifier, 0, snapshotNullifierBatchSize)
+		prev  *types.Nullifier
+	)
+	for {
+		if _, err := r.r.Peek(1); errors.Is(err, io.EOF) {
+			break
+		}
+		n := types.NewNullifier(r.next(32))
+		if r.err != nil {
+			return nil, r.error(ErrInvalidSnapshot)
+		}
+		if prev != nil && bytes.Compare(prev[:], n[:]) >= 0 {
+			return nil, ErrInvalidSnapshot
+		}
+		prev = &n
+		batch = append(batch, n)
+		if len(batch) == snapshotNullifierBatchSize {
+			if nullifiers != nil {
+				if err := nullifiers(batch); err != nil {
+					return nil, err
+				}
+			}
+			batch = batch[:0]
+		}
+	}
+	if r.err != nil {
+		return nil, r.error(ErrInvalidSnapshot)
+	}
+	if len(batch) > 0 && nullifiers != nil {
+		if err := nullifiers(batch); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// chunkWriter splits the data written to it into chunks of
+// SnapshotChunkSize bytes and stores each chunk as it fills up.
+type chunkWriter struct {
+	ds      repo.Datastore
+	blockID types.ID
+	buf     []byte
+	hashes  []types.ID
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := SnapshotChunkSize - len(w.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		w.buf = append(w.buf, p[:free]...)
+		p = p[free:]
+		if len(w.buf) == SnapshotChunkSize {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flush stores the buffered data as the next chunk.
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.ds.Put(context.Background(), snapshotChunkKey(w.blockID, len(w.hashes)), w.buf); err != nil {
+		return err
+	}
+	w.hashes = append(w.hashes, types.NewIDFromData(w.buf))
+	w.buf = make([]byte, 0, SnapshotChunkSize)
+	return nil
+}
+
+// chunkReader reads the chunks returned by fetch in order as one stream.
+type chunkReader struct {
+	fetch func(i int) ([]byte, error)
+	n     int
+	i     int
+	buf   []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.i >= r.n {
+			return 0, io.EOF
+		}
+		chunk, err := r.fetch(r.i)
+		if err != nil {
+			return 0, err
+		}
+		r.i++
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// streamReader is the streaming counterpart of byteReader. The first
+// error reading from the stream is kept and later reads return zero
+// values.
+type streamReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (r *streamReader) next(n int) []byte {
+	b := make([]byte, n)
+	if r.err != nil {
+		return b
+	}
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		r.err = err
+	}
+	return b
+}
+
+func (r *streamReader) byte() byte {
+	return r.next(1)[0]
+}
+
+func (r *streamReader) uint32() uint32 {
+	return binary.BigEndian.Uint32(r.next(4))
+}
+
+func (r *streamReader) uint64() uint64 {
+	return binary.BigEndian.Uint64(r.next(8))
+}
+
+func (r *streamReader) bytes() []byte {
+	n := r.uint32()
+	if n > maxSnapshotFieldSize {
+		r.err = ErrInvalidSnapshot
+		return nil
+	}
+	return r.next(int(n))
+}
+
+// count reads a number of elements. Every element takes at least a byte
+// so counts larger than maxSnapshotFieldSize are rejected rather than
+// allocated.
+func (r *streamReader) count() int {
+	n := r.uint32()
+	if n > maxSnapshotFieldSize {
+		r.err = ErrInvalidSnapshot
+		return 0
+	}
+	return int(n)
+}
+
+// error returns the error reading from the stream, if it is not the end
+// of the stream, or invalid otherwise.
+func (r *streamReader) error(invalid error) error {
+	if r.err != nil && !errors.Is(r.err, io.EOF) && !errors.Is(r.err, io.ErrUnexpectedEOF) {
+		return r.err
+	}
+	return invalid
+}
+
+// serializeValidatorCanonical serializes the validator with its stakes
+// sorted by nullifier so the serialization is deterministic.
+func serializeValidatorCanonical(v *Validator) ([]byte, error) {
+	ser, err := serializeValidator(v)
+	if err != nil {
+		return nil, err
+	}
+	var vProto pb.DBValidator
+	if err := proto.Unmarshal(ser, &vProto); err != nil {
+		return nil, err
+	}
+	sort.Slice(vProto.Nullifiers, func(i, j int) bool {
+		return bytes.Compare(vProto.Nullifiers[i].Hash, vProto.Nullifiers[j].Hash) < 0
+	})
+	return proto.MarshalOptions{Deterministic: true}.Marshal(&vProto)
+}
+
+func snapshotManifestKey(blockID types.ID) datastore.Key {
+	return datastore.NewKey(repo.SnapshotKeyPrefix + blockID.String())
+}
+
+func snapshotStateKey(blockID types.ID) datastore.Key {
+	return datastore.NewKey(repo.SnapshotStateKeyPrefix + blockID.String())
+}
+
+func snapshotChunkKey(blockID types.ID, index int) datastore.Key {
+	return datastore.NewKey(fmt.Sprintf("%s%s/%010d", repo.SnapshotChunkKeyPrefix, blockID.String(), index))
+}
+
+func dsFetchSnapshotManifests(ds repo.Datastore) ([]*SnapshotManifest, error) {
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix: repo.SnapshotKeyPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*SnapshotManifest
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		manifest, err := DeserializeSnapshotManifest(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+func dsFetchSnapshotLoad(ds repo.Datastore) (*SnapshotManifest, error) {
+	ser, err := ds.Get(context.Background(), datastore.NewKey(repo.SnapshotLoadKey))
+	if err != nil {
+		return nil, err
+	}
+	return DeserializeSnapshotManifest(ser)
+}
+
+func dsFetchTxoRoots(ds datastore.Read) ([]types.ID, error) {
+	results, err := ds.Query(context.Background(), query.Query{
+		Prefix:   repo.TxoRootKeyPrefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var roots []types.ID
+	for result, ok := results.NextSync(); ok; result, ok = results.NextSync() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		root, err := types.NewIDFromString(datastore.NewKey(result.Key).BaseNamespace())
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
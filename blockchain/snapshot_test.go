@@ -0,0 +1,346 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+// stateCommitment returns the hash of the serialized chain state at
+// the tip. It is used to check two chains have the same state.
+func stateCommitment(t *testing.T, b *Blockchain) types.ID {
+	b.stateLock.RLock()
+	defer b.stateLock.RUnlock()
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), true)
+	assert.NoError(t, err)
+	defer dbtx.Discard(context.Background())
+
+	state, err := b.snapshotState(dbtx)
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	assert.NoError(t, encodeSnapshot(&buf, state, dbtx))
+	return types.NewIDFromData(buf.Bytes())
+}
+
+func TestSnapshot(t *testing.T) {
+	netParams := params.RegestParams
+	netParams.SnapshotInterval = 10
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeSnapshotCommitments: 0}
+
+	b, err := NewBlockchain(DefaultOptions(), Params(&netParams))
+	assert.NoError(t, err)
+
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	genesis := params.RegestParams.GenesisBlock
+	makeBlock := func(parent types.ID, height uint32, snapshotCommitment []byte) *blocks.Block {
+		blk := &blocks.Block{
+			Header: &blocks.BlockHeader{
+				Version:            1,
+				Height:             height,
+				Parent:             parent[:],
+				Timestamp:          genesis.Header.Timestamp + int64(height),
+				SnapshotCommitment: snapshotCommitment,
+			},
+			Transactions: []*transactions.Transaction{
+				transactions.WrapTransaction(&transactions.TreasuryTransaction{
+					Amount: 100,
+					Outputs: []*transactions.Output{
+						{
+							Commitment: append([]byte{byte(height)}, make([]byte, types.CommitmentLen-1)...),
+							Ciphertext: make([]byte, CiphertextLen),
+						},
+					},
+					ProposalHash: make([]byte, MaxDocumentHashLen),
+				}),
+			},
+		}
+		assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+		return blk
+	}
+
+	var chain []*blocks.Block
+	parent := genesis.ID()
+	for height := uint32(1); height < 20; height++ {
+		blk := makeBlock(parent, height, nil)
+		assert.NoError(t, b.ConnectBlock(blk, BFFastAdd))
+		chain = append(chain, blk)
+		parent = blk.ID()
+	}
+
+	// The block at height 20 must commit to the snapshot at height 10.
+	commitment, err := b.SnapshotCommitment(20)
+	assert.NoError(t, err)
+	_, err = b.SnapshotCommitment(19)
+	assert.ErrorIs(t, err, ErrSnapshotUnavailable)
+
+	err = b.ConnectBlock(makeBlock(parent, 20, nil), BFFastAdd)
+	assert.IsType(t, RuleError{}, err)
+	assert.Equal(t, ErrorCode(ErrInvalidSnapshotCommitment), err.(RuleError).ErrorCode)
+	err = b.ConnectBlock(makeBlock(parent, 20, make([]byte, 32)), BFFastAdd)
+	assert.IsType(t, RuleError{}, err)
+	assert.Equal(t, ErrorCode(ErrInvalidSnapshotCommitment), err.(RuleError).ErrorCode)
+
+	// Without the snapshot, or the state to rebuild it from, the
+	// commitment cannot be checked and the block is not connected,
+	// but it is not invalid either.
+	commitBlock := makeBlock(parent, 20, commitment[:])
+	b.snapshotLock.Lock()
+	build := b.snapshots[chain[9].ID()]
+	delete(b.snapshots, chain[9].ID())
+	b.snapshotLock.Unlock()
+	snapshotState, err := b.ds.Get(context.Background(), snapshotStateKey(chain[9].ID()))
+	assert.NoError(t, err)
+	assert.NoError(t, b.ds.Delete(context.Background(), snapshotStateKey(chain[9].ID())))
+	err = b.ConnectBlock(commitBlock, BFFastAdd)
+	assert.ErrorIs(t, err, ErrSnapshotUnavailable)
+	_, isRuleError := err.(RuleError)
+	assert.False(t, isRuleError)
+	b.snapshotLock.Lock()
+	b.snapshots[chain[9].ID()] = build
+	b.snapshotLock.Unlock()
+	assert.NoError(t, b.ds.Put(context.Background(), snapshotStateKey(chain[9].ID()), snapshotState))
+
+	assert.NoError(t, b.ConnectBlock(commitBlock, BFFastAdd))
+	chain = append(chain, commitBlock)
+
+	manifest, err := b.SnapshotManifest(commitment)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), manifest.Height)
+	assert.Equal(t, chain[9].ID(), manifest.BlockID)
+	assert.Equal(t, commitment, manifest.Commitment())
+
+	manifest2, err := DeserializeSnapshotManifest(manifest.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, manifest2)
+	_, err = DeserializeSnapshotManifest(manifest.Serialize()[1:])
+	assert.ErrorIs(t, err, ErrInvalidSnapshot)
+
+	state, err := decodeSnapshot(b.snapshotReader(manifest), manifest, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(19000), state.treasury)
+	assert.Len(t, state.validators, 1)
+	assert.Len(t, state.txoRoots, 11)
+
+	// Load the snapshot into a new chain.
+	checkpoint := params.Checkpoint{BlockID: commitBlock.ID(), Height: 20}
+	b2, err := NewBlockchain(DefaultOptions(), Params(&netParams), Datastore(mock.NewMapDatastore()), Checkpoints([]params.Checkpoint{checkpoint}))
+	assert.NoError(t, err)
+
+	fetchChunk := func(i int) ([]byte, error) {
+		return b.SnapshotChunk(commitment, uint32(i))
+	}
+	err = b2.LoadSnapshot(chain[18].Header, manifest, fetchChunk)
+	assert.ErrorIs(t, err, ErrNoSnapshotCheckpoint)
+	err = b2.LoadSnapshot(commitBlock.Header, &SnapshotManifest{Height: 10, BlockID: manifest.BlockID, Chunks: []types.ID{{}}}, fetchChunk)
+	assert.ErrorIs(t, err, ErrSnapshotMismatch)
+	err = b2.LoadSnapshot(commitBlock.Header, manifest, func(i int) ([]byte, error) {
+		return []byte{0x01}, nil
+	})
+	assert.ErrorIs(t, err, ErrSnapshotMismatch)
+
+	assert.NoError(t, b2.LoadSnapshot(commitBlock.Header, manifest, fetchChunk))
+	bestID, height, _ := b2.BestBlock()
+	assert.Equal(t, manifest.BlockID, bestID)
+	assert.Equal(t, uint32(10), height)
+	balance, err := b2.TreasuryBalance()
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(19000), balance)
+	pruned, err := b2.IsPruned()
+	assert.NoError(t, err)
+	assert.True(t, pruned)
+	assert.ErrorIs(t, b2.LoadSnapshot(commitBlock.Header, manifest, fetchChunk), ErrSnapshotChainNotNew)
+
+	// The loaded chain can serve the snapshot and connect the
+	// blocks following it.
+	_, err = b2.SnapshotManifest(commitment)
+	assert.NoError(t, err)
+	for _, blk := range chain[10:] {
+		assert.NoError(t, b2.ConnectBlock(blk, BFFastAdd))
+	}
+	assert.Equal(t, stateCommitment(t, b), stateCommitment(t, b2))
+
+	// A load which was interrupted after the chunks were stored is
+	// finished when the chain is next opened.
+	ds := mock.NewMapDatastore()
+	_, err = NewBlockchain(DefaultOptions(), Params(&netParams), Datastore(ds))
+	assert.NoError(t, err)
+	for i := range manifest.Chunks {
+		chunk, err := fetchChunk(i)
+		assert.NoError(t, err)
+		assert.NoError(t, ds.Put(context.Background(), snapshotChunkKey(manifest.BlockID, i), chunk))
+	}
+	assert.NoError(t, ds.Put(context.Background(), snapshotManifestKey(manifest.BlockID), manifest.Serialize()))
+	assert.NoError(t, ds.Put(context.Background(), datastore.NewKey(repo.SnapshotLoadKey), manifest.Serialize()))
+
+	b3, err := NewBlockchain(DefaultOptions(), Params(&netParams), Datastore(ds), Checkpoints([]params.Checkpoint{checkpoint}))
+	assert.NoError(t, err)
+	bestID, _, _ = b3.BestBlock()
+	assert.Equal(t, manifest.BlockID, bestID)
+	_, err = dsFetchSnapshotLoad(ds)
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+	for _, blk := range chain[10:] {
+		assert.NoError(t, b3.ConnectBlock(blk, BFFastAdd))
+	}
+	assert.Equal(t, stateCommitment(t, b), stateCommitment(t, b3))
+	assert.NoError(t, b.Close())
+}
+
+func TestSnapshotRebuild(t *testing.T) {
+	netParams := params.RegestParams
+	netParams.SnapshotInterval = 10
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeSnapshotCommitments: 0}
+
+	ds := mock.NewMapDatastore()
+	b, err := NewBlockchain(DefaultOptions(), Params(&netParams), Datastore(ds))
+	assert.NoError(t, err)
+
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	genesis := params.RegestParams.GenesisBlock
+	output := func(height uint32, i byte) *transactions.Output {
+		return &transactions.Output{
+			Commitment: append([]byte{byte(height), i}, make([]byte, types.CommitmentLen-2)...),
+			Ciphertext: make([]byte, CiphertextLen),
+		}
+	}
+	makeBlock := func(parent types.ID, height uint32, snapshotCommitment []byte, txs ...*transactions.Transaction) *blocks.Block {
+		blk := &blocks.Block{
+			Header: &blocks.BlockHeader{
+				Version:            1,
+				Height:             height,
+				Parent:             parent[:],
+				Timestamp:          genesis.Header.Timestamp + int64(height),
+				SnapshotCommitment: snapshotCommitment,
+			},
+			Transactions: append([]*transactions.Transaction{
+				transactions.WrapTransaction(&transactions.TreasuryTransaction{
+					Amount:       100,
+					Outputs:      []*transactions.Output{output(height, 0)},
+					ProposalHash: make([]byte, MaxDocumentHashLen),
+				}),
+			}, txs...),
+		}
+		transactions.SortCanonical(blk.Transactions)
+		assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+		return blk
+	}
+
+	// The blocks following the snapshot spend coins so the nullifier
+	// set at the tip differs from the one in the snapshot.
+	var chain []*blocks.Block
+	parent := genesis.ID()
+	for height := uint32(1); height <= 13; height++ {
+		var txs []*transactions.Transaction
+		if height > 10 {
+			nullifier := make([]byte, 32)
+			nullifier[0] = byte(height)
+			root := b.accumulatorDB.Accumulator().Root()
+			txs = append(txs, transactions.WrapTransaction(&transactions.StandardTransaction{
+				Outputs:    []*transactions.Output{output(height, 1)},
+				Nullifiers: [][]byte{nullifier},
+				TxoRoot:    root[:],
+			}))
+		}
+		blk := makeBlock(parent, height, nil, txs...)
+		assert.NoError(t, b.ConnectBlock(blk, BFFastAdd))
+		chain = append(chain, blk)
+		parent = blk.ID()
+
+		// The mock datastore's transactions do not isolate the build
+		// from the blocks connected after it so wait for it here.
+		if height == 10 {
+			b.snapshotWg.Wait()
+		}
+	}
+	commitment, err := b.SnapshotCommitment(20)
+	assert.NoError(t, err)
+	snapshotID := chain[9].ID()
+
+	// Shut down part way through the build, leaving a chunk but no
+	// manifest behind. The snapshot is rebuilt when the chain is
+	// next opened.
+	assert.NoError(t, b.Close())
+	chunk, err := ds.Get(context.Background(), snapshotChunkKey(snapshotID, 0))
+	assert.NoError(t, err)
+	assert.NoError(t, b.deleteSnapshot(snapshotID))
+	assert.NoError(t, ds.Put(context.Background(), snapshotChunkKey(snapshotID, 0), chunk))
+
+	b, err = NewBlockchain(DefaultOptions(), Params(&netParams), Datastore(ds))
+	assert.NoError(t, err)
+	rebuilt, err := b.SnapshotCommitment(20)
+	assert.NoError(t, err)
+	assert.Equal(t, commitment, rebuilt)
+
+	for height := uint32(14); height < 20; height++ {
+		blk := makeBlock(parent, height, nil)
+		assert.NoError(t, b.ConnectBlock(blk, BFFastAdd))
+		parent = blk.ID()
+	}
+
+	// A build which failed is retried when the block committing to the
+	// snapshot is connected.
+	b.snapshotLock.Lock()
+	delete(b.snapshots, snapshotID)
+	b.snapshotLock.Unlock()
+	assert.NoError(t, b.deleteSnapshot(snapshotID))
+
+	assert.NoError(t, b.ConnectBlock(makeBlock(parent, 20, commitment[:]), BFFastAdd))
+	_, err = b.SnapshotManifest(commitment)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Close())
+}
+
+func TestSnapshotChunks(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	data := make([]byte, SnapshotChunkSize*5/2)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	w := &chunkWriter{ds: ds}
+	_, err := w.Write(data[:10])
+	assert.NoError(t, err)
+	_, err = w.Write(data[10:])
+	assert.NoError(t, err)
+	assert.NoError(t, w.flush())
+	assert.Len(t, w.hashes, 3)
+
+	manifest := &SnapshotManifest{Chunks: w.hashes}
+	b := &Blockchain{ds: ds}
+	read, err := io.ReadAll(b.snapshotReader(manifest))
+	assert.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	// Chunks which don't match the manifest are rejected.
+	assert.NoError(t, ds.Put(context.Background(), snapshotChunkKey(types.ID{}, 1), []byte{0x01}))
+	_, err = io.ReadAll(b.snapshotReader(manifest))
+	assert.ErrorIs(t, err, ErrSnapshotMismatch)
+}
@@ -67,6 +67,20 @@ func (t *TxoRootSet) AddRoot(dbtx datastore.Txn, txoRoot types.ID) error {
 	return dsPutTxoSetRoot(dbtx, txoRoot)
 }
 
+// RemoveRoot removes the root from the set using the provided database
+// transaction. This is only used when disconnecting a block.
+//
+// Unlike AddRoot the root is removed from the memory cache here. If the
+// database transaction is rolled back the root will just be loaded from
+// disk on the next lookup.
+func (t *TxoRootSet) RemoveRoot(dbtx datastore.Txn, txoRoot types.ID) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.cache, txoRoot)
+	return dsDeleteTxoSetRoot(dbtx, txoRoot)
+}
+
 // UpdateCache will add the new txoRoot to the memory cache. If the new entry
 // would cause the cache to exceed maxEntires, the oldest entry will be evicted.
 //
@@ -0,0 +1,195 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"sync"
+	"time"
+)
+
+// checkTransactionsContext checks each of the transactions against the
+// current chain state using a pool of validation workers. If more than
+// one transaction is invalid the error for the transaction with the lowest
+// index in the block is returned so that the result does not depend on
+// the order in which the workers finish.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) checkTransactionsContext(txs []*transactions.Transaction, blockTime time.Time, flags BehaviorFlags) error {
+	workers := b.validationWorkers
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	if workers <= 1 {
+		for _, t := range txs {
+			if err := b.checkTransactionContext(t, blockTime, flags); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var (
+		errs = make([]error, len(txs))
+		wg   sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(txs); i += workers {
+				errs[i] = b.checkTransactionContext(txs[i], blockTime, flags)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTransactionContext checks the transaction is sane and valid for the
+// current chain state. This includes checking that its nullifiers are unspent,
+// its txo root exists and that any validator it references is in the validator
+// set. The checks which depend on the other transactions in the block, such
+// as duplicate nullifiers, are not made here.
+//
+// During genesis validation only the sanity of the transaction is checked.
+func (b *Blockchain) checkTransactionContext(t *transactions.Transaction, blockTime time.Time, flags BehaviorFlags) error {
+	if err := CheckTransactionSanity(t, blockTime); err != nil {
+		return err
+	}
+	if flags.HasFlag(BFGenesisValidation) {
+		return nil
+	}
+	switch tx := t.Tx.(type) {
+	case *transactions.Transaction_CoinbaseTransaction:
+		validatorID, err := peer.IDFromBytes(tx.CoinbaseTransaction.Validator_ID)
+		if err != nil {
+			return ruleError(ErrInvalidTx, "coinbase tx validator ID does not decode")
+		}
+		validator, err := b.validatorSet.GetValidator(validatorID)
+		if err != nil {
+			return ruleError(ErrInvalidTx, "validator does not exist in validator set")
+		}
+		if types.Amount(tx.CoinbaseTransaction.NewCoins) != validator.UnclaimedCoins || tx.CoinbaseTransaction.NewCoins == 0 {
+			return ruleError(ErrInvalidTx, "coinbase transaction creates invalid number of coins")
+		}
+	case *transactions.Transaction_StakeTransaction:
+		exists, err := b.txoRootSet.RootExists(types.NewID(tx.StakeTransaction.TxoRoot))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ruleError(ErrInvalidTx, "txo root does not exist in chain")
+		}
+		exists, err = b.nullifierSet.NullifierExists(types.NewNullifier(tx.StakeTransaction.Nullifier))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ruleError(ErrDoubleSpend, "stake tx contains spent nullifier")
+		}
+		valID, err := peer.IDFromBytes(tx.StakeTransaction.Validator_ID)
+		if err != nil {
+			return ruleError(ErrInvalidTx, "stake tx validator ID does not decode")
+		}
+		validator, err := b.validatorSet.GetValidator(valID)
+		if err == nil {
+			stake, exists := validator.Nullifiers[types.NewNullifier(tx.StakeTransaction.Nullifier)]
+			if exists {
+				if stake.Blockstamp.Add(ValidatorExpiration - RestakePeriod).After(blockTime) {
+					return ruleError(ErrRestakeTooEarly, "restake transaction too early")
+				}
+			}
+		}
+	case *transactions.Transaction_StandardTransaction:
+		if err := b.checkNullifiersUnspent(tx.StandardTransaction.Nullifiers); err != nil {
+			return err
+		}
+		exists, err := b.txoRootSet.RootExists(types.NewID(tx.StandardTransaction.TxoRoot))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ruleError(ErrInvalidTx, "txo root does not exist in chain")
+		}
+	case *transactions.Transaction_MintTransaction:
+		if err := b.checkNullifiersUnspent(tx.MintTransaction.Nullifiers); err != nil {
+			return err
+		}
+		exists, err := b.txoRootSet.RootExists(types.NewID(tx.MintTransaction.TxoRoot))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ruleError(ErrInvalidTx, "txo root does not exist in chain")
+		}
+	}
+	return nil
+}
+
+func (b *Blockchain) checkNullifiersUnspent(nullifiers [][]byte) error {
+	for _, n := range nullifiers {
+		exists, err := b.nullifierSet.NullifierExists(types.NewNullifier(n))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ruleError(ErrDoubleSpend, "block contains spent nullifier")
+		}
+	}
+	return nil
+}
+
+// validateSignaturesAndProofs validates the header and transaction signatures
+// and the transaction proofs of the block. With more than one validation worker
+// the signatures and proofs are validated concurrently. The signature error,
+// if any, is returned ahead of the proof error regardless of which finishes
+// first.
+func (b *Blockchain) validateSignaturesAndProofs(blk *blocks.Block, flags BehaviorFlags) error {
+	proofValidator := NewProofValidator(b.proofCache, b.prover, b.params)
+	if b.validationWorkers <= 1 {
+		if err := b.batchValidateSignatures(blk, flags); err != nil {
+			return err
+		}
+		if err := proofValidator.Validate(blk.Transactions, blk.Header.Height); err != nil {
+			return err
+		}
+	} else {
+		var (
+			sigErr   error
+			proofErr error
+			wg       sync.WaitGroup
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			sigErr = b.batchValidateSignatures(blk, flags)
+		}()
+		go func() {
+			defer wg.Done()
+			proofErr = proofValidator.Validate(blk.Transactions, blk.Header.Height)
+		}()
+		wg.Wait()
+		if sigErr != nil {
+			return sigErr
+		}
+		if proofErr != nil {
+			return proofErr
+		}
+	}
+	// Any signatures validated in the batch are in the sigCache
+	// at this point and will not be validated a second time.
+	sigValidator := NewSigValidator(b.sigCache)
+	return sigValidator.Validate(blk.Transactions)
+}
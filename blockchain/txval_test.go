@@ -0,0 +1,60 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCheckTransactionsContext(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	b := Blockchain{
+		ds:           ds,
+		txoRootSet:   NewTxoRootSet(ds, 10),
+		nullifierSet: NewNullifierSet(ds, 100),
+	}
+	txoRoot := randomID()
+	b.txoRootSet.cache[txoRoot] = time.Now()
+
+	txs := make([]*transactions.Transaction, 20)
+	for i := range txs {
+		nullifier := randomID()
+		txs[i] = transactions.WrapTransaction(&transactions.StandardTransaction{
+			Outputs: []*transactions.Output{
+				{
+					Commitment: make([]byte, types.CommitmentLen),
+					Ciphertext: make([]byte, CiphertextLen),
+				},
+			},
+			Nullifiers: [][]byte{nullifier[:]},
+			TxoRoot:    txoRoot[:],
+		})
+	}
+
+	for _, workers := range []int{0, 1, 4, 32} {
+		b.validationWorkers = workers
+		assert.NoError(t, b.checkTransactionsContext(txs, time.Now(), BFNone))
+	}
+
+	// The error for the lowest index must be returned regardless
+	// of the order in which the workers finish.
+	missingRoot := randomID()
+	txs[5].GetStandardTransaction().TxoRoot = missingRoot[:]
+	b.nullifierSet.cachedEntries[types.NewNullifier(txs[14].GetStandardTransaction().Nullifiers[0])] = true
+
+	for _, workers := range []int{1, 4, 32} {
+		b.validationWorkers = workers
+		for i := 0; i < 10; i++ {
+			err := b.checkTransactionsContext(txs, time.Now(), BFNone)
+			assert.Error(t, err)
+			assert.Equal(t, ruleError(ErrInvalidTx, "").ErrorCode, err.(RuleError).ErrorCode)
+		}
+	}
+}
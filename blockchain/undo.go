@@ -0,0 +1,382 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+)
+
+// MaxReorgDepth is the number of blocks from the tip for which undo data
+// is kept. Blocks deeper than this cannot be disconnected.
+const MaxReorgDepth = 1000
+
+// blockUndoVersion is the version of the block undo serialization.
+const blockUndoVersion = 1
+
+var (
+	// ErrNoUndoData is returned when disconnecting a block for which
+	// there is no undo data, either because it is deeper than
+	// MaxReorgDepth or it was connected before undo data was stored.
+	ErrNoUndoData = errors.New("block undo data not found")
+
+	// ErrDisconnectGenesis is returned when attempting to disconnect
+	// the genesis block.
+	ErrDisconnectGenesis = errors.New("cannot disconnect genesis block")
+
+	// ErrReorgTooDeep is returned when a reorganization would disconnect
+	// more than MaxReorgDepth blocks.
+	ErrReorgTooDeep = errors.New("reorganization deeper than max reorg depth")
+
+	// ErrIndexerDisconnect is returned when disconnecting a block while
+	// using an IndexManager which does not implement IndexDisconnecter.
+	ErrIndexerDisconnect = errors.New("index manager does not support disconnecting blocks")
+
+	// ErrChainHalted is returned when changing the chain state after
+	// a reorganization failed and the original branch could not be
+	// restored. The node must be restarted to resume syncing.
+	ErrChainHalted = errors.New("chain halted after failed reorganization")
+)
+
+// IndexDisconnecter is implemented by an IndexManager which can remove
+// a disconnected block from its indexes. Blocks can only be disconnected
+// from a chain using an IndexManager if it implements this interface.
+type IndexDisconnecter interface {
+	DisconnectBlock(dbtx datastore.Txn, blk *blocks.Block) error
+}
+
+// blockUndo holds the chain state changed by connecting a block, as it was
+// prior to the block, so that the block can be disconnected.
+type blockUndo struct {
+	// accumulator is the accumulator before the block's
+	// commitments were inserted.
+	accumulator *Accumulator
+	// txoRoot is the root added to the txo root set by the
+	// block, if any.
+	txoRoot *types.ID
+	// nullifiers are the nullifiers added to the nullifier set
+	// by the block, including any banned by the validator set.
+	nullifiers []types.Nullifier
+	// validators and epochBlocks are the validator set before
+	// the block.
+	validators      []*Validator
+	epochBlocks     uint32
+	treasuryBalance types.Amount
+	currentSupply   types.Amount
+}
+
+func (u *blockUndo) serialize() ([]byte, error) {
+	acc, err := SerializeAccumulator(u.accumulator)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(blockUndoVersion)
+	writeBytes(&buf, acc)
+	if u.txoRoot != nil {
+		buf.WriteByte(1)
+		buf.Write(u.txoRoot[:])
+	} else {
+		buf.WriteByte(0)
+	}
+	writeUint32(&buf, uint32(len(u.nullifiers)))
+	for _, n := range u.nullifiers {
+		buf.Write(n[:])
+	}
+	writeUint32(&buf, uint32(len(u.validators)))
+	for _, v := range u.validators {
+		ser, err := serializeValidator(v)
+		if err != nil {
+			return nil, err
+		}
+		writeBytes(&buf, ser)
+	}
+	writeUint32(&buf, u.epochBlocks)
+	writeUint64(&buf, uint64(u.treasuryBalance))
+	writeUint64(&buf, uint64(u.currentSupply))
+	return buf.Bytes(), nil
+}
+
+func deserializeBlockUndo(ser []byte) (*blockUndo, error) {
+	r := &byteReader{b: ser}
+	if r.byte() != blockUndoVersion {
+		return nil, errors.New("unknown block undo version")
+	}
+	acc, err := DeserializeAccumulator(r.bytes())
+	if err != nil {
+		return nil, err
+	}
+	u := &blockUndo{accumulator: acc}
+	if r.byte() == 1 {
+		root := types.NewID(r.next(32))
+		u.txoRoot = &root
+	}
+	u.nullifiers = make([]types.Nullifier, r.count(32))
+	for i := range u.nullifiers {
+		u.nullifiers[i] = types.NewNullifier(r.next(32))
+	}
+	u.validators = make([]*Validator, r.count(4))
+	for i := range u.validators {
+		v, err := deserializeValidator(r.bytes())
+		if err != nil {
+			return nil, err
+		}
+		u.validators[i] = v
+	}
+	u.epochBlocks = r.uint32()
+	u.treasuryBalance = types.Amount(r.uint64())
+	u.currentSupply = types.Amount(r.uint64())
+	if r.err || len(r.b) > 0 {
+		return nil, errors.New("malformed block undo data")
+	}
+	return u, nil
+}
+
+func dsPutBlockUndo(dbtx datastore.Txn, blockID types.ID, undo *blockUndo) error {
+	ser, err := undo.serialize()
+	if err != nil {
+		return err
+	}
+	return dbtx.Put(context.Background(), datastore.NewKey(repo.BlockUndoKeyPrefix+blockID.String()), ser)
+}
+
+func dsFetchBlockUndo(ds repo.Datastore, blockID types.ID) (*blockUndo, error) {
+	ser, err := ds.Get(context.Background(), datastore.NewKey(repo.BlockUndoKeyPrefix+blockID.String()))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrNoUndoData
+	} else if err != nil {
+		return nil, err
+	}
+	return deserializeBlockUndo(ser)
+}
+
+func dsBlockUndoExists(ds repo.Datastore, blockID types.ID) (bool, error) {
+	return ds.Has(context.Background(), datastore.NewKey(repo.BlockUndoKeyPrefix+blockID.String()))
+}
+
+func dsDeleteBlockUndo(dbtx datastore.Txn, blockID types.ID) error {
+	return dbtx.Delete(context.Background(), datastore.NewKey(repo.BlockUndoKeyPrefix+blockID.String()))
+}
+
+// DisconnectBlock disconnects the block at the tip of the chain, restoring
+// the chain state to what it was prior to the block, and returns the block.
+// Only blocks within MaxReorgDepth of the tip can be disconnected.
+func (b *Blockchain) DisconnectBlock() (*blocks.Block, error) {
+	b.stateLock.Lock()
+	defer b.stateLock.Unlock()
+
+	if b.halted {
+		return nil, ErrChainHalted
+	}
+	return b.disconnectBlock()
+}
+
+// disconnectBlock disconnects the block at the tip of the chain.
+//
+// The caller must hold the stateLock.
+func (b *Blockchain) disconnectBlock() (*blocks.Block, error) {
+	tip := b.index.Tip()
+	if tip.Height() == 0 {
+		return nil, ErrDisconnectGenesis
+	}
+	var disconnecter IndexDisconnecter
+	if b.indexManager != nil {
+		d, ok := b.indexManager.(IndexDisconnecter)
+		if !ok {
+			return nil, ErrIndexerDisconnect
+		}
+		disconnecter = d
+	}
+
+	undo, err := dsFetchBlockUndo(b.ds, tip.ID())
+	if err != nil {
+		return nil, err
+	}
+	blk, err := tip.Block()
+	if err != nil {
+		return nil, err
+	}
+	parent, err := tip.Parent()
+	if err != nil {
+		return nil, err
+	}
+	parentHeader, err := parent.Header()
+	if err != nil {
+		return nil, err
+	}
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	if err != nil {
+		return nil, err
+	}
+	defer dbtx.Discard(context.Background())
+
+	if err := dsDeleteBlock(dbtx, tip.ID()); err != nil {
+		return nil, err
+	}
+	if err := dsDeleteBlockIDFromHeight(dbtx, tip.Height()); err != nil {
+		return nil, err
+	}
+	if err := dsPutBlockIndexState(dbtx, &blockNode{blockID: parentHeader.ID(), height: parentHeader.Height, timestamp: parentHeader.Timestamp}); err != nil {
+		return nil, err
+	}
+	if err := dsDeleteBlockUndo(dbtx, tip.ID()); err != nil {
+		return nil, err
+	}
+	if err := b.nullifierSet.RemoveNullifiers(dbtx, undo.nullifiers); err != nil {
+		return nil, err
+	}
+	if undo.txoRoot != nil {
+		if err := b.txoRootSet.RemoveRoot(dbtx, *undo.txoRoot); err != nil {
+			return nil, err
+		}
+	}
+	if err := dsPutTreasuryBalance(dbtx, undo.treasuryBalance); err != nil {
+		return nil, err
+	}
+	if err := dsPutCurrentSupply(dbtx, undo.currentSupply); err != nil {
+		return nil, err
+	}
+	if tip.Height()%accumulatorCheckpointInterval == 0 {
+		if err := dsDeleteAccumulatorCheckpoint(dbtx, tip.Height()); err != nil {
+			return nil, err
+		}
+	}
+	if disconnecter != nil {
+		if err := disconnecter.DisconnectBlock(dbtx, blk); err != nil {
+			return nil, err
+		}
+	}
+	txoRootHeights := b.txoRootHeights
+	if n := len(txoRootHeights); n > 0 && txoRootHeights[n-1] == tip.Height() {
+		txoRootHeights = txoRootHeights[:n-1]
+		if err := dsPutTxoRootHeights(dbtx, txoRootHeights); err != nil {
+			return nil, err
+		}
+	}
+
+	// The validator set and accumulator are written in the same
+	// transaction as their last flush height may be ahead of the
+	// new tip, in which case they could not be repaired on start up.
+	if err := b.validatorSet.putRestore(dbtx, undo.validators, parentHeader.Height); err != nil {
+		return nil, err
+	}
+	if err := dsPutAccumulator(dbtx, undo.accumulator); err != nil {
+		return nil, err
+	}
+	if err := dsPutAccumulatorLastFlushHeight(dbtx, parentHeader.Height); err != nil {
+		return nil, err
+	}
+	if err := dbtx.Commit(context.Background()); err != nil {
+		return nil, err
+	}
+
+	b.index.RollbackIndex(parentHeader)
+	b.txoRootHeights = txoRootHeights
+	b.validatorSet.restore(undo.validators, undo.epochBlocks)
+	b.accumulatorDB.restore(undo.accumulator, parentHeader.Height)
+
+	b.sendNotification(NTBlockDisconnected, blk)
+	return blk, nil
+}
+
+// ReorganizeChain switches the chain to a competing branch. The blocks from
+// the tip back to the parent of the first new block are disconnected and the
+// new blocks, which must be in order, are connected in their place using the
+// flags. If any new block fails to connect the original branch is restored,
+// with full validation, and the error is returned.
+//
+// Each block is connected and disconnected atomically. If the original
+// branch cannot be restored the chain is halted and ErrChainHalted is
+// returned by any further attempt to change the chain state.
+//
+// This is used to recover when the network has finalized a block on a
+// different branch than the one the node has connected.
+func (b *Blockchain) ReorganizeChain(newBlocks []*blocks.Block, flags BehaviorFlags) error {
+	b.stateLock.Lock()
+	defer b.stateLock.Unlock()
+
+	if b.halted {
+		return ErrChainHalted
+	}
+	if len(newBlocks) == 0 {
+		return nil
+	}
+	forkID := types.NewID(newBlocks[0].Header.Parent)
+	forkHeight := newBlocks[0].Header.Height - 1
+	tip := b.index.Tip()
+	if newBlocks[0].Header.Height == 0 || forkHeight > tip.Height() {
+		return ruleError(ErrDoesNotConnect, "reorganization does not connect to chain")
+	}
+	forkNode, err := b.index.GetNodeByHeight(forkHeight)
+	if err != nil {
+		return err
+	}
+	if forkNode.ID() != forkID {
+		return ruleError(ErrDoesNotConnect, "reorganization does not connect to chain")
+	}
+	if tip.Height()-forkHeight > MaxReorgDepth {
+		return ErrReorgTooDeep
+	}
+
+	// Make sure every block can be disconnected before changing
+	// anything.
+	if b.indexManager != nil {
+		if _, ok := b.indexManager.(IndexDisconnecter); !ok {
+			return ErrIndexerDisconnect
+		}
+	}
+	for height := tip.Height(); height > forkHeight; height-- {
+		node, err := b.index.GetNodeByHeight(height)
+		if err != nil {
+			return err
+		}
+		exists, err := dsBlockUndoExists(b.ds, node.ID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrNoUndoData
+		}
+	}
+
+	var disconnected []*blocks.Block
+	restore := func(reorgErr error) error {
+		for b.index.Tip().Height() > forkHeight {
+			if _, err := b.disconnectBlock(); err != nil {
+				b.halted = true
+				log.Errorf("Reorganize Chain: Error disconnecting block: %s", err.Error())
+				return ErrChainHalted
+			}
+		}
+		for i := len(disconnected) - 1; i >= 0; i-- {
+			if err := b.connectBlock(disconnected[i], BFNone); err != nil {
+				b.halted = true
+				log.Errorf("Reorganize Chain: Error reconnecting block: %s", err.Error())
+				return ErrChainHalted
+			}
+		}
+		return reorgErr
+	}
+
+	for b.index.Tip().Height() > forkHeight {
+		blk, err := b.disconnectBlock()
+		if err != nil {
+			return restore(err)
+		}
+		disconnected = append(disconnected, blk)
+	}
+	for _, blk := range newBlocks {
+		if err := b.connectBlock(blk, flags); err != nil {
+			return restore(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDisconnectBlock(t *testing.T) {
+	b, err := NewBlockchain(DefaultOptions())
+	assert.NoError(t, err)
+
+	validatorKey, err := crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
+	assert.NoError(t, err)
+
+	dbtx, err := b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsCreditTreasury(dbtx, 20000))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+
+	_, err = b.DisconnectBlock()
+	assert.ErrorIs(t, err, ErrDisconnectGenesis)
+
+	makeBlock := func(parent types.ID, height uint32, timestamp int64, amount uint64, commitment byte) *blocks.Block {
+		blk := &blocks.Block{
+			Header: &blocks.BlockHeader{
+				Version:   1,
+				Height:    height,
+				Parent:    parent[:],
+				Timestamp: timestamp,
+			},
+			Transactions: []*transactions.Transaction{
+				transactions.WrapTransaction(&transactions.TreasuryTransaction{
+					Amount: amount,
+					Outputs: []*transactions.Output{
+						{
+							Commitment: append([]byte{commitment}, make([]byte, types.CommitmentLen-1)...),
+							Ciphertext: make([]byte, CiphertextLen),
+						},
+					},
+					ProposalHash: make([]byte, MaxDocumentHashLen),
+				}),
+			},
+		}
+		assert.NoError(t, finalizeAndSignBlock(blk, validatorKey))
+		return blk
+	}
+	commitment := func() types.ID {
+		return stateCommitment(t, b)
+	}
+
+	genesis := params.RegestParams.GenesisBlock
+	blk1 := makeBlock(genesis.ID(), 1, genesis.Header.Timestamp+1, 10000, 1)
+	assert.NoError(t, b.ConnectBlock(blk1, BFFastAdd))
+	c1 := commitment()
+
+	// The second block starts a new epoch so the supply and
+	// treasury are credited.
+	blk2 := makeBlock(blk1.ID(), 2, genesis.Header.Timestamp+params.RegestParams.EpochLength+1, 5000, 2)
+	assert.NoError(t, b.ConnectBlock(blk2, BFFastAdd))
+	c2 := commitment()
+	assert.NotEqual(t, c1, c2)
+
+	blk, err := b.DisconnectBlock()
+	assert.NoError(t, err)
+	assert.Equal(t, blk2.ID(), blk.ID())
+	assert.Equal(t, c1, commitment())
+	bestID, height, _ := b.BestBlock()
+	assert.Equal(t, blk1.ID(), bestID)
+	assert.Equal(t, uint32(1), height)
+	assert.False(t, b.HasBlock(blk2.ID()))
+
+	// Reconnecting the block arrives at the same state.
+	assert.NoError(t, b.ConnectBlock(blk2, BFFastAdd))
+	assert.Equal(t, c2, commitment())
+
+	// Reorg to a competing block at height two.
+	blk2b := makeBlock(blk1.ID(), 2, genesis.Header.Timestamp+params.RegestParams.EpochLength+1, 2000, 3)
+	assert.NoError(t, b.ReorganizeChain([]*blocks.Block{blk2b}, BFFastAdd))
+	bestID, height, _ = b.BestBlock()
+	assert.Equal(t, blk2b.ID(), bestID)
+	assert.Equal(t, uint32(2), height)
+	assert.NotEqual(t, c2, commitment())
+
+	// A reorg to an invalid branch restores the original branch.
+	c2b := commitment()
+	blk2c := makeBlock(blk1.ID(), 2, genesis.Header.Timestamp+params.RegestParams.EpochLength+1, 3000, 4)
+	blk2c.Header.Signature[0] ^= 0xff
+	assert.Error(t, b.ReorganizeChain([]*blocks.Block{blk2c}, BFNone))
+	bestID, _, _ = b.BestBlock()
+	assert.Equal(t, blk2b.ID(), bestID)
+	assert.Equal(t, c2b, commitment())
+
+	// The restored validator set and accumulator are written with
+	// the disconnected block so a reloaded chain has the same state.
+	b2, err := NewBlockchain(DefaultOptions(), Datastore(b.ds))
+	assert.NoError(t, err)
+	assert.Equal(t, c2b, stateCommitment(t, b2))
+
+	// A reorganization is rejected without changing the chain if a
+	// block it would disconnect has no undo data.
+	dbtx, err = b.ds.NewTransaction(context.Background(), false)
+	assert.NoError(t, err)
+	assert.NoError(t, dsDeleteBlockUndo(dbtx, blk2b.ID()))
+	assert.NoError(t, dbtx.Commit(context.Background()))
+	blk2d := makeBlock(blk1.ID(), 2, genesis.Header.Timestamp+params.RegestParams.EpochLength+1, 4000, 6)
+	assert.ErrorIs(t, b.ReorganizeChain([]*blocks.Block{blk2d}, BFFastAdd), ErrNoUndoData)
+	bestID, _, _ = b.BestBlock()
+	assert.Equal(t, blk2b.ID(), bestID)
+	assert.Equal(t, c2b, commitment())
+
+	// Branches which do not connect to the chain are rejected.
+	blk3 := makeBlock(types.ID{0x01}, 3, genesis.Header.Timestamp+params.RegestParams.EpochLength+2, 1000, 5)
+	err = b.ReorganizeChain([]*blocks.Block{blk3}, BFFastAdd)
+	assert.True(t, ErrorIs(err, ErrDoesNotConnect))
+}
@@ -6,8 +6,11 @@ package blockchain
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params/hash"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -101,11 +104,27 @@ func (b *Blockchain) checkBlockContext(header *blocks.BlockHeader) error {
 	if !b.validatorSet.ValidatorExists(producerID) {
 		return ruleError(ErrInvalidProducer, "block producer not in validator set")
 	}
-	if len(b.params.Checkpoints) > 0 && header.Height <= b.params.Checkpoints[len(b.params.Checkpoints)-1].Height {
-		for _, checkpoint := range b.params.Checkpoints {
-			if header.Height == checkpoint.Height && header.ID() != checkpoint.BlockID {
-				return ruleError(ErrInvalidCheckpoint, "block ID does not match checkpoint")
-			}
+	if b.params.VRFProducerActive(header.Height) {
+		if err := b.checkProducerEligibility(header, prevHeader); err != nil {
+			return err
+		}
+	}
+	checkpoint, isCheckpoint := b.CheckpointAtHeight(header.Height)
+	if isCheckpoint && header.ID() != checkpoint.BlockID {
+		return ruleError(ErrInvalidCheckpoint, "block ID does not match checkpoint")
+	}
+	// The commitment of a checkpointed block is fixed by the checkpoint.
+	if snapshotHeight, ok := SnapshotCommitmentHeight(b.params, header.Height); ok && !isCheckpoint {
+		// Every node must check the commitment so a node which does not
+		// have the snapshot refuses to connect the block rather than
+		// accepting it unchecked. This is not a rule error as the block
+		// itself may well be valid.
+		commitment, err := b.snapshotCommitment(snapshotHeight)
+		if err != nil {
+			return fmt.Errorf("unable to verify snapshot commitment at height %d: %w", header.Height, err)
+		}
+		if types.NewID(header.SnapshotCommitment) != commitment {
+			return ruleError(ErrInvalidSnapshotCommitment, "block snapshot commitment does not match snapshot")
 		}
 	}
 	return nil
@@ -113,7 +132,10 @@ func (b *Blockchain) checkBlockContext(header *blocks.BlockHeader) error {
 
 // validateHeader validates the transaction header. No blockchain context is needed for this validation.
 func (b *Blockchain) validateHeader(header *blocks.BlockHeader, flags BehaviorFlags) error {
-	if !flags.HasFlag(BFGenesisValidation) {
+	if err := b.checkHeaderSanity(header, flags); err != nil {
+		return err
+	}
+	if !flags.HasFlag(BFGenesisValidation) && !flags.HasFlag(BFFastAdd) {
 		producerID, err := peer.IDFromBytes(header.Producer_ID)
 		if err != nil {
 			return ruleError(ErrInvalidProducer, "block producer ID does not decode")
@@ -124,18 +146,49 @@ func (b *Blockchain) validateHeader(header *blocks.BlockHeader, flags BehaviorFl
 			return ruleError(ErrInvalidProducer, "block producer pubkey invalid")
 		}
 
-		if !flags.HasFlag(BFFastAdd) {
-			sigHash, err := header.SigHash()
-			if err != nil {
-				return err
-			}
-			valid, err := producerPubkey.Verify(sigHash, header.Signature)
-			if !valid {
-				return ruleError(ErrInvalidHeaderSignature, "invalid signature in header")
-			}
-			if err != nil {
-				return err
-			}
+		sigHash, err := header.SigHash()
+		if err != nil {
+			return err
+		}
+		valid, err := producerPubkey.Verify(sigHash, header.Signature)
+		if !valid {
+			return ruleError(ErrInvalidHeaderSignature, "invalid signature in header")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHeaderSanity performs the checks of validateHeader other than
+// verifying the header signature.
+func (b *Blockchain) checkHeaderSanity(header *blocks.BlockHeader, flags BehaviorFlags) error {
+	if err := b.params.BlockVersions.ValidateForHeight(header.Version, header.Height); err != nil {
+		return ruleError(ErrInvalidVersion, err.Error())
+	}
+	if b.params.VRFProducerActive(header.Height) && !flags.HasFlag(BFGenesisValidation) {
+		if len(header.VrfProof) != icrypto.VRFProofSize {
+			return ruleError(ErrInvalidVRFProof, "block header missing vrf proof")
+		}
+	} else if len(header.VrfProof) > 0 {
+		return ruleError(ErrInvalidVRFProof, "vrf proof not allowed in block header")
+	}
+	if _, ok := SnapshotCommitmentHeight(b.params, header.Height); ok {
+		if len(header.SnapshotCommitment) != hash.HashSize {
+			return ruleError(ErrInvalidSnapshotCommitment, "block header missing snapshot commitment")
+		}
+	} else if len(header.SnapshotCommitment) > 0 {
+		return ruleError(ErrInvalidSnapshotCommitment, "snapshot commitment not allowed in block header")
+	}
+	if !flags.HasFlag(BFGenesisValidation) {
+		producerID, err := peer.IDFromBytes(header.Producer_ID)
+		if err != nil {
+			return ruleError(ErrInvalidProducer, "block producer ID does not decode")
+		}
+
+		if _, err := producerID.ExtractPublicKey(); err != nil {
+			return ruleError(ErrInvalidProducer, "block producer pubkey invalid")
 		}
 	}
 	return nil
@@ -145,7 +198,15 @@ func (b *Blockchain) validateHeader(header *blocks.BlockHeader, flags BehaviorFl
 // BLockchain context is used when validating the block as queries to the validator set,
 // treasury, tx root set, etc are made.
 func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error {
-	if err := b.validateHeader(blk.Header, flags); err != nil {
+	// Blocks proven to lead up to a checkpoint are known to be valid
+	// so the expensive signature and proof verification is skipped.
+	if b.isCheckpointAncestor(blk.ID()) {
+		flags |= BFFastAdd
+	}
+
+	// The header signature is verified below, concurrently with the
+	// transaction signatures.
+	if err := b.checkHeaderSanity(blk.Header, flags); err != nil {
 		return err
 	}
 
@@ -165,19 +226,15 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 		stakeTransactions = make([]*transactions.StakeTransaction, 0, len(blk.Transactions))
 
 		treasuryBalance *types.Amount
-		lastTxid        = types.NewID(make([]byte, 32))
 	)
 
-	for _, t := range blk.GetTransactions() {
-		if !flags.HasFlag(BFGenesisValidation) {
-			if lastTxid.Compare(t.ID()) >= 0 {
-				return ruleError(ErrBlockSort, "block is not sorted by txid")
-			}
-			lastTxid = t.ID()
-		}
-		if err := CheckTransactionSanity(t, time.Unix(blk.Header.Timestamp, 0)); err != nil {
-			return err
+	if !flags.HasFlag(BFGenesisValidation) {
+		if err := transactions.ValidateCanonicalOrder(blk.Transactions); err != nil {
+			return ruleError(ErrBlockSort, "block is not sorted by txid")
 		}
+	}
+
+	for _, t := range blk.GetTransactions() {
 		switch tx := t.Tx.(type) {
 		case *transactions.Transaction_CoinbaseTransaction:
 			validatorID, err := peer.IDFromBytes(tx.CoinbaseTransaction.Validator_ID)
@@ -188,46 +245,10 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 				if blockCoinbases[validatorID] {
 					return ruleError(ErrDuplicateCoinbase, "more than one coinbase per validator")
 				}
-				validator, err := b.validatorSet.GetValidator(validatorID)
-				if err != nil {
-					return ruleError(ErrInvalidTx, "validator does not exist in validator set")
-				}
-				if types.Amount(tx.CoinbaseTransaction.NewCoins) != validator.UnclaimedCoins || tx.CoinbaseTransaction.NewCoins == 0 {
-					return ruleError(ErrInvalidTx, "coinbase transaction creates invalid number of coins")
-				}
 				blockCoinbases[validatorID] = true
 			}
 		case *transactions.Transaction_StakeTransaction:
 			stakeTransactions = append(stakeTransactions, tx.StakeTransaction)
-			if !flags.HasFlag(BFGenesisValidation) {
-				exists, err := b.txoRootSet.RootExists(types.NewID(tx.StakeTransaction.TxoRoot))
-				if err != nil {
-					return err
-				}
-				if !exists {
-					return ruleError(ErrInvalidTx, "txo root does not exist in chain")
-				}
-				exists, err = b.nullifierSet.NullifierExists(types.NewNullifier(tx.StakeTransaction.Nullifier))
-				if err != nil {
-					return err
-				}
-				if exists {
-					return ruleError(ErrDoubleSpend, "stake tx contains spent nullifier")
-				}
-				valID, err := peer.IDFromBytes(tx.StakeTransaction.Validator_ID)
-				if err != nil {
-					return ruleError(ErrInvalidTx, "stake tx validator ID does not decode")
-				}
-				validator, err := b.validatorSet.GetValidator(valID)
-				if err == nil {
-					stake, exists := validator.Nullifiers[types.NewNullifier(tx.StakeTransaction.Nullifier)]
-					if exists {
-						if stake.Blockstamp.Add(ValidatorExpiration - RestakePeriod).After(time.Unix(blk.Header.Timestamp, 0)) {
-							return ruleError(ErrRestakeTooEarly, "restake transaction too early")
-						}
-					}
-				}
-			}
 		case *transactions.Transaction_StandardTransaction:
 			if flags.HasFlag(BFGenesisValidation) {
 				return ruleError(ErrInvalidGenesis, "genesis block should only contain coinbase and stake txs")
@@ -237,23 +258,8 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 				if blockNullifiers[nullifier] {
 					return ruleError(ErrDoubleSpend, "block contains duplicate nullifier")
 				}
-				exists, err := b.nullifierSet.NullifierExists(nullifier)
-				if err != nil {
-					return err
-				}
-				if exists {
-					return ruleError(ErrDoubleSpend, "block contains spent nullifier")
-				}
-
 				blockNullifiers[nullifier] = true
 			}
-			exists, err := b.txoRootSet.RootExists(types.NewID(tx.StandardTransaction.TxoRoot))
-			if err != nil {
-				return err
-			}
-			if !exists {
-				return ruleError(ErrInvalidTx, "txo root does not exist in chain")
-			}
 		case *transactions.Transaction_MintTransaction:
 			if flags.HasFlag(BFGenesisValidation) {
 				return ruleError(ErrInvalidGenesis, "genesis block should only contain coinbase and stake txs")
@@ -263,24 +269,8 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 				if blockNullifiers[nullifier] {
 					return ruleError(ErrDoubleSpend, "block contains duplicate nullifier")
 				}
-				exists, err := b.nullifierSet.NullifierExists(nullifier)
-				if err != nil {
-					return err
-				}
-				if exists {
-					return ruleError(ErrDoubleSpend, "block contains duplicate nullifier")
-				}
-
 				blockNullifiers[nullifier] = true
 			}
-
-			exists, err := b.txoRootSet.RootExists(types.NewID(tx.MintTransaction.TxoRoot))
-			if err != nil {
-				return err
-			}
-			if !exists {
-				return ruleError(ErrInvalidTx, "txo root does not exist in chain")
-			}
 		case *transactions.Transaction_TreasuryTransaction:
 			if flags.HasFlag(BFGenesisValidation) {
 				return ruleError(ErrInvalidGenesis, "genesis block should only contain coinbase and stake txs")
@@ -302,6 +292,13 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 		}
 	}
 
+	// The checks above depend on the other transactions in the block and
+	// are made in order. The checks against the chain state are independent
+	// for each transaction and are made in parallel.
+	if err := b.checkTransactionsContext(blk.Transactions, time.Unix(blk.Header.Timestamp, 0), flags); err != nil {
+		return err
+	}
+
 	for _, stakeTx := range stakeTransactions {
 		if blockNullifiers[types.NewNullifier(stakeTx.Nullifier)] {
 			return ruleError(ErrBlockStakeSpend, "stake created and spent in the same block")
@@ -327,7 +324,11 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 			if !bytes.Equal(stakeTx.TxoRoot, txoRoot) {
 				return ruleError(ErrInvalidGenesis, "genesis stake txoroot invalid")
 			}
-			totalStaked += types.Amount(stakeTx.Amount)
+			sum, err := totalStaked.Add(types.Amount(stakeTx.Amount))
+			if err != nil {
+				return ruleError(ErrInvalidGenesis, "genesis total stake overflows")
+			}
+			totalStaked = sum
 		}
 		if totalStaked > types.Amount(coinbaseTx.CoinbaseTransaction.NewCoins) {
 			return ruleError(ErrInvalidGenesis, "genesis total stake larger than coinbase")
@@ -335,12 +336,7 @@ func (b *Blockchain) validateBlock(blk *blocks.Block, flags BehaviorFlags) error
 	}
 
 	if !flags.HasFlag(BFFastAdd) {
-		proofValidator := NewProofValidator(b.proofCache)
-		if err := proofValidator.Validate(blk.Transactions); err != nil {
-			return err
-		}
-		sigValidator := NewSigValidator(b.sigCache)
-		if err := sigValidator.Validate(blk.Transactions); err != nil {
+		if err := b.validateSignaturesAndProofs(blk, flags); err != nil {
 			return err
 		}
 	}
@@ -26,7 +26,7 @@ import (
 )
 
 func TestValidateHeader(t *testing.T) {
-	b := Blockchain{}
+	b := Blockchain{params: &params.RegestParams}
 
 	// Build valid header
 	validHeader := randomBlockHeader(1, randomID())
@@ -73,10 +73,16 @@ func TestValidateHeader(t *testing.T) {
 		},
 		{
 			name:        "header with no producer ID",
-			header:      &blocks.BlockHeader{},
+			header:      &blocks.BlockHeader{Version: 1},
 			flags:       BFNone,
 			expectedErr: ruleError(ErrInvalidProducer, ""),
 		},
+		{
+			name:        "header with unknown version",
+			header:      &blocks.BlockHeader{Version: 2},
+			flags:       BFFastAdd,
+			expectedErr: ruleError(ErrInvalidVersion, ""),
+		},
 		{
 			name:        "valid header",
 			header:      validHeader,
@@ -237,9 +243,11 @@ func TestValidateBlock(t *testing.T) {
 	b := Blockchain{
 		ds:           ds,
 		proofCache:   NewProofCache(30),
+		sigCache:     NewSigCache(30),
 		txoRootSet:   NewTxoRootSet(ds, 10),
 		nullifierSet: NewNullifierSet(ds, 10),
 		validatorSet: NewValidatorSet(&params.RegestParams, ds),
+		params:       &params.RegestParams,
 	}
 	assert.NoError(t, dsInitTreasury(ds))
 	dbtx, err := ds.NewTransaction(context.Background(), false)
@@ -459,6 +467,35 @@ func TestValidateBlock(t *testing.T) {
 			flags:       BFNone,
 			expectedErr: nil,
 		},
+		{
+			name: "invalid header signature",
+			block: func(blk *blocks.Block) (*blocks.Block, error) {
+				blk.Transactions = []*transactions.Transaction{
+					transactions.WrapTransaction(&transactions.StandardTransaction{
+						Outputs: []*transactions.Output{
+							{
+								Commitment: make([]byte, types.CommitmentLen),
+								Ciphertext: make([]byte, CiphertextLen),
+							},
+						},
+						Nullifiers: [][]byte{nullifier[:]},
+						TxoRoot:    txoRoot[:],
+					}),
+				}
+
+				merkleRoot := TransactionsMerkleRoot(blk.Transactions)
+				header.TxRoot = merkleRoot[:]
+				header, err := signHeader(proto.Clone(header).(*blocks.BlockHeader))
+				if err != nil {
+					return nil, err
+				}
+				header.Signature[0] ^= 0xff
+				blk.Header = header
+				return blk, nil
+			},
+			flags:       BFNone,
+			expectedErr: ruleError(ErrInvalidHeaderSignature, ""),
+		},
 		{
 			name: "duplicate transaction (will violate block sort rule)",
 			block: func(blk *blocks.Block) (*blocks.Block, error) {
@@ -1325,19 +1362,23 @@ func TestValidateBlock(t *testing.T) {
 		},
 	}
 
-	for _, test := range tests {
-		blk, err := test.block(proto.Clone(block).(*blocks.Block))
-		assert.NoError(t, err)
-		err = b.validateBlock(blk, test.flags)
-		if test.expectedErr == nil {
-			assert.NoErrorf(t, err, "block validation test: %s failure", test.name)
-		} else {
-			_, ok := err.(RuleError)
-			assert.True(t, ok)
-			if ok {
-				assert.Equalf(t, test.expectedErr.(RuleError).ErrorCode, err.(RuleError).ErrorCode, "block validation test: %s: error %s", test.name, err.Error())
+	// Run the tests with both the serial and parallel validation paths.
+	for _, workers := range []int{1, 4} {
+		b.validationWorkers = workers
+		for _, test := range tests {
+			blk, err := test.block(proto.Clone(block).(*blocks.Block))
+			assert.NoError(t, err)
+			err = b.validateBlock(blk, test.flags)
+			if test.expectedErr == nil {
+				assert.NoErrorf(t, err, "block validation test: %s failure", test.name)
 			} else {
-				fmt.Println(err, test.name)
+				_, ok := err.(RuleError)
+				assert.True(t, ok)
+				if ok {
+					assert.Equalf(t, test.expectedErr.(RuleError).ErrorCode, err.(RuleError).ErrorCode, "block validation test: %s: workers %d: error %s", test.name, workers, err.Error())
+				} else {
+					fmt.Println(err, test.name)
+				}
 			}
 		}
 	}
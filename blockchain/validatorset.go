@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	datastore "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
@@ -201,11 +202,11 @@ func (vs *ValidatorSet) Init(tip *blockNode) error {
 						return err
 					}
 
-					prevEpoch := (prevHeader.Timestamp - vs.params.GenesisBlock.Header.Timestamp) / vs.params.EpochLength
-					blkEpoch := (blk.Header.Timestamp - vs.params.GenesisBlock.Header.Timestamp) / vs.params.EpochLength
+					prevEpoch := vs.params.EpochForTimestamp(prevHeader.Timestamp)
+					blkEpoch := vs.params.EpochForTimestamp(blk.Header.Timestamp)
 
 					if blkEpoch > prevEpoch {
-						validatorReward = calculateNextValidatorReward(vs.params, blkEpoch)
+						validatorReward = vs.params.ValidatorReward(blkEpoch)
 					}
 				}
 
@@ -628,6 +629,98 @@ func (vs *ValidatorSet) BlockProductionLimit(validatorID peer.ID) (uint32, uint3
 	return val.EpochBlocks, blockProductionLimit(float64(vs.EpochBlocks), expectedBlocks/float64(vs.EpochBlocks)), nil
 }
 
+// copyValidators returns a copy of each validator in the set along
+// with the number of blocks in the current epoch.
+//
+// This method is safe for concurrent access.
+func (vs *ValidatorSet) copyValidators() ([]*Validator, uint32) {
+	vs.mtx.RLock()
+	defer vs.mtx.RUnlock()
+
+	validators := make([]*Validator, 0, len(vs.validators))
+	for _, val := range vs.validators {
+		v := &Validator{}
+		copyValidator(v, val)
+		validators = append(validators, v)
+	}
+	return validators, vs.EpochBlocks
+}
+
+// putRestore writes the provided validators, as returned by
+// copyValidators, to the database transaction in place of the
+// validators currently in the set and records the set as flushed at
+// the chain height. This is used to roll back the set on disk in the
+// same transaction that disconnects a block.
+//
+// This method is safe for concurrent access.
+func (vs *ValidatorSet) putRestore(dbtx datastore.Txn, validators []*Validator, chainHeight uint32) error {
+	vs.mtx.RLock()
+	defer vs.mtx.RUnlock()
+
+	restored := make(map[peer.ID]bool, len(validators))
+	for _, val := range validators {
+		if err := dsPutValidator(dbtx, val); err != nil {
+			return err
+		}
+		restored[val.PeerID] = true
+	}
+	for peerID := range vs.validators {
+		if !restored[peerID] {
+			if err := dsDeleteValidator(dbtx, peerID); err != nil {
+				return err
+			}
+		}
+	}
+	for peerID := range vs.toDelete {
+		if !restored[peerID] {
+			if err := dsDeleteValidator(dbtx, peerID); err != nil {
+				return err
+			}
+		}
+	}
+	return dsPutValidatorLastFlushHeight(dbtx, chainHeight)
+}
+
+// restore replaces the validators in the set with the provided
+// validators after they have been written to disk by putRestore.
+// This is used to roll back the set when a block is disconnected.
+//
+// This method is safe for concurrent access.
+func (vs *ValidatorSet) restore(validators []*Validator, epochBlocks uint32) {
+	vs.mtx.Lock()
+	defer vs.mtx.Unlock()
+
+	restored := make(map[peer.ID]*Validator, len(validators))
+	for _, val := range validators {
+		restored[val.PeerID] = val
+	}
+	for peerID := range vs.validators {
+		if _, ok := restored[peerID]; !ok {
+			vs.sendNotification(peerID, NTRemoveValidator)
+		}
+	}
+	for peerID := range restored {
+		if _, ok := vs.validators[peerID]; !ok {
+			vs.sendNotification(peerID, NTAddValidator)
+		}
+	}
+
+	vs.validators = restored
+	vs.nullifierMap = make(map[types.Nullifier]*Validator)
+	choices := make([]weightedrand.Choice[peer.ID, types.Amount], 0, len(restored))
+	for peerID, val := range restored {
+		for nullifier := range val.Nullifiers {
+			vs.nullifierMap[nullifier] = val
+		}
+		choices = append(choices, weightedrand.NewChoice(peerID, val.WeightedStake))
+	}
+	vs.chooser, _ = weightedrand.NewChooser(choices...)
+	vs.EpochBlocks = epochBlocks
+	vs.toDelete = make(map[peer.ID]struct{})
+	vs.lastFlush = time.Now()
+	vs.sendNotification(struct{}{}, NTValidatorSetUpdate)
+}
+
 // Flush flushes changes from the memory cache to disk.
 //
 // This method is safe for concurrent access.
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -417,6 +418,58 @@ func (x *GetValidator) Execute(args []string) error {
 	return nil
 }
 
+type GetValidatorHistory struct {
+	ValID string `short:"i" long:"id" description:"Validator ID to look up"`
+	opts  *options
+}
+
+func (x *GetValidatorHistory) Execute(args []string) error {
+	client, err := makeBlockchainClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	pid, err := peer.Decode(x.ValID)
+	if err != nil {
+		return err
+	}
+	pBytes, err := pid.Marshal()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetValidatorHistory(makeContext(x.opts.AuthToken), &pb.GetValidatorHistoryRequest{
+		Validator_ID: pBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	type event struct {
+		Type          string             `json:"type"`
+		Height        uint32             `json:"height"`
+		TransactionID types.HexEncodable `json:"transactionID"`
+		Amount        uint64             `json:"amount"`
+		Nullifier     types.HexEncodable `json:"nullifier,omitempty"`
+	}
+	events := make([]*event, 0, len(resp.Events))
+	for _, e := range resp.Events {
+		events = append(events, &event{
+			Type:          strings.ToLower(e.Type.String()),
+			Height:        e.Height,
+			TransactionID: e.Transaction_ID,
+			Amount:        e.Amount,
+			Nullifier:     e.Nullifier,
+		})
+	}
+
+	out, err := json.MarshalIndent(events, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 type GetValidatorSetInfo struct {
 	opts *options
 }
@@ -567,6 +620,60 @@ func (x *GetAccumulatorCheckpoint) Execute(args []string) error {
 	return nil
 }
 
+type GetFinalityCertificate struct {
+	opts   *options
+	Height uint32 `short:"t" long:"height" description:"The height of the block"`
+}
+
+func (x *GetFinalityCertificate) Execute(args []string) error {
+	client, err := makeBlockchainClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetFinalityCertificate(makeContext(x.opts.AuthToken), &pb.GetFinalityCertificateRequest{
+		Height: x.Height,
+	})
+	if err != nil {
+		return err
+	}
+
+	producer, err := peer.IDFromBytes(resp.Producer_ID)
+	if err != nil {
+		return err
+	}
+	validators := make([]string, 0, len(resp.Validators))
+	for _, v := range resp.Validators {
+		pid, err := peer.IDFromBytes(v)
+		if err != nil {
+			return err
+		}
+		validators = append(validators, pid.String())
+	}
+
+	r := struct {
+		Height     uint32
+		BlockID    types.HexEncodable
+		Producer   string
+		SigHash    types.HexEncodable
+		Validators []string
+		Signature  types.HexEncodable
+	}{
+		Height:     resp.Height,
+		BlockID:    resp.Block_ID,
+		Producer:   producer.String(),
+		SigHash:    resp.SigHash,
+		Validators: validators,
+		Signature:  resp.Signature,
+	}
+
+	out, err := json.MarshalIndent(&r, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 type SubmitTransaction struct {
 	opts *options
 	Tx   string `short:"t" long:"tx" description:"The transaction to submit. Serialized as hex string or JSON."`
@@ -6,12 +6,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/jessevdk/go-flags"
 	"github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/rpc/pb"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/remoteprover"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
@@ -32,6 +35,12 @@ type options struct {
 	AuthToken   string `short:"t" long:"authtoken" description:"The ilxd node gRPC authentican token if needed"`
 	ServerAddr  string `short:"a" long:"serveraddr" description:"The address of the ilxd gRPC server (in multiaddr format)" default:"/ip4/127.0.0.1/tcp/5001"`
 	RPCCert     string `long:"rpccert" description:"A path to the SSL certificate to use with gRPC (this is only need if using a self-signed cert)" default:"~/.ilxd/rpc.cert"`
+
+	RemoteProver           string `long:"remoteprover" description:"The host:port of a remote prover service to create the zk proofs of locally proven transactions"`
+	RemoteProverToken      string `long:"remoteprovertoken" description:"The authentication token of the remote prover service"`
+	RemoteProverCert       string `long:"remoteprovercert" description:"A path to the TLS certificate of the remote prover service if it is self-signed"`
+	RemoteProverClientCert string `long:"remoteproverclientcert" description:"A path to the TLS client certificate used to authenticate to the remote prover service"`
+	RemoteProverClientKey  string `long:"remoteproverclientkey" description:"A path to the key of the TLS client certificate"`
 }
 
 func main() {
@@ -78,9 +87,11 @@ func main() {
 	parser.AddCommand("gettransaction", "Returns the transaction for the given transaction ID", "Returns the transaction for the given transaction ID. Requires TxIndex.", &GetTransaction{opts: &opts})
 	parser.AddCommand("getmerkleproof", "Returns a Merkle (SPV) proof for a specific transaction in the provided block", "Returns a Merkle (SPV) proof for a specific transaction in the provided block. Requires TxIndex.", &GetMerkleProof{opts: &opts})
 	parser.AddCommand("getvalidator", "Returns all the information about the given validator", "Returns all the information about the given validator including the number of staked coins.", &GetValidator{opts: &opts})
+	parser.AddCommand("getvalidatorhistory", "Returns the stake, unstake and coinbase events for the given validator", "Returns every stake, unstake and coinbase event for the given validator. Requires ValidatorIndex.", &GetValidatorHistory{opts: &opts})
 	parser.AddCommand("getvalidatorsetinfo", "Returns information about the validator set", "Returns information about the validator set.", &GetValidatorSetInfo{opts: &opts})
 	parser.AddCommand("getvalidatorset", "Returns all the validators in the current validator set", "Returns all the validators in the current validator set.", &GetValidatorSet{opts: &opts})
 	parser.AddCommand("getaccumulatorcheckpoint", "Returns the accumulator at the requested height", "Returns the accumulator at the requested height. If there is no checkpoint at that height, the *prior* checkpoint found in the chain will be returned. If there is no prior checkpoint (as is prior to the first), an error will be returned.", &GetAccumulatorCheckpoint{opts: &opts})
+	parser.AddCommand("getfinalitycertificate", "Returns the finality certificate for the block at the requested height", "Returns the finality certificate for the block at the requested height. Certificates are only available for blocks the node has finalized itself through avalanche.", &GetFinalityCertificate{opts: &opts})
 	parser.AddCommand("submittransaction", "Validates a transaction and submits it to the network", "Validates a transaction and submits it to the network. An error will be returned if it fails validation.", &SubmitTransaction{opts: &opts})
 
 	// Node service
@@ -101,6 +112,8 @@ func main() {
 	parser.AddCommand("updatetreasurywhitelist", "Adds or removes a transaction from the treasury whitelist", "Adds or removes a transaction from the treasury whitelist", &UpdateTreasuryWhitelist{opts: &opts})
 	parser.AddCommand("reconsiderblock", "Tries to reprocess the given block", "Tries to reprocess the given block", &ReconsiderBlock{opts: &opts})
 	parser.AddCommand("recomputechainstate", "Rebuilds the entire chain state from genesis", "Deletes the accumulator, validator set, and nullifier set and rebuilds them by loading and re-processing all blocks from genesis.", &RecomputeChainState{opts: &opts})
+	statusCmd, _ := parser.AddCommand("getnodestatus", "Returns a summary of the node's state", "Returns a summary of the node's state including its version, uptime, sync state, peers, mempool, last finalized block, validator status and resource usage.", &GetNodeStatus{opts: &opts})
+	statusCmd.Aliases = []string{"status"}
 	parser.AddCommand("signmessage", "Sign a message with the network key", "Sign a message with the nework key", &SignMessage{opts: &opts})
 	parser.AddCommand("verifymessage", "Verify a signed message", "Verify a signed message", &VerifyMessage{opts: &opts})
 
@@ -113,6 +126,12 @@ func main() {
 	parser.AddCommand("getaddrinfo", "Returns info about the given address", "Returns info about the given address", &GetAddrInfo{opts: &opts})
 	parser.AddCommand("getnewaddress", "Generates a new address and returns it", "Generates a new address and returns it. Both a new spend key and view key will be derived from the mnemonic seed.", &GetNewAddress{opts: &opts})
 	parser.AddCommand("gettransactions", "Returns the list of transactions for the wallet", "Returns the list of transactions for the wallet", &GetTransactions{opts: &opts})
+	parser.AddCommand("exporttransactionhistory", "Exports the wallet's transaction history as CSV or JSON", "Exports the wallet's confirmed transactions within the given block height range as CSV or JSON for accounting purposes. Requires TxIndex.", &ExportTransactionHistory{opts: &opts})
+	parser.AddCommand("createaccount", "Creates a new named account in the wallet", "Creates a new named account in the wallet. Each account has its own addresses and balance.", &CreateAccount{opts: &opts})
+	parser.AddCommand("getaccounts", "Returns the wallet's accounts and their balances", "Returns the wallet's accounts along with their labels, addresses, and balances", &GetAccounts{opts: &opts})
+	parser.AddCommand("setaccountlabel", "Changes the label of an account", "Changes the label of an existing account", &SetAccountLabel{opts: &opts})
+	parser.AddCommand("getaccountnewaddress", "Generates a new address for an account", "Generates a new address and assigns it to the given account", &GetAccountNewAddress{opts: &opts})
+	parser.AddCommand("accountspend", "Sends coins from an account", "Sends coins from an account. Only the account's coins are spent and change is returned to the account.", &AccountSpend{opts: &opts})
 	parser.AddCommand("getutxos", "Returns a list of the wallet's current unspent transaction outputs (UTXOs)", "Returns a list of the wallet's current unspent transaction outputs (UTXOs)", &GetUtxos{opts: &opts})
 	parser.AddCommand("getprivatekey", "Returns the serialized spend and view keys for the given address", "Returns the serialized spend and view keys for the given address", &GetPrivateKey{opts: &opts})
 	parser.AddCommand("importaddress", "Imports a watch address into the wallet", "Imports a watch address into the wallet", &ImportAddress{opts: &opts})
@@ -121,6 +140,12 @@ func main() {
 	parser.AddCommand("createmultisigaddress", "Generates a new multisig address using the provided public keys", "Generates a new multisig address using the provided public keys", &CreateMultisigAddress{opts: &opts})
 	parser.AddCommand("createmultisignature", "Generates and returns a signature for use when proving a multisig transaction", "Generates and returns a signature for use when proving a multisig transaction", &CreateMultiSignature{opts: &opts})
 	parser.AddCommand("provemultisig", "Creates a proof for a transaction with a multisig input", "Creates a proof for a transaction with a multisig input", &ProveMultisig{opts: &opts})
+	parser.AddCommand("createthresholdkey", "Splits an existing key into shares for threshold signing", "Splits an existing Ed25519 key, such as the node's network key, into n shares any t of which can cooperate to sign a stake transaction. This command acts as a trusted dealer: it sees the full key and its output contains every share. Hand each share to its participant and destroy the output and the original key. Use startthresholdkeygen to generate a new key without a dealer.", &CreateThresholdKey{opts: &opts})
+	parser.AddCommand("startthresholdkeygen", "Starts a distributed threshold key generation", "Starts the first round of a distributed key generation for a new t-of-n threshold key. The full private key is never created on any machine. Each participant runs this command with its own index. Broadcast the commitment to every participant, send each share privately to the participant it is for, and keep the state secret for finishthresholdkeygen.", &StartThresholdKeyGen{opts: &opts})
+	parser.AddCommand("finishthresholdkeygen", "Finishes a distributed threshold key generation", "Verifies the commitments of every participant and the shares sent to this participant and combines them into this participant's threshold key share. An invalid share identifies the participant that sent it. Every participant should check that they computed the same group key.", &FinishThresholdKeyGen{opts: &opts})
+	parser.AddCommand("createthresholdnonce", "Generates a nonce for threshold signing", "Generates a single use nonce and its commitment for the first round of threshold signing. Send the commitment to the other signers and keep the nonce secret.", &CreateThresholdNonce{opts: &opts})
+	parser.AddCommand("createthresholdsignatureshare", "Creates a threshold signature share", "Creates this participant's signature share for the second round of threshold signing using the nonce and the commitments of every signer", &CreateThresholdSignatureShare{opts: &opts})
+	parser.AddCommand("aggregatethresholdsignature", "Combines threshold signature shares into a signature", "Verifies and combines the signature shares into a signature under the group key. If the signed transaction is a stake transaction the signature is added to it.", &AggregateThresholdSignature{opts: &opts})
 	parser.AddCommand("walletlock", "Encrypts the wallet's private keys", "Encrypts the wallet's private keys", &WalletLock{opts: &opts})
 	parser.AddCommand("walletunlock", "Decrypts the wallet seed and holds it in memory for the specified period of time", "Decrypts the wallet seed and holds it in memory for the specified period of time", &WalletUnlock{opts: &opts})
 	parser.AddCommand("setwalletpassphrase", "Encrypts the wallet for the first time", "Encrypts the wallet for the first time", &SetWalletPassphrase{opts: &opts})
@@ -134,6 +159,16 @@ func main() {
 	parser.AddCommand("spend", "Sends coins from the wallet", "Sends coins from the wallet according to the provided parameters", &Spend{opts: &opts})
 	parser.AddCommand("timelockcoins", "Lock coins in a timelocked address", "Send coins into a timelocked address, from which the wallet may spend from after the timelock expires. This is primarily used for adding weight to stake.", &TimelockCoins{opts: &opts})
 
+	parser.CommandHandler = func(command flags.Commander, args []string) error {
+		if command == nil {
+			return nil
+		}
+		if err := configureRemoteProver(&opts); err != nil {
+			return err
+		}
+		return command.Execute(args)
+	}
+
 	if _, err := parser.Parse(); err != nil {
 		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
 			fmt.Println(err)
@@ -144,6 +179,32 @@ func main() {
 
 }
 
+// configureRemoteProver makes the remote prover in the options, if
+// there is one, create the proofs of transactions proven by ilxcli.
+func configureRemoteProver(opts *options) error {
+	if opts.RemoteProver == "" {
+		return nil
+	}
+	if opts.RemoteProverToken == "" && opts.RemoteProverClientCert == "" {
+		return errors.New("remoteprover requires remoteprovertoken or remoteproverclientcert")
+	}
+	var proverOpts []remoteprover.Option
+	if opts.RemoteProverToken != "" {
+		proverOpts = append(proverOpts, remoteprover.AuthToken(opts.RemoteProverToken))
+	}
+	if opts.RemoteProverCert != "" {
+		proverOpts = append(proverOpts, remoteprover.TLSCertFile(repo.CleanAndExpandPath(opts.RemoteProverCert)))
+	}
+	if opts.RemoteProverClientCert != "" || opts.RemoteProverClientKey != "" {
+		proverOpts = append(proverOpts, remoteprover.ClientCert(repo.CleanAndExpandPath(opts.RemoteProverClientCert), repo.CleanAndExpandPath(opts.RemoteProverClientKey)))
+	}
+	prover, err := remoteprover.NewRemoteProver(opts.RemoteProver, proverOpts...)
+	if err != nil {
+		return err
+	}
+	return zk.ConfigureProver(zk.ProverBackend(prover))
+}
+
 func makeContext(authToken string) context.Context {
 	ctx := context.Background()
 	if authToken != "" {
@@ -162,8 +162,9 @@ func (x *UnblockPeer) Execute(args []string) error {
 }
 
 type SetLogLevel struct {
-	Level string `short:"l" long:"level" description:"The log level: [DEBUG, INFO, WARNING, ERROR, CRITICAL, ALERT, EMERGENCY]"`
-	opts  *options
+	Level     string `short:"l" long:"level" description:"The log level: [DEBUG, INFO, WARNING, ERROR, CRITICAL, ALERT, EMERGENCY]"`
+	Subsystem string `short:"s" long:"subsystem" description:"Only set the level for this subsystem: [blockchain, consensus, gen, indexers, mempool, net, repo, sync, zk]"`
+	opts      *options
 }
 
 func (x *SetLogLevel) Execute(args []string) error {
@@ -185,7 +186,8 @@ func (x *SetLogLevel) Execute(args []string) error {
 		return errors.New("unknown log level")
 	}
 	_, err = client.SetLogLevel(makeContext(x.opts.AuthToken), &pb.SetLogLevelRequest{
-		Level: level,
+		Level:     level,
+		Subsystem: strings.ToLower(x.Subsystem),
 	})
 	if err != nil {
 		return err
@@ -437,6 +439,31 @@ func (x *RecomputeChainState) Execute(args []string) error {
 	return nil
 }
 
+type GetNodeStatus struct {
+	opts *options
+}
+
+func (x *GetNodeStatus) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetNodeStatus(makeContext(x.opts.AuthToken), &pb.GetNodeStatusRequest{})
+	if err != nil {
+		return err
+	}
+	m := protojson.MarshalOptions{
+		Indent:          "    ",
+		EmitUnpopulated: true,
+	}
+	out, err := m.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 type SignMessage struct {
 	Message string `short:"m" long:"message" description:"A message to sign"`
 	opts    *options
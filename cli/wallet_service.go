@@ -17,6 +17,7 @@ import (
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/rpc/pb"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/address"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
 	"github.com/project-illium/ilxd/zk/circuits/stake"
@@ -208,6 +209,168 @@ func (x *GetTransactions) Execute(args []string) error {
 	return nil
 }
 
+type ExportTransactionHistory struct {
+	opts        *options
+	StartHeight uint32 `short:"s" long:"start" description:"The first block height to include in the export"`
+	EndHeight   uint32 `short:"e" long:"end" description:"The last block height to include in the export. Defaults to the best height."`
+	Format      string `short:"f" long:"format" description:"The format of the export [csv, json]" default:"csv"`
+}
+
+func (x *ExportTransactionHistory) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	var format pb.ExportTransactionHistoryRequest_Format
+	switch strings.ToLower(x.Format) {
+	case "csv", "":
+		format = pb.ExportTransactionHistoryRequest_CSV
+	case "json":
+		format = pb.ExportTransactionHistoryRequest_JSON
+	default:
+		return errors.New("invalid format")
+	}
+	resp, err := client.ExportTransactionHistory(makeContext(x.opts.AuthToken), &pb.ExportTransactionHistoryRequest{
+		StartHeight: x.StartHeight,
+		EndHeight:   x.EndHeight,
+		Format:      format,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(resp.Data))
+	return nil
+}
+
+type CreateAccount struct {
+	Name  string `short:"n" long:"name" description:"The unique name of the account"`
+	Label string `short:"l" long:"label" description:"An optional label for the account"`
+	opts  *options
+}
+
+func (x *CreateAccount) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	_, err = client.CreateAccount(makeContext(x.opts.AuthToken), &pb.CreateAccountRequest{
+		Name:  x.Name,
+		Label: x.Label,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println("success")
+	return nil
+}
+
+type GetAccounts struct {
+	opts *options
+}
+
+func (x *GetAccounts) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetAccounts(makeContext(x.opts.AuthToken), &pb.GetAccountsRequest{})
+	if err != nil {
+		return err
+	}
+	type account struct {
+		Name      string   `json:"name"`
+		Label     string   `json:"label"`
+		Addresses []string `json:"addresses"`
+		Balance   uint64   `json:"balance"`
+	}
+	accts := make([]account, 0, len(resp.Accounts))
+	for _, a := range resp.Accounts {
+		accts = append(accts, account{
+			Name:      a.Name,
+			Label:     a.Label,
+			Addresses: a.Addresses,
+			Balance:   a.Balance,
+		})
+	}
+	out, err := json.MarshalIndent(accts, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type SetAccountLabel struct {
+	Name  string `short:"n" long:"name" description:"The name of the account"`
+	Label string `short:"l" long:"label" description:"The new label for the account"`
+	opts  *options
+}
+
+func (x *SetAccountLabel) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	_, err = client.SetAccountLabel(makeContext(x.opts.AuthToken), &pb.SetAccountLabelRequest{
+		Name:  x.Name,
+		Label: x.Label,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println("success")
+	return nil
+}
+
+type GetAccountNewAddress struct {
+	Name string `short:"n" long:"name" description:"The name of the account"`
+	opts *options
+}
+
+func (x *GetAccountNewAddress) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetAccountNewAddress(makeContext(x.opts.AuthToken), &pb.GetAccountNewAddressRequest{
+		Name: x.Name,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Address)
+	return nil
+}
+
+type AccountSpend struct {
+	Name     string `short:"n" long:"name" description:"The name of the account to spend from"`
+	Address  string `short:"a" long:"addr" description:"An address to send coins to"`
+	Amount   uint64 `short:"t" long:"amount" description:"The amount to send"`
+	FeePerKB uint64 `short:"f" long:"feeperkb" description:"The fee per kilobyte to pay for this transaction. If zero the wallet will use its default fee."`
+	opts     *options
+}
+
+func (x *AccountSpend) Execute(args []string) error {
+	if _, err := address.Decode(x.Address); err != nil {
+		return err
+	}
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.AccountSpend(makeContext(x.opts.AuthToken), &pb.AccountSpendRequest{
+		Name:           x.Name,
+		ToAddress:      x.Address,
+		Amount:         x.Amount,
+		FeePerKilobyte: x.FeePerKB,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(hex.EncodeToString(resp.Transaction_ID))
+	return nil
+}
+
 type GetUtxos struct {
 	opts *options
 }
@@ -390,7 +553,7 @@ type CreateMultisigAddress struct {
 	ViewPubKey string   `short:"k" long:"viewpubkey" description:"The view public key for the address. Serialized as hex string."`
 	Pubkeys    []string `short:"p" long:"pubkey" description:"One or more public keys to use with the address. Serialized as a hex string. Use this option more than once for more than one key."`
 	Threshold  uint32   `short:"t" long:"threshold" description:"The number of keys needing to sign to the spend from this address."`
-	Net        string   `short:"n" long:"net" description:"Which network the address is for: [mainnet, testnet, regtest] Default: mainnet"`
+	Net        string   `short:"n" long:"net" description:"Which network the address is for: [mainnet, testnet, testnet2, regtest] Default: mainnet"`
 	opts       *options
 }
 
@@ -444,6 +607,8 @@ func (x *CreateMultisigAddress) Execute(args []string) error {
 		chainParams = &params.MainnetParams
 	case "testnet":
 		chainParams = &params.Testnet1Params
+	case "testnet2":
+		chainParams = &params.Testnet2Params
 	case "regtest":
 		chainParams = &params.RegestParams
 	case "alphanet":
@@ -485,10 +650,12 @@ func (x *CreateMultiSignature) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	defer icrypto.Zeroize(privKeyBytes)
 	privKey, err := crypto.UnmarshalPrivateKey(privKeyBytes)
 	if err != nil {
 		return err
 	}
+	defer icrypto.ZeroizeKey(privKey)
 
 	var sigHash []byte
 	if x.Tx != "" {
@@ -540,10 +707,11 @@ func (x *CreateMultiSignature) Execute(args []string) error {
 }
 
 type ProveMultisig struct {
-	Tx         string   `short:"t" long:"tx" description:"The transaction to prove. Serialized as hex string."`
-	Serialize  bool     `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
-	Signatures []string `short:"s" long:"sig" description:"A signature covering the tranaction's sighash. Use this option more than once to add more signatures.'"`
-	opts       *options
+	Tx              string   `short:"t" long:"tx" description:"The transaction to prove. Serialized as hex string."`
+	Serialize       bool     `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
+	Signatures      []string `short:"s" long:"sig" description:"A signature covering the tranaction's sighash. Use this option more than once to add more signatures.'"`
+	LocktimeSeconds bool     `long:"locktimeseconds" description:"Denominate the locktime precision in the proof's public params in seconds. Set this once the locktime_seconds upgrade is active on the network."`
+	opts            *options
 }
 
 func (x *ProveMultisig) Execute(args []string) error {
@@ -586,7 +754,6 @@ func (x *ProveMultisig) Execute(args []string) error {
 		Outputs: []standard.PrivateOutput{},
 	}
 
-	nullifiers := make([][]byte, 0, len(rawTx.Inputs))
 	for _, in := range rawTx.Inputs {
 		var keys []crypto.PubKey
 		for i := 1; i < len(in.LockingParams); i += 2 {
@@ -626,12 +793,6 @@ func (x *ProveMultisig) Execute(args []string) error {
 		privIn.State = *state
 
 		privateParams.Inputs = append(privateParams.Inputs, privIn)
-
-		nullifier, err := types.CalculateNullifier(in.TxoProof.Index, privIn.Salt, privIn.ScriptCommitment, privIn.ScriptParams...)
-		if err != nil {
-			return err
-		}
-		nullifiers = append(nullifiers, nullifier.Bytes())
 	}
 	for _, out := range rawTx.Outputs {
 		privOut := standard.PrivateOutput{
@@ -651,18 +812,9 @@ func (x *ProveMultisig) Execute(args []string) error {
 		privateParams.Outputs = append(privateParams.Outputs, privOut)
 	}
 
-	publicParams := &standard.PublicParams{
-		TXORoot:    standardTx.TxoRoot,
-		SigHash:    sighash,
-		Nullifiers: nullifiers,
-		Fee:        standardTx.Fee,
-	}
-
-	for _, out := range standardTx.Outputs {
-		publicParams.Outputs = append(publicParams.Outputs, standard.PublicOutput{
-			Commitment: out.Commitment,
-			CipherText: out.Ciphertext,
-		})
+	publicParams, err := transactions.StandardPublicParams(standardTx, transactions.LocktimeSeconds(x.LocktimeSeconds))
+	if err != nil {
+		return err
 	}
 
 	proof, err := zk.CreateSnark(standard.StandardCircuit, privateParams, publicParams)
@@ -689,6 +841,368 @@ func (x *ProveMultisig) Execute(args []string) error {
 	return nil
 }
 
+type CreateThresholdKey struct {
+	PrivateKey   string `short:"k" long:"privkey" description:"The Ed25519 private key, such as the node's network key, to split into shares. Serialized as hex string."`
+	Threshold    int    `short:"t" long:"threshold" description:"The number of shares needed to sign"`
+	Participants int    `short:"n" long:"participants" description:"The number of shares to create"`
+	opts         *options
+}
+
+func (x *CreateThresholdKey) Execute(args []string) error {
+	if x.PrivateKey == "" {
+		return errors.New("privkey required, use startthresholdkeygen to generate a new key without a dealer")
+	}
+	privKeyBytes, err := hex.DecodeString(x.PrivateKey)
+	if err != nil {
+		return err
+	}
+	defer icrypto.Zeroize(privKeyBytes)
+	privKey, err := crypto.UnmarshalPrivateKey(privKeyBytes)
+	if err != nil {
+		return err
+	}
+	defer icrypto.ZeroizeKey(privKey)
+	groupKey, shares, err := icrypto.SplitPrivateKey(privKey, x.Threshold, x.Participants, rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	groupKeyBytes, err := crypto.MarshalPublicKey(groupKey)
+	if err != nil {
+		return err
+	}
+	ret := struct {
+		GroupKey types.HexEncodable   `json:"groupKey"`
+		Shares   []types.HexEncodable `json:"shares"`
+	}{
+		GroupKey: groupKeyBytes,
+	}
+	for _, share := range shares {
+		ser, err := icrypto.MarshalThresholdKeyShare(share)
+		share.Zeroize()
+		if err != nil {
+			return err
+		}
+		ret.Shares = append(ret.Shares, ser)
+	}
+	out, err := json.MarshalIndent(&ret, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+type StartThresholdKeyGen struct {
+	Index        uint32 `short:"i" long:"index" description:"This participant's index, from 1 to the number of participants. Every participant must use a different index."`
+	Threshold    int    `short:"t" long:"threshold" description:"The number of shares needed to sign"`
+	Participants int    `short:"n" long:"participants" description:"The number of participants"`
+	opts         *options
+}
+
+func (x *StartThresholdKeyGen) Execute(args []string) error {
+	participant, commitment, shares, err := icrypto.NewKeyGenParticipant(x.Index, x.Threshold, x.Participants, rand.Reader)
+	if err != nil {
+		return err
+	}
+	defer participant.Zeroize()
+
+	state, err := icrypto.MarshalKeyGenParticipant(participant)
+	if err != nil {
+		return err
+	}
+
+	type share struct {
+		Participant uint32             `json:"participant"`
+		Share       types.HexEncodable `json:"share"`
+	}
+	ret := struct {
+		State      types.HexEncodable `json:"state"`
+		Commitment types.HexEncodable `json:"commitment"`
+		Shares     []share            `json:"shares"`
+	}{
+		State:      state,
+		Commitment: commitment.Serialize(),
+	}
+	for _, s := range shares {
+		ret.Shares = append(ret.Shares, share{
+			Participant: s.To,
+			Share:       s.Serialize(),
+		})
+	}
+	out, err := json.MarshalIndent(&ret, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+type FinishThresholdKeyGen struct {
+	State       string   `short:"p" long:"state" description:"This participant's state from startthresholdkeygen. Serialized as hex string."`
+	Commitments []string `short:"m" long:"commitment" description:"A key generation commitment from one of the participants, including this one. Serialized as hex string. Use this option more than once to add more commitments."`
+	Shares      []string `short:"s" long:"share" description:"A key generation share sent to this participant by one of the other participants. Serialized as hex string. Use this option more than once to add more shares."`
+	opts        *options
+}
+
+func (x *FinishThresholdKeyGen) Execute(args []string) error {
+	stateBytes, err := hex.DecodeString(x.State)
+	if err != nil {
+		return err
+	}
+	defer icrypto.Zeroize(stateBytes)
+	participant, err := icrypto.UnmarshalKeyGenParticipant(stateBytes)
+	if err != nil {
+		return err
+	}
+	defer participant.Zeroize()
+
+	commitments := make([]*icrypto.KeyGenCommitment, 0, len(x.Commitments))
+	for _, s := range x.Commitments {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		commitment := new(icrypto.KeyGenCommitment)
+		if err := commitment.Deserialize(b); err != nil {
+			return err
+		}
+		commitments = append(commitments, commitment)
+	}
+	shares := make([]*icrypto.KeyGenShare, 0, len(x.Shares))
+	for _, s := range x.Shares {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		share := new(icrypto.KeyGenShare)
+		if err := share.Deserialize(b); err != nil {
+			return err
+		}
+		shares = append(shares, share)
+	}
+
+	keyShare, err := participant.FinishKeyGen(commitments, shares)
+	if err != nil {
+		return err
+	}
+	defer keyShare.Zeroize()
+
+	groupKeyBytes, err := crypto.MarshalPublicKey(keyShare.GroupKey)
+	if err != nil {
+		return err
+	}
+	ser, err := icrypto.MarshalThresholdKeyShare(keyShare)
+	if err != nil {
+		return err
+	}
+	ret := struct {
+		GroupKey types.HexEncodable `json:"groupKey"`
+		KeyShare types.HexEncodable `json:"keyShare"`
+	}{
+		GroupKey: groupKeyBytes,
+		KeyShare: ser,
+	}
+	out, err := json.MarshalIndent(&ret, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+type CreateThresholdNonce struct {
+	KeyShare string `short:"k" long:"keyshare" description:"The participant's threshold key share. Serialized as hex string."`
+	opts     *options
+}
+
+func (x *CreateThresholdNonce) Execute(args []string) error {
+	share, err := decodeThresholdKeyShare(x.KeyShare)
+	if err != nil {
+		return err
+	}
+	defer share.Zeroize()
+
+	nonce, err := share.NewSigningNonce(rand.Reader)
+	if err != nil {
+		return err
+	}
+	nonceBytes, err := icrypto.MarshalSigningNonce(nonce)
+	if err != nil {
+		return err
+	}
+
+	ret := struct {
+		Nonce      types.HexEncodable `json:"nonce"`
+		Commitment types.HexEncodable `json:"commitment"`
+	}{
+		Nonce:      nonceBytes,
+		Commitment: nonce.Commitment().Serialize(),
+	}
+	out, err := json.MarshalIndent(&ret, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+type CreateThresholdSignatureShare struct {
+	KeyShare    string   `short:"k" long:"keyshare" description:"The participant's threshold key share. Serialized as hex string."`
+	Nonce       string   `short:"c" long:"nonce" description:"The participant's nonce from createthresholdnonce. Serialized as hex string. Delete it after signing, a nonce must never be used twice."`
+	Commitments []string `short:"m" long:"commitment" description:"A nonce commitment from one of the signers, including this one. Serialized as hex string. Use this option more than once to add more commitments."`
+	Tx          string   `short:"t" long:"rawtx" description:"A raw stake transaction to sign, as returned by createrawstaketransaction. Serialized as hex string. Use this or sighash."`
+	SigHash     string   `short:"h" long:"sighash" description:"A sighash to sign. Serialized as hex string. Use this or rawtx."`
+	opts        *options
+}
+
+func (x *CreateThresholdSignatureShare) Execute(args []string) error {
+	share, err := decodeThresholdKeyShare(x.KeyShare)
+	if err != nil {
+		return err
+	}
+	defer share.Zeroize()
+
+	nonceBytes, err := hex.DecodeString(x.Nonce)
+	if err != nil {
+		return err
+	}
+	defer icrypto.Zeroize(nonceBytes)
+	nonce, err := icrypto.UnmarshalSigningNonce(nonceBytes)
+	if err != nil {
+		return err
+	}
+	commitments, err := decodeThresholdCommitments(x.Commitments)
+	if err != nil {
+		return err
+	}
+	_, sigHash, err := decodeSigningInput(x.Tx, x.SigHash)
+	if err != nil {
+		return err
+	}
+
+	sigShare, err := share.Sign(sigHash, nonce, commitments)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hex.EncodeToString(sigShare.Serialize()))
+	return nil
+}
+
+type AggregateThresholdSignature struct {
+	KeyShare    string   `short:"k" long:"keyshare" description:"Any participant's threshold key share. Serialized as hex string."`
+	Commitments []string `short:"m" long:"commitment" description:"A nonce commitment from one of the signers. Serialized as hex string. Use this option more than once to add more commitments."`
+	Shares      []string `short:"s" long:"share" description:"A signature share from one of the signers. Serialized as hex string. Use this option more than once to add more shares."`
+	Tx          string   `short:"t" long:"rawtx" description:"The raw stake transaction that was signed. Serialized as hex string. The signature is added to the transaction, which is returned ready for proverawtransaction. Use this or sighash."`
+	SigHash     string   `short:"h" long:"sighash" description:"The sighash that was signed. Serialized as hex string. Use this or rawtx."`
+	opts        *options
+}
+
+func (x *AggregateThresholdSignature) Execute(args []string) error {
+	share, err := decodeThresholdKeyShare(x.KeyShare)
+	if err != nil {
+		return err
+	}
+	defer share.Zeroize()
+
+	commitments, err := decodeThresholdCommitments(x.Commitments)
+	if err != nil {
+		return err
+	}
+	sigShares := make([]*icrypto.SignatureShare, 0, len(x.Shares))
+	for _, s := range x.Shares {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		sigShare := new(icrypto.SignatureShare)
+		if err := sigShare.Deserialize(b); err != nil {
+			return err
+		}
+		sigShares = append(sigShares, sigShare)
+	}
+	rawTx, sigHash, err := decodeSigningInput(x.Tx, x.SigHash)
+	if err != nil {
+		return err
+	}
+
+	sig, err := share.Aggregate(sigHash, commitments, sigShares)
+	if err != nil {
+		return err
+	}
+
+	if rawTx == nil {
+		fmt.Println(hex.EncodeToString(sig))
+		return nil
+	}
+	rawTx.Tx.GetStakeTransaction().Signature = sig
+	ser, err := proto.Marshal(rawTx)
+	if err != nil {
+		return err
+	}
+	fmt.Println(hex.EncodeToString(ser))
+	return nil
+}
+
+func decodeThresholdKeyShare(s string) (*icrypto.ThresholdKeyShare, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	defer icrypto.Zeroize(b)
+	return icrypto.UnmarshalThresholdKeyShare(b)
+}
+
+func decodeThresholdCommitments(strs []string) ([]icrypto.SigningCommitment, error) {
+	commitments := make([]icrypto.SigningCommitment, 0, len(strs))
+	for _, s := range strs {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		var c icrypto.SigningCommitment
+		if err := c.Deserialize(b); err != nil {
+			return nil, err
+		}
+		commitments = append(commitments, c)
+	}
+	return commitments, nil
+}
+
+// decodeSigningInput returns the sighash to sign from either a serialized
+// raw stake transaction or a sighash. If a transaction was provided it is
+// also returned.
+func decodeSigningInput(txHex, sigHashHex string) (*pb.RawTransaction, []byte, error) {
+	if sigHashHex != "" {
+		sigHash, err := hex.DecodeString(sigHashHex)
+		return nil, sigHash, err
+	}
+	if txHex == "" {
+		return nil, nil, errors.New("tx or sighash required")
+	}
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rawTx pb.RawTransaction
+	if err := proto.Unmarshal(txBytes, &rawTx); err != nil {
+		return nil, nil, err
+	}
+	if rawTx.Tx == nil || rawTx.Tx.GetStakeTransaction() == nil {
+		return nil, nil, errors.New("raw transaction is not a stake transaction")
+	}
+	sigHash, err := rawTx.Tx.GetStakeTransaction().SigHash()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &rawTx, sigHash, nil
+}
+
 type WalletLock struct {
 	opts *options
 }
@@ -801,9 +1315,10 @@ type CreateRawTransaction struct {
 	InputCommitments   []string `short:"t" long:"commitment" description:"A commitment to spend as an input. Serialized as a hex string. If using this the wallet will look up the private input data. Use this or input."`
 	PrivateInputs      []string `short:"i" long:"input" description:"Private input data as a JSON string. To include more than one input use this option more than once. Use this or commitment."`
 	PrivateOutputs     []string `short:"o" long:"output" description:"Private output data as a JSON string. To include more than one output use this option more than once."`
-	AppendChangeOutput bool     `short:"c" long:"appendchange" description:"Append a change output to the transaction. If false you'll have to manually include the change out. If true the wallet will use its most recent address for change.'"`
+	AppendChangeOutput bool     `short:"c" long:"appendchange" description:"Append a change output to the transaction. If false you'll have to manually include the change out. If true the change goes to a new address."`
 	FeePerKB           uint64   `short:"f" long:"feeperkb" description:"The fee per kilobyte to pay for this transaction. If zero the wallet will use its default fee."`
 	Serialize          bool     `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
+	Account            string   `short:"a" long:"account" description:"The name of the account to spend from. If empty, coins belonging to an account may not be used and the change goes to an address outside of any account."`
 	opts               *options
 }
 
@@ -817,6 +1332,7 @@ func (x *CreateRawTransaction) Execute(args []string) error {
 		Outputs:            nil,
 		AppendChangeOutput: x.AppendChangeOutput,
 		FeePerKilobyte:     x.FeePerKB,
+		Account:            x.Account,
 	}
 
 	if len(x.PrivateInputs) > 0 {
@@ -895,6 +1411,7 @@ type CreateRawStakeTransaction struct {
 	InputCommitment string `short:"t" long:"commitment" description:"A commitment to stake as an input. Serialized as a hex string. If using this the wallet will look up the private input data. Use this or input."`
 	PrivateInput    string `short:"i" long:"input" description:"Private input data as a JSON string. Use this or commitment."`
 	Serialize       bool   `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
+	Account         string `short:"a" long:"account" description:"The name of the account the input belongs to, if any."`
 	opts            *options
 }
 
@@ -904,7 +1421,8 @@ func (x *CreateRawStakeTransaction) Execute(args []string) error {
 		return err
 	}
 	req := &pb.CreateRawStakeTransactionRequest{
-		Input: nil,
+		Input:   nil,
+		Account: x.Account,
 	}
 
 	if len(x.PrivateInput) > 0 {
@@ -953,21 +1471,28 @@ func (x *CreateRawStakeTransaction) Execute(args []string) error {
 }
 
 type ProveRawTransaction struct {
-	Tx          string   `short:"t" long:"rawtx" description:"The transaction to prove. Serialized as hex string or JSON."`
-	Serialize   bool     `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
-	PrivateKeys []string `short:"k" long:"privkey" description:"An optional spend private to sign the inputs. If one is not provided this CLI will connect to the wallet and look for the key. Serialized as hex string."`
-	opts        *options
+	Tx              string   `short:"t" long:"rawtx" description:"The transaction to prove. Serialized as hex string or JSON."`
+	Serialize       bool     `short:"s" long:"serialize" description:"Serialize the output as a hex string. If false it will be JSON."`
+	PrivateKeys     []string `short:"k" long:"privkey" description:"An optional spend private to sign the inputs. If one is not provided this CLI will connect to the wallet and look for the key. Serialized as hex string."`
+	LocktimeSeconds bool     `long:"locktimeseconds" description:"Denominate the locktime precision in the proof's public params in seconds. Set this once the locktime_seconds upgrade is active on the network."`
+	opts            *options
 }
 
 func (x *ProveRawTransaction) Execute(args []string) error {
 
 	var privKeys []crypto.PrivKey
+	defer func() {
+		for _, k := range privKeys {
+			icrypto.ZeroizeKey(k)
+		}
+	}()
 	for _, k := range x.PrivateKeys {
 		privKeyBytes, err := hex.DecodeString(k)
 		if err != nil {
 			return err
 		}
 		privKey, err := crypto.UnmarshalPrivateKey(privKeyBytes)
+		icrypto.Zeroize(privKeyBytes)
 		if err != nil {
 			return err
 		}
@@ -996,7 +1521,7 @@ func (x *ProveRawTransaction) Execute(args []string) error {
 
 	var tx *transactions.Transaction
 	if privKeys != nil || hasUnlockingParams {
-		tx, err = proveRawTransactionLocally(&rawTx, privKeys)
+		tx, err = proveRawTransactionLocally(&rawTx, privKeys, x.LocktimeSeconds)
 		if err != nil {
 			return err
 		}
@@ -1097,6 +1622,9 @@ type Spend struct {
 }
 
 func (x *Spend) Execute(args []string) error {
+	if _, err := address.Decode(x.Address); err != nil {
+		return err
+	}
 	client, err := makeWalletClient(x.opts)
 	if err != nil {
 		return err
@@ -1176,7 +1704,7 @@ func (x *TimelockCoins) Execute(args []string) error {
 	return nil
 }
 
-func proveRawTransactionLocally(rawTx *pb.RawTransaction, privKeys []crypto.PrivKey) (*transactions.Transaction, error) {
+func proveRawTransactionLocally(rawTx *pb.RawTransaction, privKeys []crypto.PrivKey, locktimeSeconds bool) (*transactions.Transaction, error) {
 	if rawTx == nil {
 		return nil, errors.New("raw tx is nil")
 	}
@@ -1264,18 +1792,9 @@ func proveRawTransactionLocally(rawTx *pb.RawTransaction, privKeys []crypto.Priv
 			privateParams.Outputs = append(privateParams.Outputs, privOut)
 		}
 
-		publicParams := &standard.PublicParams{
-			TXORoot:    standardTx.TxoRoot,
-			SigHash:    sigHash,
-			Nullifiers: standardTx.Nullifiers,
-			Fee:        standardTx.Fee,
-		}
-
-		for _, out := range standardTx.Outputs {
-			publicParams.Outputs = append(publicParams.Outputs, standard.PublicOutput{
-				Commitment: out.Commitment,
-				CipherText: out.Ciphertext,
-			})
+		publicParams, err := transactions.StandardPublicParams(standardTx, transactions.LocktimeSeconds(locktimeSeconds))
+		if err != nil {
+			return nil, err
 		}
 
 		proof, err := zk.CreateSnark(standard.StandardCircuit, privateParams, publicParams)
@@ -1341,11 +1860,9 @@ func proveRawTransactionLocally(rawTx *pb.RawTransaction, privKeys []crypto.Priv
 		}
 		privateParams.State = *state
 
-		publicParams := &stake.PublicParams{
-			TXORoot:   stakeTx.TxoRoot,
-			SigHash:   sigHash,
-			Amount:    stakeTx.Amount,
-			Nullifier: stakeTx.Nullifier,
+		publicParams, err := transactions.StakePublicParams(stakeTx)
+		if err != nil {
+			return nil, err
 		}
 
 		proof, err := zk.CreateSnark(stake.StakeCircuit, privateParams, publicParams)
@@ -0,0 +1,247 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/wire"
+	"google.golang.org/protobuf/proto"
+)
+
+const voteDigestPrefix = "illium/vote"
+
+const (
+	// FinalityStakeNumerator and FinalityStakeDenominator define the
+	// fraction of the weighted stake of the validator set that must have
+	// voted for a block for its finality certificate to be valid.
+	FinalityStakeNumerator   = 2
+	FinalityStakeDenominator = 3
+)
+
+var (
+	// ErrInvalidCertificate is returned when the aggregate signature in a
+	// FinalityCertificate does not verify.
+	ErrInvalidCertificate = errors.New("invalid finality certificate")
+
+	// ErrCertificateNotFound is returned when there is no finality
+	// certificate for the requested height.
+	ErrCertificateNotFound = errors.New("finality certificate not found")
+
+	// ErrInsufficientCertificateStake is returned when the validators in a
+	// FinalityCertificate do not hold enough of the weighted stake.
+	ErrInsufficientCertificateStake = errors.New("finality certificate has insufficient stake")
+)
+
+// VoteDigest returns the digest that a validator signs when voting
+// for a block at the given height.
+func VoteDigest(height uint32, blockID types.ID) []byte {
+	msg := make([]byte, 0, len(voteDigestPrefix)+4+hash.HashSize)
+	msg = append(msg, []byte(voteDigestPrefix)...)
+	msg = binary.BigEndian.AppendUint32(msg, height)
+	msg = append(msg, blockID[:]...)
+	return hash.HashFunc(msg)
+}
+
+// FinalityCertificate is a proof that a set of validators voted for a
+// block which was signed by its producer. The producer's signature over
+// the block header and the individual vote signatures are half-aggregated
+// into one signature. This is about half the size of the signatures it
+// replaces but, unlike a BLS aggregate, it still grows by 32 bytes for
+// each validator.
+//
+// Each vote signs the VoteDigest, which commits to both the height and
+// the block ID, so the certificate only binds the block once enough
+// stake has voted. Callers holding the block header should also check
+// that SigHash matches the header's signature hash.
+type FinalityCertificate struct {
+	Height     uint32
+	BlockID    types.ID
+	Producer   peer.ID
+	SigHash    []byte
+	Validators []peer.ID
+	Signature  []byte
+}
+
+// NewFinalityCertificate aggregates the producer's signature over the
+// header and the vote signatures into a new FinalityCertificate. The
+// signatures are expected to already have been verified.
+func NewFinalityCertificate(header *blocks.BlockHeader, sigs map[peer.ID][]byte) (*FinalityCertificate, error) {
+	producer, err := peer.IDFromBytes(header.Producer_ID)
+	if err != nil {
+		return nil, err
+	}
+	sigHash, err := header.SigHash()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]peer.ID, 0, len(sigs))
+	for pid := range sigs {
+		validators = append(validators, pid)
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i] < validators[j]
+	})
+
+	cert := &FinalityCertificate{
+		Height:     header.Height,
+		BlockID:    header.ID(),
+		Producer:   producer,
+		SigHash:    sigHash,
+		Validators: validators,
+	}
+	pubkeys, msgs, err := cert.signedMessages()
+	if err != nil {
+		return nil, err
+	}
+	sigList := make([][]byte, 0, len(validators)+1)
+	sigList = append(sigList, header.Signature)
+	for _, pid := range validators {
+		sigList = append(sigList, sigs[pid])
+	}
+
+	cert.Signature, err = icrypto.AggregateSignatures(pubkeys, msgs, sigList)
+	if err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// Verify returns an error if the certificate is not valid for the
+// validator set at the certificate's height. The producer and each
+// voter must be in the set, the voters must hold at least two thirds
+// of the set's weighted stake, and the aggregate signature must be
+// valid for the producer and the voters.
+func (c *FinalityCertificate) Verify(validatorSet []*blockchain.Validator) error {
+	var (
+		weights = make(map[peer.ID]types.Amount, len(validatorSet))
+		total   types.Amount
+		voted   types.Amount
+	)
+	for _, v := range validatorSet {
+		weights[v.PeerID] = v.WeightedStake
+		total += v.WeightedStake
+	}
+	if _, ok := weights[c.Producer]; !ok {
+		return fmt.Errorf("%w: producer %s is not a validator", ErrInvalidCertificate, c.Producer)
+	}
+	for i, pid := range c.Validators {
+		// The validators are sorted so a repeated vote would be
+		// adjacent. Rejecting it stops its stake counting twice.
+		if i > 0 && c.Validators[i-1] >= pid {
+			return fmt.Errorf("%w: validators are not sorted", ErrInvalidCertificate)
+		}
+		weight, ok := weights[pid]
+		if !ok {
+			return fmt.Errorf("%w: %s is not a validator", ErrInvalidCertificate, pid)
+		}
+		voted += weight
+	}
+	if total == 0 || uint64(voted)*FinalityStakeDenominator < uint64(total)*FinalityStakeNumerator {
+		return ErrInsufficientCertificateStake
+	}
+
+	pubkeys, msgs, err := c.signedMessages()
+	if err != nil {
+		return err
+	}
+	valid, err := icrypto.VerifyAggregateSignature(pubkeys, msgs, c.Signature)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidCertificate
+	}
+	return nil
+}
+
+// Serialize returns the certificate in its wire format.
+func (c *FinalityCertificate) Serialize() ([]byte, error) {
+	producer, err := c.Producer.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	msg := &wire.MsgFinalityCertificate{
+		Height:      c.Height,
+		Block_ID:    c.BlockID[:],
+		Producer_ID: producer,
+		SigHash:     c.SigHash,
+		Validators:  make([][]byte, 0, len(c.Validators)),
+		Signature:   c.Signature,
+	}
+	for _, pid := range c.Validators {
+		b, err := pid.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		msg.Validators = append(msg.Validators, b)
+	}
+	return proto.Marshal(msg)
+}
+
+// Deserialize decodes a certificate from its wire format.
+func (c *FinalityCertificate) Deserialize(data []byte) error {
+	var msg wire.MsgFinalityCertificate
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return err
+	}
+	producer, err := peer.IDFromBytes(msg.Producer_ID)
+	if err != nil {
+		return err
+	}
+	validators := make([]peer.ID, 0, len(msg.Validators))
+	for _, b := range msg.Validators {
+		pid, err := peer.IDFromBytes(b)
+		if err != nil {
+			return err
+		}
+		validators = append(validators, pid)
+	}
+	c.Height = msg.Height
+	c.BlockID = types.NewID(msg.Block_ID)
+	c.Producer = producer
+	c.SigHash = msg.SigHash
+	c.Validators = validators
+	c.Signature = msg.Signature
+	return nil
+}
+
+// signedMessages returns the public keys and messages covered by the
+// aggregate signature. The producer's header signature comes first,
+// followed by the votes in the order of the validators.
+func (c *FinalityCertificate) signedMessages() ([]crypto.PubKey, [][]byte, error) {
+	var (
+		digest  = VoteDigest(c.Height, c.BlockID)
+		pubkeys = make([]crypto.PubKey, 0, len(c.Validators)+1)
+		msgs    = make([][]byte, 0, len(c.Validators)+1)
+	)
+	producerKey, err := c.Producer.ExtractPublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	pubkeys = append(pubkeys, producerKey)
+	msgs = append(msgs, c.SigHash)
+
+	for _, pid := range c.Validators {
+		pubkey, err := pid.ExtractPublicKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		pubkeys = append(pubkeys, pubkey)
+		msgs = append(msgs, digest)
+	}
+	return pubkeys, msgs, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"crypto/rand"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFinalityCertificate(t *testing.T) {
+	producerKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	producerID, err := peer.IDFromPrivateKey(producerKey)
+	assert.NoError(t, err)
+	producerIDBytes, err := producerID.Marshal()
+	assert.NoError(t, err)
+
+	header := &blocks.BlockHeader{
+		Version:     params.BlockVersionAggregateSignatures,
+		Height:      5,
+		Parent:      make([]byte, 32),
+		Producer_ID: producerIDBytes,
+	}
+	sigHash, err := header.SigHash()
+	assert.NoError(t, err)
+	header.Signature, err = producerKey.Sign(sigHash)
+	assert.NoError(t, err)
+
+	var (
+		height  = header.Height
+		blockID = header.ID()
+		digest  = VoteDigest(height, blockID)
+		sigs    = make(map[peer.ID][]byte)
+		valSet  = []*blockchain.Validator{{PeerID: producerID, WeightedStake: 10}}
+	)
+	assert.NotEqual(t, digest, VoteDigest(height+1, blockID))
+	assert.NotEqual(t, digest, VoteDigest(height, randomBlockID()))
+
+	for i := 0; i < 50; i++ {
+		sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		assert.NoError(t, err)
+		pid, err := peer.IDFromPrivateKey(sk)
+		assert.NoError(t, err)
+		sig, err := sk.Sign(digest)
+		assert.NoError(t, err)
+		sigs[pid] = sig
+		valSet = append(valSet, &blockchain.Validator{PeerID: pid, WeightedStake: 10})
+	}
+
+	cert, err := NewFinalityCertificate(header, sigs)
+	assert.NoError(t, err)
+	assert.Equal(t, height, cert.Height)
+	assert.Equal(t, blockID, cert.BlockID)
+	assert.Equal(t, producerID, cert.Producer)
+	assert.Equal(t, sigHash, cert.SigHash)
+	assert.Len(t, cert.Validators, 50)
+	assert.Len(t, cert.Signature, 32*(50+1)+32)
+	assert.NoError(t, cert.Verify(valSet))
+
+	ser, err := cert.Serialize()
+	assert.NoError(t, err)
+	cert2 := new(FinalityCertificate)
+	assert.NoError(t, cert2.Deserialize(ser))
+	assert.Equal(t, cert, cert2)
+	assert.NoError(t, cert2.Verify(valSet))
+
+	cert.BlockID = types.ID{}
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInvalidCertificate)
+	cert.BlockID = blockID
+
+	cert.Height++
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInvalidCertificate)
+	cert.Height--
+
+	cert.SigHash = make([]byte, len(sigHash))
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInvalidCertificate)
+	cert.SigHash = sigHash
+
+	cert.Producer = cert.Validators[0]
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInvalidCertificate)
+	cert.Producer = producerID
+
+	cert.Validators[0], cert.Validators[1] = cert.Validators[1], cert.Validators[0]
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInvalidCertificate)
+	cert.Validators[0], cert.Validators[1] = cert.Validators[1], cert.Validators[0]
+
+	// A voter which is not in the validator set is rejected even though
+	// its signature is valid.
+	assert.ErrorIs(t, cert.Verify(valSet[:len(valSet)-1]), ErrInvalidCertificate)
+
+	// So is a producer which is not in the validator set.
+	assert.ErrorIs(t, cert.Verify(valSet[1:]), ErrInvalidCertificate)
+
+	// The voters must hold two thirds of the weighted stake.
+	heavy := append([]*blockchain.Validator{{PeerID: randomPeerID(t), WeightedStake: 241}}, valSet...)
+	assert.ErrorIs(t, cert.Verify(heavy), ErrInsufficientCertificateStake)
+	heavy[0].WeightedStake = 240
+	assert.NoError(t, cert.Verify(heavy))
+
+	// A certificate without votes only carries the producer's signature
+	// which does not commit to the block ID, so it is never valid.
+	cert, err = NewFinalityCertificate(header, nil)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInsufficientCertificateStake)
+	cert.BlockID = randomBlockID()
+	assert.ErrorIs(t, cert.Verify(valSet), ErrInsufficientCertificateStake)
+}
+
+func randomPeerID(t *testing.T) peer.ID {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(sk)
+	assert.NoError(t, err)
+	return pid
+}
+
+func TestAggregateSignaturesActive(t *testing.T) {
+	p := params.RegestParams
+	assert.False(t, p.AggregateSignaturesActive(0))
+
+	p.BlockVersions = types.VersionSchedule{
+		{Version: 1, ActivationHeight: 0, ExpirationHeight: 100},
+		{Version: params.BlockVersionAggregateSignatures, ActivationHeight: 100},
+	}
+	assert.False(t, p.AggregateSignaturesActive(99))
+	assert.True(t, p.AggregateSignaturesActive(100))
+}
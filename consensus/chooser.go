@@ -8,6 +8,7 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo"
 	"time"
 )
 
@@ -69,7 +70,7 @@ func (b *BackoffChooser) RegisterDialFailure(p peer.ID) {
 		backoffUntil: time.Now().Add(eb.NextBackOff()),
 		eb:           eb,
 	}
-	log.Debugf("[CONSENSUS] adding backoff to peer %s", p.String())
+	log.Debugw("[CONSENSUS] adding backoff to peer", repo.LogFieldPeerID, p)
 }
 
 // RegisterDialSuccess deletes the exponential backoff for the
@@ -77,7 +78,7 @@ func (b *BackoffChooser) RegisterDialFailure(p peer.ID) {
 func (b *BackoffChooser) RegisterDialSuccess(p peer.ID) {
 	_, ok := b.peerMap[p]
 	if ok {
-		log.Debugf("[CONSENSUS] removing backoff from peer %s", p.String())
+		log.Debugw("[CONSENSUS] removing backoff from peer", repo.LogFieldPeerID, p)
 		delete(b.peerMap, p)
 	}
 }
@@ -6,20 +6,25 @@ package consensus
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/ipfs/go-datastore"
 	ctxio "github.com/jbenet/go-context/io"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	inet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-msgio"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/wire"
 	"google.golang.org/protobuf/proto"
 	"io"
 	"math/rand"
+	"runtime"
 	"sync"
 	"time"
 )
@@ -52,6 +57,12 @@ const (
 	// MinConnectedStakeThreshold is the minimum percentage of the weighted stake
 	// set we must be connected to in order to finalize blocks.
 	MinConnectedStakeThreshold = .5
+
+	// MaxSignedVotesPerQuery is the maximum number of votes in a response
+	// to a query that will be signed. Votes for the remaining heights are
+	// left unsigned so that a peer cannot make us sign an unbounded number
+	// of votes.
+	MaxSignedVotesPerQuery = 16
 )
 
 // requestExpirationMsg signifies a request has expired and
@@ -81,6 +92,13 @@ type registerVotesMsg struct {
 	resp *wire.MsgAvaResponse
 }
 
+// getCertificateMsg is a request for the finality certificate
+// at a height.
+type getCertificateMsg struct {
+	height   uint32
+	respChan chan *FinalityCertificate
+}
+
 // RequestBlockFunc is called when the engine receives a query from a peer about
 // and unknown block. It should attempt to download the block from the remote peer,
 // validate it, then pass it into the engine.
@@ -101,6 +119,8 @@ type ConsensusEngine struct {
 	ms           net.MessageSender
 	valConn      ValidatorSetConnection
 	self         peer.ID
+	privKey      crypto.PrivKey
+	ds           repo.Datastore
 	wg           sync.WaitGroup
 	requestBlock RequestBlockFunc
 	getBlockID   GetBlockIDFunc
@@ -111,6 +131,10 @@ type ConsensusEngine struct {
 	blocks    map[uint32]*BlockChoice
 	queries   map[string]RequestRecord
 	callbacks map[types.ID]chan<- Status
+
+	certificates map[uint32]*FinalityCertificate
+	validatorSet ValidatorSet
+	signSem      chan struct{}
 }
 
 // NewConsensusEngine returns a new ConsensusEngine
@@ -133,6 +157,8 @@ func NewConsensusEngine(ctx context.Context, opts ...Option) (*ConsensusEngine,
 		chooser:      NewBackoffChooser(cfg.chooser),
 		params:       cfg.params,
 		self:         cfg.self,
+		privKey:      cfg.privKey,
+		ds:           cfg.datastore,
 		ms:           net.NewMessageSender(cfg.network.Host(), cfg.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion),
 		wg:           sync.WaitGroup{},
 		requestBlock: cfg.requestBlock,
@@ -142,6 +168,9 @@ func NewConsensusEngine(ctx context.Context, opts ...Option) (*ConsensusEngine,
 		blocks:       make(map[uint32]*BlockChoice),
 		queries:      make(map[string]RequestRecord),
 		callbacks:    make(map[types.ID]chan<- Status),
+		certificates: make(map[uint32]*FinalityCertificate),
+		validatorSet: cfg.validatorSet,
+		signSem:      make(chan struct{}, runtime.NumCPU()),
 	}
 	eng.network.Host().SetStreamHandler(eng.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion, eng.HandleNewStream)
 	eng.wg.Add(1)
@@ -170,6 +199,8 @@ out:
 				eng.handleNewBlock(msg.header, msg.isAcceptable, msg.callback)
 			case *registerVotesMsg:
 				eng.handleRegisterVotes(msg.p, msg.resp)
+			case *getCertificateMsg:
+				msg.respChan <- eng.certificates[msg.height]
 			}
 		case <-eventLoopTicker.C:
 			eng.pollLoop()
@@ -208,14 +239,63 @@ func (eng *ConsensusEngine) handleNewBlock(header *blocks.BlockHeader, isAccepta
 	}
 
 	bc.AddNewBlock(blockID, isAcceptable)
+	bc.RecordHeader(header)
 
 	if len(bc.blockVotes) > 1 {
-		log.Debugf("[CONSENSUS] Conflicting blocks at height %d: conflicts %d, block %s", header.Height, len(bc.blockVotes), header.ID())
+		log.Debugw("[CONSENSUS] Conflicting blocks", repo.LogFieldHeight, header.Height, "conflicts", len(bc.blockVotes), repo.LogFieldBlockID, header.ID())
 	}
 
 	eng.callbacks[blockID] = callback
 }
 
+// FinalityCertificate returns the finality certificate for the block
+// finalized at the height. A certificate is only built if aggregate
+// signatures are active and the validators which signed their votes
+// hold enough stake for the certificate to verify.
+//
+// Certificates are kept in memory for as long as the block is in the
+// engine. After that they are only available if the engine was given
+// a datastore.
+func (eng *ConsensusEngine) FinalityCertificate(height uint32) (*FinalityCertificate, error) {
+	respChan := make(chan *FinalityCertificate)
+	eng.msgChan <- &getCertificateMsg{
+		height:   height,
+		respChan: respChan,
+	}
+	if cert := <-respChan; cert != nil {
+		return cert, nil
+	}
+	if eng.ds == nil {
+		return nil, ErrCertificateNotFound
+	}
+	ser, err := eng.ds.Get(context.Background(), certificateKey(height))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrCertificateNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	cert := new(FinalityCertificate)
+	if err := cert.Deserialize(ser); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+func (eng *ConsensusEngine) putCertificate(cert *FinalityCertificate) error {
+	if eng.ds == nil {
+		return nil
+	}
+	ser, err := cert.Serialize()
+	if err != nil {
+		return err
+	}
+	return eng.ds.Put(context.Background(), certificateKey(cert.Height), ser)
+}
+
+func certificateKey(height uint32) datastore.Key {
+	return datastore.NewKey(repo.FinalityCertificateKeyPrefix + fmt.Sprintf("%010d", int(height)))
+}
+
 // HandleNewStream handles incoming streams from peers. We use one stream for
 // incoming and a separate one for outgoing.
 func (eng *ConsensusEngine) HandleNewStream(s inet.Stream) {
@@ -224,9 +304,18 @@ func (eng *ConsensusEngine) HandleNewStream(s inet.Stream) {
 
 func (eng *ConsensusEngine) handleNewMessage(s inet.Stream) {
 	defer s.Close()
+	remotePeer := s.Conn().RemotePeer()
+	ctx, cancel := context.WithTimeout(eng.ctx, time.Minute)
+	_, err := eng.network.WaitProtocolVersion(ctx, remotePeer)
+	cancel()
+	if err != nil {
+		log.Debugw("Rejecting avalanche stream without a negotiated protocol version", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
+		s.Reset()
+		return
+	}
+
 	contextReader := ctxio.NewReader(eng.ctx, s)
 	reader := msgio.NewVarintReaderSize(contextReader, inet.MessageSizeMax)
-	remotePeer := s.Conn().RemotePeer()
 	defer reader.Close()
 	ticker := time.NewTicker(time.Minute)
 
@@ -247,13 +336,13 @@ func (eng *ConsensusEngine) handleNewMessage(s inet.Stream) {
 				s.Close()
 				return
 			}
-			log.Debugf("Error reading from avalanche stream: peer: %s, error: %s", remotePeer, err.Error())
+			log.Debugw("Error reading from avalanche stream", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 			s.Reset()
 			return
 		}
 		if err := proto.Unmarshal(msgBytes, req); err != nil {
 			reader.ReleaseMsg(msgBytes)
-			log.Debugf("Error unmarshalling avalanche message: peer: %s, error: %s", remotePeer, err.Error())
+			log.Debugw("Error unmarshalling avalanche message", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 			s.Reset()
 			return
 		}
@@ -269,7 +358,7 @@ func (eng *ConsensusEngine) handleNewMessage(s inet.Stream) {
 		respMsg := <-respCh
 		err = net.WriteMsg(s, respMsg)
 		if err != nil {
-			log.Errorf("Error writing avalanche stream to peer %d", remotePeer)
+			log.Errorw("Error writing avalanche stream to peer", repo.LogFieldPeerID, remotePeer)
 			s.Reset()
 		}
 		ticker.Reset(time.Minute)
@@ -278,7 +367,7 @@ func (eng *ConsensusEngine) handleNewMessage(s inet.Stream) {
 
 func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer.ID, respChan chan *wire.MsgAvaResponse) {
 	if len(req.Heights) == 0 {
-		log.Debugf("Received empty avalanche request from peer %s", remotePeer)
+		log.Debugw("Received empty avalanche request", repo.LogFieldPeerID, remotePeer)
 		eng.network.IncreaseBanscore(remotePeer, 30, 0)
 		return
 	}
@@ -286,8 +375,9 @@ func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer
 		Request_ID: req.Request_ID,
 		Votes:      make([][]byte, 0, len(req.Heights)),
 	}
+	var toSign []int
 
-	for _, height := range req.Heights {
+	for i, height := range req.Heights {
 		preference := types.ID{}
 		record, ok := eng.blocks[height]
 		if !ok {
@@ -300,9 +390,40 @@ func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer
 		}
 
 		resp.Votes = append(resp.Votes, preference.Bytes())
+
+		if eng.privKey != nil && len(toSign) < MaxSignedVotesPerQuery && eng.params.AggregateSignaturesActive(height) && preference.Compare(types.ID{}) != 0 {
+			toSign = append(toSign, i)
+		}
+	}
+	if len(toSign) == 0 {
+		respChan <- resp
+		return
 	}
 
-	respChan <- resp
+	// Signing is done off the event loop so that queries do not hold
+	// up the engine.
+	go func() {
+		eng.signSem <- struct{}{}
+		defer func() { <-eng.signSem }()
+
+		var (
+			sigs   = make([][]byte, len(req.Heights))
+			signed bool
+		)
+		for _, i := range toSign {
+			sig, err := eng.privKey.Sign(VoteDigest(req.Heights[i], types.NewID(resp.Votes[i])))
+			if err != nil {
+				log.Errorf("Error signing avalanche vote: %s", err)
+				continue
+			}
+			sigs[i] = sig
+			signed = true
+		}
+		if signed {
+			resp.VoteSignatures = sigs
+		}
+		respChan <- resp
+	}()
 }
 
 func (eng *ConsensusEngine) handleRequestExpiration(key string, p peer.ID) {
@@ -358,7 +479,7 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 
 	r, ok := eng.queries[key]
 	if !ok {
-		log.Debugf("Received avalanche response from peer %s with an unknown request ID", p)
+		log.Debugw("Received avalanche response with an unknown request ID", repo.LogFieldPeerID, p)
 		eng.network.IncreaseBanscore(p, 30, 0)
 		return
 	}
@@ -367,14 +488,19 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 	delete(eng.queries, key)
 
 	if r.IsExpired() {
-		log.Debugf("Received avalanche response from peer %s with an expired request", p)
+		log.Debugw("Received avalanche response with an expired request", repo.LogFieldPeerID, p)
 		eng.network.IncreaseBanscore(p, 0, 20)
 		return
 	}
 
 	heights := r.GetHeights()
 	if len(resp.Votes) != len(heights) {
-		log.Debugf("Received avalanche response from peer %s with incorrect number of height votes", p)
+		log.Debugw("Received avalanche response with incorrect number of height votes", repo.LogFieldPeerID, p)
+		eng.network.IncreaseBanscore(p, 30, 0)
+		return
+	}
+	if len(resp.VoteSignatures) > 0 && len(resp.VoteSignatures) != len(heights) {
+		log.Debugw("Received avalanche response with incorrect number of vote signatures", repo.LogFieldPeerID, p)
 		eng.network.IncreaseBanscore(p, 30, 0)
 		return
 	}
@@ -391,7 +517,7 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 		}
 
 		if len(resp.Votes[i]) != hash.HashSize {
-			log.Debugf("Received avalanche response from peer %s with incorrect hash len", p)
+			log.Debugw("Received avalanche response with incorrect hash len", repo.LogFieldPeerID, p)
 			eng.network.IncreaseBanscore(p, 30, 0)
 			continue
 		}
@@ -406,8 +532,34 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 			voteID = types.ID{}
 		}
 
+		// Only signatures from validators are recorded. Signatures from
+		// other peers could never count towards a finality certificate.
+		if len(resp.VoteSignatures) > 0 && len(resp.VoteSignatures[i]) > 0 && voteID.Compare(types.ID{}) != 0 && eng.params.AggregateSignaturesActive(height) && eng.validatorSet.ValidatorExists(p) {
+			if eng.verifyVoteSignature(p, height, voteID, resp.VoteSignatures[i]) {
+				bc.RecordVoteSignature(voteID, p, resp.VoteSignatures[i])
+			} else {
+				log.Debugw("Received avalanche response with invalid vote signature", repo.LogFieldPeerID, p)
+				eng.network.IncreaseBanscore(p, 30, 0)
+			}
+		}
+
 		// Block finalized, fire callbacks
 		if finalizedID, ok := bc.RecordVote(voteID); ok {
+			header, ok := bc.Header(finalizedID)
+			if sigs := bc.VoteSignatures(finalizedID); ok && len(sigs) > 0 {
+				cert, err := NewFinalityCertificate(header, sigs)
+				if err == nil {
+					err = cert.Verify(eng.validatorSet.Validators())
+				}
+				if err != nil {
+					log.Debugw("Unable to build finality certificate", repo.LogFieldBlockID, finalizedID, repo.LogFieldError, err)
+				} else {
+					eng.certificates[height] = cert
+					if err := eng.putCertificate(cert); err != nil {
+						log.Errorw("Error saving finality certificate", repo.LogFieldBlockID, finalizedID, repo.LogFieldError, err)
+					}
+				}
+			}
 			callback, ok := eng.callbacks[finalizedID]
 			if ok && callback != nil {
 				delete(eng.callbacks, finalizedID)
@@ -444,6 +596,7 @@ func (eng *ConsensusEngine) pollLoop() {
 	for height, record := range eng.blocks {
 		if time.Since(record.timestamp) > DeleteInventoryAfter {
 			delete(eng.blocks, height)
+			delete(eng.certificates, height)
 			continue
 		}
 
@@ -475,6 +628,15 @@ func (eng *ConsensusEngine) pollLoop() {
 	go eng.queueMessageToPeer(req, p)
 }
 
+func (eng *ConsensusEngine) verifyVoteSignature(p peer.ID, height uint32, voteID types.ID, sig []byte) bool {
+	pubkey, err := p.ExtractPublicKey()
+	if err != nil {
+		return false
+	}
+	valid, err := pubkey.Verify(VoteDigest(height, voteID), sig)
+	return err == nil && valid
+}
+
 func queryKey(requestID uint32, peerID string) string {
 	return fmt.Sprintf("%d|%s", requestID, peerID)
 }
@@ -6,10 +6,13 @@ package consensus
 
 import (
 	"context"
+	crand "crypto/rand"
 	"errors"
 	"fmt"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
@@ -17,6 +20,7 @@ import (
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/types/wire"
 	"github.com/stretchr/testify/assert"
 	rand "math/rand"
 	"testing"
@@ -45,6 +49,28 @@ func (m *MockValConn) ConnectedStakePercentage() float64 {
 	return 100
 }
 
+// MockValidatorSet is a mock ValidatorSet for testing. If validators
+// is nil every peer is treated as a validator.
+type MockValidatorSet struct {
+	validators []*blockchain.Validator
+}
+
+func (m *MockValidatorSet) ValidatorExists(validatorID peer.ID) bool {
+	if m.validators == nil {
+		return true
+	}
+	for _, v := range m.validators {
+		if v.PeerID == validatorID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MockValidatorSet) Validators() []*blockchain.Validator {
+	return m.validators
+}
+
 type mockNode struct {
 	engine *ConsensusEngine
 }
@@ -57,10 +83,10 @@ func newMockNode(mn mocknet.Mocknet) (*mockNode, error) {
 	network, err := net.NewNetwork(context.Background(), []net.Option{
 		net.WithHost(host),
 		net.Params(&params.RegestParams),
-		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+		net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
 			return nil
 		}),
-		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+		net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
 			return nil
 		}),
 		net.Datastore(mock.NewMapDatastore()),
@@ -74,6 +100,7 @@ func newMockNode(mn mocknet.Mocknet) (*mockNode, error) {
 		Network(network),
 		ValidatorConnector(&MockValConn{}),
 		Chooser(&MockChooser{network: network}),
+		Validators(&MockValidatorSet{}),
 		GetBlockID(func(height uint32) (types.ID, error) { return types.ID{}, errors.New("not found") }),
 		RequestBlock(func(id types.ID, id2 peer.ID) {}),
 		PeerID(network.Host().ID()),
@@ -481,3 +508,82 @@ func TestConsensusEngine(t *testing.T) {
 		}
 	})
 }
+
+func TestHandleQuerySignsLimitedVotes(t *testing.T) {
+	sk, _, err := crypto.GenerateEd25519Key(crand.Reader)
+	assert.NoError(t, err)
+
+	netParams := params.RegestParams
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeAggregateSignatures: 0}
+	blockID := randomBlockID()
+	eng := &ConsensusEngine{
+		params:     &netParams,
+		privKey:    sk,
+		blocks:     make(map[uint32]*BlockChoice),
+		getBlockID: func(height uint32) (types.ID, error) { return blockID, nil },
+		signSem:    make(chan struct{}, 1),
+	}
+
+	heights := make([]uint32, MaxSignedVotesPerQuery+10)
+	for i := range heights {
+		heights[i] = uint32(i)
+	}
+	respChan := make(chan *wire.MsgAvaResponse, 1)
+	eng.handleQuery(&wire.MsgAvaRequest{Heights: heights}, "", respChan)
+	resp := <-respChan
+
+	assert.Len(t, resp.Votes, len(heights))
+	assert.Len(t, resp.VoteSignatures, len(heights))
+	for i, sig := range resp.VoteSignatures {
+		if i < MaxSignedVotesPerQuery {
+			valid, err := sk.GetPublic().Verify(VoteDigest(heights[i], blockID), sig)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+		} else {
+			assert.Empty(t, sig)
+		}
+	}
+}
+
+func TestHandleRegisterVotesValidatorSignatures(t *testing.T) {
+	sk, _, err := crypto.GenerateEd25519Key(crand.Reader)
+	assert.NoError(t, err)
+	p, err := peer.IDFromPrivateKey(sk)
+	assert.NoError(t, err)
+
+	netParams := params.RegestParams
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeAggregateSignatures: 0}
+	blockID := randomBlockID()
+	sig, err := sk.Sign(VoteDigest(1, blockID))
+	assert.NoError(t, err)
+
+	valSet := &MockValidatorSet{validators: []*blockchain.Validator{{PeerID: randomPeerID(t)}}}
+	eng := &ConsensusEngine{
+		params:       &netParams,
+		chooser:      NewBackoffChooser(&MockChooser{}),
+		blocks:       make(map[uint32]*BlockChoice),
+		queries:      make(map[string]RequestRecord),
+		callbacks:    make(map[types.ID]chan<- Status),
+		validatorSet: valSet,
+	}
+	bc := NewBlockChoice(1)
+	bc.AddNewBlock(blockID, true)
+	eng.blocks[1] = bc
+
+	vote := func() {
+		eng.queries[queryKey(1, p.String())] = NewRequestRecord(time.Now().Unix(), []uint32{1})
+		eng.handleRegisterVotes(p, &wire.MsgAvaResponse{
+			Request_ID:     1,
+			Votes:          [][]byte{blockID.Bytes()},
+			VoteSignatures: [][]byte{sig},
+		})
+	}
+
+	// The signature is valid but the peer is not a validator.
+	vote()
+	assert.Empty(t, bc.VoteSignatures(blockID))
+
+	valSet.validators = append(valSet.validators, &blockchain.Validator{PeerID: p})
+	vote()
+	assert.Equal(t, map[peer.ID][]byte{p: sig}, bc.VoteSignatures(blockID))
+}
@@ -5,10 +5,12 @@
 package consensus
 
 import (
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
 )
 
 // AssertError identifies an error that indicates an internal code consistency
@@ -67,6 +69,18 @@ func ValidatorConnector(valConn ValidatorSetConnection) Option {
 	}
 }
 
+// Validators is an implementation of the ValidatorSet interface used
+// to check that vote signatures come from validators and that finality
+// certificates carry enough stake.
+//
+// This option is required.
+func Validators(vs ValidatorSet) Option {
+	return func(cfg *config) error {
+		cfg.validatorSet = vs
+		return nil
+	}
+}
+
 // RequestBlock is a function which requests to download a block
 // from the given peer.
 //
@@ -99,13 +113,40 @@ func PeerID(self peer.ID) Option {
 	}
 }
 
+// PrivateKey is the node's network private key. If set, and if
+// aggregate signatures are active, the node will sign its avalanche
+// votes so that other nodes can build finality certificates.
+//
+// This option is optional.
+func PrivateKey(key crypto.PrivKey) Option {
+	return func(cfg *config) error {
+		cfg.privKey = key
+		return nil
+	}
+}
+
+// Datastore is an implementation of the repo.Datastore interface. If
+// set, finality certificates are persisted so that they remain
+// available after the block leaves the engine.
+//
+// This option is optional.
+func Datastore(ds repo.Datastore) Option {
+	return func(cfg *config) error {
+		cfg.datastore = ds
+		return nil
+	}
+}
+
 // Config specifies the blockchain configuration.
 type config struct {
 	params         *params.NetworkParams
 	network        *net.Network
 	valConn        ValidatorSetConnection
 	chooser        blockchain.WeightedChooser
+	validatorSet   ValidatorSet
 	self           peer.ID
+	privKey        crypto.PrivKey
+	datastore      repo.Datastore
 	requestBlock   RequestBlockFunc
 	getBlockIDFunc GetBlockIDFunc
 }
@@ -126,6 +167,9 @@ func (cfg *config) validate() error {
 	if cfg.chooser == nil {
 		return AssertError("NewConsensusEngine: chooser cannot be nil")
 	}
+	if cfg.validatorSet == nil {
+		return AssertError("NewConsensusEngine: validator set cannot be nil")
+	}
 	if cfg.requestBlock == nil {
 		return AssertError("NewConsensusEngine: requestBlockFunc cannot be nil")
 	}
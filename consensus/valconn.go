@@ -4,6 +4,18 @@
 
 package consensus
 
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+)
+
 type ValidatorSetConnection interface {
 	ConnectedStakePercentage() float64
 }
+
+// ValidatorSet is used to look up the validators whose vote signatures
+// are recorded and aggregated into finality certificates.
+type ValidatorSet interface {
+	ValidatorExists(validatorID peer.ID) bool
+	Validators() []*blockchain.Validator
+}
@@ -5,7 +5,9 @@
 package consensus
 
 import (
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
 	"time"
 )
 
@@ -70,21 +72,53 @@ type BlockChoice struct {
 	height           uint32
 	bitRecord        *BitVoteRecord
 	blockVotes       map[types.ID]*BlockVoteRecord
+	headers          map[types.ID]*blocks.BlockHeader
 	inflightRequests int
 	timestamp        time.Time
 	totalVotes       int
+	voteSignatures   map[types.ID]map[peer.ID][]byte
 }
 
 // NewBlockChoice returns a new BlockChoice for this height
 func NewBlockChoice(height uint32) *BlockChoice {
 	return &BlockChoice{
-		height:     height,
-		bitRecord:  &BitVoteRecord{},
-		blockVotes: make(map[types.ID]*BlockVoteRecord),
-		timestamp:  time.Now(),
+		height:         height,
+		bitRecord:      &BitVoteRecord{},
+		blockVotes:     make(map[types.ID]*BlockVoteRecord),
+		headers:        make(map[types.ID]*blocks.BlockHeader),
+		timestamp:      time.Now(),
+		voteSignatures: make(map[types.ID]map[peer.ID][]byte),
 	}
 }
 
+// RecordVoteSignature saves a validator's signature over its vote for
+// the block. Only the most recent signature from each validator is kept.
+func (bc *BlockChoice) RecordVoteSignature(blockID types.ID, p peer.ID, sig []byte) {
+	sigs, ok := bc.voteSignatures[blockID]
+	if !ok {
+		sigs = make(map[peer.ID][]byte)
+		bc.voteSignatures[blockID] = sigs
+	}
+	sigs[p] = sig
+}
+
+// VoteSignatures returns the vote signatures recorded for the block.
+func (bc *BlockChoice) VoteSignatures(blockID types.ID) map[peer.ID][]byte {
+	return bc.voteSignatures[blockID]
+}
+
+// RecordHeader saves the header of a block at this height so that
+// its producer's signature can be included in a finality certificate.
+func (bc *BlockChoice) RecordHeader(header *blocks.BlockHeader) {
+	bc.headers[header.ID()] = header
+}
+
+// Header returns the recorded header of the block.
+func (bc *BlockChoice) Header(blockID types.ID) (*blocks.BlockHeader, bool) {
+	header, ok := bc.headers[blockID]
+	return header, ok
+}
+
 // GetPreference returns the current block preference at this height
 // or a zero ID if there is no acceptable choice.
 func (bc *BlockChoice) GetPreference() types.ID {
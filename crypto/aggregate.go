@@ -0,0 +1,183 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+)
+
+const aggregateDomain = "illium/aggregate-signature/v1"
+
+var (
+	// ErrAggregateKeyType is returned when attempting to aggregate a
+	// signature from a key that is not an Ed25519 key.
+	ErrAggregateKeyType = errors.New("aggregate signatures require ed25519 keys")
+
+	// ErrAggregateLength is returned when the number of keys, messages
+	// and signatures do not match.
+	ErrAggregateLength = errors.New("aggregate signature input length mismatch")
+
+	// ErrAggregateMalformed is returned when a signature, or the
+	// aggregate signature, does not decode.
+	ErrAggregateMalformed = errors.New("malformed aggregate signature")
+)
+
+// AggregateSignatures aggregates a list of Ed25519 signatures, each over
+// its own message, into a single signature.
+//
+// Ed25519 signatures are Schnorr signatures and can be half-aggregated
+// non-interactively: the aggregate keeps the R value of each signature
+// but combines the s values into one scalar, using random coefficients
+// derived from all the inputs. The aggregate is 32 bytes per signature
+// plus 32 bytes for the combined scalar, roughly half the size of the
+// individual signatures, and it can be verified in a single multi-scalar
+// multiplication. Unlike a BLS aggregate it is not constant-size and
+// still grows linearly with the number of signatures.
+//
+// The signatures are not verified. The caller is expected to only
+// aggregate signatures that it has already verified.
+func AggregateSignatures(pubkeys []crypto.PubKey, messages [][]byte, sigs [][]byte) ([]byte, error) {
+	if len(sigs) != len(pubkeys) {
+		return nil, ErrAggregateLength
+	}
+	rawKeys, err := aggregateKeys(pubkeys, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([][]byte, len(sigs))
+	ss := make([]*edwards25519.Scalar, len(sigs))
+	for i, sig := range sigs {
+		if len(sig) != 64 {
+			return nil, ErrAggregateMalformed
+		}
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+		if err != nil {
+			return nil, ErrAggregateMalformed
+		}
+		rs[i] = sig[:32]
+		ss[i] = s
+	}
+
+	zs := aggregateCoefficients(rs, rawKeys, messages)
+	sAgg := edwards25519.NewScalar()
+	for i := range ss {
+		sAgg.MultiplyAdd(zs[i], ss[i], sAgg)
+	}
+
+	agg := make([]byte, 0, 32*(len(rs)+1))
+	for _, r := range rs {
+		agg = append(agg, r...)
+	}
+	return append(agg, sAgg.Bytes()...), nil
+}
+
+// VerifyAggregateSignature verifies an aggregate signature created by
+// AggregateSignatures. The keys and messages must be in the same order
+// as when the signature was aggregated.
+func VerifyAggregateSignature(pubkeys []crypto.PubKey, messages [][]byte, aggSig []byte) (bool, error) {
+	rawKeys, err := aggregateKeys(pubkeys, messages)
+	if err != nil {
+		return false, err
+	}
+	n := len(rawKeys)
+	if len(aggSig) != 32*(n+1) {
+		return false, ErrAggregateMalformed
+	}
+
+	rs := make([][]byte, n)
+	for i := range rs {
+		rs[i] = aggSig[i*32 : (i+1)*32]
+	}
+	sAgg, err := edwards25519.NewScalar().SetCanonicalBytes(aggSig[n*32:])
+	if err != nil {
+		return false, ErrAggregateMalformed
+	}
+
+	var (
+		zs      = aggregateCoefficients(rs, rawKeys, messages)
+		scalars = make([]*edwards25519.Scalar, 0, n*2)
+		points  = make([]*edwards25519.Point, 0, n*2)
+	)
+	for i := 0; i < n; i++ {
+		r, err := new(edwards25519.Point).SetBytes(rs[i])
+		if err != nil {
+			return false, nil
+		}
+		a, err := new(edwards25519.Point).SetBytes(rawKeys[i])
+		if err != nil {
+			return false, nil
+		}
+
+		h := sha512.New()
+		h.Write(rs[i])
+		h.Write(rawKeys[i])
+		h.Write(messages[i])
+		k, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+		if err != nil {
+			return false, err
+		}
+
+		scalars = append(scalars, zs[i], edwards25519.NewScalar().Multiply(zs[i], k))
+		points = append(points, r, a)
+	}
+
+	// s·B == Σ z_i·R_i + Σ z_i·k_i·A_i
+	lhs := new(edwards25519.Point).ScalarBaseMult(sAgg)
+	rhs := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+	return lhs.Equal(rhs) == 1, nil
+}
+
+func aggregateKeys(pubkeys []crypto.PubKey, messages [][]byte) ([][]byte, error) {
+	if len(pubkeys) == 0 || len(pubkeys) != len(messages) {
+		return nil, ErrAggregateLength
+	}
+	rawKeys := make([][]byte, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		if pubkey == nil || pubkey.Type() != pb.KeyType_Ed25519 {
+			return nil, ErrAggregateKeyType
+		}
+		raw, err := pubkey.Raw()
+		if err != nil {
+			return nil, err
+		}
+		rawKeys[i] = raw
+	}
+	return rawKeys, nil
+}
+
+// aggregateCoefficients derives the coefficient for each signature. The
+// coefficients commit to every key, message and R value so that no single
+// signer can choose its contribution to cancel out the others.
+func aggregateCoefficients(rs, rawKeys, messages [][]byte) []*edwards25519.Scalar {
+	var lenBuf [8]byte
+	h := sha512.New()
+	h.Write([]byte(aggregateDomain))
+	for i := range rs {
+		h.Write(rs[i])
+		h.Write(rawKeys[i])
+		binary.BigEndian.PutUint64(lenBuf[:], uint64(len(messages[i])))
+		h.Write(lenBuf[:])
+		h.Write(messages[i])
+	}
+	seed := h.Sum(nil)
+
+	zs := make([]*edwards25519.Scalar, len(rs))
+	for i := range zs {
+		h.Reset()
+		h.Write(seed)
+		binary.BigEndian.PutUint64(lenBuf[:], uint64(i))
+		h.Write(lenBuf[:])
+		// SetUniformBytes only fails if the input is not 64 bytes.
+		zs[i], _ = edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	}
+	return zs
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateSignatures(t *testing.T) {
+	var (
+		pubkeys  []crypto.PubKey
+		messages [][]byte
+		sigs     [][]byte
+	)
+	for i := 0; i < 20; i++ {
+		priv, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+		assert.NoError(t, err)
+		msg := hash.HashFunc([]byte{byte(i)})
+		sig, err := priv.Sign(msg)
+		assert.NoError(t, err)
+
+		pubkeys = append(pubkeys, pub)
+		messages = append(messages, msg)
+		sigs = append(sigs, sig)
+	}
+
+	agg, err := AggregateSignatures(pubkeys, messages, sigs)
+	assert.NoError(t, err)
+	assert.Len(t, agg, 32*21)
+
+	valid, err := VerifyAggregateSignature(pubkeys, messages, agg)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// Wrong message
+	messages2 := append([][]byte{}, messages...)
+	messages2[3] = hash.HashFunc([]byte("fake message"))
+	valid, err = VerifyAggregateSignature(pubkeys, messages2, agg)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Keys out of order
+	pubkeys2 := append([]crypto.PubKey{}, pubkeys...)
+	pubkeys2[0], pubkeys2[1] = pubkeys2[1], pubkeys2[0]
+	valid, err = VerifyAggregateSignature(pubkeys2, messages, agg)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// One invalid signature
+	sigs2 := append([][]byte{}, sigs...)
+	sigs2[5] = sigs[6]
+	agg2, err := AggregateSignatures(pubkeys, messages, sigs2)
+	assert.NoError(t, err)
+	valid, err = VerifyAggregateSignature(pubkeys, messages, agg2)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Subset
+	valid, err = VerifyAggregateSignature(pubkeys[:19], messages[:19], agg)
+	assert.ErrorIs(t, err, ErrAggregateMalformed)
+	assert.False(t, valid)
+
+	// Wrong key type
+	_, curvePub, err := GenerateCurve25519Key(rand.Reader)
+	assert.NoError(t, err)
+	pubkeys2[0] = curvePub
+	_, err = AggregateSignatures(pubkeys2, messages, sigs)
+	assert.ErrorIs(t, err, ErrAggregateKeyType)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+type verifierEntry struct {
+	pubkey    crypto.PubKey
+	message   []byte
+	signature []byte
+}
+
+// ConcurrentVerifier accumulates (pubkey, message, signature) triples and
+// verifies each of them individually across all available CPUs.
+//
+// This is not batch verification. Every signature costs the same as a
+// call to PubKey.Verify, the work is only spread across goroutines.
+// Ed25519 signatures are deliberately not combined into a randomized
+// batch equation. A batch can only soundly check the cofactored equation,
+// which accepts signatures with a small-order component that
+// ed25519.Verify, used by the mempool and by other nodes, rejects.
+// Accepting them here would let a block through that the rest of the
+// network considers invalid. The nova library does not expose a batched
+// verification either.
+type ConcurrentVerifier struct {
+	entries []verifierEntry
+}
+
+// NewConcurrentVerifier returns a new, empty ConcurrentVerifier.
+func NewConcurrentVerifier() *ConcurrentVerifier {
+	return &ConcurrentVerifier{}
+}
+
+func (cv *ConcurrentVerifier) Add(pubkey crypto.PubKey, message, signature []byte) int {
+	cv.entries = append(cv.entries, verifierEntry{
+		pubkey:    pubkey,
+		message:   message,
+		signature: signature,
+	})
+	return len(cv.entries) - 1
+}
+
+// Len returns the number of signatures added to the verifier.
+func (cv *ConcurrentVerifier) Len() int {
+	return len(cv.entries)
+}
+
+// Verify verifies all the signatures added to the verifier. If any
+// signature is invalid, false is returned along with the indexes, in
+// ascending order, of the invalid signatures.
+func (cv *ConcurrentVerifier) Verify() (bool, []int) {
+	if len(cv.entries) == 0 {
+		return true, nil
+	}
+
+	valid := make([]bool, len(cv.entries))
+	cv.verifyParallel(valid)
+
+	var invalid []int
+	for i, v := range valid {
+		if !v {
+			invalid = append(invalid, i)
+		}
+	}
+	return len(invalid) == 0, invalid
+}
+
+func (cv *ConcurrentVerifier) verifyParallel(valid []bool) {
+	workers := runtime.NumCPU()
+	if workers > len(cv.entries) {
+		workers = len(cv.entries)
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(cv.entries); i += workers {
+				valid[i] = cv.entries[i].verify()
+			}
+		}(w)
+	}
+	wg.Wait()
+}
+
+func (e verifierEntry) verify() bool {
+	if e.pubkey == nil {
+		return false
+	}
+	ok, err := e.pubkey.Verify(e.message, e.signature)
+	return err == nil && ok
+}
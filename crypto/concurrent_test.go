@@ -0,0 +1,131 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentVerifier(t *testing.T) {
+	cv := NewConcurrentVerifier()
+	valid, invalid := cv.Verify()
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+
+	edPriv, edPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		msg := hash.HashFunc([]byte{byte(i)})
+		sig, err := edPriv.Sign(msg)
+		assert.NoError(t, err)
+		assert.Equal(t, i, cv.Add(edPub, msg, sig))
+	}
+	assert.Equal(t, 10, cv.Len())
+
+	valid, invalid = cv.Verify()
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+
+	msg := hash.HashFunc([]byte("message"))
+	sig, err := edPriv.Sign(msg)
+	assert.NoError(t, err)
+	cv.Add(edPub, hash.HashFunc([]byte("fake message")), sig)
+	cv.Add(nil, msg, sig)
+
+	valid, invalid = cv.Verify()
+	assert.False(t, valid)
+	assert.Equal(t, []int{10, 11}, invalid)
+}
+
+func TestConcurrentVerifierEd25519(t *testing.T) {
+	cv := NewConcurrentVerifier()
+	for i := 0; i < 50; i++ {
+		priv, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+		assert.NoError(t, err)
+		msg := hash.HashFunc([]byte{byte(i)})
+		sig, err := priv.Sign(msg)
+		assert.NoError(t, err)
+		if i == 17 || i == 42 {
+			sig[40] ^= 0x01
+		}
+		cv.Add(pub, msg, sig)
+	}
+
+	valid, invalid := cv.Verify()
+	assert.False(t, valid)
+	assert.Equal(t, []int{17, 42}, invalid)
+}
+
+// TestConcurrentVerifierSmallOrder checks that a signature which only passes
+// the cofactored Ed25519 equation is rejected,
+// as it is by ed25519.Verify.
+func TestConcurrentVerifierSmallOrder(t *testing.T) {
+	// A point of order 8.
+	torsionBytes, err := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	assert.NoError(t, err)
+	torsion, err := new(edwards25519.Point).SetBytes(torsionBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, new(edwards25519.Point).MultByCofactor(torsion).Equal(edwards25519.NewIdentityPoint()))
+
+	// The public key A = a·B + T has a small-order component. A signature
+	// s = r + k·a satisfies 8·s·B == 8·(R + k·A) but s·B == R + k·A
+	// only holds if k is a multiple of 8.
+	random := func() *edwards25519.Scalar {
+		b := make([]byte, 64)
+		_, err := rand.Read(b)
+		assert.NoError(t, err)
+		sc, err := edwards25519.NewScalar().SetUniformBytes(b)
+		assert.NoError(t, err)
+		return sc
+	}
+	a := random()
+	pubBytes := new(edwards25519.Point).Add(new(edwards25519.Point).ScalarBaseMult(a), torsion).Bytes()
+	pub, err := crypto.UnmarshalEd25519PublicKey(pubBytes)
+	assert.NoError(t, err)
+
+	msg := hash.HashFunc([]byte("message"))
+	var sig []byte
+	for sig == nil {
+		r := random()
+		rBytes := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+		h := sha512.New()
+		h.Write(rBytes)
+		h.Write(pubBytes)
+		h.Write(msg)
+		k, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+		assert.NoError(t, err)
+		if k.Bytes()[0]%8 == 0 {
+			continue
+		}
+		s := edwards25519.NewScalar().MultiplyAdd(k, a, r)
+		sig = append(rBytes, s.Bytes()...)
+
+		// The signature does pass the cofactored equation.
+		pubPoint, err := new(edwards25519.Point).SetBytes(pubBytes)
+		assert.NoError(t, err)
+		check := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(k, pubPoint, edwards25519.NewScalar().Negate(s))
+		check.Add(check, new(edwards25519.Point).ScalarBaseMult(r))
+		assert.Equal(t, 1, check.MultByCofactor(check).Equal(edwards25519.NewIdentityPoint()))
+	}
+
+	valid, err := pub.Verify(msg, sig)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	cv := NewConcurrentVerifier()
+	cv.Add(pub, msg, sig)
+	valid, invalid := cv.Verify()
+	assert.False(t, valid)
+	assert.Equal(t, []int{0}, invalid)
+}
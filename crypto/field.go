@@ -0,0 +1,110 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"math/big"
+
+	"github.com/project-illium/ilxd/params/hash"
+)
+
+// FieldElementSize is the size of a serialized field element or scalar.
+const FieldElementSize = 32
+
+// These helpers are the hash primitives used throughout illium to produce
+// values that can be used inside lurk programs. Lurk operates over the
+// Vesta base field and Nova signatures over the Vesta scalar field, both
+// of which are slightly smaller than 2^255, so a plain 256 bit hash cannot
+// be used directly.
+//
+// HashToField and HashToScalar use Poseidon over the lurk field so that
+// their output can be recomputed inside a lurk program. Commitments and
+// nullifiers are Poseidon hashes of lurk expressions and are computed by
+// zk.LurkCommit, which calls into the same hash function used by the
+// circuits.
+
+// FieldModulus returns the modulus of the field that lurk programs operate
+// over. This is the Vesta base field.
+func FieldModulus() *big.Int {
+	return new(big.Int).Set(vestaP)
+}
+
+// ScalarModulus returns the order of the Vesta group. Nova signature
+// scalars and message digests are elements of this field.
+func ScalarModulus() *big.Int {
+	return new(big.Int).Set(vestaN)
+}
+
+// Digest returns the 32 byte digest of the data. This is the same hash
+// used for transaction IDs, block IDs and sighashes. The top two bits
+// are cleared so the digest is always a valid field element.
+func Digest(data []byte) []byte {
+	return hash.HashFunc(data)
+}
+
+// TwoToOne hashes two digests into one. This is the compression function
+// used for the nodes of the transaction and TXO merkle trees.
+func TwoToOne(left, right []byte) []byte {
+	return hash.HashMerkleBranches(left, right)
+}
+
+// HashToField hashes the domain separation tag and data to an element of
+// the lurk field with Poseidon. The tag and each data item are prefixed
+// with their length and split into 31 byte big endian field elements.
+// The result is big endian.
+func HashToField(domain string, data ...[]byte) []byte {
+	return hashToField(domain, data...).bigInt().FillBytes(make([]byte, FieldElementSize))
+}
+
+// HashToScalar hashes the domain separation tag and data to a Vesta
+// scalar. It is HashToField reduced by the group order. The lurk field is
+// larger than the group order by less than 2^127 so the bias from the
+// reduction is negligible. The result is big endian.
+func HashToScalar(domain string, data ...[]byte) []byte {
+	x := hashToField(domain, data...).bigInt()
+	return x.Mod(x, vestaN).FillBytes(make([]byte, FieldElementSize))
+}
+
+func hashToField(domain string, data ...[]byte) *vestaFieldElement {
+	elems := packFieldElements(nil, []byte(domain))
+	for _, d := range data {
+		elems = packFieldElements(elems, d)
+	}
+	return poseidonHash(elems)
+}
+
+// packFieldElements appends the length of b followed by b split into 31
+// byte chunks, each of which is less than the field modulus.
+func packFieldElements(elems []*vestaFieldElement, b []byte) []*vestaFieldElement {
+	elems = append(elems, newVestaFieldElement(big.NewInt(int64(len(b)))))
+	for len(b) > 0 {
+		n := FieldElementSize - 1
+		if len(b) < n {
+			n = len(b)
+		}
+		elems = append(elems, newVestaFieldElement(new(big.Int).SetBytes(b[:n])))
+		b = b[n:]
+	}
+	return elems
+}
+
+// IsFieldElement returns whether the big endian bytes are a canonical
+// element of the lurk field.
+func IsFieldElement(b []byte) bool {
+	return len(b) == FieldElementSize && new(big.Int).SetBytes(b).Cmp(vestaP) < 0
+}
+
+// hashToModulus reduces a 512 bit SHA512 hash by the modulus. The bias
+// from the reduction is negligible. It is used by MuSig, which must match
+// the Rust implementation, rather than for values used in lurk programs.
+func hashToModulus(modulus *big.Int, domain string, data ...[]byte) *big.Int {
+	h := sha512.New()
+	h.Write([]byte(domain))
+	for _, d := range data {
+		h.Write(d)
+	}
+	return new(big.Int).Mod(new(big.Int).SetBytes(h.Sum(nil)), modulus)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldHashes(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		data := make([]byte, 32)
+		rand.Read(data)
+
+		assert.True(t, IsFieldElement(Digest(data)))
+		assert.True(t, IsFieldElement(HashToField("test", data)))
+		assert.True(t, new(big.Int).SetBytes(HashToScalar("test", data)).Cmp(ScalarModulus()) < 0)
+		assert.True(t, IsFieldElement(TwoToOne(data, Digest(data))))
+	}
+
+	assert.Equal(t, hash.HashFunc([]byte("data")), Digest([]byte("data")))
+	assert.False(t, bytes.Equal(HashToField("a", []byte("data")), HashToField("b", []byte("data"))))
+	assert.Equal(t, HashToField("a", []byte("data")), HashToField("a", []byte("data")))
+
+	assert.False(t, IsFieldElement(FieldModulus().FillBytes(make([]byte, 32))))
+	assert.False(t, IsFieldElement([]byte{0x01}))
+}
+
+func TestPoseidon(t *testing.T) {
+	fe := func(i int64) []byte {
+		return big.NewInt(i).FillBytes(make([]byte, FieldElementSize))
+	}
+	hexDecode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		assert.NoError(t, err)
+		return b
+	}
+
+	// The first round constant produced by the Grain LFSR for the
+	// P128Pow5T3 parameters.
+	assert.Equal(t, "360d7470611e473d353f628f76d110f34e71162f31003b7057538c2596426303", poseidonRoundConstants[0][0].bigInt().Text(16))
+
+	tests := []struct {
+		inputs   [][]byte
+		expected string
+	}{
+		{nil, "245b3b693364c69d2fd153784e0cebe54949e8361278969add5c2af5cc54a942"},
+		{[][]byte{fe(0), fe(1)}, "15ba96df939d77224664b1e35e194f514e3101097a6b54bff357297085f6684e"},
+		{[][]byte{fe(1), fe(2)}, "17e339dde8963d3be0e56a84ec41a422f3c72ecb2155f355d957e55cb395348c"},
+		{[][]byte{fe(1), fe(2), fe(3)}, "30aab7e4f7f9595340bec49ef719938f5d4167f92d366529433f2bc6135c8b58"},
+	}
+	for _, test := range tests {
+		h, err := Poseidon(test.inputs...)
+		assert.NoError(t, err)
+		assert.Equal(t, hexDecode(test.expected), h)
+	}
+
+	assert.Equal(t, hexDecode("19d32b50ee1274038b005986841b12be92c37a99900348d3d0e99859f5bdb02a"), HashToField("illium", []byte("data")))
+
+	_, err := Poseidon(FieldModulus().FillBytes(make([]byte, FieldElementSize)))
+	assert.ErrorIs(t, err, ErrNotFieldElement)
+	_, err = Poseidon([]byte{0x01})
+	assert.ErrorIs(t, err, ErrNotFieldElement)
+}
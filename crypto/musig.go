@@ -0,0 +1,418 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+const (
+	musigKeyAggTag    = "illium/musig/keyagg"
+	musigKeyListTag   = "illium/musig/keylist"
+	musigNonceTag     = "illium/musig/nonce"
+	musigNonceCoefTag = "illium/musig/noncecoef"
+)
+
+var (
+	// ErrMuSigKeyType is returned when a key passed to MuSig is not
+	// a Nova key.
+	ErrMuSigKeyType = errors.New("musig requires nova keys")
+
+	// ErrMuSigNotParticipant is returned when the private key does not
+	// belong to any of the participant public keys.
+	ErrMuSigNotParticipant = errors.New("private key is not a musig participant")
+
+	// ErrMuSigCommitments is returned when the nonce commitments do not
+	// contain exactly one valid commitment from every participant.
+	ErrMuSigCommitments = errors.New("invalid musig nonce commitments")
+
+	// ErrMuSigPartialSignature is returned when a partial signature does
+	// not verify.
+	ErrMuSigPartialSignature = errors.New("invalid musig partial signature")
+)
+
+// MuSigSigner is one participant in an n-of-n MuSig2 signing session
+// over Nova spend keys.
+//
+// The participants' public keys are combined into a single aggregate
+// Nova public key. Two rounds of communication produce a single Nova
+// signature under that key, which the standard single key transfer
+// script accepts. On chain, a multisig spend is indistinguishable from
+// a spend by a single key.
+//
+// The Nova signature is s·G = R + c·PK, where c is the signature digest.
+// With the aggregate key PK = Σ a_i·PK_i, each participant contributes
+// s_i = r_i + c·a_i·sk_i and the partial signatures add up to a valid
+// signature. The key coefficients a_i prevent a participant from
+// choosing its key to cancel out the others.
+type MuSigSigner struct {
+	keys   [][]byte
+	points []*vestaPoint
+	coefs  []*big.Int
+	aggKey *vestaPoint
+	index  int
+	secret *big.Int
+}
+
+// MuSigCommitment is a participant's public commitment to its nonces.
+// It is sent to every other participant in the first round.
+type MuSigCommitment struct {
+	PubKey []byte
+	R1     []byte
+	R2     []byte
+}
+
+// MuSigNonce holds the secret nonces for one signing session. A nonce
+// must only ever be used to sign a single message.
+type MuSigNonce struct {
+	r1         *big.Int
+	r2         *big.Int
+	commitment MuSigCommitment
+	used       bool
+}
+
+// Commitment returns the public commitment to the nonces.
+func (n *MuSigNonce) Commitment() MuSigCommitment {
+	return n.commitment
+}
+
+// MuSigPartialSignature is a participant's contribution to the
+// signature. It is sent to the aggregator in the second round.
+type MuSigPartialSignature struct {
+	PubKey []byte
+	S      []byte
+}
+
+// AggregateNovaPublicKeys returns the MuSig aggregate public key for the
+// participant keys. The order of the keys does not matter.
+func AggregateNovaPublicKeys(pubkeys []crypto.PubKey) (crypto.PubKey, error) {
+	_, points, coefs, err := musigKeyAgg(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	return novaPublicKeyFromPoint(musigAggregatePoint(points, coefs)), nil
+}
+
+// NewMuSigSigner returns a new signer for the private key. The private
+// key's public key must be one of the participant public keys.
+func NewMuSigSigner(priv crypto.PrivKey, pubkeys []crypto.PubKey) (*MuSigSigner, error) {
+	novaPriv, ok := priv.(*NovaPrivateKey)
+	if !ok {
+		return nil, ErrMuSigKeyType
+	}
+	keys, points, coefs, err := musigKeyAgg(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	secret := novaSecretScalar(novaPriv)
+	self := vestaScalarBaseMult(secret).bytes()
+	index := -1
+	for i, k := range keys {
+		if bytes.Equal(k, self) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, ErrMuSigNotParticipant
+	}
+	return &MuSigSigner{
+		keys:   keys,
+		points: points,
+		coefs:  coefs,
+		aggKey: musigAggregatePoint(points, coefs),
+		index:  index,
+		secret: secret,
+	}, nil
+}
+
+// AggregateKey returns the aggregate public key that the final
+// signature verifies under.
+func (s *MuSigSigner) AggregateKey() crypto.PubKey {
+	return novaPublicKeyFromPoint(s.aggKey)
+}
+
+// NewNonce generates the nonces for a new signing session. This is the
+// first round of the protocol. The nonces are derived from both the
+// random source and the private key so that a weak random source alone
+// does not leak the key.
+func (s *MuSigSigner) NewNonce(src io.Reader) (*MuSigNonce, error) {
+	r1, err := s.nonce(src)
+	if err != nil {
+		return nil, err
+	}
+	r2, err := s.nonce(src)
+	if err != nil {
+		return nil, err
+	}
+	return &MuSigNonce{
+		r1: r1,
+		r2: r2,
+		commitment: MuSigCommitment{
+			PubKey: novaKeyBytes(s.keys[s.index]),
+			R1:     vestaScalarBaseMult(r1).bytes(),
+			R2:     vestaScalarBaseMult(r2).bytes(),
+		},
+	}, nil
+}
+
+// Sign computes this participant's partial signature over the digest.
+// This is the second round of the protocol. The commitments must contain
+// one commitment from every participant, including this one. The nonce
+// is wiped after use.
+func (s *MuSigSigner) Sign(digest []byte, nonce *MuSigNonce, commitments []MuSigCommitment) (*MuSigPartialSignature, error) {
+	if nonce.used {
+		return nil, ErrNonceUsed
+	}
+	sorted, err := s.sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sorted[s.index].R1, nonce.commitment.R1) || !bytes.Equal(sorted[s.index].R2, nonce.commitment.R2) {
+		return nil, ErrMuSigCommitments
+	}
+	_, b, err := s.nonceCommitment(digest, sorted)
+	if err != nil {
+		return nil, err
+	}
+	c := novaDigestScalar(digest)
+
+	// s_i = r1_i + b·r2_i + c·a_i·sk_i
+	si := new(big.Int).Mul(c, s.coefs[s.index])
+	si.Mul(si, s.secret)
+	si.Add(si, new(big.Int).Mul(b, nonce.r2))
+	si.Add(si, nonce.r1)
+	si.Mod(si, vestaN)
+
+	nonce.used = true
+	nonce.r1.SetInt64(0)
+	nonce.r2.SetInt64(0)
+
+	return &MuSigPartialSignature{
+		PubKey: novaKeyBytes(s.keys[s.index]),
+		S:      si.FillBytes(make([]byte, 32)),
+	}, nil
+}
+
+// Aggregate verifies each partial signature and combines them into a
+// single Nova signature under the aggregate key. Any participant can
+// act as the aggregator.
+func (s *MuSigSigner) Aggregate(digest []byte, commitments []MuSigCommitment, partials []*MuSigPartialSignature) ([]byte, error) {
+	sorted, err := s.sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	if len(partials) != len(s.keys) {
+		return nil, ErrMuSigPartialSignature
+	}
+	r, b, err := s.nonceCommitment(digest, sorted)
+	if err != nil {
+		return nil, err
+	}
+	c := novaDigestScalar(digest)
+
+	sum := new(big.Int)
+	seen := make(map[int]bool)
+	for _, partial := range partials {
+		i := s.keyIndex(partial.PubKey)
+		if i < 0 || seen[i] || len(partial.S) != 32 {
+			return nil, ErrMuSigPartialSignature
+		}
+		seen[i] = true
+		si := new(big.Int).SetBytes(partial.S)
+		if si.Cmp(vestaN) >= 0 {
+			return nil, ErrMuSigPartialSignature
+		}
+
+		// s_i·G == R1_i + b·R2_i + c·a_i·PK_i
+		r1, _ := vestaPointFromBytes(sorted[i].R1)
+		r2, _ := vestaPointFromBytes(sorted[i].R2)
+		expected := vestaAdd(r1, vestaScalarMult(r2, b))
+		expected = vestaAdd(expected, vestaScalarMult(s.points[i], new(big.Int).Mul(c, s.coefs[i])))
+		if !vestaScalarBaseMult(si).equal(expected) {
+			return nil, ErrMuSigPartialSignature
+		}
+		sum.Add(sum, si)
+	}
+	sum.Mod(sum, vestaN)
+
+	sig := make([]byte, 0, 64)
+	sig = append(sig, r.bytes()...)
+	sig = append(sig, sum.FillBytes(make([]byte, 32))...)
+
+	if !verifyNovaSignature(s.aggKey, digest, sig) {
+		return nil, ErrMuSigPartialSignature
+	}
+	return sig, nil
+}
+
+// nonceCommitment computes the nonce coefficient b and the aggregate
+// nonce R = Σ R1_i + b·Σ R2_i.
+func (s *MuSigSigner) nonceCommitment(digest []byte, sorted []MuSigCommitment) (*vestaPoint, *big.Int, error) {
+	r1, r2 := &vestaPoint{}, &vestaPoint{}
+	for _, cm := range sorted {
+		p1, err := vestaPointFromBytes(cm.R1)
+		if err != nil {
+			return nil, nil, ErrMuSigCommitments
+		}
+		p2, err := vestaPointFromBytes(cm.R2)
+		if err != nil {
+			return nil, nil, ErrMuSigCommitments
+		}
+		r1 = vestaAdd(r1, p1)
+		r2 = vestaAdd(r2, p2)
+	}
+	b := musigHashToScalar(musigNonceCoefTag, s.aggKey.bytes(), r1.bytes(), r2.bytes(), digest)
+	return vestaAdd(r1, vestaScalarMult(r2, b)), b, nil
+}
+
+// sortCommitments returns the commitments in key order after checking
+// that there is exactly one commitment from each participant.
+func (s *MuSigSigner) sortCommitments(commitments []MuSigCommitment) ([]MuSigCommitment, error) {
+	if len(commitments) != len(s.keys) {
+		return nil, ErrMuSigCommitments
+	}
+	sorted := make([]MuSigCommitment, len(s.keys))
+	for _, cm := range commitments {
+		i := s.keyIndex(cm.PubKey)
+		if i < 0 || sorted[i].R1 != nil || cm.R1 == nil || cm.R2 == nil {
+			return nil, ErrMuSigCommitments
+		}
+		sorted[i] = cm
+	}
+	return sorted, nil
+}
+
+func (s *MuSigSigner) keyIndex(pubkey []byte) int {
+	for i, k := range s.keys {
+		if bytes.Equal(novaKeyBytes(k), pubkey) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *MuSigSigner) nonce(src io.Reader) (*big.Int, error) {
+	var random [32]byte
+	if _, err := io.ReadFull(src, random[:]); err != nil {
+		return nil, err
+	}
+	secret := s.secret.FillBytes(make([]byte, 32))
+	defer Zeroize(secret)
+	return musigHashToScalar(musigNonceTag, random[:], secret), nil
+}
+
+// musigKeyAgg sorts the keys and computes each key's coefficient
+// a_i = H(L || PK_i) where L is a hash of all the keys.
+func musigKeyAgg(pubkeys []crypto.PubKey) ([][]byte, []*vestaPoint, []*big.Int, error) {
+	if len(pubkeys) == 0 {
+		return nil, nil, nil, ErrMuSigNotParticipant
+	}
+	keys := make([][]byte, 0, len(pubkeys))
+	for _, pub := range pubkeys {
+		novaPub, ok := pub.(*NovaPublicKey)
+		if !ok {
+			return nil, nil, nil, ErrMuSigKeyType
+		}
+		keys = append(keys, novaKeyBytes(novaPub.k[:]))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	h := sha512.New()
+	h.Write([]byte(musigKeyListTag))
+	for _, k := range keys {
+		h.Write(k)
+	}
+	l := h.Sum(nil)
+
+	points := make([]*vestaPoint, len(keys))
+	coefs := make([]*big.Int, len(keys))
+	for i, k := range keys {
+		if i > 0 && bytes.Equal(k, keys[i-1]) {
+			return nil, nil, nil, errors.New("duplicate musig public key")
+		}
+		p, err := vestaPointFromBytes(k)
+		if err != nil || p.isIdentity() {
+			return nil, nil, nil, fmt.Errorf("musig public key %d: %w", i, errInvalidPoint)
+		}
+		points[i] = p
+		coefs[i] = musigHashToScalar(musigKeyAggTag, l, k)
+	}
+	return keys, points, coefs, nil
+}
+
+func musigAggregatePoint(points []*vestaPoint, coefs []*big.Int) *vestaPoint {
+	agg := &vestaPoint{}
+	for i := range points {
+		agg = vestaAdd(agg, vestaScalarMult(points[i], coefs[i]))
+	}
+	return agg
+}
+
+func musigHashToScalar(tag string, parts ...[]byte) *big.Int {
+	return hashToModulus(vestaN, tag, parts...)
+}
+
+// verifyNovaSignature checks s·G == R + c·PK. This is the same check
+// made by the Rust library and the lurk transfer script.
+func verifyNovaSignature(pub *vestaPoint, digest []byte, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	r, err := vestaPointFromBytes(sig[:32])
+	if err != nil {
+		return false
+	}
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(vestaN) >= 0 {
+		return false
+	}
+	expected := vestaAdd(r, vestaScalarMult(pub, novaDigestScalar(digest)))
+	return vestaScalarBaseMult(s).equal(expected)
+}
+
+// novaSecretScalar returns the scalar for the private key. The Rust
+// library reads the private key bytes in little endian.
+func novaSecretScalar(k *NovaPrivateKey) *big.Int {
+	b := make([]byte, NovaPrivateKeySize)
+	copy(b, k.privKeyBytes())
+	defer Zeroize(b)
+	return new(big.Int).Mod(new(big.Int).SetBytes(reverseBytes(b)), vestaN)
+}
+
+// novaDigestScalar returns the signature digest as a scalar. The digest
+// is read in big endian.
+func novaDigestScalar(digest []byte) *big.Int {
+	return new(big.Int).Mod(new(big.Int).SetBytes(digest), vestaN)
+}
+
+// novaKeyBytes converts between the point encoding and the byte order
+// used by NovaPublicKey. GenerateNovaKey, NewNovaKeyFromSeed and
+// PublicKeyFromXY all store the compressed point reversed, so the
+// conversion is its own inverse.
+func novaKeyBytes(b []byte) []byte {
+	out := make([]byte, len(b))
+	copy(out, b)
+	return reverseBytes(out)
+}
+
+func novaPublicKeyFromPoint(p *vestaPoint) *NovaPublicKey {
+	var k [32]byte
+	copy(k[:], novaKeyBytes(p.bytes()))
+	return &NovaPublicKey{k: &k}
+}
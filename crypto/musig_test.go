@@ -0,0 +1,144 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuSig(t *testing.T) {
+	var (
+		privs   []crypto.PrivKey
+		pubkeys []crypto.PubKey
+	)
+	for i := 0; i < 3; i++ {
+		priv, _, err := GenerateNovaKey(rand.Reader)
+		assert.NoError(t, err)
+		privs = append(privs, priv)
+		// Derive the public key in Go so the test does not depend on
+		// the Rust library.
+		pubkeys = append(pubkeys, novaPublicKeyFromPoint(vestaScalarBaseMult(novaSecretScalar(priv.(*NovaPrivateKey)))))
+	}
+
+	aggKey, err := AggregateNovaPublicKeys(pubkeys)
+	assert.NoError(t, err)
+
+	// Key order does not change the aggregate key.
+	aggKey2, err := AggregateNovaPublicKeys([]crypto.PubKey{pubkeys[2], pubkeys[0], pubkeys[1]})
+	assert.NoError(t, err)
+	assert.True(t, aggKey.Equals(aggKey2))
+
+	signers := make([]*MuSigSigner, len(privs))
+	nonces := make([]*MuSigNonce, len(privs))
+	commitments := make([]MuSigCommitment, len(privs))
+	for i, priv := range privs {
+		signers[i], err = NewMuSigSigner(priv, pubkeys)
+		assert.NoError(t, err)
+		assert.True(t, aggKey.Equals(signers[i].AggregateKey()))
+
+		nonces[i], err = signers[i].NewNonce(rand.Reader)
+		assert.NoError(t, err)
+		commitments[i] = nonces[i].Commitment()
+	}
+
+	digest := hash.HashFunc([]byte("message"))
+	partials := make([]*MuSigPartialSignature, len(privs))
+	for i := range signers {
+		partials[i], err = signers[i].Sign(digest, nonces[i], commitments)
+		assert.NoError(t, err)
+	}
+
+	sig, err := signers[0].Aggregate(digest, commitments, partials)
+	assert.NoError(t, err)
+	assert.Len(t, sig, 64)
+
+	aggPoint, err := vestaPointFromBytes(novaKeyBytes(aggKey.(*NovaPublicKey).k[:]))
+	assert.NoError(t, err)
+	assert.True(t, verifyNovaSignature(aggPoint, digest, sig))
+	assert.False(t, verifyNovaSignature(aggPoint, hash.HashFunc([]byte("fake message")), sig))
+
+	// Nonces cannot be reused.
+	_, err = signers[0].Sign(digest, nonces[0], commitments)
+	assert.ErrorIs(t, err, ErrNonceUsed)
+
+	// A bad partial signature is detected.
+	partials[1].S[31]++
+	_, err = signers[0].Aggregate(digest, commitments, partials)
+	assert.ErrorIs(t, err, ErrMuSigPartialSignature)
+
+	// Missing commitments are rejected.
+	nonce, err := signers[0].NewNonce(rand.Reader)
+	assert.NoError(t, err)
+	_, err = signers[0].Sign(digest, nonce, commitments[:2])
+	assert.ErrorIs(t, err, ErrMuSigCommitments)
+
+	// A key that is not a participant cannot sign.
+	other, _, err := GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+	_, err = NewMuSigSigner(other, pubkeys)
+	assert.ErrorIs(t, err, ErrMuSigNotParticipant)
+}
+
+// TestMuSigRustKeys runs MuSig with keys from GenerateNovaKey and checks
+// the aggregate signature with the Rust verifier used in production.
+func TestMuSigRustKeys(t *testing.T) {
+	var (
+		privs   []crypto.PrivKey
+		pubkeys []crypto.PubKey
+	)
+	for i := 0; i < 3; i++ {
+		priv, pub, err := GenerateNovaKey(rand.Reader)
+		assert.NoError(t, err)
+		privs = append(privs, priv)
+		pubkeys = append(pubkeys, pub)
+
+		// The key derived in Go matches the one from the Rust library.
+		assert.True(t, pub.Equals(novaPublicKeyFromPoint(vestaScalarBaseMult(novaSecretScalar(priv.(*NovaPrivateKey))))))
+	}
+
+	aggKey, err := AggregateNovaPublicKeys(pubkeys)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	signers := make([]*MuSigSigner, len(privs))
+	nonces := make([]*MuSigNonce, len(privs))
+	commitments := make([]MuSigCommitment, len(privs))
+	for i, priv := range privs {
+		signers[i], err = NewMuSigSigner(priv, pubkeys)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		nonces[i], err = signers[i].NewNonce(rand.Reader)
+		assert.NoError(t, err)
+		commitments[i] = nonces[i].Commitment()
+	}
+
+	digest := hash.HashFunc([]byte("message"))
+	partials := make([]*MuSigPartialSignature, len(privs))
+	for i := range signers {
+		partials[i], err = signers[i].Sign(digest, nonces[i], commitments)
+		assert.NoError(t, err)
+	}
+	sig, err := signers[0].Aggregate(digest, commitments, partials)
+	assert.NoError(t, err)
+
+	valid, err := aggKey.Verify(digest, sig)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = aggKey.Verify(hash.HashFunc([]byte("fake message")), sig)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -0,0 +1,106 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	deterministicNonceTag = "illium/nova/deterministic-nonce"
+
+	// novaSignHashPersona is the persona the Rust signer hashes the
+	// nonce with.
+	novaSignHashPersona = "Nova_Ecdsa_Hash"
+
+	// novaNonceSeedSize is the number of random bytes the Rust signer
+	// hashes the nonce from.
+	novaNonceSeedSize = 80
+)
+
+// DeterministicNonces returns a copy of the private key whose Sign
+// method uses deterministic nonces. This makes signing independent of
+// the quality of the system's random number generator, which matters
+// for long running validators where a repeated nonce would leak the key.
+func (k *NovaPrivateKey) DeterministicNonces() *NovaPrivateKey {
+	return &NovaPrivateKey{
+		k:             k.k,
+		deterministic: true,
+	}
+}
+
+// SignDeterministic signs the digest the same way the Rust signer does,
+// except that the 80 bytes of randomness the nonce is hashed from are
+// derived from the private key and the digest, in the style of RFC 6979,
+// rather than read from the random number generator. Signing the same
+// digest twice returns the same signature and distinct digests always
+// get distinct nonces.
+func (k *NovaPrivateKey) SignDeterministic(digest []byte) ([]byte, error) {
+	secret := novaSecretScalar(k)
+	c := novaDigestScalar(digest)
+
+	// h = H(T || M)
+	t := deterministicNonceSeed(k.privKeyBytes(), digest)
+	nonce := novaHashToScalar(t, scalarRepr(c))
+	Zeroize(t)
+
+	// s = h + c·sk
+	s := new(big.Int).Mul(c, secret)
+	s.Add(s, nonce)
+	s.Mod(s, vestaN)
+
+	sig := make([]byte, 0, 64)
+	sig = append(sig, vestaScalarBaseMult(nonce).bytes()...)
+	sig = append(sig, s.FillBytes(make([]byte, 32))...)
+
+	secret.SetInt64(0)
+	nonce.SetInt64(0)
+	return sig, nil
+}
+
+// deterministicNonceSeed derives the 80 bytes the Rust signer otherwise
+// reads from the random number generator as
+// HMAC-SHA512(sk, tag || digest || ctr) for ctr = 0, 1.
+func deterministicNonceSeed(sk []byte, digest []byte) []byte {
+	var (
+		ctr [4]byte
+		out = make([]byte, 0, 2*sha512.Size)
+	)
+	for i := uint32(0); i < 2; i++ {
+		mac := hmac.New(sha512.New, sk)
+		mac.Write([]byte(deterministicNonceTag))
+		mac.Write(digest)
+		binary.BigEndian.PutUint32(ctr[:], i)
+		mac.Write(ctr[:])
+		out = mac.Sum(out)
+	}
+	Zeroize(out[novaNonceSeedSize:])
+	return out[:novaNonceSeedSize]
+}
+
+// novaHashToScalar mirrors hash_to_scalar in the Rust library. The SHA3-512
+// digest of the persona and inputs is read as a 512 bit little endian
+// integer and reduced by the group order.
+func novaHashToScalar(a, b []byte) *big.Int {
+	h := sha3.New512()
+	h.Write([]byte(novaSignHashPersona))
+	h.Write(a)
+	h.Write(b)
+	return new(big.Int).Mod(new(big.Int).SetBytes(reverseBytes(h.Sum(nil))), vestaN)
+}
+
+// scalarRepr returns the little endian encoding of the scalar, which
+// matches to_repr in the Rust library.
+func scalarRepr(k *big.Int) []byte {
+	return reverseBytes(k.FillBytes(make([]byte, 32)))
+}
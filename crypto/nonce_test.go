@@ -0,0 +1,71 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNonceReuse signs a large corpus of digests with several keys and
+// checks that no nonce commitment (the R value of the signature) is
+// ever repeated. A repeated nonce over two different digests reveals
+// the private key.
+func TestNonceReuse(t *testing.T) {
+	const (
+		numKeys    = 4
+		numDigests = 250
+	)
+
+	seen := make(map[string]int)
+	check := func(sig []byte) {
+		r := string(sig[:32])
+		seen[r]++
+		assert.Equal(t, 1, seen[r], "nonce reused")
+	}
+
+	for i := 0; i < numKeys; i++ {
+		priv, _, err := GenerateNovaKey(rand.Reader)
+		assert.NoError(t, err)
+		novaPriv := priv.(*NovaPrivateKey)
+		pub := vestaScalarBaseMult(novaSecretScalar(novaPriv))
+		deterministic := novaPriv.DeterministicNonces()
+
+		for j := 0; j < numDigests; j++ {
+			var msg [8]byte
+			binary.BigEndian.PutUint64(msg[:], uint64(j))
+			digest := hash.HashFunc(msg[:])
+
+			sig, err := deterministic.Sign(digest)
+			assert.NoError(t, err)
+			check(sig)
+
+			// Verifying every signature is slow in Go so only
+			// spot check.
+			if j%50 == 0 {
+				assert.True(t, verifyNovaSignature(pub, digest, sig))
+			}
+
+			// The signatures must be accepted by the Rust verifier
+			// used in production.
+			valid, err := priv.GetPublic().Verify(digest, sig)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+
+			// The same digest always gets the same signature.
+			sig2, err := novaPriv.SignDeterministic(digest)
+			assert.NoError(t, err)
+			assert.Equal(t, sig, sig2)
+		}
+	}
+	assert.Len(t, seen, numKeys*numDigests)
+}
@@ -50,6 +50,8 @@ func init() {
 // NovaPrivateKey is a Vesta curve private key in the nova proving system.
 type NovaPrivateKey struct {
 	k *[64]byte
+
+	deterministic bool
 }
 
 // NovaPublicKey is a Vesta curve public key in the nova proving system.
@@ -114,11 +116,15 @@ func (k *NovaPrivateKey) pubKeyBytes() []byte {
 	return k.k[NovaPrivateKeySize:]
 }
 
-// nolint:unused
 func (k *NovaPrivateKey) privKeyBytes() []byte {
 	return k.k[:NovaPrivateKeySize]
 }
 
+// Zeroize overwrites the private key with zeros.
+func (k *NovaPrivateKey) Zeroize() {
+	Zeroize(k.k[:])
+}
+
 // Equals compares two Nova private keys.
 func (k *NovaPrivateKey) Equals(o crypto.Key) bool {
 	cdk, ok := o.(*NovaPrivateKey)
@@ -140,7 +146,13 @@ func (k *NovaPrivateKey) GetPublic() crypto.PubKey {
 // Sign returns a signature from an input message. Note that this
 // method expects a 32 byte digest of the raw data to sign and not
 // the raw data itself. The passed in digest will not be hashed.
+//
+// If the key was returned by DeterministicNonces the signature is
+// created with SignDeterministic.
 func (k *NovaPrivateKey) Sign(digest []byte) ([]byte, error) {
+	if k.deterministic {
+		return k.SignDeterministic(digest)
+	}
 	var m [32]byte
 	copy(m[:], digest)
 	var mReversed [32]byte
@@ -148,6 +160,7 @@ func (k *NovaPrivateKey) Sign(digest []byte) ([]byte, error) {
 
 	var sk [32]byte
 	copy(sk[:], k.k[:NovaPrivateKeySize])
+	defer Zeroize(sk[:])
 	sig := novaSign(sk, mReversed)
 	return sig[:], nil
 }
@@ -349,6 +362,7 @@ func novaSign(sk [32]byte, messageDigest [32]byte) [64]byte {
 
 	// Call the Rust function
 	C.sign(cPrivBytes, cDigestBytes, (*C.uint8_t)(unsafe.Pointer(&signature[0])))
+	Zeroize(sk[:])
 
 	return signature
 }
@@ -371,10 +385,3 @@ func novaVerify(pk [32]byte, messageDigest [32]byte, signature [64]byte) bool {
 
 	return bool(valid)
 }
-
-func reverseBytes(b []byte) []byte {
-	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
-		b[i], b[j] = b[j], b[i]
-	}
-	return b
-}
@@ -72,6 +72,10 @@ func TestNova(t *testing.T) {
 	x, y := pub3.(*NovaPublicKey).ToXY()
 	assert.NotNil(t, x)
 	assert.NotNil(t, y)
+
+	priv4.(*NovaPrivateKey).Zeroize()
+	assert.Equal(t, [64]byte{}, *priv4.(*NovaPrivateKey).k)
+	assert.False(t, priv4.Equals(priv5))
 }
 
 func TestPublicKeyFromXY(t *testing.T) {
@@ -85,3 +89,41 @@ func TestPublicKeyFromXY(t *testing.T) {
 
 	assert.Equal(t, key.(*NovaPublicKey).k, key2.(*NovaPublicKey).k)
 }
+
+func TestConcurrentVerifierNova(t *testing.T) {
+	cv := NewConcurrentVerifier()
+	valid, invalid := cv.Verify()
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+
+	novaPriv, novaPub, err := GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+	edPriv, edPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		msg := hash.HashFunc([]byte{byte(i)})
+		sig, err := novaPriv.Sign(msg)
+		assert.NoError(t, err)
+		assert.Equal(t, i*2, cv.Add(novaPub, msg, sig))
+
+		sig, err = edPriv.Sign(msg)
+		assert.NoError(t, err)
+		cv.Add(edPub, msg, sig)
+	}
+	assert.Equal(t, 20, cv.Len())
+
+	valid, invalid = cv.Verify()
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+
+	msg := hash.HashFunc([]byte("message"))
+	sig, err := edPriv.Sign(msg)
+	assert.NoError(t, err)
+	cv.Add(edPub, hash.HashFunc([]byte("fake message")), sig)
+	cv.Add(nil, msg, sig)
+
+	valid, invalid = cv.Verify()
+	assert.False(t, valid)
+	assert.Equal(t, []int{20, 21}, invalid)
+}
@@ -0,0 +1,149 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/pem"
+	"errors"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+// KeyEncoding is an encoding format used when importing or
+// exporting Nova keys.
+type KeyEncoding uint8
+
+const (
+	// KeyEncodingRaw is the raw key bytes. For private keys this
+	// is the 32 byte secret key as used by the Rust tooling. For
+	// public keys it is the 32 byte compressed point.
+	KeyEncodingRaw KeyEncoding = iota
+
+	// KeyEncodingPEM is the raw key bytes wrapped in a PEM block.
+	KeyEncodingPEM
+
+	// KeyEncodingProto is the libp2p protobuf key format. This is
+	// the format used by params.RegtestGenesisKey and the key
+	// stored in the node's datastore.
+	KeyEncodingProto
+)
+
+const (
+	novaPrivateKeyPEMType = "NOVA PRIVATE KEY"
+	novaPublicKeyPEMType  = "NOVA PUBLIC KEY"
+)
+
+var (
+	// ErrNotNovaKey is returned when encoding or decoding a key
+	// that is not a Nova key.
+	ErrNotNovaKey = errors.New("key is not a nova key")
+
+	// ErrUnknownKeyEncoding is returned when the KeyEncoding
+	// is not recognized.
+	ErrUnknownKeyEncoding = errors.New("unknown key encoding")
+
+	// ErrInvalidPEM is returned when PEM data does not decode
+	// or has the wrong block type.
+	ErrInvalidPEM = errors.New("invalid pem block")
+)
+
+// EncodeNovaPrivateKey serializes a Nova private key using the
+// provided encoding.
+func EncodeNovaPrivateKey(key crypto.PrivKey, encoding KeyEncoding) ([]byte, error) {
+	novaKey, ok := key.(*NovaPrivateKey)
+	if !ok {
+		return nil, ErrNotNovaKey
+	}
+	switch encoding {
+	case KeyEncodingRaw:
+		return append([]byte(nil), novaKey.privKeyBytes()...), nil
+	case KeyEncodingPEM:
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  novaPrivateKeyPEMType,
+			Bytes: novaKey.privKeyBytes(),
+		}), nil
+	case KeyEncodingProto:
+		return crypto.MarshalPrivateKey(novaKey)
+	default:
+		return nil, ErrUnknownKeyEncoding
+	}
+}
+
+// DecodeNovaPrivateKey deserializes a Nova private key that was
+// serialized using the provided encoding.
+func DecodeNovaPrivateKey(data []byte, encoding KeyEncoding) (crypto.PrivKey, error) {
+	switch encoding {
+	case KeyEncodingRaw:
+		return UnmarshalNovaPrivateKey(data)
+	case KeyEncodingPEM:
+		block, _ := pem.Decode(data)
+		if block == nil || block.Type != novaPrivateKeyPEMType {
+			return nil, ErrInvalidPEM
+		}
+		return UnmarshalNovaPrivateKey(block.Bytes)
+	case KeyEncodingProto:
+		key, err := crypto.UnmarshalPrivateKey(data)
+		if err != nil {
+			return nil, err
+		}
+		if key.Type() != Libp2pKeyTypeNova {
+			return nil, ErrNotNovaKey
+		}
+		return key, nil
+	default:
+		return nil, ErrUnknownKeyEncoding
+	}
+}
+
+// EncodeNovaPublicKey serializes a Nova public key using the
+// provided encoding.
+func EncodeNovaPublicKey(key crypto.PubKey, encoding KeyEncoding) ([]byte, error) {
+	novaKey, ok := key.(*NovaPublicKey)
+	if !ok {
+		return nil, ErrNotNovaKey
+	}
+	switch encoding {
+	case KeyEncodingRaw:
+		return append([]byte(nil), novaKey.k[:]...), nil
+	case KeyEncodingPEM:
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  novaPublicKeyPEMType,
+			Bytes: novaKey.k[:],
+		}), nil
+	case KeyEncodingProto:
+		return crypto.MarshalPublicKey(novaKey)
+	default:
+		return nil, ErrUnknownKeyEncoding
+	}
+}
+
+// DecodeNovaPublicKey deserializes a Nova public key that was
+// serialized using the provided encoding.
+func DecodeNovaPublicKey(data []byte, encoding KeyEncoding) (crypto.PubKey, error) {
+	switch encoding {
+	case KeyEncodingRaw:
+		return UnmarshalNovaPublicKey(data)
+	case KeyEncodingPEM:
+		block, _ := pem.Decode(data)
+		if block == nil || block.Type != novaPublicKeyPEMType {
+			return nil, ErrInvalidPEM
+		}
+		return UnmarshalNovaPublicKey(block.Bytes)
+	case KeyEncodingProto:
+		key, err := crypto.UnmarshalPublicKey(data)
+		if err != nil {
+			return nil, err
+		}
+		if key.Type() != Libp2pKeyTypeNova {
+			return nil, ErrNotNovaKey
+		}
+		return key, nil
+	default:
+		return nil, ErrUnknownKeyEncoding
+	}
+}
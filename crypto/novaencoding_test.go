@@ -0,0 +1,68 @@
+//go:build !skiprusttests
+// +build !skiprusttests
+
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNovaKeyEncoding(t *testing.T) {
+	priv, pub, err := GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+
+	for _, encoding := range []KeyEncoding{KeyEncodingRaw, KeyEncodingPEM, KeyEncodingProto} {
+		privBytes, err := EncodeNovaPrivateKey(priv, encoding)
+		assert.NoError(t, err)
+		priv2, err := DecodeNovaPrivateKey(privBytes, encoding)
+		assert.NoError(t, err)
+		assert.True(t, priv.Equals(priv2), "encoding %d", encoding)
+		assert.True(t, pub.Equals(priv2.GetPublic()), "encoding %d", encoding)
+
+		pubBytes, err := EncodeNovaPublicKey(pub, encoding)
+		assert.NoError(t, err)
+		pub2, err := DecodeNovaPublicKey(pubBytes, encoding)
+		assert.NoError(t, err)
+		assert.True(t, pub.Equals(pub2), "encoding %d", encoding)
+	}
+
+	raw, err := EncodeNovaPrivateKey(priv, KeyEncodingRaw)
+	assert.NoError(t, err)
+	assert.Len(t, raw, NovaPrivateKeySize)
+
+	// The proto encoding is the one used by crypto.MarshalPrivateKey.
+	protoBytes, err := EncodeNovaPrivateKey(priv, KeyEncodingProto)
+	assert.NoError(t, err)
+	priv3, err := crypto.UnmarshalPrivateKey(protoBytes)
+	assert.NoError(t, err)
+	assert.True(t, priv.Equals(priv3))
+
+	// A public key PEM block does not decode as a private key.
+	pubPEM, err := EncodeNovaPublicKey(pub, KeyEncodingPEM)
+	assert.NoError(t, err)
+	_, err = DecodeNovaPrivateKey(pubPEM, KeyEncodingPEM)
+	assert.ErrorIs(t, err, ErrInvalidPEM)
+
+	edPriv, edPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	_, err = EncodeNovaPrivateKey(edPriv, KeyEncodingRaw)
+	assert.ErrorIs(t, err, ErrNotNovaKey)
+	_, err = EncodeNovaPublicKey(edPub, KeyEncodingRaw)
+	assert.ErrorIs(t, err, ErrNotNovaKey)
+
+	edBytes, err := crypto.MarshalPrivateKey(edPriv)
+	assert.NoError(t, err)
+	_, err = DecodeNovaPrivateKey(edBytes, KeyEncodingProto)
+	assert.ErrorIs(t, err, ErrNotNovaKey)
+
+	_, err = EncodeNovaPrivateKey(priv, KeyEncoding(99))
+	assert.ErrorIs(t, err, ErrUnknownKeyEncoding)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"errors"
+	"math/big"
+)
+
+// This file implements the Poseidon hash over the lurk field. The
+// parameters are those of the P128Pow5T3 instance: a width of three with
+// a rate of two, the x^5 S-box, eight full rounds and 56 partial rounds.
+// The round constants and the Cauchy MDS matrix are derived with the Grain
+// LFSR as specified in the Poseidon paper and its reference implementation.
+//
+// Inputs are hashed with the constant length domain. The capacity element
+// is initialized to the number of inputs shifted left by 64 bits and the
+// inputs are padded with zeros to a multiple of the rate.
+
+const (
+	poseidonWidth         = 3
+	poseidonRate          = 2
+	poseidonFullRounds    = 8
+	poseidonPartialRounds = 56
+)
+
+// ErrNotFieldElement is returned when an input to Poseidon is not the 32
+// byte big endian encoding of an element of the lurk field.
+var ErrNotFieldElement = errors.New("input is not a lurk field element")
+
+var poseidonRoundConstants, poseidonMDS = poseidonParams()
+
+// Poseidon hashes the lurk field elements. Each input must be a canonical
+// 32 byte big endian field element and the result is encoded the same way.
+func Poseidon(inputs ...[]byte) ([]byte, error) {
+	elems := make([]*vestaFieldElement, len(inputs))
+	for i, in := range inputs {
+		if !IsFieldElement(in) {
+			return nil, ErrNotFieldElement
+		}
+		elems[i] = newVestaFieldElement(new(big.Int).SetBytes(in))
+	}
+	return poseidonHash(elems).bigInt().FillBytes(make([]byte, FieldElementSize)), nil
+}
+
+func poseidonHash(inputs []*vestaFieldElement) *vestaFieldElement {
+	var state [poseidonWidth]vestaFieldElement
+	state[poseidonRate] = *newVestaFieldElement(new(big.Int).Lsh(big.NewInt(int64(len(inputs))), 64))
+
+	for i := 0; i < len(inputs) || i == 0; i += poseidonRate {
+		for j := 0; j < poseidonRate && i+j < len(inputs); j++ {
+			state[j].add(&state[j], inputs[i+j])
+		}
+		poseidonPermute(&state)
+	}
+	return &state[0]
+}
+
+func poseidonPermute(state *[poseidonWidth]vestaFieldElement) {
+	half := poseidonFullRounds / 2
+	for r, rc := range poseidonRoundConstants {
+		full := r < half || r >= half+poseidonPartialRounds
+		for i := range state {
+			state[i].add(&state[i], &rc[i])
+			if full || i == 0 {
+				poseidonSbox(&state[i])
+			}
+		}
+
+		var next [poseidonWidth]vestaFieldElement
+		for i := range next {
+			var t vestaFieldElement
+			for j := range state {
+				next[i].add(&next[i], t.mul(&poseidonMDS[i][j], &state[j]))
+			}
+		}
+		*state = next
+	}
+}
+
+// poseidonSbox sets x to x^5.
+func poseidonSbox(x *vestaFieldElement) {
+	var x2, x4 vestaFieldElement
+	x2.mul(x, x)
+	x4.mul(&x2, &x2)
+	x.mul(&x4, x)
+}
+
+func poseidonParams() ([][poseidonWidth]vestaFieldElement, [poseidonWidth][poseidonWidth]vestaFieldElement) {
+	g := newGrain()
+	constants := make([][poseidonWidth]vestaFieldElement, poseidonFullRounds+poseidonPartialRounds)
+	for r := range constants {
+		for i := range constants[r] {
+			constants[r][i] = *newVestaFieldElement(g.fieldElement(true))
+		}
+	}
+
+	// The MDS matrix is the Cauchy matrix 1/(x_i + y_j) for distinct
+	// x_i and y_j. The first matrix sampled is secure for this field.
+	var xy [2 * poseidonWidth]*big.Int
+	for {
+		seen := make(map[string]bool)
+		for i := range xy {
+			xy[i] = g.fieldElement(false)
+			seen[xy[i].String()] = true
+		}
+		if len(seen) == len(xy) {
+			break
+		}
+	}
+	var mds [poseidonWidth][poseidonWidth]vestaFieldElement
+	for i := 0; i < poseidonWidth; i++ {
+		for j := 0; j < poseidonWidth; j++ {
+			sum := new(big.Int).Add(xy[i], xy[poseidonWidth+j])
+			mds[i][j] = *newVestaFieldElement(sum.ModInverse(sum.Mod(sum, vestaP), vestaP))
+		}
+	}
+	return constants, mds
+}
+
+// grain is the Grain LFSR used to derive the Poseidon parameters.
+type grain struct {
+	state [80]bool
+}
+
+func newGrain() *grain {
+	g := &grain{}
+	for i := range g.state {
+		g.state[i] = true
+	}
+	setBits := func(offset, n int, value uint) {
+		for i := 0; i < n; i++ {
+			g.state[offset+n-1-i] = (value>>i)&1 == 1
+		}
+	}
+	setBits(0, 2, 1)                      // Prime field
+	setBits(2, 4, 0)                      // x^alpha S-box
+	setBits(6, 12, uint(vestaP.BitLen())) // Field size
+	setBits(18, 12, poseidonWidth)
+	setBits(30, 10, poseidonFullRounds)
+	setBits(40, 10, poseidonPartialRounds)
+
+	for i := 0; i < 160; i++ {
+		g.nextBit()
+	}
+	return g
+}
+
+func (g *grain) nextBit() bool {
+	s := &g.state
+	bit := s[62] != s[51] != s[38] != s[23] != s[13] != s[0]
+	copy(s[:], s[1:])
+	s[len(s)-1] = bit
+	return bit
+}
+
+// sample returns the next output bit. Bits are drawn in pairs and the
+// second bit is output only if the first is set.
+func (g *grain) sample() bool {
+	for !g.nextBit() {
+		g.nextBit()
+	}
+	return g.nextBit()
+}
+
+// fieldElement reads a field element from the most significant bit
+// down. With rejection, values which are not less than the modulus are
+// discarded. Otherwise they are reduced by the modulus.
+func (g *grain) fieldElement(rejection bool) *big.Int {
+	for {
+		x := new(big.Int)
+		for i := 0; i < vestaP.BitLen(); i++ {
+			x.Lsh(x, 1)
+			if g.sample() {
+				x.SetBit(x, 0, 1)
+			}
+		}
+		if !rejection {
+			return x.Mod(x, vestaP)
+		}
+		if x.Cmp(vestaP) < 0 {
+			return x
+		}
+	}
+}
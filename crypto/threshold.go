@@ -0,0 +1,510 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+)
+
+const thresholdDomain = "illium/frost/v1"
+
+var (
+	// ErrInvalidThreshold is returned when the threshold is zero or
+	// larger than the number of participants.
+	ErrInvalidThreshold = errors.New("invalid threshold parameters")
+
+	// ErrNonceUsed is returned when a SigningNonce is used more than once.
+	ErrNonceUsed = errors.New("signing nonce already used")
+
+	// ErrInvalidCommitments is returned when the set of commitments is
+	// too small, contains duplicates, or does not include the signer.
+	ErrInvalidCommitments = errors.New("invalid signing commitments")
+
+	// ErrInvalidSignatureShare is returned when a signature share does
+	// not verify against the participant's verification share.
+	ErrInvalidSignatureShare = errors.New("invalid signature share")
+
+	// ErrInvalidThresholdEncoding is returned when a serialized key
+	// share, nonce, commitment or signature share is malformed.
+	ErrInvalidThresholdEncoding = errors.New("invalid threshold encoding")
+)
+
+// ThresholdKeyShare is one participant's share of a t-of-n Ed25519 key.
+//
+// Any Threshold participants can cooperate, using a two round FROST
+// signing protocol, to produce a standard Ed25519 signature under the
+// GroupKey. No participant learns the full private key while signing.
+// This allows the key which signs a validator's stake transactions to be
+// held by several operators or machines so that staking does not depend
+// on a single copy of the key.
+//
+// New keys should be created with the distributed key generation in
+// NewKeyGenParticipant so that the full private key never exists on any
+// one machine. GenerateThresholdKey and SplitPrivateKey instead act as a
+// trusted dealer which sees the full private key and must be trusted to
+// destroy it, and its copies of the shares, once they are handed out.
+// SplitPrivateKey is only needed to move an existing key, such as a
+// validator's network key, to threshold signing.
+type ThresholdKeyShare struct {
+	// Index is the participant's index. Indexes start at one.
+	Index uint32
+
+	// Threshold is the number of participants needed to sign.
+	Threshold uint32
+
+	// GroupKey is the public key that the signatures verify under.
+	GroupKey crypto.PubKey
+
+	// VerificationShares are the public keys, by index, of every
+	// participant's secret share. They are used to detect invalid
+	// signature shares when aggregating.
+	VerificationShares map[uint32][]byte
+
+	secret *edwards25519.Scalar
+}
+
+// GenerateThresholdKey generates a new random Ed25519 key and splits it
+// into n shares, any t of which can sign. The caller acts as the trusted
+// dealer. Use NewKeyGenParticipant to generate a key without a dealer.
+func GenerateThresholdKey(t, n int, src io.Reader) (crypto.PubKey, []*ThresholdKeyShare, error) {
+	secret, err := randomScalar(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitScalar(secret, t, n, src)
+}
+
+// SplitPrivateKey splits an existing Ed25519 private key into n shares,
+// any t of which can sign. The group key is the private key's public key
+// so an existing validator can move to threshold signing without changing
+// its peer ID. The caller acts as the trusted dealer and the original key
+// should be destroyed after the shares are distributed.
+func SplitPrivateKey(priv crypto.PrivKey, t, n int, src io.Reader) (crypto.PubKey, []*ThresholdKeyShare, error) {
+	if priv.Type() != pb.KeyType_Ed25519 {
+		return nil, nil, ErrAggregateKeyType
+	}
+	raw, err := priv.Raw()
+	if err != nil {
+		return nil, nil, err
+	}
+	// Ed25519 derives the signing scalar from the hash of the seed.
+	h := sha512.Sum512(raw[:ed25519.SeedSize])
+	secret, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitScalar(secret, t, n, src)
+}
+
+func splitScalar(secret *edwards25519.Scalar, t, n int, src io.Reader) (crypto.PubKey, []*ThresholdKeyShare, error) {
+	if t <= 0 || n <= 0 || t > n || n > 0xffff {
+		return nil, nil, ErrInvalidThreshold
+	}
+	coefficients, err := randomPolynomial(secret, t, src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupKey, err := crypto.UnmarshalEd25519PublicKey(new(edwards25519.Point).ScalarBaseMult(secret).Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shares := make([]*ThresholdKeyShare, n)
+	verificationShares := make(map[uint32][]byte, n)
+	for i := 0; i < n; i++ {
+		y := evalPolynomial(coefficients, uint32(i+1))
+		shares[i] = &ThresholdKeyShare{
+			Index:              uint32(i + 1),
+			Threshold:          uint32(t),
+			GroupKey:           groupKey,
+			VerificationShares: verificationShares,
+			secret:             y,
+		}
+		verificationShares[uint32(i+1)] = new(edwards25519.Point).ScalarBaseMult(y).Bytes()
+	}
+	return groupKey, shares, nil
+}
+
+// randomPolynomial returns the coefficients of a random polynomial of
+// degree t-1 whose constant term is the secret.
+//
+// f(x) = secret + a_1·x + ... + a_(t-1)·x^(t-1)
+func randomPolynomial(secret *edwards25519.Scalar, t int, src io.Reader) ([]*edwards25519.Scalar, error) {
+	coefficients := make([]*edwards25519.Scalar, t)
+	coefficients[0] = secret
+	for i := 1; i < t; i++ {
+		c, err := randomScalar(src)
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = c
+	}
+	return coefficients, nil
+}
+
+// evalPolynomial evaluates the polynomial at x.
+func evalPolynomial(coefficients []*edwards25519.Scalar, x uint32) *edwards25519.Scalar {
+	xs := scalarFromUint32(x)
+	y := edwards25519.NewScalar()
+	for j := len(coefficients) - 1; j >= 0; j-- {
+		y.MultiplyAdd(y, xs, coefficients[j])
+	}
+	return y
+}
+
+func randomScalar(src io.Reader) (*edwards25519.Scalar, error) {
+	var b [64]byte
+	if _, err := io.ReadFull(src, b[:]); err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().SetUniformBytes(b[:])
+}
+
+// SigningCommitment is a participant's public commitment to its nonces
+// for one signing session. It is sent to the other signers in the first
+// round of the protocol.
+type SigningCommitment struct {
+	Index  uint32
+	Hiding []byte
+	Bind   []byte
+}
+
+// SigningNonce holds the secret nonces for one signing session. A nonce
+// must only ever be used to sign a single message.
+type SigningNonce struct {
+	hiding     *edwards25519.Scalar
+	binding    *edwards25519.Scalar
+	commitment SigningCommitment
+	used       bool
+}
+
+// Commitment returns the public commitment to the nonces.
+func (n *SigningNonce) Commitment() SigningCommitment {
+	return n.commitment
+}
+
+// SignatureShare is a participant's contribution to the signature. It
+// is sent to the aggregator in the second round of the protocol.
+type SignatureShare struct {
+	Index uint32
+	Share []byte
+}
+
+// NewSigningNonce generates the nonces for a new signing session. This
+// is the first round of the signing protocol. The nonces are derived from
+// both the random source and the secret share so that a weak random source
+// alone does not leak the share.
+func (s *ThresholdKeyShare) NewSigningNonce(src io.Reader) (*SigningNonce, error) {
+	hiding, err := s.nonce(src)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := s.nonce(src)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningNonce{
+		hiding:  hiding,
+		binding: binding,
+		commitment: SigningCommitment{
+			Index:  s.Index,
+			Hiding: new(edwards25519.Point).ScalarBaseMult(hiding).Bytes(),
+			Bind:   new(edwards25519.Point).ScalarBaseMult(binding).Bytes(),
+		},
+	}, nil
+}
+
+// Sign computes this participant's signature share over the message.
+// This is the second round of the signing protocol. The commitments must
+// contain at least Threshold commitments, including this participant's,
+// and every signer must use the same set. The nonce is wiped after use.
+func (s *ThresholdKeyShare) Sign(message []byte, nonce *SigningNonce, commitments []SigningCommitment) (*SignatureShare, error) {
+	if nonce.used {
+		return nil, ErrNonceUsed
+	}
+	commitments, err := s.sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, c := range commitments {
+		if c.Index == s.Index {
+			if c.Hiding == nil || string(c.Hiding) != string(nonce.commitment.Hiding) || string(c.Bind) != string(nonce.commitment.Bind) {
+				return nil, ErrInvalidCommitments
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil, ErrInvalidCommitments
+	}
+
+	groupKey, err := s.GroupKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+	r, rhos, err := groupCommitment(groupKey, message, commitments)
+	if err != nil {
+		return nil, err
+	}
+	c := challenge(r, groupKey, message)
+	lambda := lagrangeCoefficient(s.Index, commitments)
+
+	// z_i = d_i + e_i·rho_i + lambda_i·s_i·c
+	z := edwards25519.NewScalar().Multiply(lambda, s.secret)
+	z.Multiply(z, c)
+	z.MultiplyAdd(nonce.binding, rhos[s.Index], z)
+	z.Add(z, nonce.hiding)
+
+	nonce.used = true
+	nonce.hiding.Set(edwards25519.NewScalar())
+	nonce.binding.Set(edwards25519.NewScalar())
+
+	return &SignatureShare{
+		Index: s.Index,
+		Share: z.Bytes(),
+	}, nil
+}
+
+// Aggregate verifies each signature share and combines them into a
+// standard Ed25519 signature under the group key. Any participant can
+// act as the aggregator.
+func (s *ThresholdKeyShare) Aggregate(message []byte, commitments []SigningCommitment, shares []*SignatureShare) ([]byte, error) {
+	commitments, err := s.sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) != len(commitments) {
+		return nil, ErrInvalidCommitments
+	}
+	groupKey, err := s.GroupKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+	r, rhos, err := groupCommitment(groupKey, message, commitments)
+	if err != nil {
+		return nil, err
+	}
+	c := challenge(r, groupKey, message)
+
+	byIndex := make(map[uint32]SigningCommitment, len(commitments))
+	for _, cm := range commitments {
+		byIndex[cm.Index] = cm
+	}
+
+	z := edwards25519.NewScalar()
+	for _, share := range shares {
+		cm, ok := byIndex[share.Index]
+		if !ok {
+			return nil, ErrInvalidCommitments
+		}
+		delete(byIndex, share.Index)
+
+		zi, err := edwards25519.NewScalar().SetCanonicalBytes(share.Share)
+		if err != nil {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidSignatureShare, share.Index)
+		}
+		if err := s.verifyShare(zi, cm, rhos[share.Index], c, commitments); err != nil {
+			return nil, err
+		}
+		z.Add(z, zi)
+	}
+
+	sig := make([]byte, 0, ed25519.SignatureSize)
+	sig = append(sig, r.Bytes()...)
+	sig = append(sig, z.Bytes()...)
+
+	valid, err := s.GroupKey.Verify(message, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, ErrInvalidSignatureShare
+	}
+	return sig, nil
+}
+
+// verifyShare checks z_i·B == D_i + rho_i·E_i + c·lambda_i·Y_i
+func (s *ThresholdKeyShare) verifyShare(zi *edwards25519.Scalar, cm SigningCommitment, rho, c *edwards25519.Scalar, commitments []SigningCommitment) error {
+	invalid := fmt.Errorf("%w: participant %d", ErrInvalidSignatureShare, cm.Index)
+
+	vs, ok := s.VerificationShares[cm.Index]
+	if !ok {
+		return invalid
+	}
+	y, err := new(edwards25519.Point).SetBytes(vs)
+	if err != nil {
+		return invalid
+	}
+	d, err := new(edwards25519.Point).SetBytes(cm.Hiding)
+	if err != nil {
+		return invalid
+	}
+	e, err := new(edwards25519.Point).SetBytes(cm.Bind)
+	if err != nil {
+		return invalid
+	}
+	lambda := lagrangeCoefficient(cm.Index, commitments)
+	cl := edwards25519.NewScalar().Multiply(c, lambda)
+
+	rhs := new(edwards25519.Point).VarTimeMultiScalarMult(
+		[]*edwards25519.Scalar{scalarFromUint32(1), rho, cl},
+		[]*edwards25519.Point{d, e, y},
+	)
+	if new(edwards25519.Point).ScalarBaseMult(zi).Equal(rhs) != 1 {
+		return invalid
+	}
+	return nil
+}
+
+func (s *ThresholdKeyShare) nonce(src io.Reader) (*edwards25519.Scalar, error) {
+	var random [32]byte
+	if _, err := io.ReadFull(src, random[:]); err != nil {
+		return nil, err
+	}
+	h := sha512.New()
+	h.Write([]byte(thresholdDomain + "/nonce"))
+	h.Write(random[:])
+	h.Write(s.secret.Bytes())
+	return edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+}
+
+// sortCommitments returns a copy of the commitments sorted by index after
+// checking that there are enough of them and that there are no duplicates.
+func (s *ThresholdKeyShare) sortCommitments(commitments []SigningCommitment) ([]SigningCommitment, error) {
+	if len(commitments) < int(s.Threshold) {
+		return nil, ErrInvalidCommitments
+	}
+	sorted := make([]SigningCommitment, len(commitments))
+	copy(sorted, commitments)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+	for i, c := range sorted {
+		if c.Index == 0 || (i > 0 && sorted[i-1].Index == c.Index) {
+			return nil, ErrInvalidCommitments
+		}
+	}
+	return sorted, nil
+}
+
+// groupCommitment computes the binding factor for each participant and
+// the group commitment R = Σ D_i + rho_i·E_i.
+func groupCommitment(groupKey, message []byte, commitments []SigningCommitment) (*edwards25519.Point, map[uint32]*edwards25519.Scalar, error) {
+	var idx [4]byte
+	h := sha512.New()
+	h.Write([]byte(thresholdDomain + "/commitments"))
+	for _, c := range commitments {
+		binary.BigEndian.PutUint32(idx[:], c.Index)
+		h.Write(idx[:])
+		h.Write(c.Hiding)
+		h.Write(c.Bind)
+	}
+	encCommitments := h.Sum(nil)
+	msgHash := sha512.Sum512(message)
+
+	var (
+		r    = edwards25519.NewIdentityPoint()
+		rhos = make(map[uint32]*edwards25519.Scalar, len(commitments))
+	)
+	for _, c := range commitments {
+		h.Reset()
+		h.Write([]byte(thresholdDomain + "/rho"))
+		h.Write(groupKey)
+		h.Write(msgHash[:])
+		h.Write(encCommitments)
+		binary.BigEndian.PutUint32(idx[:], c.Index)
+		h.Write(idx[:])
+		rho, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+		if err != nil {
+			return nil, nil, err
+		}
+		rhos[c.Index] = rho
+
+		d, err := new(edwards25519.Point).SetBytes(c.Hiding)
+		if err != nil {
+			return nil, nil, ErrInvalidCommitments
+		}
+		e, err := new(edwards25519.Point).SetBytes(c.Bind)
+		if err != nil {
+			return nil, nil, ErrInvalidCommitments
+		}
+		r.Add(r, d)
+		r.Add(r, new(edwards25519.Point).ScalarMult(rho, e))
+	}
+	return r, rhos, nil
+}
+
+// challenge computes the Ed25519 challenge SHA512(R || A || M).
+func challenge(r *edwards25519.Point, groupKey, message []byte) *edwards25519.Scalar {
+	h := sha512.New()
+	h.Write(r.Bytes())
+	h.Write(groupKey)
+	h.Write(message)
+	// SetUniformBytes only fails if the input is not 64 bytes.
+	c, _ := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	return c
+}
+
+// lagrangeCoefficient computes lambda_i = Π j / (j - i) for all other
+// participants j in the signing set.
+func lagrangeCoefficient(index uint32, commitments []SigningCommitment) *edwards25519.Scalar {
+	var (
+		num = scalarFromUint32(1)
+		den = scalarFromUint32(1)
+		x   = scalarFromUint32(index)
+	)
+	for _, c := range commitments {
+		if c.Index == index {
+			continue
+		}
+		j := scalarFromUint32(c.Index)
+		num.Multiply(num, j)
+		den.Multiply(den, edwards25519.NewScalar().Subtract(j, x))
+	}
+	return num.Multiply(num, scalarInvert(den))
+}
+
+func scalarFromUint32(n uint32) *edwards25519.Scalar {
+	var b [32]byte
+	binary.LittleEndian.PutUint32(b[:], n)
+	s, _ := edwards25519.NewScalar().SetCanonicalBytes(b[:])
+	return s
+}
+
+// scalarOrderMinusTwo is l - 2 in little endian where l is the order
+// of the ed25519 group.
+var scalarOrderMinusTwo = [32]byte{
+	0xeb, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
+
+// scalarInvert computes x^(l-2) which, by Fermat's little theorem, is
+// the inverse of x.
+func scalarInvert(x *edwards25519.Scalar) *edwards25519.Scalar {
+	ret := scalarFromUint32(1)
+	for i := len(scalarOrderMinusTwo) - 1; i >= 0; i-- {
+		for bit := 7; bit >= 0; bit-- {
+			ret.Multiply(ret, ret)
+			if (scalarOrderMinusTwo[i]>>bit)&1 == 1 {
+				ret.Multiply(ret, x)
+			}
+		}
+	}
+	return ret
+}
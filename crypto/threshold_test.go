@@ -0,0 +1,237 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func thresholdSign(t *testing.T, signers []*ThresholdKeyShare, message []byte) ([]SigningCommitment, []*SignatureShare) {
+	nonces := make([]*SigningNonce, len(signers))
+	commitments := make([]SigningCommitment, len(signers))
+	for i, s := range signers {
+		nonce, err := s.NewSigningNonce(rand.Reader)
+		assert.NoError(t, err)
+		nonces[i] = nonce
+		commitments[i] = nonce.Commitment()
+	}
+	shares := make([]*SignatureShare, len(signers))
+	for i, s := range signers {
+		share, err := s.Sign(message, nonces[i], commitments)
+		assert.NoError(t, err)
+		shares[i] = share
+	}
+	return commitments, shares
+}
+
+func TestThresholdSigning(t *testing.T) {
+	priv, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+
+	groupKey, shares, err := SplitPrivateKey(priv, 3, 5, rand.Reader)
+	assert.NoError(t, err)
+	assert.True(t, groupKey.Equals(pub))
+	assert.Len(t, shares, 5)
+
+	message := hash.HashFunc([]byte("stake"))
+
+	for _, signers := range [][]*ThresholdKeyShare{
+		{shares[0], shares[1], shares[2]},
+		{shares[4], shares[2], shares[0]},
+		{shares[0], shares[1], shares[2], shares[3], shares[4]},
+	} {
+		commitments, sigShares := thresholdSign(t, signers, message)
+		sig, err := signers[0].Aggregate(message, commitments, sigShares)
+		assert.NoError(t, err)
+
+		valid, err := pub.Verify(message, sig)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// Invalid share is detected
+	signers := []*ThresholdKeyShare{shares[1], shares[2], shares[3]}
+	commitments, sigShares := thresholdSign(t, signers, message)
+	sigShares[1].Share = sigShares[0].Share
+	_, err = shares[0].Aggregate(message, commitments, sigShares)
+	assert.ErrorIs(t, err, ErrInvalidSignatureShare)
+
+	// Below threshold
+	nonce, err := shares[0].NewSigningNonce(rand.Reader)
+	assert.NoError(t, err)
+	nonce2, err := shares[1].NewSigningNonce(rand.Reader)
+	assert.NoError(t, err)
+	_, err = shares[0].Sign(message, nonce, []SigningCommitment{nonce.Commitment(), nonce2.Commitment()})
+	assert.ErrorIs(t, err, ErrInvalidCommitments)
+
+	// Nonce reuse
+	nonce3, err := shares[2].NewSigningNonce(rand.Reader)
+	assert.NoError(t, err)
+	commitments = []SigningCommitment{nonce.Commitment(), nonce2.Commitment(), nonce3.Commitment()}
+	_, err = shares[0].Sign(message, nonce, commitments)
+	assert.NoError(t, err)
+	_, err = shares[0].Sign(message, nonce, commitments)
+	assert.ErrorIs(t, err, ErrNonceUsed)
+
+	// Fresh key
+	groupKey, shares, err = GenerateThresholdKey(2, 2, rand.Reader)
+	assert.NoError(t, err)
+	commitments, sigShares = thresholdSign(t, shares, message)
+	sig, err := shares[1].Aggregate(message, commitments, sigShares)
+	assert.NoError(t, err)
+	valid, err := groupKey.Verify(message, sig)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	_, _, err = GenerateThresholdKey(3, 2, rand.Reader)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+}
+
+func TestThresholdEncoding(t *testing.T) {
+	groupKey, shares, err := GenerateThresholdKey(2, 3, rand.Reader)
+	assert.NoError(t, err)
+
+	// Every message passes through its serialized form.
+	decoded := make([]*ThresholdKeyShare, len(shares))
+	for i, share := range shares {
+		ser, err := MarshalThresholdKeyShare(share)
+		assert.NoError(t, err)
+		decoded[i], err = UnmarshalThresholdKeyShare(ser)
+		assert.NoError(t, err)
+		assert.Equal(t, share.Index, decoded[i].Index)
+		assert.Equal(t, share.Threshold, decoded[i].Threshold)
+		assert.True(t, groupKey.Equals(decoded[i].GroupKey))
+		assert.Equal(t, share.VerificationShares, decoded[i].VerificationShares)
+	}
+
+	message := hash.HashFunc([]byte("stake"))
+	signers := []*ThresholdKeyShare{decoded[0], decoded[2]}
+	nonces := make([][]byte, len(signers))
+	commitments := make([]SigningCommitment, len(signers))
+	for i, s := range signers {
+		nonce, err := s.NewSigningNonce(rand.Reader)
+		assert.NoError(t, err)
+		nonces[i], err = MarshalSigningNonce(nonce)
+		assert.NoError(t, err)
+		assert.NoError(t, commitments[i].Deserialize(nonce.Commitment().Serialize()))
+	}
+	sigShares := make([]*SignatureShare, len(signers))
+	for i, s := range signers {
+		nonce, err := UnmarshalSigningNonce(nonces[i])
+		assert.NoError(t, err)
+		share, err := s.Sign(message, nonce, commitments)
+		assert.NoError(t, err)
+		sigShares[i] = new(SignatureShare)
+		assert.NoError(t, sigShares[i].Deserialize(share.Serialize()))
+
+		_, err = MarshalSigningNonce(nonce)
+		assert.ErrorIs(t, err, ErrNonceUsed)
+	}
+	sig, err := decoded[1].Aggregate(message, commitments, sigShares)
+	assert.NoError(t, err)
+	valid, err := groupKey.Verify(message, sig)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// A share whose secret does not match its verification share is
+	// rejected.
+	ser, err := MarshalThresholdKeyShare(shares[0])
+	assert.NoError(t, err)
+	ser[40] ^= 0x01
+	_, err = UnmarshalThresholdKeyShare(ser)
+	assert.ErrorIs(t, err, ErrInvalidThresholdEncoding)
+
+	_, err = UnmarshalThresholdKeyShare(ser[:50])
+	assert.ErrorIs(t, err, ErrInvalidThresholdEncoding)
+	_, err = UnmarshalSigningNonce(nonces[0][:10])
+	assert.ErrorIs(t, err, ErrInvalidThresholdEncoding)
+	assert.ErrorIs(t, new(SigningCommitment).Deserialize([]byte{0x01}), ErrInvalidThresholdEncoding)
+	assert.ErrorIs(t, new(SignatureShare).Deserialize([]byte{0x01}), ErrInvalidThresholdEncoding)
+}
+
+func TestThresholdKeyGen(t *testing.T) {
+	const threshold, n = 3, 5
+
+	keyGen := func() ([]*KeyGenParticipant, []*KeyGenCommitment, map[uint32][]*KeyGenShare) {
+		participants := make([]*KeyGenParticipant, n)
+		commitments := make([]*KeyGenCommitment, n)
+		received := make(map[uint32][]*KeyGenShare)
+		for i := range participants {
+			p, commitment, shares, err := NewKeyGenParticipant(uint32(i+1), threshold, n, rand.Reader)
+			assert.NoError(t, err)
+			assert.Len(t, shares, n-1)
+
+			// Every message passes through its serialized form.
+			ser, err := MarshalKeyGenParticipant(p)
+			assert.NoError(t, err)
+			participants[i], err = UnmarshalKeyGenParticipant(ser)
+			assert.NoError(t, err)
+			commitments[i] = new(KeyGenCommitment)
+			assert.NoError(t, commitments[i].Deserialize(commitment.Serialize()))
+			for _, share := range shares {
+				s := new(KeyGenShare)
+				assert.NoError(t, s.Deserialize(share.Serialize()))
+				received[s.To] = append(received[s.To], s)
+			}
+		}
+		return participants, commitments, received
+	}
+
+	participants, commitments, received := keyGen()
+	keyShares := make([]*ThresholdKeyShare, n)
+	for i, p := range participants {
+		share, err := p.FinishKeyGen(commitments, received[p.Index])
+		assert.NoError(t, err)
+		keyShares[i] = share
+
+		_, err = p.FinishKeyGen(commitments, received[p.Index])
+		assert.ErrorIs(t, err, ErrInvalidThreshold)
+	}
+	for _, share := range keyShares[1:] {
+		assert.True(t, keyShares[0].GroupKey.Equals(share.GroupKey))
+		assert.Equal(t, keyShares[0].VerificationShares, share.VerificationShares)
+	}
+
+	message := hash.HashFunc([]byte("stake"))
+	signers := []*ThresholdKeyShare{keyShares[4], keyShares[1], keyShares[2]}
+	commitmentsSig, sigShares := thresholdSign(t, signers, message)
+	sig, err := keyShares[0].Aggregate(message, commitmentsSig, sigShares)
+	assert.NoError(t, err)
+	valid, err := keyShares[0].GroupKey.Verify(message, sig)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// A share that does not match the sender's commitment identifies
+	// the sender.
+	participants, commitments, received = keyGen()
+	received[1][2].Share = received[1][1].Share
+	_, err = participants[0].FinishKeyGen(commitments, received[1])
+	assert.ErrorIs(t, err, ErrInvalidKeyGenShare)
+	assert.ErrorContains(t, err, fmt.Sprintf("participant %d", received[1][2].From))
+
+	// A missing share is rejected.
+	_, err = participants[1].FinishKeyGen(commitments, received[2][1:])
+	assert.ErrorIs(t, err, ErrInvalidKeyGenShare)
+
+	// A commitment with an invalid proof of knowledge is rejected.
+	commitments[3].Proof = commitments[2].Proof
+	_, err = participants[1].FinishKeyGen(commitments, received[2])
+	assert.ErrorIs(t, err, ErrInvalidKeyGenCommitment)
+
+	// A missing commitment is rejected.
+	_, err = participants[1].FinishKeyGen(commitments[1:], received[2])
+	assert.ErrorIs(t, err, ErrInvalidKeyGenCommitment)
+
+	_, _, _, err = NewKeyGenParticipant(6, threshold, n, rand.Reader)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+	_, _, _, err = NewKeyGenParticipant(1, n+1, n, rand.Reader)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+}
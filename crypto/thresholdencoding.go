@@ -0,0 +1,271 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+// The threshold types are serialized as fixed width fields. Indexes are
+// four byte big endian integers and points and scalars use their 32 byte
+// Ed25519 encodings.
+const (
+	thresholdCommitmentLen = 4 + 32 + 32
+	thresholdShareLen      = 4 + 32
+	thresholdNonceLen      = 4 + 32 + 32
+	keyGenParticipantLen   = 4 + 4 + 4 + 32
+	keyGenShareLen         = 4 + 4 + 32
+)
+
+// MarshalThresholdKeyShare serializes the key share, including the secret
+// share, so that it can be handed to its participant and stored. The
+// output must be kept as secret as a private key.
+func MarshalThresholdKeyShare(s *ThresholdKeyShare) ([]byte, error) {
+	groupKey, err := s.GroupKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]uint32, 0, len(s.VerificationShares))
+	for i := range s.VerificationShares {
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	b := make([]byte, 0, 4+4+32+32+4+len(indexes)*36)
+	b = binary.BigEndian.AppendUint32(b, s.Index)
+	b = binary.BigEndian.AppendUint32(b, s.Threshold)
+	b = append(b, groupKey...)
+	b = append(b, s.secret.Bytes()...)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(indexes)))
+	for _, i := range indexes {
+		b = binary.BigEndian.AppendUint32(b, i)
+		b = append(b, s.VerificationShares[i]...)
+	}
+	return b, nil
+}
+
+// UnmarshalThresholdKeyShare deserializes a key share created with
+// MarshalThresholdKeyShare. It checks that the secret share matches the
+// participant's verification share.
+func UnmarshalThresholdKeyShare(b []byte) (*ThresholdKeyShare, error) {
+	if len(b) < 4+4+32+32+4 {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	s := &ThresholdKeyShare{
+		Index:     binary.BigEndian.Uint32(b[0:4]),
+		Threshold: binary.BigEndian.Uint32(b[4:8]),
+	}
+	if _, err := new(edwards25519.Point).SetBytes(b[8:40]); err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	groupKey, err := crypto.UnmarshalEd25519PublicKey(b[8:40])
+	if err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	s.GroupKey = groupKey
+
+	secret, err := edwards25519.NewScalar().SetCanonicalBytes(b[40:72])
+	if err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	s.secret = secret
+
+	n := binary.BigEndian.Uint32(b[72:76])
+	b = b[76:]
+	if uint64(len(b)) != uint64(n)*36 {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	s.VerificationShares = make(map[uint32][]byte, n)
+	for len(b) > 0 {
+		i := binary.BigEndian.Uint32(b[:4])
+		if _, err := new(edwards25519.Point).SetBytes(b[4:36]); err != nil {
+			return nil, ErrInvalidThresholdEncoding
+		}
+		if _, ok := s.VerificationShares[i]; ok || i == 0 {
+			return nil, ErrInvalidThresholdEncoding
+		}
+		s.VerificationShares[i] = append([]byte(nil), b[4:36]...)
+		b = b[36:]
+	}
+
+	if s.Threshold == 0 || s.Threshold > n {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	vs, ok := s.VerificationShares[s.Index]
+	if !ok || string(vs) != string(new(edwards25519.Point).ScalarBaseMult(secret).Bytes()) {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	return s, nil
+}
+
+// MarshalSigningNonce serializes the secret nonces so that a participant
+// can keep them between the two rounds of the signing protocol. The
+// output must be kept secret and deleted once the nonce has been used to
+// sign. Signing two messages with the same nonce leaks the secret share.
+func MarshalSigningNonce(n *SigningNonce) ([]byte, error) {
+	if n.used {
+		return nil, ErrNonceUsed
+	}
+	b := make([]byte, 0, thresholdNonceLen)
+	b = binary.BigEndian.AppendUint32(b, n.commitment.Index)
+	b = append(b, n.hiding.Bytes()...)
+	b = append(b, n.binding.Bytes()...)
+	return b, nil
+}
+
+// UnmarshalSigningNonce deserializes a nonce created with
+// MarshalSigningNonce.
+func UnmarshalSigningNonce(b []byte) (*SigningNonce, error) {
+	if len(b) != thresholdNonceLen {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	hiding, err := edwards25519.NewScalar().SetCanonicalBytes(b[4:36])
+	if err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	binding, err := edwards25519.NewScalar().SetCanonicalBytes(b[36:68])
+	if err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	return &SigningNonce{
+		hiding:  hiding,
+		binding: binding,
+		commitment: SigningCommitment{
+			Index:  binary.BigEndian.Uint32(b[0:4]),
+			Hiding: new(edwards25519.Point).ScalarBaseMult(hiding).Bytes(),
+			Bind:   new(edwards25519.Point).ScalarBaseMult(binding).Bytes(),
+		},
+	}, nil
+}
+
+// Serialize returns the commitment for sending to the other signers.
+func (c SigningCommitment) Serialize() []byte {
+	b := make([]byte, 0, thresholdCommitmentLen)
+	b = binary.BigEndian.AppendUint32(b, c.Index)
+	b = append(b, c.Hiding...)
+	return append(b, c.Bind...)
+}
+
+// Deserialize sets the commitment from a serialized commitment.
+func (c *SigningCommitment) Deserialize(b []byte) error {
+	if len(b) != thresholdCommitmentLen {
+		return ErrInvalidThresholdEncoding
+	}
+	c.Index = binary.BigEndian.Uint32(b[0:4])
+	c.Hiding = append([]byte(nil), b[4:36]...)
+	c.Bind = append([]byte(nil), b[36:68]...)
+	return nil
+}
+
+// Serialize returns the signature share for sending to the aggregator.
+func (s *SignatureShare) Serialize() []byte {
+	b := make([]byte, 0, thresholdShareLen)
+	b = binary.BigEndian.AppendUint32(b, s.Index)
+	return append(b, s.Share...)
+}
+
+// Deserialize sets the signature share from a serialized share.
+func (s *SignatureShare) Deserialize(b []byte) error {
+	if len(b) != thresholdShareLen {
+		return ErrInvalidThresholdEncoding
+	}
+	s.Index = binary.BigEndian.Uint32(b[0:4])
+	s.Share = append([]byte(nil), b[4:36]...)
+	return nil
+}
+
+// MarshalKeyGenParticipant serializes the participant's key generation
+// state so that it can be kept between the two rounds of key generation.
+// The output must be kept secret and deleted once key generation has
+// finished.
+func MarshalKeyGenParticipant(p *KeyGenParticipant) ([]byte, error) {
+	if p.share == nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	b := make([]byte, 0, keyGenParticipantLen)
+	b = binary.BigEndian.AppendUint32(b, p.Index)
+	b = binary.BigEndian.AppendUint32(b, p.Threshold)
+	b = binary.BigEndian.AppendUint32(b, p.Participants)
+	return append(b, p.share.Bytes()...), nil
+}
+
+// UnmarshalKeyGenParticipant deserializes a participant created with
+// MarshalKeyGenParticipant.
+func UnmarshalKeyGenParticipant(b []byte) (*KeyGenParticipant, error) {
+	if len(b) != keyGenParticipantLen {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	p := &KeyGenParticipant{
+		Index:        binary.BigEndian.Uint32(b[0:4]),
+		Threshold:    binary.BigEndian.Uint32(b[4:8]),
+		Participants: binary.BigEndian.Uint32(b[8:12]),
+	}
+	if p.Index == 0 || p.Index > p.Participants || p.Threshold == 0 || p.Threshold > p.Participants {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	share, err := edwards25519.NewScalar().SetCanonicalBytes(b[12:44])
+	if err != nil {
+		return nil, ErrInvalidThresholdEncoding
+	}
+	p.share = share
+	return p, nil
+}
+
+// Serialize returns the commitment for broadcasting to the other
+// participants.
+func (c *KeyGenCommitment) Serialize() []byte {
+	b := make([]byte, 0, 4+4+len(c.Coefficients)*32+64)
+	b = binary.BigEndian.AppendUint32(b, c.Index)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(c.Coefficients)))
+	for _, coeff := range c.Coefficients {
+		b = append(b, coeff...)
+	}
+	return append(b, c.Proof...)
+}
+
+// Deserialize sets the commitment from a serialized commitment.
+func (c *KeyGenCommitment) Deserialize(b []byte) error {
+	if len(b) < 8 {
+		return ErrInvalidThresholdEncoding
+	}
+	n := binary.BigEndian.Uint32(b[4:8])
+	if uint64(len(b)) != 8+uint64(n)*32+64 {
+		return ErrInvalidThresholdEncoding
+	}
+	c.Index = binary.BigEndian.Uint32(b[0:4])
+	c.Coefficients = make([][]byte, n)
+	b = b[8:]
+	for i := range c.Coefficients {
+		c.Coefficients[i] = append([]byte(nil), b[:32]...)
+		b = b[32:]
+	}
+	c.Proof = append([]byte(nil), b...)
+	return nil
+}
+
+// Serialize returns the share for sending to its participant.
+func (s *KeyGenShare) Serialize() []byte {
+	b := make([]byte, 0, keyGenShareLen)
+	b = binary.BigEndian.AppendUint32(b, s.From)
+	b = binary.BigEndian.AppendUint32(b, s.To)
+	return append(b, s.Share...)
+}
+
+// Deserialize sets the share from a serialized share.
+func (s *KeyGenShare) Deserialize(b []byte) error {
+	if len(b) != keyGenShareLen {
+		return ErrInvalidThresholdEncoding
+	}
+	s.From = binary.BigEndian.Uint32(b[0:4])
+	s.To = binary.BigEndian.Uint32(b[4:8])
+	s.Share = append([]byte(nil), b[8:40]...)
+	return nil
+}
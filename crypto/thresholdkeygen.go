@@ -0,0 +1,288 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+var (
+	// ErrInvalidKeyGenCommitment is returned when a participant's key
+	// generation commitment is malformed or its proof of knowledge does
+	// not verify.
+	ErrInvalidKeyGenCommitment = errors.New("invalid key generation commitment")
+
+	// ErrInvalidKeyGenShare is returned when a secret share received
+	// during key generation does not match the sender's commitment.
+	ErrInvalidKeyGenShare = errors.New("invalid key generation share")
+)
+
+// KeyGenParticipant is one participant's state in a t-of-n distributed
+// key generation. This is the Pedersen key generation used by FROST: each
+// participant picks its own random polynomial, publishes commitments to
+// its coefficients and sends every other participant a secret share. The
+// group key is the sum of the participants' constant terms so no one,
+// including the participants themselves, ever learns the private key.
+//
+// Every share received is checked against the sender's commitment so a
+// participant that sends an invalid share is identified by index and the
+// key generation can be restarted without it.
+type KeyGenParticipant struct {
+	// Index is the participant's index. Indexes start at one.
+	Index uint32
+
+	// Threshold is the number of participants needed to sign.
+	Threshold uint32
+
+	// Participants is the total number of participants.
+	Participants uint32
+
+	// share is the participant's evaluation of its own polynomial.
+	share *edwards25519.Scalar
+}
+
+// KeyGenCommitment is a participant's public commitment to its polynomial.
+// It is broadcast to every other participant in the first round.
+type KeyGenCommitment struct {
+	Index uint32
+
+	// Coefficients are the commitments a_k·B to each coefficient of the
+	// participant's polynomial, starting with the constant term.
+	Coefficients [][]byte
+
+	// Proof is a Schnorr proof of knowledge of the constant term. It
+	// prevents a participant from choosing its commitment as a function
+	// of the others' to bias or control the group key.
+	Proof []byte
+}
+
+// KeyGenShare is a secret share of one participant's polynomial for
+// another participant. It must be sent privately to the participant it
+// is for.
+type KeyGenShare struct {
+	From  uint32
+	To    uint32
+	Share []byte
+}
+
+// NewKeyGenParticipant starts a distributed key generation for the
+// participant at the given index. It returns the participant's state,
+// which must be kept secret until FinishKeyGen, the commitment to
+// broadcast to every other participant, and a share for each of the
+// other participants.
+func NewKeyGenParticipant(index uint32, t, n int, src io.Reader) (*KeyGenParticipant, *KeyGenCommitment, []*KeyGenShare, error) {
+	if t <= 0 || n <= 0 || t > n || n > 0xffff {
+		return nil, nil, nil, ErrInvalidThreshold
+	}
+	if index == 0 || index > uint32(n) {
+		return nil, nil, nil, ErrInvalidThreshold
+	}
+	secret, err := randomScalar(src)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	coefficients, err := randomPolynomial(secret, t, src)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	commitment := &KeyGenCommitment{
+		Index:        index,
+		Coefficients: make([][]byte, t),
+	}
+	for i, c := range coefficients {
+		commitment.Coefficients[i] = new(edwards25519.Point).ScalarBaseMult(c).Bytes()
+	}
+
+	// Prove knowledge of the constant term: R = k·B, z = k + a_0·c
+	k, err := randomScalar(src)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	r := new(edwards25519.Point).ScalarBaseMult(k)
+	c := keyGenChallenge(index, uint32(t), uint32(n), commitment.Coefficients[0], r.Bytes())
+	z := edwards25519.NewScalar().MultiplyAdd(secret, c, k)
+	commitment.Proof = append(r.Bytes(), z.Bytes()...)
+	k.Set(edwards25519.NewScalar())
+
+	shares := make([]*KeyGenShare, 0, n-1)
+	for i := uint32(1); i <= uint32(n); i++ {
+		if i == index {
+			continue
+		}
+		shares = append(shares, &KeyGenShare{
+			From:  index,
+			To:    i,
+			Share: evalPolynomial(coefficients, i).Bytes(),
+		})
+	}
+
+	p := &KeyGenParticipant{
+		Index:        index,
+		Threshold:    uint32(t),
+		Participants: uint32(n),
+		share:        evalPolynomial(coefficients, index),
+	}
+	for _, c := range coefficients {
+		c.Set(edwards25519.NewScalar())
+	}
+	return p, commitment, shares, nil
+}
+
+// FinishKeyGen verifies the commitments of every participant, including
+// this one, and the shares sent to this participant and combines them
+// into the participant's threshold key share. The participant's state is
+// wiped when key generation succeeds.
+//
+// Every participant must use the same set of commitments, otherwise they
+// will compute different group keys. Participants should compare the
+// resulting group keys before using them.
+func (p *KeyGenParticipant) FinishKeyGen(commitments []*KeyGenCommitment, shares []*KeyGenShare) (*ThresholdKeyShare, error) {
+	if p.share == nil {
+		return nil, ErrInvalidThreshold
+	}
+	if len(commitments) != int(p.Participants) {
+		return nil, ErrInvalidKeyGenCommitment
+	}
+	polys := make(map[uint32][]*edwards25519.Point, len(commitments))
+	for _, cm := range commitments {
+		poly, err := p.verifyCommitment(cm)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := polys[cm.Index]; ok {
+			return nil, fmt.Errorf("%w: duplicate participant %d", ErrInvalidKeyGenCommitment, cm.Index)
+		}
+		polys[cm.Index] = poly
+	}
+
+	secret := edwards25519.NewScalar().Set(p.share)
+	if new(edwards25519.Point).ScalarBaseMult(secret).Equal(evalCommitment(polys[p.Index], p.Index)) != 1 {
+		return nil, fmt.Errorf("%w: participant %d", ErrInvalidKeyGenCommitment, p.Index)
+	}
+
+	received := make(map[uint32]bool, len(shares))
+	for _, share := range shares {
+		invalid := fmt.Errorf("%w: participant %d", ErrInvalidKeyGenShare, share.From)
+		if share.To != p.Index || share.From == p.Index || received[share.From] {
+			return nil, invalid
+		}
+		poly, ok := polys[share.From]
+		if !ok {
+			return nil, invalid
+		}
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(share.Share)
+		if err != nil {
+			return nil, invalid
+		}
+		if new(edwards25519.Point).ScalarBaseMult(s).Equal(evalCommitment(poly, p.Index)) != 1 {
+			return nil, invalid
+		}
+		received[share.From] = true
+		secret.Add(secret, s)
+	}
+	if len(received) != int(p.Participants)-1 {
+		return nil, ErrInvalidKeyGenShare
+	}
+
+	// The group key is the sum of the constant terms and each verification
+	// share is the sum of every polynomial's commitment at that index.
+	groupPoint := edwards25519.NewIdentityPoint()
+	for _, poly := range polys {
+		groupPoint.Add(groupPoint, poly[0])
+	}
+	groupKey, err := crypto.UnmarshalEd25519PublicKey(groupPoint.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	verificationShares := make(map[uint32][]byte, p.Participants)
+	for i := uint32(1); i <= p.Participants; i++ {
+		y := edwards25519.NewIdentityPoint()
+		for _, poly := range polys {
+			y.Add(y, evalCommitment(poly, i))
+		}
+		verificationShares[i] = y.Bytes()
+	}
+
+	p.Zeroize()
+	return &ThresholdKeyShare{
+		Index:              p.Index,
+		Threshold:          p.Threshold,
+		GroupKey:           groupKey,
+		VerificationShares: verificationShares,
+		secret:             secret,
+	}, nil
+}
+
+// verifyCommitment decodes the commitment and checks its proof of
+// knowledge.
+func (p *KeyGenParticipant) verifyCommitment(cm *KeyGenCommitment) ([]*edwards25519.Point, error) {
+	invalid := fmt.Errorf("%w: participant %d", ErrInvalidKeyGenCommitment, cm.Index)
+	if cm.Index == 0 || cm.Index > p.Participants || len(cm.Coefficients) != int(p.Threshold) || len(cm.Proof) != 64 {
+		return nil, invalid
+	}
+	poly := make([]*edwards25519.Point, len(cm.Coefficients))
+	for i, b := range cm.Coefficients {
+		pt, err := new(edwards25519.Point).SetBytes(b)
+		if err != nil {
+			return nil, invalid
+		}
+		poly[i] = pt
+	}
+	r, err := new(edwards25519.Point).SetBytes(cm.Proof[:32])
+	if err != nil {
+		return nil, invalid
+	}
+	z, err := edwards25519.NewScalar().SetCanonicalBytes(cm.Proof[32:])
+	if err != nil {
+		return nil, invalid
+	}
+
+	// z·B - c·A_0 == R
+	c := keyGenChallenge(cm.Index, p.Threshold, p.Participants, cm.Coefficients[0], cm.Proof[:32])
+	c.Negate(c)
+	if new(edwards25519.Point).VarTimeDoubleScalarBaseMult(c, poly[0], z).Equal(r) != 1 {
+		return nil, invalid
+	}
+	return poly, nil
+}
+
+// evalCommitment evaluates the committed polynomial at x in the exponent.
+func evalCommitment(poly []*edwards25519.Point, x uint32) *edwards25519.Point {
+	xs := scalarFromUint32(x)
+	y := edwards25519.NewIdentityPoint()
+	for j := len(poly) - 1; j >= 0; j-- {
+		y.ScalarMult(xs, y)
+		y.Add(y, poly[j])
+	}
+	return y
+}
+
+// keyGenChallenge computes the challenge for the proof of knowledge. It
+// commits to the participant's index and the threshold parameters so
+// that a proof cannot be replayed by another participant or in another
+// key generation with different parameters.
+func keyGenChallenge(index, t, n uint32, a0, r []byte) *edwards25519.Scalar {
+	var b [12]byte
+	binary.BigEndian.PutUint32(b[0:4], index)
+	binary.BigEndian.PutUint32(b[4:8], t)
+	binary.BigEndian.PutUint32(b[8:12], n)
+
+	h := sha512.New()
+	h.Write([]byte(thresholdDomain + "/keygen"))
+	h.Write(b[:])
+	h.Write(a0)
+	h.Write(r)
+	// SetUniformBytes only fails if the input is not 64 bytes.
+	c, _ := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	return c
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"errors"
+	"math/big"
+)
+
+// This file implements the Vesta curve arithmetic needed by protocols,
+// such as MuSig, that must combine points in Go rather than only signing
+// and verifying through the Rust library.
+//
+// Vesta is y^2 = x^3 + 5 over the field with modulus vestaP. Points are
+// encoded the same way as the pasta_curves crate: the x coordinate in
+// little endian with the parity of y stored in the top bit. The identity
+// is encoded as all zeros.
+
+var (
+	vestaP, _ = new(big.Int).SetString("40000000000000000000000000000000224698fc0994a8dd8c46eb2100000001", 16)
+	vestaN, _ = new(big.Int).SetString("40000000000000000000000000000000224698fc094cf91b992d30ed00000001", 16)
+	vestaB    = big.NewInt(5)
+
+	vestaG = &vestaPoint{
+		x: new(big.Int).Sub(vestaP, big.NewInt(1)),
+		y: big.NewInt(2),
+	}
+
+	errInvalidPoint = errors.New("invalid vesta point")
+)
+
+// vestaPoint is a point on the Vesta curve in affine coordinates. A
+// nil x and y is the identity.
+type vestaPoint struct {
+	x, y *big.Int
+}
+
+func (p *vestaPoint) isIdentity() bool {
+	return p.x == nil
+}
+
+func (p *vestaPoint) equal(q *vestaPoint) bool {
+	if p.isIdentity() || q.isIdentity() {
+		return p.isIdentity() == q.isIdentity()
+	}
+	return p.x.Cmp(q.x) == 0 && p.y.Cmp(q.y) == 0
+}
+
+func vestaAdd(p, q *vestaPoint) *vestaPoint {
+	if p.isIdentity() {
+		return q
+	}
+	if q.isIdentity() {
+		return p
+	}
+
+	lambda := new(big.Int)
+	if p.x.Cmp(q.x) == 0 {
+		sum := new(big.Int).Add(p.y, q.y)
+		if sum.Mod(sum, vestaP).Sign() == 0 {
+			return &vestaPoint{}
+		}
+		// lambda = 3x^2 / 2y
+		num := new(big.Int).Mul(p.x, p.x)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(p.y, 1)
+		den.ModInverse(den, vestaP)
+		lambda.Mul(num, den)
+	} else {
+		// lambda = (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(q.y, p.y)
+		den := new(big.Int).Sub(q.x, p.x)
+		den.Mod(den, vestaP)
+		den.ModInverse(den, vestaP)
+		lambda.Mul(num, den)
+	}
+	lambda.Mod(lambda, vestaP)
+
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, p.x)
+	x.Sub(x, q.x)
+	x.Mod(x, vestaP)
+
+	y := new(big.Int).Sub(p.x, x)
+	y.Mul(y, lambda)
+	y.Sub(y, p.y)
+	y.Mod(y, vestaP)
+
+	return &vestaPoint{x: x, y: y}
+}
+
+// vestaScalarMult returns k·p. The multiplication runs in constant time
+// so it is safe to use with secret scalars.
+func vestaScalarMult(p *vestaPoint, k *big.Int) *vestaPoint {
+	return vestaScalarMultConstantTime(p, k)
+}
+
+func vestaScalarBaseMult(k *big.Int) *vestaPoint {
+	return vestaScalarMult(vestaG, k)
+}
+
+// bytes returns the compressed encoding of the point.
+func (p *vestaPoint) bytes() []byte {
+	out := make([]byte, 32)
+	if p.isIdentity() {
+		return out
+	}
+	p.x.FillBytes(out)
+	reverseBytes(out)
+	out[31] |= byte(p.y.Bit(0)) << 7
+	return out
+}
+
+// vestaPointFromBytes decodes a compressed point.
+func vestaPointFromBytes(b []byte) (*vestaPoint, error) {
+	if len(b) != 32 {
+		return nil, errInvalidPoint
+	}
+	buf := make([]byte, 32)
+	copy(buf, b)
+	sign := uint(buf[31] >> 7)
+	buf[31] &= 0x7f
+	x := new(big.Int).SetBytes(reverseBytes(buf))
+	if x.Cmp(vestaP) >= 0 {
+		return nil, errInvalidPoint
+	}
+	if x.Sign() == 0 && sign == 0 {
+		return &vestaPoint{}, nil
+	}
+
+	// y^2 = x^3 + 5
+	y2 := new(big.Int).Exp(x, big.NewInt(3), vestaP)
+	y2.Add(y2, vestaB)
+	y2.Mod(y2, vestaP)
+	y := new(big.Int).ModSqrt(y2, vestaP)
+	if y == nil {
+		return nil, errInvalidPoint
+	}
+	if y.Bit(0) != sign {
+		if y.Sign() == 0 {
+			return nil, errInvalidPoint
+		}
+		y.Sub(vestaP, y)
+	}
+	return &vestaPoint{x: x, y: y}, nil
+}
+
+// reverseBytes reverses b in place and returns it. It converts between
+// the big endian encoding used by math/big and the little endian
+// encoding of the field elements.
+func reverseBytes(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// This file implements constant time arithmetic over the Vesta base field
+// and constant time scalar multiplication of Vesta points. It is used
+// whenever a point is multiplied by a secret scalar. Field elements are
+// kept in Montgomery form as four little endian 64 bit limbs.
+
+type vestaFieldElement [4]uint64
+
+var (
+	// vestaFieldModulus is vestaP as little endian limbs.
+	vestaFieldModulus = vestaFieldElement{0x8c46eb2100000001, 0x224698fc0994a8dd, 0, 0x4000000000000000}
+
+	// vestaFieldR2 is 2^512 mod vestaP and is used to convert into
+	// Montgomery form.
+	vestaFieldR2 = vestaFieldElement{0xfc9678ff0000000f, 0x67bb433d891a16e3, 0x7fae231004ccf590, 0x096d41af7ccfdaa9}
+
+	// vestaFieldOne is one in Montgomery form.
+	vestaFieldOne = vestaFieldElement{0x5b2b3e9cfffffffd, 0x992c350be3420567, 0xffffffffffffffff, 0x3fffffffffffffff}
+
+	// vestaB3 is three times the curve constant b in Montgomery form.
+	vestaB3 = newVestaFieldElement(big.NewInt(15))
+)
+
+// vestaFieldInv is -vestaP^-1 mod 2^64.
+const vestaFieldInv = 0x8c46eb20ffffffff
+
+// newVestaFieldElement converts x, which must be less than vestaP, into
+// Montgomery form.
+func newVestaFieldElement(x *big.Int) *vestaFieldElement {
+	var b [32]byte
+	x.FillBytes(b[:])
+	var z vestaFieldElement
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			z[i] |= uint64(b[31-(i*8+j)]) << (8 * j)
+		}
+	}
+	return z.mul(&z, &vestaFieldR2)
+}
+
+// bigInt returns the canonical value of the field element.
+func (z *vestaFieldElement) bigInt() *big.Int {
+	var (
+		one = vestaFieldElement{1}
+		t   vestaFieldElement
+		b   [32]byte
+	)
+	t.mul(z, &one)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			b[31-(i*8+j)] = byte(t[i] >> (8 * j))
+		}
+	}
+	return new(big.Int).SetBytes(b[:])
+}
+
+func (z *vestaFieldElement) isZero() bool {
+	return z[0]|z[1]|z[2]|z[3] == 0
+}
+
+// reduce sets z to t - vestaP if t >= vestaP and to t otherwise.
+func (z *vestaFieldElement) reduce(t *vestaFieldElement) *vestaFieldElement {
+	var (
+		r      vestaFieldElement
+		borrow uint64
+	)
+	r[0], borrow = bits.Sub64(t[0], vestaFieldModulus[0], 0)
+	r[1], borrow = bits.Sub64(t[1], vestaFieldModulus[1], borrow)
+	r[2], borrow = bits.Sub64(t[2], vestaFieldModulus[2], borrow)
+	r[3], borrow = bits.Sub64(t[3], vestaFieldModulus[3], borrow)
+	return z.selectElement(t, &r, borrow)
+}
+
+// selectElement sets z to a if cond is 1 and to b if cond is 0.
+func (z *vestaFieldElement) selectElement(a, b *vestaFieldElement, cond uint64) *vestaFieldElement {
+	mask := -cond
+	for i := 0; i < 4; i++ {
+		z[i] = (a[i] & mask) | (b[i] &^ mask)
+	}
+	return z
+}
+
+func (z *vestaFieldElement) add(a, b *vestaFieldElement) *vestaFieldElement {
+	var (
+		t     vestaFieldElement
+		carry uint64
+	)
+	t[0], carry = bits.Add64(a[0], b[0], 0)
+	t[1], carry = bits.Add64(a[1], b[1], carry)
+	t[2], carry = bits.Add64(a[2], b[2], carry)
+	t[3], _ = bits.Add64(a[3], b[3], carry)
+	return z.reduce(&t)
+}
+
+func (z *vestaFieldElement) sub(a, b *vestaFieldElement) *vestaFieldElement {
+	var (
+		t             vestaFieldElement
+		borrow, carry uint64
+	)
+	t[0], borrow = bits.Sub64(a[0], b[0], 0)
+	t[1], borrow = bits.Sub64(a[1], b[1], borrow)
+	t[2], borrow = bits.Sub64(a[2], b[2], borrow)
+	t[3], borrow = bits.Sub64(a[3], b[3], borrow)
+
+	// Add the modulus back if the subtraction underflowed.
+	mask := -borrow
+	z[0], carry = bits.Add64(t[0], vestaFieldModulus[0]&mask, 0)
+	z[1], carry = bits.Add64(t[1], vestaFieldModulus[1]&mask, carry)
+	z[2], carry = bits.Add64(t[2], vestaFieldModulus[2]&mask, carry)
+	z[3], _ = bits.Add64(t[3], vestaFieldModulus[3]&mask, carry)
+	return z
+}
+
+// mul sets z to a·b using Montgomery multiplication.
+func (z *vestaFieldElement) mul(a, b *vestaFieldElement) *vestaFieldElement {
+	var t [6]uint64
+	for i := 0; i < 4; i++ {
+		var c uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(a[j], b[i])
+			var c1, c2 uint64
+			lo, c1 = bits.Add64(lo, t[j], 0)
+			lo, c2 = bits.Add64(lo, c, 0)
+			t[j], c = lo, hi+c1+c2
+		}
+		var c1 uint64
+		t[4], c1 = bits.Add64(t[4], c, 0)
+		t[5] = c1
+
+		m := t[0] * vestaFieldInv
+		hi, lo := bits.Mul64(m, vestaFieldModulus[0])
+		_, c1 = bits.Add64(lo, t[0], 0)
+		c = hi + c1
+		for j := 1; j < 4; j++ {
+			hi, lo := bits.Mul64(m, vestaFieldModulus[j])
+			var c2 uint64
+			lo, c1 = bits.Add64(lo, t[j], 0)
+			lo, c2 = bits.Add64(lo, c, 0)
+			t[j-1], c = lo, hi+c1+c2
+		}
+		t[3], c1 = bits.Add64(t[4], c, 0)
+		t[4] = t[5] + c1
+	}
+	r := vestaFieldElement{t[0], t[1], t[2], t[3]}
+	return z.reduce(&r)
+}
+
+// invert sets z to a^-1 by raising it to vestaP - 2. The exponent is
+// public so the running time does not depend on a.
+func (z *vestaFieldElement) invert(a *vestaFieldElement) *vestaFieldElement {
+	e := new(big.Int).Sub(vestaP, big.NewInt(2))
+	r := vestaFieldOne
+	base := *a
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r.mul(&r, &r)
+		if e.Bit(i) == 1 {
+			r.mul(&r, &base)
+		}
+	}
+	*z = r
+	return z
+}
+
+// vestaProjectivePoint is a point in homogeneous projective coordinates.
+// The identity is (0:1:0).
+type vestaProjectivePoint struct {
+	x, y, z vestaFieldElement
+}
+
+func newVestaProjectivePoint(p *vestaPoint) *vestaProjectivePoint {
+	if p.isIdentity() {
+		return &vestaProjectivePoint{y: vestaFieldOne}
+	}
+	return &vestaProjectivePoint{
+		x: *newVestaFieldElement(p.x),
+		y: *newVestaFieldElement(p.y),
+		z: vestaFieldOne,
+	}
+}
+
+func (p *vestaProjectivePoint) affine() *vestaPoint {
+	if p.z.isZero() {
+		return &vestaPoint{}
+	}
+	var zInv, x, y vestaFieldElement
+	zInv.invert(&p.z)
+	x.mul(&p.x, &zInv)
+	y.mul(&p.y, &zInv)
+	return &vestaPoint{x: x.bigInt(), y: y.bigInt()}
+}
+
+// add sets r to p + q using the complete addition formula for a = 0
+// curves from Renes, Costello and Batina (algorithm 7). The formula
+// handles doubling and the identity so there are no branches.
+func (r *vestaProjectivePoint) add(p, q *vestaProjectivePoint) *vestaProjectivePoint {
+	var t0, t1, t2, t3, t4, x3, y3, z3 vestaFieldElement
+	t0.mul(&p.x, &q.x)
+	t1.mul(&p.y, &q.y)
+	t2.mul(&p.z, &q.z)
+	t3.add(&p.x, &p.y)
+	t4.add(&q.x, &q.y)
+	t3.mul(&t3, &t4)
+	t4.add(&t0, &t1)
+	t3.sub(&t3, &t4)
+	t4.add(&p.y, &p.z)
+	x3.add(&q.y, &q.z)
+	t4.mul(&t4, &x3)
+	x3.add(&t1, &t2)
+	t4.sub(&t4, &x3)
+	x3.add(&p.x, &p.z)
+	y3.add(&q.x, &q.z)
+	x3.mul(&x3, &y3)
+	y3.add(&t0, &t2)
+	y3.sub(&x3, &y3)
+	x3.add(&t0, &t0)
+	t0.add(&x3, &t0)
+	t2.mul(vestaB3, &t2)
+	z3.add(&t1, &t2)
+	t1.sub(&t1, &t2)
+	y3.mul(vestaB3, &y3)
+	x3.mul(&t4, &y3)
+	t2.mul(&t3, &t1)
+	x3.sub(&t2, &x3)
+	y3.mul(&y3, &t0)
+	t1.mul(&t1, &z3)
+	y3.add(&t1, &y3)
+	t0.mul(&t0, &t3)
+	z3.mul(&z3, &t4)
+	z3.add(&z3, &t0)
+	r.x, r.y, r.z = x3, y3, z3
+	return r
+}
+
+// selectPoint sets r to a if cond is 1 and to b if cond is 0.
+func (r *vestaProjectivePoint) selectPoint(a, b *vestaProjectivePoint, cond uint64) *vestaProjectivePoint {
+	r.x.selectElement(&a.x, &b.x, cond)
+	r.y.selectElement(&a.y, &b.y, cond)
+	r.z.selectElement(&a.z, &b.z, cond)
+	return r
+}
+
+// vestaScalarMultConstantTime returns k·p. It always performs 256
+// doublings and additions and selects the result without branching
+// on the bits of k.
+func vestaScalarMultConstantTime(p *vestaPoint, k *big.Int) *vestaPoint {
+	var kb [32]byte
+	new(big.Int).Mod(k, vestaN).FillBytes(kb[:])
+	defer Zeroize(kb[:])
+
+	var (
+		base = newVestaProjectivePoint(p)
+		r    = &vestaProjectivePoint{y: vestaFieldOne}
+		t    vestaProjectivePoint
+	)
+	for i := 0; i < 256; i++ {
+		bit := uint64(kb[i/8]>>(7-i%8)) & 1
+		r.add(r, r)
+		t.add(r, base)
+		r.selectPoint(&t, r, bit)
+	}
+	return r.affine()
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVestaFieldElement(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		a, err := rand.Int(rand.Reader, vestaP)
+		assert.NoError(t, err)
+		b, err := rand.Int(rand.Reader, vestaP)
+		assert.NoError(t, err)
+		fa, fb := newVestaFieldElement(a), newVestaFieldElement(b)
+		assert.Equal(t, a, fa.bigInt())
+
+		var z vestaFieldElement
+		expected := new(big.Int).Add(a, b)
+		assert.Equal(t, expected.Mod(expected, vestaP), z.add(fa, fb).bigInt())
+
+		expected = new(big.Int).Sub(a, b)
+		assert.Equal(t, expected.Mod(expected, vestaP), z.sub(fa, fb).bigInt())
+
+		expected = new(big.Int).Mul(a, b)
+		assert.Equal(t, expected.Mod(expected, vestaP), z.mul(fa, fb).bigInt())
+
+		assert.Equal(t, new(big.Int).ModInverse(a, vestaP), z.invert(fa).bigInt())
+	}
+}
+
+func TestVestaScalarMult(t *testing.T) {
+	// Reference double and add using the affine formulas.
+	reference := func(p *vestaPoint, k *big.Int) *vestaPoint {
+		r := &vestaPoint{}
+		for i := k.BitLen() - 1; i >= 0; i-- {
+			r = vestaAdd(r, r)
+			if k.Bit(i) == 1 {
+				r = vestaAdd(r, p)
+			}
+		}
+		return r
+	}
+
+	p := vestaScalarBaseMult(big.NewInt(7))
+	assert.True(t, reference(vestaG, big.NewInt(7)).equal(p))
+
+	for i := 0; i < 20; i++ {
+		k, err := rand.Int(rand.Reader, vestaN)
+		assert.NoError(t, err)
+		assert.True(t, reference(vestaG, k).equal(vestaScalarBaseMult(k)))
+		assert.True(t, reference(p, k).equal(vestaScalarMult(p, k)))
+	}
+
+	assert.True(t, vestaScalarBaseMult(big.NewInt(0)).isIdentity())
+	assert.True(t, vestaScalarBaseMult(vestaN).isIdentity())
+	assert.True(t, vestaScalarMult(&vestaPoint{}, big.NewInt(5)).isIdentity())
+	assert.True(t, vestaG.equal(vestaScalarBaseMult(new(big.Int).Add(vestaN, big.NewInt(1)))))
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+)
+
+const (
+	// VRFProofSize is the size of a serialized VRF proof.
+	VRFProofSize = 80
+
+	// VRFOutputSize is the size of the VRF output.
+	VRFOutputSize = 64
+
+	vrfSuite        = 0x03
+	vrfChallengeLen = 16
+)
+
+var (
+	// ErrVRFKeyType is returned when a VRF is requested for a key
+	// that is not an Ed25519 key.
+	ErrVRFKeyType = errors.New("vrf requires an ed25519 key")
+
+	// ErrVRFMalformedProof is returned when a VRF proof does not decode.
+	ErrVRFMalformedProof = errors.New("malformed vrf proof")
+)
+
+// VRFProve evaluates the verifiable random function for the input using
+// the private key. It returns the proof along with the output. Anyone
+// with the public key can verify the proof and recompute the output, but
+// the output cannot be predicted without the private key.
+//
+// The VRF is ECVRF-EDWARDS25519-SHA512-TAI from RFC 9381 so the existing
+// Ed25519 validator keys can be used.
+func VRFProve(priv crypto.PrivKey, alpha []byte) ([]byte, []byte, error) {
+	if priv.Type() != pb.KeyType_Ed25519 {
+		return nil, nil, ErrVRFKeyType
+	}
+	raw, err := priv.Raw()
+	if err != nil {
+		return nil, nil, err
+	}
+	hashedSK := sha512.Sum512(raw[:ed25519.SeedSize])
+	x, err := edwards25519.NewScalar().SetBytesWithClamping(hashedSK[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	pk := new(edwards25519.Point).ScalarBaseMult(x).Bytes()
+
+	h, err := vrfHashToCurve(pk, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hBytes := h.Bytes()
+	gamma := new(edwards25519.Point).ScalarMult(x, h)
+
+	kHash := sha512.New()
+	kHash.Write(hashedSK[32:])
+	kHash.Write(hBytes)
+	k, err := edwards25519.NewScalar().SetUniformBytes(kHash.Sum(nil))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := new(edwards25519.Point).ScalarBaseMult(k)
+	v := new(edwards25519.Point).ScalarMult(k, h)
+	c := vrfChallenge(pk, hBytes, gamma.Bytes(), u.Bytes(), v.Bytes())
+	s := edwards25519.NewScalar().MultiplyAdd(c, x, k)
+
+	proof := make([]byte, 0, VRFProofSize)
+	proof = append(proof, gamma.Bytes()...)
+	proof = append(proof, c.Bytes()[:vrfChallengeLen]...)
+	proof = append(proof, s.Bytes()...)
+
+	return proof, vrfProofToHash(gamma), nil
+}
+
+// VRFVerify verifies the proof for the input and public key and returns
+// the VRF output if it is valid.
+func VRFVerify(pub crypto.PubKey, alpha []byte, proof []byte) ([]byte, bool, error) {
+	if pub.Type() != pb.KeyType_Ed25519 {
+		return nil, false, ErrVRFKeyType
+	}
+	pk, err := pub.Raw()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(proof) != VRFProofSize {
+		return nil, false, ErrVRFMalformedProof
+	}
+	y, err := new(edwards25519.Point).SetBytes(pk)
+	if err != nil {
+		return nil, false, nil
+	}
+	// RFC 9381 validate_key. A small-order key lets anyone forge a
+	// proof, with an output that does not depend on the input.
+	if new(edwards25519.Point).MultByCofactor(y).Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, false, nil
+	}
+	gamma, err := new(edwards25519.Point).SetBytes(proof[:32])
+	if err != nil {
+		return nil, false, nil
+	}
+	var cBytes [32]byte
+	copy(cBytes[:], proof[32:32+vrfChallengeLen])
+	c, err := edwards25519.NewScalar().SetCanonicalBytes(cBytes[:])
+	if err != nil {
+		return nil, false, nil
+	}
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(proof[32+vrfChallengeLen:])
+	if err != nil {
+		return nil, false, nil
+	}
+
+	h, err := vrfHashToCurve(pk, alpha)
+	if err != nil {
+		return nil, false, err
+	}
+	negC := edwards25519.NewScalar().Negate(c)
+
+	// U = s·B - c·Y
+	u := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(negC, y, s)
+	// V = s·H - c·Gamma
+	v := new(edwards25519.Point).VarTimeMultiScalarMult(
+		[]*edwards25519.Scalar{s, negC},
+		[]*edwards25519.Point{h, gamma},
+	)
+
+	expected := vrfChallenge(pk, h.Bytes(), proof[:32], u.Bytes(), v.Bytes())
+	if expected.Equal(c) != 1 {
+		return nil, false, nil
+	}
+	return vrfProofToHash(gamma), true, nil
+}
+
+// vrfHashToCurve is the try and increment hash to curve.
+func vrfHashToCurve(pk, alpha []byte) (*edwards25519.Point, error) {
+	h := sha512.New()
+	for ctr := 0; ctr < 256; ctr++ {
+		h.Reset()
+		h.Write([]byte{vrfSuite, 0x01})
+		h.Write(pk)
+		h.Write(alpha)
+		h.Write([]byte{byte(ctr), 0x00})
+		p, err := new(edwards25519.Point).SetBytes(h.Sum(nil)[:32])
+		if err != nil {
+			continue
+		}
+		p.MultByCofactor(p)
+		if p.Equal(edwards25519.NewIdentityPoint()) == 1 {
+			continue
+		}
+		return p, nil
+	}
+	// This is expected to never happen. Each attempt succeeds with
+	// probability of roughly one half.
+	return nil, errors.New("vrf hash to curve failed")
+}
+
+func vrfChallenge(points ...[]byte) *edwards25519.Scalar {
+	h := sha512.New()
+	h.Write([]byte{vrfSuite, 0x02})
+	for _, p := range points {
+		h.Write(p)
+	}
+	h.Write([]byte{0x00})
+
+	var c [32]byte
+	copy(c[:], h.Sum(nil)[:vrfChallengeLen])
+	// A 16 byte value is always less than the group order.
+	s, _ := edwards25519.NewScalar().SetCanonicalBytes(c[:])
+	return s
+}
+
+func vrfProofToHash(gamma *edwards25519.Point) []byte {
+	h := sha512.New()
+	h.Write([]byte{vrfSuite, 0x03})
+	h.Write(new(edwards25519.Point).MultByCofactor(gamma).Bytes())
+	h.Write([]byte{0x00})
+	return h.Sum(nil)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVRF(t *testing.T) {
+	// RFC 9381 ECVRF-EDWARDS25519-SHA512-TAI example 16.
+	seed, err := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	assert.NoError(t, err)
+	priv, err := crypto.UnmarshalEd25519PrivateKey(ed25519.NewKeyFromSeed(seed))
+	assert.NoError(t, err)
+
+	proof, output, err := VRFProve(priv, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805", hex.EncodeToString(proof))
+	assert.Equal(t, "90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae", hex.EncodeToString(output))
+
+	output2, valid, err := VRFVerify(priv.GetPublic(), nil, proof)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, output, output2)
+
+	// Wrong input
+	_, valid, err = VRFVerify(priv.GetPublic(), []byte{0x01}, proof)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Wrong key
+	_, pub2, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	_, valid, err = VRFVerify(pub2, nil, proof)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// Tampered proof
+	proof[40] ^= 0x01
+	_, valid, err = VRFVerify(priv.GetPublic(), nil, proof)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, _, err = VRFVerify(priv.GetPublic(), nil, proof[:79])
+	assert.ErrorIs(t, err, ErrVRFMalformedProof)
+}
+
+// TestVRFSmallOrderKey checks that proofs for small-order public keys are
+// rejected. For such a key c·Y takes at most eight values so a proof can
+// be forged for any input, with an output that does not depend on it.
+func TestVRFSmallOrderKey(t *testing.T) {
+	for _, keyHex := range []string{
+		// The identity point.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		// A point of order 8.
+		"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a",
+	} {
+		pk, err := hex.DecodeString(keyHex)
+		assert.NoError(t, err)
+		y, err := new(edwards25519.Point).SetBytes(pk)
+		assert.NoError(t, err)
+		pub, err := crypto.UnmarshalEd25519PublicKey(pk)
+		assert.NoError(t, err)
+
+		alpha := []byte("input")
+		h, err := vrfHashToCurve(pk, alpha)
+		assert.NoError(t, err)
+		gamma := edwards25519.NewIdentityPoint()
+
+		// Guess c·Y and retry until the challenge matches the guess.
+		var proof []byte
+		for i := 0; proof == nil; i++ {
+			b := make([]byte, 64)
+			_, err := rand.Read(b)
+			assert.NoError(t, err)
+			s, err := edwards25519.NewScalar().SetUniformBytes(b)
+			assert.NoError(t, err)
+
+			var jBytes [32]byte
+			jBytes[0] = byte(i % 8)
+			j, err := edwards25519.NewScalar().SetCanonicalBytes(jBytes[:])
+			assert.NoError(t, err)
+			jY := new(edwards25519.Point).ScalarMult(j, y)
+
+			u := new(edwards25519.Point).Subtract(new(edwards25519.Point).ScalarBaseMult(s), jY)
+			v := new(edwards25519.Point).ScalarMult(s, h)
+			c := vrfChallenge(pk, h.Bytes(), gamma.Bytes(), u.Bytes(), v.Bytes())
+			if new(edwards25519.Point).ScalarMult(c, y).Equal(jY) != 1 {
+				continue
+			}
+			proof = append(proof, gamma.Bytes()...)
+			proof = append(proof, c.Bytes()[:vrfChallengeLen]...)
+			proof = append(proof, s.Bytes()...)
+		}
+
+		_, valid, err := VRFVerify(pub, alpha, proof)
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	}
+}
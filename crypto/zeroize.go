@@ -0,0 +1,71 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"runtime"
+	"unsafe"
+
+	"filippo.io/edwards25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+// Zeroizer is implemented by private keys that can wipe their
+// key material from memory.
+type Zeroizer interface {
+	// Zeroize overwrites the key material with zeros. The key
+	// must not be used after it has been zeroized.
+	Zeroize()
+}
+
+// Zeroize overwrites the buffer with zeros.
+//
+// The Go garbage collector may have already copied the buffer so this
+// is not a guarantee that no copy remains in memory, but it does ensure
+// buffers holding secrets do not outlive their use.
+func Zeroize(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+	// Prevent the compiler from eliding the writes as dead stores.
+	runtime.KeepAlive(b)
+}
+
+// ZeroizeKey wipes the private key if its type supports it. Ed25519
+// keys from the libp2p crypto package are also wiped. Other key types
+// are left unchanged.
+func ZeroizeKey(key crypto.PrivKey) {
+	switch k := key.(type) {
+	case Zeroizer:
+		k.Zeroize()
+	case *crypto.Ed25519PrivateKey:
+		// The libp2p key does not expose its buffer but its only
+		// field is the ed25519.PrivateKey slice.
+		Zeroize(*(*ed25519.PrivateKey)(unsafe.Pointer(k)))
+	}
+}
+
+// Zeroize overwrites the private key with zeros.
+func (k *Curve25519PrivateKey) Zeroize() {
+	Zeroize(k.k[:])
+}
+
+// Zeroize overwrites the secret share with zeros. The share can no
+// longer be used to sign.
+func (s *ThresholdKeyShare) Zeroize() {
+	if s.secret != nil {
+		s.secret.Set(edwards25519.NewScalar())
+	}
+}
+
+// Zeroize overwrites the participant's key generation secret with zeros.
+// The participant can no longer finish key generation.
+func (p *KeyGenParticipant) Zeroize() {
+	if p.share != nil {
+		p.share.Set(edwards25519.NewScalar())
+		p.share = nil
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroize(t *testing.T) {
+	buf := []byte{1, 2, 3, 4}
+	Zeroize(buf)
+	assert.Equal(t, []byte{0, 0, 0, 0}, buf)
+
+	priv, _, err := GenerateCurve25519Key(rand.Reader)
+	assert.NoError(t, err)
+	ZeroizeKey(priv)
+	assert.Equal(t, [64]byte{}, *priv.(*Curve25519PrivateKey).k)
+
+	edPriv, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	ZeroizeKey(edPriv)
+	raw, err := edPriv.Raw()
+	assert.NoError(t, err)
+	assert.Equal(t, make([]byte, ed25519.PrivateKeySize), raw)
+
+	_, shares, err := GenerateThresholdKey(2, 3, rand.Reader)
+	assert.NoError(t, err)
+	shares[0].Zeroize()
+	assert.Equal(t, [32]byte{}, [32]byte(shares[0].secret.Bytes()))
+}
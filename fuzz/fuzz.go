@@ -0,0 +1,170 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+// Package fuzz contains fuzzing entry points for the consensus critical
+// parsers. Each function takes arbitrary input and follows the go-fuzz
+// convention of returning 1 if the input was parsed successfully and 0
+// otherwise. A function must never panic regardless of the input.
+//
+// The functions are wired up as native Go fuzz targets in fuzz_test.go
+// and can be run with, for example:
+//
+//	go test ./fuzz -fuzz=FuzzBlock
+package fuzz
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+)
+
+// FuzzBlock deserializes a block and computes its hashes.
+func FuzzBlock(data []byte) int {
+	blk := new(blocks.Block)
+	if err := blk.Deserialize(data); err != nil {
+		return 0
+	}
+	if blk.Header != nil {
+		blk.ID()
+		if _, err := blk.Header.SigHash(); err != nil {
+			return 0
+		}
+	}
+	blk.Weight()
+	blk.Txids()
+	// Block validation rejects blocks without transactions before
+	// computing the merkle root.
+	if len(blk.Transactions) > 0 {
+		blockchain.TransactionsMerkleRoot(blk.Transactions)
+	}
+	if _, err := blk.Serialize(); err != nil {
+		return 0
+	}
+	return 1
+}
+
+// FuzzTransaction deserializes a transaction and computes its IDs and
+// accessors.
+func FuzzTransaction(data []byte) int {
+	tx := new(transactions.Transaction)
+	if err := tx.Deserialize(data); err != nil {
+		return 0
+	}
+	tx.ID()
+	tx.UID()
+	tx.WID()
+	tx.Nullifiers()
+	tx.Outputs()
+	tx.Weight()
+	if _, _, err := mempool.CalcFeePerKilobyte(tx); err != nil {
+		return 0
+	}
+	return 1
+}
+
+// FuzzSigHash deserializes a transaction and computes its sighash.
+func FuzzSigHash(data []byte) int {
+	tx := new(transactions.Transaction)
+	if err := tx.Deserialize(data); err != nil {
+		return 0
+	}
+	var err error
+	switch t := tx.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		_, err = t.StandardTransaction.SigHash()
+	case *transactions.Transaction_CoinbaseTransaction:
+		_, err = t.CoinbaseTransaction.SigHash()
+	case *transactions.Transaction_StakeTransaction:
+		_, err = t.StakeTransaction.SigHash()
+	case *transactions.Transaction_TreasuryTransaction:
+		_, err = t.TreasuryTransaction.SigHash()
+	case *transactions.Transaction_MintTransaction:
+		_, err = t.MintTransaction.SigHash()
+	default:
+		return 0
+	}
+	if err != nil {
+		return 0
+	}
+	return 1
+}
+
+// FuzzXthinner deserializes an xthinner block and decodes it against
+// an empty mempool.
+func FuzzXthinner(data []byte) int {
+	blk := new(blocks.XThinnerBlock)
+	if err := blk.Deserialize(data); err != nil {
+		return 0
+	}
+	m, err := fuzzMempool()
+	if err != nil {
+		panic(err)
+	}
+	if _, _, err := m.DecodeXthinner(blk); err != nil {
+		return 0
+	}
+	return 1
+}
+
+// FuzzLurkPreprocess runs the lurk macro preprocessor, with the standard
+// library, over the input.
+func FuzzLurkPreprocess(data []byte) int {
+	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib(), macros.RemoveComments())
+	if err != nil {
+		panic(err)
+	}
+	if _, err := mp.Preprocess(string(data)); err != nil {
+		return 0
+	}
+	return 1
+}
+
+var (
+	mpool     *mempool.Mempool
+	mpoolErr  error
+	mpoolOnce sync.Once
+)
+
+func fuzzMempool() (*mempool.Mempool, error) {
+	mpoolOnce.Do(func() {
+		mpool, mpoolErr = mempool.NewMempool(
+			mempool.BlockchainView(&emptyChainView{}),
+			mempool.Params(&params.RegestParams),
+			mempool.SignatureCache(blockchain.NewSigCache(10)),
+			mempool.ProofCache(blockchain.NewProofCache(10)),
+		)
+	})
+	return mpool, mpoolErr
+}
+
+type emptyChainView struct{}
+
+func (cv *emptyChainView) TreasuryBalance() (types.Amount, error) {
+	return 0, nil
+}
+
+func (cv *emptyChainView) TxoRootExists(txoRoot types.ID) (bool, error) {
+	return false, nil
+}
+
+func (cv *emptyChainView) NullifierExists(n types.Nullifier) (bool, error) {
+	return false, nil
+}
+
+func (cv *emptyChainView) GetValidator(validatorID peer.ID) (*blockchain.Validator, error) {
+	return nil, errors.New("validator not found")
+}
+
+func (cv *emptyChainView) BestBlock() (types.ID, uint32, time.Time) {
+	return types.ID{}, 0, time.Time{}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package fuzz_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/project-illium/ilxd/fuzz"
+	"github.com/project-illium/ilxd/types/testvectors"
+)
+
+func addTxSeeds(f *testing.F) {
+	for _, v := range testvectors.TxVectors {
+		ser, err := hex.DecodeString(v.Serialized)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(ser)
+	}
+}
+
+func addBlockSeeds(f *testing.F) {
+	for _, v := range testvectors.BlockVectors {
+		ser, err := hex.DecodeString(v.Serialized)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(ser)
+	}
+}
+
+func FuzzBlock(f *testing.F) {
+	addBlockSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzz.FuzzBlock(data)
+	})
+}
+
+func FuzzTransaction(f *testing.F) {
+	addTxSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzz.FuzzTransaction(data)
+	})
+}
+
+func FuzzSigHash(f *testing.F) {
+	addTxSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzz.FuzzSigHash(data)
+	})
+}
+
+func FuzzXthinner(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x10, 0x02, 0x1a, 0x01, 0x80, 0x22, 0x01, 0x00, 0x2a, 0x02, 0xab, 0xcd})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzz.FuzzXthinner(data)
+	})
+}
+
+func FuzzLurkPreprocess(f *testing.F) {
+	f.Add([]byte("(lambda (x) (+ x 1))"))
+	f.Add([]byte("!(defun my-func (y) (!(import std/crypto) (check-sig 10)))"))
+	f.Add([]byte("!(def x 5) !(assert (= x 5)) !(list 1 2 3) !(param nullifiers 0)"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzz.FuzzLurkPreprocess(data)
+	})
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/policy"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -20,8 +23,11 @@ import (
 
 const (
 	BlockGenerationInterval          = time.Second
-	BlockVersion                     = 1
 	MinAllowableTimeBetweenDupBlocks = time.Minute * 2
+
+	// blockSignatureWeight is an upper bound on the weight added to
+	// the header by the signature and tx root.
+	blockSignatureWeight = 128
 )
 
 type BlockGenerator struct {
@@ -31,6 +37,7 @@ type BlockGenerator struct {
 	lastGenHeight  uint32
 	lastGenTime    time.Time
 	mpool          *mempool.Mempool
+	policy         *policy.Policy
 	tickInterval   time.Duration
 	chain          *blockchain.Blockchain
 	broadcast      func(blk *blocks.XThinnerBlock) error
@@ -70,6 +77,7 @@ func NewBlockGenerator(opts ...Option) (*BlockGenerator, error) {
 		ownPeerIDBytes: ownPeerIDBytes,
 		privKey:        cfg.privKey,
 		mpool:          cfg.mpool,
+		policy:         cfg.policy,
 		tickInterval:   cfg.tickInterval,
 		chain:          cfg.chain,
 		broadcast:      cfg.broadcastFunc,
@@ -132,8 +140,10 @@ func (g *BlockGenerator) eventLoop() {
 	for {
 		select {
 		case <-ticker.C:
-			val := g.chain.WeightedRandomValidator()
-			if val == g.ownPeerID {
+			// Once VRF producer selection is active generateBlock
+			// checks our eligibility for the round itself.
+			_, height, _ := g.chain.BestBlock()
+			if g.chain.Params().VRFProducerActive(height+1) || g.chain.WeightedRandomValidator() == g.ownPeerID {
 				if err := g.generateBlock(); err != nil {
 					log.Warnf("Error in block generator: %s", err.Error())
 				}
@@ -169,13 +179,46 @@ func (g *BlockGenerator) generateBlock() error {
 		return nil
 	}
 
+	version, ok := g.chain.Params().BlockVersions.LatestForHeight(height + 1)
+	if !ok {
+		return AssertError("no block version active at height")
+	}
+
+	var vrfProof []byte
+	if g.chain.Params().VRFProducerActive(height + 1) {
+		proof, output, err := icrypto.VRFProve(g.privKey, blockchain.ProducerVRFInput(bestID, height+1))
+		if err != nil {
+			return err
+		}
+		stake, totalStake, err := g.chain.ProducerStake(g.ownPeerID)
+		if err != nil {
+			return err
+		}
+		round := blockchain.ProducerRound(timestamp.Unix(), blockTime)
+		if !blockchain.IsEligibleProducer(output, stake, totalStake, round) {
+			return nil
+		}
+		vrfProof = proof
+	}
+
+	var snapshotCommitment []byte
+	if _, ok := blockchain.SnapshotCommitmentHeight(g.chain.Params(), height+1); ok {
+		commitment, err := g.chain.SnapshotCommitment(height + 1)
+		if err != nil {
+			return err
+		}
+		snapshotCommitment = commitment[:]
+	}
+
 	blk := &blocks.Block{
 		Header: &blocks.BlockHeader{
-			Version:     BlockVersion,
-			Height:      height + 1,
-			Parent:      bestID[:],
-			Timestamp:   blockTime,
-			Producer_ID: g.ownPeerIDBytes,
+			Version:            version,
+			Height:             height + 1,
+			Parent:             bestID[:],
+			Timestamp:          blockTime,
+			Producer_ID:        g.ownPeerIDBytes,
+			VrfProof:           vrfProof,
+			SnapshotCommitment: snapshotCommitment,
 		},
 	}
 
@@ -219,12 +262,12 @@ func (g *BlockGenerator) generateBlock() error {
 	if len(txs) == 0 {
 		return nil
 	}
-	blk.Transactions = make([]*transactions.Transaction, 0, len(txs))
-	for _, tx := range txs {
-		blk.Transactions = append(blk.Transactions, tx)
+	blk.Transactions = g.selectTransactions(blk.Header, txs)
+	if len(blk.Transactions) == 0 {
+		return nil
 	}
 
-	sort.Sort(mempool.TxSorter(blk.Transactions))
+	transactions.SortCanonical(blk.Transactions)
 
 	merkleRoot := blockchain.TransactionsMerkleRoot(blk.Transactions)
 	blk.Header.TxRoot = merkleRoot[:]
@@ -262,7 +305,59 @@ out:
 			break out
 		}
 	}
-	log.Debugf("[GEN] Generated block at height %d: %s", blk.Header.Height, blk.Header.ID())
+	log.Debugw("[GEN] Generated block", repo.LogFieldBlockID, blk.Header.ID(), repo.LogFieldHeight, blk.Header.Height)
 
 	return g.broadcast(xthinnerBlock)
 }
+
+// selectTransactions returns the transactions to include in the block.
+// If a policy is set, transactions that don't pay a fee are selected
+// first followed by the remaining transactions in order of fee rate until
+// the block weight reaches the block size soft limit.
+func (g *BlockGenerator) selectTransactions(header *blocks.BlockHeader, txs map[types.ID]*transactions.Transaction) []*transactions.Transaction {
+	candidates := make([]*transactions.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		candidates = append(candidates, tx)
+	}
+	if g.policy == nil {
+		return candidates
+	}
+
+	type weightedTx struct {
+		tx         *transactions.Transaction
+		fpkb       types.Amount
+		isFeePayer bool
+	}
+	weighted := make([]weightedTx, 0, len(candidates))
+	for _, tx := range candidates {
+		fpkb, isFeePayer, err := mempool.CalcFeePerKilobyte(tx)
+		if err != nil {
+			continue
+		}
+		weighted = append(weighted, weightedTx{tx: tx, fpkb: fpkb, isFeePayer: isFeePayer})
+	}
+	sort.SliceStable(weighted, func(i, j int) bool {
+		if weighted[i].isFeePayer != weighted[j].isFeePayer {
+			return !weighted[i].isFeePayer
+		}
+		if weighted[i].fpkb != weighted[j].fpkb {
+			return weighted[i].fpkb > weighted[j].fpkb
+		}
+		return weighted[i].tx.ID().Compare(weighted[j].tx.ID()) < 0
+	})
+
+	// Leave room for the signature which is added after the
+	// transactions are selected.
+	limit := int(g.policy.GetBlocksizeSoftLimit())
+	weight := header.Weight() + blockSignatureWeight
+	selected := make([]*transactions.Transaction, 0, len(weighted))
+	for _, w := range weighted {
+		txWeight := w.tx.Weight()
+		if weight+txWeight > limit {
+			continue
+		}
+		weight += txWeight
+		selected = append(selected, w.tx)
+	}
+	return selected
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/policy"
 	"github.com/project-illium/ilxd/types/blocks"
 	"time"
 )
@@ -77,10 +78,22 @@ func PrivateKey(key crypto.PrivKey) Option {
 	}
 }
 
+// Policy is the node's policy. If set, generated blocks will not
+// exceed the policy's block size soft limit.
+//
+// This is optional.
+func Policy(p *policy.Policy) Option {
+	return func(cfg *config) error {
+		cfg.policy = p
+		return nil
+	}
+}
+
 // Config specifies the blockchain configuration.
 type config struct {
 	privKey       crypto.PrivKey
 	mpool         *mempool.Mempool
+	policy        *policy.Policy
 	tickInterval  time.Duration
 	chain         *blockchain.Blockchain
 	broadcastFunc func(blk *blocks.XThinnerBlock) error
@@ -14,6 +14,29 @@ import (
 )
 
 func main() {
+	// The lurk subcommand works with lurk scripts and doesn't start
+	// the node.
+	if len(os.Args) > 1 && os.Args[1] == lurkCommand {
+		if err := runLurkCommand(os.Args[2:]); err != nil {
+			if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+				os.Exit(0)
+			}
+			os.Exit(1)
+		}
+		return
+	}
+
+	// The zk subcommand benchmarks the prover and doesn't start the node.
+	if len(os.Args) > 1 && os.Args[1] == zkCommand {
+		if err := runZKCommand(os.Args[2:]); err != nil {
+			if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+				os.Exit(0)
+			}
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Up some limits.
 	if err := limits.SetLimits(); err != nil {
 		log.Fatalf("failed to set limits: %v\n", err)
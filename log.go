@@ -64,65 +64,219 @@ var logLevelSeverity = map[zapcore.Level]string{
 	zapcore.FatalLevel:  "EMERGENCY",
 }
 
-func setupLogging(logDir, level string, testnet bool) (*zap.AtomicLevel, error) {
+// logSubsystems are the names of the package loggers whose level can
+// be set independently of the default level.
+var logSubsystems = []string{
+	"blockchain",
+	"consensus",
+	"gen",
+	"indexers",
+	"mempool",
+	"net",
+	"repo",
+	"sync",
+	"zk",
+}
+
+// logLevels holds the log level for each subsystem. Loggers that
+// are not part of a subsystem use the default level.
+type logLevels struct {
+	defaultLevel zap.AtomicLevel
+	subsystems   map[string]zap.AtomicLevel
+}
+
+// parseLogLevels parses a log level string of the form
+// "info,net=debug,sync=warning". The first element without a subsystem
+// sets the default level for every subsystem.
+func parseLogLevels(s string) (*logLevels, error) {
+	var (
+		defaultLevel = zapcore.InfoLevel
+		overrides    = make(map[string]zapcore.Level)
+	)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		subsystem, levelStr, found := strings.Cut(part, "=")
+		if !found {
+			levelStr = subsystem
+		}
+		level, ok := LogLevelMap[strings.ToLower(levelStr)]
+		if !ok {
+			return nil, errors.New("invalid log level")
+		}
+		if !found {
+			defaultLevel = level
+			continue
+		}
+		if !isLogSubsystem(subsystem) {
+			return nil, fmt.Errorf("unknown log subsystem %s", subsystem)
+		}
+		overrides[subsystem] = level
+	}
+
+	levels := &logLevels{
+		defaultLevel: zap.NewAtomicLevelAt(defaultLevel),
+		subsystems:   make(map[string]zap.AtomicLevel),
+	}
+	for _, subsystem := range logSubsystems {
+		level, ok := overrides[subsystem]
+		if !ok {
+			level = defaultLevel
+		}
+		levels.subsystems[subsystem] = zap.NewAtomicLevelAt(level)
+	}
+	return levels, nil
+}
+
+// SetLevel sets the log level for the subsystem. If the subsystem is
+// empty the level is set for the default logger and all subsystems.
+func (l *logLevels) SetLevel(subsystem string, level zapcore.Level) error {
+	if subsystem == "" {
+		l.defaultLevel.SetLevel(level)
+		for _, lvl := range l.subsystems {
+			lvl.SetLevel(level)
+		}
+		return nil
+	}
+	lvl, ok := l.subsystems[subsystem]
+	if !ok {
+		return fmt.Errorf("unknown log subsystem %s", subsystem)
+	}
+	lvl.SetLevel(level)
+	return nil
+}
+
+// levelFor returns the level for the named logger.
+func (l *logLevels) levelFor(loggerName string) zap.AtomicLevel {
+	subsystem, _, _ := strings.Cut(loggerName, ".")
+	if lvl, ok := l.subsystems[subsystem]; ok {
+		return lvl
+	}
+	return l.defaultLevel
+}
+
+func isLogSubsystem(subsystem string) bool {
+	for _, s := range logSubsystems {
+		if s == subsystem {
+			return true
+		}
+	}
+	return false
+}
+
+// subsystemCore filters log entries using the level of the subsystem
+// that the entry was logged from.
+type subsystemCore struct {
+	zapcore.Core
+	levels *logLevels
+}
+
+func (c *subsystemCore) With(fields []zapcore.Field) zapcore.Core {
+	return &subsystemCore{Core: c.Core.With(fields), levels: c.levels}
+}
+
+func (c *subsystemCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.levelFor(ent.LoggerName).Enabled(ent.Level) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
+
+func setupLogging(config *repo.Config) (*logLevels, error) {
 	var cfg zap.Config
-	if testnet {
+	if config.Testnet || config.Testnet2 {
 		cfg = zap.NewDevelopmentConfig()
 	} else {
 		cfg = zap.NewProductionConfig()
 	}
 
-	logLevel, ok := LogLevelMap[strings.ToLower(level)]
-	if !ok {
-		return nil, errors.New("invalid log level")
-	}
-	cfg.Encoding = "console"
-	cfg.Level = zap.NewAtomicLevelAt(logLevel)
-
-	levelToColor := map[zapcore.Level]color{
-		zapcore.DebugLevel:  magenta,
-		zapcore.InfoLevel:   blue,
-		zapcore.WarnLevel:   yellow,
-		zapcore.ErrorLevel:  red,
-		zapcore.DPanicLevel: red,
-		zapcore.PanicLevel:  red,
-		zapcore.FatalLevel:  red,
+	levels, err := parseLogLevels(config.LogLevel)
+	if err != nil {
+		return nil, err
 	}
-	customLevelEncoder := func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
-		enc.AppendString("[" + levelToColor[level].Add(logLevelSeverity[level]) + "]")
-	}
-	cfg.EncoderConfig.EncodeLevel = customLevelEncoder
-	cfg.EncoderConfig.EncodeTime = zapcore.RFC3339TimeEncoder
+	// The level is enforced per subsystem by the subsystemCore so
+	// the underlying core lets everything through.
+	cfg.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
 	cfg.DisableCaller = true
 	cfg.DisableStacktrace = true
-	cfg.EncoderConfig.ConsoleSeparator = "  "
 
-	var (
-		logger *zap.Logger
-		err    error
-	)
-	if logDir != "" {
-		logRotator := &lumberjack.Logger{
-			Filename:   path.Join(logDir, repo.DefaultLogFilename),
-			MaxSize:    10, // Megabytes
-			MaxAge:     30, // Days
-			MaxBackups: 3,
+	var fileEncoder zapcore.Encoder
+	switch strings.ToLower(config.LogFormat) {
+	case "", "console":
+		levelToColor := map[zapcore.Level]color{
+			zapcore.DebugLevel:  magenta,
+			zapcore.InfoLevel:   blue,
+			zapcore.WarnLevel:   yellow,
+			zapcore.ErrorLevel:  red,
+			zapcore.DPanicLevel: red,
+			zapcore.PanicLevel:  red,
+			zapcore.FatalLevel:  red,
 		}
+		customLevelEncoder := func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString("[" + levelToColor[level].Add(logLevelSeverity[level]) + "]")
+		}
+		cfg.Encoding = "console"
+		cfg.EncoderConfig.EncodeLevel = customLevelEncoder
+		cfg.EncoderConfig.EncodeTime = zapcore.RFC3339TimeEncoder
+		cfg.EncoderConfig.ConsoleSeparator = "  "
 
-		lumberjackZapHook := func(e zapcore.Entry) error {
-			logRotator.Write([]byte(fmt.Sprintf("%+v\n", e)))
-			return nil
+		// The log file gets the same format without the colors.
+		fileConfig := cfg.EncoderConfig
+		fileConfig.EncodeLevel = func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString("[" + logLevelSeverity[level] + "]")
 		}
+		fileEncoder = zapcore.NewConsoleEncoder(fileConfig)
+	case "json":
+		// Use the same keys regardless of network so the logs can
+		// be ingested without per-network parsing rules.
+		cfg.Encoding = "json"
+		cfg.EncoderConfig = zap.NewProductionEncoderConfig()
+		cfg.EncoderConfig.EncodeTime = zapcore.RFC3339TimeEncoder
+		fileEncoder = zapcore.NewJSONEncoder(cfg.EncoderConfig)
+	default:
+		return nil, errors.New("invalid log format")
+	}
 
-		logger, err = cfg.Build(zap.Hooks(lumberjackZapHook))
-		if err != nil {
-			return nil, err
+	var fileCore zapcore.Core
+	if config.LogDir != "" {
+		logRotator := &lumberjack.Logger{
+			Filename:   path.Join(config.LogDir, repo.DefaultLogFilename),
+			MaxSize:    config.LogMaxSize, // Megabytes
+			MaxAge:     config.LogMaxAge,  // Days
+			MaxBackups: config.LogMaxBackups,
+			Compress:   config.LogCompress,
 		}
-	} else {
-		logger, err = cfg.Build()
-		if err != nil {
-			return nil, err
+		fileCore = zapcore.NewCore(fileEncoder, zapcore.AddSync(logRotator), zapcore.DebugLevel)
+	}
+
+	opts := []zap.Option{}
+	switch strings.ToLower(config.LogOutput) {
+	case "", "both":
+		if fileCore != nil {
+			opts = append(opts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+				return zapcore.NewTee(core, fileCore)
+			}))
 		}
+	case "console":
+	case "file":
+		if fileCore == nil {
+			return nil, errors.New("log output set to file without a log directory")
+		}
+		opts = append(opts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return fileCore
+		}))
+	default:
+		return nil, errors.New("invalid log output")
+	}
+	opts = append(opts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &subsystemCore{Core: core, levels: levels}
+	}))
+
+	logger, err := cfg.Build(opts...)
+	if err != nil {
+		return nil, err
 	}
 	zap.ReplaceGlobals(logger)
 
@@ -137,5 +291,5 @@ func setupLogging(logDir, level string, testnet bool) (*zap.AtomicLevel, error)
 	walletlib.UpdateLogger()
 	indexers.UpdateLogger()
 	zk.UpdateLogger()
-	return &cfg.Level, nil
+	return levels, nil
 }
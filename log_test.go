@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/project-illium/ilxd/repo"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestParseLogLevels(t *testing.T) {
+	levels, err := parseLogLevels("warning,net=debug,sync=error")
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.WarnLevel, levels.defaultLevel.Level())
+	assert.Equal(t, zapcore.DebugLevel, levels.levelFor("net").Level())
+	assert.Equal(t, zapcore.ErrorLevel, levels.levelFor("sync").Level())
+	assert.Equal(t, zapcore.WarnLevel, levels.levelFor("blockchain").Level())
+	assert.Equal(t, zapcore.WarnLevel, levels.levelFor("").Level())
+
+	levels, err = parseLogLevels("info")
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.InfoLevel, levels.levelFor("zk").Level())
+
+	_, err = parseLogLevels("loud")
+	assert.Error(t, err)
+	_, err = parseLogLevels("info,p2p=debug")
+	assert.Error(t, err)
+}
+
+func TestSubsystemCore(t *testing.T) {
+	levels, err := parseLogLevels("info,net=debug")
+	assert.NoError(t, err)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(&subsystemCore{Core: core, levels: levels})
+
+	logger.Named("net").Debug("net debug")
+	logger.Named("sync").Debug("sync debug")
+	logger.Named("sync").Info("sync info")
+	logger.Debug("default debug")
+	assert.Equal(t, 2, logs.Len())
+
+	assert.NoError(t, levels.SetLevel("sync", zapcore.DebugLevel))
+	logger.Named("sync").Debug("sync debug")
+	assert.Equal(t, 3, logs.Len())
+
+	assert.NoError(t, levels.SetLevel("", zapcore.ErrorLevel))
+	logger.Named("net").Info("net info")
+	logger.Named("sync").Info("sync info")
+	assert.Equal(t, 3, logs.Len())
+
+	assert.Error(t, levels.SetLevel("p2p", zapcore.DebugLevel))
+}
+
+func TestSetupLoggingJSON(t *testing.T) {
+	dir := t.TempDir()
+	_, err := setupLogging(&repo.Config{LogDir: dir, LogLevel: "info", LogFormat: "yaml"})
+	assert.Error(t, err)
+
+	_, err = setupLogging(&repo.Config{LogDir: dir, LogLevel: "info", LogFormat: "json"})
+	assert.NoError(t, err)
+	defer zap.ReplaceGlobals(zap.NewNop())
+
+	zap.S().Named("net").Infow("Connected to peer", repo.LogFieldPeerID, "12D3KooW", repo.LogFieldHeight, 10)
+	zap.S().Named("net").Debugw("Not logged")
+	zap.L().Sync()
+
+	data, err := os.ReadFile(path.Join(dir, repo.DefaultLogFilename))
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 1)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "info", entry["level"])
+	assert.Equal(t, "net", entry["logger"])
+	assert.Equal(t, "Connected to peer", entry["msg"])
+	assert.Equal(t, "12D3KooW", entry[repo.LogFieldPeerID])
+	assert.Equal(t, float64(10), entry[repo.LogFieldHeight])
+}
+
+func TestSetupLoggingOutput(t *testing.T) {
+	defer zap.ReplaceGlobals(zap.NewNop())
+
+	_, err := setupLogging(&repo.Config{LogDir: t.TempDir(), LogLevel: "info", LogOutput: "syslog"})
+	assert.Error(t, err)
+
+	_, err = setupLogging(&repo.Config{LogLevel: "info", LogOutput: "file"})
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	_, err = setupLogging(&repo.Config{LogDir: dir, LogLevel: "info", LogOutput: "console"})
+	assert.NoError(t, err)
+	zap.S().Info("Console only")
+	zap.L().Sync()
+	_, err = os.Stat(path.Join(dir, repo.DefaultLogFilename))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = setupLogging(&repo.Config{LogDir: dir, LogLevel: "info", LogOutput: "file"})
+	assert.NoError(t, err)
+	zap.S().Info("File only")
+	zap.L().Sync()
+	data, err := os.ReadFile(path.Join(dir, repo.DefaultLogFilename))
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(data), "File only"))
+	assert.False(t, strings.Contains(string(data), "\x1b["))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+)
+
+// lurkCommand is the name of the ilxd subcommand for working with lurk
+// scripts. It is handled before the node options are parsed.
+const lurkCommand = "lurk"
+
+// runLurkCommand runs the `ilxd lurk` subcommand with the arguments
+// following it.
+func runLurkCommand(args []string) error {
+	parser := flags.NewNamedParser("ilxd lurk", flags.Default)
+	parser.AddCommand("preprocess", "Expands the macros in a lurk script", "Expands the macros in a lurk script, importing modules from the dependency directory and the standard library, and writes the resulting lurk program to stdout or the output file. Use - to read the script from stdin.", &LurkPreprocess{})
+	_, err := parser.ParseArgs(args)
+	return err
+}
+
+// LurkPreprocess is the `ilxd lurk preprocess` command.
+type LurkPreprocess struct {
+	DepDir         string   `short:"d" long:"depdir" description:"The directory, or zip or tar.gz archive, to import modules from. The standard library is always available."`
+	Defines        []string `short:"D" long:"define" description:"A feature to define for the ifdef and ifndef macros. May be repeated."`
+	RemoveComments bool     `short:"r" long:"removecomments" description:"Remove comments from the preprocessed program"`
+	Format         bool     `short:"f" long:"format" description:"Pretty print the preprocessed program"`
+	Output         string   `short:"o" long:"output" description:"The file to write the preprocessed program to. Defaults to stdout."`
+	Layout         string   `short:"l" long:"layout" description:"A JSON file with the param layout of the circuit version the script targets. Defaults to the current circuit."`
+	Args           struct {
+		File string `positional-arg-name:"file" description:"The lurk script to preprocess"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+func (x *LurkPreprocess) Execute(args []string) error {
+	var (
+		script []byte
+		err    error
+	)
+	if x.Args.File == "-" {
+		script, err = io.ReadAll(os.Stdin)
+	} else {
+		script, err = os.ReadFile(repo.CleanAndExpandPath(x.Args.File))
+	}
+	if err != nil {
+		return err
+	}
+
+	opts := []macros.Option{macros.WithDefines(x.Defines...), macros.WithEmbeddedStdlib()}
+	if x.DepDir != "" {
+		opts = append(opts, macros.DependencyDir(repo.CleanAndExpandPath(x.DepDir)))
+	}
+	if x.Layout != "" {
+		layout, err := macros.LoadParamLayout(repo.CleanAndExpandPath(x.Layout))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, macros.WithParamLayout(layout))
+	}
+	if x.RemoveComments {
+		opts = append(opts, macros.RemoveComments())
+	}
+	mp, err := macros.NewMacroPreprocessor(opts...)
+	if err != nil {
+		return err
+	}
+	program, err := mp.Preprocess(string(script))
+	if err != nil {
+		return err
+	}
+	if x.Format {
+		program, err = macros.Format(program)
+		if err != nil {
+			return err
+		}
+	}
+
+	program = strings.TrimRight(program, "\n")
+	if x.Output == "" {
+		fmt.Println(program)
+		return nil
+	}
+	return os.WriteFile(repo.CleanAndExpandPath(x.Output), []byte(program+"\n"), 0644)
+}
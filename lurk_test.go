@@ -0,0 +1,43 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLurkPreprocess(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "script.lurk")
+	output := filepath.Join(dir, "script.out.lurk")
+
+	err := os.WriteFile(script, []byte(";; script\n!(ifdef TESTNET !(def x 1)) !(ifndef TESTNET !(def x 2)) (+ x 3)"), 0644)
+	assert.NoError(t, err)
+
+	err = runLurkCommand([]string{"preprocess", "-D", "TESTNET", "--removecomments", "-f", "-o", output, script})
+	assert.NoError(t, err)
+	data, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Equal(t, "(let ((x 1)) (+ x 3))\n", string(data))
+
+	err = os.WriteFile(filepath.Join(dir, "math.lurk"), []byte("!(module math (!(defun plus-two (y) (+ y 2))))"), 0644)
+	assert.NoError(t, err)
+	err = os.WriteFile(script, []byte("!(import math/plus-two) (plus-two 1)"), 0644)
+	assert.NoError(t, err)
+	err = runLurkCommand([]string{"preprocess", "--depdir", dir, "-o", output, script})
+	assert.NoError(t, err)
+	data, err = os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "plus-two")
+
+	err = os.WriteFile(script, []byte("(+ 1 !(param nope))"), 0644)
+	assert.NoError(t, err)
+	err = runLurkCommand([]string{"preprocess", "-o", output, script})
+	assert.Error(t, err)
+}
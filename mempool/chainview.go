@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/types"
+	"time"
 )
 
 // ChainView is an interface of methods that provide the blockchain
@@ -26,4 +27,8 @@ type ChainView interface {
 
 	// GetValidator returns the validator for the given ID
 	GetValidator(validatorID peer.ID) (*blockchain.Validator, error)
+
+	// BestBlock returns the ID, height, and timestamp of the tip
+	// of the chain.
+	BestBlock() (types.ID, uint32, time.Time)
 }
@@ -37,6 +37,8 @@ const (
 var (
 	ErrDuplicateTx = errors.New("tx already in mempool")
 	ErrNotFound    = errors.New("tx not found in pool")
+
+	ErrMalformedXthinner = errors.New("malformed xthinner block")
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
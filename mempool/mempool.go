@@ -7,6 +7,7 @@ package mempool
 import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/transactions"
 	"google.golang.org/protobuf/proto"
@@ -125,7 +126,10 @@ func (m *Mempool) ProcessTransaction(tx *transactions.Transaction) error {
 		return policyError(ErrFeeTooLow, "transaction fee is below policy minimum")
 	}
 
-	proofChan := blockchain.ValidateTransactionProof(tx, m.cfg.proofCache)
+	// The proof must be valid for a circuit version which is active
+	// at the height of the next block.
+	_, height, _ := m.cfg.chainView.BestBlock()
+	proofChan := blockchain.ValidateTransactionProof(tx, m.cfg.proofCache, m.cfg.prover, m.cfg.params, height+1)
 	sigChan := blockchain.ValidateTransactionSig(tx, m.cfg.sigCache)
 
 	err = <-proofChan
@@ -356,10 +360,17 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 		}
 		inPoolBalance := types.Amount(0)
 		for _, amt := range m.treasuryDebits {
-			inPoolBalance += amt
+			inPoolBalance, err = inPoolBalance.Add(amt)
+			if err != nil {
+				return err
+			}
+		}
+		available, err := treasuryBalance.Sub(inPoolBalance)
+		if err != nil {
+			available = 0
 		}
 
-		if types.Amount(t.TreasuryTransaction.Amount) > treasuryBalance-inPoolBalance {
+		if types.Amount(t.TreasuryTransaction.Amount) > available {
 			return ruleError(blockchain.ErrInvalidTx, "treasury tx amount exceeds treasury balance")
 		}
 
@@ -371,7 +382,7 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 		tx:         tx,
 		expiration: time.Now().Add(m.cfg.transactionTTL),
 	}
-	log.Debugf("Mempool: New transaction %s", tx.ID())
+	log.Debugw("Mempool: New transaction", repo.LogFieldTxID, tx.ID())
 	return nil
 }
 
@@ -388,11 +399,16 @@ func CalcFeePerKilobyte(tx *transactions.Transaction) (types.Amount, bool, error
 		fee = t.MintTransaction.Fee
 	}
 
-	size, err := tx.SerializedSize()
-	if err != nil {
-		return 0, false, err
+	weight := tx.Weight()
+	if weight == 0 {
+		return 0, false, nil
 	}
-	kbs := float64(size) / 1000
 
-	return types.Amount(float64(fee) / kbs), true, nil
+	fpkb, err := types.Amount(fee).Mul(1000)
+	if err != nil {
+		// The fee is too large to scale without overflowing. Divide
+		// first instead, the loss of precision is irrelevant at this size.
+		return (types.Amount(fee) / types.Amount(weight)) * 1000, true, nil
+	}
+	return fpkb / types.Amount(weight), true, nil
 }
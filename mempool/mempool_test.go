@@ -781,6 +781,11 @@ type mockBlockchainView struct {
 	txoRoots        map[types.ID]bool
 	nullifiers      map[types.Nullifier]bool
 	validators      map[peer.ID]*blockchain.Validator
+	height          uint32
+}
+
+func (m *mockBlockchainView) BestBlock() (types.ID, uint32, time.Time) {
+	return types.ID{}, m.height, time.Time{}
 }
 
 func (m *mockBlockchainView) TreasuryBalance() (types.Amount, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/zk"
 	"time"
 )
 
@@ -28,6 +29,7 @@ func DefaultOptions() Option {
 		cfg.minStake = repo.DefaultMinimumStake
 		cfg.sigCache = blockchain.NewSigCache(defaultSigCacheSize)
 		cfg.proofCache = blockchain.NewProofCache(defaultProofCacheSize)
+		cfg.prover = zk.DefaultProver()
 		cfg.treasuryWhitelist = make(map[types.ID]bool)
 		cfg.transactionTTL = defaultTransactionTTL
 		return nil
@@ -124,6 +126,16 @@ func ProofCache(proofCache *blockchain.ProofCache) Option {
 	}
 }
 
+// Prover is the backend used to verify the zk-snark proofs.
+//
+// If this is not provided the default prover is used.
+func Prover(prover zk.Prover) Option {
+	return func(cfg *config) error {
+		cfg.prover = prover
+		return nil
+	}
+}
+
 // Config specifies the blockchain configuration.
 type config struct {
 	params            *params.NetworkParams
@@ -132,6 +144,7 @@ type config struct {
 	minStake          types.Amount
 	sigCache          *blockchain.SigCache
 	proofCache        *blockchain.ProofCache
+	prover            zk.Prover
 	treasuryWhitelist map[types.ID]bool
 	transactionTTL    time.Duration
 }
@@ -11,28 +11,14 @@ import (
 
 // TxSorter implements sort.Interface to allow a slice of transactions
 // to be sorted lexicographically.
-type TxSorter []*transactions.Transaction
-
-// Len returns the number of txs in the slice.  It is part of the
-// sort.Interface implementation.
-func (s TxSorter) Len() int {
-	return len(s)
-}
-
-// Swap swaps the txs at the passed indices.  It is part of the
-// sort.Interface implementation.
-func (s TxSorter) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-// Less returns whether the txs with index i should sort before the
-// tx with index j.  It is part of the sort.Interface implementation.
-func (s TxSorter) Less(i, j int) bool {
-	return s[i].ID().Compare(s[j].ID()) < 0
-}
+//
+// Deprecated: use transactions.SortCanonical.
+type TxSorter = transactions.TxSorter
 
 // TxidSorter implements sort.Interface to allow a slice of transaction
 // ids to be sorted lexicographically.
+//
+// Deprecated: use transactions.SortTxids.
 type TxidSorter []types.ID
 
 // Len returns the number of txs in the slice.  It is part of the
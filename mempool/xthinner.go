@@ -10,7 +10,6 @@ import (
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
-	"sort"
 )
 
 // EncodeXthinner replaces the full transactions from a block.Block with
@@ -42,7 +41,7 @@ func (m *Mempool) EncodeXthinner(blkIds []types.ID) (*blocks.XThinnerBlock, erro
 	for txid := range m.pool {
 		mempoolTxs = append(mempoolTxs, txid)
 	}
-	sort.Sort(TxidSorter(mempoolTxs))
+	transactions.SortTxids(mempoolTxs)
 	mempoolTxs = append(mempoolTxs, types.NewID(make([]byte, 32)))
 
 	var (
@@ -130,10 +129,19 @@ func (m *Mempool) EncodeXthinner(blkIds []types.ID) (*blocks.XThinnerBlock, erro
 //  2. The peer maliciously sent us an invalid block.
 //
 // In both cases the solution is to download the full list of txids from the remote peer.
-func (m *Mempool) DecodeXthinner(blk *blocks.XThinnerBlock) (*blocks.Block, []uint32) {
+//
+// An error is returned if the XThinnerBlock is malformed.
+func (m *Mempool) DecodeXthinner(blk *blocks.XThinnerBlock) (*blocks.Block, []uint32, error) {
 	m.mempoolLock.RLock()
 	defer m.mempoolLock.RUnlock()
 
+	// Each transaction pushes at least one byte so the number of push
+	// bytes bounds the transaction count. This prevents a peer from
+	// making us allocate a huge block.
+	if int(blk.TxCount) > len(blk.PushBytes) {
+		return nil, nil, ErrMalformedXthinner
+	}
+
 	var (
 		pops            = decodeBitmap(blk.Pops)
 		pushes          = decodeBitmap(blk.Pushes)
@@ -153,34 +161,49 @@ func (m *Mempool) DecodeXthinner(blk *blocks.XThinnerBlock) (*blocks.Block, []ui
 	}
 	prefilled := make(map[types.ID]*transactions.Transaction)
 	for _, tx := range blk.PrefilledTxs {
+		if tx == nil || tx.Transaction == nil {
+			return nil, nil, ErrMalformedXthinner
+		}
 		id := tx.Transaction.ID()
 		if _, ok := m.pool[id]; !ok {
 			mempoolTxs = append(mempoolTxs, id)
 		}
 		prefilled[id] = tx.Transaction
 	}
-	sort.Sort(TxidSorter(mempoolTxs))
+	transactions.SortTxids(mempoolTxs)
 	mempoolTxs = append(mempoolTxs, types.NewID(bytes.Repeat([]byte{0xff}, 32)))
 
 	for pos := uint32(0); pos < blk.TxCount; pos++ {
 		if len(stack) > 0 {
 			stack = stack[:len(stack)-1] // 0x25
 		}
+		if popi >= len(pops) {
+			return nil, nil, ErrMalformedXthinner
+		}
 		pop := pops[popi]
 		popi++
 
 		for pop > 0 {
+			if len(stack) == 0 || popi >= len(pops) {
+				return nil, nil, ErrMalformedXthinner
+			}
 			stack = stack[:len(stack)-1]
 			pop = pops[popi]
 			popi++
 		}
 
+		if pushbi >= len(blk.PushBytes) || pushi >= len(pushes) {
+			return nil, nil, ErrMalformedXthinner
+		}
 		stack = append(stack, blk.PushBytes[pushbi])
 		pushbi++
 		push := pushes[pushi]
 		pushi++
 
 		for push > 0 {
+			if pushbi >= len(blk.PushBytes) || pushi >= len(pushes) || len(stack) >= len(types.ID{}) {
+				return nil, nil, ErrMalformedXthinner
+			}
 			stack = append(stack, blk.PushBytes[pushbi])
 			pushbi++
 			push = pushes[pushi]
@@ -208,7 +231,7 @@ func (m *Mempool) DecodeXthinner(blk *blocks.XThinnerBlock) (*blocks.Block, []ui
 			}
 		}
 	}
-	return fullBlk, rerequests
+	return fullBlk, rerequests, nil
 }
 
 func encodeBitmap(bits []uint32) []byte {
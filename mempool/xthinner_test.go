@@ -227,7 +227,8 @@ func TestMempool_EncodeXthinner(t *testing.T) {
 			test.mempoolFunc(m)
 		}
 
-		ret, rerequests := m.DecodeXthinner(blk)
+		ret, rerequests, err := m.DecodeXthinner(blk)
+		assert.NoError(t, err)
 
 		for i := range ret.Transactions {
 			if test.expectedTxids[i] == "0000000000000000000000000000000000000000000000000000000000000000" {
@@ -277,7 +278,8 @@ func TestEmptyPool(t *testing.T) {
 		pool: make(map[types.ID]*ttlTx),
 	}
 
-	_, missing := m2.DecodeXthinner(blk)
+	_, missing, err := m2.DecodeXthinner(blk)
+	assert.NoError(t, err)
 	assert.Len(t, missing, 3)
 }
 
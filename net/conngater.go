@@ -145,12 +145,17 @@ func (cg *ConnectionGater) IncreaseBanscore(p peer.ID, persistent, transient uin
 		banscore = &DynamicBanScore{}
 		cg.scores[p] = banscore
 	}
+	prevScore := banscore.Int()
 	score := banscore.Increase(persistent, transient)
-	log.Infof("Increased peer %s banscore to %d, threshold %d", p, score, cg.maxBanscore)
+	limitedLog.Infow("Increased peer banscore", repo.LogFieldPeerID, p, "banscore", score, "threshold", cg.maxBanscore)
 	cg.Unlock()
+	if warn := cg.maxBanscore / 2; prevScore <= warn && score > warn && score <= cg.maxBanscore {
+		repo.Audit(repo.AuditBanscoreThreshold, repo.LogFieldPeerID, p, "banscore", score, "threshold", cg.maxBanscore)
+	}
 	banned := score > cg.maxBanscore
 	if banned {
-		log.Infof("Banning peer %s for %s", p, cg.banDuration)
+		log.Infow("Banning peer", repo.LogFieldPeerID, p, "duration", cg.banDuration)
+		repo.Audit(repo.AuditPeerBanned, repo.LogFieldPeerID, p, "banscore", score, "addrs", cg.addrBook.Addrs(p), "duration", cg.banDuration)
 		if err := cg.BlockPeer(p); err != nil {
 			return false, err
 		}
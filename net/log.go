@@ -4,10 +4,27 @@
 
 package net
 
-import "go.uber.org/zap"
+import (
+	"time"
 
-var log = zap.S()
+	"github.com/project-illium/ilxd/repo"
+	"go.uber.org/zap"
+)
 
-func UpdateLogger() {
+const (
+	limitedLogInterval = time.Second
+	limitedLogBurst    = 10
+)
+
+var (
 	log = zap.S()
+
+	// limitedLog is used for log lines in per-message paths which a
+	// misbehaving peer could trigger at will.
+	limitedLog = repo.RateLimitedLogger(log, limitedLogInterval, limitedLogBurst)
+)
+
+func UpdateLogger() {
+	log = zap.S().Named("net")
+	limitedLog = repo.RateLimitedLogger(log, limitedLogInterval, limitedLogBurst)
 }
@@ -33,8 +33,13 @@ import (
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"time"
 )
 
@@ -45,6 +50,8 @@ const (
 	ValidatorProtectionFlag = "validator"
 )
 
+var tracer = otel.Tracer("github.com/project-illium/ilxd/net")
+
 type Network struct {
 	host        host.Host
 	connManager coreconmgr.ConnManager
@@ -56,6 +63,7 @@ type Network struct {
 	pstoreds    *Peerstoreds
 	txSub       *pubsub.Subscription
 	blkSub      *pubsub.Subscription
+	versions    *peerVersions
 }
 
 func NewNetwork(ctx context.Context, opts ...Option) (*Network, error) {
@@ -325,27 +333,50 @@ loop:
 		return nil, err
 	}
 
+	// Gossip is only handled from peers which we negotiated a
+	// protocol version with.
+	versions := newPeerVersions()
+	negotiated := func(p peer.ID) bool {
+		_, ok := versions.get(p)
+		return ok || p == self
+	}
+
 	err = ps.RegisterTopicValidator(TransactionsTopic, pubsub.ValidatorEx(func(ctx context.Context, p peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+		if !negotiated(p) {
+			return pubsub.ValidationIgnore
+		}
 		tx := &transactions.Transaction{}
 		if err := tx.Deserialize(m.Data); err != nil {
 			return pubsub.ValidationReject
 		}
-		err := cfg.acceptToMempool(tx)
+		ctx, span := tracer.Start(ctx, "gossip.ReceiveTransaction", trace.WithAttributes(
+			attribute.String(repo.LogFieldTxID, tx.ID().String()),
+			attribute.String(repo.LogFieldPeerID, p.String()),
+		))
+		defer span.End()
+
+		err := cfg.acceptToMempool(ctx, tx)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
 		switch e := err.(type) {
 		case mempool.PolicyError:
 			// Policy errors do no penalize peer
-			log.Debugf("Mempool reject tx %s. Policy error: %s:%s", tx.ID(), e.ErrorCode, e.Description)
+			limitedLog.Debugw("Mempool reject tx. Policy error", repo.LogFieldTxID, tx.ID(), "code", e.ErrorCode, repo.LogFieldError, e.Description)
 			return pubsub.ValidationIgnore
 		case blockchain.RuleError:
 			// Rule errors do
-			log.Debugf("Mempool reject tx %s. Rule error: %s:%s", tx.ID(), e.ErrorCode, e.Description)
+			limitedLog.Debugw("Mempool reject tx. Rule error", repo.LogFieldTxID, tx.ID(), "code", e.ErrorCode, repo.LogFieldError, e.Description)
+			if e.ErrorCode == blockchain.ErrInvalidProof {
+				repo.Audit(repo.AuditInvalidProof, repo.LogFieldPeerID, p, repo.LogFieldTxID, tx.ID())
+			}
 			return pubsub.ValidationReject
 		case blockchain.NotCurrentError:
 			return pubsub.ValidationIgnore
 		case nil:
 			return pubsub.ValidationAccept
 		default:
-			log.Debugf("Mempool reject tx %s. Unknown error: %s", tx.ID(), err)
+			limitedLog.Debugw("Mempool reject tx. Unknown error", repo.LogFieldTxID, tx.ID(), repo.LogFieldError, err)
 			return pubsub.ValidationIgnore
 		}
 	}))
@@ -356,28 +387,44 @@ loop:
 	// For blocks we will wait for the full block to be recovered from the compact block
 	// so that we can validate it before returning here.
 	err = ps.RegisterTopicValidator(BlockTopic, pubsub.ValidatorEx(func(ctx context.Context, p peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+		if !negotiated(p) {
+			return pubsub.ValidationIgnore
+		}
 		blk := &blocks.XThinnerBlock{}
 		if err := blk.Deserialize(m.Data); err != nil {
-			log.Errorf("[PUBSUB] xthinner deserialize error: %s", err)
+			limitedLog.Errorw("[PUBSUB] xthinner deserialize error", repo.LogFieldPeerID, p, repo.LogFieldError, err)
 			return pubsub.ValidationReject
 		}
-		log.Debugf("[PUBSUB] new incoming block: %s", blk.ID())
-		err := cfg.validateBlock(blk, p)
+		limitedLog.Debugw("[PUBSUB] new incoming block", repo.LogFieldBlockID, blk.ID(), repo.LogFieldHeight, blk.Header.Height)
+		ctx, span := tracer.Start(ctx, "gossip.ReceiveBlock", trace.WithAttributes(
+			attribute.String(repo.LogFieldBlockID, blk.ID().String()),
+			attribute.Int64(repo.LogFieldHeight, int64(blk.Header.Height)),
+			attribute.String(repo.LogFieldPeerID, p.String()),
+		))
+		defer span.End()
+
+		err := cfg.validateBlock(ctx, blk, p)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
 		switch e := err.(type) {
 		case blockchain.OrphanBlockError:
 			// Orphans we won't relay (yet) but won't penalize them either.
-			log.Debugf("Recieved orphan block: %s", blk.ID())
+			limitedLog.Debugw("Received orphan block", repo.LogFieldBlockID, blk.ID(), repo.LogFieldHeight, blk.Header.Height)
 			return pubsub.ValidationIgnore
 		case blockchain.RuleError:
 			// Rule errors do
-			log.Debugf("Block %s rule error: %s:%s", blk.ID(), e.ErrorCode, e.Description)
+			limitedLog.Debugw("Block reject. Rule error", repo.LogFieldBlockID, blk.ID(), "code", e.ErrorCode, repo.LogFieldError, e.Description)
+			if e.ErrorCode == blockchain.ErrInvalidProof {
+				repo.Audit(repo.AuditInvalidProof, repo.LogFieldPeerID, p, repo.LogFieldBlockID, blk.ID(), repo.LogFieldHeight, blk.Header.Height)
+			}
 			return pubsub.ValidationReject
 		case blockchain.NotCurrentError:
 			return pubsub.ValidationIgnore
 		case nil:
 			return pubsub.ValidationAccept
 		default:
-			log.Debugf("Block reject %s. Unknown error: %s", blk.ID(), err)
+			limitedLog.Debugw("Block reject. Unknown error", repo.LogFieldBlockID, blk.ID(), repo.LogFieldError, err)
 			return pubsub.ValidationIgnore
 		}
 	}))
@@ -385,6 +432,10 @@ loop:
 		return nil, err
 	}
 
+	if err := advertiseVersions(ctx, host, cfg.params, versions); err != nil {
+		return nil, err
+	}
+
 	if err = kdht.Bootstrap(ctx); err != nil {
 		return nil, err
 	}
@@ -473,13 +524,14 @@ loop:
 		pstoreds:    pstoreds,
 		txSub:       txSub,
 		blkSub:      blockSub,
+		versions:    versions,
 	}
 
 	connected := func(_ inet.Network, conn inet.Conn) {
-		log.Debugf("Connected to peer %s", conn.RemotePeer().String())
+		log.Debugw("Connected to peer", repo.LogFieldPeerID, conn.RemotePeer())
 	}
 	disconnected := func(_ inet.Network, conn inet.Conn) {
-		log.Debugf("Disconnected from peer %s", conn.RemotePeer().String())
+		log.Debugw("Disconnected from peer", repo.LogFieldPeerID, conn.RemotePeer())
 	}
 
 	notifier := &inet.NotifyBundle{
@@ -578,10 +630,24 @@ func (n *Network) BroadcastTransaction(tx *transactions.Transaction) error {
 	return n.txTopic.Publish(context.Background(), ser)
 }
 
+// ProtocolVersion returns the protocol version negotiated with the peer
+// in the identify handshake. It returns false if the handshake has not
+// completed or the peer is not connected.
+func (n *Network) ProtocolVersion(p peer.ID) (uint32, bool) {
+	return n.versions.get(p)
+}
+
+// WaitProtocolVersion is ProtocolVersion but waits for the identify
+// handshake with the peer to complete. ErrIncompatibleVersion is returned
+// if no version was negotiated.
+func (n *Network) WaitProtocolVersion(ctx context.Context, p peer.ID) (uint32, error) {
+	return n.versions.wait(ctx, p)
+}
+
 func (n *Network) IncreaseBanscore(p peer.ID, persistent, transient uint32) {
 	banned, err := n.connGater.IncreaseBanscore(p, persistent, transient)
 	if err != nil {
-		log.Errorf("Error setting banscore for peer %: %s", p, err)
+		limitedLog.Errorw("Error setting banscore for peer", repo.LogFieldPeerID, p, repo.LogFieldError, err)
 	}
 	if banned {
 		n.host.Network().ClosePeer(p) //nolint:errcheck
@@ -5,6 +5,7 @@
 package net
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
@@ -22,14 +23,18 @@ var ErrNetworkConfig = errors.New("network config error")
 // Option is configuration option function for the Network
 type Option func(cfg *config) error
 
-func MempoolValidator(acceptToMempool func(tx *transactions.Transaction) error) Option {
+// MempoolValidator sets the function used to validate transactions received
+// over gossip. The context carries the span covering the gossip receipt.
+func MempoolValidator(acceptToMempool func(ctx context.Context, tx *transactions.Transaction) error) Option {
 	return func(cfg *config) error {
 		cfg.acceptToMempool = acceptToMempool
 		return nil
 	}
 }
 
-func BlockValidator(validateBlock func(blk *blocks.XThinnerBlock, p peer.ID) error) Option {
+// BlockValidator sets the function used to validate blocks received over
+// gossip. The context carries the span covering the gossip receipt.
+func BlockValidator(validateBlock func(ctx context.Context, blk *blocks.XThinnerBlock, p peer.ID) error) Option {
 	return func(cfg *config) error {
 		cfg.validateBlock = validateBlock
 		return nil
@@ -133,8 +138,8 @@ type config struct {
 	host              host.Host
 	privateKey        crypto.PrivKey
 	datastore         repo.Datastore
-	acceptToMempool   func(tx *transactions.Transaction) error
-	validateBlock     func(blk *blocks.XThinnerBlock, p peer.ID) error
+	acceptToMempool   func(ctx context.Context, tx *transactions.Transaction) error
+	validateBlock     func(ctx context.Context, blk *blocks.XThinnerBlock, p peer.ID) error
 	maxBanscore       uint32
 	forceServerMode   bool
 	banDuration       time.Duration
@@ -0,0 +1,218 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	inet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+)
+
+const versionProtocolPath = "/version/"
+
+// ErrIncompatibleVersion is returned when a peer does not speak any
+// protocol version that we support.
+var ErrIncompatibleVersion = errors.New("incompatible protocol version")
+
+// VersionProtocolID returns the protocol ID used to advertise support
+// for the protocol version on the network.
+func VersionProtocolID(prefix protocol.ID, version uint32) protocol.ID {
+	return prefix + versionProtocolPath + protocol.ID(strconv.FormatUint(uint64(version), 10))
+}
+
+// NegotiateVersion returns the highest protocol version supported by both
+// us and the peer given the protocols that the peer advertised in the
+// identify handshake.
+//
+// Nodes released before protocol versioning do not advertise a version
+// but speak version one, so a peer that advertises no version under our
+// prefix is treated as a version one peer.
+func NegotiateVersion(netParams *params.NetworkParams, peerProtocols []protocol.ID) (uint32, error) {
+	var (
+		prefix     = string(netParams.ProtocolPrefix + versionProtocolPath)
+		best       uint32
+		advertised bool
+	)
+	for _, proto := range peerProtocols {
+		if !strings.HasPrefix(string(proto), prefix) {
+			continue
+		}
+		v, err := strconv.ParseUint(strings.TrimPrefix(string(proto), prefix), 10, 32)
+		if err != nil {
+			continue
+		}
+		advertised = true
+		version := uint32(v)
+		if version >= netParams.MinProtocolVersion && version <= netParams.ProtocolVersion && version > best {
+			best = version
+		}
+	}
+	if !advertised && netParams.MinProtocolVersion <= 1 {
+		return 1, nil
+	}
+	if best == 0 {
+		return 0, ErrIncompatibleVersion
+	}
+	return best, nil
+}
+
+// peerVersions holds the protocol version negotiated with each
+// connected peer.
+type peerVersions struct {
+	mtx      sync.Mutex
+	versions map[peer.ID]uint32
+	waiters  map[peer.ID]chan struct{}
+}
+
+func newPeerVersions() *peerVersions {
+	return &peerVersions{
+		versions: make(map[peer.ID]uint32),
+		waiters:  make(map[peer.ID]chan struct{}),
+	}
+}
+
+// get returns the version negotiated with the peer.
+func (pv *peerVersions) get(p peer.ID) (uint32, bool) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	version, ok := pv.versions[p]
+	return version, ok
+}
+
+// set records the version negotiated with the peer. A zero version
+// removes the peer.
+func (pv *peerVersions) set(p peer.ID, version uint32) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	if version == 0 {
+		delete(pv.versions, p)
+	} else {
+		pv.versions[p] = version
+	}
+	pv.releaseLocked(p)
+}
+
+// release wakes up anyone waiting on the peer's version.
+func (pv *peerVersions) release(p peer.ID) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	pv.releaseLocked(p)
+}
+
+func (pv *peerVersions) releaseLocked(p peer.ID) {
+	if ch, ok := pv.waiters[p]; ok {
+		close(ch)
+		delete(pv.waiters, p)
+	}
+}
+
+// wait returns the version negotiated with the peer, waiting for the
+// identify handshake to complete if it has not yet.
+func (pv *peerVersions) wait(ctx context.Context, p peer.ID) (uint32, error) {
+	pv.mtx.Lock()
+	if version, ok := pv.versions[p]; ok {
+		pv.mtx.Unlock()
+		return version, nil
+	}
+	ch, ok := pv.waiters[p]
+	if !ok {
+		ch = make(chan struct{})
+		pv.waiters[p] = ch
+	}
+	pv.mtx.Unlock()
+
+	select {
+	case <-ch:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	if version, ok := pv.get(p); ok {
+		return version, nil
+	}
+	return 0, ErrIncompatibleVersion
+}
+
+// negotiate negotiates the version with the peer from the protocols it
+// advertised and records the result.
+func (pv *peerVersions) negotiate(h host.Host, netParams *params.NetworkParams, p peer.ID) (uint32, error) {
+	protocols, err := h.Peerstore().GetProtocols(p)
+	if err != nil {
+		pv.set(p, 0)
+		return 0, err
+	}
+	version, err := NegotiateVersion(netParams, protocols)
+	pv.set(p, version)
+	return version, err
+}
+
+// advertiseVersions registers a handler for each protocol version that we
+// support so they are included in the identify handshake. Once a peer is
+// identified the version negotiated with it is recorded in versions, or the
+// peer is disconnected if it does not share a version with us.
+func advertiseVersions(ctx context.Context, h host.Host, netParams *params.NetworkParams, versions *peerVersions) error {
+	for v := netParams.MinProtocolVersion; v <= netParams.ProtocolVersion; v++ {
+		h.SetStreamHandler(VersionProtocolID(netParams.ProtocolPrefix, v), func(s inet.Stream) {
+			s.Close()
+		})
+	}
+
+	sub, err := h.EventBus().Subscribe([]interface{}{
+		new(event.EvtPeerIdentificationCompleted),
+		new(event.EvtPeerIdentificationFailed),
+		new(event.EvtPeerConnectednessChanged),
+	})
+	if err != nil {
+		return err
+	}
+
+	negotiate := func(p peer.ID) {
+		if _, err := versions.negotiate(h, netParams, p); err != nil {
+			log.Debugw("Disconnecting peer", repo.LogFieldPeerID, p, repo.LogFieldError, err)
+			h.Network().ClosePeer(p)
+		}
+	}
+
+	// Peers which were identified before we subscribed.
+	for _, p := range h.Network().Peers() {
+		if protocols, err := h.Peerstore().GetProtocols(p); err == nil && len(protocols) > 0 {
+			negotiate(p)
+		}
+	}
+
+	go func() {
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+				switch e := evt.(type) {
+				case event.EvtPeerIdentificationCompleted:
+					negotiate(e.Peer)
+				case event.EvtPeerIdentificationFailed:
+					versions.release(e.Peer)
+				case event.EvtPeerConnectednessChanged:
+					if e.Connectedness == inet.NotConnected && h.Network().Connectedness(e.Peer) != inet.Connected {
+						versions.set(e.Peer, 0)
+					}
+				}
+			}
+		}
+	}()
+	return nil
+}
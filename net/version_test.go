@@ -0,0 +1,115 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/project-illium/ilxd/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateVersion(t *testing.T) {
+	p := params.RegestParams
+	p.ProtocolVersion = 3
+	p.MinProtocolVersion = 2
+
+	other := params.MainnetParams
+
+	tests := []struct {
+		name      string
+		protocols []protocol.ID
+		expected  uint32
+		err       error
+	}{
+		{
+			name:      "highest common version",
+			protocols: []protocol.ID{VersionProtocolID(p.ProtocolPrefix, 2), VersionProtocolID(p.ProtocolPrefix, 3), VersionProtocolID(p.ProtocolPrefix, 4)},
+			expected:  3,
+		},
+		{
+			name:      "older peer",
+			protocols: []protocol.ID{VersionProtocolID(p.ProtocolPrefix, 2)},
+			expected:  2,
+		},
+		{
+			name:      "peer too old",
+			protocols: []protocol.ID{VersionProtocolID(p.ProtocolPrefix, 1)},
+			err:       ErrIncompatibleVersion,
+		},
+		{
+			name:      "peer too new",
+			protocols: []protocol.ID{VersionProtocolID(p.ProtocolPrefix, 4)},
+			err:       ErrIncompatibleVersion,
+		},
+		{
+			name:      "versions from another network are ignored",
+			protocols: []protocol.ID{VersionProtocolID(other.ProtocolPrefix, 3)},
+			err:       ErrIncompatibleVersion,
+		},
+		{
+			name:      "malformed version",
+			protocols: []protocol.ID{p.ProtocolPrefix + "/version/abc"},
+			err:       ErrIncompatibleVersion,
+		},
+	}
+	for _, test := range tests {
+		version, err := NegotiateVersion(&p, test.protocols)
+		assert.Equal(t, test.err, err, test.name)
+		assert.Equal(t, test.expected, version, test.name)
+	}
+
+	// Peers that predate versioning speak version one.
+	p.MinProtocolVersion = 1
+	version, err := NegotiateVersion(&p, []protocol.ID{"/ipfs/id/1.0.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), version)
+}
+
+func TestPeerVersions(t *testing.T) {
+	pv := newPeerVersions()
+	p := peer.ID("peer")
+
+	_, ok := pv.get(p)
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := pv.wait(ctx, p)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Waiting returns once the handshake completes.
+	go pv.set(p, 2)
+	version, err := pv.wait(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), version)
+	version, ok = pv.get(p)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), version)
+
+	// Disconnected peers are removed.
+	pv.set(p, 0)
+	_, ok = pv.get(p)
+	assert.False(t, ok)
+
+	// A failed handshake releases the waiters without a version.
+	errCh := make(chan error)
+	go func() {
+		_, err := pv.wait(context.Background(), p)
+		errCh <- err
+	}()
+	assert.Eventually(t, func() bool {
+		pv.mtx.Lock()
+		defer pv.mtx.Unlock()
+		_, ok := pv.waiters[p]
+		return ok
+	}, time.Second, time.Millisecond)
+	pv.release(p)
+	assert.ErrorIs(t, <-errCh, ErrIncompatibleVersion)
+}
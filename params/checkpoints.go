@@ -0,0 +1,28 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import "sort"
+
+// LatestCheckpoint returns the checkpoint with the greatest height.
+// False is returned if the params have no checkpoints.
+func (p *NetworkParams) LatestCheckpoint() (Checkpoint, bool) {
+	if len(p.Checkpoints) == 0 {
+		return Checkpoint{}, false
+	}
+	return p.Checkpoints[len(p.Checkpoints)-1], true
+}
+
+// CheckpointAtHeight returns the checkpoint at the height if there
+// is one.
+func (p *NetworkParams) CheckpointAtHeight(height uint32) (Checkpoint, bool) {
+	i := sort.Search(len(p.Checkpoints), func(i int) bool {
+		return p.Checkpoints[i].Height >= height
+	})
+	if i < len(p.Checkpoints) && p.Checkpoints[i].Height == height {
+		return p.Checkpoints[i], true
+	}
+	return Checkpoint{}, false
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const authenticationTokenKey = "AuthenticationToken"
+
+// CheckpointParams are the options for the checkpoint generator. The
+// tool connects to a synced node over gRPC and prints the checkpoint
+// list in the format used by the NetworkParams.
+type CheckpointParams struct {
+	AuthToken     string `short:"t" long:"authtoken" description:"The ilxd node gRPC authentication token if needed"`
+	ServerAddr    string `short:"a" long:"serveraddr" description:"The address of the ilxd gRPC server (in multiaddr format)" default:"/ip4/127.0.0.1/tcp/5001"`
+	RPCCert       string `long:"rpccert" description:"A path to the SSL certificate to use with gRPC" default:"~/.ilxd/rpc.cert"`
+	Interval      uint32 `long:"interval" description:"The number of blocks between checkpoints" default:"10000"`
+	Confirmations uint32 `long:"confirmations" description:"Do not create checkpoints within this many blocks of the tip" default:"1000"`
+}
+
+// go run ./params/checkpoints --rpccert=~/.ilxd/rpc.cert --interval=10000
+
+func main() {
+	var params CheckpointParams
+	parser := flags.NewNamedParser("checkpoint generator", flags.Default)
+	parser.AddGroup("Checkpoint Options", "Options for generating the checkpoint list", &params)
+	if _, err := parser.Parse(); err != nil {
+		log.Fatal(err)
+	}
+	if params.Interval == 0 {
+		log.Fatal("interval must be greater than zero")
+	}
+
+	client, err := makeBlockchainClient(&params)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+	if params.AuthToken != "" {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(authenticationTokenKey, params.AuthToken))
+	}
+
+	info, err := client.GetBlockchainInfo(ctx, &pb.GetBlockchainInfoRequest{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if info.BestHeight < params.Confirmations {
+		log.Fatal("chain is not long enough to create checkpoints")
+	}
+	lastHeight := info.BestHeight - params.Confirmations
+
+	fmt.Println("\tCheckpoints: []Checkpoint{")
+	for height := params.Interval; height <= lastHeight; height += params.Interval {
+		resp, err := client.GetBlockInfo(ctx, &pb.GetBlockInfoRequest{
+			IdOrHeight: &pb.GetBlockInfoRequest_Height{Height: height},
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("\t\t{BlockID: hexToID(\"%s\"), Height: %d, Timestamp: %d},\n",
+			hex.EncodeToString(resp.Info.Block_ID), resp.Info.Height, resp.Info.Timestamp)
+	}
+	fmt.Println("\t},")
+}
+
+func makeBlockchainClient(params *CheckpointParams) (pb.BlockchainServiceClient, error) {
+	var (
+		creds credentials.TransportCredentials
+		err   error
+	)
+	if params.RPCCert != "" {
+		creds, err = credentials.NewClientTLSFromFile(repo.CleanAndExpandPath(params.RPCCert), "")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		creds = credentials.NewClientTLSFromCert(nil, "")
+	}
+	ma, err := multiaddr.NewMultiaddr(params.ServerAddr)
+	if err != nil {
+		return nil, err
+	}
+	netAddr, err := manet.ToNetAddr(ma)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(netAddr.String(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewBlockchainServiceClient(conn), nil
+}
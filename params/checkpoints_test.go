@@ -0,0 +1,41 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoints(t *testing.T) {
+	p := RegestParams
+	_, ok := p.LatestCheckpoint()
+	assert.False(t, ok)
+	_, ok = p.CheckpointAtHeight(0)
+	assert.False(t, ok)
+
+	p.Checkpoints = []Checkpoint{
+		{BlockID: types.ID{0x01}, Height: 100, Timestamp: 1000},
+		{BlockID: types.ID{0x02}, Height: 200, Timestamp: 2000},
+		{BlockID: types.ID{0x03}, Height: 300, Timestamp: 3000},
+	}
+	latest, ok := p.LatestCheckpoint()
+	assert.True(t, ok)
+	assert.Equal(t, uint32(300), latest.Height)
+
+	checkpoint, ok := p.CheckpointAtHeight(200)
+	assert.True(t, ok)
+	assert.Equal(t, types.ID{0x02}, checkpoint.BlockID)
+	_, ok = p.CheckpointAtHeight(150)
+	assert.False(t, ok)
+	_, ok = p.CheckpointAtHeight(301)
+	assert.False(t, ok)
+	assert.NoError(t, p.Validate())
+
+	p.Checkpoints[2].Timestamp = 1500
+	assert.Error(t, p.Validate())
+}
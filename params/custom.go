@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+)
+
+// customParams is the JSON file format for custom network params.
+//
+// Only the name and genesis block are required. The protocol prefix
+// defaults to one derived from the name so that the network does not
+// connect to any of the public networks. Any other field that is left
+// out takes the value from the regtest params.
+type customParams struct {
+	Name                       string                    `json:"name"`
+	ProtocolPrefix             string                    `json:"protocol_prefix"`
+	ProtocolVersion            *uint32                   `json:"protocol_version"`
+	MinProtocolVersion         *uint32                   `json:"min_protocol_version"`
+	GenesisBlock               *blocks.Block             `json:"genesis_block"`
+	GenesisID                  types.ID                  `json:"genesis_id"`
+	Checkpoints                []customCheckpoint        `json:"checkpoints"`
+	SeedAddrs                  []string                  `json:"seed_addrs"`
+	ListenAddrs                []string                  `json:"listen_addrs"`
+	AddressPrefix              string                    `json:"address_prefix"`
+	EpochLength                *int64                    `json:"epoch_length"`
+	TargetDistribution         *uint64                   `json:"target_distribution"`
+	InitialDistributionPeriods *int64                    `json:"initial_distribution_periods"`
+	AValue                     *float64                  `json:"a_value"`
+	TreasuryPercentage         *float64                  `json:"treasury_percentage"`
+	LongTermInflationRate      *float64                  `json:"long_term_inflation_rate"`
+	BlockVersions              []customVersionDeployment `json:"block_versions"`
+	CircuitVersions            customCircuitVersions     `json:"circuit_versions"`
+	Upgrades                   map[Upgrade]uint32        `json:"upgrades"`
+}
+
+type customCheckpoint struct {
+	BlockID   types.ID `json:"block_id"`
+	Height    uint32   `json:"height"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+type customVersionDeployment struct {
+	Version          uint32 `json:"version"`
+	ActivationHeight uint32 `json:"activation_height"`
+	ExpirationHeight uint32 `json:"expiration_height"`
+}
+
+// customCircuitVersions maps a circuit ID to its version deployments.
+type customCircuitVersions map[string][]customVersionDeployment
+
+// LoadCustomParams loads network params from a JSON file. This allows
+// private devnets to be run without modifying the params package.
+func LoadCustomParams(filePath string) (*NetworkParams, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomParams(data)
+}
+
+// ParseCustomParams parses network params from JSON. See LoadCustomParams.
+func ParseCustomParams(data []byte) (*NetworkParams, error) {
+	var cp customParams
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("error parsing custom params: %w", err)
+	}
+	if cp.Name == "" {
+		return nil, errors.New("custom params name is required")
+	}
+	switch cp.Name {
+	case networkMainnet, networkTestnet1, networkTestnet2, networkAlphanet, networkRegtest:
+		return nil, fmt.Errorf("custom params cannot use the reserved network name %s", cp.Name)
+	}
+	if cp.GenesisBlock == nil || cp.GenesisBlock.Header == nil {
+		return nil, errors.New("custom params genesis block is required")
+	}
+
+	// Start with the regtest params and override anything that
+	// was set in the file.
+	p := RegestParams
+	p.Name = cp.Name
+	p.GenesisBlock = cp.GenesisBlock
+	p.GenesisID = cp.GenesisID
+	p.ProtocolPrefix = protocol.ID(path.Join(appProtocol, cp.Name))
+	if cp.ProtocolPrefix != "" {
+		p.ProtocolPrefix = protocol.ID(cp.ProtocolPrefix)
+	}
+	if cp.ProtocolVersion != nil {
+		p.ProtocolVersion = *cp.ProtocolVersion
+	}
+	if cp.MinProtocolVersion != nil {
+		p.MinProtocolVersion = *cp.MinProtocolVersion
+	}
+	p.SeedAddrs = cp.SeedAddrs
+	if cp.ListenAddrs != nil {
+		p.ListenAddrs = cp.ListenAddrs
+	}
+	if cp.AddressPrefix != "" {
+		p.AddressPrefix = cp.AddressPrefix
+	}
+	p.Checkpoints = nil
+	for _, c := range cp.Checkpoints {
+		p.Checkpoints = append(p.Checkpoints, Checkpoint{BlockID: c.BlockID, Height: c.Height, Timestamp: c.Timestamp})
+	}
+	if cp.EpochLength != nil {
+		p.EpochLength = *cp.EpochLength
+	}
+	if cp.TargetDistribution != nil {
+		p.TargetDistribution = *cp.TargetDistribution
+	}
+	if cp.InitialDistributionPeriods != nil {
+		p.InitialDistributionPeriods = *cp.InitialDistributionPeriods
+	}
+	if cp.AValue != nil {
+		p.AValue = *cp.AValue
+	}
+	if cp.TreasuryPercentage != nil {
+		p.TreasuryPercentage = *cp.TreasuryPercentage
+	}
+	if cp.LongTermInflationRate != nil {
+		p.LongTermInflationRate = *cp.LongTermInflationRate
+	}
+	if cp.BlockVersions != nil {
+		p.BlockVersions = make(types.VersionSchedule, 0, len(cp.BlockVersions))
+		for _, v := range cp.BlockVersions {
+			p.BlockVersions = append(p.BlockVersions, types.VersionDeployment{
+				Version:          v.Version,
+				ActivationHeight: v.ActivationHeight,
+				ExpirationHeight: v.ExpirationHeight,
+			})
+		}
+	}
+	if cp.CircuitVersions != nil {
+		p.CircuitVersions = make(map[string]types.VersionSchedule, len(cp.CircuitVersions))
+		for circuit, versions := range cp.CircuitVersions {
+			schedule := make(types.VersionSchedule, 0, len(versions))
+			for _, v := range versions {
+				schedule = append(schedule, types.VersionDeployment{
+					Version:          v.Version,
+					ActivationHeight: v.ActivationHeight,
+					ExpirationHeight: v.ExpirationHeight,
+				})
+			}
+			p.CircuitVersions[circuit] = schedule
+		}
+	}
+	if cp.Upgrades != nil {
+		for upgrade := range cp.Upgrades {
+			if _, ok := upgradeVersions[upgrade]; !ok {
+				return nil, fmt.Errorf("custom params unknown upgrade %s", upgrade)
+			}
+		}
+		p.Upgrades = cp.Upgrades
+	}
+	if p.EpochLength <= 0 {
+		return nil, errors.New("custom params epoch length must be positive")
+	}
+	return &p, nil
+}
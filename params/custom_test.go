@@ -0,0 +1,58 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCustomParams(t *testing.T) {
+	genesis, err := json.Marshal(RegtestGenesisBlock)
+	assert.NoError(t, err)
+
+	data := []byte(`{
+		"name": "devnet",
+		"genesis_block": ` + string(genesis) + `,
+		"seed_addrs": ["/ip4/10.0.0.1/tcp/9003/p2p/12D3KooWN2RRWUokkcCjrf8zypvHwGv2u6rUepFAXheambSst5fV"],
+		"address_prefix": "dev",
+		"epoch_length": 60,
+		"block_versions": [{"version": 1}, {"version": 2, "activation_height": 10}],
+		"circuit_versions": {"standard": [{"version": 1, "expiration_height": 20}, {"version": 2, "activation_height": 10}], "stake": [{"version": 1}]},
+		"upgrades": {"vrf_producer": 5}
+	}`)
+	p, err := ParseCustomParams(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "devnet", p.Name)
+	assert.Equal(t, protocol.ID("/ilx/devnet"), p.ProtocolPrefix)
+	assert.Equal(t, "dev", p.AddressPrefix)
+	assert.Equal(t, int64(60), p.EpochLength)
+	assert.Equal(t, RegestParams.TargetDistribution, p.TargetDistribution)
+	assert.Len(t, p.SeedAddrs, 1)
+	assert.True(t, p.AggregateSignaturesActive(10))
+	assert.False(t, p.AggregateSignaturesActive(9))
+	assert.True(t, p.VRFProducerActive(5))
+	assert.False(t, p.VRFProducerActive(4))
+	assert.NoError(t, p.CircuitVersions["standard"].ValidateForHeight(1, 19))
+	assert.ErrorIs(t, p.CircuitVersions["standard"].ValidateForHeight(1, 20), types.ErrVersionInactive)
+	assert.NoError(t, p.CircuitVersions["standard"].ValidateForHeight(2, 20))
+
+	id1 := p.GenesisBlock.ID()
+	id2 := RegtestGenesisBlock.ID()
+	assert.Equal(t, id2, id1)
+
+	_, err = ParseCustomParams([]byte(`{"name": "regtest", "genesis_block": ` + string(genesis) + `}`))
+	assert.Error(t, err)
+
+	_, err = ParseCustomParams([]byte(`{"name": "devnet"}`))
+	assert.Error(t, err)
+
+	_, err = ParseCustomParams([]byte(`{"name": "devnet", "genesis_block": ` + string(genesis) + `, "upgrades": {"unknown": 1}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"math"
+
+	"github.com/project-illium/ilxd/types"
+)
+
+// EpochForTimestamp returns the coinbase distribution epoch that a block
+// with the given timestamp falls in. Epoch zero begins at the genesis
+// block timestamp.
+func (p *NetworkParams) EpochForTimestamp(timestamp int64) int64 {
+	return (timestamp - p.GenesisBlock.Header.Timestamp) / p.EpochLength
+}
+
+// CalculateSubsidy returns the total number of new coins created at the
+// start of the epoch.
+//
+// For the first InitialDistributionPeriods the subsidy decays exponentially
+// from w0 down to the long term inflation amount. After that the subsidy
+// grows at the LongTermInflationRate per epoch.
+func (p *NetworkParams) CalculateSubsidy(epoch int64) types.Amount {
+	if epoch > p.InitialDistributionPeriods {
+		a := float64(p.TargetDistribution) * p.LongTermInflationRate
+		return types.Amount(a * math.Pow(1.0+p.LongTermInflationRate, float64(epoch-p.InitialDistributionPeriods)))
+	}
+
+	n := float64(p.TargetDistribution) - float64(p.GenesisBlock.Transactions[0].GetCoinbaseTransaction().NewCoins)
+	periods := float64(p.InitialDistributionPeriods)
+	w0 := (n / periods) * p.AValue
+	wl := float64(p.TargetDistribution) * p.LongTermInflationRate
+	r := math.Pow((wl / w0), (1 / periods))
+	return types.Amount(w0 * math.Pow(r, float64(epoch)))
+}
+
+// TreasuryCredit returns the portion of the subsidy that is credited
+// to the treasury.
+func (p *NetworkParams) TreasuryCredit(subsidy types.Amount) types.Amount {
+	return types.Amount(float64(subsidy) / (float64(100) / p.TreasuryPercentage))
+}
+
+// ValidatorReward returns the portion of the epoch's subsidy that is
+// distributed to the validators.
+func (p *NetworkParams) ValidatorReward(epoch int64) types.Amount {
+	subsidy := p.CalculateSubsidy(epoch)
+	return subsidy - p.TreasuryCredit(subsidy)
+}
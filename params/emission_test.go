@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"math"
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateSubsidy(t *testing.T) {
+	var prevCoinbase, total types.Amount
+	for i := int64(0); i < MainnetParams.InitialDistributionPeriods; i++ {
+		coinbase := MainnetParams.CalculateSubsidy(i)
+		if i > 0 {
+			assert.Less(t, coinbase, prevCoinbase)
+		}
+		prevCoinbase = coinbase
+		total += coinbase
+	}
+	initalCoins := types.Amount(MainnetParams.GenesisBlock.Transactions[0].GetCoinbaseTransaction().NewCoins)
+	// The algorithm doesn't hit the nail on the head perfectly in terms of distributing
+	// the target supply in the initial distribution periods. But let's just check make
+	// sure it's within some tolerable amount. In this case around three tenths of
+	// a percent.
+	assert.Less(t, (float64(total+initalCoins)-float64(MainnetParams.TargetDistribution))/float64(MainnetParams.TargetDistribution), float64(.0035))
+
+	prevCoinbase, total = 0, 0
+	for i := MainnetParams.InitialDistributionPeriods + 1; i < MainnetParams.InitialDistributionPeriods+53; i++ {
+		coinbase := MainnetParams.CalculateSubsidy(i)
+		assert.Greater(t, coinbase, prevCoinbase)
+		prevCoinbase = coinbase
+		total += coinbase
+	}
+
+	// Same here. Make sure the long term inflation rate is within some tolerable margin of 2%.
+	assert.Less(t, (float64(total)/float64(MainnetParams.TargetDistribution))-float64(.02), float64(.00001))
+}
+
+func TestValidatorReward(t *testing.T) {
+	for i := int64(0); i < MainnetParams.InitialDistributionPeriods; i++ {
+		coinbase := MainnetParams.CalculateSubsidy(i)
+		validatorReward := MainnetParams.ValidatorReward(i)
+		assert.Equal(t, math.Round(float64(validatorReward)/float64(coinbase)*100)/100, (float64(100)-MainnetParams.TreasuryPercentage)/100)
+	}
+}
+
+func TestEpochForTimestamp(t *testing.T) {
+	genesisTime := MainnetParams.GenesisBlock.Header.Timestamp
+	assert.Equal(t, int64(0), MainnetParams.EpochForTimestamp(genesisTime))
+	assert.Equal(t, int64(0), MainnetParams.EpochForTimestamp(genesisTime+MainnetParams.EpochLength-1))
+	assert.Equal(t, int64(1), MainnetParams.EpochForTimestamp(genesisTime+MainnetParams.EpochLength))
+	assert.Equal(t, int64(10), MainnetParams.EpochForTimestamp(genesisTime+MainnetParams.EpochLength*10+5))
+}
+
+func TestTreasuryCredit(t *testing.T) {
+	subsidy := MainnetParams.CalculateSubsidy(0)
+	credit := MainnetParams.TreasuryCredit(subsidy)
+	assert.Equal(t, subsidy-credit, MainnetParams.ValidatorReward(0))
+	assert.Equal(t, types.Amount(50), MainnetParams.TreasuryCredit(1000))
+}
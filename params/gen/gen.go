@@ -27,7 +27,7 @@ type GenerationParams struct {
 	Mnemonic     string `long:"mnemonicseed" description:"The mnemonic seed to use when creating the params"`
 	InitialCoins uint64 `long:"initialcoins" description:"The number of coins created by the genesis block"`
 	Timestamp    int64  `long:"timestamp" description:"The genesis block timestamp"`
-	NetParams    string `long:"params" description:"The network params to use: [mainnet, testnet1, regtest, alphanet]"`
+	NetParams    string `long:"params" description:"The network params to use: [mainnet, testnet1, testnet2, regtest, alphanet]"`
 }
 
 // --timestamp=1698255320
@@ -47,6 +47,8 @@ func main() {
 		netParams = &params2.MainnetParams
 	case "testnet1":
 		netParams = &params2.Testnet1Params
+	case "testnet2":
+		netParams = &params2.Testnet2Params
 	case "regtest":
 		netParams = &params2.RegestParams
 	case "alphanet":
@@ -226,4 +228,5 @@ func main() {
 		log.Fatal(err)
 	}
 	fmt.Println(string(out))
+	log.Printf("Genesis block ID: %s", blk.ID())
 }
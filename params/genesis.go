@@ -6,6 +6,7 @@ package params
 
 import (
 	"encoding/hex"
+	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"time"
@@ -173,3 +174,7 @@ func hexToBytes(s string) []byte {
 	ret, _ := hex.DecodeString(s)
 	return ret
 }
+
+func hexToID(s string) types.ID {
+	return types.NewID(hexToBytes(s))
+}
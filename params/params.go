@@ -17,13 +17,19 @@ const (
 
 	networkMainnet  = "mainnet"
 	networkTestnet1 = "testnet1"
+	networkTestnet2 = "testnet2"
 	networkAlphanet = "alphanet"
 	networkRegtest  = "regtest"
 )
 
+// Checkpoint is a known good block in the blockchain.
 type Checkpoint struct {
 	BlockID types.ID
 	Height  uint32
+
+	// Timestamp is the timestamp of the checkpoint block. It is
+	// only a hint used to estimate sync progress. Zero means unknown.
+	Timestamp int64
 }
 
 type NetworkParams struct {
@@ -36,13 +42,30 @@ type NetworkParams struct {
 	// different protocol IDs.
 	ProtocolPrefix protocol.ID
 
+	// ProtocolVersion is the version of the network protocol spoken
+	// by this node. It must be incremented whenever a change is made
+	// that nodes on the previous version cannot decode.
+	ProtocolVersion uint32
+
+	// MinProtocolVersion is the lowest protocol version that this
+	// node will peer with. Peers that only speak older versions are
+	// disconnected after the identify handshake.
+	MinProtocolVersion uint32
+
 	// GenesisBlock defines the first block in the network. This
 	// block must have a coinbase and stake transaction for the
 	// network to move forward.
 	GenesisBlock *blocks.Block
 
+	// GenesisID is the expected ID of the genesis block. It is
+	// checked by Validate to catch a genesis block that was edited
+	// without updating the ID. If zero the check is skipped.
+	GenesisID types.ID
+
 	// Checkpoints are known good blocks in the blockchain. We
-	// use these to speed up the initial block download.
+	// use these to speed up the initial block download. They
+	// must be sorted by height and can be regenerated from a
+	// synced node with the params/checkpoints tool.
 	Checkpoints []Checkpoint
 
 	// SeedAddrs are used to connect to the network for the first
@@ -85,12 +108,150 @@ type NetworkParams struct {
 	// LongTermInflationRate defines the rate of emission per epoch after the
 	// TargetDistribution is exhausted.
 	LongTermInflationRate float64
+
+	// BlockVersions defines the block header versions that are valid
+	// and the heights at which they are valid.
+	BlockVersions types.VersionSchedule
+
+	// CircuitVersions defines the versions of each zk circuit that
+	// proofs are verified against and the heights at which they are
+	// valid. It is keyed by the circuit ID. When a circuit is upgraded
+	// the legacy version is given an expiration height after the new
+	// version activates so that proofs created with it remain valid
+	// during the transition.
+	CircuitVersions map[string]types.VersionSchedule
+
+	// Upgrades maps protocol upgrades to the height at which they
+	// activate on this network. This lets a test network turn on an
+	// upgrade earlier than mainnet. Upgrades that are not in the table
+	// activate along with the block version that introduced them.
+	Upgrades map[Upgrade]uint32
+
+	// SnapshotInterval is the number of blocks between snapshots of
+	// the chain state. Once the snapshot commitments upgrade is active
+	// the block producer commits to each snapshot in a later block
+	// header so new nodes can bootstrap from a snapshot served by
+	// their peers.
+	SnapshotInterval uint32
+}
+
+// Upgrade is the name of a protocol upgrade.
+type Upgrade string
+
+const (
+	// UpgradeAggregateSignatures activates signed avalanche votes and
+	// aggregate finality certificates.
+	UpgradeAggregateSignatures Upgrade = "aggregate_signatures"
+
+	// UpgradeVRFProducer activates VRF based block producer selection.
+	UpgradeVRFProducer Upgrade = "vrf_producer"
+
+	// UpgradeSnapshotCommitments activates commitments to the chain
+	// state snapshots in block headers.
+	UpgradeSnapshotCommitments Upgrade = "snapshot_commitments"
+
+	// UpgradeLocktimeSeconds denominates the locktime precision in the
+	// standard circuit's public params in seconds. Before it activates
+	// the precision is passed to the circuit unscaled and serializes as
+	// zero.
+	UpgradeLocktimeSeconds Upgrade = "locktime_seconds"
+)
+
+const (
+	// BlockVersionAggregateSignatures is the block version that activates
+	// signed avalanche votes and aggregate finality certificates.
+	BlockVersionAggregateSignatures = 2
+
+	// BlockVersionVRFProducer is the block version that activates VRF
+	// based block producer selection.
+	BlockVersionVRFProducer = 3
+
+	// BlockVersionSnapshotCommitments is the block version that activates
+	// snapshot commitments in block headers.
+	BlockVersionSnapshotCommitments = 4
+
+	// BlockVersionLocktimeSeconds is the block version that activates
+	// locktime precision in seconds in the standard circuit's public
+	// params.
+	BlockVersionLocktimeSeconds = 5
+)
+
+// upgradeVersions maps each upgrade to the block version that
+// introduced it.
+var upgradeVersions = map[Upgrade]uint32{
+	UpgradeAggregateSignatures: BlockVersionAggregateSignatures,
+	UpgradeVRFProducer:         BlockVersionVRFProducer,
+	UpgradeSnapshotCommitments: BlockVersionSnapshotCommitments,
+	UpgradeLocktimeSeconds:     BlockVersionLocktimeSeconds,
+}
+
+// IsActive returns whether the upgrade is active at the height. The
+// activation height in the Upgrades table takes precedence over the
+// block version schedule. Unknown upgrades are never active.
+func (p *NetworkParams) IsActive(upgrade Upgrade, height uint32) bool {
+	if activation, ok := p.Upgrades[upgrade]; ok {
+		return height >= activation
+	}
+	version, ok := upgradeVersions[upgrade]
+	if !ok {
+		return false
+	}
+	return p.versionActive(version, height)
+}
+
+// AggregateSignaturesActive returns whether signed votes and aggregate
+// finality certificates are active at the height.
+func (p *NetworkParams) AggregateSignaturesActive(height uint32) bool {
+	return p.IsActive(UpgradeAggregateSignatures, height)
+}
+
+// VRFProducerActive returns whether block producers must prove their
+// eligibility with a VRF at the height.
+func (p *NetworkParams) VRFProducerActive(height uint32) bool {
+	return p.IsActive(UpgradeVRFProducer, height)
+}
+
+// SnapshotCommitmentsActive returns whether block headers commit to the
+// chain state snapshots at the height.
+func (p *NetworkParams) SnapshotCommitmentsActive(height uint32) bool {
+	return p.IsActive(UpgradeSnapshotCommitments, height)
+}
+
+// LocktimeSecondsActive returns whether the locktime precision in the
+// standard circuit's public params is denominated in seconds at the
+// height.
+func (p *NetworkParams) LocktimeSecondsActive(height uint32) bool {
+	return p.IsActive(UpgradeLocktimeSeconds, height)
+}
+
+// versionActive returns whether the features introduced by the block
+// version are active at the height. A feature stays active once a later
+// version is deployed.
+func (p *NetworkParams) versionActive(version uint32, height uint32) bool {
+	latest, ok := p.BlockVersions.LatestForHeight(height)
+	return ok && latest >= version
+}
+
+// defaultBlockVersions is the block version schedule shared by all
+// networks. Version 1 is valid from genesis.
+var defaultBlockVersions = types.VersionSchedule{
+	{Version: 1, ActivationHeight: 0},
+}
+
+// defaultCircuitVersions is the circuit version schedule shared by all
+// networks. Version 1 of each circuit is valid from genesis.
+var defaultCircuitVersions = map[string]types.VersionSchedule{
+	"standard": {{Version: 1, ActivationHeight: 0}},
+	"stake":    {{Version: 1, ActivationHeight: 0}},
 }
 
 var MainnetParams = NetworkParams{
-	Name:           "mainnet",
-	ProtocolPrefix: protocol.ID(path.Join(appProtocol, networkMainnet)),
-	GenesisBlock:   MainnetGenesisBlock,
+	Name:               "mainnet",
+	ProtocolPrefix:     protocol.ID(path.Join(appProtocol, networkMainnet)),
+	ProtocolVersion:    1,
+	MinProtocolVersion: 1,
+	GenesisBlock:       MainnetGenesisBlock,
+	GenesisID:          hexToID("3fb0982827a4bc477e68daae186079f5f51e49ce22eb67e30b4d03e28a9905ae"),
 	SeedAddrs: []string{
 		"/ip4/167.172.126.176/tcp/4001/p2p/12D3KooWHnpVyu9XDeFoAVayqr9hvc9xPqSSHtCSFLEkKgcz5Wro",
 	},
@@ -107,11 +268,16 @@ var MainnetParams = NetworkParams{
 	AValue:                     2.59,
 	TreasuryPercentage:         5,
 	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
+	BlockVersions:              defaultBlockVersions,
+	CircuitVersions:            defaultCircuitVersions,
+	SnapshotInterval:           50000,
 }
 
 var Testnet1Params = NetworkParams{
-	Name:           "testnet1",
-	ProtocolPrefix: protocol.ID(path.Join(appProtocol, networkTestnet1)),
+	Name:               "testnet1",
+	ProtocolPrefix:     protocol.ID(path.Join(appProtocol, networkTestnet1)),
+	ProtocolVersion:    1,
+	MinProtocolVersion: 1,
 	SeedAddrs: []string{
 		"/ip4/167.172.126.176/tcp/4001/p2p/12D3KooWHnpVyu9XDeFoAVayqr9hvc9xPqSSHtCSFLEkKgcz5Wro",
 	},
@@ -128,11 +294,55 @@ var Testnet1Params = NetworkParams{
 	AValue:                     2.59,
 	TreasuryPercentage:         5,
 	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
+	BlockVersions:              defaultBlockVersions,
+	CircuitVersions:            defaultCircuitVersions,
+	SnapshotInterval:           10000,
+}
+
+// Testnet2Params are the params for the second public test network. It is
+// used to soak test protocol upgrades without disturbing testnet1 so new
+// upgrades activate here first and the epochs are much shorter.
+//
+// The network has not launched. Its genesis block must be generated with
+// params/gen from a mnemonic held by the testnet2 operators, so that the
+// genesis coins and validator belong to keys used on no other network,
+// and its seed nodes must be added. Until then the params fail
+// validation and a node started with --testnet2 exits at startup.
+var Testnet2Params = NetworkParams{
+	Name:               "testnet2",
+	ProtocolPrefix:     protocol.ID(path.Join(appProtocol, networkTestnet2)),
+	ProtocolVersion:    1,
+	MinProtocolVersion: 1,
+	SeedAddrs:          []string{},
+	ListenAddrs: []string{
+		"/ip4/0.0.0.0/tcp/9004",
+		"/ip6/::/tcp/9004",
+		"/ip4/0.0.0.0/udp/9004/quic",
+		"/ip6/::/udp/9004/quic",
+	},
+	AddressPrefix:              "tn2",
+	EpochLength:                60 * 60, // One hour
+	TargetDistribution:         1 << 60,
+	InitialDistributionPeriods: 520,
+	AValue:                     2.59,
+	TreasuryPercentage:         5,
+	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
+	BlockVersions:              defaultBlockVersions,
+	CircuitVersions:            defaultCircuitVersions,
+	Upgrades: map[Upgrade]uint32{
+		UpgradeAggregateSignatures: 0,
+		UpgradeVRFProducer:         0,
+		UpgradeSnapshotCommitments: 0,
+		UpgradeLocktimeSeconds:     0,
+	},
+	SnapshotInterval: 1000,
 }
 
 var AlphanetParams = NetworkParams{
-	Name:           "alphanet",
-	ProtocolPrefix: protocol.ID(path.Join(appProtocol, networkTestnet1)),
+	Name:               "alphanet",
+	ProtocolPrefix:     protocol.ID(path.Join(appProtocol, networkTestnet1)),
+	ProtocolVersion:    1,
+	MinProtocolVersion: 1,
 	SeedAddrs: []string{
 		"/ip4/159.223.155.82/tcp/9002/p2p/12D3KooWKUMHDGvDuJjSkhey1Gz9kYPpt5Nw1wpzRtt9xwYWF1tx",
 		"/ip4/137.184.35.103/tcp/9002/p2p/12D3KooWAqT761RNUN4ewfZwzCWkPDsG5BxMfbX48kdsT5qmjWLX",
@@ -145,17 +355,23 @@ var AlphanetParams = NetworkParams{
 	},
 	AddressPrefix:              "al",
 	GenesisBlock:               AlphanetGenesisBlock,
+	GenesisID:                  hexToID("19a887e70bb4614cdfe5ee665cd3a58c4bcdc6529bfaa955ed604fdc2c71eafb"),
 	EpochLength:                60 * 60 * 24 * 7, // One week
 	TargetDistribution:         1 << 60,
 	InitialDistributionPeriods: 520,
 	AValue:                     2.59,
 	TreasuryPercentage:         5,
 	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
+	BlockVersions:              defaultBlockVersions,
+	CircuitVersions:            defaultCircuitVersions,
+	SnapshotInterval:           10000,
 }
 
 var RegestParams = NetworkParams{
-	Name:           "regtest",
-	ProtocolPrefix: protocol.ID(path.Join(appProtocol, networkRegtest)),
+	Name:               "regtest",
+	ProtocolPrefix:     protocol.ID(path.Join(appProtocol, networkRegtest)),
+	ProtocolVersion:    1,
+	MinProtocolVersion: 1,
 	ListenAddrs: []string{
 		"/ip4/0.0.0.0/tcp/9003",
 		"/ip6/::/tcp/9003",
@@ -165,10 +381,14 @@ var RegestParams = NetworkParams{
 	SeedAddrs:                  []string{"/ip4/127.0.0.1/tcp/9003/p2p/12D3KooWN2RRWUokkcCjrf8zypvHwGv2u6rUepFAXheambSst5fV"},
 	AddressPrefix:              "reg",
 	GenesisBlock:               RegtestGenesisBlock,
+	GenesisID:                  hexToID("37fac1b9d494694c0c6618d049701a5f804de897b30b81d0eefd8120ec0c2423"),
 	EpochLength:                60 * 3, // Three minutes
 	TargetDistribution:         1 << 60,
 	InitialDistributionPeriods: 520,
 	AValue:                     2.59,
 	TreasuryPercentage:         5,
 	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
+	BlockVersions:              defaultBlockVersions,
+	CircuitVersions:            defaultCircuitVersions,
+	SnapshotInterval:           100,
 }
@@ -0,0 +1,41 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsActive(t *testing.T) {
+	p := RegestParams
+	assert.False(t, p.IsActive(UpgradeAggregateSignatures, 1000))
+	assert.False(t, p.IsActive(UpgradeVRFProducer, 1000))
+	assert.False(t, p.IsActive(Upgrade("unknown"), 1000))
+
+	// Upgrades without an entry in the table follow the block versions.
+	p.BlockVersions = types.VersionSchedule{
+		{Version: 1, ActivationHeight: 0},
+		{Version: BlockVersionAggregateSignatures, ActivationHeight: 100},
+	}
+	assert.False(t, p.IsActive(UpgradeAggregateSignatures, 99))
+	assert.True(t, p.IsActive(UpgradeAggregateSignatures, 100))
+	assert.False(t, p.IsActive(UpgradeVRFProducer, 100))
+
+	// The table takes precedence over the block versions.
+	p.Upgrades = map[Upgrade]uint32{
+		UpgradeAggregateSignatures: 200,
+		UpgradeVRFProducer:         50,
+	}
+	assert.False(t, p.IsActive(UpgradeAggregateSignatures, 100))
+	assert.True(t, p.IsActive(UpgradeAggregateSignatures, 200))
+	assert.False(t, p.IsActive(UpgradeVRFProducer, 49))
+	assert.True(t, p.IsActive(UpgradeVRFProducer, 50))
+
+	// The mainnet table is not modified.
+	assert.Nil(t, MainnetParams.Upgrades)
+}
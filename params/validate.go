@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/project-illium/ilxd/types"
+)
+
+// Validate checks that the params are internally consistent. It is
+// intended to be called at startup so that a misconfigured network
+// fails fast rather than producing confusing errors later on.
+//
+// The following are checked:
+// - Required fields are set.
+// - The genesis block is well-formed and has the expected ID.
+// - Checkpoints are sorted by height and do not conflict with genesis.
+// - The emission parameters and upgrade table are sane.
+func (p *NetworkParams) Validate() error {
+	if p.Name == "" {
+		return errors.New("params: name is required")
+	}
+	if p.ProtocolPrefix == "" {
+		return errors.New("params: protocol prefix is required")
+	}
+	if p.ProtocolVersion == 0 || p.MinProtocolVersion == 0 {
+		return errors.New("params: protocol version is required")
+	}
+	if p.MinProtocolVersion > p.ProtocolVersion {
+		return errors.New("params: min protocol version is greater than the protocol version")
+	}
+	if p.AddressPrefix == "" {
+		return errors.New("params: address prefix is required")
+	}
+	if len(p.ListenAddrs) == 0 {
+		return errors.New("params: at least one listen address is required")
+	}
+	// Public networks need seeds for new nodes to find the network.
+	// Regtest and custom devnets may be started with a single node.
+	switch p.Name {
+	case networkMainnet, networkTestnet1, networkTestnet2, networkAlphanet:
+		if len(p.SeedAddrs) == 0 {
+			return fmt.Errorf("params: %s requires seed addresses", p.Name)
+		}
+	}
+	for _, addr := range p.SeedAddrs {
+		if _, err := multiaddr.NewMultiaddr(addr); err != nil {
+			return fmt.Errorf("params: invalid seed address %s: %w", addr, err)
+		}
+	}
+	for _, addr := range p.ListenAddrs {
+		if _, err := multiaddr.NewMultiaddr(addr); err != nil {
+			return fmt.Errorf("params: invalid listen address %s: %w", addr, err)
+		}
+	}
+
+	genesisID, err := p.validateGenesis()
+	if err != nil {
+		return err
+	}
+
+	var (
+		prevHeight    uint32
+		prevTimestamp int64
+	)
+	for i, checkpoint := range p.Checkpoints {
+		if checkpoint.BlockID == (types.ID{}) {
+			return fmt.Errorf("params: checkpoint at height %d has no block ID", checkpoint.Height)
+		}
+		if checkpoint.Height == 0 && checkpoint.BlockID != genesisID {
+			return errors.New("params: checkpoint at height 0 does not match genesis")
+		}
+		if i > 0 && checkpoint.Height <= prevHeight {
+			return errors.New("params: checkpoints are not sorted by height")
+		}
+		if checkpoint.Timestamp != 0 && checkpoint.Timestamp < prevTimestamp {
+			return errors.New("params: checkpoint timestamps are not increasing")
+		}
+		prevHeight = checkpoint.Height
+		if checkpoint.Timestamp != 0 {
+			prevTimestamp = checkpoint.Timestamp
+		}
+	}
+
+	if p.EpochLength <= 0 {
+		return errors.New("params: epoch length must be positive")
+	}
+	if p.InitialDistributionPeriods <= 0 {
+		return errors.New("params: initial distribution periods must be positive")
+	}
+	if p.TreasuryPercentage < 0 || p.TreasuryPercentage > 100 {
+		return errors.New("params: treasury percentage must be between 0 and 100")
+	}
+	if _, ok := p.BlockVersions.LatestForHeight(0); !ok {
+		return errors.New("params: no block version is active at genesis")
+	}
+	for upgrade := range p.Upgrades {
+		if _, ok := upgradeVersions[upgrade]; !ok {
+			return fmt.Errorf("params: unknown upgrade %s", upgrade)
+		}
+	}
+	if len(p.CircuitVersions) == 0 {
+		return errors.New("params: circuit versions are required")
+	}
+	for circuit, schedule := range p.CircuitVersions {
+		if len(schedule) == 0 {
+			return fmt.Errorf("params: circuit %s has no versions", circuit)
+		}
+	}
+	return nil
+}
+
+// validateGenesis checks the genesis block and returns its ID.
+func (p *NetworkParams) validateGenesis() (types.ID, error) {
+	if p.GenesisBlock == nil || p.GenesisBlock.Header == nil {
+		return types.ID{}, errors.New("params: genesis block is required")
+	}
+	if p.GenesisBlock.Header.Height != 0 {
+		return types.ID{}, errors.New("params: genesis block height is not zero")
+	}
+	if len(p.GenesisBlock.Transactions) == 0 || p.GenesisBlock.Transactions[0].GetCoinbaseTransaction() == nil {
+		return types.ID{}, errors.New("params: first genesis transaction is not a coinbase")
+	}
+	if err := p.BlockVersions.ValidateForHeight(p.GenesisBlock.Header.Version, 0); err != nil {
+		return types.ID{}, fmt.Errorf("params: genesis block version: %w", err)
+	}
+	id := p.GenesisBlock.ID()
+	if p.GenesisID != (types.ID{}) && id != p.GenesisID {
+		return types.ID{}, fmt.Errorf("params: genesis block ID %s does not match expected %s", id, p.GenesisID)
+	}
+	return id, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package params
+
+import (
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkParams_Validate(t *testing.T) {
+	assert.NoError(t, MainnetParams.Validate())
+	assert.NoError(t, AlphanetParams.Validate())
+	assert.NoError(t, RegestParams.Validate())
+
+	// Testnet2 cannot be joined until its genesis block is generated
+	// and its seed nodes are added.
+	assert.Error(t, Testnet2Params.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(p *NetworkParams)
+	}{
+		{
+			name:   "missing protocol prefix",
+			modify: func(p *NetworkParams) { p.ProtocolPrefix = "" },
+		},
+		{
+			name:   "missing protocol version",
+			modify: func(p *NetworkParams) { p.ProtocolVersion = 0 },
+		},
+		{
+			name:   "min protocol version too high",
+			modify: func(p *NetworkParams) { p.MinProtocolVersion = p.ProtocolVersion + 1 },
+		},
+		{
+			name: "missing seeds",
+			modify: func(p *NetworkParams) {
+				p.Name = networkAlphanet
+				p.SeedAddrs = nil
+			},
+		},
+		{
+			name:   "invalid seed",
+			modify: func(p *NetworkParams) { p.SeedAddrs = []string{"not a multiaddr"} },
+		},
+		{
+			name:   "missing genesis",
+			modify: func(p *NetworkParams) { p.GenesisBlock = nil },
+		},
+		{
+			name:   "wrong genesis ID",
+			modify: func(p *NetworkParams) { p.GenesisID = types.ID{0x01} },
+		},
+		{
+			name: "modified genesis",
+			modify: func(p *NetworkParams) {
+				ser, err := p.GenesisBlock.Header.Serialize()
+				assert.NoError(t, err)
+				header := new(blocks.BlockHeader)
+				assert.NoError(t, header.Deserialize(ser))
+				header.Timestamp++
+				p.GenesisBlock = &blocks.Block{Header: header, Transactions: p.GenesisBlock.Transactions}
+			},
+		},
+		{
+			name: "unsorted checkpoints",
+			modify: func(p *NetworkParams) {
+				p.Checkpoints = []Checkpoint{
+					{BlockID: types.ID{0x01}, Height: 10},
+					{BlockID: types.ID{0x02}, Height: 5},
+				}
+			},
+		},
+		{
+			name: "checkpoint conflicts with genesis",
+			modify: func(p *NetworkParams) {
+				p.Checkpoints = []Checkpoint{{BlockID: types.ID{0x01}, Height: 0}}
+			},
+		},
+		{
+			name:   "zero epoch length",
+			modify: func(p *NetworkParams) { p.EpochLength = 0 },
+		},
+		{
+			name:   "treasury over 100 percent",
+			modify: func(p *NetworkParams) { p.TreasuryPercentage = 101 },
+		},
+		{
+			name:   "no block versions",
+			modify: func(p *NetworkParams) { p.BlockVersions = nil },
+		},
+		{
+			name:   "no circuit versions",
+			modify: func(p *NetworkParams) { p.CircuitVersions = nil },
+		},
+		{
+			name:   "circuit without versions",
+			modify: func(p *NetworkParams) { p.CircuitVersions = map[string]types.VersionSchedule{"standard": nil} },
+		},
+		{
+			name:   "unknown upgrade",
+			modify: func(p *NetworkParams) { p.Upgrades = map[Upgrade]uint32{"unknown": 1} },
+		},
+	}
+	for _, test := range tests {
+		p := RegestParams
+		test.modify(&p)
+		assert.Error(t, p.Validate(), test.name)
+	}
+
+	p := RegestParams
+	p.Checkpoints = []Checkpoint{
+		{BlockID: RegestParams.GenesisID, Height: 0},
+		{BlockID: types.ID{0x01}, Height: 10},
+	}
+	assert.NoError(t, p.Validate())
+}
@@ -110,11 +110,7 @@ func (p *Policy) IsAcceptableBlock(blk *blocks.Block) (bool, error) {
 	p.mtx.RLock()
 	defer p.mtx.RUnlock()
 
-	blockSize, err := blk.SerializedSize()
-	if err != nil {
-		return false, err
-	}
-	if uint32(blockSize) > p.blocksizeSoftLimit {
+	if uint32(blk.Weight()) > p.blocksizeSoftLimit {
 		return false, nil
 	}
 	for _, tx := range blk.Transactions {
@@ -0,0 +1,148 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// DefaultAuditLogFilename is the name of the audit log file in the log directory.
+const DefaultAuditLogFilename = "audit.log"
+
+// The following are the events recorded in the audit log.
+const (
+	// AuditPeerBanned is recorded when a peer and its IP addresses are
+	// banned for going over the max banscore.
+	AuditPeerBanned = "peer_banned"
+
+	// AuditBanscoreThreshold is recorded when a peer's banscore first
+	// crosses half the max banscore.
+	AuditBanscoreThreshold = "banscore_threshold"
+
+	// AuditInvalidProof is recorded when a peer relays a transaction or
+	// block containing an invalid zk-snark proof.
+	AuditInvalidProof = "invalid_proof"
+
+	// AuditRPCAdmin is recorded when an administrative RPC is invoked.
+	AuditRPCAdmin = "rpc_admin"
+
+	// AuditRPCAuthFailure is recorded when an RPC client fails to
+	// authenticate.
+	AuditRPCAuthFailure = "rpc_auth_failure"
+)
+
+// auditLimiterMaxKeys bounds the number of keys tracked by AuditLimited.
+const auditLimiterMaxKeys = 1000
+
+var (
+	auditLog = zap.NewNop().Sugar()
+	auditMtx sync.RWMutex
+
+	limiter    = make(map[string]*limitedEvent)
+	limiterMtx sync.Mutex
+)
+
+type limitedEvent struct {
+	last       time.Time
+	suppressed int
+}
+
+// OpenAuditLog opens the audit log in the log directory. If the log
+// directory is not set the audit log is put in the data directory.
+// Each event is written as a single JSON line with a timestamp.
+//
+// The audit log is rotated with the same size, age and backup settings
+// as the main log so that an attacker cannot fill the disk by
+// triggering audited events.
+//
+// Until the audit log is opened, Audit is a no-op. The returned function
+// closes the file.
+func OpenAuditLog(config *Config) (func() error, error) {
+	dir := config.LogDir
+	if dir == "" {
+		dir = config.DataDir
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	rotator := &lumberjack.Logger{
+		Filename:   filepath.Join(dir, DefaultAuditLogFilename),
+		MaxSize:    config.LogMaxSize, // Megabytes
+		MaxAge:     config.LogMaxAge,  // Days
+		MaxBackups: config.LogMaxBackups,
+		Compress:   config.LogCompress,
+	}
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.MessageKey = "event"
+	encoderConfig.LevelKey = zapcore.OmitKey
+	encoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), zapcore.AddSync(rotator), zapcore.InfoLevel)
+
+	auditMtx.Lock()
+	auditLog = zap.New(core).Sugar()
+	auditMtx.Unlock()
+
+	return func() error {
+		auditMtx.Lock()
+		auditLog = zap.NewNop().Sugar()
+		auditMtx.Unlock()
+		return rotator.Close()
+	}, nil
+}
+
+// Audit records the event in the audit log along with the key value pairs.
+// The keys should use the LogField constants where applicable.
+func Audit(event string, keysAndValues ...interface{}) {
+	auditMtx.RLock()
+	defer auditMtx.RUnlock()
+	auditLog.Infow(event, keysAndValues...)
+}
+
+// AuditLimited is Audit but records the event at most once per interval
+// for each key. It is used for events that an unauthenticated client can
+// trigger at will. The number of events dropped since the last entry is
+// recorded in the suppressed field.
+func AuditLimited(event, key string, interval time.Duration, keysAndValues ...interface{}) {
+	limiterMtx.Lock()
+	now := time.Now()
+	id := event + "/" + key
+	e, ok := limiter[id]
+	if ok && now.Sub(e.last) < interval {
+		e.suppressed++
+		limiterMtx.Unlock()
+		return
+	}
+	if !ok {
+		if len(limiter) >= auditLimiterMaxKeys {
+			for k, v := range limiter {
+				if now.Sub(v.last) >= interval {
+					delete(limiter, k)
+				}
+			}
+		}
+		if len(limiter) >= auditLimiterMaxKeys {
+			limiterMtx.Unlock()
+			return
+		}
+		e = &limitedEvent{}
+		limiter[id] = e
+	}
+	suppressed := e.suppressed
+	e.last = now
+	e.suppressed = 0
+	limiterMtx.Unlock()
+
+	if suppressed > 0 {
+		keysAndValues = append(keysAndValues, "suppressed", suppressed)
+	}
+	Audit(event, keysAndValues...)
+}
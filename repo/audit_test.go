@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	// Not opened yet. Should not panic.
+	Audit(AuditPeerBanned, LogFieldPeerID, "12D3KooWA")
+
+	// Without a log directory the audit log goes in the data directory.
+	config := &Config{DataDir: path.Join(t.TempDir(), "data")}
+	filename := path.Join(config.DataDir, DefaultAuditLogFilename)
+	closeLog, err := OpenAuditLog(config)
+	assert.NoError(t, err)
+	Audit(AuditPeerBanned, LogFieldPeerID, "12D3KooWB", "banscore", 101)
+	assert.NoError(t, closeLog())
+
+	// Reopening appends rather than truncates.
+	closeLog, err = OpenAuditLog(config)
+	assert.NoError(t, err)
+	Audit(AuditRPCAdmin, "method", "/pb.NodeService/BlockPeer")
+	assert.NoError(t, closeLog())
+
+	// Closed. Should not be written.
+	Audit(AuditInvalidProof, LogFieldPeerID, "12D3KooWC")
+
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, AuditPeerBanned, entry["event"])
+	assert.Equal(t, "12D3KooWB", entry[LogFieldPeerID])
+	assert.Equal(t, float64(101), entry["banscore"])
+	assert.NotEmpty(t, entry["ts"])
+
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	assert.Equal(t, AuditRPCAdmin, entry["event"])
+}
+
+func TestAuditLogRotation(t *testing.T) {
+	config := &Config{
+		LogDir:        t.TempDir(),
+		LogMaxSize:    1,
+		LogMaxBackups: 1,
+	}
+	closeLog, err := OpenAuditLog(config)
+	assert.NoError(t, err)
+	padding := strings.Repeat("a", 1024)
+	for i := 0; i < 1100; i++ {
+		Audit(AuditRPCAdmin, "method", padding)
+	}
+	assert.NoError(t, closeLog())
+
+	files, err := os.ReadDir(config.LogDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestAuditLimited(t *testing.T) {
+	config := &Config{LogDir: t.TempDir()}
+	closeLog, err := OpenAuditLog(config)
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		AuditLimited(AuditRPCAuthFailure, "10.0.0.1", time.Hour, "addr", "10.0.0.1:1234")
+	}
+	AuditLimited(AuditRPCAuthFailure, "10.0.0.2", time.Hour, "addr", "10.0.0.2:1234")
+	// A zero interval never suppresses and reports the events
+	// dropped since the last entry.
+	AuditLimited(AuditRPCAuthFailure, "10.0.0.1", 0, "addr", "10.0.0.1:1234")
+	assert.NoError(t, closeLog())
+
+	data, err := os.ReadFile(path.Join(config.LogDir, DefaultAuditLogFilename))
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 3)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Nil(t, entry["suppressed"])
+	assert.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+	assert.Equal(t, "10.0.0.1:1234", entry["addr"])
+	assert.Equal(t, float64(4), entry["suppressed"])
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/gcash/bchutil"
 	"github.com/jessevdk/go-flags"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/project-illium/ilxd/params"
 	"io"
 	"net"
 	"os"
@@ -23,6 +24,7 @@ import (
 
 const (
 	DefaultLogFilename    = "ilxd.log"
+	DefaultTraceFilename  = "traces.json"
 	defaultConfigFilename = "ilxd.conf"
 	defaultGrpcPort       = 5001
 
@@ -31,6 +33,11 @@ const (
 	DefaultMaxMessageSize = 1 << 23 // 8 MiB
 	DefaultSoftLimit      = 1 << 20 // 1 MiB
 
+	// Testnet2 uses a relaxed policy so that small faucet
+	// payments can be spent and staked.
+	Testnet2FeePerKilobyte = 100
+	Testnet2MinimumStake   = 10000
+
 	DefaultMaxBanscore = 100
 	DefaultBanDuration = time.Hour * 24
 )
@@ -49,26 +56,54 @@ type Config struct {
 	DataDir            string        `short:"d" long:"datadir" description:"Directory to store data"`
 	LogDir             string        `long:"logdir" description:"Directory to log output"`
 	WalletDir          string        `long:"walletdir" description:"Directory to store wallet data"`
-	LogLevel           string        `short:"l" long:"loglevel" description:"Set the logging level [debug, info, notice, error, alert, critical, emergency]." default:"info"`
+	LogLevel           string        `short:"l" long:"loglevel" description:"Set the logging level [debug, info, warning, error, alert, critical, emergency]. Subsystem levels can be set with a comma separated list such as info,net=debug,sync=warning" default:"info"`
+	LogFormat          string        `long:"logformat" description:"The format of the log output [console, json]" default:"console"`
+	LogOutput          string        `long:"logoutput" description:"Where to write the log output [both, console, file]" default:"both"`
+	LogMaxSize         int           `long:"logmaxsize" description:"The maximum size in megabytes of the log file before it gets rotated" default:"10"`
+	LogMaxAge          int           `long:"logmaxage" description:"The maximum number of days to retain rotated log files" default:"30"`
+	LogMaxBackups      int           `long:"logmaxbackups" description:"The maximum number of rotated log files to retain" default:"3"`
+	LogCompress        bool          `long:"logcompress" description:"Compress rotated log files using gzip"`
+	NoAuditLog         bool          `long:"noauditlog" description:"Disable the security audit log of peer bans, invalid proofs and admin RPC calls"`
 	EnableDebugLogging bool          `long:"debug" description:"Enable libp2p debug logging to the terminal"`
+	Tracing            bool          `long:"tracing" description:"Enable OpenTelemetry tracing of the block and transaction pipelines. Spans are written as JSON to traces.json in the log directory."`
+	TracingSampleRate  float64       `long:"tracingsamplerate" description:"The fraction of traces to record when tracing is enabled" default:"1"`
 	SeedAddrs          []string      `long:"seedaddr" description:"Override the default seed addresses with the provided values"`
 	ListenAddrs        []string      `long:"listenaddr" description:"Override the default listen addresses with the provided values"`
 	Testnet            bool          `short:"t" long:"testnet" description:"Use the test network"`
+	Testnet2           bool          `long:"testnet2" description:"Use the second test network"`
 	Alphanet           bool          `long:"alpha" description:"Use the alpha network"`
 	Regtest            bool          `short:"r" long:"regtest" description:"Use regression testing mode"`
 	RegtestVal         bool          `long:"regtestval" description:"Set self as the regtest genesis validator. This can only be done on first startup."`
+	MockProver         bool          `long:"mockprover" description:"Create and accept fake zk-snark proofs instead of real ones. This can only be used with regtest."`
+	CustomParams       string        `long:"customparams" description:"Path to a JSON file containing the network params for a private devnet"`
 	DisableNATPortMap  bool          `long:"noupnp" description:"Disable use of upnp"`
 	UserAgent          string        `long:"useragent" description:"A custom user agent to advertise to the network"`
 	NoTxIndex          bool          `long:"notxindex" description:"Disable the transaction index"`
 	DropTxIndex        bool          `long:"droptxindex" description:"Delete the tx index from the database"`
+	ValidatorIndex     bool          `long:"validatorindex" description:"Enable the validator index to track the stake history of each validator"`
+	DropValidatorIndex bool          `long:"dropvalidatorindex" description:"Delete the validator index from the database"`
 	WSIndex            bool          `long:"wsindex" description:"Enable the wallet server index to serve lite wallets"`
 	DropWSIndex        bool          `long:"dropwsindex" description:"Delete the wallet server index from the database"`
+	WSIndexScanWorkers int           `long:"wsindexscanworkers" description:"The number of goroutines the wallet server index uses to scan block outputs. Defaults to the number of CPUs."`
+	WSIndexScanBatch   int           `long:"wsindexscanbatch" description:"The number of outputs handed to a wallet server index scan worker at a time" default:"64"`
+	ProverWorkers      int           `long:"proverworkers" description:"The maximum number of zk proofs the node will create at once. Proving is CPU and memory intensive." default:"1"`
+	ProverQueueSize    int           `long:"proverqueuesize" description:"The maximum number of zk proofs waiting for a prover. Requests beyond this are rejected." default:"100"`
+	RemoteProver       string        `long:"remoteprover" description:"The host:port of a remote prover service to create the zk proofs of the node and its wallet. Proofs are still verified locally."`
+	RemoteProverToken  string        `long:"remoteprovertoken" description:"The authentication token of the remote prover service"`
+	RemoteProverCert   string        `long:"remoteprovercert" description:"A path to the TLS certificate of the remote prover service if it is self-signed"`
+	ProverClientCert   string        `long:"remoteproverclientcert" description:"A path to the TLS client certificate used to authenticate to the remote prover service"`
+	ProverClientKey    string        `long:"remoteproverclientkey" description:"A path to the key of the TLS client certificate"`
 	MaxBanscore        uint32        `long:"maxbanscore" description:"The maximum ban score a peer is allowed to have before getting banned" default:"100"`
 	BanDuration        time.Duration `long:"banduration" description:"The duration for which banned peers are banned for" default:"24h"`
 	WalletSeed         string        `long:"walletseed" description:"A mnemonic seed to initialize the node with. This can only be used on first startup."`
 	CoinbaseAddress    string        `long:"coinbaseaddr" description:"An optional address to send all coinbase rewards to. If this option is not used the wallet will automatically select an internal address."`
 	NetworkKey         string        `long:"networkkey" description:"A network key to use for this node. This will override the node's peer ID."`
+	KeystorePassFile   string        `long:"keystorepassfile" description:"A file containing the passphrase used to encrypt the network key. The passphrase may also be set with the ILXD_NETWORK_KEY_PASSPHRASE environment variable."`
+	KeystorePrompt     bool          `long:"keystoreprompt" description:"Prompt for the passphrase used to encrypt the network key at startup"`
 	Prune              bool          `long:"prune" description:"Delete the blockchain from disk. The node will store just the date needed to validate new blocks."`
+	PruneDepth         uint32        `long:"prunedepth" description:"The number of recent blocks a pruned node retains so that it can serve them to syncing peers. Implies --prune."`
+	Checkpoints        []string      `long:"checkpoint" description:"Add a checkpoint in the form <height>:<blockID>. The chain will not accept another block at that height and syncs up to it without verifying the signatures and proofs of its ancestors."`
+	SnapshotSync       bool          `long:"snapshotsync" description:"Start a new node from a snapshot of the chain state committed to by the latest checkpoint rather than syncing from genesis. Requires --notxindex."`
 
 	Policy  Policy     `group:"Policy"`
 	RPCOpts RPCOptions `group:"RPC Options"`
@@ -169,14 +204,35 @@ func LoadConfig() (*Config, error) {
 	if cfg.Alphanet && cfg.Regtest {
 		return nil, errors.New("invalid combination of alphanet and regtest")
 	}
+	if cfg.Testnet2 && (cfg.Testnet || cfg.Regtest || cfg.Alphanet) {
+		return nil, errors.New("testnet2 cannot be combined with another network")
+	}
+	if cfg.CustomParams != "" && (cfg.Testnet || cfg.Testnet2 || cfg.Regtest || cfg.Alphanet) {
+		return nil, errors.New("customparams cannot be combined with another network")
+	}
+	if cfg.MockProver && !cfg.Regtest {
+		return nil, errors.New("mockprover can only be used with regtest")
+	}
+	if cfg.TracingSampleRate < 0 || cfg.TracingSampleRate > 1 {
+		return nil, errors.New("tracingsamplerate must be between 0 and 1")
+	}
 
 	netStr := "mainnet"
 	if cfg.Testnet {
 		netStr = "testnet"
+	} else if cfg.Testnet2 {
+		netStr = "testnet2"
 	} else if cfg.Regtest {
 		netStr = "regtest"
 	} else if cfg.Alphanet {
 		netStr = "alphanet"
+	} else if cfg.CustomParams != "" {
+		cfg.CustomParams = CleanAndExpandPath(cfg.CustomParams)
+		customParams, err := params.LoadCustomParams(cfg.CustomParams)
+		if err != nil {
+			return nil, err
+		}
+		netStr = customParams.Name
 	}
 
 	if cfg.LogDir == "" {
@@ -228,9 +284,15 @@ func LoadConfig() (*Config, error) {
 	cfg.UserAgent = "/ilxd/" + VersionString() + "/" + cfg.UserAgent
 	if cfg.Policy.MinFeePerKilobyte == 0 {
 		cfg.Policy.MinFeePerKilobyte = DefaultFeePerKilobyte
+		if cfg.Testnet2 {
+			cfg.Policy.MinFeePerKilobyte = Testnet2FeePerKilobyte
+		}
 	}
 	if cfg.Policy.MinStake == 0 {
 		cfg.Policy.MinStake = DefaultMinimumStake
+		if cfg.Testnet2 {
+			cfg.Policy.MinStake = Testnet2MinimumStake
+		}
 	}
 	if cfg.Policy.BlocksizeSoftLimit == 0 {
 		cfg.Policy.BlocksizeSoftLimit = DefaultSoftLimit
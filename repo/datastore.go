@@ -39,6 +39,16 @@ const (
 	AccumulatorStateKey = "/ilxd/accumulator/"
 	// AccumulatorCheckpointKey is the datastore key for storing accumulator checkpoints.
 	AccumulatorCheckpointKey = "/ilxd/accumulatorcheckpoint/"
+	// SnapshotKeyPrefix is the datastore key prefix for storing chain state snapshot manifests by block ID.
+	SnapshotKeyPrefix = "/ilxd/snapshot/"
+	// SnapshotChunkKeyPrefix is the datastore key prefix for storing the chunks of a chain state snapshot.
+	SnapshotChunkKeyPrefix = "/ilxd/snapshotchunk/"
+	// SnapshotStateKeyPrefix is the datastore key prefix for storing the chain state captured for a snapshot, less the nullifiers, by block ID.
+	SnapshotStateKeyPrefix = "/ilxd/snapshotstate/"
+	// SnapshotLoadKey is the datastore key used to store the manifest of a snapshot which is being loaded.
+	SnapshotLoadKey = "/ilxd/snapshotload/"
+	// BlockUndoKeyPrefix is the datastore key prefix for storing the undo data for a block.
+	BlockUndoKeyPrefix = "/ilxd/blockundo/"
 	// CoinSupplyKey is the datastore key for storing the current supply of coins.
 	CoinSupplyKey = "/ilxd/coinsupply/"
 	// IndexerHeightKeyPrefix is the datastore key prefix for mapping indexers to sync heights.
@@ -51,8 +61,14 @@ const (
 	AutostakeDatastoreKey = "/ilxd/autostake/"
 	// PrunedBlockchainDatastoreKey is the datastore key used to store a flag setting whether the chain has ever been pruned.
 	PrunedBlockchainDatastoreKey = "/ilxd/pruned/"
+	// TxoRootHeightsKey is the datastore key used to store the heights of the blocks which created the recent txo roots.
+	TxoRootHeightsKey = "/ilxd/txorootheights/"
 	// CachedAddrInfoDatastoreKey is the datastore key used to persist addrinfos from the peerstore.
 	CachedAddrInfoDatastoreKey = "/ilxd/peerstore/addrinfo/"
+	// WalletAccountKeyPrefix is the datastore key prefix used to store wallet accounts.
+	WalletAccountKeyPrefix = "/ilxd/walletaccount/"
+	// FinalityCertificateKeyPrefix is the datastore key prefix for storing finality certificates by height.
+	FinalityCertificateKeyPrefix = "/ilxd/finalitycertificate/"
 )
 
 type Datastore interface {
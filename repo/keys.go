@@ -9,28 +9,82 @@ import (
 	"crypto/rand"
 	datastore "github.com/ipfs/go-datastore"
 	crypto "github.com/libp2p/go-libp2p/core/crypto"
+	icrypto "github.com/project-illium/ilxd/crypto"
 )
 
 func HasNetworkKey(ds Datastore) (bool, error) {
 	return ds.Has(context.Background(), datastore.NewKey(NetworkKeyDatastoreKey))
 }
 
-func LoadNetworkKey(ds Datastore) (crypto.PrivKey, error) {
+// IsNetworkKeyEncrypted returns whether the network key is stored
+// in the encrypted keystore format.
+func IsNetworkKeyEncrypted(ds Datastore) (bool, error) {
+	keyBytes, err := ds.Get(context.Background(), datastore.NewKey(NetworkKeyDatastoreKey))
+	if err != nil {
+		return false, err
+	}
+	return IsEncryptedKey(keyBytes), nil
+}
+
+// LoadNetworkKey loads the network key from the datastore. If the key is
+// encrypted the passphrase is used to decrypt it. Keys stored in the old
+// plaintext format load without a passphrase.
+func LoadNetworkKey(ds Datastore, passphrase []byte) (crypto.PrivKey, error) {
 	keyBytes, err := ds.Get(context.Background(), datastore.NewKey(NetworkKeyDatastoreKey))
 	if err != nil {
 		return nil, err
 	}
+	if IsEncryptedKey(keyBytes) {
+		if len(passphrase) == 0 {
+			return nil, ErrKeystoreLocked
+		}
+		keyBytes, err = DecryptKey(keyBytes, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		defer icrypto.Zeroize(keyBytes)
+	}
 	return crypto.UnmarshalPrivateKey(keyBytes)
 }
 
-func PutNetworkKey(ds Datastore, key crypto.PrivKey) error {
+// PutNetworkKey saves the network key to the datastore. If a passphrase
+// is provided the key is encrypted, otherwise it is stored in plaintext.
+func PutNetworkKey(ds Datastore, key crypto.PrivKey, passphrase []byte) error {
 	keyBytes, err := crypto.MarshalPrivateKey(key)
 	if err != nil {
 		return err
 	}
+	if len(passphrase) > 0 {
+		plaintext := keyBytes
+		keyBytes, err = EncryptKey(plaintext, passphrase)
+		icrypto.Zeroize(plaintext)
+		if err != nil {
+			return err
+		}
+	}
 	return ds.Put(context.Background(), datastore.NewKey(NetworkKeyDatastoreKey), keyBytes)
 }
 
+// MigrateNetworkKey encrypts a network key that is stored in the old
+// plaintext format. It returns true if the key was migrated.
+func MigrateNetworkKey(ds Datastore, passphrase []byte) (bool, error) {
+	encrypted, err := IsNetworkKeyEncrypted(ds)
+	if err != nil {
+		return false, err
+	}
+	if encrypted || len(passphrase) == 0 {
+		return false, nil
+	}
+	key, err := LoadNetworkKey(ds, nil)
+	if err != nil {
+		return false, err
+	}
+	if err := PutNetworkKey(ds, key, passphrase); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func GenerateNetworkKeypair() (crypto.PrivKey, crypto.PubKey, error) {
 	privkey, _, err := crypto.GenerateEd25519Key(rand.Reader)
 	if err != nil {
@@ -0,0 +1,105 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptedNetworkKey(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	passphrase := []byte("correct horse battery staple")
+
+	key, _, err := repo.GenerateNetworkKeypair()
+	assert.NoError(t, err)
+	assert.NoError(t, repo.PutNetworkKey(ds, key, passphrase))
+
+	encrypted, err := repo.IsNetworkKeyEncrypted(ds)
+	assert.NoError(t, err)
+	assert.True(t, encrypted)
+
+	key2, err := repo.LoadNetworkKey(ds, passphrase)
+	assert.NoError(t, err)
+	assert.True(t, key.Equals(key2))
+
+	_, err = repo.LoadNetworkKey(ds, nil)
+	assert.ErrorIs(t, err, repo.ErrKeystoreLocked)
+
+	_, err = repo.LoadNetworkKey(ds, []byte("wrong"))
+	assert.ErrorIs(t, err, repo.ErrInvalidPassphrase)
+
+	// Tampering with the header invalidates the ciphertext.
+	data, err := ds.Get(context.Background(), datastore.NewKey(repo.NetworkKeyDatastoreKey))
+	assert.NoError(t, err)
+	data[len("ilxks")+4]++
+	_, err = repo.DecryptKey(data, passphrase)
+	assert.Error(t, err)
+
+	_, err = repo.DecryptKey(data[:10], passphrase)
+	assert.ErrorIs(t, err, repo.ErrMalformedKeystore)
+}
+
+func TestMigrateNetworkKey(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	passphrase := []byte("passphrase")
+
+	key, _, err := repo.GenerateNetworkKeypair()
+	assert.NoError(t, err)
+	assert.NoError(t, repo.PutNetworkKey(ds, key, nil))
+
+	encrypted, err := repo.IsNetworkKeyEncrypted(ds)
+	assert.NoError(t, err)
+	assert.False(t, encrypted)
+
+	// Plaintext keys load with or without a passphrase.
+	key2, err := repo.LoadNetworkKey(ds, passphrase)
+	assert.NoError(t, err)
+	assert.True(t, key.Equals(key2))
+
+	migrated, err := repo.MigrateNetworkKey(ds, nil)
+	assert.NoError(t, err)
+	assert.False(t, migrated)
+
+	migrated, err = repo.MigrateNetworkKey(ds, passphrase)
+	assert.NoError(t, err)
+	assert.True(t, migrated)
+
+	encrypted, err = repo.IsNetworkKeyEncrypted(ds)
+	assert.NoError(t, err)
+	assert.True(t, encrypted)
+
+	key3, err := repo.LoadNetworkKey(ds, passphrase)
+	assert.NoError(t, err)
+	assert.True(t, key.Equals(key3))
+
+	migrated, err = repo.MigrateNetworkKey(ds, passphrase)
+	assert.NoError(t, err)
+	assert.False(t, migrated)
+}
+
+func TestLoadKeystorePassphrase(t *testing.T) {
+	passphrase, err := repo.LoadKeystorePassphrase("", false)
+	assert.NoError(t, err)
+	assert.Nil(t, passphrase)
+
+	path := filepath.Join(t.TempDir(), "passphrase")
+	assert.NoError(t, os.WriteFile(path, []byte("from file\n"), 0600))
+	passphrase, err = repo.LoadKeystorePassphrase(path, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("from file"), passphrase)
+
+	t.Setenv(repo.KeystorePassphraseEnv, "from env")
+	passphrase, err = repo.LoadKeystorePassphrase(path, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("from env"), passphrase)
+}
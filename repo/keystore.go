@@ -0,0 +1,219 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/term"
+)
+
+const (
+	// KeystorePassphraseEnv is the environment variable that is checked
+	// for the network key passphrase.
+	KeystorePassphraseEnv = "ILXD_NETWORK_KEY_PASSPHRASE"
+
+	keystoreVersion = 1
+	keystoreSaltLen = 16
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+)
+
+// keystoreMagic prefixes an encrypted key. The plaintext format is a
+// serialized libp2p key which always starts with 0x08 so the two can be
+// told apart.
+var keystoreMagic = []byte("ilxks")
+
+var (
+	// ErrKeystoreLocked is returned when loading an encrypted key without
+	// a passphrase.
+	ErrKeystoreLocked = errors.New("network key is encrypted and no passphrase was provided")
+
+	// ErrInvalidPassphrase is returned when the key fails to decrypt.
+	ErrInvalidPassphrase = errors.New("invalid network key passphrase")
+
+	// ErrMalformedKeystore is returned when the encrypted key does not
+	// decode.
+	ErrMalformedKeystore = errors.New("malformed keystore")
+)
+
+// keystoreParams are the Argon2id parameters used to derive the
+// encryption key. They are stored alongside the ciphertext so they can
+// be raised in the future without breaking existing keystores.
+type keystoreParams struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+// IsEncryptedKey returns whether the key bytes are in the encrypted
+// keystore format.
+func IsEncryptedKey(data []byte) bool {
+	return bytes.HasPrefix(data, keystoreMagic)
+}
+
+// EncryptKey encrypts the serialized key with a key derived from the
+// passphrase using Argon2id.
+//
+// The format is:
+// magic || version || time || memory || threads || salt || nonce || ciphertext
+func EncryptKey(keyBytes []byte, passphrase []byte) ([]byte, error) {
+	params := keystoreParams{
+		time:    argon2Time,
+		memory:  argon2Memory,
+		threads: argon2Threads,
+	}
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(deriveKeystoreKey(passphrase, salt, params))
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(keystoreMagic)+10+len(salt)+len(nonce))
+	header = append(header, keystoreMagic...)
+	header = append(header, keystoreVersion)
+	header = binary.BigEndian.AppendUint32(header, params.time)
+	header = binary.BigEndian.AppendUint32(header, params.memory)
+	header = append(header, params.threads)
+	header = append(header, salt...)
+	header = append(header, nonce...)
+
+	// The header is authenticated so the parameters cannot be
+	// tampered with.
+	return aead.Seal(header, nonce, keyBytes, header), nil
+}
+
+// DecryptKey decrypts a key that was encrypted with EncryptKey.
+func DecryptKey(data []byte, passphrase []byte) ([]byte, error) {
+	if !IsEncryptedKey(data) {
+		return nil, ErrMalformedKeystore
+	}
+	r := bytes.NewReader(data[len(keystoreMagic):])
+	var (
+		version uint8
+		params  keystoreParams
+	)
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	if version != keystoreVersion {
+		return nil, fmt.Errorf("%w: unknown version %d", ErrMalformedKeystore, version)
+	}
+	if err := binary.Read(r, binary.BigEndian, &params.time); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	if err := binary.Read(r, binary.BigEndian, &params.memory); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	if err := binary.Read(r, binary.BigEndian, &params.threads); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	if params.time == 0 || params.threads == 0 {
+		return nil, ErrMalformedKeystore
+	}
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+	if _, err := io.ReadFull(r, nonce); err != nil {
+		return nil, ErrMalformedKeystore
+	}
+	headerLen := len(data) - r.Len()
+
+	aead, err := chacha20poly1305.NewX(deriveKeystoreKey(passphrase, salt, params))
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, data[headerLen:], data[:headerLen])
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+	return plaintext, nil
+}
+
+func deriveKeystoreKey(passphrase, salt []byte, params keystoreParams) []byte {
+	return argon2.IDKey(passphrase, salt, params.time, params.memory, params.threads, chacha20poly1305.KeySize)
+}
+
+// LoadKeystorePassphrase returns the passphrase used to encrypt the
+// network key. The sources are checked in order:
+//  1. The ILXD_NETWORK_KEY_PASSPHRASE environment variable.
+//  2. The passphrase file, if a path is provided.
+//  3. A prompt on stdin, if prompt is true.
+//
+// If no source provides a passphrase nil is returned. The caller should
+// zero the passphrase once it is no longer needed.
+func LoadKeystorePassphrase(passphraseFile string, prompt bool) ([]byte, error) {
+	if env := os.Getenv(KeystorePassphraseEnv); env != "" {
+		return []byte(env), nil
+	}
+	if passphraseFile != "" {
+		data, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, err
+		}
+		passphrase := bytes.TrimRight(data, "\r\n")
+		if len(passphrase) == 0 {
+			return nil, fmt.Errorf("passphrase file %s is empty", passphraseFile)
+		}
+		return passphrase, nil
+	}
+	if prompt {
+		return promptPassphrase(os.Stdin, os.Stdout)
+	}
+	return nil, nil
+}
+
+// promptPassphrase reads the passphrase from stdin. If stdin is a
+// terminal echo is disabled while the passphrase is typed.
+func promptPassphrase(in *os.File, out io.Writer) ([]byte, error) {
+	fmt.Fprint(out, "Enter network key passphrase: ")
+	var (
+		passphrase []byte
+		err        error
+	)
+	if term.IsTerminal(int(in.Fd())) {
+		passphrase, err = term.ReadPassword(int(in.Fd()))
+		fmt.Fprintln(out)
+	} else {
+		passphrase, err = readPassphraseLine(in)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	return passphrase, nil
+}
+
+// readPassphraseLine reads a single line from a non-terminal reader
+// such as a pipe.
+func readPassphraseLine(in io.Reader) ([]byte, error) {
+	line, err := bufio.NewReader(in).ReadBytes('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && len(line) > 0) {
+		return nil, err
+	}
+	return bytes.TrimRight(line, "\r\n"), nil
+}
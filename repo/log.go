@@ -4,10 +4,28 @@
 
 package repo
 
-import "go.uber.org/zap"
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
 
 var log = zap.S()
 
 func UpdateLogger() {
-	log = zap.S()
+	log = zap.S().Named("repo")
+}
+
+// RateLimitedLogger wraps the logger such that at most burst entries with
+// the same level and message are logged per interval. The rest are dropped.
+//
+// This is intended for log lines in per-message paths, such as gossip
+// validation failures, which a misbehaving peer could otherwise use to
+// flood the log. Because the entries are grouped by message, the variable
+// parts of the line must be passed as structured fields.
+func RateLimitedLogger(logger *zap.SugaredLogger, interval time.Duration, burst int) *zap.SugaredLogger {
+	return logger.Desugar().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewSamplerWithOptions(core, interval, burst, 0)
+	})).Sugar()
 }
@@ -0,0 +1,32 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRateLimitedLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := RateLimitedLogger(zap.New(core).Sugar(), time.Hour, 3)
+
+	for i := 0; i < 10; i++ {
+		logger.Debugw("Block reject. Rule error", LogFieldPeerID, i)
+		logger.Infow("Increased peer banscore", LogFieldPeerID, i)
+	}
+	assert.Equal(t, 3, logs.FilterMessage("Block reject. Rule error").Len())
+	assert.Equal(t, 3, logs.FilterMessage("Increased peer banscore").Len())
+
+	// Entries are limited per level so a warning isn't hidden by
+	// debug entries with the same message.
+	logger.Warnw("Block reject. Rule error")
+	assert.Equal(t, 4, logs.FilterMessage("Block reject. Rule error").Len())
+}
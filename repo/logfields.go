@@ -0,0 +1,16 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package repo
+
+// The following are the keys used for structured log fields. Every
+// subsystem uses the same keys so that logs can be filtered on a field
+// no matter which subsystem emitted them.
+const (
+	LogFieldPeerID  = "peer_id"
+	LogFieldBlockID = "block_id"
+	LogFieldHeight  = "height"
+	LogFieldTxID    = "tx_id"
+	LogFieldError   = "error"
+)
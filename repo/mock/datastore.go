@@ -10,12 +10,16 @@ import (
 	datastore "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
 	"github.com/project-illium/ilxd/repo"
+	"sync"
 )
 
 var _ repo.Datastore = (*MapDatastore)(nil)
 
+// MapDatastore is an in memory datastore. Unlike the go-datastore
+// MapDatastore it is safe for concurrent access.
 type MapDatastore struct {
 	datastore.MapDatastore
+	mtx sync.RWMutex
 }
 
 func NewMapDatastore() *MapDatastore {
@@ -23,6 +27,46 @@ func NewMapDatastore() *MapDatastore {
 	return &MapDatastore{MapDatastore: *ds}
 }
 
+func (ds *MapDatastore) Put(ctx context.Context, key datastore.Key, value []byte) error {
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	return ds.MapDatastore.Put(ctx, key, value)
+}
+
+func (ds *MapDatastore) Get(ctx context.Context, key datastore.Key) ([]byte, error) {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+	return ds.MapDatastore.Get(ctx, key)
+}
+
+func (ds *MapDatastore) Has(ctx context.Context, key datastore.Key) (bool, error) {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+	return ds.MapDatastore.Has(ctx, key)
+}
+
+func (ds *MapDatastore) GetSize(ctx context.Context, key datastore.Key) (int, error) {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+	return ds.MapDatastore.GetSize(ctx, key)
+}
+
+func (ds *MapDatastore) Delete(ctx context.Context, key datastore.Key) error {
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	return ds.MapDatastore.Delete(ctx, key)
+}
+
+func (ds *MapDatastore) Query(ctx context.Context, q query.Query) (query.Results, error) {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+	return ds.MapDatastore.Query(ctx, q)
+}
+
+func (ds *MapDatastore) Batch(ctx context.Context) (datastore.Batch, error) {
+	return datastore.NewBasicBatch(ds), nil
+}
+
 func (ds *MapDatastore) DiskUsage(ctx context.Context) (uint64, error) {
 	return 0, nil
 }
@@ -6,8 +6,11 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/blockchain/indexers"
+	"github.com/project-illium/ilxd/consensus"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/rpc/pb"
 	"github.com/project-illium/ilxd/types"
@@ -21,20 +24,48 @@ const maxBatchSize = 2000
 
 // GetMempoolInfo returns the state of the current mempool
 func (s *GrpcServer) GetMempoolInfo(ctx context.Context, req *pb.GetMempoolInfoRequest) (*pb.GetMempoolInfoResponse, error) {
+	size, bytes, err := s.mempoolStats()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetMempoolInfoResponse{
+		Size:  uint32(size),
+		Bytes: uint32(bytes),
+	}, nil
+}
+
+// mempoolStats returns the number of transactions in the mempool
+// and their total size in bytes.
+func (s *GrpcServer) mempoolStats() (int, int, error) {
 	size := 0
 	bytes := 0
 	for _, tx := range s.txMemPool.GetTransactions() {
 		size++
 		n, err := tx.SerializedSize()
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return 0, 0, err
 		}
 		bytes += n
 	}
-	return &pb.GetMempoolInfoResponse{
-		Size:  uint32(size),
-		Bytes: uint32(bytes),
-	}, nil
+	return size, bytes, nil
+}
+
+// networkType returns the network the node is operating on.
+func (s *GrpcServer) networkType() pb.GetBlockchainInfoResponse_Network {
+	switch s.chainParams.Name {
+	case params.MainnetParams.Name:
+		return pb.GetBlockchainInfoResponse_MAINNET
+	case params.Testnet1Params.Name:
+		return pb.GetBlockchainInfoResponse_TESTNET
+	case params.Testnet2Params.Name:
+		return pb.GetBlockchainInfoResponse_TESTNET2
+	case params.RegestParams.Name:
+		return pb.GetBlockchainInfoResponse_REGTEST
+	case params.AlphanetParams.Name:
+		return pb.GetBlockchainInfoResponse_ALPHANET
+	default:
+		return pb.GetBlockchainInfoResponse_CUSTOM
+	}
 }
 
 // GetMempool returns all the transactions in the mempool
@@ -66,20 +97,6 @@ func (s *GrpcServer) GetMempool(ctx context.Context, req *pb.GetMempoolRequest)
 
 // GetBlockchainInfo returns data about the blockchain including the most recent block hash and height.
 func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchainInfoRequest) (*pb.GetBlockchainInfoResponse, error) {
-	var nt pb.GetBlockchainInfoResponse_Network
-	switch s.chainParams.Name {
-	case params.MainnetParams.Name:
-		nt = pb.GetBlockchainInfoResponse_MAINNET
-	case params.Testnet1Params.Name:
-		nt = pb.GetBlockchainInfoResponse_TESTNET
-	case params.RegestParams.Name:
-		nt = pb.GetBlockchainInfoResponse_REGTEST
-	case params.AlphanetParams.Name:
-		nt = pb.GetBlockchainInfoResponse_ALPHANET
-	default:
-		return nil, status.Error(codes.Internal, "unknown network params")
-	}
-
 	id, height, ts := s.chain.BestBlock()
 
 	currentSupply, err := s.chain.CurrentSupply()
@@ -94,7 +111,7 @@ func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchai
 	}
 
 	return &pb.GetBlockchainInfoResponse{
-		Network:           nt,
+		Network:           s.networkType(),
 		BestHeight:        height,
 		BestBlock_ID:      id[:],
 		BlockTime:         ts.Unix(),
@@ -236,11 +253,7 @@ func (s *GrpcServer) GetCompressedBlocks(ctx context.Context, req *pb.GetCompres
 		endHeight = bestHeight
 	}
 	blks := make([]*blocks.CompressedBlock, 0, endHeight-req.StartHeight+1)
-	for i := req.StartHeight; i <= endHeight; i++ {
-		blk, err := s.chain.GetBlockByHeight(i)
-		if err != nil {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
+	err := s.chain.IterateBlocks(req.StartHeight, endHeight, func(blk *blocks.Block) error {
 		cb := &blocks.CompressedBlock{
 			Height: blk.Header.Height,
 			Txs:    make([]*blocks.CompressedBlock_CompressedTx, 0, len(blk.Transactions)),
@@ -257,6 +270,10 @@ func (s *GrpcServer) GetCompressedBlocks(ctx context.Context, req *pb.GetCompres
 			})
 		}
 		blks = append(blks, cb)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	return &pb.GetCompressedBlocksResponse{
 		Blocks: blks,
@@ -370,6 +387,42 @@ func (s *GrpcServer) GetValidator(ctx context.Context, req *pb.GetValidatorReque
 	return resp, nil
 }
 
+// GetValidatorHistory returns every stake, unstake and coinbase event for the
+// given validator.
+//
+// **Requires ValidatorIndex**
+func (s *GrpcServer) GetValidatorHistory(ctx context.Context, req *pb.GetValidatorHistoryRequest) (*pb.GetValidatorHistoryResponse, error) {
+	if s.validatorIndex == nil {
+		return nil, status.Error(codes.Unavailable, "validator index is not available")
+	}
+	pid, err := peer.IDFromBytes(req.Validator_ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := s.validatorIndex.GetValidatorHistory(s.ds, pid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetValidatorHistoryResponse{
+		Events: make([]*pb.ValidatorEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		e := &pb.ValidatorEvent{
+			Type:           pb.ValidatorEvent_Type(event.Type),
+			Height:         event.Height,
+			Transaction_ID: event.Txid[:],
+			Amount:         uint64(event.Amount),
+		}
+		if event.Type != indexers.ValidatorEventCoinbase {
+			e.Nullifier = event.Nullifier[:]
+		}
+		resp.Events = append(resp.Events, e)
+	}
+	return resp, nil
+}
+
 // GetValidatorSetInfo returns information about the validator set.
 func (s *GrpcServer) GetValidatorSetInfo(ctx context.Context, req *pb.GetValidatorSetInfoRequest) (*pb.GetValidatorSetInfoResponse, error) {
 	return &pb.GetValidatorSetInfoResponse{
@@ -433,6 +486,40 @@ func (s *GrpcServer) GetAccumulatorCheckpoint(ctx context.Context, req *pb.GetAc
 	}, nil
 }
 
+// GetFinalityCertificate returns the finality certificate for the block at the requested height.
+// Certificates are only available for blocks this node has finalized itself through avalanche.
+func (s *GrpcServer) GetFinalityCertificate(ctx context.Context, req *pb.GetFinalityCertificateRequest) (*pb.GetFinalityCertificateResponse, error) {
+	if s.certificateFunc == nil {
+		return nil, status.Error(codes.Unavailable, "finality certificates are not available")
+	}
+	cert, err := s.certificateFunc(req.Height)
+	if errors.Is(err, consensus.ErrCertificateNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	producer, err := cert.Producer.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.GetFinalityCertificateResponse{
+		Height:      cert.Height,
+		Block_ID:    cert.BlockID[:],
+		Producer_ID: producer,
+		SigHash:     cert.SigHash,
+		Validators:  make([][]byte, 0, len(cert.Validators)),
+		Signature:   cert.Signature,
+	}
+	for _, pid := range cert.Validators {
+		b, err := pid.Marshal()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Validators = append(resp.Validators, b)
+	}
+	return resp, nil
+}
+
 // SubmitTransaction validates a transaction and submits it to the network. An error will be returned if it fails validation.
 func (s *GrpcServer) SubmitTransaction(ctx context.Context, req *pb.SubmitTransactionRequest) (*pb.SubmitTransactionResponse, error) {
 	err := s.broadcastTxFunc(req.Transaction)
@@ -6,14 +6,19 @@ package rpc
 
 import (
 	"context"
+	"math/rand"
+	"runtime"
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/crypto"
+	inet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/rpc/pb"
 	"github.com/project-illium/ilxd/types"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"math/rand"
 )
 
 // GetHostInfo returns info about the libp2p host
@@ -115,7 +120,8 @@ func (s *GrpcServer) UnblockPeer(ctx context.Context, req *pb.UnblockPeerRequest
 	return &pb.UnblockPeerResponse{}, nil
 }
 
-// SetLogLevel changes the logging level of the node
+// SetLogLevel changes the logging level of the node. If a subsystem is
+// provided only the level of that subsystem is changed.
 func (s *GrpcServer) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
 	var logLevelSeverity = map[pb.SetLogLevelRequest_Level]zapcore.Level{
 		pb.SetLogLevelRequest_DEBUG:     zapcore.DebugLevel,
@@ -130,7 +136,9 @@ func (s *GrpcServer) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest
 	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "unknown log level")
 	}
-	s.setLogLevelFunc(level)
+	if err := s.setLogLevelFunc(req.Subsystem, level); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	return &pb.SetLogLevelResponse{}, nil
 }
 
@@ -229,3 +237,72 @@ func (s *GrpcServer) RecomputeChainState(ctx context.Context, req *pb.RecomputeC
 	go s.reindexChainFunc() //nolint:errcheck
 	return &pb.RecomputeChainStateResponse{}, nil
 }
+
+// GetNodeStatus returns a summary of the node's state in a single call. It
+// is intended to drive a dashboard or status command.
+func (s *GrpcServer) GetNodeStatus(ctx context.Context, req *pb.GetNodeStatusRequest) (*pb.GetNodeStatusResponse, error) {
+	id, height, ts := s.chain.BestBlock()
+
+	peers := &pb.GetNodeStatusResponse_PeerSummary{
+		Banned: uint32(len(s.network.ConnGater().ListBlockedPeers())),
+	}
+	for _, p := range s.network.Host().Network().Peers() {
+		peers.Connected++
+		if s.chain.ValidatorExists(p) {
+			peers.Validators++
+		}
+	}
+	for _, conn := range s.network.Host().Network().Conns() {
+		switch conn.Stat().Direction {
+		case inet.DirInbound:
+			peers.Inbound++
+		case inet.DirOutbound:
+			peers.Outbound++
+		}
+	}
+
+	size, bytes, err := s.mempoolStats()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validatorStatus := &pb.GetNodeStatusResponse_ValidatorStatus{
+		TotalStaked: uint64(s.chain.TotalStaked()),
+	}
+	if validator, err := s.chain.GetValidator(s.network.Host().ID()); err == nil {
+		validatorStatus.IsValidator = true
+		validatorStatus.Stake = uint64(validator.TotalStake)
+		validatorStatus.UnclaimedCoins = uint64(validator.UnclaimedCoins)
+		validatorStatus.EpochBlocks = validator.EpochBlocks
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	synced := false
+	if s.isCurrentFunc != nil {
+		synced = s.isCurrentFunc()
+	}
+
+	return &pb.GetNodeStatusResponse{
+		Version:      repo.VersionString(),
+		Uptime:       int64(time.Since(s.startTime).Seconds()),
+		Network:      s.networkType(),
+		Synced:       synced,
+		BestHeight:   height,
+		BestBlock_ID: id[:],
+		BlockTime:    ts.Unix(),
+		Peers:        peers,
+		Mempool: &pb.GetNodeStatusResponse_MempoolSummary{
+			Size:  uint32(size),
+			Bytes: uint32(bytes),
+		},
+		Validator: validatorStatus,
+		Resources: &pb.GetNodeStatusResponse_ResourceUsage{
+			Goroutines: uint32(runtime.NumGoroutine()),
+			HeapAlloc:  memStats.HeapAlloc,
+			SysMemory:  memStats.Sys,
+			NumCpu:     uint32(runtime.NumCPU()),
+		},
+	}, nil
+}
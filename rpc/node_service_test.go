@@ -0,0 +1,86 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/net"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/rpc/pb"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNodeStatus(t *testing.T) {
+	mn := mocknet.New()
+	newNetwork := func() *net.Network {
+		host, err := mn.GenPeer()
+		assert.NoError(t, err)
+		network, err := net.NewNetwork(context.Background(), []net.Option{
+			net.WithHost(host),
+			net.Params(&params.RegestParams),
+			net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
+				return nil
+			}),
+			net.Datastore(mock.NewMapDatastore()),
+			net.MaxMessageSize(repo.DefaultMaxMessageSize),
+		}...)
+		assert.NoError(t, err)
+		return network
+	}
+
+	chain, err := blockchain.NewBlockchain(blockchain.DefaultOptions())
+	assert.NoError(t, err)
+	mpool, err := mempool.NewMempool(mempool.DefaultOptions(), mempool.BlockchainView(chain))
+	assert.NoError(t, err)
+
+	network := newNetwork()
+	s := &GrpcServer{
+		chain:       chain,
+		chainParams: &params.RegestParams,
+		network:     network,
+		txMemPool:   mpool,
+		startTime:   time.Now(),
+	}
+
+	resp, err := s.GetNodeStatus(context.Background(), &pb.GetNodeStatusRequest{})
+	assert.NoError(t, err)
+	genesisID := params.RegestParams.GenesisBlock.ID()
+	assert.Equal(t, genesisID[:], resp.BestBlock_ID)
+	assert.Equal(t, uint32(0), resp.BestHeight)
+	assert.Equal(t, pb.GetBlockchainInfoResponse_REGTEST, resp.Network)
+	assert.False(t, resp.Synced)
+	assert.Equal(t, uint32(0), resp.Peers.Connected)
+	assert.Equal(t, uint32(0), resp.Mempool.Size)
+	assert.False(t, resp.Validator.IsValidator)
+	assert.Equal(t, uint64(chain.TotalStaked()), resp.Validator.TotalStaked)
+	assert.Greater(t, resp.Resources.Goroutines, uint32(0))
+
+	// Connect a peer and report the node as synced.
+	network2 := newNetwork()
+	assert.NoError(t, mn.LinkAll())
+	_, err = mn.ConnectPeers(network.Host().ID(), network2.Host().ID())
+	assert.NoError(t, err)
+	s.isCurrentFunc = func() bool { return true }
+
+	resp, err = s.GetNodeStatus(context.Background(), &pb.GetNodeStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.Synced)
+	assert.Equal(t, uint32(1), resp.Peers.Connected)
+	assert.Equal(t, uint32(1), resp.Peers.Outbound)
+}
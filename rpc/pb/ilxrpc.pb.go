@@ -35,6 +35,10 @@ const (
 	GetBlockchainInfoResponse_TESTNET GetBlockchainInfoResponse_Network = 2
 	// Alpha testnet
 	GetBlockchainInfoResponse_ALPHANET GetBlockchainInfoResponse_Network = 3
+	// A private network using parameters loaded from a file
+	GetBlockchainInfoResponse_CUSTOM GetBlockchainInfoResponse_Network = 4
+	// Second public test network used to test protocol upgrades
+	GetBlockchainInfoResponse_TESTNET2 GetBlockchainInfoResponse_Network = 5
 )
 
 // Enum value maps for GetBlockchainInfoResponse_Network.
@@ -44,12 +48,16 @@ var (
 		1: "REGTEST",
 		2: "TESTNET",
 		3: "ALPHANET",
+		4: "CUSTOM",
+		5: "TESTNET2",
 	}
 	GetBlockchainInfoResponse_Network_value = map[string]int32{
 		"MAINNET":  0,
 		"REGTEST":  1,
 		"TESTNET":  2,
 		"ALPHANET": 3,
+		"CUSTOM":   4,
+		"TESTNET2": 5,
 	}
 )
 
@@ -80,6 +88,52 @@ func (GetBlockchainInfoResponse_Network) EnumDescriptor() ([]byte, []int) {
 	return file_ilxrpc_proto_rawDescGZIP(), []int{5, 0}
 }
 
+type ExportTransactionHistoryRequest_Format int32
+
+const (
+	ExportTransactionHistoryRequest_CSV  ExportTransactionHistoryRequest_Format = 0
+	ExportTransactionHistoryRequest_JSON ExportTransactionHistoryRequest_Format = 1
+)
+
+// Enum value maps for ExportTransactionHistoryRequest_Format.
+var (
+	ExportTransactionHistoryRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+	}
+	ExportTransactionHistoryRequest_Format_value = map[string]int32{
+		"CSV":  0,
+		"JSON": 1,
+	}
+)
+
+func (x ExportTransactionHistoryRequest_Format) Enum() *ExportTransactionHistoryRequest_Format {
+	p := new(ExportTransactionHistoryRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportTransactionHistoryRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportTransactionHistoryRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportTransactionHistoryRequest_Format) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[1]
+}
+
+func (x ExportTransactionHistoryRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportTransactionHistoryRequest_Format.Descriptor instead.
+func (ExportTransactionHistoryRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{59, 0}
+}
+
 type SetLogLevelRequest_Level int32
 
 const (
@@ -125,11 +179,11 @@ func (x SetLogLevelRequest_Level) String() string {
 }
 
 func (SetLogLevelRequest_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_ilxrpc_proto_enumTypes[1].Descriptor()
+	return file_ilxrpc_proto_enumTypes[2].Descriptor()
 }
 
 func (SetLogLevelRequest_Level) Type() protoreflect.EnumType {
-	return &file_ilxrpc_proto_enumTypes[1]
+	return &file_ilxrpc_proto_enumTypes[2]
 }
 
 func (x SetLogLevelRequest_Level) Number() protoreflect.EnumNumber {
@@ -138,7 +192,59 @@ func (x SetLogLevelRequest_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SetLogLevelRequest_Level.Descriptor instead.
 func (SetLogLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{111, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{127, 0}
+}
+
+type ValidatorEvent_Type int32
+
+const (
+	ValidatorEvent_STAKE    ValidatorEvent_Type = 0
+	ValidatorEvent_RESTAKE  ValidatorEvent_Type = 1
+	ValidatorEvent_UNSTAKE  ValidatorEvent_Type = 2
+	ValidatorEvent_COINBASE ValidatorEvent_Type = 3
+)
+
+// Enum value maps for ValidatorEvent_Type.
+var (
+	ValidatorEvent_Type_name = map[int32]string{
+		0: "STAKE",
+		1: "RESTAKE",
+		2: "UNSTAKE",
+		3: "COINBASE",
+	}
+	ValidatorEvent_Type_value = map[string]int32{
+		"STAKE":    0,
+		"RESTAKE":  1,
+		"UNSTAKE":  2,
+		"COINBASE": 3,
+	}
+)
+
+func (x ValidatorEvent_Type) Enum() *ValidatorEvent_Type {
+	p := new(ValidatorEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ValidatorEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidatorEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ValidatorEvent_Type) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[3]
+}
+
+func (x ValidatorEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidatorEvent_Type.Descriptor instead.
+func (ValidatorEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{159, 0}
 }
 
 // BlockchainService
@@ -1603,6 +1709,102 @@ func (x *GetValidatorSetResponse) GetValidators() []*Validator {
 	return nil
 }
 
+type GetValidatorHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A serialized validator ID
+	Validator_ID []byte `protobuf:"bytes,1,opt,name=validator_ID,json=validatorID,proto3" json:"validator_ID,omitempty"`
+}
+
+func (x *GetValidatorHistoryRequest) Reset() {
+	*x = GetValidatorHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorHistoryRequest) ProtoMessage() {}
+
+func (x *GetValidatorHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetValidatorHistoryRequest) GetValidator_ID() []byte {
+	if x != nil {
+		return x.Validator_ID
+	}
+	return nil
+}
+
+type GetValidatorHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events for the validator in the order they occurred
+	Events []*ValidatorEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetValidatorHistoryResponse) Reset() {
+	*x = GetValidatorHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorHistoryResponse) ProtoMessage() {}
+
+func (x *GetValidatorHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetValidatorHistoryResponse) GetEvents() []*ValidatorEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 type GetAccumulatorCheckpointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1618,7 +1820,7 @@ type GetAccumulatorCheckpointRequest struct {
 func (x *GetAccumulatorCheckpointRequest) Reset() {
 	*x = GetAccumulatorCheckpointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[26]
+		mi := &file_ilxrpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1631,7 +1833,7 @@ func (x *GetAccumulatorCheckpointRequest) String() string {
 func (*GetAccumulatorCheckpointRequest) ProtoMessage() {}
 
 func (x *GetAccumulatorCheckpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[26]
+	mi := &file_ilxrpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +1846,7 @@ func (x *GetAccumulatorCheckpointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccumulatorCheckpointRequest.ProtoReflect.Descriptor instead.
 func (*GetAccumulatorCheckpointRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{26}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{28}
 }
 
 func (m *GetAccumulatorCheckpointRequest) GetHeightOrTimestamp() isGetAccumulatorCheckpointRequest_HeightOrTimestamp {
@@ -1714,7 +1916,7 @@ type GetAccumulatorCheckpointResponse struct {
 func (x *GetAccumulatorCheckpointResponse) Reset() {
 	*x = GetAccumulatorCheckpointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[27]
+		mi := &file_ilxrpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1727,7 +1929,7 @@ func (x *GetAccumulatorCheckpointResponse) String() string {
 func (*GetAccumulatorCheckpointResponse) ProtoMessage() {}
 
 func (x *GetAccumulatorCheckpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[27]
+	mi := &file_ilxrpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +1942,7 @@ func (x *GetAccumulatorCheckpointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccumulatorCheckpointResponse.ProtoReflect.Descriptor instead.
 func (*GetAccumulatorCheckpointResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{27}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetAccumulatorCheckpointResponse) GetHeight() uint32 {
@@ -1764,32 +1966,32 @@ func (x *GetAccumulatorCheckpointResponse) GetAccumulator() [][]byte {
 	return nil
 }
 
-type SubmitTransactionRequest struct {
+type GetFinalityCertificateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction to submit to the network
-	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The height of the block
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *SubmitTransactionRequest) Reset() {
-	*x = SubmitTransactionRequest{}
+func (x *GetFinalityCertificateRequest) Reset() {
+	*x = GetFinalityCertificateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[28]
+		mi := &file_ilxrpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitTransactionRequest) String() string {
+func (x *GetFinalityCertificateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitTransactionRequest) ProtoMessage() {}
+func (*GetFinalityCertificateRequest) ProtoMessage() {}
 
-func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[28]
+func (x *GetFinalityCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,46 +2002,54 @@ func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetFinalityCertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetFinalityCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SubmitTransactionRequest) GetTransaction() *transactions.Transaction {
+func (x *GetFinalityCertificateRequest) GetHeight() uint32 {
 	if x != nil {
-		return x.Transaction
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-type SubmitTransactionResponse struct {
+type GetFinalityCertificateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction ID of the transaction.
-	//
-	// If submission was unsuccessful and error will be returned.
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The height of the block
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The ID of the finalized block
+	Block_ID []byte `protobuf:"bytes,2,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// The peer ID of the block producer
+	Producer_ID []byte `protobuf:"bytes,3,opt,name=producer_ID,json=producerID,proto3" json:"producer_ID,omitempty"`
+	// The signature hash of the block header
+	SigHash []byte `protobuf:"bytes,4,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The IDs of the validators that signed a vote for the block
+	Validators [][]byte `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	// The aggregate signature
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *SubmitTransactionResponse) Reset() {
-	*x = SubmitTransactionResponse{}
+func (x *GetFinalityCertificateResponse) Reset() {
+	*x = GetFinalityCertificateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[29]
+		mi := &file_ilxrpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitTransactionResponse) String() string {
+func (x *GetFinalityCertificateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitTransactionResponse) ProtoMessage() {}
+func (*GetFinalityCertificateResponse) ProtoMessage() {}
 
-func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[29]
+func (x *GetFinalityCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,51 +2060,79 @@ func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetFinalityCertificateResponse.ProtoReflect.Descriptor instead.
+func (*GetFinalityCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SubmitTransactionResponse) GetTransaction_ID() []byte {
+func (x *GetFinalityCertificateResponse) GetHeight() uint32 {
 	if x != nil {
-		return x.Transaction_ID
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetFinalityCertificateResponse) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
 	}
 	return nil
 }
 
-type SubscribeBlocksRequest struct {
+func (x *GetFinalityCertificateResponse) GetProducer_ID() []byte {
+	if x != nil {
+		return x.Producer_ID
+	}
+	return nil
+}
+
+func (x *GetFinalityCertificateResponse) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *GetFinalityCertificateResponse) GetValidators() [][]byte {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *GetFinalityCertificateResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SubmitTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// When full_block is true, a complete marshaled block is sent.
-	// Default is false, block metadata is sent. See `BlockInfo`.
-	FullBlock bool `protobuf:"varint,1,opt,name=full_block,json=fullBlock,proto3" json:"full_block,omitempty"`
-	// When full_transactions is true, provide full transaction info
-	// for a marshaled block.
-	//
-	// Default is false, only the transaction IDs are included for
-	// a marshaled block.
-	FullTransactions bool `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+	// The transaction to submit to the network
+	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *SubscribeBlocksRequest) Reset() {
-	*x = SubscribeBlocksRequest{}
+func (x *SubmitTransactionRequest) Reset() {
+	*x = SubmitTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[30]
+		mi := &file_ilxrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeBlocksRequest) String() string {
+func (x *SubmitTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeBlocksRequest) ProtoMessage() {}
+func (*SubmitTransactionRequest) ProtoMessage() {}
 
-func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[30]
+func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,22 +2143,127 @@ func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SubmitTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SubscribeBlocksRequest) GetFullBlock() bool {
+func (x *SubmitTransactionRequest) GetTransaction() *transactions.Transaction {
 	if x != nil {
-		return x.FullBlock
+		return x.Transaction
 	}
-	return false
+	return nil
 }
 
-func (x *SubscribeBlocksRequest) GetFullTransactions() bool {
-	if x != nil {
-		return x.FullTransactions
-	}
+type SubmitTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID of the transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+}
+
+func (x *SubmitTransactionResponse) Reset() {
+	*x = SubmitTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTransactionResponse) ProtoMessage() {}
+
+func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubmitTransactionResponse) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+type SubscribeBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When full_block is true, a complete marshaled block is sent.
+	// Default is false, block metadata is sent. See `BlockInfo`.
+	FullBlock bool `protobuf:"varint,1,opt,name=full_block,json=fullBlock,proto3" json:"full_block,omitempty"`
+	// When full_transactions is true, provide full transaction info
+	// for a marshaled block.
+	//
+	// Default is false, only the transaction IDs are included for
+	// a marshaled block.
+	FullTransactions bool `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+}
+
+func (x *SubscribeBlocksRequest) Reset() {
+	*x = SubscribeBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlocksRequest) ProtoMessage() {}
+
+func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SubscribeBlocksRequest) GetFullBlock() bool {
+	if x != nil {
+		return x.FullBlock
+	}
+	return false
+}
+
+func (x *SubscribeBlocksRequest) GetFullTransactions() bool {
+	if x != nil {
+		return x.FullTransactions
+	}
 	return false
 }
 
@@ -1933,7 +2276,7 @@ type SubscribeCompressedBlocksRequest struct {
 func (x *SubscribeCompressedBlocksRequest) Reset() {
 	*x = SubscribeCompressedBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[31]
+		mi := &file_ilxrpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1946,7 +2289,7 @@ func (x *SubscribeCompressedBlocksRequest) String() string {
 func (*SubscribeCompressedBlocksRequest) ProtoMessage() {}
 
 func (x *SubscribeCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[31]
+	mi := &file_ilxrpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1959,7 +2302,7 @@ func (x *SubscribeCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeCompressedBlocksRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeCompressedBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{31}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{35}
 }
 
 // WalletServerService
@@ -1987,7 +2330,7 @@ type RegisterViewKeyRequest struct {
 func (x *RegisterViewKeyRequest) Reset() {
 	*x = RegisterViewKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[32]
+		mi := &file_ilxrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2000,7 +2343,7 @@ func (x *RegisterViewKeyRequest) String() string {
 func (*RegisterViewKeyRequest) ProtoMessage() {}
 
 func (x *RegisterViewKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[32]
+	mi := &file_ilxrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,7 +2356,7 @@ func (x *RegisterViewKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterViewKeyRequest.ProtoReflect.Descriptor instead.
 func (*RegisterViewKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{32}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RegisterViewKeyRequest) GetViewKey() []byte {
@@ -2046,7 +2389,7 @@ type RegisterViewKeyResponse struct {
 func (x *RegisterViewKeyResponse) Reset() {
 	*x = RegisterViewKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[33]
+		mi := &file_ilxrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2059,7 +2402,7 @@ func (x *RegisterViewKeyResponse) String() string {
 func (*RegisterViewKeyResponse) ProtoMessage() {}
 
 func (x *RegisterViewKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[33]
+	mi := &file_ilxrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,7 +2415,7 @@ func (x *RegisterViewKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterViewKeyResponse.ProtoReflect.Descriptor instead.
 func (*RegisterViewKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{33}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{37}
 }
 
 type SubscribeTransactionsRequest struct {
@@ -2087,7 +2430,7 @@ type SubscribeTransactionsRequest struct {
 func (x *SubscribeTransactionsRequest) Reset() {
 	*x = SubscribeTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[34]
+		mi := &file_ilxrpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2100,7 +2443,7 @@ func (x *SubscribeTransactionsRequest) String() string {
 func (*SubscribeTransactionsRequest) ProtoMessage() {}
 
 func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[34]
+	mi := &file_ilxrpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +2456,7 @@ func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{34}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SubscribeTransactionsRequest) GetViewKeys() [][]byte {
@@ -2145,7 +2488,7 @@ type GetWalletTransactionsRequest struct {
 func (x *GetWalletTransactionsRequest) Reset() {
 	*x = GetWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[35]
+		mi := &file_ilxrpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2158,7 +2501,7 @@ func (x *GetWalletTransactionsRequest) String() string {
 func (*GetWalletTransactionsRequest) ProtoMessage() {}
 
 func (x *GetWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[35]
+	mi := &file_ilxrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,7 +2514,7 @@ func (x *GetWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{35}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetWalletTransactionsRequest) GetViewKey() []byte {
@@ -2250,7 +2593,7 @@ type GetWalletTransactionsResponse struct {
 func (x *GetWalletTransactionsResponse) Reset() {
 	*x = GetWalletTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[36]
+		mi := &file_ilxrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2263,7 +2606,7 @@ func (x *GetWalletTransactionsResponse) String() string {
 func (*GetWalletTransactionsResponse) ProtoMessage() {}
 
 func (x *GetWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[36]
+	mi := &file_ilxrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +2619,7 @@ func (x *GetWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetWalletTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{36}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetWalletTransactionsResponse) GetChainHeight() uint32 {
@@ -2310,7 +2653,7 @@ type GetTxoProofRequest struct {
 func (x *GetTxoProofRequest) Reset() {
 	*x = GetTxoProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[37]
+		mi := &file_ilxrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2323,7 +2666,7 @@ func (x *GetTxoProofRequest) String() string {
 func (*GetTxoProofRequest) ProtoMessage() {}
 
 func (x *GetTxoProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[37]
+	mi := &file_ilxrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2679,7 @@ func (x *GetTxoProofRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTxoProofRequest.ProtoReflect.Descriptor instead.
 func (*GetTxoProofRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{37}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetTxoProofRequest) GetCommitments() [][]byte {
@@ -2358,7 +2701,7 @@ type GetTxoProofResponse struct {
 func (x *GetTxoProofResponse) Reset() {
 	*x = GetTxoProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[38]
+		mi := &file_ilxrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2371,7 +2714,7 @@ func (x *GetTxoProofResponse) String() string {
 func (*GetTxoProofResponse) ProtoMessage() {}
 
 func (x *GetTxoProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[38]
+	mi := &file_ilxrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2384,7 +2727,7 @@ func (x *GetTxoProofResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTxoProofResponse.ProtoReflect.Descriptor instead.
 func (*GetTxoProofResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{38}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetTxoProofResponse) GetProofs() []*TxoProof {
@@ -2404,7 +2747,7 @@ type GetBalanceRequest struct {
 func (x *GetBalanceRequest) Reset() {
 	*x = GetBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[39]
+		mi := &file_ilxrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2417,7 +2760,7 @@ func (x *GetBalanceRequest) String() string {
 func (*GetBalanceRequest) ProtoMessage() {}
 
 func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[39]
+	mi := &file_ilxrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +2773,7 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{39}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{43}
 }
 
 type GetBalanceResponse struct {
@@ -2445,7 +2788,7 @@ type GetBalanceResponse struct {
 func (x *GetBalanceResponse) Reset() {
 	*x = GetBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[40]
+		mi := &file_ilxrpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2458,7 +2801,7 @@ func (x *GetBalanceResponse) String() string {
 func (*GetBalanceResponse) ProtoMessage() {}
 
 func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[40]
+	mi := &file_ilxrpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +2814,7 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{40}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetBalanceResponse) GetBalance() uint64 {
@@ -2490,7 +2833,7 @@ type GetWalletSeedRequest struct {
 func (x *GetWalletSeedRequest) Reset() {
 	*x = GetWalletSeedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[41]
+		mi := &file_ilxrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2503,7 +2846,7 @@ func (x *GetWalletSeedRequest) String() string {
 func (*GetWalletSeedRequest) ProtoMessage() {}
 
 func (x *GetWalletSeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[41]
+	mi := &file_ilxrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +2859,7 @@ func (x *GetWalletSeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletSeedRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletSeedRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{41}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{45}
 }
 
 type GetWalletSeedResponse struct {
@@ -2531,7 +2874,7 @@ type GetWalletSeedResponse struct {
 func (x *GetWalletSeedResponse) Reset() {
 	*x = GetWalletSeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[42]
+		mi := &file_ilxrpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2544,7 +2887,7 @@ func (x *GetWalletSeedResponse) String() string {
 func (*GetWalletSeedResponse) ProtoMessage() {}
 
 func (x *GetWalletSeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[42]
+	mi := &file_ilxrpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,7 +2900,7 @@ func (x *GetWalletSeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletSeedResponse.ProtoReflect.Descriptor instead.
 func (*GetWalletSeedResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{42}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetWalletSeedResponse) GetMnemonicSeed() string {
@@ -2576,7 +2919,7 @@ type GetAddressRequest struct {
 func (x *GetAddressRequest) Reset() {
 	*x = GetAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[43]
+		mi := &file_ilxrpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2589,7 +2932,7 @@ func (x *GetAddressRequest) String() string {
 func (*GetAddressRequest) ProtoMessage() {}
 
 func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[43]
+	mi := &file_ilxrpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2602,7 +2945,7 @@ func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{43}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{47}
 }
 
 type GetAddressResponse struct {
@@ -2617,7 +2960,7 @@ type GetAddressResponse struct {
 func (x *GetAddressResponse) Reset() {
 	*x = GetAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[44]
+		mi := &file_ilxrpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2630,7 +2973,7 @@ func (x *GetAddressResponse) String() string {
 func (*GetAddressResponse) ProtoMessage() {}
 
 func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[44]
+	mi := &file_ilxrpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2643,7 +2986,7 @@ func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{44}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAddressResponse) GetAddress() string {
@@ -2665,7 +3008,7 @@ type GetTimelockedAddressRequest struct {
 func (x *GetTimelockedAddressRequest) Reset() {
 	*x = GetTimelockedAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[45]
+		mi := &file_ilxrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2678,7 +3021,7 @@ func (x *GetTimelockedAddressRequest) String() string {
 func (*GetTimelockedAddressRequest) ProtoMessage() {}
 
 func (x *GetTimelockedAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[45]
+	mi := &file_ilxrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,7 +3034,7 @@ func (x *GetTimelockedAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTimelockedAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetTimelockedAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{45}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetTimelockedAddressRequest) GetLockUntil() int64 {
@@ -2713,7 +3056,7 @@ type GetTimelockedAddressResponse struct {
 func (x *GetTimelockedAddressResponse) Reset() {
 	*x = GetTimelockedAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[46]
+		mi := &file_ilxrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2726,7 +3069,7 @@ func (x *GetTimelockedAddressResponse) String() string {
 func (*GetTimelockedAddressResponse) ProtoMessage() {}
 
 func (x *GetTimelockedAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[46]
+	mi := &file_ilxrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,7 +3082,7 @@ func (x *GetTimelockedAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTimelockedAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetTimelockedAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{46}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetTimelockedAddressResponse) GetAddress() string {
@@ -2758,7 +3101,7 @@ type GetAddressesRequest struct {
 func (x *GetAddressesRequest) Reset() {
 	*x = GetAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[47]
+		mi := &file_ilxrpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2771,7 +3114,7 @@ func (x *GetAddressesRequest) String() string {
 func (*GetAddressesRequest) ProtoMessage() {}
 
 func (x *GetAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[47]
+	mi := &file_ilxrpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2784,7 +3127,7 @@ func (x *GetAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{47}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{51}
 }
 
 type GetAddressesResponse struct {
@@ -2799,7 +3142,7 @@ type GetAddressesResponse struct {
 func (x *GetAddressesResponse) Reset() {
 	*x = GetAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[48]
+		mi := &file_ilxrpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2812,7 +3155,7 @@ func (x *GetAddressesResponse) String() string {
 func (*GetAddressesResponse) ProtoMessage() {}
 
 func (x *GetAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[48]
+	mi := &file_ilxrpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2825,7 +3168,7 @@ func (x *GetAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{48}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetAddressesResponse) GetAddresses() []string {
@@ -2847,7 +3190,7 @@ type GetAddressInfoRequest struct {
 func (x *GetAddressInfoRequest) Reset() {
 	*x = GetAddressInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[49]
+		mi := &file_ilxrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2860,7 +3203,7 @@ func (x *GetAddressInfoRequest) String() string {
 func (*GetAddressInfoRequest) ProtoMessage() {}
 
 func (x *GetAddressInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[49]
+	mi := &file_ilxrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2873,7 +3216,7 @@ func (x *GetAddressInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{49}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetAddressInfoRequest) GetAddress() string {
@@ -2893,7 +3236,7 @@ type GetAddressInfoResponse struct {
 	// This is the serialized locking script
 	// <scriptCommitment><lockingParams>
 	LockingScript []byte `protobuf:"bytes,2,opt,name=lockingScript,proto3" json:"lockingScript,omitempty"`
-	// The private view key for the address
+	//  The private view key for the address
 	ViewPrivateKey []byte `protobuf:"bytes,3,opt,name=viewPrivateKey,proto3" json:"viewPrivateKey,omitempty"`
 	// Is this address watch only
 	WatchOnly bool `protobuf:"varint,4,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
@@ -2902,7 +3245,7 @@ type GetAddressInfoResponse struct {
 func (x *GetAddressInfoResponse) Reset() {
 	*x = GetAddressInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[50]
+		mi := &file_ilxrpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2915,7 +3258,7 @@ func (x *GetAddressInfoResponse) String() string {
 func (*GetAddressInfoResponse) ProtoMessage() {}
 
 func (x *GetAddressInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[50]
+	mi := &file_ilxrpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2928,7 +3271,7 @@ func (x *GetAddressInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{50}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetAddressInfoResponse) GetAddress() string {
@@ -2968,7 +3311,7 @@ type GetNewAddressRequest struct {
 func (x *GetNewAddressRequest) Reset() {
 	*x = GetNewAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[51]
+		mi := &file_ilxrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2981,7 +3324,7 @@ func (x *GetNewAddressRequest) String() string {
 func (*GetNewAddressRequest) ProtoMessage() {}
 
 func (x *GetNewAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[51]
+	mi := &file_ilxrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,7 +3337,7 @@ func (x *GetNewAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetNewAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{51}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{55}
 }
 
 type GetNewAddressResponse struct {
@@ -3009,7 +3352,7 @@ type GetNewAddressResponse struct {
 func (x *GetNewAddressResponse) Reset() {
 	*x = GetNewAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[52]
+		mi := &file_ilxrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3022,7 +3365,7 @@ func (x *GetNewAddressResponse) String() string {
 func (*GetNewAddressResponse) ProtoMessage() {}
 
 func (x *GetNewAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[52]
+	mi := &file_ilxrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3035,7 +3378,7 @@ func (x *GetNewAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetNewAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{52}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetNewAddressResponse) GetAddress() string {
@@ -3054,7 +3397,7 @@ type GetTransactionsRequest struct {
 func (x *GetTransactionsRequest) Reset() {
 	*x = GetTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[53]
+		mi := &file_ilxrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3067,7 +3410,7 @@ func (x *GetTransactionsRequest) String() string {
 func (*GetTransactionsRequest) ProtoMessage() {}
 
 func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[53]
+	mi := &file_ilxrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,7 +3423,7 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{53}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{57}
 }
 
 type GetTransactionsResponse struct {
@@ -3095,7 +3438,7 @@ type GetTransactionsResponse struct {
 func (x *GetTransactionsResponse) Reset() {
 	*x = GetTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[54]
+		mi := &file_ilxrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3108,7 +3451,7 @@ func (x *GetTransactionsResponse) String() string {
 func (*GetTransactionsResponse) ProtoMessage() {}
 
 func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[54]
+	mi := &file_ilxrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3121,7 +3464,7 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{54}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetTransactionsResponse) GetTxs() []*WalletTransaction {
@@ -3131,16 +3474,131 @@ func (x *GetTransactionsResponse) GetTxs() []*WalletTransaction {
 	return nil
 }
 
-type GetUtxosRequest struct {
+type ExportTransactionHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *GetUtxosRequest) Reset() {
+	// The first block height to include in the export
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The last block height to include in the export. If
+	// zero the current best height will be used.
+	EndHeight uint32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// The format to serialize the history in
+	Format ExportTransactionHistoryRequest_Format `protobuf:"varint,3,opt,name=format,proto3,enum=pb.ExportTransactionHistoryRequest_Format" json:"format,omitempty"`
+}
+
+func (x *ExportTransactionHistoryRequest) Reset() {
+	*x = ExportTransactionHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTransactionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionHistoryRequest) ProtoMessage() {}
+
+func (x *ExportTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExportTransactionHistoryRequest) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ExportTransactionHistoryRequest) GetEndHeight() uint32 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *ExportTransactionHistoryRequest) GetFormat() ExportTransactionHistoryRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportTransactionHistoryRequest_CSV
+}
+
+type ExportTransactionHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized transaction history
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportTransactionHistoryResponse) Reset() {
+	*x = ExportTransactionHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTransactionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionHistoryResponse) ProtoMessage() {}
+
+func (x *ExportTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ExportTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExportTransactionHistoryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUtxosRequest) Reset() {
 	*x = GetUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[55]
+		mi := &file_ilxrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3153,7 +3611,7 @@ func (x *GetUtxosRequest) String() string {
 func (*GetUtxosRequest) ProtoMessage() {}
 
 func (x *GetUtxosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[55]
+	mi := &file_ilxrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3166,7 +3624,7 @@ func (x *GetUtxosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosRequest.ProtoReflect.Descriptor instead.
 func (*GetUtxosRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{55}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{61}
 }
 
 type GetUtxosResponse struct {
@@ -3181,7 +3639,7 @@ type GetUtxosResponse struct {
 func (x *GetUtxosResponse) Reset() {
 	*x = GetUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[56]
+		mi := &file_ilxrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3194,7 +3652,7 @@ func (x *GetUtxosResponse) String() string {
 func (*GetUtxosResponse) ProtoMessage() {}
 
 func (x *GetUtxosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[56]
+	mi := &file_ilxrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3207,7 +3665,7 @@ func (x *GetUtxosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosResponse.ProtoReflect.Descriptor instead.
 func (*GetUtxosResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{56}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetUtxosResponse) GetUtxos() []*Utxo {
@@ -3229,7 +3687,7 @@ type GetPrivateKeyRequest struct {
 func (x *GetPrivateKeyRequest) Reset() {
 	*x = GetPrivateKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[57]
+		mi := &file_ilxrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3242,7 +3700,7 @@ func (x *GetPrivateKeyRequest) String() string {
 func (*GetPrivateKeyRequest) ProtoMessage() {}
 
 func (x *GetPrivateKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[57]
+	mi := &file_ilxrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3255,7 +3713,7 @@ func (x *GetPrivateKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPrivateKeyRequest.ProtoReflect.Descriptor instead.
 func (*GetPrivateKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{57}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetPrivateKeyRequest) GetAddress() string {
@@ -3276,7 +3734,7 @@ type GetPrivateKeyResponse struct {
 func (x *GetPrivateKeyResponse) Reset() {
 	*x = GetPrivateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[58]
+		mi := &file_ilxrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3289,7 +3747,7 @@ func (x *GetPrivateKeyResponse) String() string {
 func (*GetPrivateKeyResponse) ProtoMessage() {}
 
 func (x *GetPrivateKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[58]
+	mi := &file_ilxrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3302,7 +3760,7 @@ func (x *GetPrivateKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPrivateKeyResponse.ProtoReflect.Descriptor instead.
 func (*GetPrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{58}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetPrivateKeyResponse) GetSerializedKeys() []byte {
@@ -3339,7 +3797,7 @@ type ImportAddressRequest struct {
 func (x *ImportAddressRequest) Reset() {
 	*x = ImportAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[59]
+		mi := &file_ilxrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3352,7 +3810,7 @@ func (x *ImportAddressRequest) String() string {
 func (*ImportAddressRequest) ProtoMessage() {}
 
 func (x *ImportAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[59]
+	mi := &file_ilxrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +3823,7 @@ func (x *ImportAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportAddressRequest.ProtoReflect.Descriptor instead.
 func (*ImportAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{59}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ImportAddressRequest) GetAddress() string {
@@ -3412,7 +3870,7 @@ type ImportAddressResponse struct {
 func (x *ImportAddressResponse) Reset() {
 	*x = ImportAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[60]
+		mi := &file_ilxrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3425,7 +3883,7 @@ func (x *ImportAddressResponse) String() string {
 func (*ImportAddressResponse) ProtoMessage() {}
 
 func (x *ImportAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[60]
+	mi := &file_ilxrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3438,7 +3896,7 @@ func (x *ImportAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportAddressResponse.ProtoReflect.Descriptor instead.
 func (*ImportAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{60}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{66}
 }
 
 type CreateMultisigSpendKeypairRequest struct {
@@ -3450,7 +3908,7 @@ type CreateMultisigSpendKeypairRequest struct {
 func (x *CreateMultisigSpendKeypairRequest) Reset() {
 	*x = CreateMultisigSpendKeypairRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[61]
+		mi := &file_ilxrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3463,7 +3921,7 @@ func (x *CreateMultisigSpendKeypairRequest) String() string {
 func (*CreateMultisigSpendKeypairRequest) ProtoMessage() {}
 
 func (x *CreateMultisigSpendKeypairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[61]
+	mi := &file_ilxrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3476,7 +3934,7 @@ func (x *CreateMultisigSpendKeypairRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigSpendKeypairRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigSpendKeypairRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{61}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{67}
 }
 
 type CreateMultisigSpendKeypairResponse struct {
@@ -3493,7 +3951,7 @@ type CreateMultisigSpendKeypairResponse struct {
 func (x *CreateMultisigSpendKeypairResponse) Reset() {
 	*x = CreateMultisigSpendKeypairResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[62]
+		mi := &file_ilxrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3506,7 +3964,7 @@ func (x *CreateMultisigSpendKeypairResponse) String() string {
 func (*CreateMultisigSpendKeypairResponse) ProtoMessage() {}
 
 func (x *CreateMultisigSpendKeypairResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[62]
+	mi := &file_ilxrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +3977,7 @@ func (x *CreateMultisigSpendKeypairResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigSpendKeypairResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigSpendKeypairResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{62}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CreateMultisigSpendKeypairResponse) GetPrivkey() []byte {
@@ -3545,7 +4003,7 @@ type CreateMultisigViewKeypairRequest struct {
 func (x *CreateMultisigViewKeypairRequest) Reset() {
 	*x = CreateMultisigViewKeypairRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[63]
+		mi := &file_ilxrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3558,7 +4016,7 @@ func (x *CreateMultisigViewKeypairRequest) String() string {
 func (*CreateMultisigViewKeypairRequest) ProtoMessage() {}
 
 func (x *CreateMultisigViewKeypairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[63]
+	mi := &file_ilxrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3571,7 +4029,7 @@ func (x *CreateMultisigViewKeypairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigViewKeypairRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigViewKeypairRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{63}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{69}
 }
 
 type CreateMultisigViewKeypairResponse struct {
@@ -3588,7 +4046,7 @@ type CreateMultisigViewKeypairResponse struct {
 func (x *CreateMultisigViewKeypairResponse) Reset() {
 	*x = CreateMultisigViewKeypairResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[64]
+		mi := &file_ilxrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3601,7 +4059,7 @@ func (x *CreateMultisigViewKeypairResponse) String() string {
 func (*CreateMultisigViewKeypairResponse) ProtoMessage() {}
 
 func (x *CreateMultisigViewKeypairResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[64]
+	mi := &file_ilxrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,7 +4072,7 @@ func (x *CreateMultisigViewKeypairResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigViewKeypairResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigViewKeypairResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{64}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *CreateMultisigViewKeypairResponse) GetPrivkey() []byte {
@@ -3648,7 +4106,7 @@ type CreateMultisigAddressRequest struct {
 func (x *CreateMultisigAddressRequest) Reset() {
 	*x = CreateMultisigAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[65]
+		mi := &file_ilxrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3661,7 +4119,7 @@ func (x *CreateMultisigAddressRequest) String() string {
 func (*CreateMultisigAddressRequest) ProtoMessage() {}
 
 func (x *CreateMultisigAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[65]
+	mi := &file_ilxrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,7 +4132,7 @@ func (x *CreateMultisigAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigAddressRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{65}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CreateMultisigAddressRequest) GetPubkeys() [][]byte {
@@ -3710,7 +4168,7 @@ type CreateMultisigAddressResponse struct {
 func (x *CreateMultisigAddressResponse) Reset() {
 	*x = CreateMultisigAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[66]
+		mi := &file_ilxrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3723,7 +4181,7 @@ func (x *CreateMultisigAddressResponse) String() string {
 func (*CreateMultisigAddressResponse) ProtoMessage() {}
 
 func (x *CreateMultisigAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[66]
+	mi := &file_ilxrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +4194,7 @@ func (x *CreateMultisigAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigAddressResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{66}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateMultisigAddressResponse) GetAddress() string {
@@ -3763,7 +4221,7 @@ type CreateMultiSignatureRequest struct {
 func (x *CreateMultiSignatureRequest) Reset() {
 	*x = CreateMultiSignatureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[67]
+		mi := &file_ilxrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3776,7 +4234,7 @@ func (x *CreateMultiSignatureRequest) String() string {
 func (*CreateMultiSignatureRequest) ProtoMessage() {}
 
 func (x *CreateMultiSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[67]
+	mi := &file_ilxrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,7 +4247,7 @@ func (x *CreateMultiSignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultiSignatureRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultiSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{67}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{73}
 }
 
 func (m *CreateMultiSignatureRequest) GetTxOrSighash() isCreateMultiSignatureRequest_TxOrSighash {
@@ -3850,7 +4308,7 @@ type CreateMultiSignatureResponse struct {
 func (x *CreateMultiSignatureResponse) Reset() {
 	*x = CreateMultiSignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[68]
+		mi := &file_ilxrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3863,7 +4321,7 @@ func (x *CreateMultiSignatureResponse) String() string {
 func (*CreateMultiSignatureResponse) ProtoMessage() {}
 
 func (x *CreateMultiSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[68]
+	mi := &file_ilxrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4334,7 @@ func (x *CreateMultiSignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultiSignatureResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultiSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{68}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *CreateMultiSignatureResponse) GetSignature() []byte {
@@ -3901,7 +4359,7 @@ type ProveMultisigRequest struct {
 func (x *ProveMultisigRequest) Reset() {
 	*x = ProveMultisigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[69]
+		mi := &file_ilxrpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3914,7 +4372,7 @@ func (x *ProveMultisigRequest) String() string {
 func (*ProveMultisigRequest) ProtoMessage() {}
 
 func (x *ProveMultisigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[69]
+	mi := &file_ilxrpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3927,7 +4385,7 @@ func (x *ProveMultisigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveMultisigRequest.ProtoReflect.Descriptor instead.
 func (*ProveMultisigRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{69}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ProveMultisigRequest) GetRawTx() *RawTransaction {
@@ -3956,7 +4414,7 @@ type ProveMultisigResponse struct {
 func (x *ProveMultisigResponse) Reset() {
 	*x = ProveMultisigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[70]
+		mi := &file_ilxrpc_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3969,7 +4427,7 @@ func (x *ProveMultisigResponse) String() string {
 func (*ProveMultisigResponse) ProtoMessage() {}
 
 func (x *ProveMultisigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[70]
+	mi := &file_ilxrpc_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3982,7 +4440,7 @@ func (x *ProveMultisigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveMultisigResponse.ProtoReflect.Descriptor instead.
 func (*ProveMultisigResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{70}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ProveMultisigResponse) GetProvedTx() *transactions.Transaction {
@@ -4001,7 +4459,7 @@ type WalletLockRequest struct {
 func (x *WalletLockRequest) Reset() {
 	*x = WalletLockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[71]
+		mi := &file_ilxrpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4014,7 +4472,7 @@ func (x *WalletLockRequest) String() string {
 func (*WalletLockRequest) ProtoMessage() {}
 
 func (x *WalletLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[71]
+	mi := &file_ilxrpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4027,7 +4485,7 @@ func (x *WalletLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletLockRequest.ProtoReflect.Descriptor instead.
 func (*WalletLockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{71}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{77}
 }
 
 type WalletLockResponse struct {
@@ -4039,7 +4497,7 @@ type WalletLockResponse struct {
 func (x *WalletLockResponse) Reset() {
 	*x = WalletLockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[72]
+		mi := &file_ilxrpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4052,7 +4510,7 @@ func (x *WalletLockResponse) String() string {
 func (*WalletLockResponse) ProtoMessage() {}
 
 func (x *WalletLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[72]
+	mi := &file_ilxrpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,7 +4523,7 @@ func (x *WalletLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletLockResponse.ProtoReflect.Descriptor instead.
 func (*WalletLockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{72}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{78}
 }
 
 type WalletUnlockRequest struct {
@@ -4083,7 +4541,7 @@ type WalletUnlockRequest struct {
 func (x *WalletUnlockRequest) Reset() {
 	*x = WalletUnlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[73]
+		mi := &file_ilxrpc_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4096,7 +4554,7 @@ func (x *WalletUnlockRequest) String() string {
 func (*WalletUnlockRequest) ProtoMessage() {}
 
 func (x *WalletUnlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[73]
+	mi := &file_ilxrpc_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4109,7 +4567,7 @@ func (x *WalletUnlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletUnlockRequest.ProtoReflect.Descriptor instead.
 func (*WalletUnlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{73}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *WalletUnlockRequest) GetPassphrase() string {
@@ -4135,7 +4593,7 @@ type WalletUnlockResponse struct {
 func (x *WalletUnlockResponse) Reset() {
 	*x = WalletUnlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[74]
+		mi := &file_ilxrpc_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4148,7 +4606,7 @@ func (x *WalletUnlockResponse) String() string {
 func (*WalletUnlockResponse) ProtoMessage() {}
 
 func (x *WalletUnlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[74]
+	mi := &file_ilxrpc_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4161,7 +4619,7 @@ func (x *WalletUnlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletUnlockResponse.ProtoReflect.Descriptor instead.
 func (*WalletUnlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{74}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{80}
 }
 
 type SetWalletPassphraseRequest struct {
@@ -4176,7 +4634,7 @@ type SetWalletPassphraseRequest struct {
 func (x *SetWalletPassphraseRequest) Reset() {
 	*x = SetWalletPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[75]
+		mi := &file_ilxrpc_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4189,7 +4647,7 @@ func (x *SetWalletPassphraseRequest) String() string {
 func (*SetWalletPassphraseRequest) ProtoMessage() {}
 
 func (x *SetWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[75]
+	mi := &file_ilxrpc_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4202,7 +4660,7 @@ func (x *SetWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetWalletPassphraseRequest.ProtoReflect.Descriptor instead.
 func (*SetWalletPassphraseRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{75}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *SetWalletPassphraseRequest) GetPassphrase() string {
@@ -4221,7 +4679,7 @@ type SetWalletPassphraseResponse struct {
 func (x *SetWalletPassphraseResponse) Reset() {
 	*x = SetWalletPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[76]
+		mi := &file_ilxrpc_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4234,7 +4692,7 @@ func (x *SetWalletPassphraseResponse) String() string {
 func (*SetWalletPassphraseResponse) ProtoMessage() {}
 
 func (x *SetWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[76]
+	mi := &file_ilxrpc_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,7 +4705,7 @@ func (x *SetWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetWalletPassphraseResponse.ProtoReflect.Descriptor instead.
 func (*SetWalletPassphraseResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{76}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{82}
 }
 
 type ChangeWalletPassphraseRequest struct {
@@ -4264,7 +4722,7 @@ type ChangeWalletPassphraseRequest struct {
 func (x *ChangeWalletPassphraseRequest) Reset() {
 	*x = ChangeWalletPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[77]
+		mi := &file_ilxrpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4277,7 +4735,7 @@ func (x *ChangeWalletPassphraseRequest) String() string {
 func (*ChangeWalletPassphraseRequest) ProtoMessage() {}
 
 func (x *ChangeWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[77]
+	mi := &file_ilxrpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4290,7 +4748,7 @@ func (x *ChangeWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeWalletPassphraseRequest.ProtoReflect.Descriptor instead.
 func (*ChangeWalletPassphraseRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{77}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ChangeWalletPassphraseRequest) GetCurrentPassphrase() string {
@@ -4316,7 +4774,7 @@ type ChangeWalletPassphraseResponse struct {
 func (x *ChangeWalletPassphraseResponse) Reset() {
 	*x = ChangeWalletPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[78]
+		mi := &file_ilxrpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4329,7 +4787,7 @@ func (x *ChangeWalletPassphraseResponse) String() string {
 func (*ChangeWalletPassphraseResponse) ProtoMessage() {}
 
 func (x *ChangeWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[78]
+	mi := &file_ilxrpc_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4342,7 +4800,7 @@ func (x *ChangeWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeWalletPassphraseResponse.ProtoReflect.Descriptor instead.
 func (*ChangeWalletPassphraseResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{78}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{84}
 }
 
 type DeletePrivateKeysRequest struct {
@@ -4354,7 +4812,7 @@ type DeletePrivateKeysRequest struct {
 func (x *DeletePrivateKeysRequest) Reset() {
 	*x = DeletePrivateKeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[79]
+		mi := &file_ilxrpc_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4367,7 +4825,7 @@ func (x *DeletePrivateKeysRequest) String() string {
 func (*DeletePrivateKeysRequest) ProtoMessage() {}
 
 func (x *DeletePrivateKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[79]
+	mi := &file_ilxrpc_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,7 +4838,7 @@ func (x *DeletePrivateKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateKeysRequest.ProtoReflect.Descriptor instead.
 func (*DeletePrivateKeysRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{79}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{85}
 }
 
 type DeletePrivateKeysResponse struct {
@@ -4392,7 +4850,7 @@ type DeletePrivateKeysResponse struct {
 func (x *DeletePrivateKeysResponse) Reset() {
 	*x = DeletePrivateKeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[80]
+		mi := &file_ilxrpc_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4405,7 +4863,7 @@ func (x *DeletePrivateKeysResponse) String() string {
 func (*DeletePrivateKeysResponse) ProtoMessage() {}
 
 func (x *DeletePrivateKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[80]
+	mi := &file_ilxrpc_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4418,7 +4876,7 @@ func (x *DeletePrivateKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateKeysResponse.ProtoReflect.Descriptor instead.
 func (*DeletePrivateKeysResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{80}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{86}
 }
 
 type CreateRawTransactionRequest struct {
@@ -4437,12 +4895,19 @@ type CreateRawTransactionRequest struct {
 	// If append_change_output is true you can specify the fee amount to use.
 	// If zero the wallet will use its internal fee policy.
 	FeePerKilobyte uint64 `protobuf:"varint,4,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+
+	// The name of the account to spend from. If set, the inputs known to
+	// the wallet must belong to the account and the change is sent to a
+	// new account address. If no inputs are provided they are selected
+	// from the account's coins, or from coins outside of any account if
+	// no account is named.
+	Account string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
 }
 
 func (x *CreateRawTransactionRequest) Reset() {
 	*x = CreateRawTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[81]
+		mi := &file_ilxrpc_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4455,7 +4920,7 @@ func (x *CreateRawTransactionRequest) String() string {
 func (*CreateRawTransactionRequest) ProtoMessage() {}
 
 func (x *CreateRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[81]
+	mi := &file_ilxrpc_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,7 +4933,7 @@ func (x *CreateRawTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *CreateRawTransactionRequest) GetInputs() []*CreateRawTransactionRequest_Input {
@@ -4499,6 +4964,13 @@ func (x *CreateRawTransactionRequest) GetFeePerKilobyte() uint64 {
 	return 0
 }
 
+func (x *CreateRawTransactionRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type CreateRawTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4511,7 +4983,7 @@ type CreateRawTransactionResponse struct {
 func (x *CreateRawTransactionResponse) Reset() {
 	*x = CreateRawTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[82]
+		mi := &file_ilxrpc_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4524,7 +4996,7 @@ func (x *CreateRawTransactionResponse) String() string {
 func (*CreateRawTransactionResponse) ProtoMessage() {}
 
 func (x *CreateRawTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[82]
+	mi := &file_ilxrpc_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4537,7 +5009,7 @@ func (x *CreateRawTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{82}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CreateRawTransactionResponse) GetRawTx() *RawTransaction {
@@ -4554,12 +5026,14 @@ type CreateRawStakeTransactionRequest struct {
 
 	// A list of either utxo commitments or private inputs
 	Input *CreateRawStakeTransactionRequest_Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// The name of the account the input belongs to, if any
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
 func (x *CreateRawStakeTransactionRequest) Reset() {
 	*x = CreateRawStakeTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[83]
+		mi := &file_ilxrpc_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4572,7 +5046,7 @@ func (x *CreateRawStakeTransactionRequest) String() string {
 func (*CreateRawStakeTransactionRequest) ProtoMessage() {}
 
 func (x *CreateRawStakeTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[83]
+	mi := &file_ilxrpc_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4585,7 +5059,7 @@ func (x *CreateRawStakeTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawStakeTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateRawStakeTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{83}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateRawStakeTransactionRequest) GetInput() *CreateRawStakeTransactionRequest_Input {
@@ -4595,6 +5069,13 @@ func (x *CreateRawStakeTransactionRequest) GetInput() *CreateRawStakeTransaction
 	return nil
 }
 
+func (x *CreateRawStakeTransactionRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type CreateRawStakeTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4607,7 +5088,7 @@ type CreateRawStakeTransactionResponse struct {
 func (x *CreateRawStakeTransactionResponse) Reset() {
 	*x = CreateRawStakeTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[84]
+		mi := &file_ilxrpc_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4620,7 +5101,7 @@ func (x *CreateRawStakeTransactionResponse) String() string {
 func (*CreateRawStakeTransactionResponse) ProtoMessage() {}
 
 func (x *CreateRawStakeTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[84]
+	mi := &file_ilxrpc_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4633,7 +5114,7 @@ func (x *CreateRawStakeTransactionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateRawStakeTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateRawStakeTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{84}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CreateRawStakeTransactionResponse) GetRawTx() *RawTransaction {
@@ -4655,7 +5136,7 @@ type ProveRawTransactionRequest struct {
 func (x *ProveRawTransactionRequest) Reset() {
 	*x = ProveRawTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[85]
+		mi := &file_ilxrpc_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4668,7 +5149,7 @@ func (x *ProveRawTransactionRequest) String() string {
 func (*ProveRawTransactionRequest) ProtoMessage() {}
 
 func (x *ProveRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[85]
+	mi := &file_ilxrpc_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4681,7 +5162,7 @@ func (x *ProveRawTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRawTransactionRequest.ProtoReflect.Descriptor instead.
 func (*ProveRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{85}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ProveRawTransactionRequest) GetRawTx() *RawTransaction {
@@ -4703,7 +5184,7 @@ type ProveRawTransactionResponse struct {
 func (x *ProveRawTransactionResponse) Reset() {
 	*x = ProveRawTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[86]
+		mi := &file_ilxrpc_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4716,7 +5197,7 @@ func (x *ProveRawTransactionResponse) String() string {
 func (*ProveRawTransactionResponse) ProtoMessage() {}
 
 func (x *ProveRawTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[86]
+	mi := &file_ilxrpc_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4729,7 +5210,7 @@ func (x *ProveRawTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRawTransactionResponse.ProtoReflect.Descriptor instead.
 func (*ProveRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{86}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ProveRawTransactionResponse) GetProvedTx() *transactions.Transaction {
@@ -4751,7 +5232,7 @@ type StakeRequest struct {
 func (x *StakeRequest) Reset() {
 	*x = StakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[87]
+		mi := &file_ilxrpc_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4764,7 +5245,7 @@ func (x *StakeRequest) String() string {
 func (*StakeRequest) ProtoMessage() {}
 
 func (x *StakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[87]
+	mi := &file_ilxrpc_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4777,7 +5258,7 @@ func (x *StakeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeRequest.ProtoReflect.Descriptor instead.
 func (*StakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{87}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *StakeRequest) GetCommitments() [][]byte {
@@ -4796,7 +5277,7 @@ type StakeResponse struct {
 func (x *StakeResponse) Reset() {
 	*x = StakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[88]
+		mi := &file_ilxrpc_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4809,7 +5290,7 @@ func (x *StakeResponse) String() string {
 func (*StakeResponse) ProtoMessage() {}
 
 func (x *StakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[88]
+	mi := &file_ilxrpc_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4822,7 +5303,7 @@ func (x *StakeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeResponse.ProtoReflect.Descriptor instead.
 func (*StakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{88}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{94}
 }
 
 type SetAutoStakeRewardsRequest struct {
@@ -4837,7 +5318,7 @@ type SetAutoStakeRewardsRequest struct {
 func (x *SetAutoStakeRewardsRequest) Reset() {
 	*x = SetAutoStakeRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[89]
+		mi := &file_ilxrpc_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4850,7 +5331,7 @@ func (x *SetAutoStakeRewardsRequest) String() string {
 func (*SetAutoStakeRewardsRequest) ProtoMessage() {}
 
 func (x *SetAutoStakeRewardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[89]
+	mi := &file_ilxrpc_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4863,7 +5344,7 @@ func (x *SetAutoStakeRewardsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAutoStakeRewardsRequest.ProtoReflect.Descriptor instead.
 func (*SetAutoStakeRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{89}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *SetAutoStakeRewardsRequest) GetAutostake() bool {
@@ -4882,7 +5363,7 @@ type SetAutoStakeRewardsResponse struct {
 func (x *SetAutoStakeRewardsResponse) Reset() {
 	*x = SetAutoStakeRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[90]
+		mi := &file_ilxrpc_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4895,7 +5376,7 @@ func (x *SetAutoStakeRewardsResponse) String() string {
 func (*SetAutoStakeRewardsResponse) ProtoMessage() {}
 
 func (x *SetAutoStakeRewardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[90]
+	mi := &file_ilxrpc_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,47 +5389,37 @@ func (x *SetAutoStakeRewardsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAutoStakeRewardsResponse.ProtoReflect.Descriptor instead.
 func (*SetAutoStakeRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{90}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{96}
 }
 
-type SpendRequest struct {
+type CreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address to send funds to
-	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	// Amount to send
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
-	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
-	// An optional list of input commitments to spend. If this
-	// is empty the wallet will select its own inputs.
-	//
-	// Note that staked commitments will not be selected by
-	// the wallet. You will need to list staked commitments
-	// here if you wish to spend them.
-	InputCommitments [][]byte `protobuf:"bytes,4,rep,name=input_commitments,json=inputCommitments,proto3" json:"input_commitments,omitempty"`
+	// The name of the account. Must be unique within the wallet.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// An optional human-readable label for the account
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *SpendRequest) Reset() {
-	*x = SpendRequest{}
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[91]
+		mi := &file_ilxrpc_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpendRequest) String() string {
+func (x *CreateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpendRequest) ProtoMessage() {}
+func (*CreateAccountRequest) ProtoMessage() {}
 
-func (x *SpendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[91]
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4959,67 +5430,48 @@ func (x *SpendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpendRequest.ProtoReflect.Descriptor instead.
-func (*SpendRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *SpendRequest) GetToAddress() string {
+func (x *CreateAccountRequest) GetName() string {
 	if x != nil {
-		return x.ToAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SpendRequest) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *SpendRequest) GetFeePerKilobyte() uint64 {
-	if x != nil {
-		return x.FeePerKilobyte
-	}
-	return 0
-}
-
-func (x *SpendRequest) GetInputCommitments() [][]byte {
+func (x *CreateAccountRequest) GetLabel() string {
 	if x != nil {
-		return x.InputCommitments
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type SpendResponse struct {
+type CreateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The transaction ID of the transaction.
-	//
-	// If submission was unsuccessful and error will be returned.
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *SpendResponse) Reset() {
-	*x = SpendResponse{}
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[92]
+		mi := &file_ilxrpc_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpendResponse) String() string {
+func (x *CreateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpendResponse) ProtoMessage() {}
+func (*CreateAccountResponse) ProtoMessage() {}
 
-func (x *SpendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[92]
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5030,56 +5482,34 @@ func (x *SpendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpendResponse.ProtoReflect.Descriptor instead.
-func (*SpendResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *SpendResponse) GetTransaction_ID() []byte {
-	if x != nil {
-		return x.Transaction_ID
-	}
-	return nil
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{98}
 }
 
-type TimelockCoinsRequest struct {
+type GetAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Amount of coins to lock
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The unix time (in seconds) to lock the coins until
-	LockUntil int64 `protobuf:"varint,2,opt,name=lock_until,json=lockUntil,proto3" json:"lock_until,omitempty"`
-	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
-	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
-	// An optional list of input commitments to spend. If this
-	// is empty the wallet will select its own inputs.
-	//
-	// Note that staked commitments will not be selected by
-	// the wallet. You will need to list staked commitments
-	// here if you wish to spend them.
-	InputCommitments [][]byte `protobuf:"bytes,4,rep,name=input_commitments,json=inputCommitments,proto3" json:"input_commitments,omitempty"`
 }
 
-func (x *TimelockCoinsRequest) Reset() {
-	*x = TimelockCoinsRequest{}
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[93]
+		mi := &file_ilxrpc_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TimelockCoinsRequest) String() string {
+func (x *GetAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelockCoinsRequest) ProtoMessage() {}
+func (*GetAccountsRequest) ProtoMessage() {}
 
-func (x *TimelockCoinsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[93]
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5090,67 +5520,37 @@ func (x *TimelockCoinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelockCoinsRequest.ProtoReflect.Descriptor instead.
-func (*TimelockCoinsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *TimelockCoinsRequest) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *TimelockCoinsRequest) GetLockUntil() int64 {
-	if x != nil {
-		return x.LockUntil
-	}
-	return 0
-}
-
-func (x *TimelockCoinsRequest) GetFeePerKilobyte() uint64 {
-	if x != nil {
-		return x.FeePerKilobyte
-	}
-	return 0
-}
-
-func (x *TimelockCoinsRequest) GetInputCommitments() [][]byte {
-	if x != nil {
-		return x.InputCommitments
-	}
-	return nil
+// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{99}
 }
 
-type TimelockCoinsResponse struct {
+type GetAccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction ID of the transaction.
-	//
-	// If submission was unsuccessful and error will be returned.
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// All accounts in the wallet
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (x *TimelockCoinsResponse) Reset() {
-	*x = TimelockCoinsResponse{}
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[94]
+		mi := &file_ilxrpc_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TimelockCoinsResponse) String() string {
+func (x *GetAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelockCoinsResponse) ProtoMessage() {}
+func (*GetAccountsResponse) ProtoMessage() {}
 
-func (x *TimelockCoinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[94]
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5161,47 +5561,46 @@ func (x *TimelockCoinsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelockCoinsResponse.ProtoReflect.Descriptor instead.
-func (*TimelockCoinsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *TimelockCoinsResponse) GetTransaction_ID() []byte {
+func (x *GetAccountsResponse) GetAccounts() []*Account {
 	if x != nil {
-		return x.Transaction_ID
+		return x.Accounts
 	}
 	return nil
 }
 
-type SweepWalletRequest struct {
+type SetAccountLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address to send funds to
-	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
-	FeePerKilobyte uint64 `protobuf:"varint,2,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// The name of the account to update
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The new label
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *SweepWalletRequest) Reset() {
-	*x = SweepWalletRequest{}
+func (x *SetAccountLabelRequest) Reset() {
+	*x = SetAccountLabelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[95]
+		mi := &file_ilxrpc_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SweepWalletRequest) String() string {
+func (x *SetAccountLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SweepWalletRequest) ProtoMessage() {}
+func (*SetAccountLabelRequest) ProtoMessage() {}
 
-func (x *SweepWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[95]
+func (x *SetAccountLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5212,53 +5611,48 @@ func (x *SweepWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SweepWalletRequest.ProtoReflect.Descriptor instead.
-func (*SweepWalletRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use SetAccountLabelRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountLabelRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *SweepWalletRequest) GetToAddress() string {
+func (x *SetAccountLabelRequest) GetName() string {
 	if x != nil {
-		return x.ToAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SweepWalletRequest) GetFeePerKilobyte() uint64 {
+func (x *SetAccountLabelRequest) GetLabel() string {
 	if x != nil {
-		return x.FeePerKilobyte
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-type SweepWalletResponse struct {
+type SetAccountLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The transaction ID of the transaction.
-	//
-	// If submission was unsuccessful and error will be returned.
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *SweepWalletResponse) Reset() {
-	*x = SweepWalletResponse{}
+func (x *SetAccountLabelResponse) Reset() {
+	*x = SetAccountLabelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[96]
+		mi := &file_ilxrpc_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SweepWalletResponse) String() string {
+func (x *SetAccountLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SweepWalletResponse) ProtoMessage() {}
+func (*SetAccountLabelResponse) ProtoMessage() {}
 
-func (x *SweepWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[96]
+func (x *SetAccountLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5269,41 +5663,37 @@ func (x *SweepWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SweepWalletResponse.ProtoReflect.Descriptor instead.
-func (*SweepWalletResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use SetAccountLabelResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountLabelResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *SweepWalletResponse) GetTransaction_ID() []byte {
-	if x != nil {
-		return x.Transaction_ID
-	}
-	return nil
-}
-
-type SubscribeWalletTransactionsRequest struct {
+type GetAccountNewAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The name of the account to generate the address for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SubscribeWalletTransactionsRequest) Reset() {
-	*x = SubscribeWalletTransactionsRequest{}
+func (x *GetAccountNewAddressRequest) Reset() {
+	*x = GetAccountNewAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[97]
+		mi := &file_ilxrpc_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeWalletTransactionsRequest) String() string {
+func (x *GetAccountNewAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeWalletTransactionsRequest) ProtoMessage() {}
+func (*GetAccountNewAddressRequest) ProtoMessage() {}
 
-func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[97]
+func (x *GetAccountNewAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5314,73 +5704,44 @@ func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeWalletTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeWalletTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{97}
-}
-
-type SubscribeWalletSyncNotificationsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *SubscribeWalletSyncNotificationsRequest) Reset() {
-	*x = SubscribeWalletSyncNotificationsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[98]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SubscribeWalletSyncNotificationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetAccountNewAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountNewAddressRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{103}
 }
 
-func (*SubscribeWalletSyncNotificationsRequest) ProtoMessage() {}
-
-func (x *SubscribeWalletSyncNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[98]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetAccountNewAddressRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SubscribeWalletSyncNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeWalletSyncNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{98}
+	return ""
 }
 
-// NodeService
-type GetHostInfoRequest struct {
+type GetAccountNewAddressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// A fresh address belonging to the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetHostInfoRequest) Reset() {
-	*x = GetHostInfoRequest{}
+func (x *GetAccountNewAddressResponse) Reset() {
+	*x = GetAccountNewAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[99]
+		mi := &file_ilxrpc_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHostInfoRequest) String() string {
+func (x *GetAccountNewAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostInfoRequest) ProtoMessage() {}
+func (*GetAccountNewAddressResponse) ProtoMessage() {}
 
-func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[99]
+func (x *GetAccountNewAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5391,45 +5752,51 @@ func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetHostInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetAccountNewAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountNewAddressResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{104}
 }
 
-type GetHostInfoResponse struct {
+func (x *GetAccountNewAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AccountSpendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The host peer ID
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
-	// A list of multiaddrs that this node is listening on
-	Addrs []string `protobuf:"bytes,2,rep,name=addrs,proto3" json:"addrs,omitempty"`
-	// The number of peers this node is connected to
-	Peers uint32 `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`
-	// Is tx index enabled
-	TxIndex bool `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
-	// Is the wallet server enabled
-	WalletServer bool `protobuf:"varint,5,opt,name=wallet_server,json=walletServer,proto3" json:"wallet_server,omitempty"`
+	// The name of the account to spend from
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Address to send funds to
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// Amount to send
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The fee to use for the transaction.
+	// If zero the wallet will use its internal fee policy.
+	FeePerKilobyte uint64 `protobuf:"varint,4,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *GetHostInfoResponse) Reset() {
-	*x = GetHostInfoResponse{}
+func (x *AccountSpendRequest) Reset() {
+	*x = AccountSpendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[100]
+		mi := &file_ilxrpc_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHostInfoResponse) String() string {
+func (x *AccountSpendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostInfoResponse) ProtoMessage() {}
+func (*AccountSpendRequest) ProtoMessage() {}
 
-func (x *GetHostInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[100]
+func (x *AccountSpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5440,69 +5807,67 @@ func (x *GetHostInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetHostInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use AccountSpendRequest.ProtoReflect.Descriptor instead.
+func (*AccountSpendRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetHostInfoResponse) GetPeer_ID() string {
+func (x *AccountSpendRequest) GetName() string {
 	if x != nil {
-		return x.Peer_ID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetHostInfoResponse) GetAddrs() []string {
+func (x *AccountSpendRequest) GetToAddress() string {
 	if x != nil {
-		return x.Addrs
+		return x.ToAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHostInfoResponse) GetPeers() uint32 {
+func (x *AccountSpendRequest) GetAmount() uint64 {
 	if x != nil {
-		return x.Peers
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GetHostInfoResponse) GetTxIndex() bool {
-	if x != nil {
-		return x.TxIndex
-	}
-	return false
-}
-
-func (x *GetHostInfoResponse) GetWalletServer() bool {
+func (x *AccountSpendRequest) GetFeePerKilobyte() uint64 {
 	if x != nil {
-		return x.WalletServer
+		return x.FeePerKilobyte
 	}
-	return false
+	return 0
 }
 
-type GetNetworkKeyRequest struct {
+type AccountSpendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID of the transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *GetNetworkKeyRequest) Reset() {
-	*x = GetNetworkKeyRequest{}
+func (x *AccountSpendResponse) Reset() {
+	*x = AccountSpendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[101]
+		mi := &file_ilxrpc_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetNetworkKeyRequest) String() string {
+func (x *AccountSpendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkKeyRequest) ProtoMessage() {}
+func (*AccountSpendResponse) ProtoMessage() {}
 
-func (x *GetNetworkKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[101]
+func (x *AccountSpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5513,37 +5878,56 @@ func (x *GetNetworkKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetNetworkKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use AccountSpendResponse.ProtoReflect.Descriptor instead.
+func (*AccountSpendResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{106}
 }
 
-type GetNetworkKeyResponse struct {
+func (x *AccountSpendResponse) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+type SpendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The nodes' serialized network key
-	NetworkPrivateKey []byte `protobuf:"bytes,1,opt,name=networkPrivateKey,proto3" json:"networkPrivateKey,omitempty"`
+	// Address to send funds to
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// Amount to send
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The fee to use for the transaction.
+	// If zero the wallet will use its internal fee policy.
+	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// An optional list of input commitments to spend. If this
+	// is empty the wallet will select its own inputs.
+	//
+	// Note that staked commitments will not be selected by
+	// the wallet. You will need to list staked commitments
+	// here if you wish to spend them.
+	InputCommitments [][]byte `protobuf:"bytes,4,rep,name=input_commitments,json=inputCommitments,proto3" json:"input_commitments,omitempty"`
 }
 
-func (x *GetNetworkKeyResponse) Reset() {
-	*x = GetNetworkKeyResponse{}
+func (x *SpendRequest) Reset() {
+	*x = SpendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[102]
+		mi := &file_ilxrpc_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetNetworkKeyResponse) String() string {
+func (x *SpendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkKeyResponse) ProtoMessage() {}
+func (*SpendRequest) ProtoMessage() {}
 
-func (x *GetNetworkKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[102]
+func (x *SpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5554,41 +5938,67 @@ func (x *GetNetworkKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetNetworkKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use SpendRequest.ProtoReflect.Descriptor instead.
+func (*SpendRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetNetworkKeyResponse) GetNetworkPrivateKey() []byte {
+func (x *SpendRequest) GetToAddress() string {
 	if x != nil {
-		return x.NetworkPrivateKey
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *SpendRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SpendRequest) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+func (x *SpendRequest) GetInputCommitments() [][]byte {
+	if x != nil {
+		return x.InputCommitments
 	}
 	return nil
 }
 
-type GetPeersRequest struct {
+type SpendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID of the transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *GetPeersRequest) Reset() {
-	*x = GetPeersRequest{}
+func (x *SpendResponse) Reset() {
+	*x = SpendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[103]
+		mi := &file_ilxrpc_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPeersRequest) String() string {
+func (x *SpendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeersRequest) ProtoMessage() {}
+func (*SpendResponse) ProtoMessage() {}
 
-func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[103]
+func (x *SpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5599,37 +6009,56 @@ func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeersRequest.ProtoReflect.Descriptor instead.
-func (*GetPeersRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use SpendResponse.ProtoReflect.Descriptor instead.
+func (*SpendResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{108}
 }
 
-type GetPeersResponse struct {
+func (x *SpendResponse) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+type TimelockCoinsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of peers
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// Amount of coins to lock
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The unix time (in seconds) to lock the coins until
+	LockUntil int64 `protobuf:"varint,2,opt,name=lock_until,json=lockUntil,proto3" json:"lock_until,omitempty"`
+	// The fee to use for the transaction.
+	// If zero the wallet will use its internal fee policy.
+	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// An optional list of input commitments to spend. If this
+	// is empty the wallet will select its own inputs.
+	//
+	// Note that staked commitments will not be selected by
+	// the wallet. You will need to list staked commitments
+	// here if you wish to spend them.
+	InputCommitments [][]byte `protobuf:"bytes,4,rep,name=input_commitments,json=inputCommitments,proto3" json:"input_commitments,omitempty"`
 }
 
-func (x *GetPeersResponse) Reset() {
-	*x = GetPeersResponse{}
+func (x *TimelockCoinsRequest) Reset() {
+	*x = TimelockCoinsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[104]
+		mi := &file_ilxrpc_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPeersResponse) String() string {
+func (x *TimelockCoinsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeersResponse) ProtoMessage() {}
+func (*TimelockCoinsRequest) ProtoMessage() {}
 
-func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[104]
+func (x *TimelockCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5640,45 +6069,67 @@ func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeersResponse.ProtoReflect.Descriptor instead.
-func (*GetPeersResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use TimelockCoinsRequest.ProtoReflect.Descriptor instead.
+func (*TimelockCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetPeersResponse) GetPeers() []*Peer {
+func (x *TimelockCoinsRequest) GetAmount() uint64 {
 	if x != nil {
-		return x.Peers
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TimelockCoinsRequest) GetLockUntil() int64 {
+	if x != nil {
+		return x.LockUntil
+	}
+	return 0
+}
+
+func (x *TimelockCoinsRequest) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+func (x *TimelockCoinsRequest) GetInputCommitments() [][]byte {
+	if x != nil {
+		return x.InputCommitments
 	}
 	return nil
 }
 
-type AddPeerRequest struct {
+type TimelockCoinsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The peer addr to add. The IP addresses for the peer
-	// will be looked up in the DHT if necessary.
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+	// The transaction ID of the transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *AddPeerRequest) Reset() {
-	*x = AddPeerRequest{}
+func (x *TimelockCoinsResponse) Reset() {
+	*x = TimelockCoinsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[105]
+		mi := &file_ilxrpc_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerRequest) String() string {
+func (x *TimelockCoinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerRequest) ProtoMessage() {}
+func (*TimelockCoinsResponse) ProtoMessage() {}
 
-func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[105]
+func (x *TimelockCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5689,41 +6140,47 @@ func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
-func (*AddPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use TimelockCoinsResponse.ProtoReflect.Descriptor instead.
+func (*TimelockCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *AddPeerRequest) GetPeer_ID() string {
+func (x *TimelockCoinsResponse) GetTransaction_ID() []byte {
 	if x != nil {
-		return x.Peer_ID
+		return x.Transaction_ID
 	}
-	return ""
+	return nil
 }
 
-type AddPeerResponse struct {
+type SweepWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Address to send funds to
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// The fee to use for the transaction.
+	// If zero the wallet will use its internal fee policy.
+	FeePerKilobyte uint64 `protobuf:"varint,2,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *AddPeerResponse) Reset() {
-	*x = AddPeerResponse{}
+func (x *SweepWalletRequest) Reset() {
+	*x = SweepWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[106]
+		mi := &file_ilxrpc_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerResponse) String() string {
+func (x *SweepWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerResponse) ProtoMessage() {}
+func (*SweepWalletRequest) ProtoMessage() {}
 
-func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[106]
+func (x *SweepWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5734,37 +6191,53 @@ func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
-func (*AddPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use SweepWalletRequest.ProtoReflect.Descriptor instead.
+func (*SweepWalletRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{111}
 }
 
-type BlockPeerRequest struct {
+func (x *SweepWalletRequest) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *SweepWalletRequest) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+type SweepWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Peer ID to block
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+	// The transaction ID of the transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *BlockPeerRequest) Reset() {
-	*x = BlockPeerRequest{}
+func (x *SweepWalletResponse) Reset() {
+	*x = SweepWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[107]
+		mi := &file_ilxrpc_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockPeerRequest) String() string {
+func (x *SweepWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockPeerRequest) ProtoMessage() {}
+func (*SweepWalletResponse) ProtoMessage() {}
 
-func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[107]
+func (x *SweepWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5775,41 +6248,41 @@ func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockPeerRequest.ProtoReflect.Descriptor instead.
-func (*BlockPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use SweepWalletResponse.ProtoReflect.Descriptor instead.
+func (*SweepWalletResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *BlockPeerRequest) GetPeer_ID() string {
+func (x *SweepWalletResponse) GetTransaction_ID() []byte {
 	if x != nil {
-		return x.Peer_ID
+		return x.Transaction_ID
 	}
-	return ""
+	return nil
 }
 
-type BlockPeerResponse struct {
+type SubscribeWalletTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BlockPeerResponse) Reset() {
-	*x = BlockPeerResponse{}
+func (x *SubscribeWalletTransactionsRequest) Reset() {
+	*x = SubscribeWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[108]
+		mi := &file_ilxrpc_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockPeerResponse) String() string {
+func (x *SubscribeWalletTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockPeerResponse) ProtoMessage() {}
+func (*SubscribeWalletTransactionsRequest) ProtoMessage() {}
 
-func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[108]
+func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5820,37 +6293,34 @@ func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockPeerResponse.ProtoReflect.Descriptor instead.
-func (*BlockPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use SubscribeWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeWalletTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{113}
 }
 
-type UnblockPeerRequest struct {
+type SubscribeWalletSyncNotificationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Peer ID to unblock
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *UnblockPeerRequest) Reset() {
-	*x = UnblockPeerRequest{}
+func (x *SubscribeWalletSyncNotificationsRequest) Reset() {
+	*x = SubscribeWalletSyncNotificationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[109]
+		mi := &file_ilxrpc_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnblockPeerRequest) String() string {
+func (x *SubscribeWalletSyncNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockPeerRequest) ProtoMessage() {}
+func (*SubscribeWalletSyncNotificationsRequest) ProtoMessage() {}
 
-func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[109]
+func (x *SubscribeWalletSyncNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5861,41 +6331,35 @@ func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockPeerRequest.ProtoReflect.Descriptor instead.
-func (*UnblockPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{109}
-}
-
-func (x *UnblockPeerRequest) GetPeer_ID() string {
-	if x != nil {
-		return x.Peer_ID
-	}
-	return ""
+// Deprecated: Use SubscribeWalletSyncNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeWalletSyncNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{114}
 }
 
-type UnblockPeerResponse struct {
+// NodeService
+type GetHostInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnblockPeerResponse) Reset() {
-	*x = UnblockPeerResponse{}
+func (x *GetHostInfoRequest) Reset() {
+	*x = GetHostInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[110]
+		mi := &file_ilxrpc_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnblockPeerResponse) String() string {
+func (x *GetHostInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockPeerResponse) ProtoMessage() {}
+func (*GetHostInfoRequest) ProtoMessage() {}
 
-func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[110]
+func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5906,37 +6370,45 @@ func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockPeerResponse.ProtoReflect.Descriptor instead.
-func (*UnblockPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use GetHostInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetHostInfoRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{115}
 }
 
-type SetLogLevelRequest struct {
+type GetHostInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The debug level to set the logging to
-	Level SetLogLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=pb.SetLogLevelRequest_Level" json:"level,omitempty"`
-}
-
-func (x *SetLogLevelRequest) Reset() {
-	*x = SetLogLevelRequest{}
+	// The host peer ID
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+	// A list of multiaddrs that this node is listening on
+	Addrs []string `protobuf:"bytes,2,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// The number of peers this node is connected to
+	Peers uint32 `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`
+	// Is tx index enabled
+	TxIndex bool `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Is the wallet server enabled
+	WalletServer bool `protobuf:"varint,5,opt,name=wallet_server,json=walletServer,proto3" json:"wallet_server,omitempty"`
+}
+
+func (x *GetHostInfoResponse) Reset() {
+	*x = GetHostInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[111]
+		mi := &file_ilxrpc_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLogLevelRequest) String() string {
+func (x *GetHostInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLogLevelRequest) ProtoMessage() {}
+func (*GetHostInfoResponse) ProtoMessage() {}
 
-func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[111]
+func (x *GetHostInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5947,41 +6419,69 @@ func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
-func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use GetHostInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetHostInfoResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *SetLogLevelRequest) GetLevel() SetLogLevelRequest_Level {
+func (x *GetHostInfoResponse) GetPeer_ID() string {
 	if x != nil {
-		return x.Level
+		return x.Peer_ID
 	}
-	return SetLogLevelRequest_DEBUG
+	return ""
 }
 
-type SetLogLevelResponse struct {
+func (x *GetHostInfoResponse) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+func (x *GetHostInfoResponse) GetPeers() uint32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *GetHostInfoResponse) GetTxIndex() bool {
+	if x != nil {
+		return x.TxIndex
+	}
+	return false
+}
+
+func (x *GetHostInfoResponse) GetWalletServer() bool {
+	if x != nil {
+		return x.WalletServer
+	}
+	return false
+}
+
+type GetNetworkKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetLogLevelResponse) Reset() {
-	*x = SetLogLevelResponse{}
+func (x *GetNetworkKeyRequest) Reset() {
+	*x = GetNetworkKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[112]
+		mi := &file_ilxrpc_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLogLevelResponse) String() string {
+func (x *GetNetworkKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLogLevelResponse) ProtoMessage() {}
+func (*GetNetworkKeyRequest) ProtoMessage() {}
 
-func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[112]
+func (x *GetNetworkKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5992,34 +6492,37 @@ func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
-func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use GetNetworkKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{117}
 }
 
-type GetMinFeePerKilobyteRequest struct {
+type GetNetworkKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The nodes' serialized network key
+	NetworkPrivateKey []byte `protobuf:"bytes,1,opt,name=networkPrivateKey,proto3" json:"networkPrivateKey,omitempty"`
 }
 
-func (x *GetMinFeePerKilobyteRequest) Reset() {
-	*x = GetMinFeePerKilobyteRequest{}
+func (x *GetNetworkKeyResponse) Reset() {
+	*x = GetNetworkKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[113]
+		mi := &file_ilxrpc_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinFeePerKilobyteRequest) String() string {
+func (x *GetNetworkKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinFeePerKilobyteRequest) ProtoMessage() {}
+func (*GetNetworkKeyResponse) ProtoMessage() {}
 
-func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[113]
+func (x *GetNetworkKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6030,37 +6533,41 @@ func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
-func (*GetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use GetNetworkKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{118}
 }
 
-type GetMinFeePerKilobyteResponse struct {
+func (x *GetNetworkKeyResponse) GetNetworkPrivateKey() []byte {
+	if x != nil {
+		return x.NetworkPrivateKey
+	}
+	return nil
+}
+
+type GetPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Fee per kilobyte response
-	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *GetMinFeePerKilobyteResponse) Reset() {
-	*x = GetMinFeePerKilobyteResponse{}
+func (x *GetPeersRequest) Reset() {
+	*x = GetPeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[114]
+		mi := &file_ilxrpc_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinFeePerKilobyteResponse) String() string {
+func (x *GetPeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinFeePerKilobyteResponse) ProtoMessage() {}
+func (*GetPeersRequest) ProtoMessage() {}
 
-func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[114]
+func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6071,44 +6578,37 @@ func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
-func (*GetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *GetMinFeePerKilobyteResponse) GetFeePerKilobyte() uint64 {
-	if x != nil {
-		return x.FeePerKilobyte
-	}
-	return 0
+// Deprecated: Use GetPeersRequest.ProtoReflect.Descriptor instead.
+func (*GetPeersRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{119}
 }
 
-type SetMinFeePerKilobyteRequest struct {
+type GetPeersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Fee per byte to set
-	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// List of peers
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *SetMinFeePerKilobyteRequest) Reset() {
-	*x = SetMinFeePerKilobyteRequest{}
+func (x *GetPeersResponse) Reset() {
+	*x = GetPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[115]
+		mi := &file_ilxrpc_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinFeePerKilobyteRequest) String() string {
+func (x *GetPeersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinFeePerKilobyteRequest) ProtoMessage() {}
+func (*GetPeersResponse) ProtoMessage() {}
 
-func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[115]
+func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6119,41 +6619,45 @@ func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
-func (*SetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GetPeersResponse.ProtoReflect.Descriptor instead.
+func (*GetPeersResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *SetMinFeePerKilobyteRequest) GetFeePerKilobyte() uint64 {
+func (x *GetPeersResponse) GetPeers() []*Peer {
 	if x != nil {
-		return x.FeePerKilobyte
+		return x.Peers
 	}
-	return 0
+	return nil
 }
 
-type SetMinFeePerKilobyteResponse struct {
+type AddPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The peer addr to add. The IP addresses for the peer
+	// will be looked up in the DHT if necessary.
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *SetMinFeePerKilobyteResponse) Reset() {
-	*x = SetMinFeePerKilobyteResponse{}
+func (x *AddPeerRequest) Reset() {
+	*x = AddPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[116]
+		mi := &file_ilxrpc_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinFeePerKilobyteResponse) String() string {
+func (x *AddPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinFeePerKilobyteResponse) ProtoMessage() {}
+func (*AddPeerRequest) ProtoMessage() {}
 
-func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[116]
+func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6164,34 +6668,41 @@ func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
-func (*SetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
+func (*AddPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{121}
 }
 
-type GetMinStakeRequest struct {
+func (x *AddPeerRequest) GetPeer_ID() string {
+	if x != nil {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+type AddPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetMinStakeRequest) Reset() {
-	*x = GetMinStakeRequest{}
+func (x *AddPeerResponse) Reset() {
+	*x = AddPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[117]
+		mi := &file_ilxrpc_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinStakeRequest) String() string {
+func (x *AddPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinStakeRequest) ProtoMessage() {}
+func (*AddPeerResponse) ProtoMessage() {}
 
-func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[117]
+func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6202,38 +6713,38 @@ func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinStakeRequest.ProtoReflect.Descriptor instead.
-func (*GetMinStakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
+func (*AddPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{122}
 }
 
-type GetMinStakeResponse struct {
+type BlockPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Minimum stake response
-	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
+	// Peer ID to block
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *GetMinStakeResponse) Reset() {
-	*x = GetMinStakeResponse{}
+func (x *BlockPeerRequest) Reset() {
+	*x = BlockPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[118]
+		mi := &file_ilxrpc_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinStakeResponse) String() string {
+func (x *BlockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinStakeResponse) ProtoMessage() {}
+func (*BlockPeerRequest) ProtoMessage() {}
 
-func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[118]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -6243,44 +6754,41 @@ func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinStakeResponse.ProtoReflect.Descriptor instead.
-func (*GetMinStakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use BlockPeerRequest.ProtoReflect.Descriptor instead.
+func (*BlockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetMinStakeResponse) GetMinStakeAmount() uint64 {
+func (x *BlockPeerRequest) GetPeer_ID() string {
 	if x != nil {
-		return x.MinStakeAmount
+		return x.Peer_ID
 	}
-	return 0
+	return ""
 }
 
-type SetMinStakeRequest struct {
+type BlockPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Minimum stake amount to set
-	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *SetMinStakeRequest) Reset() {
-	*x = SetMinStakeRequest{}
+func (x *BlockPeerResponse) Reset() {
+	*x = BlockPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[119]
+		mi := &file_ilxrpc_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinStakeRequest) String() string {
+func (x *BlockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinStakeRequest) ProtoMessage() {}
+func (*BlockPeerResponse) ProtoMessage() {}
 
-func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[119]
+func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6291,41 +6799,37 @@ func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinStakeRequest.ProtoReflect.Descriptor instead.
-func (*SetMinStakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{119}
-}
-
-func (x *SetMinStakeRequest) GetMinStakeAmount() uint64 {
-	if x != nil {
-		return x.MinStakeAmount
-	}
-	return 0
+// Deprecated: Use BlockPeerResponse.ProtoReflect.Descriptor instead.
+func (*BlockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{124}
 }
 
-type SetMinStakeResponse struct {
+type UnblockPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Peer ID to unblock
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *SetMinStakeResponse) Reset() {
-	*x = SetMinStakeResponse{}
+func (x *UnblockPeerRequest) Reset() {
+	*x = UnblockPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[120]
+		mi := &file_ilxrpc_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinStakeResponse) String() string {
+func (x *UnblockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinStakeResponse) ProtoMessage() {}
+func (*UnblockPeerRequest) ProtoMessage() {}
 
-func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[120]
+func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6336,34 +6840,41 @@ func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinStakeResponse.ProtoReflect.Descriptor instead.
-func (*SetMinStakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use UnblockPeerRequest.ProtoReflect.Descriptor instead.
+func (*UnblockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{125}
 }
 
-type GetBlockSizeSoftLimitRequest struct {
+func (x *UnblockPeerRequest) GetPeer_ID() string {
+	if x != nil {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+type UnblockPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetBlockSizeSoftLimitRequest) Reset() {
-	*x = GetBlockSizeSoftLimitRequest{}
+func (x *UnblockPeerResponse) Reset() {
+	*x = UnblockPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[121]
+		mi := &file_ilxrpc_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockSizeSoftLimitRequest) String() string {
+func (x *UnblockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockSizeSoftLimitRequest) ProtoMessage() {}
+func (*UnblockPeerResponse) ProtoMessage() {}
 
-func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[121]
+func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6374,37 +6885,41 @@ func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use UnblockPeerResponse.ProtoReflect.Descriptor instead.
+func (*UnblockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{126}
 }
 
-type GetBlockSizeSoftLimitResponse struct {
+type SetLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Block size response
-	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	// The debug level to set the logging to
+	Level SetLogLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=pb.SetLogLevelRequest_Level" json:"level,omitempty"`
+	// The subsystem to set the level for. If empty the level is
+	// set for all subsystems.
+	// [blockchain, consensus, gen, indexers, mempool, net, repo, sync, zk]
+	Subsystem string `protobuf:"bytes,2,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
 }
 
-func (x *GetBlockSizeSoftLimitResponse) Reset() {
-	*x = GetBlockSizeSoftLimitResponse{}
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[122]
+		mi := &file_ilxrpc_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockSizeSoftLimitResponse) String() string {
+func (x *SetLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockSizeSoftLimitResponse) ProtoMessage() {}
+func (*SetLogLevelRequest) ProtoMessage() {}
 
-func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[122]
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6415,44 +6930,48 @@ func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetBlockSizeSoftLimitResponse) GetBlockSize() uint32 {
+func (x *SetLogLevelRequest) GetLevel() SetLogLevelRequest_Level {
 	if x != nil {
-		return x.BlockSize
+		return x.Level
 	}
-	return 0
+	return SetLogLevelRequest_DEBUG
 }
 
-type SetBlockSizeSoftLimitRequest struct {
+func (x *SetLogLevelRequest) GetSubsystem() string {
+	if x != nil {
+		return x.Subsystem
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Blocksize to set
-	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *SetBlockSizeSoftLimitRequest) Reset() {
-	*x = SetBlockSizeSoftLimitRequest{}
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[123]
+		mi := &file_ilxrpc_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetBlockSizeSoftLimitRequest) String() string {
+func (x *SetLogLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockSizeSoftLimitRequest) ProtoMessage() {}
+func (*SetLogLevelResponse) ProtoMessage() {}
 
-func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[123]
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6463,41 +6982,34 @@ func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
-func (*SetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{123}
-}
-
-func (x *SetBlockSizeSoftLimitRequest) GetBlockSize() uint32 {
-	if x != nil {
-		return x.BlockSize
-	}
-	return 0
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{128}
 }
 
-type SetBlockSizeSoftLimitResponse struct {
+type GetMinFeePerKilobyteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetBlockSizeSoftLimitResponse) Reset() {
-	*x = SetBlockSizeSoftLimitResponse{}
+func (x *GetMinFeePerKilobyteRequest) Reset() {
+	*x = GetMinFeePerKilobyteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[124]
+		mi := &file_ilxrpc_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetBlockSizeSoftLimitResponse) String() string {
+func (x *GetMinFeePerKilobyteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockSizeSoftLimitResponse) ProtoMessage() {}
+func (*GetMinFeePerKilobyteRequest) ProtoMessage() {}
 
-func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[124]
+func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6508,34 +7020,37 @@ func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
-func (*SetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use GetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
+func (*GetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{129}
 }
 
-type GetTreasuryWhitelistRequest struct {
+type GetMinFeePerKilobyteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Fee per kilobyte response
+	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *GetTreasuryWhitelistRequest) Reset() {
-	*x = GetTreasuryWhitelistRequest{}
+func (x *GetMinFeePerKilobyteResponse) Reset() {
+	*x = GetMinFeePerKilobyteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[125]
+		mi := &file_ilxrpc_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTreasuryWhitelistRequest) String() string {
+func (x *GetMinFeePerKilobyteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTreasuryWhitelistRequest) ProtoMessage() {}
+func (*GetMinFeePerKilobyteResponse) ProtoMessage() {}
 
-func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[125]
+func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6546,37 +7061,44 @@ func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
-func (*GetTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use GetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
+func (*GetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{130}
 }
 
-type GetTreasuryWhitelistResponse struct {
+func (x *GetMinFeePerKilobyteResponse) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+type SetMinFeePerKilobyteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Whitelisted txids
-	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
+	// Fee per byte to set
+	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *GetTreasuryWhitelistResponse) Reset() {
-	*x = GetTreasuryWhitelistResponse{}
+func (x *SetMinFeePerKilobyteRequest) Reset() {
+	*x = SetMinFeePerKilobyteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[126]
+		mi := &file_ilxrpc_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTreasuryWhitelistResponse) String() string {
+func (x *SetMinFeePerKilobyteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTreasuryWhitelistResponse) ProtoMessage() {}
+func (*SetMinFeePerKilobyteRequest) ProtoMessage() {}
 
-func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[126]
+func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6587,46 +7109,41 @@ func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
-func (*GetTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use SetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
+func (*SetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetTreasuryWhitelistResponse) GetTxids() [][]byte {
+func (x *SetMinFeePerKilobyteRequest) GetFeePerKilobyte() uint64 {
 	if x != nil {
-		return x.Txids
+		return x.FeePerKilobyte
 	}
-	return nil
+	return 0
 }
 
-type UpdateTreasuryWhitelistRequest struct {
+type SetMinFeePerKilobyteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Txids to add to the whitelist
-	Add [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
-	// Txids to remove from the whitelist
-	Remove [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *UpdateTreasuryWhitelistRequest) Reset() {
-	*x = UpdateTreasuryWhitelistRequest{}
+func (x *SetMinFeePerKilobyteResponse) Reset() {
+	*x = SetMinFeePerKilobyteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[127]
+		mi := &file_ilxrpc_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTreasuryWhitelistRequest) String() string {
+func (x *SetMinFeePerKilobyteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTreasuryWhitelistRequest) ProtoMessage() {}
+func (*SetMinFeePerKilobyteResponse) ProtoMessage() {}
 
-func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[127]
+func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6637,48 +7154,34 @@ func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{127}
-}
-
-func (x *UpdateTreasuryWhitelistRequest) GetAdd() [][]byte {
-	if x != nil {
-		return x.Add
-	}
-	return nil
-}
-
-func (x *UpdateTreasuryWhitelistRequest) GetRemove() [][]byte {
-	if x != nil {
-		return x.Remove
-	}
-	return nil
+// Deprecated: Use SetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
+func (*SetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{132}
 }
 
-type UpdateTreasuryWhitelistResponse struct {
+type GetMinStakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateTreasuryWhitelistResponse) Reset() {
-	*x = UpdateTreasuryWhitelistResponse{}
+func (x *GetMinStakeRequest) Reset() {
+	*x = GetMinStakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[128]
+		mi := &file_ilxrpc_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTreasuryWhitelistResponse) String() string {
+func (x *GetMinStakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTreasuryWhitelistResponse) ProtoMessage() {}
+func (*GetMinStakeRequest) ProtoMessage() {}
 
-func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[128]
+func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6689,41 +7192,37 @@ func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use GetMinStakeRequest.ProtoReflect.Descriptor instead.
+func (*GetMinStakeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{133}
 }
 
-type ReconsiderBlockRequest struct {
+type GetMinStakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Block ID to reconsider.
-	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
-	// We likely don't have the block and will have to download it from
-	// another peer. You can set the peer here. If empty we will try to find
-	// it form a few random peers.
-	DownloadPeer string `protobuf:"bytes,2,opt,name=download_peer,json=downloadPeer,proto3" json:"download_peer,omitempty"`
+	// Minimum stake response
+	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *ReconsiderBlockRequest) Reset() {
-	*x = ReconsiderBlockRequest{}
+func (x *GetMinStakeResponse) Reset() {
+	*x = GetMinStakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[129]
+		mi := &file_ilxrpc_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconsiderBlockRequest) String() string {
+func (x *GetMinStakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconsiderBlockRequest) ProtoMessage() {}
+func (*GetMinStakeResponse) ProtoMessage() {}
 
-func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[129]
+func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6734,48 +7233,44 @@ func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconsiderBlockRequest.ProtoReflect.Descriptor instead.
-func (*ReconsiderBlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{129}
-}
-
-func (x *ReconsiderBlockRequest) GetBlock_ID() []byte {
-	if x != nil {
-		return x.Block_ID
-	}
-	return nil
+// Deprecated: Use GetMinStakeResponse.ProtoReflect.Descriptor instead.
+func (*GetMinStakeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ReconsiderBlockRequest) GetDownloadPeer() string {
+func (x *GetMinStakeResponse) GetMinStakeAmount() uint64 {
 	if x != nil {
-		return x.DownloadPeer
+		return x.MinStakeAmount
 	}
-	return ""
+	return 0
 }
 
-type ReconsiderBlockResponse struct {
+type SetMinStakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Minimum stake amount to set
+	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *ReconsiderBlockResponse) Reset() {
-	*x = ReconsiderBlockResponse{}
+func (x *SetMinStakeRequest) Reset() {
+	*x = SetMinStakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[130]
+		mi := &file_ilxrpc_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconsiderBlockResponse) String() string {
+func (x *SetMinStakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconsiderBlockResponse) ProtoMessage() {}
+func (*SetMinStakeRequest) ProtoMessage() {}
 
-func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[130]
+func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6786,34 +7281,41 @@ func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconsiderBlockResponse.ProtoReflect.Descriptor instead.
-func (*ReconsiderBlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use SetMinStakeRequest.ProtoReflect.Descriptor instead.
+func (*SetMinStakeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{135}
 }
 
-type RecomputeChainStateRequest struct {
+func (x *SetMinStakeRequest) GetMinStakeAmount() uint64 {
+	if x != nil {
+		return x.MinStakeAmount
+	}
+	return 0
+}
+
+type SetMinStakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RecomputeChainStateRequest) Reset() {
-	*x = RecomputeChainStateRequest{}
+func (x *SetMinStakeResponse) Reset() {
+	*x = SetMinStakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[131]
+		mi := &file_ilxrpc_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecomputeChainStateRequest) String() string {
+func (x *SetMinStakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecomputeChainStateRequest) ProtoMessage() {}
+func (*SetMinStakeResponse) ProtoMessage() {}
 
-func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[131]
+func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6824,34 +7326,34 @@ func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecomputeChainStateRequest.ProtoReflect.Descriptor instead.
-func (*RecomputeChainStateRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use SetMinStakeResponse.ProtoReflect.Descriptor instead.
+func (*SetMinStakeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{136}
 }
 
-type RecomputeChainStateResponse struct {
+type GetBlockSizeSoftLimitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RecomputeChainStateResponse) Reset() {
-	*x = RecomputeChainStateResponse{}
+func (x *GetBlockSizeSoftLimitRequest) Reset() {
+	*x = GetBlockSizeSoftLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[132]
+		mi := &file_ilxrpc_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecomputeChainStateResponse) String() string {
+func (x *GetBlockSizeSoftLimitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecomputeChainStateResponse) ProtoMessage() {}
+func (*GetBlockSizeSoftLimitRequest) ProtoMessage() {}
 
-func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[132]
+func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6862,39 +7364,37 @@ func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecomputeChainStateResponse.ProtoReflect.Descriptor instead.
-func (*RecomputeChainStateResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use GetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{137}
 }
 
-// NOTIFICATIONS
-type TransactionNotification struct {
+type GetBlockSizeSoftLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction in this notification has finalized and
-	// been added to the blockchain.
-	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Block size response
+	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *TransactionNotification) Reset() {
-	*x = TransactionNotification{}
+func (x *GetBlockSizeSoftLimitResponse) Reset() {
+	*x = GetBlockSizeSoftLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[133]
+		mi := &file_ilxrpc_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionNotification) String() string {
+func (x *GetBlockSizeSoftLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionNotification) ProtoMessage() {}
+func (*GetBlockSizeSoftLimitResponse) ProtoMessage() {}
 
-func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[133]
+func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6905,45 +7405,44 @@ func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
-func (*TransactionNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use GetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *TransactionNotification) GetTransaction() *transactions.Transaction {
+func (x *GetBlockSizeSoftLimitResponse) GetBlockSize() uint32 {
 	if x != nil {
-		return x.Transaction
+		return x.BlockSize
 	}
-	return nil
+	return 0
 }
 
-type WalletTransactionNotification struct {
+type SetBlockSizeSoftLimitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction in this notification has finalized and
-	// been added to the blockchain.
-	Transaction *WalletTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Blocksize to set
+	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *WalletTransactionNotification) Reset() {
-	*x = WalletTransactionNotification{}
+func (x *SetBlockSizeSoftLimitRequest) Reset() {
+	*x = SetBlockSizeSoftLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[134]
+		mi := &file_ilxrpc_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransactionNotification) String() string {
+func (x *SetBlockSizeSoftLimitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransactionNotification) ProtoMessage() {}
+func (*SetBlockSizeSoftLimitRequest) ProtoMessage() {}
 
-func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[134]
+func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6954,46 +7453,41 @@ func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransactionNotification.ProtoReflect.Descriptor instead.
-func (*WalletTransactionNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use SetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *WalletTransactionNotification) GetTransaction() *WalletTransaction {
+func (x *SetBlockSizeSoftLimitRequest) GetBlockSize() uint32 {
 	if x != nil {
-		return x.Transaction
+		return x.BlockSize
 	}
-	return nil
+	return 0
 }
 
-type WalletSyncNotification struct {
+type SetBlockSizeSoftLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The current height the wallet is synced up to
-	CurrentHeight uint32 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
-	// The height of the chain that the wallet is syncing to
-	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 }
 
-func (x *WalletSyncNotification) Reset() {
-	*x = WalletSyncNotification{}
+func (x *SetBlockSizeSoftLimitResponse) Reset() {
+	*x = SetBlockSizeSoftLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[135]
+		mi := &file_ilxrpc_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletSyncNotification) String() string {
+func (x *SetBlockSizeSoftLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletSyncNotification) ProtoMessage() {}
+func (*SetBlockSizeSoftLimitResponse) ProtoMessage() {}
 
-func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[135]
+func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7004,56 +7498,34 @@ func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletSyncNotification.ProtoReflect.Descriptor instead.
-func (*WalletSyncNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{135}
-}
-
-func (x *WalletSyncNotification) GetCurrentHeight() uint32 {
-	if x != nil {
-		return x.CurrentHeight
-	}
-	return 0
-}
-
-func (x *WalletSyncNotification) GetBestHeight() uint32 {
-	if x != nil {
-		return x.BestHeight
-	}
-	return 0
+// Deprecated: Use SetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{140}
 }
 
-type BlockNotification struct {
+type GetTreasuryWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The BlockInfo (including header data) for the block
-	BlockInfo *BlockInfo `protobuf:"bytes,1,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
-	// The blocks transactions (if requested).
-	//
-	// The transactions will either be returned in for or just the txids depending
-	// on the request.
-	Transactions []*TransactionData `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *BlockNotification) Reset() {
-	*x = BlockNotification{}
+func (x *GetTreasuryWhitelistRequest) Reset() {
+	*x = GetTreasuryWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[136]
+		mi := &file_ilxrpc_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockNotification) String() string {
+func (x *GetTreasuryWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockNotification) ProtoMessage() {}
+func (*GetTreasuryWhitelistRequest) ProtoMessage() {}
 
-func (x *BlockNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[136]
+func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7064,52 +7536,37 @@ func (x *BlockNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
-func (*BlockNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{136}
-}
-
-func (x *BlockNotification) GetBlockInfo() *BlockInfo {
-	if x != nil {
-		return x.BlockInfo
-	}
-	return nil
-}
-
-func (x *BlockNotification) GetTransactions() []*TransactionData {
-	if x != nil {
-		return x.Transactions
-	}
-	return nil
+// Deprecated: Use GetTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*GetTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{141}
 }
 
-type CompressedBlockNotification struct {
+type GetTreasuryWhitelistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A compressed block containing only the height,
-	// txids, outputs, and nullifiers.
-	Block *blocks.CompressedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Whitelisted txids
+	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
 }
 
-func (x *CompressedBlockNotification) Reset() {
-	*x = CompressedBlockNotification{}
+func (x *GetTreasuryWhitelistResponse) Reset() {
+	*x = GetTreasuryWhitelistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[137]
+		mi := &file_ilxrpc_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompressedBlockNotification) String() string {
+func (x *GetTreasuryWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompressedBlockNotification) ProtoMessage() {}
+func (*GetTreasuryWhitelistResponse) ProtoMessage() {}
 
-func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[137]
+func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7120,48 +7577,46 @@ func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompressedBlockNotification.ProtoReflect.Descriptor instead.
-func (*CompressedBlockNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use GetTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*GetTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *CompressedBlockNotification) GetBlock() *blocks.CompressedBlock {
+func (x *GetTreasuryWhitelistResponse) GetTxids() [][]byte {
 	if x != nil {
-		return x.Block
+		return x.Txids
 	}
 	return nil
 }
 
-// DATA MESSAGES
-type TransactionData struct {
+type UpdateTreasuryWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to TxidsOrTxs:
-	//
-	//	*TransactionData_Transaction_ID
-	//	*TransactionData_Transaction
-	TxidsOrTxs isTransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+	// Txids to add to the whitelist
+	Add [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	// Txids to remove from the whitelist
+	Remove [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *TransactionData) Reset() {
-	*x = TransactionData{}
+func (x *UpdateTreasuryWhitelistRequest) Reset() {
+	*x = UpdateTreasuryWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[138]
+		mi := &file_ilxrpc_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionData) String() string {
+func (x *UpdateTreasuryWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionData) ProtoMessage() {}
+func (*UpdateTreasuryWhitelistRequest) ProtoMessage() {}
 
-func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[138]
+func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7172,94 +7627,93 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
-func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use UpdateTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (m *TransactionData) GetTxidsOrTxs() isTransactionData_TxidsOrTxs {
-	if m != nil {
-		return m.TxidsOrTxs
+func (x *UpdateTreasuryWhitelistRequest) GetAdd() [][]byte {
+	if x != nil {
+		return x.Add
 	}
 	return nil
 }
 
-func (x *TransactionData) GetTransaction_ID() []byte {
-	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction_ID); ok {
-		return x.Transaction_ID
+func (x *UpdateTreasuryWhitelistRequest) GetRemove() [][]byte {
+	if x != nil {
+		return x.Remove
 	}
 	return nil
 }
 
-func (x *TransactionData) GetTransaction() *transactions.Transaction {
-	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction); ok {
-		return x.Transaction
-	}
-	return nil
+type UpdateTreasuryWhitelistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type isTransactionData_TxidsOrTxs interface {
-	isTransactionData_TxidsOrTxs()
+func (x *UpdateTreasuryWhitelistResponse) Reset() {
+	*x = UpdateTreasuryWhitelistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type TransactionData_Transaction_ID struct {
-	// Just the transaction ID
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3,oneof"`
+func (x *UpdateTreasuryWhitelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type TransactionData_Transaction struct {
-	// A marshaled transaction.
-	Transaction *transactions.Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
-}
+func (*UpdateTreasuryWhitelistResponse) ProtoMessage() {}
 
-func (*TransactionData_Transaction_ID) isTransactionData_TxidsOrTxs() {}
+func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*TransactionData_Transaction) isTransactionData_TxidsOrTxs() {}
+// Deprecated: Use UpdateTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{144}
+}
 
-type BlockInfo struct {
+type ReconsiderBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The hash of the block header.
+	// Block ID to reconsider.
 	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
-	// A version number to track software/protocol upgrades.
-	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// The height of the block in the chain.
-	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// The block in the chain right before this one.
-	Parent []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The next block in the chain (or nil if this block is the tip).
-	Child []byte `protobuf:"bytes,5,opt,name=child,proto3" json:"child,omitempty"`
-	// The timestamp of the block. Expressed in seconds since 1970-01-01.
-	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The root of the Merkle Tree built from all transactions in the block.
-	TxRoot []byte `protobuf:"bytes,7,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
-	// The peerID of the validator that created the block.
-	Producer_ID []byte `protobuf:"bytes,8,opt,name=producer_ID,json=producerID,proto3" json:"producer_ID,omitempty"`
-	// The size of the block in bytes.
-	Size uint32 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
-	// The number of transactions in the block.
-	NumTxs uint32 `protobuf:"varint,10,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	// We likely don't have the block and will have to download it from
+	// another peer. You can set the peer here. If empty we will try to find
+	// it form a few random peers.
+	DownloadPeer string `protobuf:"bytes,2,opt,name=download_peer,json=downloadPeer,proto3" json:"download_peer,omitempty"`
 }
 
-func (x *BlockInfo) Reset() {
-	*x = BlockInfo{}
+func (x *ReconsiderBlockRequest) Reset() {
+	*x = ReconsiderBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[139]
+		mi := &file_ilxrpc_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockInfo) String() string {
+func (x *ReconsiderBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockInfo) ProtoMessage() {}
+func (*ReconsiderBlockRequest) ProtoMessage() {}
 
-func (x *BlockInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[139]
+func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7270,29 +7724,741 @@ func (x *BlockInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
-func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use ReconsiderBlockRequest.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *BlockInfo) GetBlock_ID() []byte {
+func (x *ReconsiderBlockRequest) GetBlock_ID() []byte {
 	if x != nil {
 		return x.Block_ID
 	}
 	return nil
 }
 
-func (x *BlockInfo) GetVersion() uint32 {
+func (x *ReconsiderBlockRequest) GetDownloadPeer() string {
 	if x != nil {
-		return x.Version
+		return x.DownloadPeer
 	}
-	return 0
+	return ""
 }
 
-func (x *BlockInfo) GetHeight() uint32 {
-	if x != nil {
-		return x.Height
-	}
+type ReconsiderBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReconsiderBlockResponse) Reset() {
+	*x = ReconsiderBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconsiderBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconsiderBlockResponse) ProtoMessage() {}
+
+func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconsiderBlockResponse.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{146}
+}
+
+type RecomputeChainStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecomputeChainStateRequest) Reset() {
+	*x = RecomputeChainStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecomputeChainStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeChainStateRequest) ProtoMessage() {}
+
+func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeChainStateRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeChainStateRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{147}
+}
+
+type RecomputeChainStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecomputeChainStateResponse) Reset() {
+	*x = RecomputeChainStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecomputeChainStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeChainStateResponse) ProtoMessage() {}
+
+func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeChainStateResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeChainStateResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{148}
+}
+
+type GetNodeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNodeStatusRequest) Reset() {
+	*x = GetNodeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusRequest) ProtoMessage() {}
+
+func (x *GetNodeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{149}
+}
+
+type GetNodeStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The node's software version
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The number of seconds since the node started
+	Uptime int64 `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// Which network the node is operating on
+	Network GetBlockchainInfoResponse_Network `protobuf:"varint,3,opt,name=network,proto3,enum=pb.GetBlockchainInfoResponse_Network" json:"network,omitempty"`
+	// Whether the node is synced to the tip of the chain
+	Synced bool `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	// The height of the last finalized block
+	BestHeight uint32 `protobuf:"varint,5,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// The ID of the last finalized block
+	BestBlock_ID []byte `protobuf:"bytes,6,opt,name=best_block_ID,json=bestBlockID,proto3" json:"best_block_ID,omitempty"`
+	// The timestamp of the last finalized block
+	BlockTime int64 `protobuf:"varint,7,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// A summary of the node's peers
+	Peers *GetNodeStatusResponse_PeerSummary `protobuf:"bytes,8,opt,name=peers,proto3" json:"peers,omitempty"`
+	// A summary of the node's mempool
+	Mempool *GetNodeStatusResponse_MempoolSummary `protobuf:"bytes,9,opt,name=mempool,proto3" json:"mempool,omitempty"`
+	// The status of this node as a validator
+	Validator *GetNodeStatusResponse_ValidatorStatus `protobuf:"bytes,10,opt,name=validator,proto3" json:"validator,omitempty"`
+	// The node's resource usage
+	Resources *GetNodeStatusResponse_ResourceUsage `protobuf:"bytes,11,opt,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *GetNodeStatusResponse) Reset() {
+	*x = GetNodeStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetNodeStatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetNodeStatusResponse) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse) GetNetwork() GetBlockchainInfoResponse_Network {
+	if x != nil {
+		return x.Network
+	}
+	return GetBlockchainInfoResponse_MAINNET
+}
+
+func (x *GetNodeStatusResponse) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *GetNodeStatusResponse) GetBestHeight() uint32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse) GetBestBlock_ID() []byte {
+	if x != nil {
+		return x.BestBlock_ID
+	}
+	return nil
+}
+
+func (x *GetNodeStatusResponse) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse) GetPeers() *GetNodeStatusResponse_PeerSummary {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *GetNodeStatusResponse) GetMempool() *GetNodeStatusResponse_MempoolSummary {
+	if x != nil {
+		return x.Mempool
+	}
+	return nil
+}
+
+func (x *GetNodeStatusResponse) GetValidator() *GetNodeStatusResponse_ValidatorStatus {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *GetNodeStatusResponse) GetResources() *GetNodeStatusResponse_ResourceUsage {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// NOTIFICATIONS
+type TransactionNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction in this notification has finalized and
+	// been added to the blockchain.
+	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *TransactionNotification) Reset() {
+	*x = TransactionNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionNotification) ProtoMessage() {}
+
+func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
+func (*TransactionNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *TransactionNotification) GetTransaction() *transactions.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type WalletTransactionNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction in this notification has finalized and
+	// been added to the blockchain.
+	Transaction *WalletTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *WalletTransactionNotification) Reset() {
+	*x = WalletTransactionNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletTransactionNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransactionNotification) ProtoMessage() {}
+
+func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransactionNotification.ProtoReflect.Descriptor instead.
+func (*WalletTransactionNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *WalletTransactionNotification) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type WalletSyncNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current height the wallet is synced up to
+	CurrentHeight uint32 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// The height of the chain that the wallet is syncing to
+	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+}
+
+func (x *WalletSyncNotification) Reset() {
+	*x = WalletSyncNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletSyncNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSyncNotification) ProtoMessage() {}
+
+func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSyncNotification.ProtoReflect.Descriptor instead.
+func (*WalletSyncNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *WalletSyncNotification) GetCurrentHeight() uint32 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *WalletSyncNotification) GetBestHeight() uint32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+type BlockNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The BlockInfo (including header data) for the block
+	BlockInfo *BlockInfo `protobuf:"bytes,1,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
+	// The blocks transactions (if requested).
+	//
+	// The transactions will either be returned in for or just the txids depending
+	// on the request.
+	Transactions []*TransactionData `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *BlockNotification) Reset() {
+	*x = BlockNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockNotification) ProtoMessage() {}
+
+func (x *BlockNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BlockNotification) GetBlockInfo() *BlockInfo {
+	if x != nil {
+		return x.BlockInfo
+	}
+	return nil
+}
+
+func (x *BlockNotification) GetTransactions() []*TransactionData {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type CompressedBlockNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A compressed block containing only the height,
+	// txids, outputs, and nullifiers.
+	Block *blocks.CompressedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *CompressedBlockNotification) Reset() {
+	*x = CompressedBlockNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompressedBlockNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressedBlockNotification) ProtoMessage() {}
+
+func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressedBlockNotification.ProtoReflect.Descriptor instead.
+func (*CompressedBlockNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *CompressedBlockNotification) GetBlock() *blocks.CompressedBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+// DATA MESSAGES
+type TransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to TxidsOrTxs:
+	//
+	//	*TransactionData_Transaction_ID
+	//	*TransactionData_Transaction
+	TxidsOrTxs isTransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+}
+
+func (x *TransactionData) Reset() {
+	*x = TransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionData) ProtoMessage() {}
+
+func (x *TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
+func (*TransactionData) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{156}
+}
+
+func (m *TransactionData) GetTxidsOrTxs() isTransactionData_TxidsOrTxs {
+	if m != nil {
+		return m.TxidsOrTxs
+	}
+	return nil
+}
+
+func (x *TransactionData) GetTransaction_ID() []byte {
+	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction_ID); ok {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *TransactionData) GetTransaction() *transactions.Transaction {
+	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+type isTransactionData_TxidsOrTxs interface {
+	isTransactionData_TxidsOrTxs()
+}
+
+type TransactionData_Transaction_ID struct {
+	// Just the transaction ID
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3,oneof"`
+}
+
+type TransactionData_Transaction struct {
+	// A marshaled transaction.
+	Transaction *transactions.Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*TransactionData_Transaction_ID) isTransactionData_TxidsOrTxs() {}
+
+func (*TransactionData_Transaction) isTransactionData_TxidsOrTxs() {}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the block header.
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// A version number to track software/protocol upgrades.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The height of the block in the chain.
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The block in the chain right before this one.
+	Parent []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The next block in the chain (or nil if this block is the tip).
+	Child []byte `protobuf:"bytes,5,opt,name=child,proto3" json:"child,omitempty"`
+	// The timestamp of the block. Expressed in seconds since 1970-01-01.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The root of the Merkle Tree built from all transactions in the block.
+	TxRoot []byte `protobuf:"bytes,7,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
+	// The peerID of the validator that created the block.
+	Producer_ID []byte `protobuf:"bytes,8,opt,name=producer_ID,json=producerID,proto3" json:"producer_ID,omitempty"`
+	// The size of the block in bytes.
+	Size uint32 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	// The number of transactions in the block.
+	NumTxs uint32 `protobuf:"varint,10,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *BlockInfo) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
 	return 0
 }
 
@@ -7367,7 +8533,7 @@ type Validator struct {
 func (x *Validator) Reset() {
 	*x = Validator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[140]
+		mi := &file_ilxrpc_proto_msgTypes[158]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7380,7 +8546,7 @@ func (x *Validator) String() string {
 func (*Validator) ProtoMessage() {}
 
 func (x *Validator) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[140]
+	mi := &file_ilxrpc_proto_msgTypes[158]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7393,7 +8559,7 @@ func (x *Validator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Validator.ProtoReflect.Descriptor instead.
 func (*Validator) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{140}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *Validator) GetValidator_ID() []byte {
@@ -7417,23 +8583,182 @@ func (x *Validator) GetStakeWeight() uint64 {
 	return 0
 }
 
-func (x *Validator) GetStake() []*Validator_Stake {
+func (x *Validator) GetStake() []*Validator_Stake {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *Validator) GetUnclaimedCoins() uint64 {
+	if x != nil {
+		return x.UnclaimedCoins
+	}
+	return 0
+}
+
+func (x *Validator) GetEpochBlocks() uint32 {
+	if x != nil {
+		return x.EpochBlocks
+	}
+	return 0
+}
+
+type ValidatorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of event
+	Type ValidatorEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.ValidatorEvent_Type" json:"type,omitempty"`
+	// The height of the block containing the event
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The ID of the transaction which caused the event
+	Transaction_ID []byte `protobuf:"bytes,3,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The amount staked, unstaked or claimed
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The staked nullifier. Empty for coinbase events.
+	Nullifier []byte `protobuf:"bytes,5,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
+}
+
+func (x *ValidatorEvent) Reset() {
+	*x = ValidatorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEvent) ProtoMessage() {}
+
+func (x *ValidatorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorEvent.ProtoReflect.Descriptor instead.
+func (*ValidatorEvent) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ValidatorEvent) GetType() ValidatorEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ValidatorEvent_STAKE
+}
+
+func (x *ValidatorEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ValidatorEvent) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *ValidatorEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ValidatorEvent) GetNullifier() []byte {
+	if x != nil {
+		return x.Nullifier
+	}
+	return nil
+}
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the account
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The account's human-readable label
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The addresses belonging to the account
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The sum of the account's unspent outputs
+	Balance uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetLabel() string {
 	if x != nil {
-		return x.Stake
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *Validator) GetUnclaimedCoins() uint64 {
+func (x *Account) GetAddresses() []string {
 	if x != nil {
-		return x.UnclaimedCoins
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-func (x *Validator) GetEpochBlocks() uint32 {
+func (x *Account) GetBalance() uint64 {
 	if x != nil {
-		return x.EpochBlocks
+		return x.Balance
 	}
 	return 0
 }
@@ -7462,7 +8787,7 @@ type Utxo struct {
 func (x *Utxo) Reset() {
 	*x = Utxo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[141]
+		mi := &file_ilxrpc_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7475,7 +8800,7 @@ func (x *Utxo) String() string {
 func (*Utxo) ProtoMessage() {}
 
 func (x *Utxo) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[141]
+	mi := &file_ilxrpc_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7488,7 +8813,7 @@ func (x *Utxo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{141}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *Utxo) GetCommitment() []byte {
@@ -7549,7 +8874,7 @@ type RawTransaction struct {
 func (x *RawTransaction) Reset() {
 	*x = RawTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[142]
+		mi := &file_ilxrpc_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7562,7 +8887,7 @@ func (x *RawTransaction) String() string {
 func (*RawTransaction) ProtoMessage() {}
 
 func (x *RawTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[142]
+	mi := &file_ilxrpc_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7575,7 +8900,7 @@ func (x *RawTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawTransaction.ProtoReflect.Descriptor instead.
 func (*RawTransaction) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{142}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *RawTransaction) GetTx() *transactions.Transaction {
@@ -7628,7 +8953,7 @@ type PrivateInput struct {
 func (x *PrivateInput) Reset() {
 	*x = PrivateInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[143]
+		mi := &file_ilxrpc_proto_msgTypes[163]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7641,7 +8966,7 @@ func (x *PrivateInput) String() string {
 func (*PrivateInput) ProtoMessage() {}
 
 func (x *PrivateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[143]
+	mi := &file_ilxrpc_proto_msgTypes[163]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7654,7 +8979,7 @@ func (x *PrivateInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateInput.ProtoReflect.Descriptor instead.
 func (*PrivateInput) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{143}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *PrivateInput) GetAmount() uint64 {
@@ -7733,7 +9058,7 @@ type PrivateOutput struct {
 func (x *PrivateOutput) Reset() {
 	*x = PrivateOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[144]
+		mi := &file_ilxrpc_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7746,7 +9071,7 @@ func (x *PrivateOutput) String() string {
 func (*PrivateOutput) ProtoMessage() {}
 
 func (x *PrivateOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[144]
+	mi := &file_ilxrpc_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7759,7 +9084,7 @@ func (x *PrivateOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateOutput.ProtoReflect.Descriptor instead.
 func (*PrivateOutput) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{144}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *PrivateOutput) GetScriptHash() []byte {
@@ -7818,7 +9143,7 @@ type TxoProof struct {
 func (x *TxoProof) Reset() {
 	*x = TxoProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[145]
+		mi := &file_ilxrpc_proto_msgTypes[165]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7831,7 +9156,7 @@ func (x *TxoProof) String() string {
 func (*TxoProof) ProtoMessage() {}
 
 func (x *TxoProof) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[145]
+	mi := &file_ilxrpc_proto_msgTypes[165]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7844,7 +9169,7 @@ func (x *TxoProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxoProof.ProtoReflect.Descriptor instead.
 func (*TxoProof) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{145}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *TxoProof) GetCommitment() []byte {
@@ -7898,7 +9223,7 @@ type Peer struct {
 func (x *Peer) Reset() {
 	*x = Peer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[146]
+		mi := &file_ilxrpc_proto_msgTypes[166]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7911,7 +9236,7 @@ func (x *Peer) String() string {
 func (*Peer) ProtoMessage() {}
 
 func (x *Peer) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[146]
+	mi := &file_ilxrpc_proto_msgTypes[166]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7924,7 +9249,7 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Peer.ProtoReflect.Descriptor instead.
 func (*Peer) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{146}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *Peer) GetId() string {
@@ -7970,7 +9295,7 @@ type WalletTransaction struct {
 func (x *WalletTransaction) Reset() {
 	*x = WalletTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[147]
+		mi := &file_ilxrpc_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7983,7 +9308,7 @@ func (x *WalletTransaction) String() string {
 func (*WalletTransaction) ProtoMessage() {}
 
 func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[147]
+	mi := &file_ilxrpc_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7996,7 +9321,7 @@ func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
 func (*WalletTransaction) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *WalletTransaction) GetTransaction_ID() []byte {
@@ -8042,7 +9367,7 @@ type CreateRawTransactionRequest_Input struct {
 func (x *CreateRawTransactionRequest_Input) Reset() {
 	*x = CreateRawTransactionRequest_Input{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[148]
+		mi := &file_ilxrpc_proto_msgTypes[168]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8055,7 +9380,7 @@ func (x *CreateRawTransactionRequest_Input) String() string {
 func (*CreateRawTransactionRequest_Input) ProtoMessage() {}
 
 func (x *CreateRawTransactionRequest_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[148]
+	mi := &file_ilxrpc_proto_msgTypes[168]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8068,7 +9393,7 @@ func (x *CreateRawTransactionRequest_Input) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateRawTransactionRequest_Input.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionRequest_Input) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{87, 0}
 }
 
 func (m *CreateRawTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput {
@@ -8130,7 +9455,7 @@ type CreateRawTransactionRequest_Output struct {
 func (x *CreateRawTransactionRequest_Output) Reset() {
 	*x = CreateRawTransactionRequest_Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[149]
+		mi := &file_ilxrpc_proto_msgTypes[169]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8143,7 +9468,7 @@ func (x *CreateRawTransactionRequest_Output) String() string {
 func (*CreateRawTransactionRequest_Output) ProtoMessage() {}
 
 func (x *CreateRawTransactionRequest_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[149]
+	mi := &file_ilxrpc_proto_msgTypes[169]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8156,7 +9481,7 @@ func (x *CreateRawTransactionRequest_Output) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateRawTransactionRequest_Output.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81, 1}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{87, 1}
 }
 
 func (x *CreateRawTransactionRequest_Output) GetAddress() string {
@@ -8195,7 +9520,7 @@ type CreateRawStakeTransactionRequest_Input struct {
 func (x *CreateRawStakeTransactionRequest_Input) Reset() {
 	*x = CreateRawStakeTransactionRequest_Input{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[150]
+		mi := &file_ilxrpc_proto_msgTypes[170]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8208,7 +9533,7 @@ func (x *CreateRawStakeTransactionRequest_Input) String() string {
 func (*CreateRawStakeTransactionRequest_Input) ProtoMessage() {}
 
 func (x *CreateRawStakeTransactionRequest_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[150]
+	mi := &file_ilxrpc_proto_msgTypes[170]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8221,7 +9546,7 @@ func (x *CreateRawStakeTransactionRequest_Input) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use CreateRawStakeTransactionRequest_Input.ProtoReflect.Descriptor instead.
 func (*CreateRawStakeTransactionRequest_Input) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{83, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{89, 0}
 }
 
 func (m *CreateRawStakeTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput {
@@ -8267,6 +9592,306 @@ func (*CreateRawStakeTransactionRequest_Input_Commitment) isCreateRawStakeTransa
 func (*CreateRawStakeTransactionRequest_Input_Input) isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput() {
 }
 
+type GetNodeStatusResponse_PeerSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of connected peers
+	Connected uint32 `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	// The number of inbound connections
+	Inbound uint32 `protobuf:"varint,2,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// The number of outbound connections
+	Outbound uint32 `protobuf:"varint,3,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	// The number of connected peers which are validators
+	Validators uint32 `protobuf:"varint,4,opt,name=validators,proto3" json:"validators,omitempty"`
+	// The number of banned peers
+	Banned uint32 `protobuf:"varint,5,opt,name=banned,proto3" json:"banned,omitempty"`
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) Reset() {
+	*x = GetNodeStatusResponse_PeerSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse_PeerSummary) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse_PeerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse_PeerSummary.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse_PeerSummary) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150, 0}
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) GetConnected() uint32 {
+	if x != nil {
+		return x.Connected
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) GetInbound() uint32 {
+	if x != nil {
+		return x.Inbound
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) GetOutbound() uint32 {
+	if x != nil {
+		return x.Outbound
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) GetValidators() uint32 {
+	if x != nil {
+		return x.Validators
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_PeerSummary) GetBanned() uint32 {
+	if x != nil {
+		return x.Banned
+	}
+	return 0
+}
+
+type GetNodeStatusResponse_MempoolSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The count of transactions in the mempool
+	Size uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// The size in bytes of all transactions in the mempool
+	Bytes uint32 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *GetNodeStatusResponse_MempoolSummary) Reset() {
+	*x = GetNodeStatusResponse_MempoolSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse_MempoolSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse_MempoolSummary) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse_MempoolSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse_MempoolSummary.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse_MempoolSummary) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150, 1}
+}
+
+func (x *GetNodeStatusResponse_MempoolSummary) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_MempoolSummary) GetBytes() uint32 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type GetNodeStatusResponse_ValidatorStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether this node is in the validator set
+	IsValidator bool `protobuf:"varint,1,opt,name=is_validator,json=isValidator,proto3" json:"is_validator,omitempty"`
+	// The number of coins staked by this node
+	Stake uint64 `protobuf:"varint,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	// The total number of coins staked on the network
+	TotalStaked uint64 `protobuf:"varint,3,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	// The coinbase coins which this node has yet to claim
+	UnclaimedCoins uint64 `protobuf:"varint,4,opt,name=unclaimed_coins,json=unclaimedCoins,proto3" json:"unclaimed_coins,omitempty"`
+	// The number of blocks this node produced this epoch
+	EpochBlocks uint32 `protobuf:"varint,5,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) Reset() {
+	*x = GetNodeStatusResponse_ValidatorStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse_ValidatorStatus) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse_ValidatorStatus.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse_ValidatorStatus) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150, 2}
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) GetIsValidator() bool {
+	if x != nil {
+		return x.IsValidator
+	}
+	return false
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) GetStake() uint64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) GetTotalStaked() uint64 {
+	if x != nil {
+		return x.TotalStaked
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) GetUnclaimedCoins() uint64 {
+	if x != nil {
+		return x.UnclaimedCoins
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ValidatorStatus) GetEpochBlocks() uint32 {
+	if x != nil {
+		return x.EpochBlocks
+	}
+	return 0
+}
+
+type GetNodeStatusResponse_ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of running goroutines
+	Goroutines uint32 `protobuf:"varint,1,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	// The bytes of allocated heap objects
+	HeapAlloc uint64 `protobuf:"varint,2,opt,name=heap_alloc,json=heapAlloc,proto3" json:"heap_alloc,omitempty"`
+	// The total bytes of memory obtained from the OS
+	SysMemory uint64 `protobuf:"varint,3,opt,name=sys_memory,json=sysMemory,proto3" json:"sys_memory,omitempty"`
+	// The number of CPUs available to the process
+	NumCpu uint32 `protobuf:"varint,4,opt,name=num_cpu,json=numCpu,proto3" json:"num_cpu,omitempty"`
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) Reset() {
+	*x = GetNodeStatusResponse_ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStatusResponse_ResourceUsage) ProtoMessage() {}
+
+func (x *GetNodeStatusResponse_ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStatusResponse_ResourceUsage.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse_ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150, 3}
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) GetGoroutines() uint32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) GetHeapAlloc() uint64 {
+	if x != nil {
+		return x.HeapAlloc
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) GetSysMemory() uint64 {
+	if x != nil {
+		return x.SysMemory
+	}
+	return 0
+}
+
+func (x *GetNodeStatusResponse_ResourceUsage) GetNumCpu() uint32 {
+	if x != nil {
+		return x.NumCpu
+	}
+	return 0
+}
+
 type Validator_Stake struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -8289,7 +9914,7 @@ type Validator_Stake struct {
 func (x *Validator_Stake) Reset() {
 	*x = Validator_Stake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[151]
+		mi := &file_ilxrpc_proto_msgTypes[175]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8302,7 +9927,7 @@ func (x *Validator_Stake) String() string {
 func (*Validator_Stake) ProtoMessage() {}
 
 func (x *Validator_Stake) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[151]
+	mi := &file_ilxrpc_proto_msgTypes[175]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8315,7 +9940,7 @@ func (x *Validator_Stake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Validator_Stake.ProtoReflect.Descriptor instead.
 func (*Validator_Stake) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{140, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{158, 0}
 }
 
 func (x *Validator_Stake) GetNullifier() []byte {
@@ -8372,7 +9997,7 @@ type WalletTransaction_IO struct {
 func (x *WalletTransaction_IO) Reset() {
 	*x = WalletTransaction_IO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[152]
+		mi := &file_ilxrpc_proto_msgTypes[176]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8385,7 +10010,7 @@ func (x *WalletTransaction_IO) String() string {
 func (*WalletTransaction_IO) ProtoMessage() {}
 
 func (x *WalletTransaction_IO) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[152]
+	mi := &file_ilxrpc_proto_msgTypes[176]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8398,7 +10023,7 @@ func (x *WalletTransaction_IO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletTransaction_IO.ProtoReflect.Descriptor instead.
 func (*WalletTransaction_IO) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{167, 0}
 }
 
 func (m *WalletTransaction_IO) GetIoType() isWalletTransaction_IO_IoType {
@@ -8452,7 +10077,7 @@ type WalletTransaction_IO_TxIO struct {
 func (x *WalletTransaction_IO_TxIO) Reset() {
 	*x = WalletTransaction_IO_TxIO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[153]
+		mi := &file_ilxrpc_proto_msgTypes[177]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8465,7 +10090,7 @@ func (x *WalletTransaction_IO_TxIO) String() string {
 func (*WalletTransaction_IO_TxIO) ProtoMessage() {}
 
 func (x *WalletTransaction_IO_TxIO) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[153]
+	mi := &file_ilxrpc_proto_msgTypes[177]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8478,7 +10103,7 @@ func (x *WalletTransaction_IO_TxIO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletTransaction_IO_TxIO.ProtoReflect.Descriptor instead.
 func (*WalletTransaction_IO_TxIO) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{167, 0, 0}
 }
 
 func (x *WalletTransaction_IO_TxIO) GetAddress() string {
@@ -8506,7 +10131,7 @@ type WalletTransaction_IO_Unknown struct {
 func (x *WalletTransaction_IO_Unknown) Reset() {
 	*x = WalletTransaction_IO_Unknown{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[154]
+		mi := &file_ilxrpc_proto_msgTypes[178]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8519,7 +10144,7 @@ func (x *WalletTransaction_IO_Unknown) String() string {
 func (*WalletTransaction_IO_Unknown) ProtoMessage() {}
 
 func (x *WalletTransaction_IO_Unknown) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[154]
+	mi := &file_ilxrpc_proto_msgTypes[178]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8532,7 +10157,7 @@ func (x *WalletTransaction_IO_Unknown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletTransaction_IO_Unknown.ProtoReflect.Descriptor instead.
 func (*WalletTransaction_IO_Unknown) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0, 1}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{167, 0, 1}
 }
 
 var File_ilxrpc_proto protoreflect.FileDescriptor
@@ -8558,7 +10183,7 @@ var file_ilxrpc_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb2, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
@@ -8580,1065 +10205,1287 @@ var file_ilxrpc_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
 	0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x3e, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x0b, 0x0a, 0x07, 0x4d,
+	0x22, 0x58, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x0b, 0x0a, 0x07, 0x4d,
 	0x41, 0x49, 0x4e, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x47, 0x54,
 	0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x53, 0x54, 0x4e, 0x45, 0x54,
 	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x54, 0x10, 0x03,
-	0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x39,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08,
+	0x54, 0x45, 0x53, 0x54, 0x4e, 0x45, 0x54, 0x32, 0x10, 0x05, 0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x22, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a,
+	0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x30, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
 	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69,
 	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69,
 	0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f,
-	0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x67, 0x68, 0x74, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5e,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x47,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
 	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x22,
+	0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x57, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x49, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1e, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x15, 0x0a, 0x13, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xcd, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x5f, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x19, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x64, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66,
+	0x75, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x64, 0x61, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb3, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62, 0x5f,
+	0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53, 0x6b,
+	0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x74, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x3b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6e, 0x65,
+	0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x53, 0x65, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3c,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x38, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x22, 0xc4, 0x01, 0x0a, 0x1f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
 	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
 	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68,
 	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x36, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x1b, 0x0a, 0x06, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x22, 0x36, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52,
+	0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x14, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x63, 0x61, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x17, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b,
+	0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a,
+	0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x21, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b,
+	0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x22, 0x77, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x76,
+	0x69, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x02, 0x74, 0x78, 0x12, 0x1a, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0x3c, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x55, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61,
+	0x77, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b,
-	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22,
-	0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x72, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0x0a, 0x13, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x7d, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x19,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0x64, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75,
-	0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x66, 0x75, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c,
-	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x12, 0x38, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3b, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb3,
-	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62,
-	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53,
-	0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x1b,
-	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x74, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54,
-	0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22,
-	0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x63, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6e,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x53, 0x65, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x38, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65, 0x77,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78,
-	0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78,
-	0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xc2, 0x01, 0x0a,
-	0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76,
-	0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46,
-	0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x56, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x21, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65,
-	0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x22, 0x77, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69,
-	0x65, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x76, 0x69, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x1d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1a, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67,
-	0x68, 0x61, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x55, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61,
-	0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
-	0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x50, 0x72, 0x6f,
-	0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x13, 0x0a,
-	0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x13, 0x0a, 0x11, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x14, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x75, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xc0, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x73, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x1a,
-	0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x50, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78,
-	0x22, 0xd8, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75,
+	0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xda, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3d, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
 	0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
 	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e,
 	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b,
 	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4e, 0x0a, 0x21, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x47, 0x0a, 0x1a, 0x50,
-	0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77,
-	0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72,
-	0x61, 0x77, 0x54, 0x78, 0x22, 0x48, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x30,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3a, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x1d, 0x0a,
-	0x1b, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a,
-	0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x53,
-	0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e,
-	0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b,
-	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x54, 0x69,
-	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x77,
-	0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62,
-	0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x53, 0x77, 0x65,
-	0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x50, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0xf2, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d,
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4e, 0x0a,
+	0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x47, 0x0a,
+	0x1a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72,
+	0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x48, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78,
+	0x22, 0x30, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x42, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x38, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x13,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a,
-	0x27, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48,
-	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9a,
-	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74,
-	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x22, 0x29, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x11, 0x0a, 0x0f,
-	0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2b, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
-	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0x5c, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44,
-	0x45, 0x42, 0x55, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54,
-	0x49, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10,
-	0x05, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x06,
-	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
-	0x22, 0x47, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62,
+	0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa4,
+	0x01, 0x0a, 0x14, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f,
+	0x62, 0x79, 0x74, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x27, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64,
+	0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x12,
+	0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x5c, 0x0a, 0x05, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x06, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62,
 	0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74,
+	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x1b, 0x53, 0x65, 0x74,
 	0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x3f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x3e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x1e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68,
-	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x64, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69,
-	0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d,
-	0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a,
-	0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1d, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x45, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x7c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f,
-	0x72, 0x5f, 0x74, 0x78, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
-	0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x5f, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75,
-	0x6d, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x54, 0x78, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61,
-	0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x6e,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a,
-	0xb9, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e, 0x75,
-	0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e,
-	0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb3, 0x01, 0x0a, 0x04,
-	0x55, 0x74, 0x78, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f,
-	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68,
-	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
-	0x6c, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02,
-	0x74, 0x78, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x07,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x0c, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x78, 0x6f, 0x5f, 0x70,
-	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
-	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x4b, 0x0a, 0x04,
-	0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x11, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x52, 0x06, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x52,
-	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0xc8, 0x01, 0x0a, 0x02, 0x49, 0x4f, 0x12,
-	0x34, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x54, 0x78, 0x49, 0x4f, 0x48, 0x00, 0x52,
-	0x04, 0x74, 0x78, 0x49, 0x6f, 0x12, 0x3c, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f,
-	0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x1a, 0x38, 0x0a, 0x04, 0x54, 0x78, 0x49, 0x4f, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x09, 0x0a,
-	0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x69, 0x6f, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x32, 0xd1, 0x0a, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
+	0x74, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53,
+	0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
+	0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x65, 0x72, 0x22, 0x19,
+	0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb4,
+	0x08, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x62, 0x65, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x45, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x1a, 0x3a, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x1a, 0xb9, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x6e, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x86, 0x01, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x79, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x79, 0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x43, 0x70, 0x75, 0x22, 0x49, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x58, 0x0a, 0x1d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x11,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x7c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a,
+	0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x73, 0x22, 0x8b, 0x02,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x54, 0x78, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x09,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75,
+	0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x65, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75,
+	0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e,
+	0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x53, 0x54,
+	0x41, 0x4b, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x49, 0x4e, 0x42, 0x41, 0x53,
+	0x45, 0x10, 0x03, 0x22, 0x6b, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0xb3, 0x01, 0x0a, 0x04, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x6c, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x95,
+	0x02, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x09,
+	0x74, 0x78, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74,
+	0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x6f, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f,
+	0x74, 0x22, 0x4b, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x87,
+	0x03, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49,
+	0x4f, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x4f, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0xc8, 0x01,
+	0x0a, 0x02, 0x49, 0x4f, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x54, 0x78,
+	0x49, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6f, 0x12, 0x3c, 0x0a, 0x07, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52,
+	0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x1a, 0x38, 0x0a, 0x04, 0x54, 0x78, 0x49, 0x4f,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x09, 0x0a,
+	0x07, 0x69, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x8e, 0x0c, 0x0a, 0x11, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
 	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
 	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xe1, 0x02, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b,
-	0x65, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xe1, 0x02, 0x0a, 0x13, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65,
+	0x77, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69,
+	0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
 	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xbf, 0x17,
+	0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xdc, 0x13, 0x0a, 0x0d,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78,
-	0x6f, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x12, 0x25, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79,
-	0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56,
-	0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61,
+	0x69, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
 	0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x43, 0x72,
+	0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65,
+	0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x76, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3d, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e,
-	0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
-	0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x52, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61,
-	0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
+	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
-	0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x75,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x77, 0x65,
-	0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77,
-	0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1b, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x20, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xaf, 0x0a, 0x0a, 0x0b, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x12, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c,
-	0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69,
-	0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62,
-	0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f,
-	0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f,
-	0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
-	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65,
-	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
-	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
-	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69,
-	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64,
-	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69,
-	0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x2d, 0x69, 0x6c, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x69, 0x6c, 0x78, 0x64, 0x2f, 0x72,
-	0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6c, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f,
+	0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32,
+	0xf7, 0x0a, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x65, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x65, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
+	0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d,
+	0x69, 0x6c, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x69, 0x6c, 0x78, 0x64, 0x2f, 0x72, 0x70, 0x63, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9653,360 +11500,413 @@ func file_ilxrpc_proto_rawDescGZIP() []byte {
 	return file_ilxrpc_proto_rawDescData
 }
 
-var file_ilxrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ilxrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 155)
+var file_ilxrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ilxrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 179)
 var file_ilxrpc_proto_goTypes = []interface{}{
 	(GetBlockchainInfoResponse_Network)(0),          // 0: pb.GetBlockchainInfoResponse.Network
-	(SetLogLevelRequest_Level)(0),                   // 1: pb.SetLogLevelRequest.Level
-	(*GetMempoolInfoRequest)(nil),                   // 2: pb.GetMempoolInfoRequest
-	(*GetMempoolInfoResponse)(nil),                  // 3: pb.GetMempoolInfoResponse
-	(*GetMempoolRequest)(nil),                       // 4: pb.GetMempoolRequest
-	(*GetMempoolResponse)(nil),                      // 5: pb.GetMempoolResponse
-	(*GetBlockchainInfoRequest)(nil),                // 6: pb.GetBlockchainInfoRequest
-	(*GetBlockchainInfoResponse)(nil),               // 7: pb.GetBlockchainInfoResponse
-	(*GetBlockInfoRequest)(nil),                     // 8: pb.GetBlockInfoRequest
-	(*GetBlockInfoResponse)(nil),                    // 9: pb.GetBlockInfoResponse
-	(*GetBlockRequest)(nil),                         // 10: pb.GetBlockRequest
-	(*GetBlockResponse)(nil),                        // 11: pb.GetBlockResponse
-	(*GetCompressedBlockRequest)(nil),               // 12: pb.GetCompressedBlockRequest
-	(*GetCompressedBlockResponse)(nil),              // 13: pb.GetCompressedBlockResponse
-	(*GetHeadersRequest)(nil),                       // 14: pb.GetHeadersRequest
-	(*GetHeadersResponse)(nil),                      // 15: pb.GetHeadersResponse
-	(*GetCompressedBlocksRequest)(nil),              // 16: pb.GetCompressedBlocksRequest
-	(*GetCompressedBlocksResponse)(nil),             // 17: pb.GetCompressedBlocksResponse
-	(*GetTransactionRequest)(nil),                   // 18: pb.GetTransactionRequest
-	(*GetTransactionResponse)(nil),                  // 19: pb.GetTransactionResponse
-	(*GetMerkleProofRequest)(nil),                   // 20: pb.GetMerkleProofRequest
-	(*GetMerkleProofResponse)(nil),                  // 21: pb.GetMerkleProofResponse
-	(*GetValidatorRequest)(nil),                     // 22: pb.GetValidatorRequest
-	(*GetValidatorResponse)(nil),                    // 23: pb.GetValidatorResponse
-	(*GetValidatorSetInfoRequest)(nil),              // 24: pb.GetValidatorSetInfoRequest
-	(*GetValidatorSetInfoResponse)(nil),             // 25: pb.GetValidatorSetInfoResponse
-	(*GetValidatorSetRequest)(nil),                  // 26: pb.GetValidatorSetRequest
-	(*GetValidatorSetResponse)(nil),                 // 27: pb.GetValidatorSetResponse
-	(*GetAccumulatorCheckpointRequest)(nil),         // 28: pb.GetAccumulatorCheckpointRequest
-	(*GetAccumulatorCheckpointResponse)(nil),        // 29: pb.GetAccumulatorCheckpointResponse
-	(*SubmitTransactionRequest)(nil),                // 30: pb.SubmitTransactionRequest
-	(*SubmitTransactionResponse)(nil),               // 31: pb.SubmitTransactionResponse
-	(*SubscribeBlocksRequest)(nil),                  // 32: pb.SubscribeBlocksRequest
-	(*SubscribeCompressedBlocksRequest)(nil),        // 33: pb.SubscribeCompressedBlocksRequest
-	(*RegisterViewKeyRequest)(nil),                  // 34: pb.RegisterViewKeyRequest
-	(*RegisterViewKeyResponse)(nil),                 // 35: pb.RegisterViewKeyResponse
-	(*SubscribeTransactionsRequest)(nil),            // 36: pb.SubscribeTransactionsRequest
-	(*GetWalletTransactionsRequest)(nil),            // 37: pb.GetWalletTransactionsRequest
-	(*GetWalletTransactionsResponse)(nil),           // 38: pb.GetWalletTransactionsResponse
-	(*GetTxoProofRequest)(nil),                      // 39: pb.GetTxoProofRequest
-	(*GetTxoProofResponse)(nil),                     // 40: pb.GetTxoProofResponse
-	(*GetBalanceRequest)(nil),                       // 41: pb.GetBalanceRequest
-	(*GetBalanceResponse)(nil),                      // 42: pb.GetBalanceResponse
-	(*GetWalletSeedRequest)(nil),                    // 43: pb.GetWalletSeedRequest
-	(*GetWalletSeedResponse)(nil),                   // 44: pb.GetWalletSeedResponse
-	(*GetAddressRequest)(nil),                       // 45: pb.GetAddressRequest
-	(*GetAddressResponse)(nil),                      // 46: pb.GetAddressResponse
-	(*GetTimelockedAddressRequest)(nil),             // 47: pb.GetTimelockedAddressRequest
-	(*GetTimelockedAddressResponse)(nil),            // 48: pb.GetTimelockedAddressResponse
-	(*GetAddressesRequest)(nil),                     // 49: pb.GetAddressesRequest
-	(*GetAddressesResponse)(nil),                    // 50: pb.GetAddressesResponse
-	(*GetAddressInfoRequest)(nil),                   // 51: pb.GetAddressInfoRequest
-	(*GetAddressInfoResponse)(nil),                  // 52: pb.GetAddressInfoResponse
-	(*GetNewAddressRequest)(nil),                    // 53: pb.GetNewAddressRequest
-	(*GetNewAddressResponse)(nil),                   // 54: pb.GetNewAddressResponse
-	(*GetTransactionsRequest)(nil),                  // 55: pb.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil),                 // 56: pb.GetTransactionsResponse
-	(*GetUtxosRequest)(nil),                         // 57: pb.GetUtxosRequest
-	(*GetUtxosResponse)(nil),                        // 58: pb.GetUtxosResponse
-	(*GetPrivateKeyRequest)(nil),                    // 59: pb.GetPrivateKeyRequest
-	(*GetPrivateKeyResponse)(nil),                   // 60: pb.GetPrivateKeyResponse
-	(*ImportAddressRequest)(nil),                    // 61: pb.ImportAddressRequest
-	(*ImportAddressResponse)(nil),                   // 62: pb.ImportAddressResponse
-	(*CreateMultisigSpendKeypairRequest)(nil),       // 63: pb.CreateMultisigSpendKeypairRequest
-	(*CreateMultisigSpendKeypairResponse)(nil),      // 64: pb.CreateMultisigSpendKeypairResponse
-	(*CreateMultisigViewKeypairRequest)(nil),        // 65: pb.CreateMultisigViewKeypairRequest
-	(*CreateMultisigViewKeypairResponse)(nil),       // 66: pb.CreateMultisigViewKeypairResponse
-	(*CreateMultisigAddressRequest)(nil),            // 67: pb.CreateMultisigAddressRequest
-	(*CreateMultisigAddressResponse)(nil),           // 68: pb.CreateMultisigAddressResponse
-	(*CreateMultiSignatureRequest)(nil),             // 69: pb.CreateMultiSignatureRequest
-	(*CreateMultiSignatureResponse)(nil),            // 70: pb.CreateMultiSignatureResponse
-	(*ProveMultisigRequest)(nil),                    // 71: pb.ProveMultisigRequest
-	(*ProveMultisigResponse)(nil),                   // 72: pb.ProveMultisigResponse
-	(*WalletLockRequest)(nil),                       // 73: pb.WalletLockRequest
-	(*WalletLockResponse)(nil),                      // 74: pb.WalletLockResponse
-	(*WalletUnlockRequest)(nil),                     // 75: pb.WalletUnlockRequest
-	(*WalletUnlockResponse)(nil),                    // 76: pb.WalletUnlockResponse
-	(*SetWalletPassphraseRequest)(nil),              // 77: pb.SetWalletPassphraseRequest
-	(*SetWalletPassphraseResponse)(nil),             // 78: pb.SetWalletPassphraseResponse
-	(*ChangeWalletPassphraseRequest)(nil),           // 79: pb.ChangeWalletPassphraseRequest
-	(*ChangeWalletPassphraseResponse)(nil),          // 80: pb.ChangeWalletPassphraseResponse
-	(*DeletePrivateKeysRequest)(nil),                // 81: pb.DeletePrivateKeysRequest
-	(*DeletePrivateKeysResponse)(nil),               // 82: pb.DeletePrivateKeysResponse
-	(*CreateRawTransactionRequest)(nil),             // 83: pb.CreateRawTransactionRequest
-	(*CreateRawTransactionResponse)(nil),            // 84: pb.CreateRawTransactionResponse
-	(*CreateRawStakeTransactionRequest)(nil),        // 85: pb.CreateRawStakeTransactionRequest
-	(*CreateRawStakeTransactionResponse)(nil),       // 86: pb.CreateRawStakeTransactionResponse
-	(*ProveRawTransactionRequest)(nil),              // 87: pb.ProveRawTransactionRequest
-	(*ProveRawTransactionResponse)(nil),             // 88: pb.ProveRawTransactionResponse
-	(*StakeRequest)(nil),                            // 89: pb.StakeRequest
-	(*StakeResponse)(nil),                           // 90: pb.StakeResponse
-	(*SetAutoStakeRewardsRequest)(nil),              // 91: pb.SetAutoStakeRewardsRequest
-	(*SetAutoStakeRewardsResponse)(nil),             // 92: pb.SetAutoStakeRewardsResponse
-	(*SpendRequest)(nil),                            // 93: pb.SpendRequest
-	(*SpendResponse)(nil),                           // 94: pb.SpendResponse
-	(*TimelockCoinsRequest)(nil),                    // 95: pb.TimelockCoinsRequest
-	(*TimelockCoinsResponse)(nil),                   // 96: pb.TimelockCoinsResponse
-	(*SweepWalletRequest)(nil),                      // 97: pb.SweepWalletRequest
-	(*SweepWalletResponse)(nil),                     // 98: pb.SweepWalletResponse
-	(*SubscribeWalletTransactionsRequest)(nil),      // 99: pb.SubscribeWalletTransactionsRequest
-	(*SubscribeWalletSyncNotificationsRequest)(nil), // 100: pb.SubscribeWalletSyncNotificationsRequest
-	(*GetHostInfoRequest)(nil),                      // 101: pb.GetHostInfoRequest
-	(*GetHostInfoResponse)(nil),                     // 102: pb.GetHostInfoResponse
-	(*GetNetworkKeyRequest)(nil),                    // 103: pb.GetNetworkKeyRequest
-	(*GetNetworkKeyResponse)(nil),                   // 104: pb.GetNetworkKeyResponse
-	(*GetPeersRequest)(nil),                         // 105: pb.GetPeersRequest
-	(*GetPeersResponse)(nil),                        // 106: pb.GetPeersResponse
-	(*AddPeerRequest)(nil),                          // 107: pb.AddPeerRequest
-	(*AddPeerResponse)(nil),                         // 108: pb.AddPeerResponse
-	(*BlockPeerRequest)(nil),                        // 109: pb.BlockPeerRequest
-	(*BlockPeerResponse)(nil),                       // 110: pb.BlockPeerResponse
-	(*UnblockPeerRequest)(nil),                      // 111: pb.UnblockPeerRequest
-	(*UnblockPeerResponse)(nil),                     // 112: pb.UnblockPeerResponse
-	(*SetLogLevelRequest)(nil),                      // 113: pb.SetLogLevelRequest
-	(*SetLogLevelResponse)(nil),                     // 114: pb.SetLogLevelResponse
-	(*GetMinFeePerKilobyteRequest)(nil),             // 115: pb.GetMinFeePerKilobyteRequest
-	(*GetMinFeePerKilobyteResponse)(nil),            // 116: pb.GetMinFeePerKilobyteResponse
-	(*SetMinFeePerKilobyteRequest)(nil),             // 117: pb.SetMinFeePerKilobyteRequest
-	(*SetMinFeePerKilobyteResponse)(nil),            // 118: pb.SetMinFeePerKilobyteResponse
-	(*GetMinStakeRequest)(nil),                      // 119: pb.GetMinStakeRequest
-	(*GetMinStakeResponse)(nil),                     // 120: pb.GetMinStakeResponse
-	(*SetMinStakeRequest)(nil),                      // 121: pb.SetMinStakeRequest
-	(*SetMinStakeResponse)(nil),                     // 122: pb.SetMinStakeResponse
-	(*GetBlockSizeSoftLimitRequest)(nil),            // 123: pb.GetBlockSizeSoftLimitRequest
-	(*GetBlockSizeSoftLimitResponse)(nil),           // 124: pb.GetBlockSizeSoftLimitResponse
-	(*SetBlockSizeSoftLimitRequest)(nil),            // 125: pb.SetBlockSizeSoftLimitRequest
-	(*SetBlockSizeSoftLimitResponse)(nil),           // 126: pb.SetBlockSizeSoftLimitResponse
-	(*GetTreasuryWhitelistRequest)(nil),             // 127: pb.GetTreasuryWhitelistRequest
-	(*GetTreasuryWhitelistResponse)(nil),            // 128: pb.GetTreasuryWhitelistResponse
-	(*UpdateTreasuryWhitelistRequest)(nil),          // 129: pb.UpdateTreasuryWhitelistRequest
-	(*UpdateTreasuryWhitelistResponse)(nil),         // 130: pb.UpdateTreasuryWhitelistResponse
-	(*ReconsiderBlockRequest)(nil),                  // 131: pb.ReconsiderBlockRequest
-	(*ReconsiderBlockResponse)(nil),                 // 132: pb.ReconsiderBlockResponse
-	(*RecomputeChainStateRequest)(nil),              // 133: pb.RecomputeChainStateRequest
-	(*RecomputeChainStateResponse)(nil),             // 134: pb.RecomputeChainStateResponse
-	(*TransactionNotification)(nil),                 // 135: pb.TransactionNotification
-	(*WalletTransactionNotification)(nil),           // 136: pb.WalletTransactionNotification
-	(*WalletSyncNotification)(nil),                  // 137: pb.WalletSyncNotification
-	(*BlockNotification)(nil),                       // 138: pb.BlockNotification
-	(*CompressedBlockNotification)(nil),             // 139: pb.CompressedBlockNotification
-	(*TransactionData)(nil),                         // 140: pb.TransactionData
-	(*BlockInfo)(nil),                               // 141: pb.BlockInfo
-	(*Validator)(nil),                               // 142: pb.Validator
-	(*Utxo)(nil),                                    // 143: pb.Utxo
-	(*RawTransaction)(nil),                          // 144: pb.RawTransaction
-	(*PrivateInput)(nil),                            // 145: pb.PrivateInput
-	(*PrivateOutput)(nil),                           // 146: pb.PrivateOutput
-	(*TxoProof)(nil),                                // 147: pb.TxoProof
-	(*Peer)(nil),                                    // 148: pb.Peer
-	(*WalletTransaction)(nil),                       // 149: pb.WalletTransaction
-	(*CreateRawTransactionRequest_Input)(nil),       // 150: pb.CreateRawTransactionRequest.Input
-	(*CreateRawTransactionRequest_Output)(nil),      // 151: pb.CreateRawTransactionRequest.Output
-	(*CreateRawStakeTransactionRequest_Input)(nil),  // 152: pb.CreateRawStakeTransactionRequest.Input
-	(*Validator_Stake)(nil),                         // 153: pb.Validator.Stake
-	(*WalletTransaction_IO)(nil),                    // 154: pb.WalletTransaction.IO
-	(*WalletTransaction_IO_TxIO)(nil),               // 155: pb.WalletTransaction.IO.TxIO
-	(*WalletTransaction_IO_Unknown)(nil),            // 156: pb.WalletTransaction.IO.Unknown
-	(*blocks.Block)(nil),                            // 157: Block
-	(*blocks.CompressedBlock)(nil),                  // 158: CompressedBlock
-	(*blocks.BlockHeader)(nil),                      // 159: BlockHeader
-	(*transactions.Transaction)(nil),                // 160: Transaction
+	(ExportTransactionHistoryRequest_Format)(0),     // 1: pb.ExportTransactionHistoryRequest.Format
+	(SetLogLevelRequest_Level)(0),                   // 2: pb.SetLogLevelRequest.Level
+	(ValidatorEvent_Type)(0),                        // 3: pb.ValidatorEvent.Type
+	(*GetMempoolInfoRequest)(nil),                   // 4: pb.GetMempoolInfoRequest
+	(*GetMempoolInfoResponse)(nil),                  // 5: pb.GetMempoolInfoResponse
+	(*GetMempoolRequest)(nil),                       // 6: pb.GetMempoolRequest
+	(*GetMempoolResponse)(nil),                      // 7: pb.GetMempoolResponse
+	(*GetBlockchainInfoRequest)(nil),                // 8: pb.GetBlockchainInfoRequest
+	(*GetBlockchainInfoResponse)(nil),               // 9: pb.GetBlockchainInfoResponse
+	(*GetBlockInfoRequest)(nil),                     // 10: pb.GetBlockInfoRequest
+	(*GetBlockInfoResponse)(nil),                    // 11: pb.GetBlockInfoResponse
+	(*GetBlockRequest)(nil),                         // 12: pb.GetBlockRequest
+	(*GetBlockResponse)(nil),                        // 13: pb.GetBlockResponse
+	(*GetCompressedBlockRequest)(nil),               // 14: pb.GetCompressedBlockRequest
+	(*GetCompressedBlockResponse)(nil),              // 15: pb.GetCompressedBlockResponse
+	(*GetHeadersRequest)(nil),                       // 16: pb.GetHeadersRequest
+	(*GetHeadersResponse)(nil),                      // 17: pb.GetHeadersResponse
+	(*GetCompressedBlocksRequest)(nil),              // 18: pb.GetCompressedBlocksRequest
+	(*GetCompressedBlocksResponse)(nil),             // 19: pb.GetCompressedBlocksResponse
+	(*GetTransactionRequest)(nil),                   // 20: pb.GetTransactionRequest
+	(*GetTransactionResponse)(nil),                  // 21: pb.GetTransactionResponse
+	(*GetMerkleProofRequest)(nil),                   // 22: pb.GetMerkleProofRequest
+	(*GetMerkleProofResponse)(nil),                  // 23: pb.GetMerkleProofResponse
+	(*GetValidatorRequest)(nil),                     // 24: pb.GetValidatorRequest
+	(*GetValidatorResponse)(nil),                    // 25: pb.GetValidatorResponse
+	(*GetValidatorSetInfoRequest)(nil),              // 26: pb.GetValidatorSetInfoRequest
+	(*GetValidatorSetInfoResponse)(nil),             // 27: pb.GetValidatorSetInfoResponse
+	(*GetValidatorSetRequest)(nil),                  // 28: pb.GetValidatorSetRequest
+	(*GetValidatorSetResponse)(nil),                 // 29: pb.GetValidatorSetResponse
+	(*GetValidatorHistoryRequest)(nil),              // 30: pb.GetValidatorHistoryRequest
+	(*GetValidatorHistoryResponse)(nil),             // 31: pb.GetValidatorHistoryResponse
+	(*GetAccumulatorCheckpointRequest)(nil),         // 32: pb.GetAccumulatorCheckpointRequest
+	(*GetAccumulatorCheckpointResponse)(nil),        // 33: pb.GetAccumulatorCheckpointResponse
+	(*GetFinalityCertificateRequest)(nil),           // 34: pb.GetFinalityCertificateRequest
+	(*GetFinalityCertificateResponse)(nil),          // 35: pb.GetFinalityCertificateResponse
+	(*SubmitTransactionRequest)(nil),                // 36: pb.SubmitTransactionRequest
+	(*SubmitTransactionResponse)(nil),               // 37: pb.SubmitTransactionResponse
+	(*SubscribeBlocksRequest)(nil),                  // 38: pb.SubscribeBlocksRequest
+	(*SubscribeCompressedBlocksRequest)(nil),        // 39: pb.SubscribeCompressedBlocksRequest
+	(*RegisterViewKeyRequest)(nil),                  // 40: pb.RegisterViewKeyRequest
+	(*RegisterViewKeyResponse)(nil),                 // 41: pb.RegisterViewKeyResponse
+	(*SubscribeTransactionsRequest)(nil),            // 42: pb.SubscribeTransactionsRequest
+	(*GetWalletTransactionsRequest)(nil),            // 43: pb.GetWalletTransactionsRequest
+	(*GetWalletTransactionsResponse)(nil),           // 44: pb.GetWalletTransactionsResponse
+	(*GetTxoProofRequest)(nil),                      // 45: pb.GetTxoProofRequest
+	(*GetTxoProofResponse)(nil),                     // 46: pb.GetTxoProofResponse
+	(*GetBalanceRequest)(nil),                       // 47: pb.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                      // 48: pb.GetBalanceResponse
+	(*GetWalletSeedRequest)(nil),                    // 49: pb.GetWalletSeedRequest
+	(*GetWalletSeedResponse)(nil),                   // 50: pb.GetWalletSeedResponse
+	(*GetAddressRequest)(nil),                       // 51: pb.GetAddressRequest
+	(*GetAddressResponse)(nil),                      // 52: pb.GetAddressResponse
+	(*GetTimelockedAddressRequest)(nil),             // 53: pb.GetTimelockedAddressRequest
+	(*GetTimelockedAddressResponse)(nil),            // 54: pb.GetTimelockedAddressResponse
+	(*GetAddressesRequest)(nil),                     // 55: pb.GetAddressesRequest
+	(*GetAddressesResponse)(nil),                    // 56: pb.GetAddressesResponse
+	(*GetAddressInfoRequest)(nil),                   // 57: pb.GetAddressInfoRequest
+	(*GetAddressInfoResponse)(nil),                  // 58: pb.GetAddressInfoResponse
+	(*GetNewAddressRequest)(nil),                    // 59: pb.GetNewAddressRequest
+	(*GetNewAddressResponse)(nil),                   // 60: pb.GetNewAddressResponse
+	(*GetTransactionsRequest)(nil),                  // 61: pb.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),                 // 62: pb.GetTransactionsResponse
+	(*ExportTransactionHistoryRequest)(nil),         // 63: pb.ExportTransactionHistoryRequest
+	(*ExportTransactionHistoryResponse)(nil),        // 64: pb.ExportTransactionHistoryResponse
+	(*GetUtxosRequest)(nil),                         // 65: pb.GetUtxosRequest
+	(*GetUtxosResponse)(nil),                        // 66: pb.GetUtxosResponse
+	(*GetPrivateKeyRequest)(nil),                    // 67: pb.GetPrivateKeyRequest
+	(*GetPrivateKeyResponse)(nil),                   // 68: pb.GetPrivateKeyResponse
+	(*ImportAddressRequest)(nil),                    // 69: pb.ImportAddressRequest
+	(*ImportAddressResponse)(nil),                   // 70: pb.ImportAddressResponse
+	(*CreateMultisigSpendKeypairRequest)(nil),       // 71: pb.CreateMultisigSpendKeypairRequest
+	(*CreateMultisigSpendKeypairResponse)(nil),      // 72: pb.CreateMultisigSpendKeypairResponse
+	(*CreateMultisigViewKeypairRequest)(nil),        // 73: pb.CreateMultisigViewKeypairRequest
+	(*CreateMultisigViewKeypairResponse)(nil),       // 74: pb.CreateMultisigViewKeypairResponse
+	(*CreateMultisigAddressRequest)(nil),            // 75: pb.CreateMultisigAddressRequest
+	(*CreateMultisigAddressResponse)(nil),           // 76: pb.CreateMultisigAddressResponse
+	(*CreateMultiSignatureRequest)(nil),             // 77: pb.CreateMultiSignatureRequest
+	(*CreateMultiSignatureResponse)(nil),            // 78: pb.CreateMultiSignatureResponse
+	(*ProveMultisigRequest)(nil),                    // 79: pb.ProveMultisigRequest
+	(*ProveMultisigResponse)(nil),                   // 80: pb.ProveMultisigResponse
+	(*WalletLockRequest)(nil),                       // 81: pb.WalletLockRequest
+	(*WalletLockResponse)(nil),                      // 82: pb.WalletLockResponse
+	(*WalletUnlockRequest)(nil),                     // 83: pb.WalletUnlockRequest
+	(*WalletUnlockResponse)(nil),                    // 84: pb.WalletUnlockResponse
+	(*SetWalletPassphraseRequest)(nil),              // 85: pb.SetWalletPassphraseRequest
+	(*SetWalletPassphraseResponse)(nil),             // 86: pb.SetWalletPassphraseResponse
+	(*ChangeWalletPassphraseRequest)(nil),           // 87: pb.ChangeWalletPassphraseRequest
+	(*ChangeWalletPassphraseResponse)(nil),          // 88: pb.ChangeWalletPassphraseResponse
+	(*DeletePrivateKeysRequest)(nil),                // 89: pb.DeletePrivateKeysRequest
+	(*DeletePrivateKeysResponse)(nil),               // 90: pb.DeletePrivateKeysResponse
+	(*CreateRawTransactionRequest)(nil),             // 91: pb.CreateRawTransactionRequest
+	(*CreateRawTransactionResponse)(nil),            // 92: pb.CreateRawTransactionResponse
+	(*CreateRawStakeTransactionRequest)(nil),        // 93: pb.CreateRawStakeTransactionRequest
+	(*CreateRawStakeTransactionResponse)(nil),       // 94: pb.CreateRawStakeTransactionResponse
+	(*ProveRawTransactionRequest)(nil),              // 95: pb.ProveRawTransactionRequest
+	(*ProveRawTransactionResponse)(nil),             // 96: pb.ProveRawTransactionResponse
+	(*StakeRequest)(nil),                            // 97: pb.StakeRequest
+	(*StakeResponse)(nil),                           // 98: pb.StakeResponse
+	(*SetAutoStakeRewardsRequest)(nil),              // 99: pb.SetAutoStakeRewardsRequest
+	(*SetAutoStakeRewardsResponse)(nil),             // 100: pb.SetAutoStakeRewardsResponse
+	(*CreateAccountRequest)(nil),                    // 101: pb.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                   // 102: pb.CreateAccountResponse
+	(*GetAccountsRequest)(nil),                      // 103: pb.GetAccountsRequest
+	(*GetAccountsResponse)(nil),                     // 104: pb.GetAccountsResponse
+	(*SetAccountLabelRequest)(nil),                  // 105: pb.SetAccountLabelRequest
+	(*SetAccountLabelResponse)(nil),                 // 106: pb.SetAccountLabelResponse
+	(*GetAccountNewAddressRequest)(nil),             // 107: pb.GetAccountNewAddressRequest
+	(*GetAccountNewAddressResponse)(nil),            // 108: pb.GetAccountNewAddressResponse
+	(*AccountSpendRequest)(nil),                     // 109: pb.AccountSpendRequest
+	(*AccountSpendResponse)(nil),                    // 110: pb.AccountSpendResponse
+	(*SpendRequest)(nil),                            // 111: pb.SpendRequest
+	(*SpendResponse)(nil),                           // 112: pb.SpendResponse
+	(*TimelockCoinsRequest)(nil),                    // 113: pb.TimelockCoinsRequest
+	(*TimelockCoinsResponse)(nil),                   // 114: pb.TimelockCoinsResponse
+	(*SweepWalletRequest)(nil),                      // 115: pb.SweepWalletRequest
+	(*SweepWalletResponse)(nil),                     // 116: pb.SweepWalletResponse
+	(*SubscribeWalletTransactionsRequest)(nil),      // 117: pb.SubscribeWalletTransactionsRequest
+	(*SubscribeWalletSyncNotificationsRequest)(nil), // 118: pb.SubscribeWalletSyncNotificationsRequest
+	(*GetHostInfoRequest)(nil),                      // 119: pb.GetHostInfoRequest
+	(*GetHostInfoResponse)(nil),                     // 120: pb.GetHostInfoResponse
+	(*GetNetworkKeyRequest)(nil),                    // 121: pb.GetNetworkKeyRequest
+	(*GetNetworkKeyResponse)(nil),                   // 122: pb.GetNetworkKeyResponse
+	(*GetPeersRequest)(nil),                         // 123: pb.GetPeersRequest
+	(*GetPeersResponse)(nil),                        // 124: pb.GetPeersResponse
+	(*AddPeerRequest)(nil),                          // 125: pb.AddPeerRequest
+	(*AddPeerResponse)(nil),                         // 126: pb.AddPeerResponse
+	(*BlockPeerRequest)(nil),                        // 127: pb.BlockPeerRequest
+	(*BlockPeerResponse)(nil),                       // 128: pb.BlockPeerResponse
+	(*UnblockPeerRequest)(nil),                      // 129: pb.UnblockPeerRequest
+	(*UnblockPeerResponse)(nil),                     // 130: pb.UnblockPeerResponse
+	(*SetLogLevelRequest)(nil),                      // 131: pb.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),                     // 132: pb.SetLogLevelResponse
+	(*GetMinFeePerKilobyteRequest)(nil),             // 133: pb.GetMinFeePerKilobyteRequest
+	(*GetMinFeePerKilobyteResponse)(nil),            // 134: pb.GetMinFeePerKilobyteResponse
+	(*SetMinFeePerKilobyteRequest)(nil),             // 135: pb.SetMinFeePerKilobyteRequest
+	(*SetMinFeePerKilobyteResponse)(nil),            // 136: pb.SetMinFeePerKilobyteResponse
+	(*GetMinStakeRequest)(nil),                      // 137: pb.GetMinStakeRequest
+	(*GetMinStakeResponse)(nil),                     // 138: pb.GetMinStakeResponse
+	(*SetMinStakeRequest)(nil),                      // 139: pb.SetMinStakeRequest
+	(*SetMinStakeResponse)(nil),                     // 140: pb.SetMinStakeResponse
+	(*GetBlockSizeSoftLimitRequest)(nil),            // 141: pb.GetBlockSizeSoftLimitRequest
+	(*GetBlockSizeSoftLimitResponse)(nil),           // 142: pb.GetBlockSizeSoftLimitResponse
+	(*SetBlockSizeSoftLimitRequest)(nil),            // 143: pb.SetBlockSizeSoftLimitRequest
+	(*SetBlockSizeSoftLimitResponse)(nil),           // 144: pb.SetBlockSizeSoftLimitResponse
+	(*GetTreasuryWhitelistRequest)(nil),             // 145: pb.GetTreasuryWhitelistRequest
+	(*GetTreasuryWhitelistResponse)(nil),            // 146: pb.GetTreasuryWhitelistResponse
+	(*UpdateTreasuryWhitelistRequest)(nil),          // 147: pb.UpdateTreasuryWhitelistRequest
+	(*UpdateTreasuryWhitelistResponse)(nil),         // 148: pb.UpdateTreasuryWhitelistResponse
+	(*ReconsiderBlockRequest)(nil),                  // 149: pb.ReconsiderBlockRequest
+	(*ReconsiderBlockResponse)(nil),                 // 150: pb.ReconsiderBlockResponse
+	(*RecomputeChainStateRequest)(nil),              // 151: pb.RecomputeChainStateRequest
+	(*RecomputeChainStateResponse)(nil),             // 152: pb.RecomputeChainStateResponse
+	(*GetNodeStatusRequest)(nil),                    // 153: pb.GetNodeStatusRequest
+	(*GetNodeStatusResponse)(nil),                   // 154: pb.GetNodeStatusResponse
+	(*TransactionNotification)(nil),                 // 155: pb.TransactionNotification
+	(*WalletTransactionNotification)(nil),           // 156: pb.WalletTransactionNotification
+	(*WalletSyncNotification)(nil),                  // 157: pb.WalletSyncNotification
+	(*BlockNotification)(nil),                       // 158: pb.BlockNotification
+	(*CompressedBlockNotification)(nil),             // 159: pb.CompressedBlockNotification
+	(*TransactionData)(nil),                         // 160: pb.TransactionData
+	(*BlockInfo)(nil),                               // 161: pb.BlockInfo
+	(*Validator)(nil),                               // 162: pb.Validator
+	(*ValidatorEvent)(nil),                          // 163: pb.ValidatorEvent
+	(*Account)(nil),                                 // 164: pb.Account
+	(*Utxo)(nil),                                    // 165: pb.Utxo
+	(*RawTransaction)(nil),                          // 166: pb.RawTransaction
+	(*PrivateInput)(nil),                            // 167: pb.PrivateInput
+	(*PrivateOutput)(nil),                           // 168: pb.PrivateOutput
+	(*TxoProof)(nil),                                // 169: pb.TxoProof
+	(*Peer)(nil),                                    // 170: pb.Peer
+	(*WalletTransaction)(nil),                       // 171: pb.WalletTransaction
+	(*CreateRawTransactionRequest_Input)(nil),       // 172: pb.CreateRawTransactionRequest.Input
+	(*CreateRawTransactionRequest_Output)(nil),      // 173: pb.CreateRawTransactionRequest.Output
+	(*CreateRawStakeTransactionRequest_Input)(nil),  // 174: pb.CreateRawStakeTransactionRequest.Input
+	(*GetNodeStatusResponse_PeerSummary)(nil),       // 175: pb.GetNodeStatusResponse.PeerSummary
+	(*GetNodeStatusResponse_MempoolSummary)(nil),    // 176: pb.GetNodeStatusResponse.MempoolSummary
+	(*GetNodeStatusResponse_ValidatorStatus)(nil),   // 177: pb.GetNodeStatusResponse.ValidatorStatus
+	(*GetNodeStatusResponse_ResourceUsage)(nil),     // 178: pb.GetNodeStatusResponse.ResourceUsage
+	(*Validator_Stake)(nil),                         // 179: pb.Validator.Stake
+	(*WalletTransaction_IO)(nil),                    // 180: pb.WalletTransaction.IO
+	(*WalletTransaction_IO_TxIO)(nil),               // 181: pb.WalletTransaction.IO.TxIO
+	(*WalletTransaction_IO_Unknown)(nil),            // 182: pb.WalletTransaction.IO.Unknown
+	(*blocks.Block)(nil),                            // 183: Block
+	(*blocks.CompressedBlock)(nil),                  // 184: CompressedBlock
+	(*blocks.BlockHeader)(nil),                      // 185: BlockHeader
+	(*transactions.Transaction)(nil),                // 186: Transaction
 }
 var file_ilxrpc_proto_depIdxs = []int32{
-	140, // 0: pb.GetMempoolResponse.transaction_data:type_name -> pb.TransactionData
+	160, // 0: pb.GetMempoolResponse.transaction_data:type_name -> pb.TransactionData
 	0,   // 1: pb.GetBlockchainInfoResponse.network:type_name -> pb.GetBlockchainInfoResponse.Network
-	141, // 2: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
-	157, // 3: pb.GetBlockResponse.block:type_name -> Block
-	158, // 4: pb.GetCompressedBlockResponse.block:type_name -> CompressedBlock
-	159, // 5: pb.GetHeadersResponse.headers:type_name -> BlockHeader
-	158, // 6: pb.GetCompressedBlocksResponse.blocks:type_name -> CompressedBlock
-	160, // 7: pb.GetTransactionResponse.tx:type_name -> Transaction
-	141, // 8: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
-	142, // 9: pb.GetValidatorResponse.validator:type_name -> pb.Validator
-	142, // 10: pb.GetValidatorSetResponse.validators:type_name -> pb.Validator
-	160, // 11: pb.SubmitTransactionRequest.transaction:type_name -> Transaction
-	160, // 12: pb.GetWalletTransactionsResponse.transactions:type_name -> Transaction
-	147, // 13: pb.GetTxoProofResponse.proofs:type_name -> pb.TxoProof
-	149, // 14: pb.GetTransactionsResponse.txs:type_name -> pb.WalletTransaction
-	143, // 15: pb.GetUtxosResponse.utxos:type_name -> pb.Utxo
-	160, // 16: pb.CreateMultiSignatureRequest.tx:type_name -> Transaction
-	144, // 17: pb.ProveMultisigRequest.raw_tx:type_name -> pb.RawTransaction
-	160, // 18: pb.ProveMultisigResponse.proved_tx:type_name -> Transaction
-	150, // 19: pb.CreateRawTransactionRequest.inputs:type_name -> pb.CreateRawTransactionRequest.Input
-	151, // 20: pb.CreateRawTransactionRequest.outputs:type_name -> pb.CreateRawTransactionRequest.Output
-	144, // 21: pb.CreateRawTransactionResponse.raw_tx:type_name -> pb.RawTransaction
-	152, // 22: pb.CreateRawStakeTransactionRequest.input:type_name -> pb.CreateRawStakeTransactionRequest.Input
-	144, // 23: pb.CreateRawStakeTransactionResponse.raw_tx:type_name -> pb.RawTransaction
-	144, // 24: pb.ProveRawTransactionRequest.raw_tx:type_name -> pb.RawTransaction
-	160, // 25: pb.ProveRawTransactionResponse.proved_tx:type_name -> Transaction
-	148, // 26: pb.GetPeersResponse.peers:type_name -> pb.Peer
-	1,   // 27: pb.SetLogLevelRequest.level:type_name -> pb.SetLogLevelRequest.Level
-	160, // 28: pb.TransactionNotification.transaction:type_name -> Transaction
-	149, // 29: pb.WalletTransactionNotification.transaction:type_name -> pb.WalletTransaction
-	141, // 30: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
-	140, // 31: pb.BlockNotification.transactions:type_name -> pb.TransactionData
-	158, // 32: pb.CompressedBlockNotification.block:type_name -> CompressedBlock
-	160, // 33: pb.TransactionData.transaction:type_name -> Transaction
-	153, // 34: pb.Validator.stake:type_name -> pb.Validator.Stake
-	160, // 35: pb.RawTransaction.tx:type_name -> Transaction
-	145, // 36: pb.RawTransaction.inputs:type_name -> pb.PrivateInput
-	146, // 37: pb.RawTransaction.outputs:type_name -> pb.PrivateOutput
-	147, // 38: pb.PrivateInput.txo_proof:type_name -> pb.TxoProof
-	154, // 39: pb.WalletTransaction.inputs:type_name -> pb.WalletTransaction.IO
-	154, // 40: pb.WalletTransaction.outputs:type_name -> pb.WalletTransaction.IO
-	145, // 41: pb.CreateRawTransactionRequest.Input.input:type_name -> pb.PrivateInput
-	145, // 42: pb.CreateRawStakeTransactionRequest.Input.input:type_name -> pb.PrivateInput
-	155, // 43: pb.WalletTransaction.IO.tx_io:type_name -> pb.WalletTransaction.IO.TxIO
-	156, // 44: pb.WalletTransaction.IO.unknown:type_name -> pb.WalletTransaction.IO.Unknown
-	2,   // 45: pb.BlockchainService.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
-	4,   // 46: pb.BlockchainService.GetMempool:input_type -> pb.GetMempoolRequest
-	6,   // 47: pb.BlockchainService.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
-	8,   // 48: pb.BlockchainService.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
-	10,  // 49: pb.BlockchainService.GetBlock:input_type -> pb.GetBlockRequest
-	12,  // 50: pb.BlockchainService.GetCompressedBlock:input_type -> pb.GetCompressedBlockRequest
-	14,  // 51: pb.BlockchainService.GetHeaders:input_type -> pb.GetHeadersRequest
-	16,  // 52: pb.BlockchainService.GetCompressedBlocks:input_type -> pb.GetCompressedBlocksRequest
-	18,  // 53: pb.BlockchainService.GetTransaction:input_type -> pb.GetTransactionRequest
-	20,  // 54: pb.BlockchainService.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
-	22,  // 55: pb.BlockchainService.GetValidator:input_type -> pb.GetValidatorRequest
-	24,  // 56: pb.BlockchainService.GetValidatorSetInfo:input_type -> pb.GetValidatorSetInfoRequest
-	26,  // 57: pb.BlockchainService.GetValidatorSet:input_type -> pb.GetValidatorSetRequest
-	28,  // 58: pb.BlockchainService.GetAccumulatorCheckpoint:input_type -> pb.GetAccumulatorCheckpointRequest
-	30,  // 59: pb.BlockchainService.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
-	32,  // 60: pb.BlockchainService.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
-	33,  // 61: pb.BlockchainService.SubscribeCompressedBlocks:input_type -> pb.SubscribeCompressedBlocksRequest
-	34,  // 62: pb.WalletServerService.RegisterViewKey:input_type -> pb.RegisterViewKeyRequest
-	36,  // 63: pb.WalletServerService.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
-	37,  // 64: pb.WalletServerService.GetWalletTransactions:input_type -> pb.GetWalletTransactionsRequest
-	39,  // 65: pb.WalletServerService.GetTxoProof:input_type -> pb.GetTxoProofRequest
-	41,  // 66: pb.WalletService.GetBalance:input_type -> pb.GetBalanceRequest
-	43,  // 67: pb.WalletService.GetWalletSeed:input_type -> pb.GetWalletSeedRequest
-	45,  // 68: pb.WalletService.GetAddress:input_type -> pb.GetAddressRequest
-	47,  // 69: pb.WalletService.GetTimelockedAddress:input_type -> pb.GetTimelockedAddressRequest
-	49,  // 70: pb.WalletService.GetAddresses:input_type -> pb.GetAddressesRequest
-	51,  // 71: pb.WalletService.GetAddressInfo:input_type -> pb.GetAddressInfoRequest
-	53,  // 72: pb.WalletService.GetNewAddress:input_type -> pb.GetNewAddressRequest
-	55,  // 73: pb.WalletService.GetTransactions:input_type -> pb.GetTransactionsRequest
-	57,  // 74: pb.WalletService.GetUtxos:input_type -> pb.GetUtxosRequest
-	59,  // 75: pb.WalletService.GetPrivateKey:input_type -> pb.GetPrivateKeyRequest
-	61,  // 76: pb.WalletService.ImportAddress:input_type -> pb.ImportAddressRequest
-	63,  // 77: pb.WalletService.CreateMultisigSpendKeypair:input_type -> pb.CreateMultisigSpendKeypairRequest
-	65,  // 78: pb.WalletService.CreateMultisigViewKeypair:input_type -> pb.CreateMultisigViewKeypairRequest
-	67,  // 79: pb.WalletService.CreateMultisigAddress:input_type -> pb.CreateMultisigAddressRequest
-	69,  // 80: pb.WalletService.CreateMultiSignature:input_type -> pb.CreateMultiSignatureRequest
-	71,  // 81: pb.WalletService.ProveMultisig:input_type -> pb.ProveMultisigRequest
-	73,  // 82: pb.WalletService.WalletLock:input_type -> pb.WalletLockRequest
-	75,  // 83: pb.WalletService.WalletUnlock:input_type -> pb.WalletUnlockRequest
-	77,  // 84: pb.WalletService.SetWalletPassphrase:input_type -> pb.SetWalletPassphraseRequest
-	79,  // 85: pb.WalletService.ChangeWalletPassphrase:input_type -> pb.ChangeWalletPassphraseRequest
-	81,  // 86: pb.WalletService.DeletePrivateKeys:input_type -> pb.DeletePrivateKeysRequest
-	83,  // 87: pb.WalletService.CreateRawTransaction:input_type -> pb.CreateRawTransactionRequest
-	85,  // 88: pb.WalletService.CreateRawStakeTransaction:input_type -> pb.CreateRawStakeTransactionRequest
-	87,  // 89: pb.WalletService.ProveRawTransaction:input_type -> pb.ProveRawTransactionRequest
-	89,  // 90: pb.WalletService.Stake:input_type -> pb.StakeRequest
-	91,  // 91: pb.WalletService.SetAutoStakeRewards:input_type -> pb.SetAutoStakeRewardsRequest
-	93,  // 92: pb.WalletService.Spend:input_type -> pb.SpendRequest
-	95,  // 93: pb.WalletService.TimelockCoins:input_type -> pb.TimelockCoinsRequest
-	97,  // 94: pb.WalletService.SweepWallet:input_type -> pb.SweepWalletRequest
-	99,  // 95: pb.WalletService.SubscribeWalletTransactions:input_type -> pb.SubscribeWalletTransactionsRequest
-	100, // 96: pb.WalletService.SubscribeWalletSyncNotifications:input_type -> pb.SubscribeWalletSyncNotificationsRequest
-	101, // 97: pb.NodeService.GetHostInfo:input_type -> pb.GetHostInfoRequest
-	103, // 98: pb.NodeService.GetNetworkKey:input_type -> pb.GetNetworkKeyRequest
-	105, // 99: pb.NodeService.GetPeers:input_type -> pb.GetPeersRequest
-	107, // 100: pb.NodeService.AddPeer:input_type -> pb.AddPeerRequest
-	109, // 101: pb.NodeService.BlockPeer:input_type -> pb.BlockPeerRequest
-	111, // 102: pb.NodeService.UnblockPeer:input_type -> pb.UnblockPeerRequest
-	113, // 103: pb.NodeService.SetLogLevel:input_type -> pb.SetLogLevelRequest
-	115, // 104: pb.NodeService.GetMinFeePerKilobyte:input_type -> pb.GetMinFeePerKilobyteRequest
-	117, // 105: pb.NodeService.SetMinFeePerKilobyte:input_type -> pb.SetMinFeePerKilobyteRequest
-	119, // 106: pb.NodeService.GetMinStake:input_type -> pb.GetMinStakeRequest
-	121, // 107: pb.NodeService.SetMinStake:input_type -> pb.SetMinStakeRequest
-	123, // 108: pb.NodeService.GetBlockSizeSoftLimit:input_type -> pb.GetBlockSizeSoftLimitRequest
-	125, // 109: pb.NodeService.SetBlockSizeSoftLimit:input_type -> pb.SetBlockSizeSoftLimitRequest
-	127, // 110: pb.NodeService.GetTreasuryWhitelist:input_type -> pb.GetTreasuryWhitelistRequest
-	129, // 111: pb.NodeService.UpdateTreasuryWhitelist:input_type -> pb.UpdateTreasuryWhitelistRequest
-	131, // 112: pb.NodeService.ReconsiderBlock:input_type -> pb.ReconsiderBlockRequest
-	133, // 113: pb.NodeService.RecomputeChainState:input_type -> pb.RecomputeChainStateRequest
-	3,   // 114: pb.BlockchainService.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
-	5,   // 115: pb.BlockchainService.GetMempool:output_type -> pb.GetMempoolResponse
-	7,   // 116: pb.BlockchainService.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
-	9,   // 117: pb.BlockchainService.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
-	11,  // 118: pb.BlockchainService.GetBlock:output_type -> pb.GetBlockResponse
-	13,  // 119: pb.BlockchainService.GetCompressedBlock:output_type -> pb.GetCompressedBlockResponse
-	15,  // 120: pb.BlockchainService.GetHeaders:output_type -> pb.GetHeadersResponse
-	17,  // 121: pb.BlockchainService.GetCompressedBlocks:output_type -> pb.GetCompressedBlocksResponse
-	19,  // 122: pb.BlockchainService.GetTransaction:output_type -> pb.GetTransactionResponse
-	21,  // 123: pb.BlockchainService.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
-	23,  // 124: pb.BlockchainService.GetValidator:output_type -> pb.GetValidatorResponse
-	25,  // 125: pb.BlockchainService.GetValidatorSetInfo:output_type -> pb.GetValidatorSetInfoResponse
-	27,  // 126: pb.BlockchainService.GetValidatorSet:output_type -> pb.GetValidatorSetResponse
-	29,  // 127: pb.BlockchainService.GetAccumulatorCheckpoint:output_type -> pb.GetAccumulatorCheckpointResponse
-	31,  // 128: pb.BlockchainService.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
-	138, // 129: pb.BlockchainService.SubscribeBlocks:output_type -> pb.BlockNotification
-	139, // 130: pb.BlockchainService.SubscribeCompressedBlocks:output_type -> pb.CompressedBlockNotification
-	35,  // 131: pb.WalletServerService.RegisterViewKey:output_type -> pb.RegisterViewKeyResponse
-	135, // 132: pb.WalletServerService.SubscribeTransactions:output_type -> pb.TransactionNotification
-	38,  // 133: pb.WalletServerService.GetWalletTransactions:output_type -> pb.GetWalletTransactionsResponse
-	40,  // 134: pb.WalletServerService.GetTxoProof:output_type -> pb.GetTxoProofResponse
-	42,  // 135: pb.WalletService.GetBalance:output_type -> pb.GetBalanceResponse
-	44,  // 136: pb.WalletService.GetWalletSeed:output_type -> pb.GetWalletSeedResponse
-	46,  // 137: pb.WalletService.GetAddress:output_type -> pb.GetAddressResponse
-	48,  // 138: pb.WalletService.GetTimelockedAddress:output_type -> pb.GetTimelockedAddressResponse
-	50,  // 139: pb.WalletService.GetAddresses:output_type -> pb.GetAddressesResponse
-	52,  // 140: pb.WalletService.GetAddressInfo:output_type -> pb.GetAddressInfoResponse
-	54,  // 141: pb.WalletService.GetNewAddress:output_type -> pb.GetNewAddressResponse
-	56,  // 142: pb.WalletService.GetTransactions:output_type -> pb.GetTransactionsResponse
-	58,  // 143: pb.WalletService.GetUtxos:output_type -> pb.GetUtxosResponse
-	60,  // 144: pb.WalletService.GetPrivateKey:output_type -> pb.GetPrivateKeyResponse
-	62,  // 145: pb.WalletService.ImportAddress:output_type -> pb.ImportAddressResponse
-	64,  // 146: pb.WalletService.CreateMultisigSpendKeypair:output_type -> pb.CreateMultisigSpendKeypairResponse
-	66,  // 147: pb.WalletService.CreateMultisigViewKeypair:output_type -> pb.CreateMultisigViewKeypairResponse
-	68,  // 148: pb.WalletService.CreateMultisigAddress:output_type -> pb.CreateMultisigAddressResponse
-	70,  // 149: pb.WalletService.CreateMultiSignature:output_type -> pb.CreateMultiSignatureResponse
-	72,  // 150: pb.WalletService.ProveMultisig:output_type -> pb.ProveMultisigResponse
-	74,  // 151: pb.WalletService.WalletLock:output_type -> pb.WalletLockResponse
-	76,  // 152: pb.WalletService.WalletUnlock:output_type -> pb.WalletUnlockResponse
-	78,  // 153: pb.WalletService.SetWalletPassphrase:output_type -> pb.SetWalletPassphraseResponse
-	80,  // 154: pb.WalletService.ChangeWalletPassphrase:output_type -> pb.ChangeWalletPassphraseResponse
-	82,  // 155: pb.WalletService.DeletePrivateKeys:output_type -> pb.DeletePrivateKeysResponse
-	84,  // 156: pb.WalletService.CreateRawTransaction:output_type -> pb.CreateRawTransactionResponse
-	86,  // 157: pb.WalletService.CreateRawStakeTransaction:output_type -> pb.CreateRawStakeTransactionResponse
-	88,  // 158: pb.WalletService.ProveRawTransaction:output_type -> pb.ProveRawTransactionResponse
-	90,  // 159: pb.WalletService.Stake:output_type -> pb.StakeResponse
-	92,  // 160: pb.WalletService.SetAutoStakeRewards:output_type -> pb.SetAutoStakeRewardsResponse
-	94,  // 161: pb.WalletService.Spend:output_type -> pb.SpendResponse
-	96,  // 162: pb.WalletService.TimelockCoins:output_type -> pb.TimelockCoinsResponse
-	98,  // 163: pb.WalletService.SweepWallet:output_type -> pb.SweepWalletResponse
-	136, // 164: pb.WalletService.SubscribeWalletTransactions:output_type -> pb.WalletTransactionNotification
-	137, // 165: pb.WalletService.SubscribeWalletSyncNotifications:output_type -> pb.WalletSyncNotification
-	102, // 166: pb.NodeService.GetHostInfo:output_type -> pb.GetHostInfoResponse
-	104, // 167: pb.NodeService.GetNetworkKey:output_type -> pb.GetNetworkKeyResponse
-	106, // 168: pb.NodeService.GetPeers:output_type -> pb.GetPeersResponse
-	108, // 169: pb.NodeService.AddPeer:output_type -> pb.AddPeerResponse
-	110, // 170: pb.NodeService.BlockPeer:output_type -> pb.BlockPeerResponse
-	112, // 171: pb.NodeService.UnblockPeer:output_type -> pb.UnblockPeerResponse
-	114, // 172: pb.NodeService.SetLogLevel:output_type -> pb.SetLogLevelResponse
-	116, // 173: pb.NodeService.GetMinFeePerKilobyte:output_type -> pb.GetMinFeePerKilobyteResponse
-	118, // 174: pb.NodeService.SetMinFeePerKilobyte:output_type -> pb.SetMinFeePerKilobyteResponse
-	120, // 175: pb.NodeService.GetMinStake:output_type -> pb.GetMinStakeResponse
-	122, // 176: pb.NodeService.SetMinStake:output_type -> pb.SetMinStakeResponse
-	124, // 177: pb.NodeService.GetBlockSizeSoftLimit:output_type -> pb.GetBlockSizeSoftLimitResponse
-	126, // 178: pb.NodeService.SetBlockSizeSoftLimit:output_type -> pb.SetBlockSizeSoftLimitResponse
-	128, // 179: pb.NodeService.GetTreasuryWhitelist:output_type -> pb.GetTreasuryWhitelistResponse
-	130, // 180: pb.NodeService.UpdateTreasuryWhitelist:output_type -> pb.UpdateTreasuryWhitelistResponse
-	132, // 181: pb.NodeService.ReconsiderBlock:output_type -> pb.ReconsiderBlockResponse
-	134, // 182: pb.NodeService.RecomputeChainState:output_type -> pb.RecomputeChainStateResponse
-	114, // [114:183] is the sub-list for method output_type
-	45,  // [45:114] is the sub-list for method input_type
-	45,  // [45:45] is the sub-list for extension type_name
-	45,  // [45:45] is the sub-list for extension extendee
-	0,   // [0:45] is the sub-list for field type_name
+	161, // 2: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
+	183, // 3: pb.GetBlockResponse.block:type_name -> Block
+	184, // 4: pb.GetCompressedBlockResponse.block:type_name -> CompressedBlock
+	185, // 5: pb.GetHeadersResponse.headers:type_name -> BlockHeader
+	184, // 6: pb.GetCompressedBlocksResponse.blocks:type_name -> CompressedBlock
+	186, // 7: pb.GetTransactionResponse.tx:type_name -> Transaction
+	161, // 8: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
+	162, // 9: pb.GetValidatorResponse.validator:type_name -> pb.Validator
+	162, // 10: pb.GetValidatorSetResponse.validators:type_name -> pb.Validator
+	163, // 11: pb.GetValidatorHistoryResponse.events:type_name -> pb.ValidatorEvent
+	186, // 12: pb.SubmitTransactionRequest.transaction:type_name -> Transaction
+	186, // 13: pb.GetWalletTransactionsResponse.transactions:type_name -> Transaction
+	169, // 14: pb.GetTxoProofResponse.proofs:type_name -> pb.TxoProof
+	171, // 15: pb.GetTransactionsResponse.txs:type_name -> pb.WalletTransaction
+	1,   // 16: pb.ExportTransactionHistoryRequest.format:type_name -> pb.ExportTransactionHistoryRequest.Format
+	165, // 17: pb.GetUtxosResponse.utxos:type_name -> pb.Utxo
+	186, // 18: pb.CreateMultiSignatureRequest.tx:type_name -> Transaction
+	166, // 19: pb.ProveMultisigRequest.raw_tx:type_name -> pb.RawTransaction
+	186, // 20: pb.ProveMultisigResponse.proved_tx:type_name -> Transaction
+	172, // 21: pb.CreateRawTransactionRequest.inputs:type_name -> pb.CreateRawTransactionRequest.Input
+	173, // 22: pb.CreateRawTransactionRequest.outputs:type_name -> pb.CreateRawTransactionRequest.Output
+	166, // 23: pb.CreateRawTransactionResponse.raw_tx:type_name -> pb.RawTransaction
+	174, // 24: pb.CreateRawStakeTransactionRequest.input:type_name -> pb.CreateRawStakeTransactionRequest.Input
+	166, // 25: pb.CreateRawStakeTransactionResponse.raw_tx:type_name -> pb.RawTransaction
+	166, // 26: pb.ProveRawTransactionRequest.raw_tx:type_name -> pb.RawTransaction
+	186, // 27: pb.ProveRawTransactionResponse.proved_tx:type_name -> Transaction
+	164, // 28: pb.GetAccountsResponse.accounts:type_name -> pb.Account
+	170, // 29: pb.GetPeersResponse.peers:type_name -> pb.Peer
+	2,   // 30: pb.SetLogLevelRequest.level:type_name -> pb.SetLogLevelRequest.Level
+	0,   // 31: pb.GetNodeStatusResponse.network:type_name -> pb.GetBlockchainInfoResponse.Network
+	175, // 32: pb.GetNodeStatusResponse.peers:type_name -> pb.GetNodeStatusResponse.PeerSummary
+	176, // 33: pb.GetNodeStatusResponse.mempool:type_name -> pb.GetNodeStatusResponse.MempoolSummary
+	177, // 34: pb.GetNodeStatusResponse.validator:type_name -> pb.GetNodeStatusResponse.ValidatorStatus
+	178, // 35: pb.GetNodeStatusResponse.resources:type_name -> pb.GetNodeStatusResponse.ResourceUsage
+	186, // 36: pb.TransactionNotification.transaction:type_name -> Transaction
+	171, // 37: pb.WalletTransactionNotification.transaction:type_name -> pb.WalletTransaction
+	161, // 38: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
+	160, // 39: pb.BlockNotification.transactions:type_name -> pb.TransactionData
+	184, // 40: pb.CompressedBlockNotification.block:type_name -> CompressedBlock
+	186, // 41: pb.TransactionData.transaction:type_name -> Transaction
+	179, // 42: pb.Validator.stake:type_name -> pb.Validator.Stake
+	3,   // 43: pb.ValidatorEvent.type:type_name -> pb.ValidatorEvent.Type
+	186, // 44: pb.RawTransaction.tx:type_name -> Transaction
+	167, // 45: pb.RawTransaction.inputs:type_name -> pb.PrivateInput
+	168, // 46: pb.RawTransaction.outputs:type_name -> pb.PrivateOutput
+	169, // 47: pb.PrivateInput.txo_proof:type_name -> pb.TxoProof
+	180, // 48: pb.WalletTransaction.inputs:type_name -> pb.WalletTransaction.IO
+	180, // 49: pb.WalletTransaction.outputs:type_name -> pb.WalletTransaction.IO
+	167, // 50: pb.CreateRawTransactionRequest.Input.input:type_name -> pb.PrivateInput
+	167, // 51: pb.CreateRawStakeTransactionRequest.Input.input:type_name -> pb.PrivateInput
+	181, // 52: pb.WalletTransaction.IO.tx_io:type_name -> pb.WalletTransaction.IO.TxIO
+	182, // 53: pb.WalletTransaction.IO.unknown:type_name -> pb.WalletTransaction.IO.Unknown
+	4,   // 54: pb.BlockchainService.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
+	6,   // 55: pb.BlockchainService.GetMempool:input_type -> pb.GetMempoolRequest
+	8,   // 56: pb.BlockchainService.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
+	10,  // 57: pb.BlockchainService.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
+	12,  // 58: pb.BlockchainService.GetBlock:input_type -> pb.GetBlockRequest
+	14,  // 59: pb.BlockchainService.GetCompressedBlock:input_type -> pb.GetCompressedBlockRequest
+	16,  // 60: pb.BlockchainService.GetHeaders:input_type -> pb.GetHeadersRequest
+	18,  // 61: pb.BlockchainService.GetCompressedBlocks:input_type -> pb.GetCompressedBlocksRequest
+	20,  // 62: pb.BlockchainService.GetTransaction:input_type -> pb.GetTransactionRequest
+	22,  // 63: pb.BlockchainService.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
+	24,  // 64: pb.BlockchainService.GetValidator:input_type -> pb.GetValidatorRequest
+	26,  // 65: pb.BlockchainService.GetValidatorSetInfo:input_type -> pb.GetValidatorSetInfoRequest
+	28,  // 66: pb.BlockchainService.GetValidatorSet:input_type -> pb.GetValidatorSetRequest
+	30,  // 67: pb.BlockchainService.GetValidatorHistory:input_type -> pb.GetValidatorHistoryRequest
+	32,  // 68: pb.BlockchainService.GetAccumulatorCheckpoint:input_type -> pb.GetAccumulatorCheckpointRequest
+	34,  // 69: pb.BlockchainService.GetFinalityCertificate:input_type -> pb.GetFinalityCertificateRequest
+	36,  // 70: pb.BlockchainService.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
+	38,  // 71: pb.BlockchainService.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
+	39,  // 72: pb.BlockchainService.SubscribeCompressedBlocks:input_type -> pb.SubscribeCompressedBlocksRequest
+	40,  // 73: pb.WalletServerService.RegisterViewKey:input_type -> pb.RegisterViewKeyRequest
+	42,  // 74: pb.WalletServerService.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
+	43,  // 75: pb.WalletServerService.GetWalletTransactions:input_type -> pb.GetWalletTransactionsRequest
+	45,  // 76: pb.WalletServerService.GetTxoProof:input_type -> pb.GetTxoProofRequest
+	47,  // 77: pb.WalletService.GetBalance:input_type -> pb.GetBalanceRequest
+	49,  // 78: pb.WalletService.GetWalletSeed:input_type -> pb.GetWalletSeedRequest
+	51,  // 79: pb.WalletService.GetAddress:input_type -> pb.GetAddressRequest
+	53,  // 80: pb.WalletService.GetTimelockedAddress:input_type -> pb.GetTimelockedAddressRequest
+	55,  // 81: pb.WalletService.GetAddresses:input_type -> pb.GetAddressesRequest
+	57,  // 82: pb.WalletService.GetAddressInfo:input_type -> pb.GetAddressInfoRequest
+	59,  // 83: pb.WalletService.GetNewAddress:input_type -> pb.GetNewAddressRequest
+	61,  // 84: pb.WalletService.GetTransactions:input_type -> pb.GetTransactionsRequest
+	63,  // 85: pb.WalletService.ExportTransactionHistory:input_type -> pb.ExportTransactionHistoryRequest
+	101, // 86: pb.WalletService.CreateAccount:input_type -> pb.CreateAccountRequest
+	103, // 87: pb.WalletService.GetAccounts:input_type -> pb.GetAccountsRequest
+	105, // 88: pb.WalletService.SetAccountLabel:input_type -> pb.SetAccountLabelRequest
+	107, // 89: pb.WalletService.GetAccountNewAddress:input_type -> pb.GetAccountNewAddressRequest
+	109, // 90: pb.WalletService.AccountSpend:input_type -> pb.AccountSpendRequest
+	65,  // 91: pb.WalletService.GetUtxos:input_type -> pb.GetUtxosRequest
+	67,  // 92: pb.WalletService.GetPrivateKey:input_type -> pb.GetPrivateKeyRequest
+	69,  // 93: pb.WalletService.ImportAddress:input_type -> pb.ImportAddressRequest
+	71,  // 94: pb.WalletService.CreateMultisigSpendKeypair:input_type -> pb.CreateMultisigSpendKeypairRequest
+	73,  // 95: pb.WalletService.CreateMultisigViewKeypair:input_type -> pb.CreateMultisigViewKeypairRequest
+	75,  // 96: pb.WalletService.CreateMultisigAddress:input_type -> pb.CreateMultisigAddressRequest
+	77,  // 97: pb.WalletService.CreateMultiSignature:input_type -> pb.CreateMultiSignatureRequest
+	79,  // 98: pb.WalletService.ProveMultisig:input_type -> pb.ProveMultisigRequest
+	81,  // 99: pb.WalletService.WalletLock:input_type -> pb.WalletLockRequest
+	83,  // 100: pb.WalletService.WalletUnlock:input_type -> pb.WalletUnlockRequest
+	85,  // 101: pb.WalletService.SetWalletPassphrase:input_type -> pb.SetWalletPassphraseRequest
+	87,  // 102: pb.WalletService.ChangeWalletPassphrase:input_type -> pb.ChangeWalletPassphraseRequest
+	89,  // 103: pb.WalletService.DeletePrivateKeys:input_type -> pb.DeletePrivateKeysRequest
+	91,  // 104: pb.WalletService.CreateRawTransaction:input_type -> pb.CreateRawTransactionRequest
+	93,  // 105: pb.WalletService.CreateRawStakeTransaction:input_type -> pb.CreateRawStakeTransactionRequest
+	95,  // 106: pb.WalletService.ProveRawTransaction:input_type -> pb.ProveRawTransactionRequest
+	97,  // 107: pb.WalletService.Stake:input_type -> pb.StakeRequest
+	99,  // 108: pb.WalletService.SetAutoStakeRewards:input_type -> pb.SetAutoStakeRewardsRequest
+	111, // 109: pb.WalletService.Spend:input_type -> pb.SpendRequest
+	113, // 110: pb.WalletService.TimelockCoins:input_type -> pb.TimelockCoinsRequest
+	115, // 111: pb.WalletService.SweepWallet:input_type -> pb.SweepWalletRequest
+	117, // 112: pb.WalletService.SubscribeWalletTransactions:input_type -> pb.SubscribeWalletTransactionsRequest
+	118, // 113: pb.WalletService.SubscribeWalletSyncNotifications:input_type -> pb.SubscribeWalletSyncNotificationsRequest
+	119, // 114: pb.NodeService.GetHostInfo:input_type -> pb.GetHostInfoRequest
+	121, // 115: pb.NodeService.GetNetworkKey:input_type -> pb.GetNetworkKeyRequest
+	123, // 116: pb.NodeService.GetPeers:input_type -> pb.GetPeersRequest
+	125, // 117: pb.NodeService.AddPeer:input_type -> pb.AddPeerRequest
+	127, // 118: pb.NodeService.BlockPeer:input_type -> pb.BlockPeerRequest
+	129, // 119: pb.NodeService.UnblockPeer:input_type -> pb.UnblockPeerRequest
+	131, // 120: pb.NodeService.SetLogLevel:input_type -> pb.SetLogLevelRequest
+	133, // 121: pb.NodeService.GetMinFeePerKilobyte:input_type -> pb.GetMinFeePerKilobyteRequest
+	135, // 122: pb.NodeService.SetMinFeePerKilobyte:input_type -> pb.SetMinFeePerKilobyteRequest
+	137, // 123: pb.NodeService.GetMinStake:input_type -> pb.GetMinStakeRequest
+	139, // 124: pb.NodeService.SetMinStake:input_type -> pb.SetMinStakeRequest
+	141, // 125: pb.NodeService.GetBlockSizeSoftLimit:input_type -> pb.GetBlockSizeSoftLimitRequest
+	143, // 126: pb.NodeService.SetBlockSizeSoftLimit:input_type -> pb.SetBlockSizeSoftLimitRequest
+	145, // 127: pb.NodeService.GetTreasuryWhitelist:input_type -> pb.GetTreasuryWhitelistRequest
+	147, // 128: pb.NodeService.UpdateTreasuryWhitelist:input_type -> pb.UpdateTreasuryWhitelistRequest
+	149, // 129: pb.NodeService.ReconsiderBlock:input_type -> pb.ReconsiderBlockRequest
+	151, // 130: pb.NodeService.RecomputeChainState:input_type -> pb.RecomputeChainStateRequest
+	153, // 131: pb.NodeService.GetNodeStatus:input_type -> pb.GetNodeStatusRequest
+	5,   // 132: pb.BlockchainService.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
+	7,   // 133: pb.BlockchainService.GetMempool:output_type -> pb.GetMempoolResponse
+	9,   // 134: pb.BlockchainService.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
+	11,  // 135: pb.BlockchainService.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
+	13,  // 136: pb.BlockchainService.GetBlock:output_type -> pb.GetBlockResponse
+	15,  // 137: pb.BlockchainService.GetCompressedBlock:output_type -> pb.GetCompressedBlockResponse
+	17,  // 138: pb.BlockchainService.GetHeaders:output_type -> pb.GetHeadersResponse
+	19,  // 139: pb.BlockchainService.GetCompressedBlocks:output_type -> pb.GetCompressedBlocksResponse
+	21,  // 140: pb.BlockchainService.GetTransaction:output_type -> pb.GetTransactionResponse
+	23,  // 141: pb.BlockchainService.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
+	25,  // 142: pb.BlockchainService.GetValidator:output_type -> pb.GetValidatorResponse
+	27,  // 143: pb.BlockchainService.GetValidatorSetInfo:output_type -> pb.GetValidatorSetInfoResponse
+	29,  // 144: pb.BlockchainService.GetValidatorSet:output_type -> pb.GetValidatorSetResponse
+	31,  // 145: pb.BlockchainService.GetValidatorHistory:output_type -> pb.GetValidatorHistoryResponse
+	33,  // 146: pb.BlockchainService.GetAccumulatorCheckpoint:output_type -> pb.GetAccumulatorCheckpointResponse
+	35,  // 147: pb.BlockchainService.GetFinalityCertificate:output_type -> pb.GetFinalityCertificateResponse
+	37,  // 148: pb.BlockchainService.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
+	158, // 149: pb.BlockchainService.SubscribeBlocks:output_type -> pb.BlockNotification
+	159, // 150: pb.BlockchainService.SubscribeCompressedBlocks:output_type -> pb.CompressedBlockNotification
+	41,  // 151: pb.WalletServerService.RegisterViewKey:output_type -> pb.RegisterViewKeyResponse
+	155, // 152: pb.WalletServerService.SubscribeTransactions:output_type -> pb.TransactionNotification
+	44,  // 153: pb.WalletServerService.GetWalletTransactions:output_type -> pb.GetWalletTransactionsResponse
+	46,  // 154: pb.WalletServerService.GetTxoProof:output_type -> pb.GetTxoProofResponse
+	48,  // 155: pb.WalletService.GetBalance:output_type -> pb.GetBalanceResponse
+	50,  // 156: pb.WalletService.GetWalletSeed:output_type -> pb.GetWalletSeedResponse
+	52,  // 157: pb.WalletService.GetAddress:output_type -> pb.GetAddressResponse
+	54,  // 158: pb.WalletService.GetTimelockedAddress:output_type -> pb.GetTimelockedAddressResponse
+	56,  // 159: pb.WalletService.GetAddresses:output_type -> pb.GetAddressesResponse
+	58,  // 160: pb.WalletService.GetAddressInfo:output_type -> pb.GetAddressInfoResponse
+	60,  // 161: pb.WalletService.GetNewAddress:output_type -> pb.GetNewAddressResponse
+	62,  // 162: pb.WalletService.GetTransactions:output_type -> pb.GetTransactionsResponse
+	64,  // 163: pb.WalletService.ExportTransactionHistory:output_type -> pb.ExportTransactionHistoryResponse
+	102, // 164: pb.WalletService.CreateAccount:output_type -> pb.CreateAccountResponse
+	104, // 165: pb.WalletService.GetAccounts:output_type -> pb.GetAccountsResponse
+	106, // 166: pb.WalletService.SetAccountLabel:output_type -> pb.SetAccountLabelResponse
+	108, // 167: pb.WalletService.GetAccountNewAddress:output_type -> pb.GetAccountNewAddressResponse
+	110, // 168: pb.WalletService.AccountSpend:output_type -> pb.AccountSpendResponse
+	66,  // 169: pb.WalletService.GetUtxos:output_type -> pb.GetUtxosResponse
+	68,  // 170: pb.WalletService.GetPrivateKey:output_type -> pb.GetPrivateKeyResponse
+	70,  // 171: pb.WalletService.ImportAddress:output_type -> pb.ImportAddressResponse
+	72,  // 172: pb.WalletService.CreateMultisigSpendKeypair:output_type -> pb.CreateMultisigSpendKeypairResponse
+	74,  // 173: pb.WalletService.CreateMultisigViewKeypair:output_type -> pb.CreateMultisigViewKeypairResponse
+	76,  // 174: pb.WalletService.CreateMultisigAddress:output_type -> pb.CreateMultisigAddressResponse
+	78,  // 175: pb.WalletService.CreateMultiSignature:output_type -> pb.CreateMultiSignatureResponse
+	80,  // 176: pb.WalletService.ProveMultisig:output_type -> pb.ProveMultisigResponse
+	82,  // 177: pb.WalletService.WalletLock:output_type -> pb.WalletLockResponse
+	84,  // 178: pb.WalletService.WalletUnlock:output_type -> pb.WalletUnlockResponse
+	86,  // 179: pb.WalletService.SetWalletPassphrase:output_type -> pb.SetWalletPassphraseResponse
+	88,  // 180: pb.WalletService.ChangeWalletPassphrase:output_type -> pb.ChangeWalletPassphraseResponse
+	90,  // 181: pb.WalletService.DeletePrivateKeys:output_type -> pb.DeletePrivateKeysResponse
+	92,  // 182: pb.WalletService.CreateRawTransaction:output_type -> pb.CreateRawTransactionResponse
+	94,  // 183: pb.WalletService.CreateRawStakeTransaction:output_type -> pb.CreateRawStakeTransactionResponse
+	96,  // 184: pb.WalletService.ProveRawTransaction:output_type -> pb.ProveRawTransactionResponse
+	98,  // 185: pb.WalletService.Stake:output_type -> pb.StakeResponse
+	100, // 186: pb.WalletService.SetAutoStakeRewards:output_type -> pb.SetAutoStakeRewardsResponse
+	112, // 187: pb.WalletService.Spend:output_type -> pb.SpendResponse
+	114, // 188: pb.WalletService.TimelockCoins:output_type -> pb.TimelockCoinsResponse
+	116, // 189: pb.WalletService.SweepWallet:output_type -> pb.SweepWalletResponse
+	156, // 190: pb.WalletService.SubscribeWalletTransactions:output_type -> pb.WalletTransactionNotification
+	157, // 191: pb.WalletService.SubscribeWalletSyncNotifications:output_type -> pb.WalletSyncNotification
+	120, // 192: pb.NodeService.GetHostInfo:output_type -> pb.GetHostInfoResponse
+	122, // 193: pb.NodeService.GetNetworkKey:output_type -> pb.GetNetworkKeyResponse
+	124, // 194: pb.NodeService.GetPeers:output_type -> pb.GetPeersResponse
+	126, // 195: pb.NodeService.AddPeer:output_type -> pb.AddPeerResponse
+	128, // 196: pb.NodeService.BlockPeer:output_type -> pb.BlockPeerResponse
+	130, // 197: pb.NodeService.UnblockPeer:output_type -> pb.UnblockPeerResponse
+	132, // 198: pb.NodeService.SetLogLevel:output_type -> pb.SetLogLevelResponse
+	134, // 199: pb.NodeService.GetMinFeePerKilobyte:output_type -> pb.GetMinFeePerKilobyteResponse
+	136, // 200: pb.NodeService.SetMinFeePerKilobyte:output_type -> pb.SetMinFeePerKilobyteResponse
+	138, // 201: pb.NodeService.GetMinStake:output_type -> pb.GetMinStakeResponse
+	140, // 202: pb.NodeService.SetMinStake:output_type -> pb.SetMinStakeResponse
+	142, // 203: pb.NodeService.GetBlockSizeSoftLimit:output_type -> pb.GetBlockSizeSoftLimitResponse
+	144, // 204: pb.NodeService.SetBlockSizeSoftLimit:output_type -> pb.SetBlockSizeSoftLimitResponse
+	146, // 205: pb.NodeService.GetTreasuryWhitelist:output_type -> pb.GetTreasuryWhitelistResponse
+	148, // 206: pb.NodeService.UpdateTreasuryWhitelist:output_type -> pb.UpdateTreasuryWhitelistResponse
+	150, // 207: pb.NodeService.ReconsiderBlock:output_type -> pb.ReconsiderBlockResponse
+	152, // 208: pb.NodeService.RecomputeChainState:output_type -> pb.RecomputeChainStateResponse
+	154, // 209: pb.NodeService.GetNodeStatus:output_type -> pb.GetNodeStatusResponse
+	132, // [132:210] is the sub-list for method output_type
+	54,  // [54:132] is the sub-list for method input_type
+	54,  // [54:54] is the sub-list for extension type_name
+	54,  // [54:54] is the sub-list for extension extendee
+	0,   // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_ilxrpc_proto_init() }
@@ -10099,8 +11999,200 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10111,8 +12203,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockRequest); i {
+		file_ilxrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10123,8 +12215,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponse); i {
+		file_ilxrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10135,8 +12227,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlockRequest); i {
+		file_ilxrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10147,8 +12239,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlockResponse); i {
+		file_ilxrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10159,8 +12251,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersRequest); i {
+		file_ilxrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccumulatorCheckpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10171,8 +12263,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersResponse); i {
+		file_ilxrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccumulatorCheckpointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10183,8 +12275,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalityCertificateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10195,8 +12287,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlocksResponse); i {
+		file_ilxrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalityCertificateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10207,8 +12299,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10219,8 +12311,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10231,8 +12323,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofRequest); i {
+		file_ilxrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10243,8 +12335,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofResponse); i {
+		file_ilxrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeCompressedBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10255,8 +12347,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorRequest); i {
+		file_ilxrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterViewKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10267,8 +12359,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorResponse); i {
+		file_ilxrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterViewKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10279,8 +12371,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10291,8 +12383,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10303,8 +12395,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetRequest); i {
+		file_ilxrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10315,8 +12407,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetResponse); i {
+		file_ilxrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxoProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10327,8 +12419,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccumulatorCheckpointRequest); i {
+		file_ilxrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxoProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10339,8 +12431,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccumulatorCheckpointResponse); i {
+		file_ilxrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10351,8 +12443,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10363,8 +12455,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletSeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10375,8 +12467,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletSeedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10387,8 +12479,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeCompressedBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10399,8 +12491,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterViewKeyRequest); i {
+		file_ilxrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10411,8 +12503,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterViewKeyResponse); i {
+		file_ilxrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelockedAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10423,8 +12515,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelockedAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10435,8 +12527,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10447,8 +12539,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletTransactionsResponse); i {
+		file_ilxrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10459,8 +12551,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxoProofRequest); i {
+		file_ilxrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10471,8 +12563,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxoProofResponse); i {
+		file_ilxrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10483,8 +12575,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceRequest); i {
+		file_ilxrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10495,8 +12587,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_ilxrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10507,8 +12599,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletSeedRequest); i {
+		file_ilxrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10519,8 +12611,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletSeedResponse); i {
+		file_ilxrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10531,8 +12623,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTransactionHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10543,8 +12635,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTransactionHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10555,8 +12647,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimelockedAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10567,8 +12659,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimelockedAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10579,8 +12671,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressesRequest); i {
+		file_ilxrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10591,8 +12683,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressesResponse); i {
+		file_ilxrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10603,8 +12695,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10615,8 +12707,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10627,8 +12719,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigSpendKeypairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10639,8 +12731,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigSpendKeypairResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10651,8 +12743,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigViewKeypairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10663,8 +12755,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsResponse); i {
+		file_ilxrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigViewKeypairResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10675,8 +12767,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUtxosRequest); i {
+		file_ilxrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10687,8 +12779,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUtxosResponse); i {
+		file_ilxrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10699,8 +12791,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPrivateKeyRequest); i {
+		file_ilxrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultiSignatureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10711,8 +12803,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPrivateKeyResponse); i {
+		file_ilxrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultiSignatureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10723,8 +12815,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveMultisigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10735,8 +12827,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveMultisigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10747,8 +12839,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigSpendKeypairRequest); i {
+		file_ilxrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletLockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10759,8 +12851,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigSpendKeypairResponse); i {
+		file_ilxrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletLockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10771,8 +12863,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigViewKeypairRequest); i {
+		file_ilxrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletUnlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10783,8 +12875,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigViewKeypairResponse); i {
+		file_ilxrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletUnlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10795,8 +12887,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWalletPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10807,8 +12899,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWalletPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10819,8 +12911,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultiSignatureRequest); i {
+		file_ilxrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeWalletPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10831,8 +12923,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultiSignatureResponse); i {
+		file_ilxrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeWalletPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10843,8 +12935,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveMultisigRequest); i {
+		file_ilxrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivateKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10855,8 +12947,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveMultisigResponse); i {
+		file_ilxrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivateKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10867,8 +12959,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletLockRequest); i {
+		file_ilxrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10879,8 +12971,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletLockResponse); i {
+		file_ilxrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10891,8 +12983,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletUnlockRequest); i {
+		file_ilxrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawStakeTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10903,8 +12995,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletUnlockResponse); i {
+		file_ilxrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawStakeTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10915,8 +13007,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetWalletPassphraseRequest); i {
+		file_ilxrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRawTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10927,8 +13019,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetWalletPassphraseResponse); i {
+		file_ilxrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRawTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10939,8 +13031,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeWalletPassphraseRequest); i {
+		file_ilxrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10951,8 +13043,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeWalletPassphraseResponse); i {
+		file_ilxrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10963,8 +13055,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePrivateKeysRequest); i {
+		file_ilxrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoStakeRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10975,8 +13067,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePrivateKeysResponse); i {
+		file_ilxrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoStakeRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10987,8 +13079,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10999,8 +13091,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11011,8 +13103,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawStakeTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11023,8 +13115,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawStakeTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11035,8 +13127,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRawTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountLabelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11047,8 +13139,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRawTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountLabelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11059,8 +13151,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakeRequest); i {
+		file_ilxrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountNewAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11071,8 +13163,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakeResponse); i {
+		file_ilxrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountNewAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11083,8 +13175,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAutoStakeRewardsRequest); i {
+		file_ilxrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSpendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11095,8 +13187,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAutoStakeRewardsResponse); i {
+		file_ilxrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSpendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11107,7 +13199,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpendRequest); i {
 			case 0:
 				return &v.state
@@ -11119,7 +13211,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpendResponse); i {
 			case 0:
 				return &v.state
@@ -11131,7 +13223,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimelockCoinsRequest); i {
 			case 0:
 				return &v.state
@@ -11143,7 +13235,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimelockCoinsResponse); i {
 			case 0:
 				return &v.state
@@ -11155,7 +13247,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SweepWalletRequest); i {
 			case 0:
 				return &v.state
@@ -11167,7 +13259,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SweepWalletResponse); i {
 			case 0:
 				return &v.state
@@ -11179,7 +13271,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeWalletTransactionsRequest); i {
 			case 0:
 				return &v.state
@@ -11191,7 +13283,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeWalletSyncNotificationsRequest); i {
 			case 0:
 				return &v.state
@@ -11203,7 +13295,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHostInfoRequest); i {
 			case 0:
 				return &v.state
@@ -11215,7 +13307,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHostInfoResponse); i {
 			case 0:
 				return &v.state
@@ -11227,7 +13319,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNetworkKeyRequest); i {
 			case 0:
 				return &v.state
@@ -11239,7 +13331,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNetworkKeyResponse); i {
 			case 0:
 				return &v.state
@@ -11251,7 +13343,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPeersRequest); i {
 			case 0:
 				return &v.state
@@ -11263,7 +13355,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPeersResponse); i {
 			case 0:
 				return &v.state
@@ -11275,7 +13367,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddPeerRequest); i {
 			case 0:
 				return &v.state
@@ -11287,7 +13379,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddPeerResponse); i {
 			case 0:
 				return &v.state
@@ -11299,7 +13391,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockPeerRequest); i {
 			case 0:
 				return &v.state
@@ -11311,7 +13403,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockPeerResponse); i {
 			case 0:
 				return &v.state
@@ -11323,7 +13415,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnblockPeerRequest); i {
 			case 0:
 				return &v.state
@@ -11335,7 +13427,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnblockPeerResponse); i {
 			case 0:
 				return &v.state
@@ -11347,7 +13439,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLogLevelRequest); i {
 			case 0:
 				return &v.state
@@ -11359,7 +13451,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLogLevelResponse); i {
 			case 0:
 				return &v.state
@@ -11371,7 +13463,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMinFeePerKilobyteRequest); i {
 			case 0:
 				return &v.state
@@ -11383,7 +13475,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMinFeePerKilobyteResponse); i {
 			case 0:
 				return &v.state
@@ -11395,7 +13487,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMinFeePerKilobyteRequest); i {
 			case 0:
 				return &v.state
@@ -11407,7 +13499,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMinFeePerKilobyteResponse); i {
 			case 0:
 				return &v.state
@@ -11419,7 +13511,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMinStakeRequest); i {
 			case 0:
 				return &v.state
@@ -11431,7 +13523,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMinStakeResponse); i {
 			case 0:
 				return &v.state
@@ -11443,7 +13535,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMinStakeRequest); i {
 			case 0:
 				return &v.state
@@ -11455,7 +13547,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMinStakeResponse); i {
 			case 0:
 				return &v.state
@@ -11467,7 +13559,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBlockSizeSoftLimitRequest); i {
 			case 0:
 				return &v.state
@@ -11479,7 +13571,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBlockSizeSoftLimitResponse); i {
 			case 0:
 				return &v.state
@@ -11491,7 +13583,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetBlockSizeSoftLimitRequest); i {
 			case 0:
 				return &v.state
@@ -11503,7 +13595,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetBlockSizeSoftLimitResponse); i {
 			case 0:
 				return &v.state
@@ -11515,7 +13607,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTreasuryWhitelistRequest); i {
 			case 0:
 				return &v.state
@@ -11527,7 +13619,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTreasuryWhitelistResponse); i {
 			case 0:
 				return &v.state
@@ -11539,7 +13631,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTreasuryWhitelistRequest); i {
 			case 0:
 				return &v.state
@@ -11551,7 +13643,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTreasuryWhitelistResponse); i {
 			case 0:
 				return &v.state
@@ -11563,7 +13655,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconsiderBlockRequest); i {
 			case 0:
 				return &v.state
@@ -11575,7 +13667,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconsiderBlockResponse); i {
 			case 0:
 				return &v.state
@@ -11587,7 +13679,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecomputeChainStateRequest); i {
 			case 0:
 				return &v.state
@@ -11599,7 +13691,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecomputeChainStateResponse); i {
 			case 0:
 				return &v.state
@@ -11611,7 +13703,31 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionNotification); i {
 			case 0:
 				return &v.state
@@ -11623,7 +13739,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransactionNotification); i {
 			case 0:
 				return &v.state
@@ -11635,7 +13751,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletSyncNotification); i {
 			case 0:
 				return &v.state
@@ -11647,7 +13763,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockNotification); i {
 			case 0:
 				return &v.state
@@ -11659,7 +13775,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CompressedBlockNotification); i {
 			case 0:
 				return &v.state
@@ -11671,7 +13787,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionData); i {
 			case 0:
 				return &v.state
@@ -11683,7 +13799,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockInfo); i {
 			case 0:
 				return &v.state
@@ -11695,7 +13811,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Validator); i {
 			case 0:
 				return &v.state
@@ -11707,7 +13823,31 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Utxo); i {
 			case 0:
 				return &v.state
@@ -11719,7 +13859,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RawTransaction); i {
 			case 0:
 				return &v.state
@@ -11731,7 +13871,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrivateInput); i {
 			case 0:
 				return &v.state
@@ -11743,7 +13883,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrivateOutput); i {
 			case 0:
 				return &v.state
@@ -11755,7 +13895,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxoProof); i {
 			case 0:
 				return &v.state
@@ -11767,7 +13907,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
@@ -11779,7 +13919,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransaction); i {
 			case 0:
 				return &v.state
@@ -11791,7 +13931,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRawTransactionRequest_Input); i {
 			case 0:
 				return &v.state
@@ -11803,7 +13943,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRawTransactionRequest_Output); i {
 			case 0:
 				return &v.state
@@ -11815,7 +13955,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRawStakeTransactionRequest_Input); i {
 			case 0:
 				return &v.state
@@ -11827,7 +13967,55 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusResponse_PeerSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusResponse_MempoolSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusResponse_ValidatorStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeStatusResponse_ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Validator_Stake); i {
 			case 0:
 				return &v.state
@@ -11839,7 +14027,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransaction_IO); i {
 			case 0:
 				return &v.state
@@ -11851,7 +14039,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransaction_IO_TxIO); i {
 			case 0:
 				return &v.state
@@ -11863,7 +14051,7 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+		file_ilxrpc_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransaction_IO_Unknown); i {
 			case 0:
 				return &v.state
@@ -11888,31 +14076,31 @@ func file_ilxrpc_proto_init() {
 		(*GetCompressedBlockRequest_Block_ID)(nil),
 		(*GetCompressedBlockRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*GetAccumulatorCheckpointRequest_Height)(nil),
 		(*GetAccumulatorCheckpointRequest_Timestamp)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*GetWalletTransactionsRequest_Block_ID)(nil),
 		(*GetWalletTransactionsRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[67].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[73].OneofWrappers = []interface{}{
 		(*CreateMultiSignatureRequest_Tx)(nil),
 		(*CreateMultiSignatureRequest_Sighash)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[138].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[156].OneofWrappers = []interface{}{
 		(*TransactionData_Transaction_ID)(nil),
 		(*TransactionData_Transaction)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[148].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[168].OneofWrappers = []interface{}{
 		(*CreateRawTransactionRequest_Input_Commitment)(nil),
 		(*CreateRawTransactionRequest_Input_Input)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[150].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[170].OneofWrappers = []interface{}{
 		(*CreateRawStakeTransactionRequest_Input_Commitment)(nil),
 		(*CreateRawStakeTransactionRequest_Input_Input)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[152].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[176].OneofWrappers = []interface{}{
 		(*WalletTransaction_IO_TxIo)(nil),
 		(*WalletTransaction_IO_Unknown_)(nil),
 	}
@@ -11921,8 +14109,8 @@ func file_ilxrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ilxrpc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   155,
+			NumEnums:      4,
+			NumMessages:   179,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -57,11 +57,24 @@ type BlockchainServiceClient interface {
 	GetValidatorSetInfo(ctx context.Context, in *GetValidatorSetInfoRequest, opts ...grpc.CallOption) (*GetValidatorSetInfoResponse, error)
 	// GetValidatorSet returns all the validators in the current validator set.
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetRequest, opts ...grpc.CallOption) (*GetValidatorSetResponse, error)
+	// GetValidatorHistory returns every stake, unstake and coinbase event
+	// for the given validator.
+	//
+	// **Requires ValidatorIndex**
+	GetValidatorHistory(ctx context.Context, in *GetValidatorHistoryRequest, opts ...grpc.CallOption) (*GetValidatorHistoryResponse, error)
 	// GetAccumulatorCheckpoint returns the accumulator at the requested height.
 	// If there is no checkpoint at that height, the *prior* checkpoint found in the
 	// chain will be returned. If there is no prior checkpoint (as is prior to the first)
 	// an error will be returned.
 	GetAccumulatorCheckpoint(ctx context.Context, in *GetAccumulatorCheckpointRequest, opts ...grpc.CallOption) (*GetAccumulatorCheckpointResponse, error)
+	// GetFinalityCertificate returns the finality certificate for the block
+	// at the requested height. The certificate aggregates the block producer's
+	// signature and the signed avalanche votes into a single signature. It grows
+	// by 32 bytes per validator.
+	//
+	// Certificates are only available for blocks this node has finalized
+	// itself through avalanche. Blocks downloaded during sync have none.
+	GetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error)
 	// SubmitTransaction validates a transaction and submits it to the network. An error will be returned
 	// if it fails validation.
 	SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error)
@@ -198,6 +211,15 @@ func (c *blockchainServiceClient) GetValidatorSet(ctx context.Context, in *GetVa
 	return out, nil
 }
 
+func (c *blockchainServiceClient) GetValidatorHistory(ctx context.Context, in *GetValidatorHistoryRequest, opts ...grpc.CallOption) (*GetValidatorHistoryResponse, error) {
+	out := new(GetValidatorHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.BlockchainService/GetValidatorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainServiceClient) GetAccumulatorCheckpoint(ctx context.Context, in *GetAccumulatorCheckpointRequest, opts ...grpc.CallOption) (*GetAccumulatorCheckpointResponse, error) {
 	out := new(GetAccumulatorCheckpointResponse)
 	err := c.cc.Invoke(ctx, "/pb.BlockchainService/GetAccumulatorCheckpoint", in, out, opts...)
@@ -207,6 +229,15 @@ func (c *blockchainServiceClient) GetAccumulatorCheckpoint(ctx context.Context,
 	return out, nil
 }
 
+func (c *blockchainServiceClient) GetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error) {
+	out := new(GetFinalityCertificateResponse)
+	err := c.cc.Invoke(ctx, "/pb.BlockchainService/GetFinalityCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainServiceClient) SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error) {
 	out := new(SubmitTransactionResponse)
 	err := c.cc.Invoke(ctx, "/pb.BlockchainService/SubmitTransaction", in, out, opts...)
@@ -319,11 +350,24 @@ type BlockchainServiceServer interface {
 	GetValidatorSetInfo(context.Context, *GetValidatorSetInfoRequest) (*GetValidatorSetInfoResponse, error)
 	// GetValidatorSet returns all the validators in the current validator set.
 	GetValidatorSet(context.Context, *GetValidatorSetRequest) (*GetValidatorSetResponse, error)
+	// GetValidatorHistory returns every stake, unstake and coinbase event
+	// for the given validator.
+	//
+	// **Requires ValidatorIndex**
+	GetValidatorHistory(context.Context, *GetValidatorHistoryRequest) (*GetValidatorHistoryResponse, error)
 	// GetAccumulatorCheckpoint returns the accumulator at the requested height.
 	// If there is no checkpoint at that height, the *prior* checkpoint found in the
 	// chain will be returned. If there is no prior checkpoint (as is prior to the first)
 	// an error will be returned.
 	GetAccumulatorCheckpoint(context.Context, *GetAccumulatorCheckpointRequest) (*GetAccumulatorCheckpointResponse, error)
+	// GetFinalityCertificate returns the finality certificate for the block
+	// at the requested height. The certificate aggregates the block producer's
+	// signature and the signed avalanche votes into a single signature. It grows
+	// by 32 bytes per validator.
+	//
+	// Certificates are only available for blocks this node has finalized
+	// itself through avalanche. Blocks downloaded during sync have none.
+	GetFinalityCertificate(context.Context, *GetFinalityCertificateRequest) (*GetFinalityCertificateResponse, error)
 	// SubmitTransaction validates a transaction and submits it to the network. An error will be returned
 	// if it fails validation.
 	SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error)
@@ -379,9 +423,15 @@ func (UnimplementedBlockchainServiceServer) GetValidatorSetInfo(context.Context,
 func (UnimplementedBlockchainServiceServer) GetValidatorSet(context.Context, *GetValidatorSetRequest) (*GetValidatorSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSet not implemented")
 }
+func (UnimplementedBlockchainServiceServer) GetValidatorHistory(context.Context, *GetValidatorHistoryRequest) (*GetValidatorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorHistory not implemented")
+}
 func (UnimplementedBlockchainServiceServer) GetAccumulatorCheckpoint(context.Context, *GetAccumulatorCheckpointRequest) (*GetAccumulatorCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccumulatorCheckpoint not implemented")
 }
+func (UnimplementedBlockchainServiceServer) GetFinalityCertificate(context.Context, *GetFinalityCertificateRequest) (*GetFinalityCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalityCertificate not implemented")
+}
 func (UnimplementedBlockchainServiceServer) SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTransaction not implemented")
 }
@@ -638,6 +688,24 @@ func _BlockchainService_GetValidatorSet_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainService_GetValidatorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServiceServer).GetValidatorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BlockchainService/GetValidatorHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServiceServer).GetValidatorHistory(ctx, req.(*GetValidatorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainService_GetAccumulatorCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccumulatorCheckpointRequest)
 	if err := dec(in); err != nil {
@@ -656,6 +724,24 @@ func _BlockchainService_GetAccumulatorCheckpoint_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainService_GetFinalityCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinalityCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServiceServer).GetFinalityCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BlockchainService/GetFinalityCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServiceServer).GetFinalityCertificate(ctx, req.(*GetFinalityCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainService_SubmitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitTransactionRequest)
 	if err := dec(in); err != nil {
@@ -775,10 +861,18 @@ var BlockchainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorSet",
 			Handler:    _BlockchainService_GetValidatorSet_Handler,
 		},
+		{
+			MethodName: "GetValidatorHistory",
+			Handler:    _BlockchainService_GetValidatorHistory_Handler,
+		},
 		{
 			MethodName: "GetAccumulatorCheckpoint",
 			Handler:    _BlockchainService_GetAccumulatorCheckpoint_Handler,
 		},
+		{
+			MethodName: "GetFinalityCertificate",
+			Handler:    _BlockchainService_GetFinalityCertificate_Handler,
+		},
 		{
 			MethodName: "SubmitTransaction",
 			Handler:    _BlockchainService_SubmitTransaction_Handler,
@@ -1073,6 +1167,31 @@ type WalletServiceClient interface {
 	GetNewAddress(ctx context.Context, in *GetNewAddressRequest, opts ...grpc.CallOption) (*GetNewAddressResponse, error)
 	// GetTransactions returns the list of transactions for the wallet
 	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	// ExportTransactionHistory returns the wallet's confirmed transactions within
+	// the given block height range serialized as either CSV or JSON. This is
+	// intended for accounting and tax reporting.
+	//
+	// The amount of a sent transaction does not include the fee, which is
+	// reported in its own column, so amount plus fee is the total that left
+	// the wallet.
+	//
+	// **Requires TxIndex**
+	ExportTransactionHistory(ctx context.Context, in *ExportTransactionHistoryRequest, opts ...grpc.CallOption) (*ExportTransactionHistoryResponse, error)
+	// CreateAccount creates a new named account within the wallet. Each account
+	// has its own set of addresses and its own balance.
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	// GetAccounts returns all of the wallet's accounts along with their balances.
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	// SetAccountLabel changes the label of an existing account.
+	SetAccountLabel(ctx context.Context, in *SetAccountLabelRequest, opts ...grpc.CallOption) (*SetAccountLabelResponse, error)
+	// GetAccountNewAddress generates a new address and assigns it to the account.
+	GetAccountNewAddress(ctx context.Context, in *GetAccountNewAddressRequest, opts ...grpc.CallOption) (*GetAccountNewAddressResponse, error)
+	// AccountSpend sends coins from the account according to the provided parameters.
+	// Only the account's own coins are selected as inputs and the change is returned
+	// to a new account address.
+	//
+	// **Requires wallet to be unlocked**
+	AccountSpend(ctx context.Context, in *AccountSpendRequest, opts ...grpc.CallOption) (*AccountSpendResponse, error)
 	// GetUtxos returns a list of the wallet's current unspent transaction outputs (UTXOs)
 	GetUtxos(ctx context.Context, in *GetUtxosRequest, opts ...grpc.CallOption) (*GetUtxosResponse, error)
 	// GetPrivateKey returns the serialized spend and view keys for the given address
@@ -1108,13 +1227,19 @@ type WalletServiceClient interface {
 	// **Requires wallet to be unlocked**
 	DeletePrivateKeys(ctx context.Context, in *DeletePrivateKeysRequest, opts ...grpc.CallOption) (*DeletePrivateKeysResponse, error)
 	// CreateRawTransaction creates a new, unsigned (unproven) transaction using the given parameters
+	//
+	// Unless an account is named, coins belonging to an account may not be used as inputs
+	// and the change is sent to an address outside of any account.
 	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
 	// CreateRawStakeTransaction creates a new, unsigned (unproven) stake transaction using the given parameters
+	//
+	// Unless an account is named, coins belonging to an account may not be staked.
 	CreateRawStakeTransaction(ctx context.Context, in *CreateRawStakeTransactionRequest, opts ...grpc.CallOption) (*CreateRawStakeTransactionResponse, error)
 	// ProveRawTransaction creates the zk-proof for the transaction. Assuming there are no errors, this
 	// transaction should be ready for broadcast.
 	ProveRawTransaction(ctx context.Context, in *ProveRawTransactionRequest, opts ...grpc.CallOption) (*ProveRawTransactionResponse, error)
-	// Stake stakes the selected wallet UTXOs and turns the node into a validator
+	// Stake stakes the selected wallet UTXOs and turns the node into a validator.
+	// UTXOs belonging to an account cannot be staked.
 	//
 	// **Requires wallet to be unlocked**
 	Stake(ctx context.Context, in *StakeRequest, opts ...grpc.CallOption) (*StakeResponse, error)
@@ -1122,7 +1247,10 @@ type WalletServiceClient interface {
 	//
 	// **Requires wallet to be unlocked**
 	SetAutoStakeRewards(ctx context.Context, in *SetAutoStakeRewardsRequest, opts ...grpc.CallOption) (*SetAutoStakeRewardsResponse, error)
-	// Spend sends coins from the wallet according to the provided parameters
+	// Spend sends coins from the wallet according to the provided parameters.
+	// Coins belonging to an account are never selected as inputs and the change
+	// is sent to an address outside of any account. Use AccountSpend to spend an
+	// account's coins.
 	//
 	// **Requires wallet to be unlocked**
 	Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error)
@@ -1130,11 +1258,14 @@ type WalletServiceClient interface {
 	// The internal wallet will be able to spend the coins after the timelock expires and
 	// the transaction will be recoverable if the wallet is restored from seed.
 	//
+	// As with Spend, coins belonging to an account are never selected as inputs.
+	//
 	// This RPC primarily exists to lock coins for staking purposes.
 	//
 	// **Requires wallet to be unlocked**
 	TimelockCoins(ctx context.Context, in *TimelockCoinsRequest, opts ...grpc.CallOption) (*TimelockCoinsResponse, error)
-	// SweepWallet sweeps all the coins from this wallet to the provided address.
+	// SweepWallet sweeps all the coins which do not belong to an account from this
+	// wallet to the provided address.
 	// This RPC is provided so that you don't have to try to guess the correct fee
 	// to take the wallet's balance down to zero. Here the fee will be subtracted
 	// from the total funds.
@@ -1228,6 +1359,60 @@ func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransa
 	return out, nil
 }
 
+func (c *walletServiceClient) ExportTransactionHistory(ctx context.Context, in *ExportTransactionHistoryRequest, opts ...grpc.CallOption) (*ExportTransactionHistoryResponse, error) {
+	out := new(ExportTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/ExportTransactionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetAccountLabel(ctx context.Context, in *SetAccountLabelRequest, opts ...grpc.CallOption) (*SetAccountLabelResponse, error) {
+	out := new(SetAccountLabelResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetAccountLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAccountNewAddress(ctx context.Context, in *GetAccountNewAddressRequest, opts ...grpc.CallOption) (*GetAccountNewAddressResponse, error) {
+	out := new(GetAccountNewAddressResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetAccountNewAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountSpend(ctx context.Context, in *AccountSpendRequest, opts ...grpc.CallOption) (*AccountSpendResponse, error) {
+	out := new(AccountSpendResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/AccountSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) GetUtxos(ctx context.Context, in *GetUtxosRequest, opts ...grpc.CallOption) (*GetUtxosResponse, error) {
 	out := new(GetUtxosResponse)
 	err := c.cc.Invoke(ctx, "/pb.WalletService/GetUtxos", in, out, opts...)
@@ -1511,6 +1696,31 @@ type WalletServiceServer interface {
 	GetNewAddress(context.Context, *GetNewAddressRequest) (*GetNewAddressResponse, error)
 	// GetTransactions returns the list of transactions for the wallet
 	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	// ExportTransactionHistory returns the wallet's confirmed transactions within
+	// the given block height range serialized as either CSV or JSON. This is
+	// intended for accounting and tax reporting.
+	//
+	// The amount of a sent transaction does not include the fee, which is
+	// reported in its own column, so amount plus fee is the total that left
+	// the wallet.
+	//
+	// **Requires TxIndex**
+	ExportTransactionHistory(context.Context, *ExportTransactionHistoryRequest) (*ExportTransactionHistoryResponse, error)
+	// CreateAccount creates a new named account within the wallet. Each account
+	// has its own set of addresses and its own balance.
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// GetAccounts returns all of the wallet's accounts along with their balances.
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	// SetAccountLabel changes the label of an existing account.
+	SetAccountLabel(context.Context, *SetAccountLabelRequest) (*SetAccountLabelResponse, error)
+	// GetAccountNewAddress generates a new address and assigns it to the account.
+	GetAccountNewAddress(context.Context, *GetAccountNewAddressRequest) (*GetAccountNewAddressResponse, error)
+	// AccountSpend sends coins from the account according to the provided parameters.
+	// Only the account's own coins are selected as inputs and the change is returned
+	// to a new account address.
+	//
+	// **Requires wallet to be unlocked**
+	AccountSpend(context.Context, *AccountSpendRequest) (*AccountSpendResponse, error)
 	// GetUtxos returns a list of the wallet's current unspent transaction outputs (UTXOs)
 	GetUtxos(context.Context, *GetUtxosRequest) (*GetUtxosResponse, error)
 	// GetPrivateKey returns the serialized spend and view keys for the given address
@@ -1546,13 +1756,19 @@ type WalletServiceServer interface {
 	// **Requires wallet to be unlocked**
 	DeletePrivateKeys(context.Context, *DeletePrivateKeysRequest) (*DeletePrivateKeysResponse, error)
 	// CreateRawTransaction creates a new, unsigned (unproven) transaction using the given parameters
+	//
+	// Unless an account is named, coins belonging to an account may not be used as inputs
+	// and the change is sent to an address outside of any account.
 	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
 	// CreateRawStakeTransaction creates a new, unsigned (unproven) stake transaction using the given parameters
+	//
+	// Unless an account is named, coins belonging to an account may not be staked.
 	CreateRawStakeTransaction(context.Context, *CreateRawStakeTransactionRequest) (*CreateRawStakeTransactionResponse, error)
 	// ProveRawTransaction creates the zk-proof for the transaction. Assuming there are no errors, this
 	// transaction should be ready for broadcast.
 	ProveRawTransaction(context.Context, *ProveRawTransactionRequest) (*ProveRawTransactionResponse, error)
-	// Stake stakes the selected wallet UTXOs and turns the node into a validator
+	// Stake stakes the selected wallet UTXOs and turns the node into a validator.
+	// UTXOs belonging to an account cannot be staked.
 	//
 	// **Requires wallet to be unlocked**
 	Stake(context.Context, *StakeRequest) (*StakeResponse, error)
@@ -1560,7 +1776,10 @@ type WalletServiceServer interface {
 	//
 	// **Requires wallet to be unlocked**
 	SetAutoStakeRewards(context.Context, *SetAutoStakeRewardsRequest) (*SetAutoStakeRewardsResponse, error)
-	// Spend sends coins from the wallet according to the provided parameters
+	// Spend sends coins from the wallet according to the provided parameters.
+	// Coins belonging to an account are never selected as inputs and the change
+	// is sent to an address outside of any account. Use AccountSpend to spend an
+	// account's coins.
 	//
 	// **Requires wallet to be unlocked**
 	Spend(context.Context, *SpendRequest) (*SpendResponse, error)
@@ -1568,11 +1787,14 @@ type WalletServiceServer interface {
 	// The internal wallet will be able to spend the coins after the timelock expires and
 	// the transaction will be recoverable if the wallet is restored from seed.
 	//
+	// As with Spend, coins belonging to an account are never selected as inputs.
+	//
 	// This RPC primarily exists to lock coins for staking purposes.
 	//
 	// **Requires wallet to be unlocked**
 	TimelockCoins(context.Context, *TimelockCoinsRequest) (*TimelockCoinsResponse, error)
-	// SweepWallet sweeps all the coins from this wallet to the provided address.
+	// SweepWallet sweeps all the coins which do not belong to an account from this
+	// wallet to the provided address.
 	// This RPC is provided so that you don't have to try to guess the correct fee
 	// to take the wallet's balance down to zero. Here the fee will be subtracted
 	// from the total funds.
@@ -1615,6 +1837,24 @@ func (UnimplementedWalletServiceServer) GetNewAddress(context.Context, *GetNewAd
 func (UnimplementedWalletServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
 }
+func (UnimplementedWalletServiceServer) ExportTransactionHistory(context.Context, *ExportTransactionHistoryRequest) (*ExportTransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTransactionHistory not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedWalletServiceServer) SetAccountLabel(context.Context, *SetAccountLabelRequest) (*SetAccountLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountLabel not implemented")
+}
+func (UnimplementedWalletServiceServer) GetAccountNewAddress(context.Context, *GetAccountNewAddressRequest) (*GetAccountNewAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountNewAddress not implemented")
+}
+func (UnimplementedWalletServiceServer) AccountSpend(context.Context, *AccountSpendRequest) (*AccountSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSpend not implemented")
+}
 func (UnimplementedWalletServiceServer) GetUtxos(context.Context, *GetUtxosRequest) (*GetUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUtxos not implemented")
 }
@@ -1841,6 +2081,114 @@ func _WalletService_GetTransactions_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_ExportTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExportTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/ExportTransactionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExportTransactionHistory(ctx, req.(*ExportTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetAccountLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetAccountLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetAccountLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetAccountLabel(ctx, req.(*SetAccountLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetAccountNewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountNewAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAccountNewAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetAccountNewAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAccountNewAddress(ctx, req.(*GetAccountNewAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AccountSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/AccountSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountSpend(ctx, req.(*AccountSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_GetUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUtxosRequest)
 	if err := dec(in); err != nil {
@@ -2300,6 +2648,30 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactions",
 			Handler:    _WalletService_GetTransactions_Handler,
 		},
+		{
+			MethodName: "ExportTransactionHistory",
+			Handler:    _WalletService_ExportTransactionHistory_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _WalletService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _WalletService_GetAccounts_Handler,
+		},
+		{
+			MethodName: "SetAccountLabel",
+			Handler:    _WalletService_SetAccountLabel_Handler,
+		},
+		{
+			MethodName: "GetAccountNewAddress",
+			Handler:    _WalletService_GetAccountNewAddress_Handler,
+		},
+		{
+			MethodName: "AccountSpend",
+			Handler:    _WalletService_AccountSpend_Handler,
+		},
 		{
 			MethodName: "GetUtxos",
 			Handler:    _WalletService_GetUtxos_Handler,
@@ -2416,7 +2788,8 @@ type NodeServiceClient interface {
 	BlockPeer(ctx context.Context, in *BlockPeerRequest, opts ...grpc.CallOption) (*BlockPeerResponse, error)
 	// UnblockPeer removes a peer from the block list
 	UnblockPeer(ctx context.Context, in *UnblockPeerRequest, opts ...grpc.CallOption) (*UnblockPeerResponse, error)
-	// SetLogLevel changes the logging level of the node
+	// SetLogLevel changes the logging level of the node. If a subsystem is
+	// provided only the level of that subsystem is changed.
 	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
 	// GetMinFeePerKilobyte returns the node's current minimum transaction fee needed to relay
 	// transactions and admit them into the mempool. Validators will also set their initial preference
@@ -2446,6 +2819,9 @@ type NodeServiceClient interface {
 	// RecomputeChainState deletes the accumulator, validator set, and nullifier set and rebuilds them by
 	// loading and re-processing all blocks from genesis.
 	RecomputeChainState(ctx context.Context, in *RecomputeChainStateRequest, opts ...grpc.CallOption) (*RecomputeChainStateResponse, error)
+	// GetNodeStatus returns a summary of the node's state in a single call. It
+	// is intended to drive a dashboard or status command.
+	GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -2609,6 +2985,15 @@ func (c *nodeServiceClient) RecomputeChainState(ctx context.Context, in *Recompu
 	return out, nil
 }
 
+func (c *nodeServiceClient) GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error) {
+	out := new(GetNodeStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetNodeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations must embed UnimplementedNodeServiceServer
 // for forward compatibility
@@ -2625,7 +3010,8 @@ type NodeServiceServer interface {
 	BlockPeer(context.Context, *BlockPeerRequest) (*BlockPeerResponse, error)
 	// UnblockPeer removes a peer from the block list
 	UnblockPeer(context.Context, *UnblockPeerRequest) (*UnblockPeerResponse, error)
-	// SetLogLevel changes the logging level of the node
+	// SetLogLevel changes the logging level of the node. If a subsystem is
+	// provided only the level of that subsystem is changed.
 	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
 	// GetMinFeePerKilobyte returns the node's current minimum transaction fee needed to relay
 	// transactions and admit them into the mempool. Validators will also set their initial preference
@@ -2655,6 +3041,9 @@ type NodeServiceServer interface {
 	// RecomputeChainState deletes the accumulator, validator set, and nullifier set and rebuilds them by
 	// loading and re-processing all blocks from genesis.
 	RecomputeChainState(context.Context, *RecomputeChainStateRequest) (*RecomputeChainStateResponse, error)
+	// GetNodeStatus returns a summary of the node's state in a single call. It
+	// is intended to drive a dashboard or status command.
+	GetNodeStatus(context.Context, *GetNodeStatusRequest) (*GetNodeStatusResponse, error)
 	mustEmbedUnimplementedNodeServiceServer()
 }
 
@@ -2713,6 +3102,9 @@ func (UnimplementedNodeServiceServer) ReconsiderBlock(context.Context, *Reconsid
 func (UnimplementedNodeServiceServer) RecomputeChainState(context.Context, *RecomputeChainStateRequest) (*RecomputeChainStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecomputeChainState not implemented")
 }
+func (UnimplementedNodeServiceServer) GetNodeStatus(context.Context, *GetNodeStatusRequest) (*GetNodeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeStatus not implemented")
+}
 func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
 
 // UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -3032,6 +3424,24 @@ func _NodeService_RecomputeChainState_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_GetNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/GetNodeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNodeStatus(ctx, req.(*GetNodeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3107,6 +3517,10 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecomputeChainState",
 			Handler:    _NodeService_RecomputeChainState_Handler,
 		},
+		{
+			MethodName: "GetNodeStatus",
+			Handler:    _NodeService_GetNodeStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ilxrpc.proto",
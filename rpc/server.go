@@ -8,8 +8,10 @@ import (
 	"crypto/rand"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/accounts"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/blockchain/indexers"
+	"github.com/project-illium/ilxd/consensus"
 	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
@@ -24,6 +26,7 @@ import (
 	"google.golang.org/grpc/reflection"
 	"net/http"
 	"sync"
+	"time"
 )
 
 var _ pb.BlockchainServiceServer = (*GrpcServer)(nil)
@@ -38,13 +41,16 @@ type GrpcServerConfig struct {
 	Chain                *blockchain.Blockchain
 	Network              *net.Network
 	Wallet               *walletlib.Wallet
+	AccountManager       *accounts.AccountManager
 	Policy               *policy.Policy
 	BroadcastTxFunc      func(tx *transactions.Transaction) error
-	SetLogLevelFunc      func(level zapcore.Level)
+	SetLogLevelFunc      func(subsystem string, level zapcore.Level) error
 	ReindexChainFunc     func() error
 	RequestBlockFunc     func(blockID types.ID, remotePeer peer.ID)
 	AutoStakeFunc        func(bool) error
+	CertificateFunc      func(height uint32) (*consensus.FinalityCertificate, error)
 	NetworkKeyFunc       func() (crypto.PrivKey, error)
+	IsCurrentFunc        func() bool
 	ChainParams          *params.NetworkParams
 	Ds                   repo.Datastore
 	TxMemPool            *mempool.Mempool
@@ -52,8 +58,9 @@ type GrpcServerConfig struct {
 	DisableWalletService bool
 	DisableWalletServer  bool
 
-	TxIndex *indexers.TxIndex
-	WSIndex *indexers.WalletServerIndex
+	TxIndex        *indexers.TxIndex
+	WSIndex        *indexers.WalletServerIndex
+	ValidatorIndex *indexers.ValidatorIndex
 }
 
 // GrpcServer is the gRPC server implementation. It holds all the objects
@@ -66,15 +73,20 @@ type GrpcServer struct {
 	network          *net.Network
 	policy           *policy.Policy
 	wallet           *walletlib.Wallet
+	accountManager   *accounts.AccountManager
 	broadcastTxFunc  func(tx *transactions.Transaction) error
-	setLogLevelFunc  func(level zapcore.Level)
+	setLogLevelFunc  func(subsystem string, level zapcore.Level) error
 	reindexChainFunc func() error
 	requestBlockFunc func(blockID types.ID, remotePeer peer.ID)
 	autoStakeFunc    func(bool) error
+	certificateFunc  func(height uint32) (*consensus.FinalityCertificate, error)
 	networkKeyFunc   func() (crypto.PrivKey, error)
+	isCurrentFunc    func() bool
+	startTime        time.Time
 
-	txIndex *indexers.TxIndex
-	wsIndex *indexers.WalletServerIndex
+	txIndex        *indexers.TxIndex
+	wsIndex        *indexers.WalletServerIndex
+	validatorIndex *indexers.ValidatorIndex
 
 	httpServer *http.Server
 	subs       map[types.ID]*subscription
@@ -98,13 +110,18 @@ func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 		txMemPool:        cfg.TxMemPool,
 		network:          cfg.Network,
 		wallet:           cfg.Wallet,
+		accountManager:   cfg.AccountManager,
 		broadcastTxFunc:  cfg.BroadcastTxFunc,
 		setLogLevelFunc:  cfg.SetLogLevelFunc,
 		reindexChainFunc: cfg.ReindexChainFunc,
 		requestBlockFunc: cfg.RequestBlockFunc,
 		autoStakeFunc:    cfg.AutoStakeFunc,
+		certificateFunc:  cfg.CertificateFunc,
 		networkKeyFunc:   cfg.NetworkKeyFunc,
+		isCurrentFunc:    cfg.IsCurrentFunc,
+		startTime:        time.Now(),
 		txIndex:          cfg.TxIndex,
+		validatorIndex:   cfg.ValidatorIndex,
 		policy:           cfg.Policy,
 		httpServer:       cfg.HTTPServer,
 		subs:             make(map[types.ID]*subscription),
@@ -5,14 +5,19 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
-	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/accounts"
 	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/address"
+	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
 	"github.com/project-illium/ilxd/zk/circuits/stake"
@@ -21,6 +26,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	pb "github.com/project-illium/ilxd/rpc/pb"
@@ -102,7 +110,7 @@ func (s *GrpcServer) GetAddresses(ctx context.Context, req *pb.GetAddressesReque
 
 // GetAddressInfo returns additional metadata about an address.
 func (s *GrpcServer) GetAddressInfo(ctx context.Context, req *pb.GetAddressInfoRequest) (*pb.GetAddressInfoResponse, error) {
-	addr, err := walletlib.DecodeAddress(req.Address, s.chainParams)
+	addr, err := s.decodeAddress(req.Address)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -130,7 +138,7 @@ func (s *GrpcServer) GetAddressInfo(ctx context.Context, req *pb.GetAddressInfoR
 // GetNewAddress generates a new address and returns it. Both a new spend key
 // and view key will be derived from the mnemonic seed.
 func (s *GrpcServer) GetNewAddress(ctx context.Context, req *pb.GetNewAddressRequest) (*pb.GetNewAddressResponse, error) {
-	addr, err := s.wallet.NewAddress()
+	addr, err := s.accountManager.NewUnassignedAddress()
 	if errors.Is(err, walletlib.ErrEncryptedKeychain) {
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
@@ -165,6 +173,195 @@ func (s *GrpcServer) GetTransactions(ctx context.Context, req *pb.GetTransaction
 	return resp, nil
 }
 
+// ExportTransactionHistory returns the wallet's confirmed transactions within
+// the given block height range serialized as either CSV or JSON.
+//
+// **Requires TxIndex**
+func (s *GrpcServer) ExportTransactionHistory(ctx context.Context, req *pb.ExportTransactionHistoryRequest) (*pb.ExportTransactionHistoryResponse, error) {
+	if s.txIndex == nil {
+		return nil, status.Error(codes.Unavailable, "tx index is not available")
+	}
+	_, bestHeight, _ := s.chain.BestBlock()
+	endHeight := req.EndHeight
+	if endHeight == 0 || endHeight > bestHeight {
+		endHeight = bestHeight
+	}
+	if req.StartHeight > endHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height is greater than end height")
+	}
+
+	txs, err := s.wallet.Transactions()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	addrs, err := s.wallet.Addresses()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	walletAddrs := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		walletAddrs[addr.String()] = true
+	}
+
+	txids := make([]types.ID, 0, len(txs))
+	for _, wtx := range txs {
+		txids = append(txids, wtx.Txid)
+	}
+	indexed, err := s.txIndex.GetTransactions(s.ds, txids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Many wallet transactions may share a block so each
+	// header is only loaded once.
+	headers := make(map[types.ID]*blocks.BlockHeader)
+	entries := make([]*txHistoryEntry, 0, len(indexed))
+	for _, wtx := range txs {
+		itx, ok := indexed[wtx.Txid]
+		if !ok {
+			// Not yet confirmed.
+			continue
+		}
+		header, ok := headers[itx.BlockID]
+		if !ok {
+			height, err := s.chain.GetBlockHeight(itx.BlockID)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			header, err = s.chain.GetHeaderByHeight(height)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			headers[itx.BlockID] = header
+		}
+		if header.Height < req.StartHeight || header.Height > endHeight {
+			continue
+		}
+		entries = append(entries, newTxHistoryEntry(wtx, itx.Tx, header, bestHeight, walletAddrs))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Height == entries[j].Height {
+			return entries[i].Txid.Compare(entries[j].Txid) < 0
+		}
+		return entries[i].Height < entries[j].Height
+	})
+
+	var data []byte
+	switch req.Format {
+	case pb.ExportTransactionHistoryRequest_CSV:
+		data, err = encodeHistoryCSV(entries)
+	case pb.ExportTransactionHistoryRequest_JSON:
+		data, err = json.MarshalIndent(entries, "", "    ")
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown format")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ExportTransactionHistoryResponse{
+		Data: data,
+	}, nil
+}
+
+// CreateAccount creates a new named account within the wallet. Each account
+// has its own set of addresses and its own balance.
+func (s *GrpcServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "account name is required")
+	}
+	err := s.accountManager.CreateAccount(req.Name, req.Label)
+	if errors.Is(err, accounts.ErrAccountExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.CreateAccountResponse{}, nil
+}
+
+// GetAccounts returns all of the wallet's accounts along with their balances.
+func (s *GrpcServer) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.GetAccountsResponse, error) {
+	accts := s.accountManager.Accounts()
+	resp := &pb.GetAccountsResponse{
+		Accounts: make([]*pb.Account, 0, len(accts)),
+	}
+	for _, acct := range accts {
+		balance, err := s.accountManager.Balance(acct.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Accounts = append(resp.Accounts, &pb.Account{
+			Name:      acct.Name,
+			Label:     acct.Label,
+			Addresses: acct.Addresses,
+			Balance:   uint64(balance),
+		})
+	}
+	return resp, nil
+}
+
+// SetAccountLabel changes the label of an existing account.
+func (s *GrpcServer) SetAccountLabel(ctx context.Context, req *pb.SetAccountLabelRequest) (*pb.SetAccountLabelResponse, error) {
+	err := s.accountManager.SetLabel(req.Name, req.Label)
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SetAccountLabelResponse{}, nil
+}
+
+// GetAccountNewAddress generates a new address and assigns it to the account.
+func (s *GrpcServer) GetAccountNewAddress(ctx context.Context, req *pb.GetAccountNewAddressRequest) (*pb.GetAccountNewAddressResponse, error) {
+	addr, err := s.accountManager.NewAddress(req.Name)
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, walletlib.ErrEncryptedKeychain) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, walletlib.ErrPublicOnlyKeychain) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetAccountNewAddressResponse{
+		Address: addr.String(),
+	}, nil
+}
+
+// AccountSpend sends coins from the account according to the provided parameters.
+// Only the account's own coins are selected as inputs and the change is returned
+// to a new account address.
+//
+// **Requires wallet to be unlocked**
+func (s *GrpcServer) AccountSpend(ctx context.Context, req *pb.AccountSpendRequest) (*pb.AccountSpendResponse, error) {
+	if req.Amount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be greater than zero")
+	}
+	addr, err := s.decodeAddress(req.ToAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	txid, err := s.accountManager.Spend(req.Name, addr, types.Amount(req.Amount), types.Amount(req.FeePerKilobyte))
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, accounts.ErrInsufficientFunds) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, walletlib.ErrEncryptedKeychain) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, walletlib.ErrPublicOnlyKeychain) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.AccountSpendResponse{Transaction_ID: txid[:]}, nil
+}
+
 // GetUtxos returns a list of the wallet's current unspent transaction outputs (UTXOs)
 func (s *GrpcServer) GetUtxos(ctx context.Context, req *pb.GetUtxosRequest) (*pb.GetUtxosResponse, error) {
 	notes, err := s.wallet.Notes()
@@ -218,7 +415,7 @@ func (s *GrpcServer) GetPrivateKey(ctx context.Context, req *pb.GetPrivateKeyReq
 
 // ImportAddress imports a watch address into the wallet.
 func (s *GrpcServer) ImportAddress(ctx context.Context, req *pb.ImportAddressRequest) (*pb.ImportAddressResponse, error) {
-	addr, err := walletlib.DecodeAddress(req.Address, s.chainParams)
+	addr, err := s.decodeAddress(req.Address)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -282,30 +479,27 @@ func (s *GrpcServer) CreateMultisigViewKeypair(ctx context.Context, req *pb.Crea
 // Note this address is *not* imported. You will need to call `ImportAddress` if you want to watch
 // it.
 func (s *GrpcServer) CreateMultisigAddress(ctx context.Context, req *pb.CreateMultisigAddressRequest) (*pb.CreateMultisigAddressResponse, error) {
-	threshold := make([]byte, 4)
-	binary.BigEndian.PutUint32(threshold, req.Threshold)
-
 	scriptCommitment, err := zk.LurkCommit(zk.MultisigScript())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	lockingScript := types.LockingScript{
-		ScriptCommitment: types.NewID(scriptCommitment),
-		LockingParams:    [][]byte{threshold},
-	}
+	keys := make([]crypto.PubKey, 0, len(req.Pubkeys))
 	for _, key := range req.Pubkeys {
 		pubkey, err := crypto.UnmarshalPublicKey(key)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		novaKey, ok := pubkey.(*icrypto.NovaPublicKey)
-		if !ok {
-			return nil, status.Error(codes.InvalidArgument, "Public key is not type Nova")
-		}
+		keys = append(keys, pubkey)
+	}
+	lockingParams, err := zk.MakeMultisigLockingParams(int(req.Threshold), keys)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		x, y := novaKey.ToXY()
-		lockingScript.LockingParams = append(lockingScript.LockingParams, x, y)
+	lockingScript := types.LockingScript{
+		ScriptCommitment: types.NewID(scriptCommitment),
+		LockingParams:    lockingParams,
 	}
 
 	viewKey, err := crypto.UnmarshalPublicKey(req.ViewPubkey)
@@ -324,10 +518,12 @@ func (s *GrpcServer) CreateMultisigAddress(ctx context.Context, req *pb.CreateMu
 
 // CreateMultiSignature generates and returns a signature for use when proving a multisig transaction
 func (s *GrpcServer) CreateMultiSignature(ctx context.Context, req *pb.CreateMultiSignatureRequest) (*pb.CreateMultiSignatureResponse, error) {
+	defer icrypto.Zeroize(req.PrivateKey)
 	privKey, err := crypto.UnmarshalPrivateKey(req.PrivateKey)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	defer icrypto.ZeroizeKey(privKey)
 	var sigHash []byte
 	if req.GetTx() != nil {
 		tx := req.GetTx()
@@ -378,7 +574,6 @@ func (s *GrpcServer) ProveMultisig(ctx context.Context, req *pb.ProveMultisigReq
 		Outputs: []standard.PrivateOutput{},
 	}
 
-	nullifiers := make([][]byte, 0, len(req.RawTx.Inputs))
 	for _, in := range req.RawTx.Inputs {
 		var keys []crypto.PubKey
 		for i := 1; i < len(in.LockingParams); i += 2 {
@@ -391,7 +586,10 @@ func (s *GrpcServer) ProveMultisig(ctx context.Context, req *pb.ProveMultisigReq
 		}
 
 		unlockingParams, err := zk.MakeMultisigUnlockingParams(keys, req.Sigs, sighash)
-		if err != nil {
+		var sigErr *zk.MultisigSignatureError
+		if errors.As(err, &sigErr) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
@@ -418,12 +616,6 @@ func (s *GrpcServer) ProveMultisig(ctx context.Context, req *pb.ProveMultisigReq
 		privIn.State = *state
 
 		privateParams.Inputs = append(privateParams.Inputs, privIn)
-
-		nullifier, err := types.CalculateNullifier(in.TxoProof.Index, privIn.Salt, privIn.ScriptCommitment, privIn.ScriptParams...)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-		nullifiers = append(nullifiers, nullifier.Bytes())
 	}
 	for _, out := range req.RawTx.Outputs {
 		privOut := standard.PrivateOutput{
@@ -443,22 +635,15 @@ func (s *GrpcServer) ProveMultisig(ctx context.Context, req *pb.ProveMultisigReq
 		privateParams.Outputs = append(privateParams.Outputs, privOut)
 	}
 
-	publicParams := &standard.PublicParams{
-		TXORoot:    standardTx.TxoRoot,
-		SigHash:    sighash,
-		Nullifiers: nullifiers,
-		Fee:        standardTx.Fee,
-	}
-
-	for _, out := range standardTx.Outputs {
-		publicParams.Outputs = append(publicParams.Outputs, standard.PublicOutput{
-			Commitment: out.Commitment,
-			CipherText: out.Ciphertext,
-		})
+	publicParams, err := transactions.StandardPublicParams(standardTx, s.locktimeSeconds())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	proof, err := zk.CreateSnark(standard.StandardCircuit, privateParams, publicParams)
-	if err != nil {
+	proof, err := zk.CreateSnarkWithContext(ctx, standard.StandardCircuit, privateParams, publicParams)
+	if errors.Is(err, zk.ErrProverQueueFull) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -505,7 +690,9 @@ func (s *GrpcServer) DeletePrivateKeys(ctx context.Context, req *pb.DeletePrivat
 	return nil, err
 }
 
-// CreateRawTransaction creates a new, unsigned (unproven) transaction using the given parameters
+// CreateRawTransaction creates a new, unsigned (unproven) transaction using the given parameters.
+// Unless an account is named, coins belonging to an account may not be used as inputs and the
+// change is sent to an address outside of any account.
 func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	inputs := make([]*walletlib.RawInput, 0, len(req.Inputs))
 	for _, in := range req.Inputs {
@@ -536,7 +723,7 @@ func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRaw
 	}
 	outputs := make([]*walletlib.RawOutput, 0, len(req.Outputs))
 	for _, out := range req.Outputs {
-		addr, err := walletlib.DecodeAddress(out.Address, s.chainParams)
+		addr, err := s.decodeAddress(out.Address)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
@@ -551,7 +738,13 @@ func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRaw
 		rawOut.State = *state
 		outputs = append(outputs, rawOut)
 	}
-	rawTx, err := s.wallet.CreateRawTransaction(inputs, outputs, req.AppendChangeOutput, types.Amount(req.FeePerKilobyte))
+	rawTx, err := s.accountManager.CreateRawTransaction(req.Account, inputs, outputs, req.AppendChangeOutput, types.Amount(req.FeePerKilobyte))
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, accounts.ErrAccountNote) || errors.Is(err, accounts.ErrNotAccountNote) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -578,10 +771,11 @@ func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRaw
 			State:      in.State,
 			Salt:       in.Salt,
 		}
-		commitment, err := note.Commitment()
+		desc, err := types.NewOutputDescriptorFromNote(&note, &lockingScript)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		commitment := desc.Commitment
 
 		rawIn := &pb.PrivateInput{
 			Amount:           uint64(in.Amount),
@@ -625,7 +819,8 @@ func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRaw
 	return resp, nil
 }
 
-// CreateRawStakeTransaction creates a new, unsigned (unproven) stake transaction using the given parameters
+// CreateRawStakeTransaction creates a new, unsigned (unproven) stake transaction using the given parameters.
+// Unless an account is named, coins belonging to an account may not be staked.
 func (s *GrpcServer) CreateRawStakeTransaction(ctx context.Context, req *pb.CreateRawStakeTransactionRequest) (*pb.CreateRawStakeTransactionResponse, error) {
 	rawInput := &walletlib.RawInput{}
 	if req.Input.GetCommitment() != nil {
@@ -651,7 +846,13 @@ func (s *GrpcServer) CreateRawStakeTransaction(ctx context.Context, req *pb.Crea
 		return nil, status.Error(codes.InvalidArgument, "input must have commitment or private input")
 	}
 
-	rawTx, err := s.wallet.CreateRawStakeTransaction(rawInput)
+	rawTx, err := s.accountManager.CreateRawStakeTransaction(req.Account, rawInput)
+	if errors.Is(err, accounts.ErrAccountNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, accounts.ErrAccountNote) || errors.Is(err, accounts.ErrNotAccountNote) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -676,10 +877,11 @@ func (s *GrpcServer) CreateRawStakeTransaction(ctx context.Context, req *pb.Crea
 		State:      rawTx.PrivateInputs[0].State,
 		Salt:       rawTx.PrivateInputs[0].Salt,
 	}
-	commitment, err := note.Commitment()
+	desc, err := types.NewOutputDescriptorFromNote(&note, &lockingScript)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	commitment := desc.Commitment
 	in := &pb.PrivateInput{
 		Amount:           uint64(rawTx.PrivateInputs[0].Amount),
 		Salt:             rawTx.PrivateInputs[0].Salt[:],
@@ -730,6 +932,9 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		// The wallet keys are map keys and cannot be wiped in place.
+		// Drop them as soon as the request is done.
+		defer clear(privkeyMap)
 
 		for i, in := range req.RawTx.Inputs {
 			if in.UnlockingParams == "" {
@@ -754,6 +959,7 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 				}
 
 				sig, err := privKey.Sign(sigHash)
+				icrypto.ZeroizeKey(privKey)
 				if err != nil {
 					return nil, status.Error(codes.Internal, err.Error())
 				}
@@ -801,22 +1007,15 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 			privateParams.Outputs = append(privateParams.Outputs, privOut)
 		}
 
-		publicParams := &standard.PublicParams{
-			TXORoot:    standardTx.TxoRoot,
-			SigHash:    sigHash,
-			Nullifiers: standardTx.Nullifiers,
-			Fee:        standardTx.Fee,
-		}
-
-		for _, out := range standardTx.Outputs {
-			publicParams.Outputs = append(publicParams.Outputs, standard.PublicOutput{
-				Commitment: out.Commitment,
-				CipherText: out.Ciphertext,
-			})
+		publicParams, err := transactions.StandardPublicParams(standardTx, s.locktimeSeconds())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		proof, err := zk.CreateSnark(standard.StandardCircuit, privateParams, publicParams)
-		if err != nil {
+		proof, err := zk.CreateSnarkWithContext(ctx, standard.StandardCircuit, privateParams, publicParams)
+		if errors.Is(err, zk.ErrProverQueueFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -841,6 +1040,7 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
+			defer clear(privkeyMap)
 
 			lockingScript := types.LockingScript{
 				ScriptCommitment: types.NewID(req.RawTx.Inputs[0].ScriptCommitment),
@@ -863,6 +1063,7 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 			}
 
 			sig, err := privKey.Sign(sigHash)
+			icrypto.ZeroizeKey(privKey)
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
@@ -889,15 +1090,15 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 		}
 		privateParams.State = *state
 
-		publicParams := &stake.PublicParams{
-			TXORoot:   stakeTx.TxoRoot,
-			SigHash:   sigHash,
-			Amount:    stakeTx.Amount,
-			Nullifier: stakeTx.Nullifier,
+		publicParams, err := transactions.StakePublicParams(stakeTx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		proof, err := zk.CreateSnark(stake.StakeCircuit, privateParams, publicParams)
-		if err != nil {
+		proof, err := zk.CreateSnarkWithContext(ctx, stake.StakeCircuit, privateParams, publicParams)
+		if errors.Is(err, zk.ErrProverQueueFull) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -910,7 +1111,8 @@ func (s *GrpcServer) ProveRawTransaction(ctx context.Context, req *pb.ProveRawTr
 	return nil, status.Error(codes.InvalidArgument, "tx must be either standard or stake type")
 }
 
-// Stake stakes the selected wallet UTXOs and turns the node into a validator
+// Stake stakes the selected wallet UTXOs and turns the node into a validator.
+// UTXOs belonging to an account cannot be staked.
 //
 // **Requires wallet to be unlocked**
 func (s *GrpcServer) Stake(ctx context.Context, req *pb.StakeRequest) (*pb.StakeResponse, error) {
@@ -918,7 +1120,7 @@ func (s *GrpcServer) Stake(ctx context.Context, req *pb.StakeRequest) (*pb.Stake
 	for _, c := range req.Commitments {
 		commitments = append(commitments, types.NewID(c))
 	}
-	err := s.wallet.Stake(commitments)
+	err := s.accountManager.StakeUnassigned(commitments)
 	return &pb.StakeResponse{}, err
 }
 
@@ -930,7 +1132,10 @@ func (s *GrpcServer) SetAutoStakeRewards(ctx context.Context, req *pb.SetAutoSta
 	return &pb.SetAutoStakeRewardsResponse{}, err
 }
 
-// Spend sends coins from the wallet according to the provided parameters
+// Spend sends coins from the wallet according to the provided parameters.
+// Coins belonging to an account are never selected as inputs and the change
+// is sent to an address outside of any account. Use AccountSpend to spend an
+// account's coins.
 //
 // **Requires wallet to be unlocked**
 func (s *GrpcServer) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.SpendResponse, error) {
@@ -938,11 +1143,11 @@ func (s *GrpcServer) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.Spend
 	for _, c := range req.InputCommitments {
 		commitments = append(commitments, types.NewID(c))
 	}
-	addr, err := walletlib.DecodeAddress(req.ToAddress, s.chainParams)
+	addr, err := s.decodeAddress(req.ToAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	txid, err := s.wallet.Spend(addr, types.Amount(req.Amount), types.Amount(req.FeePerKilobyte), commitments...)
+	txid, err := s.accountManager.SpendUnassigned(addr, types.Amount(req.Amount), types.Amount(req.FeePerKilobyte), commitments...)
 	if err != nil {
 		return nil, err
 	}
@@ -953,6 +1158,8 @@ func (s *GrpcServer) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.Spend
 // The internal wallet will be able to spend the coins after the timelock expires and
 // the transaction will be recoverable if the wallet is restored from seed.
 //
+// As with Spend, coins belonging to an account are never selected as inputs.
+//
 // This RPC primarily exists to lock coins for staking purposes.
 //
 // **Requires wallet to be unlocked**
@@ -962,25 +1169,26 @@ func (s *GrpcServer) TimelockCoins(ctx context.Context, req *pb.TimelockCoinsReq
 		commitments = append(commitments, types.NewID(c))
 	}
 
-	txid, err := s.wallet.TimelockCoins(types.Amount(req.Amount), time.Unix(req.LockUntil, 0), types.Amount(req.FeePerKilobyte), commitments...)
+	txid, err := s.accountManager.TimelockUnassigned(types.Amount(req.Amount), time.Unix(req.LockUntil, 0), types.Amount(req.FeePerKilobyte), commitments...)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.TimelockCoinsResponse{Transaction_ID: txid[:]}, nil
 }
 
-// SweepWallet sweeps all the coins from this wallet to the provided address.
+// SweepWallet sweeps all the coins which do not belong to an account from this
+// wallet to the provided address.
 // This RPC is provided so that you don't have to try to guess the correct fee
 // to take the wallet's balance down to zero. Here the fee will be subtracted
 // from the total funds.
 //
 // **Requires wallet to be unlocked**
 func (s *GrpcServer) SweepWallet(ctx context.Context, req *pb.SweepWalletRequest) (*pb.SweepWalletResponse, error) {
-	addr, err := walletlib.DecodeAddress(req.ToAddress, s.chainParams)
+	addr, err := s.decodeAddress(req.ToAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	txid, err := s.wallet.SweepWallet(addr, types.Amount(req.FeePerKilobyte))
+	txid, err := s.accountManager.SweepUnassigned(addr, types.Amount(req.FeePerKilobyte))
 	if err != nil {
 		return nil, err
 	}
@@ -1063,3 +1271,144 @@ func ioToPBio(ios []walletlib.IO) []*pb.WalletTransaction_IO {
 	}
 	return ret
 }
+
+const (
+	txDirectionReceived = "received"
+	txDirectionSent     = "sent"
+)
+
+// txHistoryEntry is a single row in the exported transaction history.
+type txHistoryEntry struct {
+	Timestamp     time.Time    `json:"timestamp"`
+	Txid          types.ID     `json:"txid"`
+	Height        uint32       `json:"height"`
+	Confirmations uint32       `json:"confirmations"`
+	Direction     string       `json:"direction"`
+	Amount        types.Amount `json:"amount"`
+	Asset         types.ID     `json:"asset"`
+	Fee           types.Amount `json:"fee"`
+	Memo          string       `json:"memo"`
+}
+
+// newTxHistoryEntry builds the history entry for a confirmed wallet
+// transaction. walletAddrs is the set of the wallet's own addresses.
+// The amount of a sent transaction is net of the fee.
+func newTxHistoryEntry(wtx *walletlib.WalletTransaction, tx *transactions.Transaction, header *blocks.BlockHeader, bestHeight uint32, walletAddrs map[string]bool) *txHistoryEntry {
+	entry := &txHistoryEntry{
+		Timestamp:     time.Unix(header.Timestamp, 0).UTC(),
+		Txid:          wtx.Txid,
+		Height:        header.Height,
+		Confirmations: bestHeight - header.Height + 1,
+		Asset:         types.IlliumCoinID,
+	}
+	netCoins := int64(wtx.AmountIn) - int64(wtx.AmountOut)
+	if netCoins >= 0 {
+		entry.Direction = txDirectionReceived
+		entry.Amount = types.Amount(netCoins)
+	} else {
+		entry.Direction = txDirectionSent
+		entry.Amount = types.Amount(-netCoins)
+	}
+	// Fees are only paid by the sender. The wallet's net change includes
+	// the fee so it is taken out of the amount, which is then only what
+	// was paid to others.
+	if entry.Direction == txDirectionSent {
+		switch t := tx.GetTx().(type) {
+		case *transactions.Transaction_StandardTransaction:
+			entry.Fee = types.Amount(t.StandardTransaction.Fee)
+		case *transactions.Transaction_MintTransaction:
+			entry.Fee = types.Amount(t.MintTransaction.Fee)
+		}
+		if entry.Fee > entry.Amount {
+			entry.Fee = entry.Amount
+		}
+		entry.Amount -= entry.Fee
+	}
+	entry.Memo = txHistoryMemo(entry.Direction, wtx, tx, walletAddrs)
+	return entry
+}
+
+// txHistoryMemo describes the transaction for the memo column. The
+// protocol does not attach memos to transactions so the description is
+// built from the transaction type and the addresses known to the wallet.
+func txHistoryMemo(direction string, wtx *walletlib.WalletTransaction, tx *transactions.Transaction, walletAddrs map[string]bool) string {
+	switch tx.GetTx().(type) {
+	case *transactions.Transaction_CoinbaseTransaction:
+		return "coinbase"
+	case *transactions.Transaction_StakeTransaction:
+		return "stake"
+	case *transactions.Transaction_TreasuryTransaction:
+		return "treasury"
+	case *transactions.Transaction_MintTransaction:
+		return "mint"
+	}
+
+	var ours, theirs []string
+	for _, out := range wtx.Outputs {
+		txio, ok := out.(*walletlib.TxIO)
+		if !ok || txio.Address == nil {
+			continue
+		}
+		if walletAddrs[txio.Address.String()] {
+			ours = append(ours, txio.Address.String())
+		} else {
+			theirs = append(theirs, txio.Address.String())
+		}
+	}
+	if direction == txDirectionReceived {
+		if len(ours) == 0 {
+			return "received"
+		}
+		return "received at " + strings.Join(ours, " ")
+	}
+	if len(theirs) == 0 {
+		if len(ours) > 0 {
+			return "sent to self"
+		}
+		return "sent"
+	}
+	return "sent to " + strings.Join(theirs, " ")
+}
+
+func encodeHistoryCSV(entries []*txHistoryEntry) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"timestamp", "txid", "height", "confirmations", "direction", "amount", "asset", "fee", "memo"}); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		record := []string{
+			e.Timestamp.Format(time.RFC3339),
+			e.Txid.String(),
+			strconv.FormatUint(uint64(e.Height), 10),
+			strconv.FormatUint(uint64(e.Confirmations), 10),
+			e.Direction,
+			e.Amount.String(),
+			e.Asset.String(),
+			e.Fee.String(),
+			e.Memo,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// decodeAddress checks that the address is a valid bech32m address
+// for this network and decodes it.
+func (s *GrpcServer) decodeAddress(addr string) (walletlib.Address, error) {
+	if err := address.Validate(addr, s.chainParams); err != nil {
+		return nil, err
+	}
+	return walletlib.DecodeAddress(addr, s.chainParams)
+}
+
+// locktimeSeconds returns the option deriving the locktime precision of
+// the public params for a transaction proved now, which is validated at
+// the height of the next block.
+func (s *GrpcServer) locktimeSeconds() transactions.ParamsOption {
+	_, height, _ := s.chain.BestBlock()
+	return transactions.LocktimeSeconds(s.chainParams.LocktimeSecondsActive(height + 1))
+}
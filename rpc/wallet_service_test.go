@@ -0,0 +1,119 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/walletlib"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTxHistory(t *testing.T) {
+	newAddr := func() walletlib.Address {
+		_, viewKey, err := icrypto.GenerateCurve25519Key(rand.Reader)
+		assert.NoError(t, err)
+		addr, err := walletlib.NewBasicAddress(types.LockingScript{}, viewKey, &params.RegestParams)
+		assert.NoError(t, err)
+		return addr
+	}
+	ours, change, theirs := newAddr(), newAddr(), newAddr()
+	walletAddrs := map[string]bool{
+		ours.String():   true,
+		change.String(): true,
+	}
+	header := &blocks.BlockHeader{
+		Height:    5,
+		Timestamp: 1700000000,
+	}
+	standardTx := transactions.WrapTransaction(&transactions.StandardTransaction{Fee: 10})
+
+	received := newTxHistoryEntry(&walletlib.WalletTransaction{
+		Txid:     types.ID{0x01},
+		AmountIn: 100,
+		Outputs:  []walletlib.IO{&walletlib.TxIO{Address: ours, Amount: 100}, &walletlib.Unknown{}},
+	}, standardTx, header, 7, walletAddrs)
+	assert.Equal(t, &txHistoryEntry{
+		Timestamp:     time.Unix(1700000000, 0).UTC(),
+		Txid:          types.ID{0x01},
+		Height:        5,
+		Confirmations: 3,
+		Direction:     txDirectionReceived,
+		Amount:        100,
+		Asset:         types.IlliumCoinID,
+		Fee:           0,
+		Memo:          "received at " + ours.String(),
+	}, received)
+
+	sent := newTxHistoryEntry(&walletlib.WalletTransaction{
+		Txid:      types.ID{0x02},
+		AmountIn:  40,
+		AmountOut: 150,
+		Outputs: []walletlib.IO{
+			&walletlib.TxIO{Address: theirs, Amount: 100},
+			&walletlib.TxIO{Address: change, Amount: 40},
+		},
+	}, standardTx, header, 5, walletAddrs)
+	// The wallet's balance fell by 110 of which 10 was the fee.
+	assert.Equal(t, txDirectionSent, sent.Direction)
+	assert.Equal(t, types.Amount(100), sent.Amount)
+	assert.Equal(t, types.Amount(10), sent.Fee)
+	assert.Equal(t, uint32(1), sent.Confirmations)
+	assert.Equal(t, "sent to "+theirs.String(), sent.Memo)
+
+	self := newTxHistoryEntry(&walletlib.WalletTransaction{
+		Txid:      types.ID{0x03},
+		AmountIn:  90,
+		AmountOut: 100,
+		Outputs:   []walletlib.IO{&walletlib.TxIO{Address: change, Amount: 90}},
+	}, standardTx, header, 5, walletAddrs)
+	assert.Equal(t, "sent to self", self.Memo)
+	assert.Equal(t, types.Amount(0), self.Amount)
+	assert.Equal(t, types.Amount(10), self.Fee)
+
+	coinbase := newTxHistoryEntry(&walletlib.WalletTransaction{
+		Txid:     types.ID{0x04},
+		AmountIn: 50,
+		Outputs:  []walletlib.IO{&walletlib.TxIO{Address: ours, Amount: 50}},
+	}, transactions.WrapTransaction(&transactions.CoinbaseTransaction{NewCoins: 50}), header, 5, walletAddrs)
+	assert.Equal(t, "coinbase", coinbase.Memo)
+
+	entries := []*txHistoryEntry{received, sent, self, coinbase}
+	data, err := encodeHistoryCSV(entries)
+	assert.NoError(t, err)
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 5)
+	assert.Equal(t, []string{"timestamp", "txid", "height", "confirmations", "direction", "amount", "asset", "fee", "memo"}, records[0])
+	assert.Equal(t, []string{
+		"2023-11-14T22:13:20Z",
+		sent.Txid.String(),
+		"5",
+		"1",
+		"sent",
+		"100",
+		types.IlliumCoinID.String(),
+		"10",
+		"sent to " + theirs.String(),
+	}, records[2])
+	assert.Equal(t, []string{"sent", "0", "10"}, []string{records[3][4], records[3][5], records[3][7]})
+
+	data, err = json.Marshal(entries)
+	assert.NoError(t, err)
+	var decoded []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Len(t, decoded, 4)
+	assert.Equal(t, "coinbase", decoded[3]["memo"])
+	assert.Equal(t, "received", decoded[0]["direction"])
+}
@@ -16,7 +16,9 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"net"
 	"net/http"
+	"time"
 )
 
 // AuthenticationTokenKey is the key used in the context to authenticate clients.
@@ -24,6 +26,34 @@ import (
 // the client to set a key value in the context metadata to 'AuthenticationToken: cfg.AuthToken'
 const AuthenticationTokenKey = "AuthenticationToken"
 
+// authFailureAuditInterval is the minimum time between audit log entries
+// for failed authentications from the same host.
+const authFailureAuditInterval = time.Minute
+
+// auditedMethods are the RPCs which change the state of the node or expose
+// secrets. Each invocation is recorded in the audit log. The request is not
+// recorded as it may contain passphrases or keys.
+var auditedMethods = map[string]bool{
+	"/pb.NodeService/GetNetworkKey":            true,
+	"/pb.NodeService/AddPeer":                  true,
+	"/pb.NodeService/BlockPeer":                true,
+	"/pb.NodeService/UnblockPeer":              true,
+	"/pb.NodeService/SetLogLevel":              true,
+	"/pb.NodeService/SetMinFeePerKilobyte":     true,
+	"/pb.NodeService/SetMinStake":              true,
+	"/pb.NodeService/SetBlockSizeSoftLimit":    true,
+	"/pb.NodeService/UpdateTreasuryWhitelist":  true,
+	"/pb.NodeService/ReconsiderBlock":          true,
+	"/pb.NodeService/RecomputeChainState":      true,
+	"/pb.WalletService/GetWalletSeed":          true,
+	"/pb.WalletService/GetPrivateKey":          true,
+	"/pb.WalletService/WalletUnlock":           true,
+	"/pb.WalletService/SetWalletPassphrase":    true,
+	"/pb.WalletService/ChangeWalletPassphrase": true,
+	"/pb.WalletService/DeletePrivateKeys":      true,
+	"/pb.WalletService/SetAutoStakeRewards":    true,
+}
+
 func newGrpcServer(cfgOpts repo.RPCOptions, rpcCfg *rpc.GrpcServerConfig) (*rpc.GrpcServer, error) {
 	i := interceptor{authToken: cfgOpts.GrpcAuthToken}
 	opts := []grpc.ServerOption{grpc.StreamInterceptor(i.interceptStreaming), grpc.UnaryInterceptor(i.interceptUnary)}
@@ -89,6 +119,7 @@ func (i *interceptor) interceptStreaming(srv interface{}, ss grpc.ServerStream,
 
 	err := validateAuthenticationToken(ss.Context(), i.authToken)
 	if err != nil {
+		auditAuthFailure(info.FullMethod, p, ok)
 		return err
 	}
 
@@ -109,10 +140,14 @@ func (i *interceptor) interceptUnary(ctx context.Context, req interface{}, info
 
 	err = validateAuthenticationToken(ctx, i.authToken)
 	if err != nil {
+		auditAuthFailure(info.FullMethod, p, ok)
 		return nil, err
 	}
 
 	resp, err = handler(ctx, req)
+	if auditedMethods[info.FullMethod] {
+		repo.Audit(repo.AuditRPCAdmin, "method", info.FullMethod, "addr", remoteAddr(p, ok), repo.LogFieldError, err)
+	}
 	if err != nil && ok {
 		log.Errorf("Unary method %s invoked by %s errored: %v",
 			info.FullMethod, p.Addr.String(), err)
@@ -127,3 +162,22 @@ func validateAuthenticationToken(ctx context.Context, authToken string) error {
 	}
 	return nil
 }
+
+// auditAuthFailure records a failed authentication in the audit log. An
+// unauthenticated client can fail as often as it likes so entries are
+// limited per remote host.
+func auditAuthFailure(method string, p *peer.Peer, ok bool) {
+	addr := remoteAddr(p, ok)
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	repo.AuditLimited(repo.AuditRPCAuthFailure, host, authFailureAuditInterval, "method", method, "addr", addr)
+}
+
+func remoteAddr(p *peer.Peer, ok bool) string {
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
@@ -15,9 +15,11 @@ import (
 	golog "github.com/ipfs/go-log"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/accounts"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/blockchain/indexers"
 	"github.com/project-illium/ilxd/consensus"
+	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/gen"
 	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/net"
@@ -27,13 +29,21 @@ import (
 	"github.com/project-illium/ilxd/rpc"
 	"github.com/project-illium/ilxd/sync"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/address"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/remoteprover"
 	"github.com/project-illium/walletlib"
 	"github.com/project-illium/walletlib/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
 	stdsync "sync"
 	"time"
 )
@@ -88,6 +98,14 @@ type Server struct {
 	coinbasesToStake map[types.ID]struct{}
 	networkKey       crypto.PrivKey
 
+	// storedNetworkKey is the network key as loaded from the
+	// datastore. It is kept so the passphrase does not need to be.
+	storedNetworkKey crypto.PrivKey
+
+	shutdownTracing   func(context.Context) error
+	closeAuditLog     func() error
+	closeRemoteProver func() error
+
 	ready chan struct{}
 }
 
@@ -101,7 +119,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	defer close(s.ready)
 
 	// Logging
-	zapLevel, err := setupLogging(config.LogDir, config.LogLevel, config.Testnet)
+	logLevels, err := setupLogging(config)
 	if err != nil {
 		return nil, err //nolint:govet
 	}
@@ -110,8 +128,21 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		golog.SetDebugLogging()
 	}
 
-	// Load public parameters
-	zk.LoadZKPublicParameters()
+	// Audit log
+	if !config.NoAuditLog {
+		s.closeAuditLog, err = repo.OpenAuditLog(config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Tracing
+	if config.Tracing {
+		s.shutdownTracing, err = setupTracing(config.LogDir, config.TracingSampleRate)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Policy
 	policy := policy2.NewPolicy(
@@ -132,6 +163,8 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	var netParams *params.NetworkParams
 	if config.Testnet {
 		netParams = &params.Testnet1Params
+	} else if config.Testnet2 {
+		netParams = &params.Testnet2Params
 	} else if config.Alphanet {
 		netParams = &params.AlphanetParams
 	} else if config.Regtest {
@@ -139,11 +172,47 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		if config.RegtestVal {
 			config.WalletSeed = params.RegtestMnemonicSeed
 		}
+	} else if config.CustomParams != "" {
+		netParams, err = params.LoadCustomParams(config.CustomParams)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		netParams = &params.MainnetParams
 	}
+	if err := netParams.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Load public parameters. The mock prover doesn't
+	// need them so loading them is skipped.
+	if config.MockProver {
+		if err := zk.EnableMockProver(netParams.Name); err != nil {
+			return nil, err
+		}
+	} else if err := zk.LoadZKPublicParametersFromCache(config.DataDir); err != nil {
+		return nil, err
+	}
+	proverOpts := []zk.Option{
+		zk.ProverWorkers(config.ProverWorkers),
+		zk.ProverQueueSize(config.ProverQueueSize),
+	}
+	if config.RemoteProver != "" {
+		remoteProver, err := newRemoteProver(config)
+		if err != nil {
+			return nil, err
+		}
+		s.closeRemoteProver = remoteProver.Close
+		proverOpts = append(proverOpts, zk.ProverBackend(remoteProver))
+	}
+	if err := zk.ConfigureProver(proverOpts...); err != nil {
+		return nil, err
+	}
 
 	if config.CoinbaseAddress != "" {
+		if err := address.Validate(config.CoinbaseAddress, netParams); err != nil {
+			return nil, err
+		}
 		addr, err := walletlib.DecodeAddress(config.CoinbaseAddress, netParams)
 		if err != nil {
 			return nil, err
@@ -164,14 +233,26 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		indexerList []indexers.Indexer
 		txIndex     *indexers.TxIndex
 		wsIndex     *indexers.WalletServerIndex
+		valIndex    *indexers.ValidatorIndex
 	)
 	if !config.NoTxIndex && !config.DropTxIndex {
 		txIndex = indexers.NewTxIndex()
 		indexerList = append(indexerList, txIndex)
 	}
 
+	if config.ValidatorIndex && !config.DropValidatorIndex {
+		valIndex = indexers.NewValidatorIndex()
+		indexerList = append(indexerList, valIndex)
+	}
+
 	if config.WSIndex && !config.DropWSIndex {
-		wsIndex, err = indexers.NewWalletServerIndex(ds)
+		wsOpts := []indexers.WalletServerIndexOption{
+			indexers.ScanBatchSize(config.WSIndexScanBatch),
+		}
+		if config.WSIndexScanWorkers > 0 {
+			wsOpts = append(wsOpts, indexers.ScanWorkers(config.WSIndexScanWorkers))
+		}
+		wsIndex, err = indexers.NewWalletServerIndex(ds, wsOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -182,6 +263,10 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		return nil, errors.New("tx index must be used with wallet server index")
 	}
 
+	if config.SnapshotSync && len(indexerList) > 0 {
+		return nil, errors.New("snapshot sync cannot be used with an index")
+	}
+
 	blockchainOpts := []blockchain.Option{
 		blockchain.Params(netParams),
 		blockchain.Datastore(ds),
@@ -189,9 +274,24 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		blockchain.MaxTxoRoots(blockchain.DefaultMaxTxoRoots),
 		blockchain.SignatureCache(sigCache),
 		blockchain.SnarkProofCache(proofCache),
+		blockchain.ValidationWorkers(runtime.NumCPU()),
 	}
 
-	if config.Prune {
+	if len(config.Checkpoints) > 0 {
+		checkpoints := make([]params.Checkpoint, 0, len(config.Checkpoints))
+		for _, c := range config.Checkpoints {
+			checkpoint, err := parseCheckpoint(c)
+			if err != nil {
+				return nil, err
+			}
+			checkpoints = append(checkpoints, checkpoint)
+		}
+		blockchainOpts = append(blockchainOpts, blockchain.Checkpoints(checkpoints))
+	}
+
+	if config.PruneDepth > 0 {
+		blockchainOpts = append(blockchainOpts, blockchain.PruneDepth(config.PruneDepth))
+	} else if config.Prune {
 		blockchainOpts = append(blockchainOpts, blockchain.Prune())
 	}
 
@@ -210,6 +310,11 @@ func BuildServer(config *repo.Config) (*Server, error) {
 			return nil, err
 		}
 	}
+	if config.DropValidatorIndex {
+		if err := indexers.DropValidatorIndex(ds); err != nil {
+			return nil, err
+		}
+	}
 
 	chain, err := blockchain.NewBlockchain(blockchainOpts...)
 	if err != nil {
@@ -230,6 +335,10 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	accountManager, err := accounts.NewAccountManager(ds, wallet, policy.GetMinFeePerKilobyte())
+	if err != nil {
+		return nil, err
+	}
 
 	// Load or create the private key for the node
 	var privKey crypto.PrivKey
@@ -237,18 +346,38 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	encrypted := false
 	if has {
-		privKey, err = repo.LoadNetworkKey(ds)
+		encrypted, err = repo.IsNetworkKeyEncrypted(ds)
 		if err != nil {
 			return nil, err
 		}
+	}
+	// Always prompt if the key is encrypted and the passphrase
+	// was not provided any other way.
+	keystorePassphrase, err := repo.LoadKeystorePassphrase(config.KeystorePassFile, config.KeystorePrompt || encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		privKey, err = repo.LoadNetworkKey(ds, keystorePassphrase)
+		if err != nil {
+			return nil, err
+		}
+		migrated, err := repo.MigrateNetworkKey(ds, keystorePassphrase)
+		if err != nil {
+			return nil, err
+		}
+		if migrated {
+			log.Info("Encrypted plaintext network key in database")
+		}
 	} else {
 		if netParams.Name == params.RegestParams.Name && config.RegtestVal {
 			privKey, err = crypto.UnmarshalPrivateKey(params.RegtestGenesisKey)
 			if err != nil {
 				return nil, err
 			}
-			if err := repo.PutNetworkKey(ds, privKey); err != nil {
+			if err := repo.PutNetworkKey(ds, privKey, keystorePassphrase); err != nil {
 				return nil, err
 			}
 		} else {
@@ -256,11 +385,15 @@ func BuildServer(config *repo.Config) (*Server, error) {
 			if err != nil {
 				return nil, err
 			}
-			if err := repo.PutNetworkKey(ds, privKey); err != nil {
+			if err := repo.PutNetworkKey(ds, privKey, keystorePassphrase); err != nil {
 				return nil, err
 			}
 		}
 	}
+	// The passphrase is not needed once the key has been decrypted
+	// or stored.
+	icrypto.Zeroize(keystorePassphrase)
+	storedNetworkKey := privKey
 
 	if config.NetworkKey != "" {
 		keyBytes, err := hex.DecodeString(config.NetworkKey)
@@ -341,9 +474,12 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		consensus.Network(network),
 		consensus.ValidatorConnector(valConn),
 		consensus.Chooser(chain),
+		consensus.Validators(chain),
 		consensus.RequestBlock(s.requestBlock),
 		consensus.GetBlockID(chain.GetBlockIDByHeight),
 		consensus.PeerID(network.Host().ID()),
+		consensus.PrivateKey(privKey),
+		consensus.Datastore(ds),
 	}...)
 	if err != nil {
 		return nil, err
@@ -354,17 +490,21 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		Network:              network,
 		Policy:               policy,
 		Wallet:               wallet,
+		AccountManager:       accountManager,
 		BroadcastTxFunc:      s.submitTransaction,
-		SetLogLevelFunc:      zapLevel.SetLevel,
+		SetLogLevelFunc:      logLevels.SetLevel,
 		ReindexChainFunc:     s.reIndexChain,
 		RequestBlockFunc:     s.requestBlock,
 		AutoStakeFunc:        s.setAutostake,
+		CertificateFunc:      engine.FinalityCertificate,
 		NetworkKeyFunc:       s.getNetworkKey,
+		IsCurrentFunc:        s.isCurrent,
 		ChainParams:          netParams,
 		Ds:                   ds,
 		TxMemPool:            mpool,
 		TxIndex:              txIndex,
 		WSIndex:              wsIndex,
+		ValidatorIndex:       valIndex,
 		DisableNodeService:   config.RPCOpts.DisableNodeService,
 		DisableWalletService: config.RPCOpts.DisableWalletService,
 		DisableWalletServer:  config.RPCOpts.DisableWalletServerService || wsIndex == nil,
@@ -377,6 +517,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		gen.Blockchain(chain),
 		gen.PrivateKey(privKey),
 		gen.Mempool(mpool),
+		gen.Policy(policy),
 		gen.BroadcastFunc(network.BroadcastBlock),
 	}...)
 	if err != nil {
@@ -398,6 +539,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	s.config = config
 	s.params = netParams
 	s.ds = ds
+	s.storedNetworkKey = storedNetworkKey
 	s.network = network
 	s.mempool = mpool
 	s.blockchain = chain
@@ -412,6 +554,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		IsCurrentCallback: s.handleCurrentStatusChange,
 		ProofCache:        proofCache,
 		SigCache:          sigCache,
+		SnapshotSync:      config.SnapshotSync,
 	})
 	s.orphanBlocks = make(map[types.ID]*orphanBlock)
 	s.activeInventory = make(map[types.ID]*blocks.Block)
@@ -450,7 +593,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	return &s, nil
 }
 
-func (s *Server) processMempoolTransaction(tx *transactions.Transaction) error {
+func (s *Server) processMempoolTransaction(ctx context.Context, tx *transactions.Transaction) error {
 	<-s.ready
 
 	// We will let our own txs through even if we're not current.
@@ -465,7 +608,11 @@ func (s *Server) processMempoolTransaction(tx *transactions.Transaction) error {
 	s.submittedTxsLock.Lock()
 	delete(s.submittedTxs, tx.ID())
 	s.submittedTxsLock.Unlock()
-	return s.mempool.ProcessTransaction(tx)
+
+	_, span := tracer.Start(ctx, "mempool.ProcessTransaction")
+	err := s.mempool.ProcessTransaction(tx)
+	endSpan(span, err)
+	return err
 }
 
 func (s *Server) submitTransaction(tx *transactions.Transaction) error {
@@ -481,11 +628,22 @@ func (s *Server) submitTransaction(tx *transactions.Transaction) error {
 	return s.network.BroadcastTransaction(tx)
 }
 
+// isCurrent returns whether the node is synced to the tip of the chain.
+// It does not block and reports false until the server is ready.
+func (s *Server) isCurrent() bool {
+	select {
+	case <-s.ready:
+		return s.syncManager.IsCurrent()
+	default:
+		return false
+	}
+}
+
 func (s *Server) getNetworkKey() (crypto.PrivKey, error) {
-	return repo.LoadNetworkKey(s.ds)
+	return s.storedNetworkKey, nil
 }
 
-func (s *Server) handleIncomingBlock(xThinnerBlk *blocks.XThinnerBlock, p peer.ID) error {
+func (s *Server) handleIncomingBlock(ctx context.Context, xThinnerBlk *blocks.XThinnerBlock, p peer.ID) error {
 	<-s.ready
 	_, height, _ := s.blockchain.BestBlock()
 
@@ -500,7 +658,7 @@ func (s *Server) handleIncomingBlock(xThinnerBlk *blocks.XThinnerBlock, p peer.I
 	s.inflightRequests[blockID] = true
 	s.inflightLock.Unlock()
 
-	blk, err := s.decodeXthinner(xThinnerBlk, p)
+	blk, err := s.decodeXthinner(ctx, xThinnerBlk, p)
 	if err != nil {
 		return err
 	}
@@ -511,7 +669,7 @@ func (s *Server) handleIncomingBlock(xThinnerBlk *blocks.XThinnerBlock, p peer.I
 		s.inflightLock.Unlock()
 	})
 
-	return s.processBlock(blk, p, false)
+	return s.processBlock(ctx, blk, p, false)
 }
 
 func (s *Server) handleBlockchainNotification(ntf *blockchain.Notification) {
@@ -541,6 +699,19 @@ func (s *Server) handleBlockchainNotification(ntf *blockchain.Notification) {
 				}
 			}
 		}
+	case blockchain.NTBlockDisconnected:
+		if blk, ok := ntf.Data.(*blocks.Block); ok {
+			// Return the transactions from the disconnected block to the
+			// mempool. Any which conflict with the new branch are rejected.
+			for _, tx := range blk.Transactions {
+				if err := s.mempool.ProcessTransaction(tx); err != nil {
+					log.Debugf("Disconnected block transaction %s not returned to mempool: %s", tx.ID(), err)
+				}
+			}
+			// The wallet does not support disconnecting blocks so any
+			// notes it detected in the block remain until it is rescanned.
+			log.Warnf("Block %s disconnected from the chain. The wallet may need to be rescanned.", blk.ID())
+		}
 	case blockchain.NTAddValidator:
 		if pid, ok := ntf.Data.(peer.ID); ok {
 			if pid == s.network.Host().ID() {
@@ -596,9 +767,18 @@ func (s *Server) setAutostake(autostake bool) error {
 	return s.ds.Put(context.Background(), datastore.NewKey(repo.AutostakeDatastoreKey), b)
 }
 
-func (s *Server) processBlock(blk *blocks.Block, relayingPeer peer.ID, recheck bool) error {
+func (s *Server) processBlock(ctx context.Context, blk *blocks.Block, relayingPeer peer.ID, recheck bool) error {
 	<-s.ready
+	ctx, span := tracer.Start(ctx, "server.ProcessBlock", trace.WithAttributes(
+		attribute.String(repo.LogFieldBlockID, blk.ID().String()),
+		attribute.Int64(repo.LogFieldHeight, int64(blk.Header.Height)),
+		attribute.Bool("recheck", recheck),
+	))
+	defer span.End()
+
+	_, checkSpan := tracer.Start(ctx, "blockchain.CheckConnectBlock")
 	err := s.blockchain.CheckConnectBlock(blk)
+	endSpan(checkSpan, err)
 
 	switch err.(type) {
 	case blockchain.OrphanBlockError:
@@ -646,11 +826,11 @@ func (s *Server) processBlock(blk *blocks.Block, relayingPeer peer.ID, recheck b
 				for _, pid := range s.network.Host().Network().Peers() {
 					blk, err = s.fetchBlockTxids(blk, pid)
 					if err == nil {
-						return s.processBlock(blk, relayingPeer, true)
+						return s.processBlock(ctx, blk, relayingPeer, true)
 					}
 				}
 			} else {
-				return s.processBlock(blk, relayingPeer, true)
+				return s.processBlock(ctx, blk, relayingPeer, true)
 			}
 		} else if blockchain.ErrorIs(err, blockchain.ErrDoesNotConnect) {
 			// Small chance of a race condition where we receive a block
@@ -699,24 +879,31 @@ func (s *Server) processBlock(blk *blocks.Block, relayingPeer peer.ID, recheck b
 	s.orphanLock.Unlock()
 
 	s.generator.Interrupt(blk.Header.Height)
-	log.Debugf("[CONSENSUS] new block: %s", blk.ID())
+	log.Debugw("[CONSENSUS] new block", repo.LogFieldBlockID, blk.ID(), repo.LogFieldHeight, blk.Header.Height)
+	_, consensusSpan := tracer.Start(ctx, "consensus.Finalize", trace.WithAttributes(attribute.Bool("acceptable", isAcceptable)))
 	s.engine.NewBlock(blk.Header, isAcceptable, callback)
 
 	go func(b *blocks.Block, t time.Time) {
 		select {
 		case status := <-callback:
+			consensusSpan.SetAttributes(attribute.String("status", status.String()))
+			consensusSpan.End()
+
 			switch status {
 			case consensus.StatusFinalized:
 				blockID := blk.ID()
-				log.Debugf("Block %s finalized in %d milliseconds", blockID, time.Since(t).Milliseconds())
-				if err := s.blockchain.ConnectBlock(b, blockchain.BFNone); err != nil {
-					log.Warnf("Connect block error: block %s: %s", blockID, err)
+				log.Debugw("Block finalized", repo.LogFieldBlockID, blockID, "milliseconds", time.Since(t).Milliseconds())
+				_, connectSpan := tracer.Start(ctx, "blockchain.ConnectBlock")
+				err := s.blockchain.ConnectBlock(b, blockchain.BFNone)
+				endSpan(connectSpan, err)
+				if err != nil {
+					log.Warnw("Connect block error", repo.LogFieldBlockID, blockID, repo.LogFieldError, err)
 				} else {
-					log.Infof("New block: %s, (height: %d, transactions: %d)", blockID, blk.Header.Height, len(b.Transactions))
+					log.Infow("New block", repo.LogFieldBlockID, blockID, repo.LogFieldHeight, blk.Header.Height, "transactions", len(b.Transactions))
 					s.syncManager.SetCurrent()
 				}
 			case consensus.StatusRejected:
-				log.Debugf("Block %s rejected by consensus", b.ID())
+				log.Debugw("Block rejected by consensus", repo.LogFieldBlockID, b.ID())
 			}
 
 			// Leave it here for a little in case a peer requests it.
@@ -732,8 +919,8 @@ func (s *Server) processBlock(blk *blocks.Block, relayingPeer peer.ID, recheck b
 				if orphan.blk.Header.Height == blk.Header.Height {
 					delete(s.orphanBlocks, orphan.blk.ID())
 				} else if orphan.blk.Header.Height == blk.Header.Height+1 {
-					log.Debugf("Re-procssing orphan at height %d: %s", orphan.blk.Header.Height, orphan.blk.ID())
-					go s.processBlock(orphan.blk, orphan.relayingPeer, false)
+					log.Debugw("Re-processing orphan", repo.LogFieldBlockID, orphan.blk.ID(), repo.LogFieldHeight, orphan.blk.Header.Height)
+					go s.processBlock(ctx, orphan.blk, orphan.relayingPeer, false)
 					break
 				} else if time.Since(orphan.firstSeen) > maxOrphanDuration {
 					delete(s.orphanBlocks, orphan.blk.ID())
@@ -741,15 +928,25 @@ func (s *Server) processBlock(blk *blocks.Block, relayingPeer peer.ID, recheck b
 			}
 			s.orphanLock.Unlock()
 		case <-s.ctx.Done():
+			consensusSpan.End()
 			return
 		}
 	}(blk, startTime)
 	return nil
 }
 
-func (s *Server) decodeXthinner(xThinnerBlk *blocks.XThinnerBlock, relayingPeer peer.ID) (*blocks.Block, error) {
+func (s *Server) decodeXthinner(ctx context.Context, xThinnerBlk *blocks.XThinnerBlock, relayingPeer peer.ID) (*blocks.Block, error) {
 	<-s.ready
-	blk, missing := s.mempool.DecodeXthinner(xThinnerBlk)
+	_, span := tracer.Start(ctx, "mempool.DecodeXthinner")
+	defer span.End()
+
+	blk, missing, err := s.mempool.DecodeXthinner(xThinnerBlk)
+	span.SetAttributes(attribute.Int("missing", len(missing)))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		s.network.IncreaseBanscore(relayingPeer, 101, 0)
+		return nil, err
+	}
 	if len(missing) > 0 {
 		txs, err := s.chainService.GetBlockTxs(relayingPeer, xThinnerBlk.ID(), missing)
 		if err == nil {
@@ -773,6 +970,7 @@ func (s *Server) decodeXthinner(xThinnerBlk *blocks.XThinnerBlock, relayingPeer
 			// us the block. If the block is invalid they may not be able to legitimately
 			// respond to our request.
 		}
+		span.SetStatus(codes.Error, "failed to decode from all peers")
 		return nil, errors.New("failed to decode from all peers")
 	}
 	return blk, nil
@@ -876,7 +1074,7 @@ func (s *Server) requestBlock(blockID types.ID, remotePeer peer.ID) {
 	s.inflightRequests[blockID] = true
 	s.inflightLock.Unlock()
 
-	log.Debugf("Requesting unknown block %s from peer %s", blockID, remotePeer.String())
+	log.Debugw("Requesting unknown block", repo.LogFieldBlockID, blockID, repo.LogFieldPeerID, remotePeer)
 	blk, err := s.chainService.GetBlock(remotePeer, blockID)
 	if err != nil {
 		s.network.IncreaseBanscore(remotePeer, 0, 30)
@@ -886,7 +1084,7 @@ func (s *Server) requestBlock(blockID types.ID, remotePeer peer.ID) {
 		return
 	}
 
-	s.processBlock(blk, remotePeer, false)
+	s.processBlock(s.ctx, blk, remotePeer, false)
 
 	time.AfterFunc(time.Minute*5, func() {
 		s.inflightLock.Lock()
@@ -932,6 +1130,21 @@ func (s *Server) Close() error {
 	if err := s.ds.Close(); err != nil {
 		return err
 	}
+	if s.closeAuditLog != nil {
+		if err := s.closeAuditLog(); err != nil {
+			return err
+		}
+	}
+	if s.closeRemoteProver != nil {
+		if err := s.closeRemoteProver(); err != nil {
+			return err
+		}
+	}
+	if s.shutdownTracing != nil {
+		if err := s.shutdownTracing(context.Background()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -940,13 +1153,9 @@ func (s *Server) makeBlockchainClient(chain *blockchain.Blockchain) *client.Inte
 		BroadcastFunc:                s.submitTransaction,
 		GetAccumulatorCheckpointFunc: chain.GetAccumulatorCheckpointByHeight,
 		GetBlocksFunc: func(from, to uint32) ([]*blocks.Block, uint32, error) {
-			blocks := make([]*blocks.Block, 0, to-from+1)
-			for i := from; i <= to; i++ {
-				blk, err := chain.GetBlockByHeight(i)
-				if err != nil {
-					return nil, 0, err
-				}
-				blocks = append(blocks, blk)
+			blocks, err := chain.GetBlocksByRange(from, to)
+			if err != nil {
+				return nil, 0, err
 			}
 			_, bestHeight, _ := chain.BestBlock()
 			return blocks, bestHeight, nil
@@ -965,6 +1174,44 @@ func (s *Server) makeBlockchainClient(chain *blockchain.Blockchain) *client.Inte
 	return c
 }
 
+// newRemoteProver connects to the remote prover service in the config.
+func newRemoteProver(config *repo.Config) (*remoteprover.RemoteProver, error) {
+	var opts []remoteprover.Option
+	if config.RemoteProverToken != "" {
+		opts = append(opts, remoteprover.AuthToken(config.RemoteProverToken))
+	}
+	if config.RemoteProverCert != "" {
+		opts = append(opts, remoteprover.TLSCertFile(repo.CleanAndExpandPath(config.RemoteProverCert)))
+	}
+	if config.ProverClientCert != "" || config.ProverClientKey != "" {
+		opts = append(opts, remoteprover.ClientCert(repo.CleanAndExpandPath(config.ProverClientCert), repo.CleanAndExpandPath(config.ProverClientKey)))
+	}
+	if config.RemoteProverToken == "" && config.ProverClientCert == "" {
+		return nil, errors.New("remoteprover requires remoteprovertoken or remoteproverclientcert")
+	}
+	return remoteprover.NewRemoteProver(config.RemoteProver, opts...)
+}
+
+// parseCheckpoint parses a checkpoint in the form <height>:<blockID>.
+func parseCheckpoint(s string) (params.Checkpoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return params.Checkpoint{}, fmt.Errorf("invalid checkpoint %s: expected <height>:<blockID>", s)
+	}
+	height, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return params.Checkpoint{}, fmt.Errorf("invalid checkpoint height %s: %s", parts[0], err)
+	}
+	blockID, err := types.NewIDFromString(parts[1])
+	if err != nil {
+		return params.Checkpoint{}, fmt.Errorf("invalid checkpoint block ID %s: %s", parts[1], err)
+	}
+	return params.Checkpoint{
+		BlockID: blockID,
+		Height:  uint32(height),
+	}, nil
+}
+
 func (s *Server) limitOrphans() {
 	if len(s.orphanBlocks) > maxOrphans {
 		for id := range s.orphanBlocks {
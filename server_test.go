@@ -0,0 +1,48 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerIsCurrentBeforeReady(t *testing.T) {
+	s := &Server{ready: make(chan struct{})}
+
+	done := make(chan bool)
+	go func() {
+		done <- s.isCurrent()
+	}()
+	select {
+	case current := <-done:
+		assert.False(t, current)
+	case <-time.After(time.Second * 5):
+		t.Fatal("isCurrent blocked before the server was ready")
+	}
+}
+
+func TestParseCheckpoint(t *testing.T) {
+	blockID := types.ID{0x01, 0x02}
+	checkpoint, err := parseCheckpoint("1000:" + blockID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1000), checkpoint.Height)
+	assert.Equal(t, blockID, checkpoint.BlockID)
+
+	for _, s := range []string{
+		"",
+		"1000",
+		"1000:" + blockID.String() + ":1",
+		"abc:" + blockID.String(),
+		"4294967296:" + blockID.String(),
+		"1000:zz",
+	} {
+		_, err := parseCheckpoint(s)
+		assert.Error(t, err, s)
+	}
+}
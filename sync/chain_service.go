@@ -15,6 +15,7 @@ import (
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -70,9 +71,18 @@ func (cs *ChainService) HandleNewStream(s inet.Stream) {
 
 func (cs *ChainService) handleNewMessage(s inet.Stream) {
 	defer s.Close()
+	remotePeer := s.Conn().RemotePeer()
+	ctx, cancel := context.WithTimeout(cs.ctx, time.Minute)
+	_, err := cs.network.WaitProtocolVersion(ctx, remotePeer)
+	cancel()
+	if err != nil {
+		log.Debugw("Rejecting chain service stream without a negotiated protocol version", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
+		s.Reset()
+		return
+	}
+
 	contextReader := ctxio.NewReader(cs.ctx, s)
 	reader := msgio.NewVarintReaderSize(contextReader, 1<<23)
-	remotePeer := s.Conn().RemotePeer()
 	defer reader.Close()
 	ticker := time.NewTicker(time.Minute)
 
@@ -92,14 +102,14 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 				s.Close()
 				return
 			}
-			log.Debugf("Error reading from chain service stream: peer: %s, error: %s", remotePeer, err.Error())
+			limitedLog.Debugw("Error reading from chain service stream", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 			s.Reset()
 			return
 		}
 		req := new(wire.MsgChainServiceRequest)
 		if err := proto.Unmarshal(msgBytes, req); err != nil {
 			reader.ReleaseMsg(msgBytes)
-			log.Debugf("Error unmarshalling chain service message: peer: %s, error: %s", remotePeer, err.Error())
+			limitedLog.Debugw("Error unmarshalling chain service message", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 			s.Reset()
 			return
 		}
@@ -117,29 +127,33 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 			resp, err = cs.handleGetBlockID(m.GetBlockId)
 		case *wire.MsgChainServiceRequest_GetBest:
 			resp, err = cs.handleGetBest(m.GetBest)
+		case *wire.MsgChainServiceRequest_GetSnapshotManifest:
+			resp, err = cs.handleGetSnapshotManifest(m.GetSnapshotManifest)
+		case *wire.MsgChainServiceRequest_GetSnapshotChunk:
+			resp, err = cs.handleGetSnapshotChunk(m.GetSnapshotChunk)
 		case *wire.MsgChainServiceRequest_GetHeadersStream:
 			err = cs.handleGetHeadersStream(m.GetHeadersStream, s)
 			if err != nil {
-				log.Errorf("Error sending header response to peer: %s, error: %s", remotePeer, err.Error())
+				limitedLog.Errorw("Error sending header response to peer", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 				s.Reset()
 				return
 			}
 		case *wire.MsgChainServiceRequest_GetBlockTxsStream:
 			err = cs.handleGetBlockTxsStream(m.GetBlockTxsStream, s)
 			if err != nil {
-				log.Errorf("Error sending block txs response to peer: %s, error: %s", remotePeer, err.Error())
+				limitedLog.Errorw("Error sending block txs response to peer", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 				s.Reset()
 				return
 			}
 		}
 		if err != nil {
-			log.Errorf("Error handing chain service message to peer: %s, error: %s", remotePeer, err.Error())
+			limitedLog.Errorw("Error handling chain service message", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 			continue
 		}
 
 		if resp != nil {
 			if err := net.WriteMsg(s, resp); err != nil {
-				log.Errorf("Error writing chain service response to peer: %s, error: %s", remotePeer, err.Error())
+				limitedLog.Errorw("Error writing chain service response to peer", repo.LogFieldPeerID, remotePeer, repo.LogFieldError, err)
 				s.Reset()
 				return
 			}
@@ -426,16 +440,16 @@ func (cs *ChainService) handleGetBlockTxsStream(req *wire.GetBlockTxsStreamReq,
 	if endHeight > bestHeight {
 		endHeight = bestHeight
 	}
+	if req.StartHeight > endHeight {
+		return s.Close()
+	}
 
-	for i := req.StartHeight; i <= endHeight; i++ {
-		block, err := cs.chain.GetBlockByHeight(i)
-		if err != nil {
-			return err
-		}
-		if err := net.WriteMsg(s, &blocks.BlockTxs{Transactions: block.Transactions}); err != nil {
-			s.Close()
-			return err
-		}
+	err := cs.chain.IterateBlocks(req.StartHeight, endHeight, func(block *blocks.Block) error {
+		return net.WriteMsg(s, &blocks.BlockTxs{Transactions: block.Transactions})
+	})
+	if err != nil {
+		s.Close()
+		return err
 	}
 	return s.Close()
 }
@@ -477,3 +491,92 @@ func (cs *ChainService) handleGetBest(req *wire.GetBestReq) (*wire.MsgGetBestRes
 
 	return resp, nil
 }
+
+func (cs *ChainService) GetSnapshotManifest(p peer.ID, commitment types.ID) (*blockchain.SnapshotManifest, error) {
+	var (
+		req = &wire.MsgChainServiceRequest{
+			Msg: &wire.MsgChainServiceRequest_GetSnapshotManifest{
+				GetSnapshotManifest: &wire.GetSnapshotManifestReq{
+					Commitment: commitment[:],
+				},
+			},
+		}
+		resp = new(wire.MsgSnapshotManifestResp)
+	)
+	err := cs.ms.SendRequest(cs.ctx, p, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Error == wire.ErrorResponse_NotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp.Error != wire.ErrorResponse_None {
+		return nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
+	}
+
+	manifest, err := blockchain.DeserializeSnapshotManifest(resp.Manifest)
+	if err != nil || manifest.Commitment() != commitment {
+		cs.network.IncreaseBanscore(p, 50, 0)
+		return nil, errors.New("incorrect snapshot manifest returned")
+	}
+
+	return manifest, nil
+}
+
+func (cs *ChainService) handleGetSnapshotManifest(req *wire.GetSnapshotManifestReq) (*wire.MsgSnapshotManifestResp, error) {
+	manifest, err := cs.chain.SnapshotManifest(types.NewID(req.Commitment))
+	if err != nil {
+		return &wire.MsgSnapshotManifestResp{Error: wire.ErrorResponse_NotFound}, nil
+	}
+
+	resp := &wire.MsgSnapshotManifestResp{
+		Manifest: manifest.Serialize(),
+	}
+
+	return resp, nil
+}
+
+// GetSnapshotChunk fetches a chunk of the snapshot with the given commitment.
+// The caller is responsible for checking the chunk against the manifest.
+func (cs *ChainService) GetSnapshotChunk(p peer.ID, commitment types.ID, index uint32) ([]byte, error) {
+	var (
+		req = &wire.MsgChainServiceRequest{
+			Msg: &wire.MsgChainServiceRequest_GetSnapshotChunk{
+				GetSnapshotChunk: &wire.GetSnapshotChunkReq{
+					Commitment: commitment[:],
+					Index:      index,
+				},
+			},
+		}
+		resp = new(wire.MsgSnapshotChunkResp)
+	)
+	err := cs.ms.SendRequest(cs.ctx, p, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Error == wire.ErrorResponse_NotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp.Error != wire.ErrorResponse_None {
+		return nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
+	}
+
+	return resp.Chunk, nil
+}
+
+func (cs *ChainService) handleGetSnapshotChunk(req *wire.GetSnapshotChunkReq) (*wire.MsgSnapshotChunkResp, error) {
+	chunk, err := cs.chain.SnapshotChunk(types.NewID(req.Commitment), req.Index)
+	if err != nil {
+		return &wire.MsgSnapshotChunkResp{Error: wire.ErrorResponse_NotFound}, nil
+	}
+
+	resp := &wire.MsgSnapshotChunkResp{
+		Chunk: chunk,
+	}
+
+	return resp, nil
+}
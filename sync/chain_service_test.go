@@ -14,6 +14,7 @@ import (
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/stretchr/testify/assert"
@@ -30,10 +31,10 @@ func TestChainService(t *testing.T) {
 	network1, err := net.NewNetwork(context.Background(), []net.Option{
 		net.WithHost(host1),
 		net.Params(&params.RegestParams),
-		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+		net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
 			return nil
 		}),
-		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+		net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
 			return nil
 		}),
 		net.Datastore(ds),
@@ -55,10 +56,10 @@ func TestChainService(t *testing.T) {
 	network2, err := net.NewNetwork(context.Background(), []net.Option{
 		net.WithHost(host2),
 		net.Params(&params.RegestParams),
-		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+		net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
 			return nil
 		}),
-		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+		net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
 			return nil
 		}),
 		net.Datastore(ds),
@@ -140,3 +141,64 @@ func TestChainService(t *testing.T) {
 	}
 	assert.Equal(t, uint32(11), i)
 }
+
+func TestChainService_Snapshot(t *testing.T) {
+	mn := mocknet.New()
+
+	netParams := params.RegestParams
+	netParams.SnapshotInterval = 5
+	netParams.Upgrades = map[params.Upgrade]uint32{params.UpgradeSnapshotCommitments: 15}
+
+	var services []*ChainService
+	var hosts []peer.ID
+	for i := 0; i < 2; i++ {
+		host, err := mn.GenPeer()
+		assert.NoError(t, err)
+		network, err := net.NewNetwork(context.Background(), []net.Option{
+			net.WithHost(host),
+			net.Params(&netParams),
+			net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
+				return nil
+			}),
+			net.Datastore(mock.NewMapDatastore()),
+			net.MaxMessageSize(repo.DefaultMaxMessageSize),
+		}...)
+		assert.NoError(t, err)
+
+		testHarness, err := harness.NewTestHarness(harness.DefaultOptions(), harness.Params(&netParams), harness.Pregenerate(0))
+		assert.NoError(t, err)
+		if i == 0 {
+			assert.NoError(t, testHarness.GenerateBlocks(10))
+		}
+
+		service, err := NewChainService(context.Background(), testHarness.Blockchain().GetBlockByID, testHarness.Blockchain(), network, testHarness.Blockchain().Params())
+		assert.NoError(t, err)
+		services = append(services, service)
+		hosts = append(hosts, host.ID())
+	}
+
+	assert.NoError(t, mn.LinkAll())
+	assert.NoError(t, mn.ConnectAllButSelf())
+
+	commitment, err := services[0].chain.SnapshotCommitment(15)
+	assert.NoError(t, err)
+
+	manifest, err := services[1].GetSnapshotManifest(hosts[0], commitment)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), manifest.Height)
+	assert.Equal(t, commitment, manifest.Commitment())
+
+	for i, h := range manifest.Chunks {
+		chunk, err := services[1].GetSnapshotChunk(hosts[0], commitment, uint32(i))
+		assert.NoError(t, err)
+		assert.Equal(t, h, types.NewIDFromData(chunk))
+	}
+
+	_, err = services[1].GetSnapshotChunk(hosts[0], commitment, uint32(len(manifest.Chunks)))
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = services[0].GetSnapshotManifest(hosts[1], commitment)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
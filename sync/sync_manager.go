@@ -14,6 +14,7 @@ import (
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -30,6 +31,11 @@ const (
 	evaluationWindow    = 5000
 )
 
+// errForkBelowTip is returned when a peer's headers do not build on the
+// tip of the chain, which happens when the peer follows a branch which
+// forked below the tip.
+var errForkBelowTip = errors.New("headers do not build on the chain tip")
+
 // SyncManager is responsible for trustlessly syncing the blockchain
 // to the tip of the chain.
 type SyncManager struct {
@@ -47,6 +53,7 @@ type SyncManager struct {
 	behavorFlag     blockchain.BehaviorFlags
 	proofCache      *blockchain.ProofCache
 	sigCache        *blockchain.SigCache
+	snapshotSync    bool
 	callback        func()
 	quit            chan struct{}
 }
@@ -66,6 +73,10 @@ type SyncManagerConfig struct {
 	ProofCache        *blockchain.ProofCache
 	SigCache          *blockchain.SigCache
 	IsCurrentCallback func()
+
+	// SnapshotSync starts a new chain from the snapshot committed to by
+	// the latest checkpoint which commits to a snapshot.
+	SnapshotSync bool
 }
 
 // NewSyncManager returns a new initialized SyncManager
@@ -79,6 +90,7 @@ func NewSyncManager(cfg *SyncManagerConfig) *SyncManager {
 		consensuChooser: cfg.Chooser,
 		proofCache:      cfg.ProofCache,
 		sigCache:        cfg.SigCache,
+		snapshotSync:    cfg.SnapshotSync,
 		buckets:         make(map[types.ID][]peer.ID),
 		syncMtx:         sync.Mutex{},
 		bucketMtx:       sync.RWMutex{},
@@ -105,8 +117,17 @@ func (sm *SyncManager) Start() {
 
 	_, startheight, _ := sm.chain.BestBlock()
 
+	// Load a snapshot rather than syncing from genesis if we were
+	// configured to and the chain is new.
+	if sm.snapshotSync && startheight == 0 {
+		if err := sm.syncSnapshot(); err != nil {
+			return
+		}
+		_, startheight, _ = sm.chain.BestBlock()
+	}
+
 	// Sync up to the checkpoints if we're not already past them.
-	if len(sm.params.Checkpoints) > 0 && startheight < sm.params.Checkpoints[len(sm.params.Checkpoints)-1].Height {
+	if checkpoints := sm.chain.Checkpoints(); len(checkpoints) > 0 && startheight < checkpoints[len(checkpoints)-1].Height {
 		sm.syncToCheckpoints(startheight)
 	}
 
@@ -161,8 +182,11 @@ syncLoop:
 			// We'll just sync up to this height.
 			for blockID, p := range blockMap {
 				err := sm.syncBlocks(p, height+1, height+lookaheadSize, bestID, blockID, sm.behavorFlag)
+				if errors.Is(err, errForkBelowTip) {
+					err = sm.reorganize(p, height)
+				}
 				if err != nil {
-					log.Debugf("Error syncing blocks. Peer: %s, Our Height: %d, Sync To: %d, Err: %s", p, height, height+lookaheadSize, err)
+					log.Debugw("Error syncing blocks", repo.LogFieldPeerID, p, repo.LogFieldHeight, height, "sync_to", height+lookaheadSize, repo.LogFieldError, err)
 				}
 				break
 			}
@@ -183,7 +207,7 @@ syncLoop:
 				for _, p := range blockMap {
 					err := sm.syncBlocks(p, height+1, forkHeight, bestID, forkBlock, sm.behavorFlag)
 					if err != nil {
-						log.Debugf("Error syncing blocks. Peer: %s, Err: %s", p, err)
+						log.Debugw("Error syncing blocks", repo.LogFieldPeerID, p, repo.LogFieldError, err)
 						continue syncLoop
 					}
 					break
@@ -205,7 +229,7 @@ syncLoop:
 				}
 				blks, err := sm.downloadEvalWindow(p, forkHeight+1)
 				if err != nil {
-					log.Debugf("Sync peer failed to serve evaluation window. Banning. Peer: %s", p)
+					log.Debugw("Sync peer failed to serve evaluation window. Banning.", repo.LogFieldPeerID, p)
 					sm.network.IncreaseBanscore(p, 101, 0)
 					continue syncLoop
 				}
@@ -214,7 +238,7 @@ syncLoop:
 				// Step four is to compute the chain score for each side of the fork.
 				score, err := sm.chain.CalcChainScore(blks, sm.behavorFlag)
 				if err != nil {
-					log.Debugf("Sync peer failed to serve valid evaluation window. Banning. Peer: %s", p)
+					log.Debugw("Sync peer failed to serve valid evaluation window. Banning.", repo.LogFieldPeerID, p)
 					sm.network.IncreaseBanscore(p, 101, 0)
 					continue syncLoop
 				}
@@ -279,8 +303,11 @@ syncLoop:
 			// Finally sync to the best fork.
 			currentID, height, _ := sm.chain.BestBlock()
 			err = sm.syncBlocks(blockMap[bestID], height+1, syncTo[bestID].Header.Height, currentID, syncTo[bestID].ID(), sm.behavorFlag)
+			if errors.Is(err, errForkBelowTip) {
+				err = sm.reorganize(blockMap[bestID], height)
+			}
 			if err != nil {
-				log.Debugf("Error syncing blocks. Peer: %s, Err: %s", blockMap[bestID], err)
+				log.Debugw("Error syncing blocks", repo.LogFieldPeerID, blockMap[bestID], repo.LogFieldError, err)
 				continue syncLoop
 			}
 		}
@@ -501,14 +528,12 @@ func (sm *SyncManager) populatePeerBuckets() error {
 
 func (sm *SyncManager) syncToCheckpoints(currentHeight uint32) {
 	startHeight := currentHeight + 1
-	parent := sm.params.GenesisBlock.ID()
-	for z, checkpoint := range sm.params.Checkpoints {
-		if currentHeight > checkpoint.Height {
+	checkpoints := sm.chain.Checkpoints()
+	for z, checkpoint := range checkpoints {
+		if currentHeight >= checkpoint.Height {
 			continue
 		}
-		if z > 0 {
-			parent = sm.params.Checkpoints[z-1].BlockID
-		}
+		parent, _, _ := sm.chain.BestBlock()
 		for {
 			peers := sm.syncPeers()
 			if len(peers) == 0 {
@@ -516,17 +541,93 @@ func (sm *SyncManager) syncToCheckpoints(currentHeight uint32) {
 				continue
 			}
 			p := peers[rand.Intn(len(peers))]
-			err := sm.syncBlocks(p, startHeight, checkpoint.Height, parent, checkpoint.BlockID, blockchain.BFFastAdd)
+			err := sm.syncBlocks(p, startHeight, checkpoint.Height, parent, checkpoint.BlockID, blockchain.BFNone)
 			if err != nil {
-				log.Debugf("Error syncing checkpoints. Peer: %s, Err: %s", p, err)
+				log.Debugw("Error syncing checkpoints", repo.LogFieldPeerID, p, repo.LogFieldError, err)
 				continue
 			}
 			break
 		}
+		if checkpoint.Timestamp != 0 {
+			log.Infof("Synced to checkpoint %d of %d at height %d (%s)", z+1, len(checkpoints),
+				checkpoint.Height, time.Unix(checkpoint.Timestamp, 0).UTC().Format(time.RFC3339))
+		} else {
+			log.Infof("Synced to checkpoint %d of %d at height %d", z+1, len(checkpoints), checkpoint.Height)
+		}
 		startHeight = checkpoint.Height + 1
 	}
 }
 
+// syncSnapshot loads the snapshot committed to by the latest checkpoint
+// which commits to a snapshot. The checkpointed header is fetched from a
+// peer and the manifest and chunks are checked against the commitment in
+// the header, so any peer may serve them. This returns an error only if
+// the sync manager was closed.
+func (sm *SyncManager) syncSnapshot() error {
+	var (
+		checkpoints = sm.chain.Checkpoints()
+		checkpoint  params.Checkpoint
+		found       bool
+	)
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		if _, ok := blockchain.SnapshotCommitmentHeight(sm.params, checkpoints[i].Height); ok {
+			checkpoint, found = checkpoints[i], true
+			break
+		}
+	}
+	if !found {
+		log.Warn("No checkpoint commits to a snapshot. Syncing from genesis.")
+		return nil
+	}
+
+	for {
+		select {
+		case <-sm.quit:
+			return errors.New("sync manager closed")
+		default:
+		}
+		peers := sm.syncPeers()
+		if len(peers) == 0 {
+			time.Sleep(time.Second * 5)
+			continue
+		}
+		p := peers[rand.Intn(len(peers))]
+		blk, err := sm.chainService.GetBlock(p, checkpoint.BlockID)
+		if err != nil {
+			log.Debugw("Error fetching snapshot commitment block", repo.LogFieldPeerID, p, repo.LogFieldError, err)
+			continue
+		}
+		commitment := types.NewID(blk.Header.SnapshotCommitment)
+		manifest, err := sm.chainService.GetSnapshotManifest(p, commitment)
+		if err != nil {
+			log.Debugw("Error fetching snapshot manifest", repo.LogFieldPeerID, p, repo.LogFieldError, err)
+			continue
+		}
+
+		// Each chunk is fetched from the first peer which serves it.
+		fetchChunk := func(index int) ([]byte, error) {
+			var err error
+			for _, p := range append([]peer.ID{p}, sm.syncPeers()...) {
+				var chunk []byte
+				chunk, err = sm.chainService.GetSnapshotChunk(p, commitment, uint32(index))
+				if err == nil {
+					return chunk, nil
+				}
+			}
+			return nil, err
+		}
+		if err := sm.chain.LoadSnapshot(blk.Header, manifest, fetchChunk); err != nil {
+			log.Debugw("Error loading snapshot", repo.LogFieldPeerID, p, repo.LogFieldError, err)
+			if errors.Is(err, blockchain.ErrSnapshotChainNotNew) {
+				return nil
+			}
+			continue
+		}
+		log.Infof("Loaded snapshot at height %d", manifest.Height)
+		return nil
+	}
+}
+
 func (sm *SyncManager) downloadEvalWindow(p peer.ID, fromHeight uint32) ([]*blocks.Block, error) {
 	headers, err := sm.downloadHeaders(p, fromHeight, fromHeight+evaluationWindow-1)
 	if err != nil {
@@ -560,8 +661,9 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 	}
 
 	if types.NewID(headers[0].Parent).Compare(parent) != 0 {
-		sm.network.IncreaseBanscore(p, 101, 0)
-		return fmt.Errorf("peer %s returned frist header with unexpected parent ID", p)
+		// The peer may be following a branch which forked below
+		// our tip. The caller checks this before banning the peer.
+		return fmt.Errorf("peer %s returned first header with unexpected parent ID: %w", p, errForkBelowTip)
 	}
 	for i := len(headers) - 1; i > 0; i-- {
 		if types.NewID(headers[i].Parent).Compare(headers[i-1].ID()) != 0 {
@@ -570,6 +672,16 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 		}
 	}
 
+	// If the headers lead up to a checkpoint the chain can skip
+	// validating the signatures and proofs of their blocks.
+	checkpointed := false
+	if checkpoint, ok := sm.chain.CheckpointAtHeight(toHeight); ok && checkpoint.BlockID == expectedID {
+		if err := sm.chain.AddCheckpointHeaders(headers); err != nil {
+			return fmt.Errorf("peer %s checkpoint headers error %s", p, err)
+		}
+		checkpointed = true
+	}
+
 	var (
 		blks      []*blocks.Block
 		start     = headers[0].Height
@@ -612,7 +724,7 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 		//
 		// The proofs and signatures are added to the proof and sig caches so the
 		// blockchain will not double validate them.
-		if !sm.behavorFlag.HasFlag(blockchain.BFNoValidation) && !sm.behavorFlag.HasFlag(blockchain.BFFastAdd) {
+		if !checkpointed && !sm.behavorFlag.HasFlag(blockchain.BFNoValidation) && !sm.behavorFlag.HasFlag(blockchain.BFFastAdd) {
 			toValidate := make([]*transactions.Transaction, 0, len(blks))
 			for _, blk := range blks {
 				toValidate = append(toValidate, blk.Transactions...)
@@ -625,7 +737,7 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 			defer close(sigChan)
 
 			go func() {
-				proofChan <- blockchain.NewProofValidator(sm.proofCache).Validate(toValidate)
+				proofChan <- blockchain.NewProofValidator(sm.proofCache, sm.chain.Prover(), sm.chain.Params()).ValidateBlocks(blks)
 			}()
 			go func() {
 				sigChan <- blockchain.NewSigValidator(sm.sigCache).Validate(toValidate)
@@ -652,6 +764,102 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 	return nil
 }
 
+// reorganize switches the chain to the branch followed by the peer when
+// the peer's branch forked below the tip of the chain. The fork point is
+// found by comparing the peer's block IDs with ours, no further back than
+// the max reorg depth, and the peer's blocks from the fork point up to one
+// block past our tip are connected in place of ours.
+func (sm *SyncManager) reorganize(p peer.ID, tipHeight uint32) error {
+	agrees := func(height uint32) (bool, error) {
+		theirs, err := sm.chainService.GetBlockID(p, height)
+		if err != nil {
+			return false, err
+		}
+		ours, err := sm.chain.GetBlockIDByHeight(height)
+		if err != nil {
+			return false, err
+		}
+		return theirs == ours, nil
+	}
+
+	// The chains disagree at the tip. Search for the highest
+	// height at which they agree.
+	low := uint32(0)
+	if tipHeight > blockchain.MaxReorgDepth {
+		low = tipHeight - blockchain.MaxReorgDepth
+	}
+	ok, err := agrees(low)
+	if err != nil {
+		sm.network.IncreaseBanscore(p, 0, 20)
+		return err
+	}
+	if !ok {
+		sm.network.IncreaseBanscore(p, 101, 0)
+		return fmt.Errorf("peer %s branch forks deeper than max reorg depth", p)
+	}
+	high := tipHeight
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		ok, err := agrees(mid)
+		if err != nil {
+			sm.network.IncreaseBanscore(p, 0, 20)
+			return err
+		}
+		if ok {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	forkID, err := sm.chain.GetBlockIDByHeight(low)
+	if err != nil {
+		return err
+	}
+
+	headers, err := sm.downloadHeaders(p, low+1, tipHeight+1)
+	if err != nil {
+		sm.network.IncreaseBanscore(p, 0, 20)
+		return err
+	}
+	txs, err := sm.downloadBlockTxs(p, low+1, tipHeight+1)
+	if err != nil {
+		sm.network.IncreaseBanscore(p, 0, 20)
+		return fmt.Errorf("peer %s block download error %s", p, err)
+	}
+	if len(headers) != int(tipHeight-low+1) || len(txs) != len(headers) {
+		sm.network.IncreaseBanscore(p, 101, 0)
+		return fmt.Errorf("peer %s returned unexpected number of blocks", p)
+	}
+	blks := make([]*blocks.Block, 0, len(headers))
+	prev := forkID
+	for i, header := range headers {
+		if types.NewID(header.Parent).Compare(prev) != 0 {
+			sm.network.IncreaseBanscore(p, 101, 0)
+			return fmt.Errorf("peer %s returned headers that do not connect", p)
+		}
+		blk := &blocks.Block{
+			Header:       header,
+			Transactions: txs[i].Transactions,
+		}
+		merkleRoot := blockchain.TransactionsMerkleRoot(blk.Transactions)
+		if !bytes.Equal(merkleRoot[:], header.TxRoot) {
+			sm.network.IncreaseBanscore(p, 101, 0)
+			return fmt.Errorf("peer %s invalid block download merkle root", p)
+		}
+		blks = append(blks, blk)
+		prev = header.ID()
+	}
+
+	log.Infow("Reorganizing chain", repo.LogFieldPeerID, p, "fork_height", low, "disconnect", tipHeight-low, "connect", len(blks))
+	if err := sm.chain.ReorganizeChain(blks, sm.behavorFlag); err != nil {
+		if !errors.Is(err, blockchain.ErrChainHalted) {
+			sm.network.IncreaseBanscore(p, 101, 0)
+		}
+		return fmt.Errorf("error reorganizing chain to branch from peer %s: %s", p, err)
+	}
+	return nil
+}
+
 func (sm *SyncManager) findForkPoint(currentHeight, toHeight uint32, blockMap map[types.ID]peer.ID) (types.ID, uint32, error) {
 	type resp struct {
 		p       peer.ID
@@ -78,10 +78,10 @@ func makeMockNode(mn mocknet.Mocknet, chain *blockchain.Blockchain) (*mockNode,
 	network, err := net.NewNetwork(context.Background(), []net.Option{
 		net.WithHost(host),
 		net.Params(&params.RegestParams),
-		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+		net.BlockValidator(func(context.Context, *blocks.XThinnerBlock, peer.ID) error {
 			return nil
 		}),
-		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+		net.MempoolValidator(func(ctx context.Context, transaction *transactions.Transaction) error {
 			return nil
 		}),
 		net.Datastore(ds),
@@ -239,14 +239,67 @@ func TestSyncWithNodesAtDifferentHeights(t *testing.T) {
 	node.network.Close()
 }
 
+func TestSyncReorganize(t *testing.T) {
+	net, err := generateMockNetwork(20, 1000)
+	assert.NoError(t, err)
+
+	// The node connects a block at height 1001 which the
+	// rest of the network does not build on.
+	harness2, err := net.harness.Clone()
+	assert.NoError(t, err)
+	assert.NoError(t, harness2.GenerateBlocks(1))
+	assert.NoError(t, net.harness.GenerateBlocks(2))
+
+	staleID, err := harness2.Blockchain().GetBlockIDByHeight(1001)
+	assert.NoError(t, err)
+	bestID, err := net.harness.Blockchain().GetBlockIDByHeight(1001)
+	assert.NoError(t, err)
+	assert.NotEqual(t, staleID, bestID)
+
+	chain := harness2.Blockchain()
+	node, err := makeMockNode(net.mn, chain)
+	assert.NoError(t, err)
+
+	manager := NewSyncManager(&SyncManagerConfig{
+		Ctx:               context.Background(),
+		Chain:             chain,
+		Network:           node.network,
+		Params:            chain.Params(),
+		CS:                node.service,
+		Chooser:           nil,
+		IsCurrentCallback: nil,
+		ProofCache:        blockchain.NewProofCache(100000),
+		SigCache:          blockchain.NewSigCache(1000000),
+	})
+	manager.behavorFlag = blockchain.BFFastAdd
+
+	assert.NoError(t, net.mn.LinkAll())
+	assert.NoError(t, net.mn.ConnectAllButSelf())
+
+	ch := make(chan struct{})
+	go func() {
+		manager.Start()
+		close(ch)
+	}()
+	select {
+	case <-ch:
+	case <-time.After(time.Second * 10):
+		t.Fatal("sync timed out")
+	}
+
+	block, height, _ := chain.BestBlock()
+	block2, height2, _ := net.harness.Blockchain().BestBlock()
+	assert.Equal(t, block2, block)
+	assert.Equal(t, height2, height)
+	assert.False(t, chain.HasBlock(staleID))
+	node.network.Close()
+}
+
 func TestSync(t *testing.T) {
 	net, err := generateMockNetwork(20, 25000)
 	assert.NoError(t, err)
 
 	t.Run("sync when all nodes agree", func(t *testing.T) {
-		chain, err := blockchain.NewBlockchain(blockchain.DefaultOptions(), blockchain.Params(net.harness.Blockchain().Params()))
-		assert.NoError(t, err)
-
 		b100, err := net.harness.Blockchain().GetBlockByHeight(100)
 		assert.NoError(t, err)
 		b200, err := net.harness.Blockchain().GetBlockByHeight(200)
@@ -254,7 +307,9 @@ func TestSync(t *testing.T) {
 		b300, err := net.harness.Blockchain().GetBlockByHeight(300)
 		assert.NoError(t, err)
 
-		chain.Params().Checkpoints = []params.Checkpoint{
+		// The checkpoints are passed to the chain rather than set in the
+		// params. The sync manager must still sync up to them.
+		checkpoints := []params.Checkpoint{
 			{
 				BlockID: b100.ID(),
 				Height:  100,
@@ -268,6 +323,8 @@ func TestSync(t *testing.T) {
 				Height:  300,
 			},
 		}
+		chain, err := blockchain.NewBlockchain(blockchain.DefaultOptions(), blockchain.Params(net.harness.Blockchain().Params()), blockchain.Checkpoints(checkpoints))
+		assert.NoError(t, err)
 
 		node, err := makeMockNode(net.mn, chain)
 		assert.NoError(t, err)
@@ -304,7 +361,6 @@ func TestSync(t *testing.T) {
 		assert.Equal(t, block2, block)
 		assert.Equal(t, height2, height)
 		node.network.Close()
-		chain.Params().Checkpoints = nil
 	})
 
 	t.Run("sync with chain fork", func(t *testing.T) {
@@ -0,0 +1,63 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"path"
+
+	"github.com/project-illium/ilxd/repo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// tracer creates the spans for the block and transaction pipelines that
+// are orchestrated by the server. Until tracing is set up it uses the
+// global no-op provider so the spans cost next to nothing.
+var tracer = otel.Tracer("github.com/project-illium/ilxd")
+
+// setupTracing installs a global tracer provider which exports spans as
+// JSON to the traces file in the log directory. The returned function
+// flushes any buffered spans and must be called on shutdown.
+func setupTracing(logDir string, sampleRate float64) (func(context.Context) error, error) {
+	traceRotator := &lumberjack.Logger{
+		Filename:   path.Join(logDir, repo.DefaultTraceFilename),
+		MaxSize:    10, // Megabytes
+		MaxAge:     30, // Days
+		MaxBackups: 3,
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(traceRotator))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "ilxd"))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		if err := provider.Shutdown(ctx); err != nil {
+			return err
+		}
+		return traceRotator.Close()
+	}, nil
+}
+
+// endSpan records the error, if any, on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
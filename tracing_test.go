@@ -0,0 +1,41 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/project-illium/ilxd/repo"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetupTracing(t *testing.T) {
+	dir := t.TempDir()
+	shutdown, err := setupTracing(dir, 1)
+	assert.NoError(t, err)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, parent := tracer.Start(context.Background(), "server.ProcessBlock")
+	_, child := tracer.Start(ctx, "blockchain.ConnectBlock")
+	endSpan(child, errors.New("block does not connect"))
+	parent.End()
+
+	assert.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(path.Join(dir, repo.DefaultTraceFilename))
+	assert.NoError(t, err)
+	out := string(data)
+	assert.True(t, strings.Contains(out, "server.ProcessBlock"))
+	assert.True(t, strings.Contains(out, "blockchain.ConnectBlock"))
+	assert.True(t, strings.Contains(out, "block does not connect"))
+	assert.True(t, strings.Contains(out, parent.SpanContext().TraceID().String()))
+}
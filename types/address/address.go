@@ -0,0 +1,164 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+// Package address implements the encoding of illium addresses.
+//
+// An address commits to the hash of the locking script which controls the
+// coins along with the curve25519 view key used to encrypt the output notes.
+// It is serialized using bech32m with a network specific human-readable prefix:
+//
+//	bech32m(prefix, version || convertbits(scriptHash || viewKey))
+package address
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+)
+
+const (
+	// Version is the current address version.
+	Version = 1
+
+	// ScriptHashLen is the length of the locking script hash.
+	ScriptHashLen = 32
+
+	// ViewKeyLen is the length of the serialized view key.
+	ViewKeyLen = 32
+)
+
+var (
+	// ErrInvalidPrefix is returned when an address does not have the
+	// prefix of the expected network.
+	ErrInvalidPrefix = errors.New("address prefix does not match network")
+
+	// ErrInvalidVersion is returned when decoding an address with an
+	// unknown version.
+	ErrInvalidVersion = errors.New("unknown address version")
+
+	// ErrInvalidLength is returned when the decoded address data is the
+	// wrong length.
+	ErrInvalidLength = errors.New("invalid address length")
+
+	// ErrInvalidEncoding is returned when an address uses the original
+	// bech32 checksum rather than bech32m.
+	ErrInvalidEncoding = errors.New("address is not bech32m encoded")
+)
+
+// Address is a decoded illium address.
+type Address struct {
+	Prefix     string
+	Version    byte
+	ScriptHash types.ID
+	ViewKey    crypto.PubKey
+}
+
+// New returns a new Address for the given network.
+func New(scriptHash types.ID, viewKey crypto.PubKey, params *params.NetworkParams) (*Address, error) {
+	if _, ok := viewKey.(*icrypto.Curve25519PublicKey); !ok {
+		return nil, errors.New("viewKey must be of type Curve25519PublicKey")
+	}
+	return &Address{
+		Prefix:     params.AddressPrefix,
+		Version:    Version,
+		ScriptHash: scriptHash,
+		ViewKey:    viewKey,
+	}, nil
+}
+
+// Encode returns the bech32m encoding of the address.
+func (a *Address) Encode() (string, error) {
+	keyBytes, err := a.ViewKey.Raw()
+	if err != nil {
+		return "", err
+	}
+	if len(keyBytes) != ViewKeyLen {
+		return "", ErrInvalidLength
+	}
+	converted, err := bech32.ConvertBits(append(a.ScriptHash.Bytes(), keyBytes...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.EncodeM(a.Prefix, append([]byte{a.Version}, converted...))
+}
+
+// String returns the bech32m encoding of the address or an empty
+// string if the address cannot be encoded.
+func (a *Address) String() string {
+	s, err := a.Encode()
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// Decode decodes the address without checking the network prefix.
+func Decode(addr string) (*Address, error) {
+	prefix, data, err := bech32.DecodeNoLimit(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// DecodeNoLimit accepts both checksum variants. Re-encoding
+	// with bech32m will only reproduce the same string if the
+	// address was bech32m encoded.
+	reencoded, err := bech32.EncodeM(prefix, data)
+	if err != nil {
+		return nil, err
+	}
+	if reencoded != strings.ToLower(addr) {
+		return nil, ErrInvalidEncoding
+	}
+
+	if len(data) < 1 {
+		return nil, ErrInvalidLength
+	}
+	if data[0] != Version {
+		return nil, ErrInvalidVersion
+	}
+
+	regrouped, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(regrouped) != ScriptHashLen+ViewKeyLen {
+		return nil, ErrInvalidLength
+	}
+
+	viewKey, err := icrypto.UnmarshalCurve25519PublicKey(regrouped[ScriptHashLen:])
+	if err != nil {
+		return nil, err
+	}
+	return &Address{
+		Prefix:     prefix,
+		Version:    data[0],
+		ScriptHash: types.NewID(regrouped[:ScriptHashLen]),
+		ViewKey:    viewKey,
+	}, nil
+}
+
+// Parse decodes the address and checks that it belongs to the
+// provided network.
+func Parse(addr string, params *params.NetworkParams) (*Address, error) {
+	a, err := Decode(addr)
+	if err != nil {
+		return nil, err
+	}
+	if a.Prefix != params.AddressPrefix {
+		return nil, ErrInvalidPrefix
+	}
+	return a, nil
+}
+
+// Validate returns an error if the address is not a valid
+// address for the provided network.
+func Validate(addr string, params *params.NetworkParams) error {
+	_, err := Parse(addr, params)
+	return err
+}
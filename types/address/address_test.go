@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package address
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress(t *testing.T) {
+	_, viewKey, err := icrypto.GenerateCurve25519Key(rand.Reader)
+	assert.NoError(t, err)
+
+	var scriptHash types.ID
+	rand.Read(scriptHash[:])
+
+	addr, err := New(scriptHash, viewKey, &params.MainnetParams)
+	assert.NoError(t, err)
+
+	encoded, err := addr.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, encoded, addr.String())
+	assert.Equal(t, "il1", encoded[:3])
+
+	decoded, err := Parse(encoded, &params.MainnetParams)
+	assert.NoError(t, err)
+	assert.Equal(t, params.MainnetParams.AddressPrefix, decoded.Prefix)
+	assert.Equal(t, byte(Version), decoded.Version)
+	assert.Equal(t, scriptHash, decoded.ScriptHash)
+	assert.True(t, viewKey.Equals(decoded.ViewKey))
+
+	// Wrong network
+	assert.ErrorIs(t, Validate(encoded, &params.RegestParams), ErrInvalidPrefix)
+	_, err = Decode(encoded)
+	assert.NoError(t, err)
+
+	// Corrupted checksum
+	corrupted := []byte(encoded)
+	if corrupted[len(corrupted)-1] == 'q' {
+		corrupted[len(corrupted)-1] = 'p'
+	} else {
+		corrupted[len(corrupted)-1] = 'q'
+	}
+	assert.Error(t, Validate(string(corrupted), &params.MainnetParams))
+
+	// Original bech32 checksum
+	keyBytes, err := viewKey.Raw()
+	assert.NoError(t, err)
+	converted, err := bech32.ConvertBits(append(scriptHash.Bytes(), keyBytes...), 8, 5, true)
+	assert.NoError(t, err)
+	bech32Addr, err := bech32.Encode(params.MainnetParams.AddressPrefix, append([]byte{Version}, converted...))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, Validate(bech32Addr, &params.MainnetParams), ErrInvalidEncoding)
+
+	// Unknown version
+	v2Addr, err := bech32.EncodeM(params.MainnetParams.AddressPrefix, append([]byte{2}, converted...))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, Validate(v2Addr, &params.MainnetParams), ErrInvalidVersion)
+
+	// Truncated data
+	short, err := bech32.EncodeM(params.MainnetParams.AddressPrefix, append([]byte{Version}, converted[:len(converted)-10]...))
+	assert.NoError(t, err)
+	assert.Error(t, Validate(short, &params.MainnetParams))
+}
@@ -4,7 +4,22 @@
 
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strconv"
+)
+
+var (
+	// ErrAmountOverflow is returned when the result of an operation on
+	// amounts is larger than the maximum amount.
+	ErrAmountOverflow = errors.New("amount overflow")
+
+	// ErrAmountUnderflow is returned when subtracting a larger amount
+	// from a smaller one.
+	ErrAmountUnderflow = errors.New("amount underflow")
+)
 
 // Amount represents the base illium monetary unit (to be named later).
 // The total number of coins issued is not expected to overflow an uint64
@@ -17,3 +32,68 @@ func (a Amount) ToBytes() []byte {
 	binary.BigEndian.PutUint64(b, uint64(a))
 	return b
 }
+
+// String returns the amount as a base 10 string of base units.
+func (a Amount) String() string {
+	return strconv.FormatUint(uint64(a), 10)
+}
+
+// FromString sets the amount from a base 10 string of base units.
+func (a *Amount) FromString(s string) error {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*a = Amount(n)
+	return nil
+}
+
+// MarshalText returns the amount as a base 10 string.
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText sets the amount from a base 10 string.
+func (a *Amount) UnmarshalText(text []byte) error {
+	return a.FromString(string(text))
+}
+
+// MarshalJSON encodes the amount as a JSON number. This takes
+// precedence over MarshalText so that existing JSON output is
+// unchanged.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON decodes the amount from either a JSON number or
+// a JSON string.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	return a.FromString(unquoteJSONString(data))
+}
+
+// Add returns a + b or ErrAmountOverflow if the result overflows.
+func (a Amount) Add(b Amount) (Amount, error) {
+	sum, carry := bits.Add64(uint64(a), uint64(b), 0)
+	if carry != 0 {
+		return 0, ErrAmountOverflow
+	}
+	return Amount(sum), nil
+}
+
+// Sub returns a - b or ErrAmountUnderflow if b is larger than a.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	diff, borrow := bits.Sub64(uint64(a), uint64(b), 0)
+	if borrow != 0 {
+		return 0, ErrAmountUnderflow
+	}
+	return Amount(diff), nil
+}
+
+// Mul returns a * b or ErrAmountOverflow if the result overflows.
+func (a Amount) Mul(b Amount) (Amount, error) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 {
+		return 0, ErrAmountOverflow
+	}
+	return Amount(lo), nil
+}
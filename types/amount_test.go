@@ -0,0 +1,77 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmount_Add(t *testing.T) {
+	sum, err := Amount(5).Add(7)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(12), sum)
+
+	sum, err = Amount(math.MaxUint64 - 1).Add(1)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(math.MaxUint64), sum)
+
+	_, err = Amount(math.MaxUint64).Add(1)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestAmount_Sub(t *testing.T) {
+	diff, err := Amount(7).Sub(5)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(2), diff)
+
+	diff, err = Amount(5).Sub(5)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(0), diff)
+
+	_, err = Amount(5).Sub(7)
+	assert.ErrorIs(t, err, ErrAmountUnderflow)
+}
+
+func TestAmount_Mul(t *testing.T) {
+	prod, err := Amount(6).Mul(7)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(42), prod)
+
+	prod, err = Amount(math.MaxUint64).Mul(0)
+	assert.NoError(t, err)
+	assert.Equal(t, Amount(0), prod)
+
+	_, err = Amount(math.MaxUint64 / 2).Mul(3)
+	assert.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestAmountText(t *testing.T) {
+	a := Amount(123456789)
+	assert.Equal(t, "123456789", a.String())
+
+	text, err := a.MarshalText()
+	assert.NoError(t, err)
+	var a2 Amount
+	assert.NoError(t, a2.UnmarshalText(text))
+	assert.Equal(t, a, a2)
+
+	ser, err := json.Marshal(struct {
+		Amount Amount `json:"amount"`
+	}{a})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":123456789}`, string(ser))
+
+	var a3 Amount
+	assert.NoError(t, json.Unmarshal([]byte(`"42"`), &a3))
+	assert.Equal(t, Amount(42), a3)
+	assert.NoError(t, json.Unmarshal([]byte(`43`), &a3))
+	assert.Equal(t, Amount(43), a3)
+
+	assert.Error(t, a3.FromString("-1"))
+}
@@ -17,13 +17,15 @@ var _ types.Serializable = (*BlockHeader)(nil)
 var _ types.Serializable = (*Block)(nil)
 
 type headerJSON struct {
-	Version     uint32             `json:"version"`
-	Height      uint32             `json:"height"`
-	Parent      types.HexEncodable `json:"parent"`
-	Timestamp   int64              `json:"timestamp"`
-	TxRoot      types.HexEncodable `json:"tx_root"`
-	Producer_ID types.HexEncodable `json:"producer_ID"`
-	Signature   types.HexEncodable `json:"signature"`
+	Version            uint32             `json:"version"`
+	Height             uint32             `json:"height"`
+	Parent             types.HexEncodable `json:"parent"`
+	Timestamp          int64              `json:"timestamp"`
+	TxRoot             types.HexEncodable `json:"tx_root"`
+	Producer_ID        types.HexEncodable `json:"producer_ID"`
+	Signature          types.HexEncodable `json:"signature"`
+	VrfProof           types.HexEncodable `json:"vrf_proof,omitempty"`
+	SnapshotCommitment types.HexEncodable `json:"snapshot_commitment,omitempty"`
 }
 
 type blockJSON struct {
@@ -40,12 +42,14 @@ func (h *BlockHeader) Serialize() ([]byte, error) {
 	return proto.Marshal(h)
 }
 
+// SerializedSize returns the size of the serialized header in bytes.
 func (h *BlockHeader) SerializedSize() (int, error) {
-	ser, err := proto.Marshal(h)
-	if err != nil {
-		return 0, err
-	}
-	return len(ser), nil
+	return proto.Size(h), nil
+}
+
+// Weight returns the weight of the header.
+func (h *BlockHeader) Weight() int {
+	return proto.Size(h)
 }
 
 func (h *BlockHeader) Deserialize(data []byte) error {
@@ -60,6 +64,8 @@ func (h *BlockHeader) Deserialize(data []byte) error {
 	h.TxRoot = newHeader.TxRoot
 	h.Parent = newHeader.Parent
 	h.Version = newHeader.Version
+	h.VrfProof = newHeader.VrfProof
+	h.SnapshotCommitment = newHeader.SnapshotCommitment
 	return nil
 }
 
@@ -77,13 +83,15 @@ func (h *BlockHeader) SigHash() ([]byte, error) {
 
 func (h *BlockHeader) MarshalJSON() ([]byte, error) {
 	header := &headerJSON{
-		Version:     h.Version,
-		Height:      h.Height,
-		Parent:      h.Parent,
-		Timestamp:   h.Timestamp,
-		TxRoot:      h.TxRoot,
-		Producer_ID: h.Producer_ID,
-		Signature:   h.Signature,
+		Version:            h.Version,
+		Height:             h.Height,
+		Parent:             h.Parent,
+		Timestamp:          h.Timestamp,
+		TxRoot:             h.TxRoot,
+		Producer_ID:        h.Producer_ID,
+		Signature:          h.Signature,
+		VrfProof:           h.VrfProof,
+		SnapshotCommitment: h.SnapshotCommitment,
 	}
 
 	return json.Marshal(header)
@@ -95,13 +103,15 @@ func (h *BlockHeader) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	*h = BlockHeader{
-		Version:     newHeader.Version,
-		Height:      newHeader.Height,
-		Parent:      newHeader.Parent,
-		Timestamp:   newHeader.Timestamp,
-		TxRoot:      newHeader.TxRoot,
-		Producer_ID: newHeader.Producer_ID,
-		Signature:   newHeader.Signature,
+		Version:            newHeader.Version,
+		Height:             newHeader.Height,
+		Parent:             newHeader.Parent,
+		Timestamp:          newHeader.Timestamp,
+		TxRoot:             newHeader.TxRoot,
+		Producer_ID:        newHeader.Producer_ID,
+		Signature:          newHeader.Signature,
+		VrfProof:           newHeader.VrfProof,
+		SnapshotCommitment: newHeader.SnapshotCommitment,
 	}
 	return nil
 }
@@ -148,12 +158,23 @@ func (b *Block) Serialize() ([]byte, error) {
 	return proto.Marshal(b)
 }
 
+// SerializedSize returns the size of the serialized block in bytes.
 func (b *Block) SerializedSize() (int, error) {
-	ser, err := proto.Marshal(b)
-	if err != nil {
-		return 0, err
+	return proto.Size(b), nil
+}
+
+// Weight returns the weight of the block. This is the sum of the
+// header and transaction weights and is the value compared against
+// the block size soft limit.
+func (b *Block) Weight() int {
+	weight := 0
+	if b.Header != nil {
+		weight += b.Header.Weight()
+	}
+	for _, tx := range b.Transactions {
+		weight += tx.Weight()
 	}
-	return len(ser), nil
+	return weight
 }
 
 func (b *Block) Deserialize(data []byte) error {
@@ -199,11 +220,7 @@ func (b *XThinnerBlock) Serialize() ([]byte, error) {
 }
 
 func (b *XThinnerBlock) SerializedSize() (int, error) {
-	ser, err := proto.Marshal(b)
-	if err != nil {
-		return 0, err
-	}
-	return len(ser), nil
+	return proto.Size(b), nil
 }
 
 func (b *XThinnerBlock) Deserialize(data []byte) error {
@@ -268,11 +285,7 @@ type compressedBlockJSON struct {
 }
 
 func (b *CompressedBlock) SerializedSize() (int, error) {
-	ser, err := proto.Marshal(b)
-	if err != nil {
-		return 0, err
-	}
-	return len(ser), nil
+	return proto.Size(b), nil
 }
 
 func (b *CompressedBlock) Deserialize(data []byte) error {
@@ -76,3 +76,23 @@ func TestJSONMarshalUnmarshal(t *testing.T) {
 
 	assert.Empty(t, deep.Equal(b, proto.Clone(&b2)))
 }
+
+func TestBlockWeight(t *testing.T) {
+	blk := params.RegestParams.GenesisBlock
+
+	ser, err := blk.Serialize()
+	assert.NoError(t, err)
+	size, err := blk.SerializedSize()
+	assert.NoError(t, err)
+	assert.Equal(t, len(ser), size)
+
+	weight := blk.Header.Weight()
+	for _, tx := range blk.Transactions {
+		txSer, err := tx.Serialize()
+		assert.NoError(t, err)
+		assert.Equal(t, len(txSer), tx.Weight())
+		weight += tx.Weight()
+	}
+	assert.Equal(t, weight, blk.Weight())
+	assert.LessOrEqual(t, blk.Weight(), size)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrUnknownHashVersion is returned when computing a nullifier or
+// commitment with a hash version that is not known.
+var ErrUnknownHashVersion = errors.New("unknown hash version")
+
+// HashVersion selects the encoding used when computing nullifiers and
+// output commitments.
+type HashVersion uint8
+
+const (
+	// HashVersionLegacy is the original encoding without a domain
+	// separation tag. This is the encoding currently used by consensus
+	// and the circuits.
+	HashVersionLegacy HashVersion = 0
+
+	// HashVersion1 prepends a domain separation tag to the list being
+	// committed. The tag is different for nullifiers and commitments
+	// so the two can never collide even if the rest of the preimage is
+	// the same.
+	HashVersion1 HashVersion = 1
+)
+
+const (
+	nullifierDomain  = "illium/nullifier"
+	commitmentDomain = "illium/commitment"
+)
+
+// NullifierDomainTag returns the domain separation tag used for nullifiers
+// with the given version. The tag is prepended to the nullifier preimage
+// as a lurk num. The legacy version has no tag.
+func NullifierDomainTag(version HashVersion) ([]byte, error) {
+	return domainTag(nullifierDomain, version)
+}
+
+// CommitmentDomainTag returns the domain separation tag used for output
+// commitments with the given version. The tag is prepended to the note
+// preimage as a lurk num. The legacy version has no tag.
+func CommitmentDomainTag(version HashVersion) ([]byte, error) {
+	return domainTag(commitmentDomain, version)
+}
+
+// domainTag returns the ascii encoding of <domain>/v<version>. The tag
+// is less than 31 bytes so it is always a valid field element.
+func domainTag(domain string, version HashVersion) ([]byte, error) {
+	switch version {
+	case HashVersionLegacy:
+		return nil, nil
+	case HashVersion1:
+		return []byte(fmt.Sprintf("%s/v%d", domain, version)), nil
+	}
+	return nil, ErrUnknownHashVersion
+}
+
+// withDomainTag prepends the tag, as a lurk num, to the lurk list
+// expression. If the tag is nil the expression is returned unchanged.
+func withDomainTag(tag []byte, listExpr string) (string, error) {
+	if tag == nil {
+		return listExpr, nil
+	}
+	if len(tag) > 31 {
+		return "", errors.New("domain tag exceeds max size")
+	}
+	return fmt.Sprintf("(cons %s %s)", new(big.Int).SetBytes(tag).String(), listExpr), nil
+}
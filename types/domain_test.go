@@ -0,0 +1,59 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainTags(t *testing.T) {
+	tag, err := NullifierDomainTag(HashVersionLegacy)
+	assert.NoError(t, err)
+	assert.Nil(t, tag)
+
+	ntag, err := NullifierDomainTag(HashVersion1)
+	assert.NoError(t, err)
+	ctag, err := CommitmentDomainTag(HashVersion1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, ntag, ctag)
+	assert.LessOrEqual(t, len(ctag), 31)
+
+	_, err = NullifierDomainTag(HashVersion(99))
+	assert.ErrorIs(t, err, ErrUnknownHashVersion)
+
+	expr, err := withDomainTag([]byte{0x01, 0x00}, "(cons 1 nil)")
+	assert.NoError(t, err)
+	assert.Equal(t, "(cons 256 (cons 1 nil))", expr)
+}
+
+func TestVersionedHashes(t *testing.T) {
+	var salt [32]byte
+	salt[0] = 0x05
+	scriptCommitment := make([]byte, 32)
+
+	legacy, err := CalculateNullifier(7, salt, scriptCommitment, []byte{0x01})
+	assert.NoError(t, err)
+	v0, err := CalculateNullifierWithVersion(HashVersionLegacy, 7, salt, scriptCommitment, []byte{0x01})
+	assert.NoError(t, err)
+	assert.Equal(t, legacy, v0)
+	v1, err := CalculateNullifierWithVersion(HashVersion1, 7, salt, scriptCommitment, []byte{0x01})
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacy, v1)
+
+	note := SpendNote{Amount: 1000, Salt: salt}
+	c, err := note.Commitment()
+	assert.NoError(t, err)
+	c0, err := note.CommitmentWithVersion(HashVersionLegacy)
+	assert.NoError(t, err)
+	assert.Equal(t, c, c0)
+	c1, err := note.CommitmentWithVersion(HashVersion1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, c, c1)
+
+	_, err = note.CommitmentWithVersion(HashVersion(99))
+	assert.ErrorIs(t, err, ErrUnknownHashVersion)
+}
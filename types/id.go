@@ -48,12 +48,9 @@ func (id *ID) SetBytes(data []byte) {
 	copy(id[:], data)
 }
 
-func (id *ID) MarshalJSON() ([]byte, error) {
-	return []byte(hex.EncodeToString(id[:])), nil
-}
-
-func (id *ID) UnmarshalJSON(data []byte) error {
-	i, err := NewIDFromString(string(data)) //nolint:staticcheck
+// FromString sets the ID from a hex encoded string.
+func (id *ID) FromString(s string) error {
+	i, err := NewIDFromString(s)
 	if err != nil {
 		return err
 	}
@@ -61,6 +58,25 @@ func (id *ID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText returns the hex encoded ID. This allows the ID to be
+// used directly in JSON configs, map keys, URL paths and log fields.
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText sets the ID from hex encoded text.
+func (id *ID) UnmarshalText(text []byte) error {
+	return id.FromString(string(text))
+}
+
+func (id *ID) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(id.String()), nil
+}
+
+func (id *ID) UnmarshalJSON(data []byte) error {
+	return id.FromString(unquoteJSONString(data))
+}
+
 func NewID(digest []byte) ID {
 	var sh ID
 	sh.SetBytes(digest)
@@ -87,3 +103,18 @@ func NewIDFromData(data []byte) ID {
 	id.SetBytes(hash)
 	return id
 }
+
+// marshalJSONString returns s as a JSON string. Hex and decimal strings
+// never need escaping.
+func marshalJSONString(s string) []byte {
+	return []byte(`"` + s + `"`)
+}
+
+// unquoteJSONString strips the quotes from a JSON string. Unquoted data
+// is returned as is for compatibility with the previous encoding.
+func unquoteJSONString(data []byte) string {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		return string(data[1 : len(data)-1])
+	}
+	return string(data)
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -30,3 +33,35 @@ func TestIDUnmarshalJSON(t *testing.T) {
 		t.Errorf("Expected %s, got %s", testSerializedID, id.String())
 	}
 }
+
+func TestIDMarshalText(t *testing.T) {
+	id, err := NewIDFromString(testSerializedID)
+	assert.NoError(t, err)
+
+	text, err := id.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, testSerializedID, string(text))
+
+	var id2 ID
+	assert.NoError(t, id2.UnmarshalText(text))
+	assert.Equal(t, id, id2)
+
+	s := struct {
+		ID   ID            `json:"id"`
+		Keys map[ID]string `json:"keys"`
+	}{
+		ID:   id,
+		Keys: map[ID]string{id: "a"},
+	}
+	ser, err := json.Marshal(&s)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"`+testSerializedID+`","keys":{"`+testSerializedID+`":"a"}}`, string(ser))
+
+	s2 := s
+	s2.ID = ID{}
+	s2.Keys = nil
+	assert.NoError(t, json.Unmarshal(ser, &s2))
+	assert.Equal(t, s, s2)
+
+	assert.Error(t, id2.FromString("zz"))
+}
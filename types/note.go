@@ -21,7 +21,20 @@ type SpendNote struct {
 
 // Commitment builds a Lurk list expression out of the note
 // data and returns the Lurk Commitment hash.
+//
+// This uses the legacy, untagged, encoding. It is equivalent to calling
+// CommitmentWithVersion with HashVersionLegacy.
 func (s *SpendNote) Commitment() (ID, error) {
+	return s.CommitmentWithVersion(HashVersionLegacy)
+}
+
+// CommitmentWithVersion returns the Lurk Commitment hash of the note
+// using the encoding selected by the hash version.
+func (s *SpendNote) CommitmentWithVersion(version HashVersion) (ID, error) {
+	tag, err := CommitmentDomainTag(version)
+	if err != nil {
+		return ID{}, err
+	}
 	elems := []any{
 		s.ScriptHash.Bytes(),
 		s.Amount.ToBytes(),
@@ -37,6 +50,10 @@ func (s *SpendNote) Commitment() (ID, error) {
 	if err != nil {
 		return ID{}, err
 	}
+	expr, err = withDomainTag(tag, expr)
+	if err != nil {
+		return ID{}, err
+	}
 	h, err := zk.LurkCommit(expr)
 	if err != nil {
 		return ID{}, err
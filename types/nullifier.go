@@ -33,12 +33,9 @@ func (n *Nullifier) SetBytes(data []byte) {
 	copy(n[:], data)
 }
 
-func (n *Nullifier) MarshalJSON() ([]byte, error) {
-	return []byte(hex.EncodeToString(n[:])), nil
-}
-
-func (n *Nullifier) UnmarshalJSON(data []byte) error {
-	i, err := NewNullifierFromString(string(data)) //nolint:staticcheck
+// FromString sets the nullifier from a hex encoded string.
+func (n *Nullifier) FromString(s string) error {
+	i, err := NewNullifierFromString(s)
 	if err != nil {
 		return err
 	}
@@ -46,6 +43,24 @@ func (n *Nullifier) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText returns the hex encoded nullifier.
+func (n Nullifier) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText sets the nullifier from hex encoded text.
+func (n *Nullifier) UnmarshalText(text []byte) error {
+	return n.FromString(string(text))
+}
+
+func (n *Nullifier) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(n.String()), nil
+}
+
+func (n *Nullifier) UnmarshalJSON(data []byte) error {
+	return n.FromString(unquoteJSONString(data))
+}
+
 func NewNullifier(b []byte) Nullifier {
 	var sh Nullifier
 	sh.SetBytes(b)
@@ -67,13 +82,30 @@ func NewNullifierFromString(n string) (Nullifier, error) {
 }
 
 // CalculateNullifier calculates and returns the nullifier for the given inputs.
+//
+// This uses the legacy, untagged, encoding. It is equivalent to calling
+// CalculateNullifierWithVersion with HashVersionLegacy.
 func CalculateNullifier(commitmentIndex uint64, salt [32]byte, scriptCommitment []byte, lockingParams ...[]byte) (Nullifier, error) {
+	return CalculateNullifierWithVersion(HashVersionLegacy, commitmentIndex, salt, scriptCommitment, lockingParams...)
+}
+
+// CalculateNullifierWithVersion calculates and returns the nullifier for the
+// given inputs using the encoding selected by the hash version.
+func CalculateNullifierWithVersion(version HashVersion, commitmentIndex uint64, salt [32]byte, scriptCommitment []byte, lockingParams ...[]byte) (Nullifier, error) {
+	tag, err := NullifierDomainTag(version)
+	if err != nil {
+		return Nullifier{}, err
+	}
 	lockingParamExpr, err := buildLurkExpression(lockingParams)
 	if err != nil {
 		return Nullifier{}, err
 	}
 
 	expr := fmt.Sprintf("(cons %d (cons 0x%x (cons 0x%x (cons %s nil))))", commitmentIndex, salt, scriptCommitment, lockingParamExpr)
+	expr, err = withDomainTag(tag, expr)
+	if err != nil {
+		return Nullifier{}, err
+	}
 	h, err := zk.LurkCommit(expr)
 	if err != nil {
 		return Nullifier{}, err
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -48,3 +49,20 @@ func TestCalculateNullifier(t *testing.T) {
 
 	assert.Equal(t, "112c36d51636533954aef733108d223ab2e7d57623ac27e6805d21420c463155", n.String())
 }
+
+func TestNullifierMarshalText(t *testing.T) {
+	n, err := NewNullifierFromString(testSerializedNullifier)
+	assert.NoError(t, err)
+
+	text, err := n.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, testSerializedNullifier, string(text))
+
+	ser, err := json.Marshal([]Nullifier{n})
+	assert.NoError(t, err)
+	assert.Equal(t, `["`+testSerializedNullifier+`"]`, string(ser))
+
+	var n2 []Nullifier
+	assert.NoError(t, json.Unmarshal(ser, &n2))
+	assert.Equal(t, []Nullifier{n}, n2)
+}
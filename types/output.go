@@ -0,0 +1,113 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNoteUnknown is returned when an operation requires the
+	// decrypted note but it is not known.
+	ErrNoteUnknown = errors.New("output note is not known")
+
+	// ErrLockingScriptUnknown is returned when an operation requires
+	// the locking script but it is not known.
+	ErrLockingScriptUnknown = errors.New("output locking script is not known")
+
+	// ErrScriptHashMismatch is returned when the locking script does not
+	// hash to the script hash in the note.
+	ErrScriptHashMismatch = errors.New("locking script does not match note script hash")
+
+	// ErrCommitmentMismatch is returned when the note does not hash to
+	// the output commitment.
+	ErrCommitmentMismatch = errors.New("note does not match output commitment")
+)
+
+// OutputDescriptor describes a transaction output. The commitment and
+// ciphertext are always known. The decrypted note and the locking script
+// are only known if the output belongs to us and may be nil.
+type OutputDescriptor struct {
+	Commitment    ID
+	Ciphertext    []byte
+	Note          *SpendNote
+	LockingScript *LockingScript
+}
+
+// NewOutputDescriptorFromNote returns a descriptor for the note and locking
+// script with the commitment computed from the note. The ciphertext is left
+// empty as the note has not been encrypted. The locking script may be nil.
+func NewOutputDescriptorFromNote(note *SpendNote, lockingScript *LockingScript) (*OutputDescriptor, error) {
+	commitment, err := note.Commitment()
+	if err != nil {
+		return nil, err
+	}
+	d := &OutputDescriptor{
+		Commitment: commitment,
+		Note:       note,
+	}
+	if lockingScript != nil {
+		if err := d.SetLockingScript(lockingScript); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// SetDecryptedNote deserializes the decrypted ciphertext and sets
+// it as the descriptor's note.
+func (d *OutputDescriptor) SetDecryptedNote(plaintext []byte) error {
+	note := new(SpendNote)
+	if err := note.Deserialize(plaintext); err != nil {
+		return err
+	}
+	d.Note = note
+	return nil
+}
+
+// SetLockingScript sets the locking script for the output. If the note is
+// known, ErrScriptHashMismatch is returned if the locking script does not
+// hash to the note's script hash.
+func (d *OutputDescriptor) SetLockingScript(lockingScript *LockingScript) error {
+	if d.Note != nil {
+		scriptHash, err := lockingScript.Hash()
+		if err != nil {
+			return err
+		}
+		if scriptHash.Compare(d.Note.ScriptHash) != 0 {
+			return ErrScriptHashMismatch
+		}
+	}
+	d.LockingScript = lockingScript
+	return nil
+}
+
+// ValidateCommitment returns ErrCommitmentMismatch if the note does not
+// hash to the output commitment.
+func (d *OutputDescriptor) ValidateCommitment() error {
+	if d.Note == nil {
+		return ErrNoteUnknown
+	}
+	commitment, err := d.Note.Commitment()
+	if err != nil {
+		return err
+	}
+	if commitment != d.Commitment {
+		return ErrCommitmentMismatch
+	}
+	return nil
+}
+
+// Nullifier returns the nullifier for the output given its index in the
+// accumulator. Both the note and the locking script must be known.
+func (d *OutputDescriptor) Nullifier(commitmentIndex uint64) (Nullifier, error) {
+	if d.Note == nil {
+		return Nullifier{}, ErrNoteUnknown
+	}
+	if d.LockingScript == nil {
+		return Nullifier{}, ErrLockingScriptUnknown
+	}
+	return CalculateNullifier(commitmentIndex, d.Note.Salt, d.LockingScript.ScriptCommitment.Bytes(), d.LockingScript.LockingParams...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputDescriptor(t *testing.T) {
+	lockingScript := &LockingScript{
+		ScriptCommitment: ID{0x01},
+		LockingParams:    [][]byte{{0x02}},
+	}
+	scriptHash, err := lockingScript.Hash()
+	assert.NoError(t, err)
+
+	note := &SpendNote{
+		ScriptHash: scriptHash,
+		Amount:     1000,
+		AssetID:    IlliumCoinID,
+		Salt:       [32]byte{0x03},
+		State:      State{},
+	}
+
+	desc, err := NewOutputDescriptorFromNote(note, lockingScript)
+	assert.NoError(t, err)
+	assert.NoError(t, desc.ValidateCommitment())
+
+	expected, err := CalculateNullifier(5, note.Salt, lockingScript.ScriptCommitment.Bytes(), lockingScript.LockingParams...)
+	assert.NoError(t, err)
+	nullifier, err := desc.Nullifier(5)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, nullifier)
+
+	ser, err := note.Serialize()
+	assert.NoError(t, err)
+
+	desc2 := &OutputDescriptor{Commitment: desc.Commitment}
+	_, err = desc2.Nullifier(5)
+	assert.ErrorIs(t, err, ErrNoteUnknown)
+	assert.NoError(t, desc2.SetDecryptedNote(ser))
+	assert.NoError(t, desc2.ValidateCommitment())
+	_, err = desc2.Nullifier(5)
+	assert.ErrorIs(t, err, ErrLockingScriptUnknown)
+
+	err = desc2.SetLockingScript(&LockingScript{ScriptCommitment: ID{0x09}})
+	assert.ErrorIs(t, err, ErrScriptHashMismatch)
+
+	desc2.Commitment = ID{}
+	assert.ErrorIs(t, desc2.ValidateCommitment(), ErrCommitmentMismatch)
+}
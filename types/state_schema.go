@@ -0,0 +1,293 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/project-illium/ilxd/zk"
+)
+
+var (
+	// ErrUnknownStateField is returned when accessing a field that
+	// is not defined in the schema.
+	ErrUnknownStateField = errors.New("unknown state field")
+
+	// ErrStateFieldType is returned when accessing a field with a
+	// type other than the one defined in the schema.
+	ErrStateFieldType = errors.New("state field type mismatch")
+
+	// ErrStateSchemaMismatch is returned when decoding a state that
+	// does not conform to the schema.
+	ErrStateSchemaMismatch = errors.New("state does not match schema")
+)
+
+// StateFieldType is the type of a field in a StateSchema. Each type
+// occupies a fixed size slot in the state.
+type StateFieldType uint8
+
+const (
+	// StateFieldBool is a one byte slot. Inside a lurk program it
+	// is evaluated as the number 0 or 1.
+	StateFieldBool StateFieldType = iota
+
+	// StateFieldUint64 is an eight byte, big endian, slot. Inside a
+	// lurk program it is evaluated as a number.
+	StateFieldUint64
+
+	// StateFieldHash is a 32 byte slot. Inside a lurk program it
+	// is evaluated as a num.
+	StateFieldHash
+)
+
+// Size returns the size of the slot in bytes.
+func (t StateFieldType) Size() int {
+	switch t {
+	case StateFieldBool:
+		return 1
+	case StateFieldUint64:
+		return 8
+	case StateFieldHash:
+		return 32
+	}
+	return 0
+}
+
+// String returns the name of the type.
+func (t StateFieldType) String() string {
+	switch t {
+	case StateFieldBool:
+		return "bool"
+	case StateFieldUint64:
+		return "uint64"
+	case StateFieldHash:
+		return "hash"
+	}
+	return "unknown"
+}
+
+// StateField is a named slot in a StateSchema.
+type StateField struct {
+	Name string
+	Type StateFieldType
+}
+
+// StateSchema defines the layout of a note's state. Fields are laid out
+// in order, one element per field, so that a script can access each field
+// by its index in the state list.
+type StateSchema struct {
+	fields []StateField
+	index  map[string]int
+}
+
+// NewStateSchema returns a new schema with the provided fields. An error
+// is returned if a field name is duplicated or if the serialized state
+// would exceed StateLen.
+func NewStateSchema(fields ...StateField) (*StateSchema, error) {
+	s := &StateSchema{
+		fields: make([]StateField, 0, len(fields)),
+		index:  make(map[string]int),
+	}
+	size := 0
+	for i, f := range fields {
+		if f.Name == "" {
+			return nil, errors.New("state field name is empty")
+		}
+		if _, ok := s.index[f.Name]; ok {
+			return nil, fmt.Errorf("duplicate state field %s", f.Name)
+		}
+		if f.Type.Size() == 0 {
+			return nil, fmt.Errorf("state field %s has unknown type", f.Name)
+		}
+		// Each element is serialized with a one byte length prefix.
+		size += f.Type.Size() + 1
+		if size > StateLen {
+			return nil, errors.New("state schema exceeds max state size")
+		}
+		s.fields = append(s.fields, f)
+		s.index[f.Name] = i
+	}
+	return s, nil
+}
+
+// Fields returns the fields in the schema in order.
+func (s *StateSchema) Fields() []StateField {
+	fields := make([]StateField, len(s.fields))
+	copy(fields, s.fields)
+	return fields
+}
+
+// Index returns the position of the field in the state.
+func (s *StateSchema) Index(name string) (int, bool) {
+	i, ok := s.index[name]
+	return i, ok
+}
+
+// NewState returns a TypedState for this schema with all fields
+// set to zero.
+func (s *StateSchema) NewState() *TypedState {
+	slots := make(State, len(s.fields))
+	for i, f := range s.fields {
+		slots[i] = make([]byte, f.Type.Size())
+	}
+	return &TypedState{schema: s, state: slots}
+}
+
+// Decode validates that the state conforms to the schema and wraps
+// it in a TypedState.
+func (s *StateSchema) Decode(state State) (*TypedState, error) {
+	if len(state) != len(s.fields) {
+		return nil, ErrStateSchemaMismatch
+	}
+	slots := make(State, len(state))
+	for i, f := range s.fields {
+		if len(state[i]) != f.Type.Size() {
+			return nil, ErrStateSchemaMismatch
+		}
+		if f.Type == StateFieldBool && state[i][0] > 1 {
+			return nil, ErrStateSchemaMismatch
+		}
+		slots[i] = make([]byte, len(state[i]))
+		copy(slots[i], state[i])
+	}
+	return &TypedState{schema: s, state: slots}, nil
+}
+
+// LurkAccessors returns a lurk function definition for each field in
+// the schema. The functions are named state-<field> and take the state
+// list as their only argument. They can be pasted into a script so that
+// it accesses the state using the same layout as the schema.
+func (s *StateSchema) LurkAccessors() string {
+	var sb strings.Builder
+	for i, f := range s.fields {
+		expr := "state"
+		for j := 0; j < i; j++ {
+			expr = "(cdr " + expr + ")"
+		}
+		sb.WriteString(fmt.Sprintf("!(defun state-%s (state) (car %s))\n", f.Name, expr))
+	}
+	return sb.String()
+}
+
+// TypedState is a State whose layout is defined by a StateSchema.
+type TypedState struct {
+	schema *StateSchema
+	state  State
+}
+
+// Schema returns the schema for this state.
+func (ts *TypedState) Schema() *StateSchema {
+	return ts.schema
+}
+
+// State returns a copy of the underlying State suitable for use
+// in a SpendNote.
+func (ts *TypedState) State() State {
+	state := make(State, len(ts.state))
+	for i, slot := range ts.state {
+		state[i] = make([]byte, len(slot))
+		copy(state[i], slot)
+	}
+	return state
+}
+
+// Encode serializes the state in the same format as State.Serialize.
+func (ts *TypedState) Encode() ([]byte, error) {
+	return ts.state.Serialize(true)
+}
+
+// Commitment returns the lurk commitment of the state. This is the
+// same value a lurk program computes with (num (commit state)).
+func (ts *TypedState) Commitment() (ID, error) {
+	return ts.state.Commitment()
+}
+
+// SetBool sets the value of a bool field.
+func (ts *TypedState) SetBool(name string, b bool) error {
+	slot, err := ts.slot(name, StateFieldBool)
+	if err != nil {
+		return err
+	}
+	slot[0] = 0
+	if b {
+		slot[0] = 1
+	}
+	return nil
+}
+
+// Bool returns the value of a bool field.
+func (ts *TypedState) Bool(name string) (bool, error) {
+	slot, err := ts.slot(name, StateFieldBool)
+	if err != nil {
+		return false, err
+	}
+	return slot[0] == 1, nil
+}
+
+// SetUint64 sets the value of a uint64 field.
+func (ts *TypedState) SetUint64(name string, n uint64) error {
+	slot, err := ts.slot(name, StateFieldUint64)
+	if err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint64(slot, n)
+	return nil
+}
+
+// Uint64 returns the value of a uint64 field.
+func (ts *TypedState) Uint64(name string) (uint64, error) {
+	slot, err := ts.slot(name, StateFieldUint64)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(slot), nil
+}
+
+// SetHash sets the value of a hash field.
+func (ts *TypedState) SetHash(name string, h ID) error {
+	slot, err := ts.slot(name, StateFieldHash)
+	if err != nil {
+		return err
+	}
+	copy(slot, h[:])
+	return nil
+}
+
+// Hash returns the value of a hash field.
+func (ts *TypedState) Hash(name string) (ID, error) {
+	slot, err := ts.slot(name, StateFieldHash)
+	if err != nil {
+		return ID{}, err
+	}
+	return NewID(slot), nil
+}
+
+func (ts *TypedState) slot(name string, typ StateFieldType) ([]byte, error) {
+	i, ok := ts.schema.index[name]
+	if !ok {
+		return nil, ErrUnknownStateField
+	}
+	if ts.schema.fields[i].Type != typ {
+		return nil, ErrStateFieldType
+	}
+	return ts.state[i], nil
+}
+
+// Commitment returns the lurk commitment of the state. This is the
+// same value a lurk program computes with (num (commit state)).
+func (s *State) Commitment() (ID, error) {
+	expr, err := s.ToExpr()
+	if err != nil {
+		return ID{}, err
+	}
+	h, err := zk.LurkCommit(expr)
+	if err != nil {
+		return ID{}, err
+	}
+	return NewID(h), nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateSchema(t *testing.T) {
+	schema, err := NewStateSchema(
+		StateField{Name: "locked", Type: StateFieldBool},
+		StateField{Name: "spent", Type: StateFieldUint64},
+		StateField{Name: "owner", Type: StateFieldHash},
+	)
+	assert.NoError(t, err)
+
+	i, ok := schema.Index("owner")
+	assert.True(t, ok)
+	assert.Equal(t, 2, i)
+
+	ts := schema.NewState()
+	assert.NoError(t, ts.SetBool("locked", true))
+	assert.NoError(t, ts.SetUint64("spent", 12345))
+	owner := NewID([]byte{0x01, 0x02, 0x03})
+	assert.NoError(t, ts.SetHash("owner", owner))
+
+	assert.ErrorIs(t, ts.SetUint64("locked", 1), ErrStateFieldType)
+	assert.ErrorIs(t, ts.SetBool("missing", true), ErrUnknownStateField)
+
+	ser, err := ts.Encode()
+	assert.NoError(t, err)
+	assert.Len(t, ser, StateLen)
+
+	var state State
+	assert.NoError(t, state.Deserialize(ser))
+
+	ts2, err := schema.Decode(state)
+	assert.NoError(t, err)
+
+	locked, err := ts2.Bool("locked")
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	spent, err := ts2.Uint64("spent")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12345), spent)
+	h, err := ts2.Hash("owner")
+	assert.NoError(t, err)
+	assert.Equal(t, owner, h)
+
+	_, err = schema.Decode(State{{0x01}})
+	assert.ErrorIs(t, err, ErrStateSchemaMismatch)
+	_, err = schema.Decode(State{{0x02}, make([]byte, 8), make([]byte, 32)})
+	assert.ErrorIs(t, err, ErrStateSchemaMismatch)
+
+	expected := "!(defun state-locked (state) (car state))\n" +
+		"!(defun state-spent (state) (car (cdr state)))\n" +
+		"!(defun state-owner (state) (car (cdr (cdr state))))\n"
+	assert.Equal(t, expected, schema.LurkAccessors())
+}
+
+func TestNewStateSchemaInvalid(t *testing.T) {
+	_, err := NewStateSchema(
+		StateField{Name: "a", Type: StateFieldBool},
+		StateField{Name: "a", Type: StateFieldUint64},
+	)
+	assert.Error(t, err)
+
+	fields := make([]StateField, 0, 4)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		fields = append(fields, StateField{Name: name, Type: StateFieldHash})
+	}
+	_, err = NewStateSchema(fields...)
+	assert.Error(t, err)
+}
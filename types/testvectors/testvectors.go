@@ -0,0 +1,151 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+// Package testvectors contains serialized blocks and transactions along
+// with their expected IDs, sighashes and merkle roots. The vectors guard
+// against accidental changes to the wire format or hashing functions
+// which would cause a hard fork. Other implementations may also use them
+// to check compatibility.
+package testvectors
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+)
+
+// TxVector is a serialized transaction and its expected hashes. All
+// fields are hex encoded.
+type TxVector struct {
+	Name       string
+	Serialized string
+	ID         string
+	SigHash    string
+	UID        string
+	WID        string
+}
+
+// BlockVector is a serialized block and its expected hashes. All
+// fields are hex encoded.
+type BlockVector struct {
+	Name       string
+	Serialized string
+	ID         string
+	SigHash    string
+	TxRoot     string
+}
+
+// VerifyTx deserializes the vector's transaction and checks that it
+// re-serializes to the same bytes and produces the expected hashes.
+func VerifyTx(v TxVector) error {
+	ser, err := hex.DecodeString(v.Serialized)
+	if err != nil {
+		return err
+	}
+	tx := new(transactions.Transaction)
+	if err := tx.Deserialize(ser); err != nil {
+		return err
+	}
+	ser2, err := tx.Serialize()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ser, ser2) {
+		return fmt.Errorf("tx vector %s: serialization mismatch", v.Name)
+	}
+
+	sigHash, err := txSigHash(tx)
+	if err != nil {
+		return err
+	}
+	if err := checkHex(v.Name, "sighash", v.SigHash, sigHash); err != nil {
+		return err
+	}
+	if err := checkHex(v.Name, "id", v.ID, tx.ID().Bytes()); err != nil {
+		return err
+	}
+	if err := checkHex(v.Name, "uid", v.UID, tx.UID().Bytes()); err != nil {
+		return err
+	}
+	return checkHex(v.Name, "wid", v.WID, tx.WID().Bytes())
+}
+
+// VerifyBlock deserializes the vector's block and checks that it
+// re-serializes to the same bytes and produces the expected hashes.
+// The computed merkle root must also match the header's tx root.
+func VerifyBlock(v BlockVector) error {
+	ser, err := hex.DecodeString(v.Serialized)
+	if err != nil {
+		return err
+	}
+	blk := new(blocks.Block)
+	if err := blk.Deserialize(ser); err != nil {
+		return err
+	}
+	ser2, err := blk.Serialize()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ser, ser2) {
+		return fmt.Errorf("block vector %s: serialization mismatch", v.Name)
+	}
+
+	sigHash, err := blk.Header.SigHash()
+	if err != nil {
+		return err
+	}
+	if err := checkHex(v.Name, "sighash", v.SigHash, sigHash); err != nil {
+		return err
+	}
+	if err := checkHex(v.Name, "id", v.ID, blk.ID().Bytes()); err != nil {
+		return err
+	}
+	root := blockchain.TransactionsMerkleRoot(blk.Transactions)
+	if err := checkHex(v.Name, "tx root", v.TxRoot, root.Bytes()); err != nil {
+		return err
+	}
+	return checkHex(v.Name, "header tx root", v.TxRoot, blk.Header.TxRoot)
+}
+
+// VerifyAll verifies all transaction and block vectors.
+func VerifyAll() error {
+	for _, v := range TxVectors {
+		if err := VerifyTx(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range BlockVectors {
+		if err := VerifyBlock(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func txSigHash(tx *transactions.Transaction) ([]byte, error) {
+	switch t := tx.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		return t.StandardTransaction.SigHash()
+	case *transactions.Transaction_CoinbaseTransaction:
+		return t.CoinbaseTransaction.SigHash()
+	case *transactions.Transaction_StakeTransaction:
+		return t.StakeTransaction.SigHash()
+	case *transactions.Transaction_TreasuryTransaction:
+		return t.TreasuryTransaction.SigHash()
+	case *transactions.Transaction_MintTransaction:
+		return t.MintTransaction.SigHash()
+	}
+	return nil, fmt.Errorf("unknown transaction type")
+}
+
+func checkHex(name, field, expected string, actual []byte) error {
+	if expected != hex.EncodeToString(actual) {
+		return fmt.Errorf("vector %s: %s mismatch: expected %s, got %x", name, field, expected, actual)
+	}
+	return nil
+}
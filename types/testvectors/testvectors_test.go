@@ -0,0 +1,29 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package testvectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxVectors(t *testing.T) {
+	for _, v := range TxVectors {
+		assert.NoError(t, VerifyTx(v), v.Name)
+	}
+}
+
+func TestBlockVectors(t *testing.T) {
+	for _, v := range BlockVectors {
+		assert.NoError(t, VerifyBlock(v), v.Name)
+	}
+}
+
+func TestVerifyDetectsChange(t *testing.T) {
+	v := TxVectors[0]
+	v.ID = BlockVectors[0].ID
+	assert.Error(t, VerifyTx(v))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package testvectors
+
+// The vectors below were generated from deterministic inputs and must
+// never be changed. If one of them stops verifying, the serialization or
+// hashing of blocks and transactions has changed in a way that would fork
+// the network.
+
+// TxVectors is the set of transaction vectors. There is one vector for
+// each transaction type.
+var TxVectors = []TxVector{
+	{
+		Name:       "standard",
+		Serialized: "0ae3020a540a20101010101010101010101010101010101010101010101010101010101010101012301111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111110a540a202020202020202020202020202020202020202020202020202020202020202020123021212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212112203030303030303030303030303030303030303030303030303030303030303030122031313131313131313131313131313131313131313131313131313131313131311a20404040404040404040404040404040404040404040404040404040404040404022090880e2cfaa0610d80428a09c01324050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050",
+		ID:         "1815fd0fc233309bcb93da4dfab128dadb2aa380382f16efa7ffb3ff3fefd9f4",
+		SigHash:    "177708f2b60a6e83b2c067f97bce15e8292a9beb76cfca5bd659e281c2a76384",
+		UID:        "214542260001911b5287ab276f611533b0792b7cf3a70b68b6817ab21e3de0b4",
+		WID:        "1e1d00c8db78adeb211b880d722b4777ec39c700f25eb11f915628bc4acb427b",
+	},
+	{
+		Name:       "coinbase",
+		Serialized: "1288020a260101010101010101010101010101010101010101010101010101010101010101010101010101108094ebdc031a540a20606060606060606060606060606060606060606060606060606060606060606012306161616161616161616161616161616161616161616161616161616161616161616161616161616161616161616161612240020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202022a4003030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303",
+		ID:         "0c7a543271661740968a3b11a799f024ebb2686c6d4a2181de069643166a7ca5",
+		SigHash:    "3cf4f2127bb4396a4d8416c148ad3e314d73c86856fb6903ff2d2c0c7ce745fa",
+		UID:        "04496bf74233be357467ff60326611258a21561f2c61b6179d9f7756c3ba13b6",
+		WID:        "03408522fdef2a8c010093bf726dea07954591677cc4bb0c58421dac49942186",
+	},
+	{
+		Name:       "stake",
+		Serialized: "1afb010a26040404040404040404040404040404040404040404040404040404040404040404040404040410c096b1021a200505050505050505050505050505050505050505050505050505050505050505222006060606060606060606060606060606060606060606060606060606060606062880a4a7da063240070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707073a4008080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808",
+		ID:         "07a9aefcc73199556d3a0f7d3e4203b472e3762d2bb47cf7025583393a63e343",
+		SigHash:    "0c86b831fda42eccb0ec40e5f08452ba713fe9501f6c726c34829ee3e8f14caa",
+		UID:        "1d6990c62ee589fb3c2c755d447fbd0e454ae9a79d90dd88c924136795e96637",
+		WID:        "19f493e092e69be49af15f25fdbc26c62b62e036b361b4431d33c7236fdeb134",
+	},
+	{
+		Name:       "treasury",
+		Serialized: "22be0108c0c40712540a20707070707070707070707070707070707070707070707070707070707070707012307171717171717171717171717171717171717171717171717171717171717171717171717171717171717171717171711a20090909090909090909090909090909090909090909090909090909090909090922400a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a",
+		ID:         "32907c501086c5b475d74e9d821f208599848728eaca5f6a0c16afd98939285f",
+		SigHash:    "280b1c26d7f0c8a190a03005ebc9e7be41ce9e68cd45e3a75e772cf85ab279c1",
+		UID:        "1f66b6944fb18205c85712770b1b92409d1c645dc7c6383ec1125b45555d18d7",
+		WID:        "1c491df16fff685e2cb3a853aa9bd04f273081c85c65aeb57a41fa8c18c8c427",
+	},
+	{
+		Name:       "mint",
+		Serialized: "2a9a03080112200b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b1a200c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c2089062a540a208080808080808080808080808080808080808080808080808080808080808080123081818181818181818181818181818181818181818181818181818181818181818181818181818181818181818181818130b8173a200d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d42200e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e4a240f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f52080880e2cfaa06101e5a4011111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111624012121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212",
+		ID:         "0556fa701f6fffedb44e56ec525f0b1b89e0b532dda7bfb90ef95f63056be5b3",
+		SigHash:    "2cd88b5d6884d2275aea4a17d09e355cf130244f6837e479ba4e04d894c07c6d",
+		UID:        "396ec6a752d701a7b68f5762fd3eae65c858368ad85c58e49675aeb89f852395",
+		WID:        "1d98771e2f71e145cec03b42ae61616e501407ba75ab09017618bf0c3dd15027",
+	},
+}
+
+// BlockVectors is the set of block vectors. The blocks contain the
+// transactions from TxVectors.
+var BlockVectors = []BlockVector{
+	{
+		Name:       "1-txs",
+		Serialized: "0ab801080110011a2021212121212121212121212121212121212121212121212121212121212121212081e2cfaa062a202b09042be2216689671d3c3e398c022b2bf3759a6560c6910b0142e49aa6c711322622222222222222222222222222222222222222222222222222222222222222222222222222223a4023232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323128b021288020a260101010101010101010101010101010101010101010101010101010101010101010101010101108094ebdc031a540a20606060606060606060606060606060606060606060606060606060606060606012306161616161616161616161616161616161616161616161616161616161616161616161616161616161616161616161612240020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202022a4003030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303",
+		ID:         "393214a13bcc080fb9e7323215c0557e6b07ec476c0f4537f9c3bd0c5eed2a1a",
+		SigHash:    "3847cb6bb746b3c46307ee2fda06500927659d74155be2e8d1ac02f1de4da4d8",
+		TxRoot:     "2b09042be2216689671d3c3e398c022b2bf3759a6560c6910b0142e49aa6c711",
+	},
+	{
+		Name:       "3-txs",
+		Serialized: "0ab801080110031a2021212121212121212121212121212121212121212121212121212121212121212083e2cfaa062a20159af0fdf39b06969b9db44300cd2373a1d8b990defc25624ddad04cdf7c5774322622222222222222222222222222222222222222222222222222222222222222222222222222223a402323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232312e6020ae3020a540a20101010101010101010101010101010101010101010101010101010101010101012301111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111110a540a202020202020202020202020202020202020202020202020202020202020202020123021212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212112203030303030303030303030303030303030303030303030303030303030303030122031313131313131313131313131313131313131313131313131313131313131311a20404040404040404040404040404040404040404040404040404040404040404022090880e2cfaa0610d80428a09c01324050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050128b021288020a260101010101010101010101010101010101010101010101010101010101010101010101010101108094ebdc031a540a20606060606060606060606060606060606060606060606060606060606060606012306161616161616161616161616161616161616161616161616161616161616161616161616161616161616161616161612240020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202022a400303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030312fe011afb010a26040404040404040404040404040404040404040404040404040404040404040404040404040410c096b1021a200505050505050505050505050505050505050505050505050505050505050505222006060606060606060606060606060606060606060606060606060606060606062880a4a7da063240070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707073a4008080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808",
+		ID:         "0c40ddca8dd7ca647bf5b90887a01d7fe43e802ceeb1e2327d13be1dc9619cb0",
+		SigHash:    "0fbdf569f389c40392d54d6b45bd11be9a7e4dbb83ed193267652c08a016d348",
+		TxRoot:     "159af0fdf39b06969b9db44300cd2373a1d8b990defc25624ddad04cdf7c5774",
+	},
+	{
+		Name:       "5-txs",
+		Serialized: "0ab801080110051a2021212121212121212121212121212121212121212121212121212121212121212085e2cfaa062a20145682140e13279588172117061d20ac4f8df512be99476cc49d7d1e5de04731322622222222222222222222222222222222222222222222222222222222222222222222222222223a402323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232323232312e6020ae3020a540a20101010101010101010101010101010101010101010101010101010101010101012301111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111110a540a202020202020202020202020202020202020202020202020202020202020202020123021212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212112203030303030303030303030303030303030303030303030303030303030303030122031313131313131313131313131313131313131313131313131313131313131311a20404040404040404040404040404040404040404040404040404040404040404022090880e2cfaa0610d80428a09c01324050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050505050128b021288020a260101010101010101010101010101010101010101010101010101010101010101010101010101108094ebdc031a540a20606060606060606060606060606060606060606060606060606060606060606012306161616161616161616161616161616161616161616161616161616161616161616161616161616161616161616161612240020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202022a400303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030312fe011afb010a26040404040404040404040404040404040404040404040404040404040404040404040404040410c096b1021a200505050505050505050505050505050505050505050505050505050505050505222006060606060606060606060606060606060606060606060606060606060606062880a4a7da063240070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707070707073a400808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080808080812c10122be0108c0c40712540a20707070707070707070707070707070707070707070707070707070707070707012307171717171717171717171717171717171717171717171717171717171717171717171717171717171717171717171711a20090909090909090909090909090909090909090909090909090909090909090922400a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a129d032a9a03080112200b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b1a200c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c2089062a540a208080808080808080808080808080808080808080808080808080808080808080123081818181818181818181818181818181818181818181818181818181818181818181818181818181818181818181818130b8173a200d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d42200e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e4a240f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f52080880e2cfaa06101e5a4011111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111624012121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212121212",
+		ID:         "074fb825dec38e19fd9946d11f7ba183513a9ddba5a74dab7f8e639d73242c30",
+		SigHash:    "3eb0286a81d694ac89647ea892fac596a1166b50cc3c0cc9a25e043abe574acc",
+		TxRoot:     "145682140e13279588172117061d20ac4f8df512be99476cc49d7d1e5de04731",
+	},
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"errors"
+	"time"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+)
+
+var (
+	// ErrNoInputs is returned by the TxBuilder when attempting to
+	// build a transaction without any inputs.
+	ErrNoInputs = errors.New("transaction has no inputs")
+
+	// ErrInsufficientInputs is returned by the TxBuilder when the
+	// outputs plus fee exceed the value of the inputs.
+	ErrInsufficientInputs = errors.New("transaction outputs exceed inputs")
+
+	// ErrTooManyInputs is returned by the TxBuilder when the transaction
+	// has more inputs than the circuit supports.
+	ErrTooManyInputs = standard.ErrTooManyInputs
+
+	// ErrTooManyOutputs is returned by the TxBuilder when the transaction
+	// has more outputs than the circuit supports.
+	ErrTooManyOutputs = standard.ErrTooManyOutputs
+)
+
+type builderInput struct {
+	note            types.SpendNote
+	lockingScript   types.LockingScript
+	commitmentIndex uint64
+	inclusionProof  standard.InclusionProof
+}
+
+type builderOutput struct {
+	note       types.SpendNote
+	ciphertext []byte
+}
+
+// TxBuilder is used to construct a StandardTransaction along with
+// the private and public parameters needed to prove it.
+//
+// Each method returns the builder so that calls can be chained:
+//
+//	tx, priv, pub, err := NewTxBuilder().
+//		SetTxoRoot(root).
+//		AddInput(note, lockingScript, index, proof).
+//		AddOutput(outNote, ciphertext).
+//		SetFee(fee).
+//		Build()
+//
+// The UnlockingParams of each private input are left empty as they
+// typically commit to the sighash. The caller should populate them
+// using pub.SigHash before creating the proof.
+type TxBuilder struct {
+	inputs    []builderInput
+	outputs   []builderOutput
+	fee       types.Amount
+	txoRoot   types.ID
+	locktime  time.Time
+	precision time.Duration
+
+	locktimeSeconds bool
+
+	maxInputs  int
+	maxOutputs int
+}
+
+// NewTxBuilder returns a new, empty TxBuilder. The number of inputs and
+// outputs is not limited unless SetCircuitSize is used.
+func NewTxBuilder() *TxBuilder {
+	return &TxBuilder{}
+}
+
+// SetCircuitSize limits the transaction to the number of inputs and
+// outputs supported by the circuit it will be proved with, for example
+// one created with standard.NewStandardCircuit.
+func (b *TxBuilder) SetCircuitSize(maxInputs, maxOutputs int) *TxBuilder {
+	b.maxInputs = maxInputs
+	b.maxOutputs = maxOutputs
+	return b
+}
+
+// AddInput adds a note to be spent by the transaction. The commitment
+// index and inclusion proof link the note's commitment to the txo root.
+func (b *TxBuilder) AddInput(note types.SpendNote, lockingScript types.LockingScript, commitmentIndex uint64, inclusionProof standard.InclusionProof) *TxBuilder {
+	b.inputs = append(b.inputs, builderInput{
+		note:            note,
+		lockingScript:   lockingScript,
+		commitmentIndex: commitmentIndex,
+		inclusionProof:  inclusionProof,
+	})
+	return b
+}
+
+// AddOutput adds a new output to the transaction. The ciphertext is the
+// encrypted note and is included in the transaction as-is.
+func (b *TxBuilder) AddOutput(note types.SpendNote, ciphertext []byte) *TxBuilder {
+	b.outputs = append(b.outputs, builderOutput{
+		note:       note,
+		ciphertext: ciphertext,
+	})
+	return b
+}
+
+// SetFee sets the fee paid by the transaction.
+func (b *TxBuilder) SetFee(fee types.Amount) *TxBuilder {
+	b.fee = fee
+	return b
+}
+
+// SetTxoRoot sets the txo root that the input inclusion proofs link to.
+func (b *TxBuilder) SetTxoRoot(root types.ID) *TxBuilder {
+	b.txoRoot = root
+	return b
+}
+
+// SetLocktime sets the transaction locktime. The transaction will only
+// be valid in blocks with a timestamp within precision of the locktime.
+func (b *TxBuilder) SetLocktime(locktime time.Time, precision time.Duration) *TxBuilder {
+	b.locktime = locktime
+	b.precision = precision
+	return b
+}
+
+// SetLocktimeSeconds sets whether the public params denominate the
+// locktime precision in seconds. It should be set when the locktime
+// seconds upgrade is active at the height the transaction is expected
+// to be included.
+func (b *TxBuilder) SetLocktimeSeconds(active bool) *TxBuilder {
+	b.locktimeSeconds = active
+	return b
+}
+
+// Build validates that the inputs cover the outputs plus fee and returns the
+// transaction along with the private and public parameters for the standard
+// circuit.
+func (b *TxBuilder) Build() (*StandardTransaction, *standard.PrivateParams, *standard.PublicParams, error) {
+	if len(b.inputs) == 0 {
+		return nil, nil, nil, ErrNoInputs
+	}
+	if b.maxInputs > 0 && len(b.inputs) > b.maxInputs {
+		return nil, nil, nil, ErrTooManyInputs
+	}
+	if b.maxOutputs > 0 && len(b.outputs) > b.maxOutputs {
+		return nil, nil, nil, ErrTooManyOutputs
+	}
+
+	var (
+		inVal     types.Amount
+		outVal    types.Amount
+		assetIns  = make(map[types.ID]types.Amount)
+		assetOuts = make(map[types.ID]types.Amount)
+		err       error
+	)
+
+	tx := &StandardTransaction{
+		Outputs:    make([]*Output, 0, len(b.outputs)),
+		Nullifiers: make([][]byte, 0, len(b.inputs)),
+		TxoRoot:    b.txoRoot.Bytes(),
+		Fee:        uint64(b.fee),
+	}
+	if !b.locktime.IsZero() {
+		tx.Locktime = &Locktime{
+			Timestamp: b.locktime.Unix(),
+			Precision: int64(b.precision.Seconds()),
+		}
+	}
+	priv := &standard.PrivateParams{
+		Inputs:  make([]standard.PrivateInput, 0, len(b.inputs)),
+		Outputs: make([]standard.PrivateOutput, 0, len(b.outputs)),
+	}
+
+	for _, in := range b.inputs {
+		nullifier, err := types.CalculateNullifier(in.commitmentIndex, in.note.Salt, in.lockingScript.ScriptCommitment.Bytes(), in.lockingScript.LockingParams...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tx.Nullifiers = append(tx.Nullifiers, nullifier.Bytes())
+		priv.Inputs = append(priv.Inputs, standard.PrivateInput{
+			SpendNote:        in.note,
+			CommitmentIndex:  in.commitmentIndex,
+			InclusionProof:   in.inclusionProof,
+			ScriptCommitment: in.lockingScript.ScriptCommitment.Bytes(),
+			ScriptParams:     in.lockingScript.LockingParams,
+		})
+
+		if in.note.AssetID == types.IlliumCoinID {
+			inVal, err = inVal.Add(in.note.Amount)
+		} else {
+			assetIns[in.note.AssetID], err = assetIns[in.note.AssetID].Add(in.note.Amount)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	for _, out := range b.outputs {
+		commitment, err := out.note.Commitment()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tx.Outputs = append(tx.Outputs, &Output{
+			Commitment: commitment.Bytes(),
+			Ciphertext: out.ciphertext,
+		})
+		priv.Outputs = append(priv.Outputs, standard.PrivateOutput{
+			SpendNote: out.note,
+		})
+
+		if out.note.AssetID == types.IlliumCoinID {
+			outVal, err = outVal.Add(out.note.Amount)
+		} else {
+			assetOuts[out.note.AssetID], err = assetOuts[out.note.AssetID].Add(out.note.Amount)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	totalOut, err := outVal.Add(b.fee)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if totalOut > inVal {
+		return nil, nil, nil, ErrInsufficientInputs
+	}
+	for assetID, amt := range assetOuts {
+		if amt > assetIns[assetID] {
+			return nil, nil, nil, ErrInsufficientInputs
+		}
+	}
+
+	// The public params are derived from the transaction exactly the
+	// same way block validation derives them.
+	pub, err := StandardPublicParams(tx, LocktimeSeconds(b.locktimeSeconds))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return tx, priv, pub, nil
+}
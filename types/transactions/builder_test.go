@@ -0,0 +1,144 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxBuilder(t *testing.T) {
+	lockingScript := types.LockingScript{
+		ScriptCommitment: types.ID{0x01},
+		LockingParams:    [][]byte{{0x02}},
+	}
+	scriptHash, err := lockingScript.Hash()
+	assert.NoError(t, err)
+
+	salt, err := types.RandomSalt()
+	assert.NoError(t, err)
+	inNote := types.SpendNote{
+		ScriptHash: scriptHash,
+		Amount:     1000,
+		AssetID:    types.IlliumCoinID,
+		Salt:       salt,
+		State:      types.State{},
+	}
+	commitment, err := inNote.Commitment()
+	assert.NoError(t, err)
+
+	// With a single element in the accumulator the root is just
+	// the commitment hashed with its index.
+	txoRoot := types.NewID(hash.HashWithIndex(commitment.Bytes(), 0))
+
+	makeOutput := func(amt types.Amount) types.SpendNote {
+		var sh types.ID
+		rand.Read(sh[:])
+		s, err := types.RandomSalt()
+		assert.NoError(t, err)
+		return types.SpendNote{
+			ScriptHash: sh,
+			Amount:     amt,
+			AssetID:    types.IlliumCoinID,
+			Salt:       s,
+			State:      types.State{},
+		}
+	}
+
+	locktime := time.Unix(time.Now().Unix(), 0)
+	tx, priv, pub, err := NewTxBuilder().
+		SetTxoRoot(txoRoot).
+		AddInput(inNote, lockingScript, 0, standard.InclusionProof{}).
+		AddOutput(makeOutput(600), []byte{0x03}).
+		AddOutput(makeOutput(390), []byte{0x04}).
+		SetFee(10).
+		SetLocktime(locktime, time.Minute*10).
+		Build()
+	assert.NoError(t, err)
+
+	nullifier, err := types.CalculateNullifier(0, inNote.Salt, lockingScript.ScriptCommitment.Bytes(), lockingScript.LockingParams...)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{nullifier.Bytes()}, tx.Nullifiers)
+	assert.Len(t, tx.Outputs, 2)
+	assert.Equal(t, uint64(10), tx.Fee)
+	assert.Equal(t, txoRoot.Bytes(), tx.TxoRoot)
+	assert.Equal(t, locktime.Unix(), tx.Locktime.Timestamp)
+	assert.Equal(t, int64(600), tx.Locktime.Precision)
+
+	sigHash, err := tx.SigHash()
+	assert.NoError(t, err)
+	assert.Equal(t, sigHash, pub.SigHash)
+	assert.Equal(t, locktime, pub.Locktime)
+	for i, out := range tx.Outputs {
+		c, err := priv.Outputs[i].Commitment()
+		assert.NoError(t, err)
+		assert.Equal(t, c.Bytes(), out.Commitment)
+		assert.Equal(t, out.Commitment, pub.Outputs[i].Commitment)
+		assert.Equal(t, out.Ciphertext, pub.Outputs[i].CipherText)
+	}
+	assert.True(t, standard.StandardCircuit(priv, pub))
+
+	// Outputs exceed inputs
+	_, _, _, err = NewTxBuilder().
+		SetTxoRoot(txoRoot).
+		AddInput(inNote, lockingScript, 0, standard.InclusionProof{}).
+		AddOutput(makeOutput(995), nil).
+		SetFee(10).
+		Build()
+	assert.ErrorIs(t, err, ErrInsufficientInputs)
+
+	// Asset outputs exceed asset inputs
+	assetOut := makeOutput(1)
+	assetOut.AssetID = types.ID{0xff}
+	_, _, _, err = NewTxBuilder().
+		SetTxoRoot(txoRoot).
+		AddInput(inNote, lockingScript, 0, standard.InclusionProof{}).
+		AddOutput(assetOut, nil).
+		Build()
+	assert.ErrorIs(t, err, ErrInsufficientInputs)
+
+	// No inputs
+	_, _, _, err = NewTxBuilder().AddOutput(makeOutput(1), nil).Build()
+	assert.ErrorIs(t, err, ErrNoInputs)
+
+	// More outputs than a sized circuit supports
+	builder := NewTxBuilder().
+		SetTxoRoot(txoRoot).
+		AddInput(inNote, lockingScript, 0, standard.InclusionProof{}).
+		SetCircuitSize(16, 16)
+	for i := 0; i < 17; i++ {
+		builder.AddOutput(makeOutput(1), nil)
+	}
+	_, _, _, err = builder.Build()
+	assert.ErrorIs(t, err, ErrTooManyOutputs)
+
+	// A larger circuit accepts them
+	_, priv, pub, err = builder.SetCircuitSize(16, 32).Build()
+	assert.NoError(t, err)
+	assert.False(t, standard.NewStandardCircuit(16, 16)(priv, pub))
+	assert.True(t, standard.NewStandardCircuit(16, 32)(priv, pub))
+	assert.True(t, standard.StandardCircuit(priv, pub))
+
+	// Sweeps with more than sixteen inputs are not limited by
+	// default and are accepted by the consensus circuit.
+	builder = NewTxBuilder().SetTxoRoot(txoRoot)
+	for i := 0; i < 20; i++ {
+		builder.AddInput(inNote, lockingScript, 0, standard.InclusionProof{})
+	}
+	tx, priv, pub, err = builder.AddOutput(makeOutput(19990), nil).SetFee(10).Build()
+	assert.NoError(t, err)
+	assert.Len(t, tx.Nullifiers, 20)
+	assert.True(t, standard.StandardCircuit(priv, pub))
+	assert.False(t, standard.NewStandardCircuit(16, 16)(priv, pub))
+
+	_, _, _, err = builder.SetCircuitSize(16, 16).Build()
+	assert.ErrorIs(t, err, ErrTooManyInputs)
+}
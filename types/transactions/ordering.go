@@ -0,0 +1,65 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/project-illium/ilxd/types"
+)
+
+// ErrNonCanonicalOrder is returned when a list of transactions is not
+// in the canonical order.
+var ErrNonCanonicalOrder = errors.New("transactions are not in canonical order")
+
+// TxSorter implements sort.Interface to allow a slice of transactions
+// to be sorted in the canonical order.
+type TxSorter []*Transaction
+
+// Len returns the number of txs in the slice.  It is part of the
+// sort.Interface implementation.
+func (s TxSorter) Len() int {
+	return len(s)
+}
+
+// Swap swaps the txs at the passed indices.  It is part of the
+// sort.Interface implementation.
+func (s TxSorter) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Less returns whether the txs with index i should sort before the
+// tx with index j.  It is part of the sort.Interface implementation.
+func (s TxSorter) Less(i, j int) bool {
+	return s[i].ID().Compare(s[j].ID()) < 0
+}
+
+// SortCanonical sorts the transactions in the canonical in-block order.
+//
+// Transactions in a block are ordered lexicographically by txid. Both
+// the tx root and the xthinner encoding depend on this order.
+func SortCanonical(txs []*Transaction) {
+	sort.Sort(TxSorter(txs))
+}
+
+// ValidateCanonicalOrder returns ErrNonCanonicalOrder if the transactions
+// are not strictly increasing by txid. A duplicate transaction is treated
+// as out of order.
+func ValidateCanonicalOrder(txs []*Transaction) error {
+	for i := 1; i < len(txs); i++ {
+		if txs[i-1].ID().Compare(txs[i].ID()) >= 0 {
+			return ErrNonCanonicalOrder
+		}
+	}
+	return nil
+}
+
+// SortTxids sorts the txids in the canonical order.
+func SortTxids(txids []types.ID) {
+	sort.Slice(txids, func(i, j int) bool {
+		return txids[i].Compare(txids[j]) < 0
+	})
+}
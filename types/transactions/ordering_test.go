@@ -0,0 +1,37 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalOrder(t *testing.T) {
+	txs := make([]*Transaction, 0, 10)
+	for i := 0; i < 10; i++ {
+		txs = append(txs, WrapTransaction(&StandardTransaction{Fee: uint64(i)}))
+	}
+	SortCanonical(txs)
+	assert.NoError(t, ValidateCanonicalOrder(txs))
+
+	txs[0], txs[1] = txs[1], txs[0]
+	assert.ErrorIs(t, ValidateCanonicalOrder(txs), ErrNonCanonicalOrder)
+
+	SortCanonical(txs)
+	txs = append(txs, txs[len(txs)-1])
+	assert.ErrorIs(t, ValidateCanonicalOrder(txs), ErrNonCanonicalOrder)
+
+	txids := make([]types.ID, 0, len(txs)-1)
+	for i := len(txs) - 2; i >= 0; i-- {
+		txids = append(txids, txs[i].ID())
+	}
+	SortTxids(txids)
+	for i, tx := range txs[:len(txs)-1] {
+		assert.Equal(t, tx.ID(), txids[i])
+	}
+}
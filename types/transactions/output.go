@@ -0,0 +1,31 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"github.com/project-illium/ilxd/types"
+)
+
+// ToDescriptor returns an OutputDescriptor for the output. The note and
+// locking script are unknown and left nil.
+func (o *Output) ToDescriptor() *types.OutputDescriptor {
+	ciphertext := make([]byte, len(o.Ciphertext))
+	copy(ciphertext, o.Ciphertext)
+	return &types.OutputDescriptor{
+		Commitment: types.NewID(o.Commitment),
+		Ciphertext: ciphertext,
+	}
+}
+
+// NewOutputFromDescriptor returns the transaction output for the
+// descriptor.
+func NewOutputFromDescriptor(d *types.OutputDescriptor) *Output {
+	ciphertext := make([]byte, len(d.Ciphertext))
+	copy(ciphertext, d.Ciphertext)
+	return &Output{
+		Commitment: d.Commitment.Bytes(),
+		Ciphertext: ciphertext,
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"testing"
+
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputDescriptorConversion(t *testing.T) {
+	out := &Output{
+		Commitment: types.ID{0x01}.Bytes(),
+		Ciphertext: []byte{0x02, 0x03},
+	}
+	desc := out.ToDescriptor()
+	assert.Equal(t, types.ID{0x01}, desc.Commitment)
+	assert.Equal(t, out.Ciphertext, desc.Ciphertext)
+	assert.Nil(t, desc.Note)
+	assert.Nil(t, desc.LockingScript)
+
+	out2 := NewOutputFromDescriptor(desc)
+	assert.Equal(t, out.Commitment, out2.Commitment)
+	assert.Equal(t, out.Ciphertext, out2.Ciphertext)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"errors"
+	"time"
+
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+)
+
+// ErrNoProof is returned by PublicParamsFromTx for transactions which
+// do not carry a zk-snark proof.
+var ErrNoProof = errors.New("transaction does not have a proof")
+
+// ParamsOption configures how the public params are derived from a
+// transaction.
+type ParamsOption func(cfg *paramsConfig)
+
+type paramsConfig struct {
+	locktimeSeconds bool
+}
+
+// LocktimeSeconds sets whether the locktime precision is placed in the
+// public params in seconds. Block validation passes the precision
+// unscaled until the locktime seconds upgrade activates, so this must
+// match the upgrade's state at the height the proof is validated at.
+func LocktimeSeconds(active bool) ParamsOption {
+	return func(cfg *paramsConfig) {
+		cfg.locktimeSeconds = active
+	}
+}
+
+func makeParamsConfig(opts []ParamsOption) paramsConfig {
+	var cfg paramsConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// PublicParamsFromTx returns the public params of the circuit the
+// transaction's proof is verified against, along with the ID of the
+// circuit. The params are derived exactly as block validation derives
+// them so a proof created with them will validate, provided the options
+// match the network's upgrades at the height it is validated at.
+//
+// The params are a *standard.PublicParams for standard, coinbase,
+// treasury and mint transactions and a *stake.PublicParams for stake
+// transactions.
+func PublicParamsFromTx(t *Transaction, opts ...ParamsOption) (zk.CircuitID, interface{}, error) {
+	switch tx := t.GetTx().(type) {
+	case *Transaction_StandardTransaction:
+		params, err := StandardPublicParams(tx.StandardTransaction, opts...)
+		return zk.StandardCircuitID, params, err
+	case *Transaction_CoinbaseTransaction:
+		sigHash, err := tx.CoinbaseTransaction.SigHash()
+		if err != nil {
+			return "", nil, err
+		}
+		return zk.StandardCircuitID, &standard.PublicParams{
+			SigHash:  sigHash,
+			Outputs:  publicOutputs(tx.CoinbaseTransaction.Outputs),
+			Coinbase: tx.CoinbaseTransaction.NewCoins,
+		}, nil
+	case *Transaction_TreasuryTransaction:
+		sigHash, err := tx.TreasuryTransaction.SigHash()
+		if err != nil {
+			return "", nil, err
+		}
+		return zk.StandardCircuitID, &standard.PublicParams{
+			SigHash:  sigHash,
+			Outputs:  publicOutputs(tx.TreasuryTransaction.Outputs),
+			Coinbase: tx.TreasuryTransaction.Amount,
+		}, nil
+	case *Transaction_MintTransaction:
+		sigHash, err := tx.MintTransaction.SigHash()
+		if err != nil {
+			return "", nil, err
+		}
+		params := &standard.PublicParams{
+			TXORoot:    tx.MintTransaction.TxoRoot,
+			SigHash:    sigHash,
+			Outputs:    publicOutputs(tx.MintTransaction.Outputs),
+			Nullifiers: tx.MintTransaction.Nullifiers,
+			Fee:        tx.MintTransaction.Fee,
+			MintID:     tx.MintTransaction.Asset_ID,
+			MintAmount: tx.MintTransaction.NewTokens,
+		}
+		setLocktime(params, tx.MintTransaction.Locktime, makeParamsConfig(opts))
+		return zk.StandardCircuitID, params, nil
+	case *Transaction_StakeTransaction:
+		params, err := StakePublicParams(tx.StakeTransaction)
+		return zk.StakeCircuitID, params, err
+	}
+	return "", nil, ErrNoProof
+}
+
+// StandardPublicParams returns the public params of the standard circuit
+// for the transaction.
+func StandardPublicParams(tx *StandardTransaction, opts ...ParamsOption) (*standard.PublicParams, error) {
+	sigHash, err := tx.SigHash()
+	if err != nil {
+		return nil, err
+	}
+	params := &standard.PublicParams{
+		TXORoot:    tx.TxoRoot,
+		SigHash:    sigHash,
+		Outputs:    publicOutputs(tx.Outputs),
+		Nullifiers: tx.Nullifiers,
+		Fee:        tx.Fee,
+	}
+	setLocktime(params, tx.Locktime, makeParamsConfig(opts))
+	return params, nil
+}
+
+// StakePublicParams returns the public params of the stake circuit for
+// the transaction.
+func StakePublicParams(tx *StakeTransaction) (*stake.PublicParams, error) {
+	sigHash, err := tx.SigHash()
+	if err != nil {
+		return nil, err
+	}
+	return &stake.PublicParams{
+		TXORoot:     tx.TxoRoot,
+		SigHash:     sigHash,
+		Amount:      tx.Amount,
+		Nullifier:   tx.Nullifier,
+		LockedUntil: time.Unix(tx.LockedUntil, 0),
+	}, nil
+}
+
+func setLocktime(params *standard.PublicParams, locktime *Locktime, cfg paramsConfig) {
+	if locktime != nil {
+		params.Locktime = time.Unix(locktime.Timestamp, 0)
+		params.LocktimePrecision = time.Duration(locktime.Precision)
+		if cfg.locktimeSeconds {
+			params.LocktimePrecision *= time.Second
+		}
+	}
+}
+
+func publicOutputs(outs []*Output) []standard.PublicOutput {
+	outputs := make([]standard.PublicOutput, 0, len(outs))
+	for _, out := range outs {
+		outputs = append(outputs, standard.PublicOutput{
+			Commitment: out.Commitment,
+			CipherText: out.Ciphertext,
+		})
+	}
+	return outputs
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package transactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicParamsFromTx(t *testing.T) {
+	outputs := []*Output{{Commitment: []byte{0x01}, Ciphertext: []byte{0x02}}}
+	expectedOutputs := []standard.PublicOutput{{Commitment: []byte{0x01}, CipherText: []byte{0x02}}}
+
+	standardTx := &StandardTransaction{
+		Outputs:    outputs,
+		Nullifiers: [][]byte{{0x03}},
+		TxoRoot:    []byte{0x04},
+		Locktime:   &Locktime{Timestamp: 1700000000, Precision: 600},
+		Fee:        10,
+	}
+	id, params, err := PublicParamsFromTx(WrapTransaction(standardTx))
+	assert.NoError(t, err)
+	assert.Equal(t, zk.StandardCircuitID, id)
+	sigHash, err := standardTx.SigHash()
+	assert.NoError(t, err)
+	assert.Equal(t, &standard.PublicParams{
+		TXORoot:           []byte{0x04},
+		SigHash:           sigHash,
+		Outputs:           expectedOutputs,
+		Nullifiers:        [][]byte{{0x03}},
+		Fee:               10,
+		Locktime:          time.Unix(1700000000, 0),
+		LocktimePrecision: time.Duration(600),
+	}, params)
+
+	_, params, err = PublicParamsFromTx(WrapTransaction(standardTx), LocktimeSeconds(true))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second*600, params.(*standard.PublicParams).LocktimePrecision)
+
+	mintTx := &MintTransaction{
+		Asset_ID:  []byte{0x05},
+		NewTokens: 1000,
+		Outputs:   outputs,
+	}
+	id, params, err = PublicParamsFromTx(WrapTransaction(mintTx))
+	assert.NoError(t, err)
+	assert.Equal(t, zk.StandardCircuitID, id)
+	assert.Equal(t, []byte{0x05}, params.(*standard.PublicParams).MintID)
+	assert.Equal(t, uint64(1000), params.(*standard.PublicParams).MintAmount)
+
+	coinbaseTx := &CoinbaseTransaction{
+		NewCoins: 5000,
+		Outputs:  outputs,
+	}
+	id, params, err = PublicParamsFromTx(WrapTransaction(coinbaseTx))
+	assert.NoError(t, err)
+	assert.Equal(t, zk.StandardCircuitID, id)
+	assert.Equal(t, uint64(5000), params.(*standard.PublicParams).Coinbase)
+	assert.Equal(t, expectedOutputs, params.(*standard.PublicParams).Outputs)
+
+	stakeTx := &StakeTransaction{
+		Amount:      2000,
+		Nullifier:   []byte{0x06},
+		TxoRoot:     []byte{0x07},
+		LockedUntil: 1700000000,
+	}
+	id, params, err = PublicParamsFromTx(WrapTransaction(stakeTx))
+	assert.NoError(t, err)
+	assert.Equal(t, zk.StakeCircuitID, id)
+	sigHash, err = stakeTx.SigHash()
+	assert.NoError(t, err)
+	assert.Equal(t, &stake.PublicParams{
+		TXORoot:     []byte{0x07},
+		SigHash:     sigHash,
+		Amount:      2000,
+		Nullifier:   []byte{0x06},
+		LockedUntil: time.Unix(1700000000, 0),
+	}, params)
+
+	_, _, err = PublicParamsFromTx(&Transaction{})
+	assert.ErrorIs(t, err, ErrNoProof)
+}
@@ -129,12 +129,20 @@ func (tx *Transaction) Serialize() ([]byte, error) {
 	return proto.Marshal(tx)
 }
 
+// SerializedSize returns the size of the serialized transaction in bytes.
+// The size is computed without marshaling the transaction.
 func (tx *Transaction) SerializedSize() (int, error) {
-	ser, err := proto.Marshal(tx)
-	if err != nil {
-		return 0, err
-	}
-	return len(ser), nil
+	return proto.Size(tx), nil
+}
+
+// Weight returns the weight of the transaction. This is the value used
+// by fee rate policy, block size limits and block template building and
+// should be used in preference to the serialized size for those purposes
+// so that the measure is consistent everywhere.
+//
+// The weight is currently equal to the serialized size.
+func (tx *Transaction) Weight() int {
+	return proto.Size(tx)
 }
 
 func (tx *Transaction) Deserialize(data []byte) error {
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnknownVersion is returned when a version is not
+	// in the VersionSchedule.
+	ErrUnknownVersion = errors.New("unknown version")
+
+	// ErrVersionInactive is returned when a known version is
+	// used at a height where it is not active.
+	ErrVersionInactive = errors.New("version not active at height")
+)
+
+// VersionDeployment describes the range of heights over which a
+// version is valid.
+type VersionDeployment struct {
+	// Version is the version number.
+	Version uint32
+
+	// ActivationHeight is the first height at which the version
+	// is valid.
+	ActivationHeight uint32
+
+	// ExpirationHeight is the first height at which the version
+	// is no longer valid. Zero means the version never expires.
+	ExpirationHeight uint32
+}
+
+// IsActive returns whether the deployment is active at the height.
+func (d VersionDeployment) IsActive(height uint32) bool {
+	if height < d.ActivationHeight {
+		return false
+	}
+	return d.ExpirationHeight == 0 || height < d.ExpirationHeight
+}
+
+// VersionSchedule is the set of versions known to the node for a
+// given type (for example block headers). New fields or rules can be
+// introduced behind a new version by adding a deployment to the
+// schedule and checking the version rather than the height.
+type VersionSchedule []VersionDeployment
+
+// IsKnownVersion returns whether the version is in the schedule.
+func (s VersionSchedule) IsKnownVersion(version uint32) bool {
+	_, ok := s.deployment(version)
+	return ok
+}
+
+// ValidateForHeight returns an error if the version is unknown or
+// is not active at the height.
+func (s VersionSchedule) ValidateForHeight(version uint32, height uint32) error {
+	d, ok := s.deployment(version)
+	if !ok {
+		return ErrUnknownVersion
+	}
+	if !d.IsActive(height) {
+		return ErrVersionInactive
+	}
+	return nil
+}
+
+// LatestForHeight returns the largest version that is active at the
+// height. False is returned if no version is active.
+func (s VersionSchedule) LatestForHeight(height uint32) (uint32, bool) {
+	var (
+		latest uint32
+		found  bool
+	)
+	for _, d := range s {
+		if d.IsActive(height) && (!found || d.Version > latest) {
+			latest = d.Version
+			found = true
+		}
+	}
+	return latest, found
+}
+
+func (s VersionSchedule) deployment(version uint32) (VersionDeployment, bool) {
+	for _, d := range s {
+		if d.Version == version {
+			return d, true
+		}
+	}
+	return VersionDeployment{}, false
+}
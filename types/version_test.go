@@ -0,0 +1,39 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionSchedule(t *testing.T) {
+	schedule := VersionSchedule{
+		{Version: 1, ActivationHeight: 0, ExpirationHeight: 100},
+		{Version: 2, ActivationHeight: 50},
+	}
+
+	assert.True(t, schedule.IsKnownVersion(1))
+	assert.True(t, schedule.IsKnownVersion(2))
+	assert.False(t, schedule.IsKnownVersion(3))
+
+	assert.NoError(t, schedule.ValidateForHeight(1, 0))
+	assert.NoError(t, schedule.ValidateForHeight(1, 99))
+	assert.ErrorIs(t, schedule.ValidateForHeight(1, 100), ErrVersionInactive)
+	assert.ErrorIs(t, schedule.ValidateForHeight(2, 49), ErrVersionInactive)
+	assert.NoError(t, schedule.ValidateForHeight(2, 50))
+	assert.ErrorIs(t, schedule.ValidateForHeight(3, 50), ErrUnknownVersion)
+
+	v, ok := schedule.LatestForHeight(10)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), v)
+	v, ok = schedule.LatestForHeight(75)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), v)
+
+	_, ok = VersionSchedule{}.LatestForHeight(0)
+	assert.False(t, ok)
+}
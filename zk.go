@@ -0,0 +1,395 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"runtime"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/project-illium/ilxd/zk/remoteprover"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// zkCommand is the name of the ilxd subcommand for working with the
+// zk prover. It is handled before the node options are parsed.
+const zkCommand = "zk"
+
+// runZKCommand runs the `ilxd zk` subcommand with the arguments
+// following it.
+func runZKCommand(args []string) error {
+	parser := flags.NewNamedParser("ilxd zk", flags.Default)
+	parser.AddCommand("bench", "Benchmarks proof creation and verification", "Creates and verifies proofs for the standard and stake circuits across a range of input and output counts and prints the timing and memory use of each. The results can be used to size validator hardware and to compare releases.", &ZKBench{out: os.Stdout})
+	parser.AddCommand("serve", "Runs a remote prover service", "Creates proofs for nodes and wallets configured with --remoteprover. Clients must authenticate with the token or, if a client CA is provided, with a certificate it signed. The private params of the proofs are sent to the service so it must only be run on a machine the clients' owner controls.", &ZKServe{})
+	_, err := parser.ParseArgs(args)
+	return err
+}
+
+// ZKServe is the `ilxd zk serve` command.
+type ZKServe struct {
+	Listen    string `short:"l" long:"listen" description:"The host:port to listen for proof requests on" default:"127.0.0.1:5002"`
+	DataDir   string `short:"d" long:"datadir" description:"The directory the lurk public parameters are cached in" default:"~/.ilxd"`
+	AuthToken string `short:"t" long:"authtoken" description:"The token clients must authenticate with"`
+	TLSCert   string `long:"tlscert" description:"A path to the TLS certificate of the service" required:"true"`
+	TLSKey    string `long:"tlskey" description:"A path to the key of the TLS certificate" required:"true"`
+	ClientCA  string `long:"clientca" description:"A path to the certificate authority which signs the client certificates. Clients must present a certificate it signed."`
+	Workers   int    `long:"workers" description:"The maximum number of proofs to create at once" default:"1"`
+}
+
+func (x *ZKServe) Execute(args []string) error {
+	var (
+		opts     []remoteprover.ServerOption
+		clientCA string
+	)
+	if x.AuthToken != "" {
+		opts = append(opts, remoteprover.ServerAuthToken(x.AuthToken))
+	}
+	if x.ClientCA != "" {
+		opts = append(opts, remoteprover.ClientCertAuth())
+		clientCA = repo.CleanAndExpandPath(x.ClientCA)
+	}
+	proverServer, err := remoteprover.NewServer(zk.DefaultProver(), opts...)
+	if err != nil {
+		return err
+	}
+	tlsConfig, err := remoteprover.ServerTLSConfig(repo.CleanAndExpandPath(x.TLSCert), repo.CleanAndExpandPath(x.TLSKey), clientCA)
+	if err != nil {
+		return err
+	}
+	if err := zk.ConfigureProver(zk.ProverWorkers(x.Workers)); err != nil {
+		return err
+	}
+	if err := zk.LoadZKPublicParametersFromCache(repo.CleanAndExpandPath(x.DataDir)); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", x.Listen)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	proverServer.Register(server)
+	fmt.Printf("Prover service listening on %s\n", listener.Addr())
+	return server.Serve(listener)
+}
+
+// ZKBench is the `ilxd zk bench` command.
+type ZKBench struct {
+	Circuit    string `short:"c" long:"circuit" description:"The circuit to benchmark" choice:"standard" choice:"stake" choice:"all" default:"all"`
+	Inputs     []int  `short:"i" long:"inputs" description:"A number of inputs to benchmark the standard circuit with. May be repeated." default:"1" default:"2" default:"4" default:"8"`
+	Outputs    []int  `short:"o" long:"outputs" description:"A number of outputs to benchmark the standard circuit with. May be repeated." default:"2"`
+	Iterations int    `short:"n" long:"iterations" description:"The number of proofs to create for each benchmark" default:"5"`
+
+	out io.Writer
+}
+
+// zkBenchResult holds the measurements of one benchmark.
+type zkBenchResult struct {
+	name       string
+	inputs     int
+	outputs    int
+	prove      time.Duration
+	verify     time.Duration
+	proofSize  int
+	allocBytes uint64
+	sysBytes   uint64
+}
+
+func (x *ZKBench) Execute(args []string) error {
+	if x.Iterations < 1 {
+		return errors.New("iterations must be at least one")
+	}
+	var results []zkBenchResult
+	if x.Circuit == "standard" || x.Circuit == "all" {
+		for _, numInputs := range x.Inputs {
+			for _, numOutputs := range x.Outputs {
+				if numInputs < 1 || numOutputs < 1 {
+					return fmt.Errorf("invalid size %d inputs %d outputs", numInputs, numOutputs)
+				}
+				priv, pub, err := benchStandardParams(numInputs, numOutputs)
+				if err != nil {
+					return err
+				}
+				circuit := standard.NewStandardCircuit(numInputs, numOutputs)
+				res, err := x.run(circuit, priv, pub)
+				if err != nil {
+					return err
+				}
+				res.name, res.inputs, res.outputs = "standard", numInputs, numOutputs
+				results = append(results, res)
+			}
+		}
+	}
+	if x.Circuit == "stake" || x.Circuit == "all" {
+		priv, pub, err := benchStakeParams()
+		if err != nil {
+			return err
+		}
+		res, err := x.run(stake.StakeCircuit, priv, pub)
+		if err != nil {
+			return err
+		}
+		res.name, res.inputs = "stake", 1
+		results = append(results, res)
+	}
+
+	out := x.out
+	if out == nil {
+		out = os.Stdout
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "circuit\tinputs\toutputs\tprove\tverify\tproof size\talloc/proof\tsys mem\n")
+	for _, res := range results {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%d B\t%d KiB\t%d MiB\n", res.name, res.inputs, res.outputs,
+			res.prove, res.verify, res.proofSize, res.allocBytes>>10, res.sysBytes>>20)
+	}
+	return w.Flush()
+}
+
+// run creates and verifies a proof for the params Iterations times and
+// returns the average time and allocations of each.
+func (x *ZKBench) run(circuit zk.CircuitFunc, priv, pub interface{}) (zkBenchResult, error) {
+	var (
+		res      zkBenchResult
+		before   runtime.MemStats
+		after    runtime.MemStats
+		proveDur time.Duration
+		verDur   time.Duration
+	)
+	prover := zk.DefaultProver()
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < x.Iterations; i++ {
+		start := time.Now()
+		proof, err := prover.CreateProof(circuit, priv, pub)
+		if err != nil {
+			return res, err
+		}
+		proveDur += time.Since(start)
+
+		start = time.Now()
+		valid, err := prover.VerifyProof(circuit, pub, proof)
+		if err != nil {
+			return res, err
+		}
+		if !valid {
+			return res, errors.New("benchmark proof is invalid")
+		}
+		verDur += time.Since(start)
+		res.proofSize = len(proof)
+	}
+	runtime.ReadMemStats(&after)
+
+	res.prove = proveDur / time.Duration(x.Iterations)
+	res.verify = verDur / time.Duration(x.Iterations)
+	res.allocBytes = (after.TotalAlloc - before.TotalAlloc) / uint64(x.Iterations)
+	res.sysBytes = after.Sys
+	return res, nil
+}
+
+// benchLockingScript returns a locking script with random locking params.
+func benchLockingScript() (types.LockingScript, error) {
+	params := [][]byte{make([]byte, 32), make([]byte, 32)}
+	for _, p := range params {
+		if _, err := rand.Read(p); err != nil {
+			return types.LockingScript{}, err
+		}
+	}
+	return types.LockingScript{
+		ScriptCommitment: types.NewID(zk.BasicTransferScriptCommitment()),
+		LockingParams:    params,
+	}, nil
+}
+
+// benchStandardParams returns valid params for the standard circuit
+// spending numInputs notes to numOutputs outputs.
+func benchStandardParams(numInputs, numOutputs int) (*standard.PrivateParams, *standard.PublicParams, error) {
+	var (
+		acc     = blockchain.NewAccumulator()
+		notes   []types.SpendNote
+		scripts []types.LockingScript
+	)
+	for i := 0; i < numInputs; i++ {
+		script, err := benchLockingScript()
+		if err != nil {
+			return nil, nil, err
+		}
+		scriptHash, err := script.Hash()
+		if err != nil {
+			return nil, nil, err
+		}
+		salt, err := types.RandomSalt()
+		if err != nil {
+			return nil, nil, err
+		}
+		note := types.SpendNote{
+			ScriptHash: scriptHash,
+			Amount:     1000000,
+			AssetID:    types.IlliumCoinID,
+			Salt:       salt,
+			State:      types.State{},
+		}
+		commitment, err := note.Commitment()
+		if err != nil {
+			return nil, nil, err
+		}
+		acc.Insert(commitment.Bytes(), true)
+		notes = append(notes, note)
+		scripts = append(scripts, script)
+	}
+
+	sigHash := make([]byte, 32)
+	rand.Read(sigHash)
+	fakeSig := make([]byte, 64)
+	rand.Read(fakeSig)
+
+	root := acc.Root()
+	priv := &standard.PrivateParams{}
+	pub := &standard.PublicParams{
+		TXORoot: root.Bytes(),
+		SigHash: sigHash,
+		Fee:     uint64(numInputs) * 1000000,
+	}
+	for i, note := range notes {
+		commitment, err := note.Commitment()
+		if err != nil {
+			return nil, nil, err
+		}
+		proof, err := acc.GetProof(commitment.Bytes())
+		if err != nil {
+			return nil, nil, err
+		}
+		nullifier, err := types.CalculateNullifier(proof.Index, note.Salt, scripts[i].ScriptCommitment.Bytes(), scripts[i].LockingParams...)
+		if err != nil {
+			return nil, nil, err
+		}
+		priv.Inputs = append(priv.Inputs, standard.PrivateInput{
+			SpendNote:       note,
+			CommitmentIndex: proof.Index,
+			InclusionProof: standard.InclusionProof{
+				Hashes: proof.Hashes,
+				Flags:  proof.Flags,
+			},
+			ScriptCommitment: scripts[i].ScriptCommitment.Bytes(),
+			ScriptParams:     scripts[i].LockingParams,
+			UnlockingParams:  []byte(fmt.Sprintf("(cons 0x%x 0x%x)", fakeSig[:32], fakeSig[32:])),
+		})
+		pub.Nullifiers = append(pub.Nullifiers, nullifier.Bytes())
+	}
+
+	// The outputs split the inputs, less a fee, evenly.
+	for i := 0; i < numOutputs; i++ {
+		script, err := benchLockingScript()
+		if err != nil {
+			return nil, nil, err
+		}
+		scriptHash, err := script.Hash()
+		if err != nil {
+			return nil, nil, err
+		}
+		salt, err := types.RandomSalt()
+		if err != nil {
+			return nil, nil, err
+		}
+		note := types.SpendNote{
+			ScriptHash: scriptHash,
+			Amount:     types.Amount(uint64(numInputs) * 900000 / uint64(numOutputs)),
+			AssetID:    types.IlliumCoinID,
+			Salt:       salt,
+			State:      types.State{},
+		}
+		commitment, err := note.Commitment()
+		if err != nil {
+			return nil, nil, err
+		}
+		priv.Outputs = append(priv.Outputs, standard.PrivateOutput{SpendNote: note})
+		pub.Outputs = append(pub.Outputs, standard.PublicOutput{
+			Commitment: commitment.Bytes(),
+			CipherText: make([]byte, types.ScriptHashLen+types.AmountLen+types.AssetIDLen+types.SaltLen),
+		})
+		pub.Fee -= uint64(note.Amount)
+	}
+	return priv, pub, nil
+}
+
+// benchStakeParams returns valid params for the stake circuit.
+func benchStakeParams() (*stake.PrivateParams, *stake.PublicParams, error) {
+	script, err := benchLockingScript()
+	if err != nil {
+		return nil, nil, err
+	}
+	scriptHash, err := script.Hash()
+	if err != nil {
+		return nil, nil, err
+	}
+	salt, err := types.RandomSalt()
+	if err != nil {
+		return nil, nil, err
+	}
+	note := types.SpendNote{
+		ScriptHash: scriptHash,
+		Amount:     1000000,
+		AssetID:    types.IlliumCoinID,
+		Salt:       salt,
+		State:      types.State{},
+	}
+	commitment, err := note.Commitment()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acc := blockchain.NewAccumulator()
+	acc.Insert(commitment.Bytes(), true)
+	for i := uint32(0); i < 10; i++ {
+		b := make([]byte, 32)
+		binary.BigEndian.PutUint32(b, i)
+		acc.Insert(b, false)
+	}
+	proof, err := acc.GetProof(commitment.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	nullifier, err := types.CalculateNullifier(proof.Index, note.Salt, script.ScriptCommitment.Bytes(), script.LockingParams...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sigHash := make([]byte, 32)
+	rand.Read(sigHash)
+	root := acc.Root()
+	priv := &stake.PrivateParams{
+		SpendNote:       note,
+		CommitmentIndex: proof.Index,
+		InclusionProof: standard.InclusionProof{
+			Hashes: proof.Hashes,
+			Flags:  proof.Flags,
+		},
+		ScriptCommitment: script.ScriptCommitment.Bytes(),
+		ScriptParams:     script.LockingParams,
+	}
+	pub := &stake.PublicParams{
+		TXORoot:   root.Bytes(),
+		SigHash:   sigHash,
+		Amount:    uint64(note.Amount),
+		Nullifier: nullifier.Bytes(),
+	}
+	return priv, pub, nil
+}
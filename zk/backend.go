@@ -0,0 +1,50 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import "context"
+
+// Prover is a proving backend which creates and verifies the proofs of
+// the circuits. It allows alternative backends, such as a GPU
+// accelerated prover, to be used in place of the default.
+type Prover interface {
+	// CreateProof creates a proof that the params are valid for the
+	// circuit.
+	CreateProof(circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error)
+
+	// VerifyProof returns whether the proof is valid for the circuit
+	// and public params.
+	VerifyProof(circuit CircuitFunc, publicParams interface{}, proof []byte) (bool, error)
+}
+
+// ContextProver is a Prover which stops creating a proof when the
+// context is canceled.
+type ContextProver interface {
+	Prover
+
+	// CreateProofWithContext is CreateProof but returns the context's
+	// error if it is canceled before the proof is created.
+	CreateProofWithContext(ctx context.Context, circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error)
+}
+
+// SnarkProver is the default Prover. It creates proofs with CreateSnark
+// and verifies them with ValidateSnark.
+type SnarkProver struct{}
+
+// CreateProof creates a proof that the params are valid for the circuit.
+func (p *SnarkProver) CreateProof(circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return CreateSnark(circuit, privateParams, publicParams)
+}
+
+// VerifyProof returns whether the proof is valid for the circuit and
+// public params.
+func (p *SnarkProver) VerifyProof(circuit CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+	return ValidateSnark(circuit, publicParams, proof)
+}
+
+// DefaultProver returns the Prover used when none is configured.
+func DefaultProver() Prover {
+	return &SnarkProver{}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package stake
+
+import (
+	"encoding/binary"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+)
+
+// Serialize returns the canonical byte serialization of the public params.
+// The fields are written in the order they are declared in:
+//
+//	txo-root     [32]byte
+//	sighash      [32]byte
+//	amount       uint64
+//	nullifier    [32]byte
+//	locked-until int64 (unix seconds)
+//
+// Integers are big endian. The hash fields follow the same rules as
+// standard.PublicParams.Serialize.
+func (pub *PublicParams) Serialize() ([]byte, error) {
+	var (
+		ser []byte
+		err error
+	)
+	if ser, err = standard.AppendHash(ser, pub.TXORoot); err != nil {
+		return nil, err
+	}
+	if ser, err = standard.AppendHash(ser, pub.SigHash); err != nil {
+		return nil, err
+	}
+	ser = binary.BigEndian.AppendUint64(ser, pub.Amount)
+	if ser, err = standard.AppendHash(ser, pub.Nullifier); err != nil {
+		return nil, err
+	}
+	ser = binary.BigEndian.AppendUint64(ser, uint64(pub.LockedUntil.Unix()))
+	return ser, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package stake_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPublicParams_Serialize(t *testing.T) {
+	// This vector must never change. If it does other implementations
+	// will serialize the params differently.
+	params := &stake.PublicParams{
+		TXORoot:     bytes.Repeat([]byte{0x11}, 32),
+		SigHash:     bytes.Repeat([]byte{0x22}, 32),
+		Amount:      1000000,
+		Nullifier:   bytes.Repeat([]byte{0x66}, 32),
+		LockedUntil: time.Unix(1700000000, 0),
+	}
+	expected := "1111111111111111111111111111111111111111111111111111111111111111" +
+		"2222222222222222222222222222222222222222222222222222222222222222" +
+		"00000000000f4240" +
+		"6666666666666666666666666666666666666666666666666666666666666666" +
+		"000000006553f100"
+
+	ser, err := params.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(ser))
+
+	params.Nullifier = params.Nullifier[:31]
+	_, err = params.Serialize()
+	assert.ErrorIs(t, err, standard.ErrInvalidFieldLen)
+}
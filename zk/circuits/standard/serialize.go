@@ -0,0 +1,97 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package standard
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// HashLen is the length of the hash fields in the serialized params.
+const HashLen = 32
+
+// ErrInvalidFieldLen is returned when serializing params containing a
+// hash field that is neither empty nor HashLen bytes.
+var ErrInvalidFieldLen = errors.New("invalid field length")
+
+// Serialize returns the canonical byte serialization of the public params.
+// The fields are written in the order they are declared in:
+//
+//	txo-root    [32]byte
+//	sighash     [32]byte
+//	num-outputs uint32
+//	outputs     num-outputs * (commitment [32]byte, ciphertext-len uint32, ciphertext)
+//	num-nulls   uint32
+//	nullifiers  num-nulls * [32]byte
+//	fee         uint64
+//	coinbase    uint64
+//	mint-id     [32]byte
+//	mint-amount uint64
+//	locktime    int64 (unix seconds)
+//	precision   int64 (seconds)
+//
+// Integers are big endian. Empty hash fields, such as the mint ID of a
+// transaction that does not mint, are written as 32 zero bytes.
+func (pub *PublicParams) Serialize() ([]byte, error) {
+	var (
+		ser []byte
+		err error
+	)
+	if ser, err = AppendHash(ser, pub.TXORoot); err != nil {
+		return nil, err
+	}
+	if ser, err = AppendHash(ser, pub.SigHash); err != nil {
+		return nil, err
+	}
+
+	if uint64(len(pub.Outputs)) > math.MaxUint32 {
+		return nil, ErrInvalidFieldLen
+	}
+	ser = binary.BigEndian.AppendUint32(ser, uint32(len(pub.Outputs)))
+	for _, out := range pub.Outputs {
+		if ser, err = AppendHash(ser, out.Commitment); err != nil {
+			return nil, err
+		}
+		if uint64(len(out.CipherText)) > math.MaxUint32 {
+			return nil, ErrInvalidFieldLen
+		}
+		ser = binary.BigEndian.AppendUint32(ser, uint32(len(out.CipherText)))
+		ser = append(ser, out.CipherText...)
+	}
+
+	if uint64(len(pub.Nullifiers)) > math.MaxUint32 {
+		return nil, ErrInvalidFieldLen
+	}
+	ser = binary.BigEndian.AppendUint32(ser, uint32(len(pub.Nullifiers)))
+	for _, n := range pub.Nullifiers {
+		if ser, err = AppendHash(ser, n); err != nil {
+			return nil, err
+		}
+	}
+
+	ser = binary.BigEndian.AppendUint64(ser, pub.Fee)
+	ser = binary.BigEndian.AppendUint64(ser, pub.Coinbase)
+	if ser, err = AppendHash(ser, pub.MintID); err != nil {
+		return nil, err
+	}
+	ser = binary.BigEndian.AppendUint64(ser, pub.MintAmount)
+	ser = binary.BigEndian.AppendUint64(ser, uint64(pub.Locktime.Unix()))
+	ser = binary.BigEndian.AppendUint64(ser, uint64(int64(pub.LocktimePrecision.Seconds())))
+	return ser, nil
+}
+
+// AppendHash appends a hash field of the canonical serialization to ser.
+// An empty hash is written as HashLen zero bytes.
+func AppendHash(ser []byte, h []byte) ([]byte, error) {
+	switch len(h) {
+	case 0:
+		return append(ser, make([]byte, HashLen)...), nil
+	case HashLen:
+		return append(ser, h...), nil
+	default:
+		return nil, ErrInvalidFieldLen
+	}
+}
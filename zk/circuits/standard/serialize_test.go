@@ -0,0 +1,86 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package standard_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPublicParams_Serialize(t *testing.T) {
+	// These vectors must never change. If they do other implementations
+	// will serialize the params differently.
+	tests := []struct {
+		name     string
+		params   *standard.PublicParams
+		expected string
+	}{
+		{
+			name: "standard",
+			params: &standard.PublicParams{
+				TXORoot: bytes.Repeat([]byte{0x11}, 32),
+				SigHash: bytes.Repeat([]byte{0x22}, 32),
+				Outputs: []standard.PublicOutput{
+					{Commitment: bytes.Repeat([]byte{0x33}, 32), CipherText: []byte{0x44, 0x44, 0x44}},
+					{Commitment: bytes.Repeat([]byte{0x55}, 32)},
+				},
+				Nullifiers:        [][]byte{bytes.Repeat([]byte{0x66}, 32)},
+				Fee:               1000,
+				Locktime:          time.Unix(1700000000, 0),
+				LocktimePrecision: time.Minute * 10,
+			},
+			expected: "1111111111111111111111111111111111111111111111111111111111111111" +
+				"2222222222222222222222222222222222222222222222222222222222222222" +
+				"00000002" +
+				"3333333333333333333333333333333333333333333333333333333333333333" + "00000003" + "444444" +
+				"5555555555555555555555555555555555555555555555555555555555555555" + "00000000" +
+				"00000001" +
+				"6666666666666666666666666666666666666666666666666666666666666666" +
+				"00000000000003e8" +
+				"0000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000" +
+				"000000006553f100" +
+				"0000000000000258",
+		},
+		{
+			name: "mint",
+			params: &standard.PublicParams{
+				TXORoot:    bytes.Repeat([]byte{0x11}, 32),
+				SigHash:    bytes.Repeat([]byte{0x22}, 32),
+				Nullifiers: [][]byte{bytes.Repeat([]byte{0x66}, 32)},
+				Fee:        1000,
+				Coinbase:   123,
+				MintID:     bytes.Repeat([]byte{0x77}, 32),
+				MintAmount: 5000,
+				Locktime:   time.Unix(0, 0),
+			},
+			expected: "1111111111111111111111111111111111111111111111111111111111111111" +
+				"2222222222222222222222222222222222222222222222222222222222222222" +
+				"00000000" +
+				"00000001" +
+				"6666666666666666666666666666666666666666666666666666666666666666" +
+				"00000000000003e8" +
+				"000000000000007b" +
+				"7777777777777777777777777777777777777777777777777777777777777777" +
+				"0000000000001388" +
+				"0000000000000000" +
+				"0000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		ser, err := test.params.Serialize()
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, hex.EncodeToString(ser), test.name)
+	}
+
+	_, err := (&standard.PublicParams{TXORoot: []byte{0x01}}).Serialize()
+	assert.ErrorIs(t, err, standard.ErrInvalidFieldLen)
+}
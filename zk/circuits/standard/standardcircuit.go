@@ -15,6 +15,16 @@ import (
 
 var ErrIntegerOverflow = errors.New("integer overflow")
 
+var (
+	// ErrTooManyInputs is returned when the params have more inputs
+	// than the circuit supports.
+	ErrTooManyInputs = errors.New("too many inputs for circuit")
+
+	// ErrTooManyOutputs is returned when the params have more outputs
+	// than the circuit supports.
+	ErrTooManyOutputs = errors.New("too many outputs for circuit")
+)
+
 var (
 	defaultAssetID [types.AssetIDLen]byte
 )
@@ -67,9 +77,39 @@ type UnlockingScriptInputs struct {
 	ScriptParams  [][]byte
 }
 
-// This whole function is a placeholder for the actual zk-snark circuit. We enumerate it
-// here to give an approximate idea of what the circuit will do.
+// CheckParamsSize returns an error if the params have more inputs or
+// outputs than a circuit supporting maxInputs and maxOutputs accepts.
+// A limit of zero means the number is not limited.
+func CheckParamsSize(priv *PrivateParams, pub *PublicParams, maxInputs, maxOutputs int) error {
+	if maxInputs > 0 && (len(priv.Inputs) > maxInputs || len(pub.Nullifiers) > maxInputs) {
+		return ErrTooManyInputs
+	}
+	if maxOutputs > 0 && (len(priv.Outputs) > maxOutputs || len(pub.Outputs) > maxOutputs) {
+		return ErrTooManyOutputs
+	}
+	return nil
+}
+
+// NewStandardCircuit returns the standard circuit sized for up to maxInputs
+// inputs and maxOutputs outputs. A fixed size circuit is faster to prove
+// than a larger one but transactions with more inputs or outputs, such as
+// consolidations, need a circuit which is large enough to prove them.
+func NewStandardCircuit(maxInputs, maxOutputs int) func(privateParams, publicParams interface{}) bool {
+	return func(privateParams, publicParams interface{}) bool {
+		return standardCircuit(maxInputs, maxOutputs, privateParams, publicParams)
+	}
+}
+
+// StandardCircuit is the standard circuit. It accepts any number of inputs
+// and outputs and is the circuit transactions are verified against.
 func StandardCircuit(privateParams, publicParams interface{}) bool {
+	return standardCircuit(0, 0, privateParams, publicParams)
+}
+
+// This whole function is a placeholder for the actual zk-snark circuit. We enumerate it
+// here to give an approximate idea of what the circuit will do. A maxInputs
+// or maxOutputs of zero means the circuit is not limited in size.
+func standardCircuit(maxInputs, maxOutputs int, privateParams, publicParams interface{}) bool {
 	priv, ok := privateParams.(*PrivateParams)
 	if !ok {
 		return false
@@ -78,6 +118,9 @@ func StandardCircuit(privateParams, publicParams interface{}) bool {
 	if !ok {
 		return false
 	}
+	if err := CheckParamsSize(priv, pub, maxInputs, maxOutputs); err != nil {
+		return false
+	}
 	var (
 		inVal = uint64(0)
 		err   error
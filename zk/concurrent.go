@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SnarkProof is a proof along with the circuit and public params it is
+// verified against.
+type SnarkProof struct {
+	Circuit      CircuitFunc
+	PublicParams interface{}
+	Proof        []byte
+}
+
+// VerifySnarksConcurrently verifies each proof individually across a
+// pool of goroutines. If any proof is invalid false is returned along
+// with the indexes, in ascending order, of the invalid proofs. If a proof
+// cannot be verified the error of the first such proof is returned.
+//
+// This is not batch verification. The lurk library does not expose a
+// batched verifier so the cost is the same as verifying the proofs one
+// after another, only spread across the CPUs. It mirrors
+// crypto.ConcurrentVerifier, which does the same for signatures.
+func VerifySnarksConcurrently(proofs []SnarkProof) (bool, []int, error) {
+	return VerifyProofsConcurrently(DefaultProver(), proofs)
+}
+
+// VerifyProofsConcurrently is VerifySnarksConcurrently using the prover
+// to verify the proofs.
+func VerifyProofsConcurrently(prover Prover, proofs []SnarkProof) (bool, []int, error) {
+	if len(proofs) == 0 {
+		return true, nil, nil
+	}
+
+	workers := runtime.NumCPU() * 3
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(proofs) {
+		workers = len(proofs)
+	}
+
+	var (
+		valid = make([]bool, len(proofs))
+		errs  = make([]error, len(proofs))
+		wg    sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(proofs); i += workers {
+				valid[i], errs[i] = prover.VerifyProof(proofs[i].Circuit, proofs[i].PublicParams, proofs[i].Proof)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var invalid []int
+	for i := range proofs {
+		if errs[i] != nil {
+			return false, nil, errs[i]
+		}
+		if !valid[i] {
+			invalid = append(invalid, i)
+		}
+	}
+	return len(invalid) == 0, invalid, nil
+}
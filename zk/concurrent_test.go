@@ -0,0 +1,25 @@
+package zk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVerifySnarksConcurrently(t *testing.T) {
+	valid, invalid, err := VerifySnarksConcurrently(nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+
+	proofs := make([]SnarkProof, 50)
+	for i := range proofs {
+		proofs[i] = SnarkProof{
+			Circuit: func(privateParams, publicParams interface{}) bool { return true },
+			Proof:   make([]byte, 32),
+		}
+	}
+	valid, invalid, err = VerifySnarksConcurrently(proofs)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Empty(t, invalid)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"sort"
+)
+
+// Analysis describes the macros used in a lurk program.
+type Analysis struct {
+	// Macros are the macros used in the program ordered by name.
+	Macros []MacroUsage `json:"macros"`
+	// Imports are the import paths in the program in the order they
+	// first appear.
+	Imports []string `json:"imports,omitempty"`
+}
+
+// MacroUsage is the number of times a macro appears in a program and
+// where.
+type MacroUsage struct {
+	Macro Macro `json:"macro"`
+	Count int   `json:"count"`
+	// Locations are the locations of the macro invocations ordered by
+	// their position in the program.
+	Locations []SourceLocation `json:"locations"`
+}
+
+// Count returns the number of times the macro appears in the program.
+func (a *Analysis) Count(m Macro) int {
+	for _, usage := range a.Macros {
+		if usage.Macro == m {
+			return usage.Count
+		}
+	}
+	return 0
+}
+
+// Analyze returns the macros which appear in the lurk program, which
+// may be a program or a file of modules. The program is analyzed as
+// written: the imports are not resolved, and macros in both branches
+// of an ifdef or ifndef are included.
+//
+// An error is returned if the program cannot be parsed, for example if
+// it uses a macro which does not exist.
+func Analyze(lurkProgram string) (*Analysis, error) {
+	src := &source{text: lurkProgram}
+	nodes, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		analysis = &Analysis{}
+		usages   = make(map[Macro]*MacroUsage)
+		imports  = make(map[string]bool)
+	)
+	var walk func(nodes []*node)
+	walk = func(nodes []*node) {
+		for _, n := range nodes {
+			if n.typ == macroNode {
+				m := Macro(n.value)
+				usage, ok := usages[m]
+				if !ok {
+					usage = &MacroUsage{Macro: m}
+					usages[m] = usage
+				}
+				line, column := sourcePosition(src.text, n.pos)
+				usage.Count++
+				usage.Locations = append(usage.Locations, SourceLocation{Line: line, Column: column})
+
+				if m == Import {
+					if spec, err := parseImport(n); err == nil && !imports[spec.path] {
+						imports[spec.path] = true
+						analysis.Imports = append(analysis.Imports, spec.path)
+					}
+				}
+			}
+			walk(n.children)
+		}
+	}
+	walk(nodes)
+
+	for _, usage := range usages {
+		analysis.Macros = append(analysis.Macros, *usage)
+	}
+	sort.Slice(analysis.Macros, func(i, j int) bool {
+		return analysis.Macros[i].Macro < analysis.Macros[j].Macro
+	})
+	return analysis, nil
+}
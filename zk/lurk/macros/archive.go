@@ -0,0 +1,142 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// LurkArchiveExtension is the file extension of a zip archive of lurk
+// modules. Archives are recognized by their contents so zip and tar.gz
+// archives with other extensions may also be used.
+const LurkArchiveExtension = ".lurkz"
+
+// maxArchiveSize is the limit on the total uncompressed size of the
+// files in a dependency archive.
+const maxArchiveSize = 1 << 26
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// openArchive opens the zip or tar.gz archive as a file system. If the
+// archive contains a single top level directory, as release archives
+// often do, the file system is rooted at that directory.
+func openArchive(archivePath string) (fs.FS, error) {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	return openArchiveData(archivePath, data)
+}
+
+// openArchiveData opens the contents of the zip or tar.gz archive at
+// the path like openArchive.
+func openArchiveData(archivePath string, data []byte) (fs.FS, error) {
+	var (
+		fileSystem fs.FS
+		err        error
+	)
+	switch {
+	case bytes.HasPrefix(data, zipMagic):
+		fileSystem, err = zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	case bytes.HasPrefix(data, gzipMagic):
+		fileSystem, err = tarGzipFS(data)
+	default:
+		return nil, fmt.Errorf("%s is not a zip or tar.gz archive", archivePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening archive %s: %w", archivePath, err)
+	}
+	if err := checkArchiveSize(fileSystem); err != nil {
+		return nil, fmt.Errorf("error opening archive %s: %w", archivePath, err)
+	}
+
+	entries, err := fs.ReadDir(fileSystem, ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		return fs.Sub(fileSystem, entries[0].Name())
+	}
+	return fileSystem, nil
+}
+
+// tarGzipFS returns a file system of the regular files in the tar.gz
+// archive. The files are copied into an in memory zip archive, which
+// implements fs.FS.
+func tarGzipFS(data []byte) (fs.FS, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var (
+		buf  bytes.Buffer
+		zw   = zip.NewWriter(&buf)
+		tr   = tar.NewReader(gz)
+		size int64
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("invalid file name %s", hdr.Name)
+		}
+		size += hdr.Size
+		if size > maxArchiveSize {
+			return nil, errors.New("archive is too large")
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(w, tr); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+}
+
+// checkArchiveSize returns an error if the files in the archive are
+// larger than maxArchiveSize when uncompressed.
+func checkArchiveSize(fileSystem fs.FS) error {
+	r, ok := fileSystem.(*zip.Reader)
+	if !ok {
+		return nil
+	}
+	var size uint64
+	for _, f := range r.File {
+		size += f.UncompressedSize64
+		if size > maxArchiveSize {
+			return errors.New("archive is too large")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+// PreprocessAll preprocesses the lurk programs concurrently and returns
+// the preprocessed programs in the same order. The files in the
+// dependency directory are parsed at most once and shared by all the
+// programs, rather than parsed again for every import, which makes
+// preprocessing many programs that import the same modules much faster.
+//
+// If any of the programs cannot be preprocessed the error of the first
+// of them is returned.
+func (p *MacroPreprocessor) PreprocessAll(lurkPrograms []string) ([]string, error) {
+	depDir := p.depDir
+	if depDir != nil {
+		cpy := *depDir
+		if cpy.fileSystem != nil {
+			cpy.parsed = newParsedFiles()
+		}
+		if cpy.stdlib != nil {
+			stdlib := *cpy.stdlib
+			stdlib.parsed = newParsedFiles()
+			cpy.stdlib = &stdlib
+		}
+		depDir = &cpy
+	}
+
+	var (
+		outputs = make([]string, len(lurkPrograms))
+		errs    = make([]error, len(lurkPrograms))
+		jobs    = make(chan int)
+		wg      sync.WaitGroup
+	)
+	workers := runtime.NumCPU()
+	if workers > len(lurkPrograms) {
+		workers = len(lurkPrograms)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				outputs[i], errs[i] = p.preprocess(lurkPrograms[i], depDir)
+			}
+		}()
+	}
+	for i := range lurkPrograms {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("program %d: %w", i, err)
+		}
+	}
+	return outputs, nil
+}
+
+// parsedFiles caches the files loaded from the directories of a
+// dependency directory. The cached nodes are shared by the programs
+// importing them so they must never be modified.
+type parsedFiles struct {
+	dirs map[string]*parsedDir
+	mtx  sync.Mutex
+}
+
+// parsedDir is the result of loading the files in a directory. It is
+// loaded once, even if requested concurrently.
+type parsedDir struct {
+	once  sync.Once
+	files [][]*node
+	err   error
+}
+
+func newParsedFiles() *parsedFiles {
+	return &parsedFiles{dirs: make(map[string]*parsedDir)}
+}
+
+// loadFiles loads the lurk files in the directory like loadFilesFromFS,
+// using the parsed files if set.
+func (d *fsDirectory) loadFiles(directory string, defines map[string]bool) ([][]*node, error) {
+	if d.parsed == nil {
+		return loadFilesFromFS(d.fileSystem, directory, defines)
+	}
+	key := filepath.Clean(directory)
+	d.parsed.mtx.Lock()
+	dir, ok := d.parsed.dirs[key]
+	if !ok {
+		dir = &parsedDir{}
+		d.parsed.dirs[key] = dir
+	}
+	d.parsed.mtx.Unlock()
+
+	dir.once.Do(func() {
+		dir.files, dir.err = loadFilesFromFS(d.fileSystem, directory, defines)
+	})
+	return dir.files, dir.err
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Bundle reads the lurk file at the entry path and returns a single
+// self-contained file with all of its imports resolved. The entry file
+// is expected to declare one or more modules. Only the conditional and
+// import macros are expanded so the modules in the bundle can themselves
+// be imported like any other dependency.
+//
+// The bundle starts with a comment header listing the modules imported
+// by the entry file and, for remote dependencies, the URL and hash they
+// were pinned to.
+func (p *MacroPreprocessor) Bundle(entryPath string) (string, error) {
+	content, err := os.ReadFile(entryPath)
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Base(entryPath)
+	nodes, err := parse(&source{file: file, text: string(content)})
+	if err != nil {
+		return "", err
+	}
+	nodes, err = expandConditionals(nodes, p.defines)
+	if err != nil {
+		return "", err
+	}
+	if !containsMacro(nodes, Module) {
+		return "", errors.New("entry file does not declare any modules")
+	}
+
+	var imports []string
+	var collect func(nodes []*node)
+	collect = func(nodes []*node) {
+		for _, n := range nodes {
+			if n.isMacro(Import) {
+				if spec, err := parseImport(n); err == nil {
+					imports = append(imports, spec.path)
+				}
+			}
+			collect(n.children)
+		}
+	}
+	collect(nodes)
+
+	nodes, err = resolveImports(nodes, p.depDir, p.defines, p.newBudget())
+	if err != nil {
+		return "", err
+	}
+	bundle := p.bundleHeader(file, imports) + serialize(p.stripComments(nodes)) + "\n"
+	if err := p.limits.checkOutputSize(bundle); err != nil {
+		return "", err
+	}
+	return bundle, nil
+}
+
+// bundleHeader returns the comment header of a bundle listing the
+// imports of the entry file.
+func (p *MacroPreprocessor) bundleHeader(file string, imports []string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(";; bundle: %s\n", file))
+
+	sort.Strings(imports)
+	seen := make(map[string]bool)
+	for _, imp := range imports {
+		if seen[imp] {
+			continue
+		}
+		seen[imp] = true
+		sb.WriteString(fmt.Sprintf(";; import: %s", imp))
+
+		name := strings.Split(imp, "/")[0]
+		if p.depDir != nil && p.depDir.remote != nil {
+			if dep, ok := p.depDir.remote.lockfile.Dependencies[name]; ok {
+				sb.WriteString(fmt.Sprintf(" url=%s sha256=%s", dep.URL, dep.Sha256))
+			}
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
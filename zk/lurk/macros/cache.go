@@ -0,0 +1,300 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// cacheVersion is included in the cache key so that entries are
+// invalidated when the preprocessor output changes.
+const cacheVersion = "1"
+
+// missingDependency is the hash recorded for a dependency file or
+// directory which does not exist.
+const missingDependency = "missing"
+
+// cacheEntry is a preprocessed program along with the dependency files
+// and directories read to expand it.
+type cacheEntry struct {
+	Output string `json:"output"`
+	// Dependencies maps the path of each file, or directory with a
+	// trailing slash, in the dependency directory to the hash of its
+	// contents or listing.
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// expansionCache caches the output of Preprocess in memory and,
+// optionally, on disk. Entries are keyed by the hash of the program and
+// the options affecting the output. An entry is only used if the
+// dependency files it was expanded from are unchanged.
+type expansionCache struct {
+	dir     string
+	entries map[string]*cacheEntry
+	mtx     sync.Mutex
+}
+
+func newExpansionCache(dir string) *expansionCache {
+	return &expansionCache{
+		dir:     dir,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// get returns the cached output for the key if its dependencies in the
+// file system are unchanged.
+func (c *expansionCache) get(key string, fileSystem fs.FS) (string, bool) {
+	c.mtx.Lock()
+	entry, ok := c.entries[key]
+	c.mtx.Unlock()
+
+	if !ok && c.dir != "" {
+		data, err := os.ReadFile(filepath.Join(c.dir, key+".json"))
+		if err != nil {
+			return "", false
+		}
+		entry = new(cacheEntry)
+		if err := json.Unmarshal(data, entry); err != nil {
+			return "", false
+		}
+	}
+	if entry == nil || !entry.valid(fileSystem) {
+		return "", false
+	}
+
+	c.mtx.Lock()
+	c.entries[key] = entry
+	c.mtx.Unlock()
+	return entry.Output, true
+}
+
+// put adds the entry to the cache. Failing to write the entry to disk
+// is not an error as the program can always be expanded again.
+func (c *expansionCache) put(key string, entry *cacheEntry) {
+	c.mtx.Lock()
+	c.entries[key] = entry
+	c.mtx.Unlock()
+
+	if c.dir == "" {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return
+	}
+	// Write to a temporary file first so a concurrent reader never
+	// sees a partially written entry.
+	tmp, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(c.dir, key+".json"))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// valid returns whether the dependencies of the entry are unchanged.
+func (e *cacheEntry) valid(fileSystem fs.FS) bool {
+	for name, hash := range e.Dependencies {
+		if fileSystem == nil {
+			return false
+		}
+		var current string
+		if strings.HasSuffix(name, "/") {
+			entries, err := fs.ReadDir(fileSystem, strings.TrimSuffix(name, "/"))
+			current = hashDirectory(entries, err)
+		} else {
+			data, err := fs.ReadFile(fileSystem, name)
+			current = hashFile(data, err)
+		}
+		if current != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheKey returns the hash of the program along with the options
+// affecting the output.
+func (p *MacroPreprocessor) cacheKey(lurkProgram string) string {
+	var defines []string
+	for feature, defined := range p.defines {
+		if defined {
+			defines = append(defines, feature)
+		}
+	}
+	sort.Strings(defines)
+
+	h := sha256.New()
+	write := func(format string, a ...interface{}) {
+		fmt.Fprintf(h, format+"\n", a...)
+	}
+	write("version %s", cacheVersion)
+	write("defines %q", defines)
+	write("comments %t %t %q", p.removeComments, p.preserveHeader, p.preservePrefixes)
+	write("optimize %t %t", p.eliminateDeadCode, p.foldConstants)
+	if p.depDir != nil && p.depDir.remote != nil {
+		// Remote dependencies are pinned by hash so the lockfile
+		// identifies their contents.
+		var names []string
+		for name := range p.depDir.remote.lockfile.Dependencies {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			write("remote %s %s", name, p.depDir.remote.lockfile.Dependencies[name].Sha256)
+		}
+	}
+	if p.layout != nil {
+		layout, _ := json.Marshal(p.layout)
+		write("layout %s", layout)
+	}
+	if p.depDir != nil && p.depDir.stdlib != nil {
+		// The embedded standard library changes with the binary.
+		write("stdlib %s", embeddedStdlibHash())
+	}
+	write("program %d", len(lurkProgram))
+	h.Write([]byte(lurkProgram))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// preprocessCached returns the cached output for the program, or
+// expands the program and caches the output.
+func (p *MacroPreprocessor) preprocessCached(lurkProgram string, depDir *fsDirectory) (string, error) {
+	key := p.cacheKey(lurkProgram)
+
+	var fileSystem fs.FS
+	if depDir != nil {
+		fileSystem = depDir.fileSystem
+	}
+	if output, ok := p.cache.get(key, fileSystem); ok {
+		// The entry may have been written with a larger limit.
+		if err := p.limits.checkOutputSize(output); err != nil {
+			return "", err
+		}
+		return output, nil
+	}
+
+	// Record the dependency files read while expanding the program.
+	// Files already parsed would not be read and recorded, so the
+	// parsed files are not used.
+	var recorder *recordingFS
+	if depDir != nil && depDir.fileSystem != nil {
+		recorder = &recordingFS{FS: depDir.fileSystem, deps: make(map[string]string)}
+		cpy := *depDir
+		cpy.fileSystem = recorder
+		cpy.parsed = nil
+		depDir = &cpy
+	}
+	nodes, err := p.expand(lurkProgram, depDir)
+	if err != nil {
+		return "", err
+	}
+	output := serialize(p.stripComments(nodes))
+	if err := p.limits.checkOutputSize(output); err != nil {
+		return "", err
+	}
+
+	entry := &cacheEntry{Output: output, Dependencies: make(map[string]string)}
+	if recorder != nil {
+		entry.Dependencies = recorder.deps
+	}
+	p.cache.put(key, entry)
+	return output, nil
+}
+
+// recordingFS records the hash of every file and directory read from
+// the file system.
+type recordingFS struct {
+	fs.FS
+	deps map[string]string
+	mtx  sync.Mutex
+}
+
+// ReadFile implements fs.ReadFileFS.
+func (r *recordingFS) ReadFile(name string) ([]byte, error) {
+	data, err := fs.ReadFile(r.FS, name)
+	r.record(path.Clean(name), hashFile(data, err))
+	return data, err
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (r *recordingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(r.FS, name)
+	r.record(path.Clean(name)+"/", hashDirectory(entries, err))
+	return entries, err
+}
+
+func (r *recordingFS) record(name, hash string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.deps[name] = hash
+}
+
+var (
+	stdlibHash     string
+	stdlibHashOnce sync.Once
+)
+
+// embeddedStdlibHash returns the hash of the files in the embedded
+// standard library.
+func embeddedStdlibHash() string {
+	stdlibHashOnce.Do(func() {
+		h := sha256.New()
+		fs.WalkDir(embeddedDependencyDir, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			data, err := fs.ReadFile(embeddedDependencyDir, name)
+			fmt.Fprintln(h, name, hashFile(data, err))
+			return nil
+		})
+		stdlibHash = hex.EncodeToString(h.Sum(nil))
+	})
+	return stdlibHash
+}
+
+func hashFile(data []byte, err error) string {
+	if err != nil {
+		return missingDependency
+	}
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hashDirectory(entries []fs.DirEntry, err error) string {
+	if err != nil {
+		return missingDependency
+	}
+	h := sha256.New()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			name += "/"
+		}
+		fmt.Fprintln(h, name)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
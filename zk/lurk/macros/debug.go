@@ -0,0 +1,192 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"strings"
+)
+
+// DebugStepKind is the kind of a DebugStep.
+type DebugStepKind int
+
+const (
+	// DebugBinding is the value bound by a let or letrec, as expanded
+	// from the def and defrec macros. Bindings of functions are skipped.
+	DebugBinding DebugStepKind = iota
+	// DebugCondition is the condition of an assert, or of an if whose
+	// else branch is nil. Evaluation only continues past it if it is
+	// true.
+	DebugCondition
+	// DebugResult is the value the program returns.
+	DebugResult
+)
+
+// String returns the kind as a string.
+func (k DebugStepKind) String() string {
+	switch k {
+	case DebugBinding:
+		return "binding"
+	case DebugCondition:
+		return "condition"
+	case DebugResult:
+		return "result"
+	}
+	return "unknown"
+}
+
+// DebugStep is a point in a preprocessed program at which the value of
+// an expression can be inspected.
+type DebugStep struct {
+	// Kind is the kind of the step.
+	Kind DebugStepKind
+	// Name is the name bound by a DebugBinding step.
+	Name string
+	// Expr is the expression evaluated by the step.
+	Expr string
+	// Scope is the program surrounding the expression. Any expression
+	// placed in it is evaluated with the same params and bindings in
+	// scope as Expr.
+	Scope DebugScope
+}
+
+// DebugScope is the program surrounding an expression.
+type DebugScope struct {
+	Prefix string
+	Suffix string
+}
+
+// Wrap returns the program evaluating the expression in the scope.
+func (s DebugScope) Wrap(expr string) string {
+	return s.Prefix + expr + s.Suffix
+}
+
+// Program returns the program which evaluates to the value of the
+// step's expression. It takes the same params as the program the step
+// is from.
+func (s DebugStep) Program() string {
+	return s.Scope.Wrap(s.Expr)
+}
+
+// DebugSteps splits a preprocessed program, a lambda, into the steps
+// its body is evaluated in: the definitions and asserts of the source
+// program followed by the value it returns. Each step carries a
+// program that evaluates just that step, so a failing program can be
+// debugged by evaluating the steps in turn.
+func DebugSteps(lurkProgram string) ([]DebugStep, error) {
+	nodes, err := parse(&source{text: lurkProgram})
+	if err != nil {
+		return nil, err
+	}
+	var root *node
+	for _, n := range nodes {
+		if n.typ == commentNode {
+			continue
+		}
+		if root != nil {
+			return nil, errors.New("program has more than one top level expression")
+		}
+		root = n
+	}
+	if !isForm(root, "lambda", 3) {
+		return nil, errors.New("program is not a lambda")
+	}
+	args := root.args()
+	scope := DebugScope{
+		Prefix: "(lambda " + serialize(args[1:2]) + " ",
+		Suffix: ")",
+	}
+
+	var steps []DebugStep
+	body := args[2]
+	for {
+		switch {
+		case isForm(body, "let", 3) || isForm(body, "letrec", 3):
+			bodyArgs := body.args()
+			bindings := bodyArgs[1].args()
+			for i, binding := range bindings {
+				b := binding.args()
+				if binding.typ != listNode || len(b) != 2 {
+					return nil, errors.New("malformed binding")
+				}
+				if isForm(b[1], "lambda", 3) {
+					continue
+				}
+				steps = append(steps, DebugStep{
+					Kind: DebugBinding,
+					Name: b[0].value,
+					Expr: b[0].value,
+					Scope: DebugScope{
+						Prefix: scope.Prefix + "(" + bodyArgs[0].value + " (" + serializeSeq(bindings[:i+1]) + ") ",
+						Suffix: ")" + scope.Suffix,
+					},
+				})
+			}
+			scope.Prefix += "(" + bodyArgs[0].value + " (" + serializeSeq(bindings) + ") "
+			scope.Suffix = ")" + scope.Suffix
+			body = bodyArgs[2]
+		case isAssert(body):
+			// The assert macros expand to (if (eq cond nil) nil rest).
+			bodyArgs := body.args()
+			steps = append(steps, DebugStep{
+				Kind:  DebugCondition,
+				Expr:  serialize(bodyArgs[1].args()[1:2]),
+				Scope: scope,
+			})
+			scope.Prefix += "(if " + serialize(bodyArgs[1:2]) + " nil "
+			scope.Suffix = ")" + scope.Suffix
+			body = bodyArgs[3]
+		case isForm(body, "if", 4) && isNil(body.args()[3]):
+			bodyArgs := body.args()
+			cond := serialize(bodyArgs[1:2])
+			steps = append(steps, DebugStep{
+				Kind:  DebugCondition,
+				Expr:  cond,
+				Scope: scope,
+			})
+			scope.Prefix += "(if " + cond + " "
+			scope.Suffix = " nil)" + scope.Suffix
+			body = bodyArgs[2]
+		default:
+			steps = append(steps, DebugStep{
+				Kind:  DebugResult,
+				Expr:  serialize([]*node{body}),
+				Scope: scope,
+			})
+			return steps, nil
+		}
+	}
+}
+
+// isForm returns whether the node is a list of n elements starting with
+// the symbol.
+func isForm(n *node, symbol string, elems int) bool {
+	if n == nil || n.typ != listNode {
+		return false
+	}
+	args := n.args()
+	return len(args) == elems && args[0].typ == atomNode && strings.EqualFold(args[0].value, symbol)
+}
+
+// isAssert returns whether the node is an expanded assert,
+// (if (eq cond nil) nil rest).
+func isAssert(n *node) bool {
+	if !isForm(n, "if", 4) {
+		return false
+	}
+	args := n.args()
+	return isForm(args[1], "eq", 3) && isNil(args[1].args()[2]) && isNil(args[2])
+}
+
+func isNil(n *node) bool {
+	return n.typ == atomNode && n.value == "nil"
+}
+
+// serializeSeq serializes the nodes separated by spaces.
+func serializeSeq(nodes []*node) string {
+	w := &writer{}
+	w.writeSeq(nodes, " ")
+	return w.sb.String()
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros_test
+
+import (
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDebugSteps(t *testing.T) {
+	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib(), macros.RemoveComments())
+	assert.NoError(t, err)
+
+	program, err := mp.Preprocess(`(lambda (locking-params unlocking-params input-index private-params public-params)
+		!(def x (car locking-params))
+		!(defun double (y) (* y 2))
+		!(assert (> x 1))
+		!(def z (double x))
+		!(assert-eq z 4)
+		(= z unlocking-params)
+	)`)
+	assert.NoError(t, err)
+
+	steps, err := macros.DebugSteps(program)
+	assert.NoError(t, err)
+
+	expected := []struct {
+		kind macros.DebugStepKind
+		name string
+		expr string
+	}{
+		{macros.DebugBinding, "x", "x"},
+		{macros.DebugCondition, "", "(> x 1)"},
+		{macros.DebugBinding, "z", "z"},
+		{macros.DebugCondition, "", "(eq z 4)"},
+		{macros.DebugResult, "", "(= z unlocking-params)"},
+	}
+	if assert.Len(t, steps, len(expected)) {
+		for i, e := range expected {
+			assert.Equal(t, e.kind, steps[i].Kind, "step %d", i)
+			assert.Equal(t, e.name, steps[i].Name, "step %d", i)
+			assert.Equal(t, e.expr, steps[i].Expr, "step %d", i)
+			assert.True(t, macros.IsValidLurk(steps[i].Program()), "step %d", i)
+		}
+	}
+	assert.Equal(t, "(lambda (locking-params unlocking-params input-index private-params public-params) (let ((x (car locking-params))) x))", steps[0].Program())
+	assert.Equal(t, program, steps[len(steps)-1].Program())
+
+	_, err = macros.DebugSteps("(+ 1 2)")
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorType identifies the kind of error encountered while preprocessing.
+type ErrorType int
+
+const (
+	// ErrUnclosedParen means an opening parenthesis is never closed.
+	ErrUnclosedParen ErrorType = iota
+	// ErrUnexpectedParen means a closing parenthesis has no matching
+	// opening parenthesis.
+	ErrUnexpectedParen
+	// ErrMismatchedParen means a closing parenthesis does not match the
+	// type of the opening parenthesis, for example "(]".
+	ErrMismatchedParen
+	// ErrUnknownMacro means a macro was invoked that does not exist.
+	ErrUnknownMacro
+	// ErrImport means an imported module or expression could not be
+	// resolved.
+	ErrImport
+	// ErrInvalidMacro means a macro was invoked with invalid arguments.
+	ErrInvalidMacro
+	// ErrUnclosedComment means a #| block comment is never closed.
+	ErrUnclosedComment
+	// ErrLimitExceeded means the program exceeds one of the expansion
+	// limits. The error wraps ErrExpansionLimit.
+	ErrLimitExceeded
+	// ErrUnknownParam means a param macro refers to a parameter or field
+	// which does not exist.
+	ErrUnknownParam
+)
+
+// String returns the ErrorType as a human-readable string.
+func (e ErrorType) String() string {
+	switch e {
+	case ErrUnclosedParen:
+		return "unclosed parenthesis"
+	case ErrUnexpectedParen:
+		return "unexpected closing parenthesis"
+	case ErrMismatchedParen:
+		return "mismatch parenthesis"
+	case ErrUnknownMacro:
+		return "unknown macro"
+	case ErrImport:
+		return "import error"
+	case ErrInvalidMacro:
+		return "invalid macro"
+	case ErrUnclosedComment:
+		return "unclosed comment"
+	case ErrLimitExceeded:
+		return "limit exceeded"
+	case ErrUnknownParam:
+		return "unknown param"
+	}
+	return fmt.Sprintf("unknown error type (%d)", int(e))
+}
+
+// PreprocessError is a structured error returned by the MacroPreprocessor.
+// The location refers to the source as written, before any macros are
+// expanded, so that it points at the spot the circuit author needs to fix.
+type PreprocessError struct {
+	// Type is the kind of error.
+	Type ErrorType
+	// Macro is the innermost macro enclosing the error, if any.
+	Macro string
+	// File is the dependency file containing the error. It is empty if the
+	// error is in the program passed to Preprocess.
+	File string
+	// Line and Column are one-indexed. They are zero if the location
+	// is not known.
+	Line   int
+	Column int
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// Error returns the error as a string in the form file:line:column: description.
+func (e *PreprocessError) Error() string {
+	var sb strings.Builder
+	if e.File != "" {
+		sb.WriteString(e.File)
+	} else {
+		sb.WriteString("<program>")
+	}
+	if e.Line > 0 {
+		sb.WriteString(fmt.Sprintf(":%d:%d", e.Line, e.Column))
+	}
+	sb.WriteString(": ")
+	sb.WriteString(e.Type.String())
+	if e.Macro != "" {
+		sb.WriteString(fmt.Sprintf(" in !(%s)", e.Macro))
+	}
+	if e.Err != nil {
+		sb.WriteString(": ")
+		sb.WriteString(e.Err.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *PreprocessError) Unwrap() error {
+	return e.Err
+}
+
+// knownMacros is the set of macro names which may appear in source files.
+// Module is only used to declare modules in dependency files.
+var knownMacros = map[string]bool{
+	Def.String():      true,
+	Defrec.String():   true,
+	Defun.String():    true,
+	List.String():     true,
+	Param.String():    true,
+	Assert.String():   true,
+	AssertEq.String(): true,
+	Import.String():   true,
+	Ifdef.String():    true,
+	Ifndef.String():   true,
+	Module.String():   true,
+	CheckSig.String(): true,
+	Append.String():   true,
+	Splice.String():   true,
+	Test.String():     true,
+}
+
+// sourcePosition returns the one-indexed line and column of the byte
+// offset in the source.
+func sourcePosition(src string, offset int) (int, int) {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	line := strings.Count(src[:offset], "\n") + 1
+	column := offset - strings.LastIndex(src[:offset], "\n")
+	return line, column
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"strings"
+)
+
+// formatWidth is the line width Format tries to stay within.
+const formatWidth = 80
+
+// bodyForms are the forms which keep their first argument on the same
+// line as the form name and indent their body, for example:
+//
+//	(lambda (x y)
+//	  (+ x y))
+var bodyForms = map[string]bool{
+	"lambda": true,
+	"let":    true,
+	"letrec": true,
+}
+
+// Format parses the lurk program and returns it with its whitespace
+// normalized and its expressions indented. An expression is written on
+// a single line if it fits within 80 columns, otherwise each of its
+// arguments is written on its own line. Comments are kept.
+//
+// The output only depends on the structure of the program, so
+// formatting is stable across runs and formatting an already formatted
+// program returns it unchanged. This makes it suitable for reviewing
+// diffs of generated circuits and for golden-file tests.
+func Format(lurkProgram string) (string, error) {
+	nodes, err := parse(&source{text: lurkProgram})
+	if err != nil {
+		return "", err
+	}
+	f := &formatter{}
+	for i, n := range nodes {
+		if i > 0 && !f.atLineStart() {
+			f.sb.WriteByte('\n')
+		}
+		f.format(n)
+	}
+	if !f.atLineStart() {
+		f.sb.WriteByte('\n')
+	}
+	return f.sb.String(), nil
+}
+
+type formatter struct {
+	sb strings.Builder
+}
+
+func (f *formatter) format(n *node) {
+	col := f.column()
+	if flat := serialize([]*node{n}); !strings.Contains(flat, "\n") && col+len(flat) <= formatWidth {
+		f.sb.WriteString(flat)
+		return
+	}
+
+	switch n.typ {
+	case quoteNode:
+		f.sb.WriteByte('\'')
+		f.format(n.children[0])
+		return
+	case commentNode:
+		f.sb.WriteString(n.value)
+		if !isBlockComment(n.value) {
+			f.sb.WriteByte('\n')
+		}
+		return
+	case atomNode:
+		f.sb.WriteString(n.value)
+		return
+	}
+
+	// The number of children written on the same line as the opening
+	// bracket and the indentation of the remaining children.
+	var (
+		sameLine int
+		indent   = col + 2
+		children = n.children
+	)
+	if n.typ == macroNode {
+		f.sb.WriteString("!(" + n.value)
+		if len(children) > 0 && children[0].typ == atomNode {
+			sameLine = 1
+			if n.isMacro(Defun) {
+				sameLine = 2
+			}
+		}
+	} else {
+		f.sb.WriteByte(n.open)
+		switch {
+		case len(children) == 0:
+		case children[0].typ == atomNode:
+			sameLine = 1
+			if bodyForms[children[0].value] {
+				sameLine = 2
+			}
+		default:
+			// Lists of lists, such as let bindings, are aligned.
+			sameLine = 1
+			indent = col + 1
+		}
+	}
+
+	for i, child := range children {
+		switch {
+		case i >= sameLine:
+			f.newline(indent)
+		case f.atLineStart():
+			f.sb.WriteString(strings.Repeat(" ", indent))
+		case i > 0 || n.typ == macroNode:
+			f.sb.WriteByte(' ')
+		}
+		f.format(child)
+	}
+	if f.atLineStart() {
+		// A line comment must not swallow the closing bracket.
+		f.sb.WriteString(strings.Repeat(" ", indent))
+	}
+	if n.typ == macroNode {
+		f.sb.WriteByte(')')
+	} else {
+		f.sb.WriteByte(closingBracket(n.open))
+	}
+}
+
+func (f *formatter) newline(indent int) {
+	if !f.atLineStart() {
+		f.sb.WriteByte('\n')
+	}
+	f.sb.WriteString(strings.Repeat(" ", indent))
+}
+
+// column returns the zero-indexed column the next byte is written at.
+func (f *formatter) column() int {
+	s := f.sb.String()
+	return len(s) - strings.LastIndexByte(s, '\n') - 1
+}
+
+func (f *formatter) atLineStart() bool {
+	s := f.sb.String()
+	return len(s) > 0 && s[len(s)-1] == '\n'
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ImportGraph is the graph of the modules imported by a lurk program.
+type ImportGraph struct {
+	// Nodes are the program and the modules it imports, directly or
+	// indirectly, ordered by path. The program is the node with an
+	// empty path.
+	Nodes []ImportGraphNode `json:"nodes"`
+	// Edges are the imports ordered by the importing and imported path.
+	Edges []ImportGraphEdge `json:"edges"`
+}
+
+// ImportGraphNode is a module or module expression in the import graph.
+type ImportGraphNode struct {
+	// Path is the import path, for example std/crypto or
+	// std/crypto/checksig.
+	Path string `json:"path"`
+	// Module is the name of the module.
+	Module string `json:"module,omitempty"`
+	// Files are the files declaring the imported nodes. The paths are
+	// relative to the dependency directory, or the cached copy of the
+	// remote dependency.
+	Files []string `json:"files,omitempty"`
+	// Remote is the URL of the remote dependency the module is
+	// imported from, if any.
+	Remote string `json:"remote,omitempty"`
+}
+
+// ImportGraphEdge is an import from one node to another.
+type ImportGraphEdge struct {
+	// From is the path of the importing node, or empty for the program.
+	From string `json:"from"`
+	// To is the import path.
+	To string `json:"to"`
+	// Symbols are the expressions imported, if only some of the module
+	// is imported.
+	Symbols []string `json:"symbols,omitempty"`
+	// Alias is the alias the definitions are imported under, if any.
+	Alias string `json:"alias,omitempty"`
+}
+
+// Modules returns the paths of all the modules and module expressions
+// in the graph, excluding the program.
+func (g *ImportGraph) Modules() []string {
+	var paths []string
+	for _, n := range g.Nodes {
+		if n.Path != "" {
+			paths = append(paths, n.Path)
+		}
+	}
+	return paths
+}
+
+// DependencyGraph returns the graph of the modules imported by the lurk
+// program, directly or through other modules. The options are the same
+// as those used to preprocess the program so that its imports are
+// resolved in the same way. An error is returned if an import cannot
+// be resolved or the imports are circular.
+func DependencyGraph(lurkProgram string, opts ...Option) (*ImportGraph, error) {
+	p, err := NewMacroPreprocessor(opts...)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := parse(&source{text: lurkProgram})
+	if err != nil {
+		return nil, err
+	}
+	nodes, err = expandConditionals(nodes, p.defines)
+	if err != nil {
+		return nil, err
+	}
+
+	if containsMacro(nodes, Import) && (p.depDir == nil || (p.depDir.fileSystem == nil && p.depDir.remote == nil)) {
+		return nil, errors.New("dependency directory not set")
+	}
+
+	b := &graphBuilder{
+		p:       p,
+		nodes:   map[string]*ImportGraphNode{"": {}},
+		edges:   make(map[string]bool),
+		visited: make(map[string]bool),
+	}
+	if err := b.walk(nodes, "", nil); err != nil {
+		return nil, err
+	}
+
+	graph := &ImportGraph{Edges: b.graphEdges}
+	for _, n := range b.nodes {
+		graph.Nodes = append(graph.Nodes, *n)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Path < graph.Nodes[j].Path
+	})
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	return graph, nil
+}
+
+type graphBuilder struct {
+	p          *MacroPreprocessor
+	nodes      map[string]*ImportGraphNode
+	graphEdges []ImportGraphEdge
+
+	// edges and visited are the edges added to the graph and the
+	// imports walked, keyed by the import.
+	edges   map[string]bool
+	visited map[string]bool
+}
+
+// walk adds the imports in the nodes, imported by the from path, to the
+// graph and recursively walks the imported modules.
+func (b *graphBuilder) walk(nodes []*node, from string, dependencyChain []string) error {
+	for _, n := range nodes {
+		if !n.isMacro(Import) {
+			if err := b.walk(n.children, from, dependencyChain); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := b.addImport(n, from, dependencyChain); err != nil {
+			return n.wrapError(ErrImport, err)
+		}
+	}
+	return nil
+}
+
+func (b *graphBuilder) addImport(n *node, from string, dependencyChain []string) error {
+	spec, err := parseImport(n)
+	if err != nil {
+		return err
+	}
+	depChain, err := extendDependencyChain(dependencyChain, spec.path)
+	if err != nil {
+		return err
+	}
+	key := spec.path + " " + strings.Join(spec.symbols, " ")
+	if edgeKey := from + " " + key + " " + spec.alias; !b.edges[edgeKey] {
+		b.edges[edgeKey] = true
+		b.graphEdges = append(b.graphEdges, ImportGraphEdge{
+			From:    from,
+			To:      spec.path,
+			Symbols: spec.symbols,
+			Alias:   spec.alias,
+		})
+	}
+
+	// Each import only needs to be loaded and walked once. Importing a
+	// module again through a different chain cannot be circular as the
+	// cycle would have been found the first time.
+	if b.visited[key] {
+		return nil
+	}
+	b.visited[key] = true
+
+	mod, err := loadImport(spec, b.p.depDir, b.p.defines)
+	if err != nil {
+		return err
+	}
+	graphNode, ok := b.nodes[spec.path]
+	if !ok {
+		graphNode = &ImportGraphNode{Path: spec.path, Module: mod.module}
+		if mod.remote != "" {
+			graphNode.Remote = b.p.depDir.remote.lockfile.Dependencies[mod.remote].URL
+		}
+		b.nodes[spec.path] = graphNode
+	}
+	// Selective imports of the same module may declare different files.
+	for _, imported := range mod.nodes {
+		if imported.src != nil {
+			graphNode.Files = appendUnique(graphNode.Files, imported.src.file)
+		}
+	}
+	return b.walk(mod.nodes, spec.path, depChain)
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, existing := range s {
+		if existing == v {
+			return s
+		}
+	}
+	s = append(s, v)
+	sort.Strings(s)
+	return s
+}
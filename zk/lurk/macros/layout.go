@@ -0,0 +1,174 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ParamSource is the list of circuit parameters a param is found in.
+type ParamSource string
+
+const (
+	PublicParams  ParamSource = "public"
+	PrivateParams ParamSource = "private"
+)
+
+// ParamLayout describes where the param macro finds each parameter in
+// the public and private params passed to a script by the circuit. The
+// layout changes when the circuit adds parameters, so scripts written
+// for an older circuit version may be preprocessed with its layout.
+type ParamLayout struct {
+	// Version is the circuit version the layout describes.
+	Version int `json:"version"`
+	// Params maps the names accepted by the param macro to where the
+	// parameter is found.
+	Params map[string]ParamField `json:"params"`
+}
+
+// ParamField is the position of a parameter.
+type ParamField struct {
+	// Source is the params the parameter is in.
+	Source ParamSource `json:"source"`
+	// Index is the position of the parameter in the params list.
+	Index int `json:"index"`
+	// List is set if the parameter is a list whose items are accessed
+	// by index, as in !(param priv-in 0).
+	List bool `json:"list,omitempty"`
+	// Fields are the positions of the fields of the items of a list
+	// parameter, accessed as in !(param priv-in 0 amount).
+	Fields map[string]int `json:"fields,omitempty"`
+}
+
+// DefaultParamLayout is the layout of the current circuit.
+var DefaultParamLayout = &ParamLayout{
+	Version: 1,
+	Params: map[string]ParamField{
+		"sighash":     {Source: PublicParams, Index: 0},
+		"nullifiers":  {Source: PublicParams, Index: 1, List: true},
+		"txo-root":    {Source: PublicParams, Index: 2},
+		"fee":         {Source: PublicParams, Index: 3},
+		"coinbase":    {Source: PublicParams, Index: 4},
+		"mint-id":     {Source: PublicParams, Index: 5},
+		"mint-amount": {Source: PublicParams, Index: 6},
+		"pub-out": {Source: PublicParams, Index: 7, List: true, Fields: map[string]int{
+			"commitment": 0,
+			"ciphertext": 1,
+		}},
+		"locktime":           {Source: PublicParams, Index: 8},
+		"locktime-precision": {Source: PublicParams, Index: 9},
+		"priv-in": {Source: PrivateParams, Index: 0, List: true, Fields: map[string]int{
+			"amount":           0,
+			"asset-id":         1,
+			"salt":             2,
+			"state":            3,
+			"commitment-index": 4,
+			"inclusion-proof":  5,
+			"script":           6,
+			"locking-params":   7,
+			"unlocking-params": 8,
+		}},
+		"priv-out": {Source: PrivateParams, Index: 1, List: true, Fields: map[string]int{
+			"script-hash": 0,
+			"amount":      1,
+			"asset-id":    2,
+			"salt":        3,
+			"state":       4,
+		}},
+	},
+}
+
+// LoadParamLayout loads the JSON encoded layout at the path.
+func LoadParamLayout(path string) (*ParamLayout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	layout := new(ParamLayout)
+	if err := json.Unmarshal(data, layout); err != nil {
+		return nil, fmt.Errorf("invalid param layout %s: %w", path, err)
+	}
+	if err := layout.validate(); err != nil {
+		return nil, fmt.Errorf("invalid param layout %s: %w", path, err)
+	}
+	return layout, nil
+}
+
+// WithParamLayout sets the layout the param and check-sig macros expand
+// against. The default is DefaultParamLayout.
+func WithParamLayout(layout *ParamLayout) Option {
+	return func(cfg *config) error {
+		if layout == nil {
+			return errors.New("param layout is nil")
+		}
+		if err := layout.validate(); err != nil {
+			return err
+		}
+		cfg.layout = layout
+		return nil
+	}
+}
+
+func (l *ParamLayout) validate() error {
+	if len(l.Params) == 0 {
+		return errors.New("layout has no params")
+	}
+	for name, field := range l.Params {
+		if name == "" || strings.ContainsAny(name, " ()'\"") {
+			return fmt.Errorf("invalid param name %q", name)
+		}
+		if field.Source != PublicParams && field.Source != PrivateParams {
+			return fmt.Errorf("param %s has invalid source %q", name, field.Source)
+		}
+		if field.Index < 0 {
+			return fmt.Errorf("param %s has a negative index", name)
+		}
+		if len(field.Fields) > 0 && !field.List {
+			return fmt.Errorf("param %s has fields but is not a list", name)
+		}
+		for fieldName, index := range field.Fields {
+			if index < 0 {
+				return fmt.Errorf("field %s of param %s has a negative index", fieldName, name)
+			}
+		}
+	}
+	return nil
+}
+
+// names returns the names of the params in the layout, sorted.
+func (l *ParamLayout) names() []string {
+	names := make([]string, 0, len(l.Params))
+	for name := range l.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expr returns the expression accessing the param in the params.
+func (f ParamField) expr() string {
+	return nthItemExpr(f.Index, string(f.Source)+"-params")
+}
+
+// fieldNames returns the names of the fields of the param, sorted.
+func (f ParamField) fieldNames() []string {
+	names := make([]string, 0, len(f.Fields))
+	for name := range f.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// nthItemExpr returns the expression accessing the item at the constant
+// index in the list.
+func nthItemExpr(index int, list string) string {
+	return "(car " + strings.Repeat("(cdr ", index) + list + strings.Repeat(")", index+1)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultMaxImportDepth is the default limit on how deeply imports
+	// may be nested.
+	DefaultMaxImportDepth = 64
+
+	// DefaultMaxImports is the default limit on the number of imports
+	// expanded while preprocessing a program.
+	DefaultMaxImports = 10000
+
+	// DefaultMaxExpandedNodes is the default limit on the number of
+	// syntax tree nodes imported while preprocessing a program.
+	DefaultMaxExpandedNodes = 1 << 20
+
+	// DefaultMaxOutputSize is the default limit on the size in bytes of
+	// a preprocessed program.
+	DefaultMaxOutputSize = 1 << 24
+
+	// maxNestingDepth is the limit on how deeply lists and macros may be
+	// nested in the source. It prevents the recursive parser and macro
+	// expansion from exhausting the stack.
+	maxNestingDepth = 10000
+)
+
+// ErrExpansionLimit is returned when preprocessing a program exceeds
+// one of the expansion limits.
+var ErrExpansionLimit = errors.New("expansion limit exceeded")
+
+// expansionLimits are the limits on the expansion of a program.
+type expansionLimits struct {
+	maxImportDepth   int
+	maxImports       int
+	maxExpandedNodes int
+	maxOutputSize    int
+}
+
+func defaultExpansionLimits() expansionLimits {
+	return expansionLimits{
+		maxImportDepth:   DefaultMaxImportDepth,
+		maxImports:       DefaultMaxImports,
+		maxExpandedNodes: DefaultMaxExpandedNodes,
+		maxOutputSize:    DefaultMaxOutputSize,
+	}
+}
+
+// expansionBudget tracks the expansion of a single program against
+// the limits.
+type expansionBudget struct {
+	limits   expansionLimits
+	imports  int
+	imported int
+}
+
+// importModule accounts for an import at the depth with the nodes it
+// imports. It returns an error if a limit is exceeded.
+func (b *expansionBudget) importModule(depth int, nodes []*node) error {
+	if depth > b.limits.maxImportDepth {
+		return fmt.Errorf("%w: imports nested deeper than %d", ErrExpansionLimit, b.limits.maxImportDepth)
+	}
+	b.imports++
+	if b.imports > b.limits.maxImports {
+		return fmt.Errorf("%w: more than %d imports", ErrExpansionLimit, b.limits.maxImports)
+	}
+	b.imported += countNodes(nodes)
+	if b.imported > b.limits.maxExpandedNodes {
+		return fmt.Errorf("%w: more than %d imported nodes", ErrExpansionLimit, b.limits.maxExpandedNodes)
+	}
+	return nil
+}
+
+// checkOutputSize returns an error if the preprocessed program is
+// larger than the limit.
+func (l expansionLimits) checkOutputSize(output string) error {
+	if len(output) > l.maxOutputSize {
+		return &PreprocessError{
+			Type: ErrLimitExceeded,
+			Err:  fmt.Errorf("%w: output larger than %d bytes", ErrExpansionLimit, l.maxOutputSize),
+		}
+	}
+	return nil
+}
+
+func countNodes(nodes []*node) int {
+	count := len(nodes)
+	for _, n := range nodes {
+		count += countNodes(n.children)
+	}
+	return count
+}
+
+// MaxImportDepth limits how deeply imports may be nested, that is the
+// length of the longest chain of modules importing each other. The
+// default is DefaultMaxImportDepth.
+func MaxImportDepth(depth int) Option {
+	return func(cfg *config) error {
+		if depth <= 0 {
+			return errors.New("max import depth must be positive")
+		}
+		cfg.limits.maxImportDepth = depth
+		return nil
+	}
+}
+
+// MaxImports limits the number of imports expanded while preprocessing
+// a program, counting each time a module is imported. The default is
+// DefaultMaxImports.
+func MaxImports(n int) Option {
+	return func(cfg *config) error {
+		if n <= 0 {
+			return errors.New("max imports must be positive")
+		}
+		cfg.limits.maxImports = n
+		return nil
+	}
+}
+
+// MaxExpandedNodes limits the total number of syntax tree nodes, that
+// is the symbols, lists and macros, imported while preprocessing a
+// program. This bounds the memory used by modules which import each
+// other many times over. The default is DefaultMaxExpandedNodes.
+func MaxExpandedNodes(n int) Option {
+	return func(cfg *config) error {
+		if n <= 0 {
+			return errors.New("max expanded nodes must be positive")
+		}
+		cfg.limits.maxExpandedNodes = n
+		return nil
+	}
+}
+
+// MaxOutputSize limits the size in bytes of the preprocessed program.
+// The default is DefaultMaxOutputSize.
+func MaxOutputSize(size int) Option {
+	return func(cfg *config) error {
+		if size <= 0 {
+			return errors.New("max output size must be positive")
+		}
+		cfg.limits.maxOutputSize = size
+		return nil
+	}
+}
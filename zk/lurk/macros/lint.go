@@ -0,0 +1,374 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LintCheck identifies the check that produced a Diagnostic.
+type LintCheck string
+
+const (
+	// LintUnusedDefinition flags a def, defrec or defun which is never
+	// referenced. Definitions in a module body are exported and are not
+	// flagged.
+	LintUnusedDefinition LintCheck = "unused-definition"
+	// LintShadowedBinding flags a definition or let binding which hides
+	// a binding of the same name in an enclosing scope.
+	LintShadowedBinding LintCheck = "shadowed-binding"
+	// LintUnknownParam flags a !(param ...) macro with an unknown name,
+	// field or invalid index.
+	LintUnknownParam LintCheck = "unknown-param"
+	// LintUnreachableAssert flags an assert which can never be evaluated
+	// because it follows an assert that always fails or is in a branch
+	// of an if that is never taken.
+	LintUnreachableAssert LintCheck = "unreachable-assert"
+	// LintAmbiguousImport flags an import path which matches more than
+	// one module or module expression.
+	LintAmbiguousImport LintCheck = "ambiguous-import"
+)
+
+// Diagnostic is a problem found by Lint.
+type Diagnostic struct {
+	// Check is the check that produced the diagnostic.
+	Check LintCheck `json:"check"`
+	// Line and Column are the one-indexed location in the program.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic in the form line:column: check: message.
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", d.Line, d.Column, d.Check, d.Message)
+}
+
+// Lint checks the lurk program, which may be a program or a file of
+// modules, for likely mistakes. The options are the same as those used
+// to preprocess the program so that its imports can be resolved.
+//
+// Only problems in the program itself are reported, not in the modules
+// it imports. The diagnostics are ordered by their location. An error
+// is returned if the program cannot be preprocessed.
+func Lint(lurkProgram string, opts ...Option) ([]Diagnostic, error) {
+	p, err := NewMacroPreprocessor(opts...)
+	if err != nil {
+		return nil, err
+	}
+	src := &source{text: lurkProgram}
+	nodes, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err = expandConditionals(nodes, p.defines)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{p: p, src: src}
+	l.checkImports(nodes)
+
+	nodes, err = resolveImports(nodes, p.depDir, p.defines, p.newBudget())
+	if err != nil {
+		return nil, err
+	}
+	l.checkUnused(nodes, false)
+	l.checkShadowing(nodes, nil)
+	l.checkParams(nodes)
+	l.checkAsserts(nodes, false)
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Check < b.Check
+	})
+	return l.diagnostics, nil
+}
+
+type linter struct {
+	p           *MacroPreprocessor
+	src         *source
+	diagnostics []Diagnostic
+}
+
+// report adds a diagnostic located at the node if the node is in the
+// program being linted.
+func (l *linter) report(n *node, check LintCheck, format string, a ...interface{}) {
+	if n.src != l.src {
+		return
+	}
+	line, column := sourcePosition(n.src.text, n.pos)
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Check:   check,
+		Line:    line,
+		Column:  column,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// checkUnused reports the definitions which are not referenced by the
+// nodes following them in their sequence.
+func (l *linter) checkUnused(nodes []*node, exported bool) {
+	for i, n := range nodes {
+		if name, ok := definedName(n); ok && !exported {
+			referenced := make(map[string]bool)
+			for _, next := range nodes[i+1:] {
+				collectReferences(next, referenced)
+			}
+			if !referenced[name] {
+				l.report(n, LintUnusedDefinition, "%s is defined but never used", name)
+			}
+		}
+		if n.isMacro(Module) {
+			if args := n.args(); len(args) == 2 {
+				l.checkUnused(args[1].children, true)
+			}
+			continue
+		}
+		l.checkUnused(n.children, false)
+	}
+}
+
+// scope is a linked list of the names bound in the enclosing scopes.
+type scope struct {
+	name   string
+	parent *scope
+}
+
+func (s *scope) bind(name string) *scope {
+	return &scope{name: name, parent: s}
+}
+
+func (s *scope) has(name string) bool {
+	for ; s != nil; s = s.parent {
+		if s.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkShadowing reports the definitions and let bindings which shadow
+// a name already in scope. Lambda parameters are brought into scope
+// but are not reported as it is common to reuse parameter names.
+func (l *linter) checkShadowing(nodes []*node, sc *scope) {
+	for _, n := range nodes {
+		if name, ok := definedName(n); ok {
+			if sc.has(name) {
+				l.report(n, LintShadowedBinding, "%s shadows an existing binding", name)
+			}
+			inner := sc
+			if !n.isMacro(Def) {
+				inner = inner.bind(name)
+			}
+			args := n.args()
+			if n.isMacro(Defun) && len(args) > 1 {
+				for _, param := range args[1].args() {
+					inner = inner.bind(param.value)
+				}
+				args = args[1:]
+			}
+			l.checkShadowing(args[1:], inner)
+			sc = sc.bind(name)
+			continue
+		}
+		l.checkShadowingNode(n, sc)
+	}
+}
+
+func (l *linter) checkShadowingNode(n *node, sc *scope) {
+	args := n.args()
+	switch {
+	case n.typ == quoteNode:
+		return
+	case n.isMacro(Module):
+		// Each module has its own scope.
+		l.checkShadowing(n.children, nil)
+		return
+	case n.typ != listNode || len(args) < 2 || args[0].typ != atomNode:
+		l.checkShadowing(n.children, sc)
+		return
+	}
+
+	switch args[0].value {
+	case "lambda":
+		for _, param := range args[1].args() {
+			sc = sc.bind(param.value)
+		}
+		l.checkShadowing(args[2:], sc)
+	case "let", "letrec":
+		for _, binding := range args[1].args() {
+			bindingArgs := binding.args()
+			if binding.typ != listNode || len(bindingArgs) != 2 || bindingArgs[0].typ != atomNode {
+				l.checkShadowing(binding.children, sc)
+				continue
+			}
+			name := bindingArgs[0].value
+			if sc.has(name) {
+				l.report(bindingArgs[0], LintShadowedBinding, "%s shadows an existing binding", name)
+			}
+			if args[0].value == "letrec" {
+				sc = sc.bind(name)
+				l.checkShadowingNode(bindingArgs[1], sc)
+			} else {
+				l.checkShadowingNode(bindingArgs[1], sc)
+				sc = sc.bind(name)
+			}
+		}
+		l.checkShadowing(args[2:], sc)
+	default:
+		l.checkShadowing(n.children, sc)
+	}
+}
+
+// checkParams reports the param macros which cannot be expanded.
+func (l *linter) checkParams(nodes []*node) {
+	for _, n := range nodes {
+		if n.isMacro(Param) {
+			if _, err := (&expander{layout: l.p.layout}).expandParam(n); err != nil {
+				var perr *PreprocessError
+				if errors.As(err, &perr) && perr.Err != nil {
+					err = perr.Err
+				}
+				l.report(n, LintUnknownParam, "%s", err)
+			}
+			continue
+		}
+		l.checkParams(n.children)
+	}
+}
+
+// checkAsserts reports the asserts which can never be evaluated. An
+// assert is unreachable if it follows an assert which always fails in
+// the same sequence, or if it is in the branch of an if with a constant
+// condition that is never taken.
+func (l *linter) checkAsserts(nodes []*node, unreachable bool) {
+	for _, n := range nodes {
+		if n.isMacro(Assert) || n.isMacro(AssertEq) {
+			if unreachable {
+				l.report(n, LintUnreachableAssert, "assert is never evaluated")
+			}
+			l.checkAsserts(n.children, unreachable)
+			if alwaysFails(n) {
+				unreachable = true
+			}
+			continue
+		}
+		args := n.args()
+		if n.typ == listNode && len(args) == 4 && args[0].typ == atomNode && args[0].value == "if" &&
+			args[1].typ == atomNode && isConstant(args[1].value) {
+			l.checkAsserts(args[1:2], unreachable)
+			l.checkAsserts(args[2:3], unreachable || args[1].value == "nil")
+			l.checkAsserts(args[3:4], unreachable || args[1].value != "nil")
+			continue
+		}
+		l.checkAsserts(n.children, unreachable)
+	}
+}
+
+// alwaysFails returns whether the assert macro asserts a constant
+// condition which never holds.
+func alwaysFails(n *node) bool {
+	args := n.args()
+	switch {
+	case n.isMacro(Assert) && len(args) >= 1:
+		return args[0].typ == atomNode && args[0].value == "nil"
+	case n.isMacro(AssertEq) && len(args) >= 2:
+		return args[0].typ == atomNode && args[1].typ == atomNode &&
+			isConstant(args[0].value) && isConstant(args[1].value) &&
+			args[0].value != args[1].value
+	}
+	return false
+}
+
+// isConstant returns whether the atom is a self-evaluating constant
+// such as t, nil, a number, a string or a character.
+func isConstant(atom string) bool {
+	if atom == "t" || atom == "nil" {
+		return true
+	}
+	if _, err := strconv.ParseInt(atom, 10, 64); err == nil {
+		return true
+	}
+	return strings.HasPrefix(atom, "\"") || strings.HasPrefix(atom, "#\\")
+}
+
+// checkImports reports the imports in the program whose path matches
+// more than one module or module expression. Only the first match is
+// used when preprocessing, which may not be the one intended.
+func (l *linter) checkImports(nodes []*node) {
+	for _, n := range nodes {
+		if !n.isMacro(Import) {
+			l.checkImports(n.children)
+			continue
+		}
+		spec, err := parseImport(n)
+		if err != nil {
+			continue
+		}
+		if candidates := l.importCandidates(spec); len(candidates) > 1 {
+			l.report(n, LintAmbiguousImport, "import %s resolves to multiple candidates: %s", spec.path, strings.Join(candidates, ", "))
+		}
+	}
+}
+
+// importCandidates returns the remote dependencies and files which the
+// import path could refer to.
+func (l *linter) importCandidates(spec importSpec) []string {
+	depDir := l.p.depDir
+	if depDir == nil {
+		return nil
+	}
+	splits := strings.Split(spec.path, "/")
+
+	var candidates []string
+	if depDir.remote != nil && len(splits) > 1 {
+		if dep, ok := depDir.remote.lockfile.Dependencies[splits[0]]; ok {
+			candidates = append(candidates, dep.URL)
+		}
+	}
+	if depDir.fileSystem != nil {
+		candidates = append(candidates, l.moduleFiles(splits[:len(splits)-1], splits[len(splits)-1], "")...)
+		if len(spec.symbols) == 0 && len(splits) > 1 {
+			candidates = append(candidates, l.moduleFiles(splits[:len(splits)-2], splits[len(splits)-2], splits[len(splits)-1])...)
+		}
+	}
+	return candidates
+}
+
+// moduleFiles returns the files in the directory declaring the module.
+// If exprName is set only modules defining the expression are included.
+func (l *linter) moduleFiles(dirSplits []string, moduleName, exprName string) []string {
+	dir := filepath.Join(append([]string{l.p.depDir.path}, dirSplits...)...)
+	files, err := loadFilesFromFS(l.p.depDir.fileSystem, dir, l.p.defines)
+	if err != nil {
+		return nil
+	}
+	var ret []string
+	for _, nodes := range files {
+		for _, n := range nodes {
+			args := n.args()
+			if !n.isMacro(Module) || len(args) != 2 || args[0].value != moduleName {
+				continue
+			}
+			if exprName == "" || len(extractModuleExpression(args[1].children, exprName)) > 0 {
+				ret = append(ret, n.src.file)
+			}
+		}
+	}
+	return ret
+}
@@ -4,8 +4,6 @@
 
 package macros
 
-import "strings"
-
 type Macro string
 
 func (m Macro) String() string {
@@ -21,6 +19,13 @@ const (
 	Assert   Macro = "assert"
 	AssertEq Macro = "assert-eq"
 	Import   Macro = "import"
+	Ifdef    Macro = "ifdef"
+	Ifndef   Macro = "ifndef"
+	Module   Macro = "module"
+	CheckSig Macro = "check-sig"
+	Append   Macro = "append"
+	Splice   Macro = "splice"
+	Test     Macro = "test"
 )
 
 func (m Macro) IsNested() bool {
@@ -31,43 +36,3 @@ func (m Macro) IsNested() bool {
 		return false
 	}
 }
-
-func (m Macro) Expand(program string) string {
-	switch m {
-	case Def:
-		return macroExpandDef(program)
-	case Defrec:
-		return macroExpandDefrec(program)
-	case Defun:
-		return macroExpandDefun(program)
-	case Assert:
-		return macroExpandAssert(program)
-	case AssertEq:
-		return macroExpandAssertEq(program)
-	case List:
-		return macroExpandList(program)
-	case Param:
-		return macroExpandParam(program)
-	}
-	return program
-}
-
-func IsMacro(s string) (Macro, bool) {
-	s = strings.TrimPrefix(strings.ToLower(s), "!(")
-	if strings.HasPrefix(s, Def.String()) {
-		return Def, true
-	} else if strings.HasPrefix(s, Defrec.String()) {
-		return Defrec, true
-	} else if strings.HasPrefix(s, Defun.String()) {
-		return Defun, true
-	} else if strings.HasPrefix(s, List.String()) {
-		return List, true
-	} else if strings.HasPrefix(s, Param.String()) {
-		return Param, true
-	} else if strings.HasPrefix(s, Assert.String()) {
-		return Assert, true
-	} else if strings.HasPrefix(s, AssertEq.String()) {
-		return AssertEq, true
-	}
-	return "", false
-}
@@ -0,0 +1,404 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// eliminateDeadCode removes the def, defrec and defun macros imported
+// from modules whose names are never referenced. A definition is only
+// in scope for the nodes following it in the enclosing sequence, so
+// the sequence is walked backwards collecting the symbols referenced
+// by the nodes that are kept. Definitions only referenced by other
+// unused definitions are removed as well.
+//
+// Quoted symbols are counted as references, so a definition which is
+// only used via eval is kept.
+func eliminateDeadCode(nodes []*node) []*node {
+	var (
+		kept       []*node
+		referenced = make(map[string]bool)
+	)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		n := nodes[i]
+		if name, ok := definedName(n); ok && n.module != "" && !referenced[name] {
+			continue
+		}
+		if len(n.children) > 0 {
+			cpy := *n
+			cpy.children = eliminateDeadCode(n.children)
+			n = &cpy
+		}
+		collectReferences(n, referenced)
+		kept = append(kept, n)
+	}
+	for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+		kept[i], kept[j] = kept[j], kept[i]
+	}
+	return kept
+}
+
+// definedName returns the name defined by a def, defrec or defun macro.
+func definedName(n *node) (string, bool) {
+	if !n.isMacro(Def) && !n.isMacro(Defrec) && !n.isMacro(Defun) {
+		return "", false
+	}
+	args := n.args()
+	if len(args) == 0 || args[0].typ != atomNode {
+		return "", false
+	}
+	return args[0].value, true
+}
+
+// collectReferences adds the symbols in the node to the set. The name
+// of a definition is not a reference to itself.
+func collectReferences(n *node, referenced map[string]bool) {
+	if n.typ == atomNode {
+		referenced[n.value] = true
+		return
+	}
+	children := n.children
+	if _, ok := definedName(n); ok {
+		children = n.args()[1:]
+	}
+	for _, child := range children {
+		collectReferences(child, referenced)
+	}
+}
+
+// maxFoldedConstant bounds the values produced by constant folding.
+// Lurk numbers are field elements, so results are kept well below the
+// field modulus where the arithmetic matches integer arithmetic.
+const maxFoldedConstant = 1 << 62
+
+// foldConstants folds the arithmetic and comparisons of integer
+// constants, and the if expressions with a constant condition, and hoists the car/cdr chains accessing lambda parameters
+// into precomputed accessors.
+func foldConstants(nodes []*node) []*node {
+	folded := make([]*node, 0, len(nodes))
+	for _, n := range nodes {
+		folded = append(folded, foldNode(n))
+	}
+	return hoistAccessors(folded)
+}
+
+// foldNode folds the constant expressions in the node.
+func foldNode(n *node) *node {
+	if n.typ == quoteNode || len(n.children) == 0 {
+		return n
+	}
+	cpy := *n
+	cpy.children = make([]*node, 0, len(n.children))
+	for _, child := range n.children {
+		cpy.children = append(cpy.children, foldNode(child))
+	}
+
+	args := cpy.args()
+	if cpy.typ != listNode || len(args) < 3 || len(args) > 4 || args[0].typ != atomNode {
+		return &cpy
+	}
+	if args[0].value == "if" {
+		// Only nil is false, all other constants are true.
+		if args[1].typ != atomNode || !isBooleanOrInt(args[1].value) {
+			return &cpy
+		}
+		switch {
+		case args[1].value != "nil":
+			return args[2]
+		case len(args) == 4:
+			return args[3]
+		}
+		return newAtom("nil").withLocation(n)
+	}
+	if len(args) != 3 {
+		return &cpy
+	}
+	if args[0].value == "eq" && args[1].typ == atomNode && args[2].typ == atomNode &&
+		isBooleanOrInt(args[1].value) && isBooleanOrInt(args[2].value) {
+		return newAtom(lurkBool(args[1].value == args[2].value)).withLocation(n)
+	}
+	a, aok := foldableConstant(args[1])
+	b, bok := foldableConstant(args[2])
+	if !aok || !bok {
+		return &cpy
+	}
+	var result string
+	switch args[0].value {
+	case "+":
+		if a+b < maxFoldedConstant {
+			result = strconv.FormatUint(a+b, 10)
+		}
+	case "-":
+		if a >= b {
+			result = strconv.FormatUint(a-b, 10)
+		}
+	case "*":
+		if a == 0 || b < maxFoldedConstant/a {
+			result = strconv.FormatUint(a*b, 10)
+		}
+	case "=":
+		result = lurkBool(a == b)
+	case "<":
+		result = lurkBool(a < b)
+	case ">":
+		result = lurkBool(a > b)
+	case "<=":
+		result = lurkBool(a <= b)
+	case ">=":
+		result = lurkBool(a >= b)
+	}
+	if result == "" {
+		return &cpy
+	}
+	return newAtom(result).withLocation(n)
+}
+
+// foldableConstant returns the value of a non-negative integer constant
+// small enough to be folded.
+func foldableConstant(n *node) (uint64, bool) {
+	if n.typ != atomNode {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(n.value, 10, 64)
+	if err != nil || v >= maxFoldedConstant {
+		return 0, false
+	}
+	return v, true
+}
+
+// isBooleanOrInt returns whether the atom is t, nil or a non-negative
+// integer in canonical form.
+func isBooleanOrInt(atom string) bool {
+	if atom == "t" || atom == "nil" {
+		return true
+	}
+	v, err := strconv.ParseUint(atom, 10, 64)
+	return err == nil && strconv.FormatUint(v, 10) == atom
+}
+
+func lurkBool(b bool) string {
+	if b {
+		return "t"
+	}
+	return "nil"
+}
+
+// accessorChain returns the root symbol of a chain of car and cdr calls
+// along with the operations from the outermost to the innermost, for
+// example (car (cdr (cdr x))) returns x and "add".
+func accessorChain(n *node) (string, string, bool) {
+	var ops strings.Builder
+	for {
+		args := n.args()
+		if n.typ != listNode || len(args) != 2 || args[0].typ != atomNode ||
+			(args[0].value != "car" && args[0].value != "cdr") {
+			break
+		}
+		ops.WriteByte(args[0].value[1])
+		n = args[1]
+	}
+	if ops.Len() == 0 || n.typ != atomNode || isConstant(n.value) {
+		return "", "", false
+	}
+	return n.value, ops.String(), true
+}
+
+// buildChain returns the chain of car and cdr operations applied to the
+// expression.
+func buildChain(ops string, expr *node) *node {
+	for i := len(ops) - 1; i >= 0; i-- {
+		op := "car"
+		if ops[i] == 'd' {
+			op = "cdr"
+		}
+		expr = newList(newAtom(op), expr)
+	}
+	return expr
+}
+
+// accessorRoots are the parameters whose car/cdr chains are hoisted.
+var accessorRoots = map[string]bool{
+	"public-params":  true,
+	"private-params": true,
+}
+
+// hoistAccessors replaces the car/cdr chains on a lambda parameter which
+// are used more than once with a let binding at the top of the lambda
+// body, for example (car (cdr (cdr public-params))) is bound once as
+// public-params-caddr. Each accessor is computed from the longest
+// accessor it extends so the chains are not repeated.
+//
+// Only the public-params and private-params parameters, which the param
+// macro accesses, are considered as they are always lists and so the
+// accessors can be evaluated eagerly. They must be bound once in the
+// whole program so the accessors cannot refer to a shadowed binding.
+func hoistAccessors(nodes []*node) []*node {
+	bindings := make(map[string]int)
+	symbols := make(map[string]bool)
+	var collect func(nodes []*node)
+	collect = func(nodes []*node) {
+		for _, n := range nodes {
+			args := n.args()
+			if n.typ == listNode && len(args) > 1 && args[0].typ == atomNode {
+				switch args[0].value {
+				case "lambda":
+					for _, param := range args[1].args() {
+						bindings[param.value]++
+					}
+				case "let", "letrec":
+					for _, binding := range args[1].args() {
+						if bindingArgs := binding.args(); len(bindingArgs) > 0 {
+							bindings[bindingArgs[0].value]++
+						}
+					}
+				}
+			}
+			if n.typ == atomNode {
+				symbols[n.value] = true
+			}
+			collect(n.children)
+		}
+	}
+	collect(nodes)
+
+	ret, _ := transformNodes(nodes, func(n *node) ([]*node, bool, error) {
+		args := n.args()
+		if n.typ != listNode || len(args) != 3 || args[0].typ != atomNode || args[0].value != "lambda" {
+			return nil, false, nil
+		}
+		body := hoistAccessors(args[2:])[0]
+		for _, param := range args[1].args() {
+			if accessorRoots[param.value] && bindings[param.value] == 1 {
+				body = hoistParamAccessors(param.value, body, symbols)
+			}
+		}
+		return []*node{newList(args[0], args[1], body).withLocation(n)}, true, nil
+	})
+	return ret
+}
+
+// hoistParamAccessors hoists the car/cdr chains on the parameter used
+// more than once in the body.
+func hoistParamAccessors(param string, body *node, symbols map[string]bool) *node {
+	name := func(ops string) string {
+		return param + "-c" + ops + "r"
+	}
+
+	var chains []string
+	var collect func(n *node)
+	collect = func(n *node) {
+		if root, ops, ok := accessorChain(n); ok && root == param {
+			chains = append(chains, ops)
+			return
+		}
+		if n.typ == quoteNode {
+			return
+		}
+		for _, child := range n.children {
+			collect(child)
+		}
+	}
+	collect(body)
+
+	// Any innermost part of a chain, such as the (cdr (cdr x)) in
+	// (car (cdr (cdr x))), may be hoisted so that chains sharing it
+	// are collapsed. The candidates are chosen longest first so a
+	// shorter accessor is only hoisted if it is still used by more than
+	// one chain or longer accessor.
+	candidates := make(map[string]bool)
+	for _, ops := range chains {
+		for i := range ops {
+			candidates[ops[i:]] = true
+		}
+	}
+	sorted := make([]string, 0, len(candidates))
+	for ops := range candidates {
+		sorted = append(sorted, ops)
+	}
+	sortAccessors(sorted)
+
+	var hoisted []string
+	for i := len(sorted) - 1; i >= 0; i-- {
+		ops := sorted[i]
+		if symbols[name(ops)] {
+			continue
+		}
+		uses := 0
+		for j, user := range append(chains[:len(chains):len(chains)], hoisted...) {
+			isAccessor := j >= len(chains)
+			if !strings.HasSuffix(user, ops) || (isAccessor && user == ops) {
+				continue
+			}
+			covered := false
+			for _, longer := range hoisted {
+				if len(longer) > len(ops) && strings.HasSuffix(user, longer) &&
+					strings.HasSuffix(longer, ops) && !(isAccessor && longer == user) {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				uses++
+			}
+		}
+		// The accessor is only hoisted if the program gets smaller. Each
+		// use is replaced by the name and the binding adds the name and
+		// the chain.
+		chainLen := len(ops)*len("(car )") + len(param)
+		nameLen := len(name(ops))
+		if uses > 1 && uses*(chainLen-nameLen) > nameLen+chainLen+len("() ") {
+			hoisted = append(hoisted, ops)
+		}
+	}
+	if len(hoisted) == 0 {
+		return body
+	}
+	sortAccessors(hoisted)
+
+	// accessor returns the chain rewritten to use the longest hoisted
+	// accessor it extends out of the first limit accessors, which are
+	// sorted by length.
+	accessor := func(ops string, limit int) *node {
+		for i := limit - 1; i >= 0; i-- {
+			if strings.HasSuffix(ops, hoisted[i]) {
+				if len(ops) == len(hoisted[i]) {
+					return newAtom(name(ops))
+				}
+				return buildChain(strings.TrimSuffix(ops, hoisted[i]), newAtom(name(hoisted[i])))
+			}
+		}
+		return buildChain(ops, newAtom(param))
+	}
+
+	letBindings := make([]*node, 0, len(hoisted))
+	for i, ops := range hoisted {
+		letBindings = append(letBindings, newList(newAtom(name(ops)), accessor(ops, i)))
+	}
+
+	rewritten, _ := transformNodes([]*node{body}, func(n *node) ([]*node, bool, error) {
+		if n.typ == quoteNode {
+			return []*node{n}, true, nil
+		}
+		if root, ops, ok := accessorChain(n); ok && root == param {
+			return []*node{accessor(ops, len(hoisted)).withLocation(n)}, true, nil
+		}
+		return nil, false, nil
+	})
+	return newList(newAtom("let"), newList(letBindings...), rewritten[0]).withLocation(body)
+}
+
+// sortAccessors sorts the car/cdr operations by length and then
+// lexicographically.
+func sortAccessors(accessors []string) {
+	sort.Slice(accessors, func(i, j int) bool {
+		if len(accessors[i]) != len(accessors[j]) {
+			return len(accessors[i]) < len(accessors[j])
+		}
+		return accessors[i] < accessors[j]
+	})
+}
@@ -6,8 +6,11 @@ package macros
 
 import (
 	"embed"
+	"fmt"
 	"io/fs"
 	"os"
+	"strings"
+	"time"
 )
 
 // Option is configuration option function for the MacroPreprocessor
@@ -16,14 +19,35 @@ type Option func(cfg *config) error
 type fsDirectory struct {
 	fileSystem fs.FS
 	path       string
+
+	// remote resolves imports from remote dependencies, if set.
+	remote *remoteResolver
+
+	// parsed caches the parsed files in the directory, if set.
+	parsed *parsedFiles
+
+	// stdlib is the embedded standard library which std/ imports are
+	// loaded from, if set.
+	stdlib *fsDirectory
 }
 
 // DependencyDir sets the dependency directory that is used to look
 // up imported modules.
+//
+// The path may also be a zip or tar.gz archive of the directory, such
+// as a .lurkz release of the standard library. If the archive contains
+// a single top level directory the modules are looked up in it.
 func DependencyDir(depDir string) Option {
 	return func(cfg *config) error {
+		fileSystem := os.DirFS(depDir)
+		if info, err := os.Stat(depDir); err == nil && !info.IsDir() {
+			fileSystem, err = openArchive(depDir)
+			if err != nil {
+				return err
+			}
+		}
 		cfg.depDir = &fsDirectory{
-			fileSystem: os.DirFS(depDir),
+			fileSystem: fileSystem,
 			path:       ".",
 		}
 		return nil
@@ -35,7 +59,7 @@ var embeddedDependencyDir embed.FS
 
 // WithStandardLib creates an embedded dependency directory
 // containing only the standard library. This is not compatible
-// with DependencyDir.
+// with DependencyDir, see WithEmbeddedStdlib.
 func WithStandardLib() Option {
 	return func(cfg *config) error {
 		cfg.depDir = &fsDirectory{
@@ -46,6 +70,25 @@ func WithStandardLib() Option {
 	}
 }
 
+// WithEmbeddedStdlib loads the imports of the standard library, the
+// import paths starting with std/, from the copy embedded in the binary.
+// Unlike WithStandardLib it may be used alongside DependencyDir and
+// RemoteImports, which are used for all other imports. Without a
+// dependency directory only the standard library may be imported.
+//
+// The embedded copy is always used, even if the dependency directory
+// has a std directory, so that programs importing the standard library
+// always expand to the canonical modules.
+func WithEmbeddedStdlib() Option {
+	return func(cfg *config) error {
+		cfg.embeddedStdlib = true
+		return nil
+	}
+}
+
+// RemoveComments removes all line and block comments from the
+// preprocessed program, including comments following code on the
+// same line.
 func RemoveComments() Option {
 	return func(cfg *config) error {
 		cfg.removeComments = true
@@ -53,7 +96,135 @@ func RemoveComments() Option {
 	}
 }
 
+// EliminateDeadCode removes the definitions imported from modules
+// which are never referenced by the program. When a whole module is
+// imported for a single function this keeps the unused functions out
+// of the program, making it smaller and faster to prove.
+func EliminateDeadCode() Option {
+	return func(cfg *config) error {
+		cfg.eliminateDeadCode = true
+		return nil
+	}
+}
+
+// FoldConstants folds the arithmetic and comparisons of integer
+// constants, and the if expressions with a constant condition, in the
+// expanded program and replaces the car/cdr chains
+// which are repeated on a lambda parameter, such as those produced by
+// the param macro, with accessors computed once at the top of the
+// lambda. This reduces the size of the program fed to the prover.
+func FoldConstants() Option {
+	return func(cfg *config) error {
+		cfg.foldConstants = true
+		return nil
+	}
+}
+
+// ExpansionCache caches the output of Preprocess so that preprocessing
+// the same program again skips the expansion entirely. Entries are kept
+// in memory and, if dir is not empty, on disk in the directory so they
+// are shared between processes.
+//
+// An entry is keyed by the hash of the program and the options, and is
+// only used if the dependency files it imported are unchanged.
+func ExpansionCache(dir string) Option {
+	return func(cfg *config) error {
+		cfg.cache = newExpansionCache(dir)
+		return nil
+	}
+}
+
+// PreserveHeaderComments keeps the comments at the top of the program,
+// before any code, when using RemoveComments. This is intended for
+// license headers.
+func PreserveHeaderComments() Option {
+	return func(cfg *config) error {
+		cfg.preserveHeader = true
+		return nil
+	}
+}
+
+// PreserveComments keeps the comments starting with any of the prefixes
+// when using RemoveComments. For example PreserveComments(";;!") keeps
+// all comments written as ;;! comment.
+func PreserveComments(prefixes ...string) Option {
+	return func(cfg *config) error {
+		for _, prefix := range prefixes {
+			if !strings.HasPrefix(prefix, ";") && !isBlockComment(prefix) {
+				return fmt.Errorf("invalid comment prefix %q", prefix)
+			}
+		}
+		cfg.preservePrefixes = append(cfg.preservePrefixes, prefixes...)
+		return nil
+	}
+}
+
+// WithDefines defines the features used by the !(ifdef FEATURE ...) and
+// !(ifndef FEATURE ...) macros. This allows the same source to be
+// preprocessed differently, for example, for testnet and mainnet.
+func WithDefines(features ...string) Option {
+	return func(cfg *config) error {
+		if cfg.defines == nil {
+			cfg.defines = make(map[string]bool)
+		}
+		for _, feature := range features {
+			if feature == "" || strings.ContainsAny(feature, " \t\r\n()") {
+				return fmt.Errorf("invalid feature name %q", feature)
+			}
+			cfg.defines[feature] = true
+		}
+		return nil
+	}
+}
+
+// RemoteImports allows modules to be imported from the remote dependencies
+// listed in the lockfile at the path. A remote dependency is imported using
+// its name in the lockfile as the first element of the import path, for
+// example !(import illium-math/math).
+//
+// Each dependency is pinned by the sha256 hash of its contents. It is
+// downloaded once, checked against the hash, and then loaded from the
+// cache directory. This may be used alongside DependencyDir or
+// WithStandardLib.
+func RemoteImports(lockfilePath, cacheDir string) Option {
+	return func(cfg *config) error {
+		lockfile, err := LoadLockfile(lockfilePath)
+		if err != nil {
+			return err
+		}
+		if cfg.remote == nil {
+			cfg.remote = newRemoteResolver()
+		}
+		cfg.remote.lockfile = lockfile
+		cfg.remote.cacheDir = cacheDir
+		return nil
+	}
+}
+
+// IPFSGateway sets the HTTP gateway used to fetch ipfs:// dependencies
+// when using RemoteImports. The default is https://ipfs.io/ipfs/.
+func IPFSGateway(gateway string) Option {
+	return func(cfg *config) error {
+		if cfg.remote == nil {
+			cfg.remote = newRemoteResolver()
+		}
+		cfg.remote.ipfsGateway = strings.TrimSuffix(gateway, "/") + "/"
+		return nil
+	}
+}
+
 type config struct {
-	depDir         *fsDirectory
-	removeComments bool
+	depDir            *fsDirectory
+	removeComments    bool
+	preserveHeader    bool
+	preservePrefixes  []string
+	eliminateDeadCode bool
+	foldConstants     bool
+	cache             *expansionCache
+	limits            expansionLimits
+	defines           map[string]bool
+	remote            *remoteResolver
+	embeddedStdlib    bool
+	layout            *ParamLayout
+	watchInterval     time.Duration
 }
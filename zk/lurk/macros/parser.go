@@ -4,55 +4,459 @@
 
 package macros
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
 
-type Parser struct {
-	input  string
-	pos    int
-	length int
+// nodeType is the type of a node in the syntax tree.
+type nodeType int
+
+const (
+	// atomNode is a symbol, number, or a string or character literal.
+	atomNode nodeType = iota
+	// listNode is a list delimited by (), [] or {}.
+	listNode
+	// macroNode is a macro invocation, !(name args...). The value is
+	// the name of the macro and the children are its arguments.
+	macroNode
+	// quoteNode is a quoted expression, 'expr, with a single child.
+	quoteNode
+	// commentNode is either a comment running to the end of the line
+	// or a #| block comment |#.
+	commentNode
+)
+
+// node is a node in the syntax tree of a lurk program.
+type node struct {
+	typ      nodeType
+	value    string
+	children []*node
+
+	// open is the opening bracket of a list.
+	open byte
+
+	// src and pos are the source the node was parsed from and its
+	// byte offset within it. They are used to report errors and are
+	// not set on nodes created by macro expansion.
+	src *source
+	pos int
+
+	// module is the name of the module the node was imported from.
+	module string
+
+	// message is the message of the assert whose failing branch the
+	// node is. It is recorded in the source map.
+	message string
 }
 
-func NewParser(input string) *Parser {
-	return &Parser{
-		input:  input,
-		pos:    0,
-		length: len(input),
+// source is lurk code along with the file it came from.
+type source struct {
+	// file is the dependency file name. Empty for the program.
+	file string
+	// text is the full contents of the file or program.
+	text string
+}
+
+func newAtom(value string) *node {
+	return &node{typ: atomNode, value: value}
+}
+
+func newList(children ...*node) *node {
+	return &node{typ: listNode, open: '(', children: children}
+}
+
+// withLocation sets the location of the node, if it was generated by
+// macro expansion, to that of the original node. The children of
+// generated nodes are located in the same way.
+func (n *node) withLocation(orig *node) *node {
+	if n.src != nil {
+		return n
+	}
+	n.src, n.pos, n.module = orig.src, orig.pos, orig.module
+	for _, child := range n.children {
+		child.withLocation(orig)
 	}
+	return n
 }
 
-func (p *Parser) Peek() byte {
-	if p.pos >= p.length {
-		return 0
+// isMacro returns whether the node is an invocation of the macro.
+func (n *node) isMacro(m Macro) bool {
+	return n.typ == macroNode && n.value == m.String()
+}
+
+// args returns the children of the node excluding any comments.
+func (n *node) args() []*node {
+	args := make([]*node, 0, len(n.children))
+	for _, child := range n.children {
+		if child.typ != commentNode {
+			args = append(args, child)
+		}
 	}
-	return p.input[p.pos]
+	return args
 }
 
-func (p *Parser) Consume() byte {
-	char := p.Peek()
-	p.pos++
-	return char
+// errorf returns a *PreprocessError located at the node.
+func (n *node) errorf(typ ErrorType, format string, a ...interface{}) error {
+	return n.wrapError(typ, fmt.Errorf(format, a...))
+}
+
+// wrapError returns a *PreprocessError located at the node wrapping
+// the error. If the error is already a *PreprocessError it is returned
+// as is so that it points at the original location.
+func (n *node) wrapError(typ ErrorType, err error) error {
+	if perr, ok := err.(*PreprocessError); ok {
+		return perr
+	}
+	perr := &PreprocessError{
+		Type: typ,
+		Err:  err,
+	}
+	if n.typ == macroNode {
+		perr.Macro = n.value
+	}
+	if n.src != nil {
+		perr.File = n.src.file
+		perr.Line, perr.Column = sourcePosition(n.src.text, n.pos)
+	}
+	return perr
 }
 
-func (p *Parser) ReadUntil(c byte) string {
+// parser builds the syntax tree of a lurk program.
+type parser struct {
+	src *source
+	pos int
+
+	// macros is the stack of macros enclosing the current position.
+	macros []string
+
+	// depth is the number of lists and macros enclosing the current
+	// position.
+	depth int
+}
+
+// parse parses the source into a syntax tree. The brackets must be
+// balanced and all macros must exist. The returned error, if any,
+// is a *PreprocessError.
+func parse(src *source) ([]*node, error) {
+	p := &parser{src: src}
+	return p.parseSeq(0, 0)
+}
+
+// parseSeq parses nodes until the closing bracket, which is consumed,
+// or until the end of the input if closing is zero. Open is the offset
+// of the opening bracket.
+func (p *parser) parseSeq(closing byte, open int) ([]*node, error) {
+	if closing != 0 {
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxNestingDepth {
+			perr := p.errorAt(ErrLimitExceeded, open)
+			perr.Err = fmt.Errorf("%w: nested deeper than %d", ErrExpansionLimit, maxNestingDepth)
+			return nil, perr
+		}
+	}
+	var nodes []*node
+	text := p.src.text
+	for {
+		for p.pos < len(text) && isWhitespace(text[p.pos]) {
+			p.pos++
+		}
+		if p.pos >= len(text) {
+			if closing != 0 {
+				return nil, p.errorAt(ErrUnclosedParen, open)
+			}
+			return nodes, nil
+		}
+		switch c := text[p.pos]; c {
+		case ')', ']', '}':
+			if closing == 0 {
+				return nil, p.errorAt(ErrUnexpectedParen, p.pos)
+			}
+			if c != closing {
+				return nil, p.errorAt(ErrMismatchedParen, p.pos)
+			}
+			p.pos++
+			return nodes, nil
+		}
+		n, err := p.parseNode()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+}
+
+// parseNode parses the node at the current position.
+func (p *parser) parseNode() (*node, error) {
+	text := p.src.text
 	start := p.pos
-	for p.Peek() != c && p.Peek() != 0 {
-		p.Consume()
+	n := &node{src: p.src, pos: start}
+
+	if size := literalLen(text, start); size > 0 {
+		n.typ = atomNode
+		n.value = text[start : start+size]
+		p.pos += size
+		return n, nil
+	}
+
+	switch c := text[start]; {
+	case c == '#' && start+1 < len(text) && text[start+1] == '|':
+		size, closed := blockCommentLen(text, start)
+		if !closed {
+			return nil, p.errorAt(ErrUnclosedComment, start)
+		}
+		n.typ = commentNode
+		n.value = text[start : start+size]
+		p.pos += size
+		return n, nil
+
+	case c == ';':
+		end := strings.IndexByte(text[start:], '\n')
+		if end < 0 {
+			end = len(text) - start
+		}
+		n.typ = commentNode
+		n.value = strings.TrimRight(text[start:start+end], "\r")
+		p.pos += end
+		return n, nil
+
+	case c == '(' || c == '[' || c == '{':
+		p.pos++
+		children, err := p.parseSeq(closingBracket(c), start)
+		if err != nil {
+			return nil, err
+		}
+		n.typ = listNode
+		n.open = c
+		n.children = children
+		return n, nil
+
+	case c == '!' && start+1 < len(text) && text[start+1] == '(':
+		p.pos += 2
+		nameStart := p.pos
+		for p.pos < len(text) && !isDelimiter(text[p.pos]) {
+			p.pos++
+		}
+		name := text[nameStart:p.pos]
+		if !knownMacros[name] {
+			perr := p.errorAt(ErrUnknownMacro, start)
+			perr.Macro = name
+			return nil, perr
+		}
+		p.macros = append(p.macros, name)
+		children, err := p.parseSeq(')', start)
+		if err != nil {
+			return nil, err
+		}
+		p.macros = p.macros[:len(p.macros)-1]
+		n.typ = macroNode
+		n.value = name
+		n.children = children
+		return n, nil
+
+	case c == '\'' && start+1 < len(text) && !isWhitespace(text[start+1]) && !isClosingBracket(text[start+1]):
+		p.pos++
+		child, err := p.parseNode()
+		if err != nil {
+			return nil, err
+		}
+		n.typ = quoteNode
+		n.children = []*node{child}
+		return n, nil
 	}
-	return p.input[start:p.pos]
+
+	p.pos++
+	for p.pos < len(text) && !isDelimiter(text[p.pos]) {
+		p.pos++
+	}
+	n.typ = atomNode
+	n.value = text[start:p.pos]
+	return n, nil
 }
 
-func (p *Parser) ParseSExpr() string {
-	var result strings.Builder
-	result.WriteByte(p.Consume()) // Consume opening (
-	for p.Peek() != 0 {
-		if p.Peek() == '(' {
-			result.WriteString(p.ParseSExpr())
-		} else if p.Peek() == ')' {
-			result.WriteByte(p.Consume()) // Consume closing )
-			return result.String()
-		} else {
-			result.WriteByte(p.Consume())
+// errorAt returns a *PreprocessError located at the offset in the
+// source within the innermost enclosing macro.
+func (p *parser) errorAt(typ ErrorType, offset int) *PreprocessError {
+	line, column := sourcePosition(p.src.text, offset)
+	perr := &PreprocessError{
+		Type:   typ,
+		File:   p.src.file,
+		Line:   line,
+		Column: column,
+	}
+	if len(p.macros) > 0 {
+		perr.Macro = p.macros[len(p.macros)-1]
+	}
+	return perr
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func isClosingBracket(c byte) bool {
+	return c == ')' || c == ']' || c == '}'
+}
+
+// closingBracket returns the bracket closing the opening bracket c,
+// or zero if c is not an opening bracket.
+func closingBracket(c byte) byte {
+	switch c {
+	case '(':
+		return ')'
+	case '[':
+		return ']'
+	case '{':
+		return '}'
+	}
+	return 0
+}
+
+// isDelimiter returns whether c ends a symbol.
+func isDelimiter(c byte) bool {
+	return isWhitespace(c) || closingBracket(c) != 0 || isClosingBracket(c) || c == ';' || c == '"'
+}
+
+// serialize writes the syntax tree as a lurk program. Top level nodes
+// are separated by newlines and all other nodes by a single space.
+func serialize(nodes []*node) string {
+	w := &writer{}
+	w.writeSeq(nodes, "\n")
+	return w.sb.String()
+}
+
+// serializeWithSourceMap serializes the syntax tree and returns the
+// source map of the serialized program.
+func serializeWithSourceMap(nodes []*node) (string, *SourceMap) {
+	w := &writer{sourceMap: &sourceMapBuilder{}}
+	w.writeSeq(nodes, "\n")
+	return w.sb.String(), w.sourceMap.sourceMap()
+}
+
+// stripComments returns the tree with all comments removed other than
+// those for which keep returns true. Keep may be nil.
+func stripComments(nodes []*node, keep func(n *node) bool) []*node {
+	ret, _ := transformNodes(nodes, func(n *node) ([]*node, bool, error) {
+		if n.typ != commentNode {
+			return nil, false, nil
+		}
+		if keep != nil && keep(n) {
+			return []*node{n}, true, nil
+		}
+		return nil, true, nil
+	})
+	return ret
+}
+
+type writer struct {
+	sb        strings.Builder
+	sourceMap *sourceMapBuilder
+}
+
+func (w *writer) writeSeq(nodes []*node, sep string) {
+	first := true
+	for _, n := range nodes {
+		if !first && !w.atLineStart() {
+			w.sb.WriteString(sep)
+		}
+		w.writeNode(n)
+		first = false
+	}
+}
+
+func (w *writer) writeNode(n *node) {
+	if w.sourceMap != nil && n.typ != commentNode {
+		start := w.sb.Len()
+		defer func() {
+			w.sourceMap.add(n, start, w.sb.Len())
+		}()
+	}
+	switch n.typ {
+	case atomNode:
+		w.sb.WriteString(n.value)
+	case listNode:
+		w.sb.WriteByte(n.open)
+		w.writeSeq(n.children, " ")
+		w.sb.WriteByte(closingBracket(n.open))
+	case macroNode:
+		w.sb.WriteString("!(" + n.value)
+		if len(n.children) > 0 {
+			w.sb.WriteByte(' ')
+			w.writeSeq(n.children, " ")
+		}
+		w.sb.WriteByte(')')
+	case quoteNode:
+		w.sb.WriteByte('\'')
+		w.writeSeq(n.children, " ")
+	case commentNode:
+		w.sb.WriteString(n.value)
+		// A line comment runs to the end of the line so it must
+		// be followed by a newline.
+		if !isBlockComment(n.value) {
+			w.sb.WriteByte('\n')
+		}
+	}
+}
+
+func (w *writer) atLineStart() bool {
+	s := w.sb.String()
+	return len(s) > 0 && s[len(s)-1] == '\n'
+}
+
+// isBlockComment returns whether the comment is a #| block comment |#.
+func isBlockComment(comment string) bool {
+	return strings.HasPrefix(comment, "#|")
+}
+
+// blockCommentLen returns the length of the #| block comment |# starting
+// at i in s. Block comments may be nested. The bool is false if the
+// comment is never closed.
+func blockCommentLen(s string, i int) (int, bool) {
+	depth := 0
+	for j := i; j+1 < len(s); j++ {
+		switch {
+		case s[j] == '#' && s[j+1] == '|':
+			depth++
+			j++
+		case s[j] == '|' && s[j+1] == '#':
+			depth--
+			j++
+			if depth == 0 {
+				return j + 1 - i, true
+			}
+		}
+	}
+	return len(s) - i, false
+}
+
+// literalLen returns the length of the string, character or quoted
+// symbol literal starting at i in s, or zero if there is none. Lurk
+// strings are delimited by double quotes, characters are written as
+// #\c, and symbols containing special characters are delimited by
+// pipes, as in |a(b|.
+func literalLen(s string, i int) int {
+	switch {
+	case s[i] == '"' || s[i] == '|':
+		end := i + 1
+		for end < len(s) && s[end] != s[i] {
+			if s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		end++ // Closing delimiter
+		if end > len(s) {
+			end = len(s)
+		}
+		return end - i
+	case s[i] == '#' && i+1 < len(s) && s[i+1] == '\\':
+		if i+2 >= len(s) {
+			return len(s) - i
 		}
+		_, size := utf8.DecodeRuneInString(s[i+2:])
+		return 2 + size
 	}
-	return result.String()
+	return 0
 }
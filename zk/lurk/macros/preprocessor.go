@@ -5,7 +5,6 @@
 package macros
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -19,819 +18,1082 @@ var ErrCircularImports = errors.New("circular imports")
 const LurkFileExtension = ".lurk"
 
 type MacroPreprocessor struct {
-	depDir         *fsDirectory
-	removeComments bool
+	depDir            *fsDirectory
+	removeComments    bool
+	preserveHeader    bool
+	preservePrefixes  []string
+	eliminateDeadCode bool
+	foldConstants     bool
+	cache             *expansionCache
+	limits            expansionLimits
+	defines           map[string]bool
+	layout            *ParamLayout
 }
 
 func NewMacroPreprocessor(opts ...Option) (*MacroPreprocessor, error) {
-	var cfg config
+	cfg := config{limits: defaultExpansionLimits()}
 	for _, opt := range opts {
 		if err := opt(&cfg); err != nil {
 			return nil, err
 		}
 	}
 
+	if cfg.remote != nil && cfg.remote.lockfile != nil {
+		if cfg.depDir == nil {
+			cfg.depDir = &fsDirectory{}
+		}
+		cfg.depDir.remote = cfg.remote
+	}
+	if cfg.embeddedStdlib {
+		if cfg.depDir == nil {
+			cfg.depDir = &fsDirectory{}
+		}
+		cfg.depDir.stdlib = &fsDirectory{
+			fileSystem: embeddedDependencyDir,
+			path:       "deps",
+		}
+	}
+
 	return &MacroPreprocessor{
-		depDir:         cfg.depDir,
-		removeComments: cfg.removeComments,
+		depDir:            cfg.depDir,
+		removeComments:    cfg.removeComments,
+		preserveHeader:    cfg.preserveHeader,
+		preservePrefixes:  cfg.preservePrefixes,
+		eliminateDeadCode: cfg.eliminateDeadCode,
+		foldConstants:     cfg.foldConstants,
+		cache:             cfg.cache,
+		limits:            cfg.limits,
+		defines:           cfg.defines,
+		layout:            cfg.layout,
 	}, nil
 }
 
+// Preprocess expands all the macros in the lurk program. The program is
+// parsed into a syntax tree, the macros are expanded as transforms on the
+// tree, and the result is serialized back into a lurk program.
+//
+// If the program cannot be preprocessed the returned error is a
+// *PreprocessError with the location of the offending code.
 func (p *MacroPreprocessor) Preprocess(lurkProgram string) (string, error) {
-	if strings.Contains(lurkProgram, fmt.Sprintf("!(%s", Import.String())) {
-		if p.depDir == nil {
-			return "", errors.New("dependency directory not set")
-		}
+	return p.preprocess(lurkProgram, p.depDir)
+}
 
-		// Recursively expand import macros and check for circular imports
-		var err error
-		lurkProgram, err = macroExpandImport(lurkProgram, p.depDir, nil)
-		if err != nil {
-			return "", err
-		}
+// preprocess preprocesses the program importing modules from the
+// dependency directory.
+func (p *MacroPreprocessor) preprocess(lurkProgram string, depDir *fsDirectory) (string, error) {
+	if p.cache != nil {
+		return p.preprocessCached(lurkProgram, depDir)
 	}
-	ret, err := preProcess(lurkProgram)
+	nodes, err := p.expand(lurkProgram, depDir)
 	if err != nil {
 		return "", err
 	}
-	if p.removeComments {
-		ret = removeComments(ret)
-	}
-	if !IsValidLurk(ret) {
-		return "", errors.New("error preprocessing: mismatch parenthesis")
+	output := serialize(p.stripComments(nodes))
+	if err := p.limits.checkOutputSize(output); err != nil {
+		return "", err
 	}
-	return ret, nil
-}
-
-var paramMap = map[string]string{
-	"sighash":            "(car public-params)",
-	"txo-root":           "(car (cdr (cdr public-params)))",
-	"fee":                "(car (cdr (cdr (cdr public-params))))",
-	"coinbase":           "(car (cdr (cdr (cdr (cdr public-params)))))",
-	"mint-id":            "(car (cdr (cdr (cdr (cdr (cdr public-params))))))",
-	"mint-amount":        "(car (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))",
-	"locktime":           "(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))))",
-	"locktime-precision": "(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params))))))))))",
+	return output, nil
 }
 
-var inputMap = map[string]string{
-	"amount":           "(car %s)",
-	"asset-id":         "(car (cdr %s))",
-	"salt":             "(car (cdr (cdr %s)))",
-	"state":            "(car (cdr (cdr (cdr %s))))",
-	"commitment-index": "(car (cdr (cdr (cdr (cdr %s)))))",
-	"inclusion-proof":  "(car (cdr (cdr (cdr (cdr (cdr %s))))))",
-	"script":           "(car (cdr (cdr (cdr (cdr (cdr (cdr %s)))))))",
-	"locking-params":   "(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr %s))))))))",
-	"unlocking-params": "(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr %s)))))))))",
-}
-
-var outputMap = map[string]string{
-	"script-hash": "(car %s)",
-	"amount":      "(car (cdr %s))",
-	"asset-id":    "(car (cdr (cdr %s)))",
-	"salt":        "(car (cdr (cdr (cdr %s))))",
-	"state":       "(car (cdr (cdr (cdr (cdr %s)))))",
+// PreprocessWithSourceMap expands all the macros in the lurk program
+// like Preprocess and also returns a source map from the expressions
+// in the preprocessed program back to the files, modules and lines
+// they originated from.
+func (p *MacroPreprocessor) PreprocessWithSourceMap(lurkProgram string) (string, *SourceMap, error) {
+	nodes, err := p.expand(lurkProgram, p.depDir)
+	if err != nil {
+		return "", nil, err
+	}
+	program, sourceMap := serializeWithSourceMap(p.stripComments(nodes))
+	if err := p.limits.checkOutputSize(program); err != nil {
+		return "", nil, err
+	}
+	return program, sourceMap, nil
 }
 
-var pubOutMap = map[string]string{
-	"commitment": "(car %s)",
-	"ciphertext": "(car (cdr %s))",
+// expand parses the program and expands all of its macros, importing
+// modules from the dependency directory.
+func (p *MacroPreprocessor) expand(lurkProgram string, depDir *fsDirectory) ([]*node, error) {
+	return p.expandSteps(lurkProgram, depDir, nil)
 }
 
-func loadFilesFromFS(fileSystem fs.FS, directory string) ([]string, error) {
-	dirEntries, err := fs.ReadDir(fileSystem, directory)
+// expandSteps expands the program like expand. If step is not nil the
+// macros are expanded one pass at a time and step is called with the
+// tree after each pass.
+func (p *MacroPreprocessor) expandSteps(lurkProgram string, depDir *fsDirectory, step func(pass string, nodes []*node)) ([]*node, error) {
+	stepwise := step != nil
+	if !stepwise {
+		step = func(string, []*node) {}
+	}
+	nodes, err := parse(&source{text: lurkProgram})
 	if err != nil {
 		return nil, err
 	}
-
-	var fileContents []string
-	for _, entry := range dirEntries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == LurkFileExtension {
-			content, err := fs.ReadFile(fileSystem, filepath.Join(directory, entry.Name()))
+	nodes, err = expandConditionals(nodes, p.defines)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err = removeTests(nodes)
+	if err != nil {
+		return nil, err
+	}
+	step(PassConditionals, nodes)
+	nodes, err = resolveImports(nodes, depDir, p.defines, p.newBudget())
+	if err != nil {
+		return nil, err
+	}
+	step(Import.String(), nodes)
+	if p.eliminateDeadCode {
+		nodes = eliminateDeadCode(nodes)
+		step(PassEliminateDeadCode, nodes)
+	}
+	if !stepwise {
+		nodes, err = newExpander(nodes, nil, p.layout).expandMacros(nodes)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, pass := range macroPasses {
+			expanded := make(map[Macro]bool)
+			for _, m := range pass {
+				expanded[m] = true
+			}
+			nodes, err = newExpander(nodes, expanded, p.layout).expandMacros(nodes)
 			if err != nil {
 				return nil, err
 			}
-			fileContents = append(fileContents, string(content))
+			step(pass[0].String(), nodes)
 		}
 	}
-	return fileContents, nil
+	if p.foldConstants {
+		nodes = foldConstants(nodes)
+		step(PassFoldConstants, nodes)
+	}
+	return nodes, nil
 }
 
-func extractModule(files []string, moduleName string) (string, error) {
-	moduleCount := 0
-	moduleContent := ""
+// stripComments removes the comments from the tree if RemoveComments is
+// set, keeping the header and prefixed comments selected by the options.
+func (p *MacroPreprocessor) stripComments(nodes []*node) []*node {
+	if !p.removeComments {
+		return nodes
+	}
+	header := make(map[*node]bool)
+	if p.preserveHeader {
+		for _, n := range nodes {
+			if n.typ != commentNode {
+				break
+			}
+			header[n] = true
+		}
+	}
+	return stripComments(nodes, func(n *node) bool {
+		if header[n] {
+			return true
+		}
+		for _, prefix := range p.preservePrefixes {
+			if strings.HasPrefix(n.value, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+}
 
-	for _, content := range files {
-		p := NewParser(content)
-		for p.Peek() != 0 {
-			if strings.HasPrefix(p.input[p.pos:], "!(module") {
-				p.pos += 9 // Skip over "!(module"
-				nameStart := p.pos
+// newBudget returns a budget to expand a program within the limits.
+func (p *MacroPreprocessor) newBudget() *expansionBudget {
+	return &expansionBudget{limits: p.limits}
+}
 
-				for p.Peek() != ' ' && p.Peek() != 0 {
-					p.Consume()
-				}
+// resolveImports replaces the import macros in the tree with the
+// contents of the modules imported from the dependency directory.
+func resolveImports(nodes []*node, depDir *fsDirectory, defines map[string]bool, budget *expansionBudget) ([]*node, error) {
+	if !containsMacro(nodes, Import) {
+		return nodes, nil
+	}
+	if depDir == nil || (depDir.fileSystem == nil && depDir.remote == nil && depDir.stdlib == nil) {
+		return nil, errors.New("dependency directory not set")
+	}
 
-				name := p.input[nameStart:p.pos]
-				if name == moduleName {
-					moduleCount++
-
-					for p.Peek() != '(' && p.Peek() != 0 {
-						p.Consume()
-					}
-					if p.Peek() == '(' {
-						p.Consume() // Skip over opening parenthesis
-					}
-					depth := 1
-					moduleStart := p.pos
-					for depth > 0 && p.Peek() != 0 {
-						if p.Peek() == '(' {
-							depth++
-						} else if p.Peek() == ')' {
-							depth--
-						}
-						if depth > 0 {
-							p.Consume()
-						}
-					}
-					moduleContent += p.input[moduleStart:p.pos-1] + "\n" // Exclude the closing parenthesis
-				}
-			} else {
-				p.Consume()
+	// Recursively expand import macros and check for circular imports
+	return expandImports(nodes, depDir, defines, nil, budget)
+}
+
+// nthAccessorExpr returns the item at the index in a list, or nil if
+// the index doesn't exist. It is used for param indices which are not
+// known until the script is evaluated. The placeholders are the names of
+// the function and its parameters, the index and the list.
+const nthAccessorExpr = "(letrec ((%[1]s (lambda (%[2]s %[3]s) (if (= %[2]s 0) (car %[3]s) (%[1]s (- %[2]s 1) (cdr %[3]s)))))) (%[1]s %[4]s %[5]s))"
+
+// isIndexSymbol returns whether the param index is a symbol rather than
+// a constant.
+func isIndexSymbol(index string) bool {
+	if isConstant(index) || strings.ContainsAny(index, "'`,") {
+		return false
+	}
+	// Symbols cannot start with a digit or a signed number.
+	c := index[0]
+	if c >= '0' && c <= '9' {
+		return false
+	}
+	if (c == '-' || c == '+') && len(index) > 1 && index[1] >= '0' && index[1] <= '9' {
+		return false
+	}
+	return true
+}
+
+// transformNodes calls fn on each node in the tree. If fn returns true the
+// node is replaced by the returned nodes, otherwise the node is kept and
+// its children are transformed. The tree is not modified in place.
+func transformNodes(nodes []*node, fn func(n *node) ([]*node, bool, error)) ([]*node, error) {
+	ret := make([]*node, 0, len(nodes))
+	for _, n := range nodes {
+		replacement, ok, err := fn(n)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, replacement...)
+			continue
+		}
+		if len(n.children) > 0 {
+			children, err := transformNodes(n.children, fn)
+			if err != nil {
+				return nil, err
 			}
+			cpy := *n
+			cpy.children = children
+			n = &cpy
 		}
+		ret = append(ret, n)
 	}
+	return ret, nil
+}
 
-	if moduleCount > 1 {
-		return "", fmt.Errorf("found multiple modules named %s", moduleName)
-	} else if moduleCount == 0 {
-		return "", fmt.Errorf("module %s not found", moduleName)
+// containsMacro returns whether the macro is used anywhere in the tree.
+func containsMacro(nodes []*node, m Macro) bool {
+	for _, n := range nodes {
+		if n.isMacro(m) || containsMacro(n.children, m) {
+			return true
+		}
 	}
-
-	return moduleContent, nil
+	return false
 }
 
-func extractModuleExpression(moduleContent, exprName string) (string, error) {
-	expression := ""
+// expandConditionals expands the !(ifdef FEATURE ...) and
+// !(ifndef FEATURE ...) macros. If the condition holds the macro is
+// replaced by its body, otherwise it is removed entirely. Conditionals
+// may be nested.
+func expandConditionals(nodes []*node, defines map[string]bool) ([]*node, error) {
+	return transformNodes(nodes, func(n *node) ([]*node, bool, error) {
+		if !n.isMacro(Ifdef) && !n.isMacro(Ifndef) {
+			return nil, false, nil
+		}
+		args := n.args()
+		if len(args) == 0 || args[0].typ != atomNode {
+			return nil, false, n.errorf(ErrInvalidMacro, "missing feature name")
+		}
+		if defines[args[0].value] != n.isMacro(Ifdef) {
+			return nil, true, nil
+		}
+		// Keep the body, including any comments, after the feature name.
+		for i, child := range n.children {
+			if child == args[0] {
+				body, err := expandConditionals(n.children[i+1:], defines)
+				return body, true, err
+			}
+		}
+		return nil, true, nil
+	})
+}
 
-	p := NewParser(moduleContent)
-	for p.Peek() != 0 {
-		if strings.HasPrefix(p.input[p.pos:], "!(defun") {
-			startPos := p.pos
-			p.pos += 8 // Skip over "!(defun"
-			nameStart := p.pos
+// loadFilesFromFS loads and parses all the lurk files in the directory,
+// expands their conditional macros and removes their tests. The nodes
+// in each module body are labeled with the module name.
+func loadFilesFromFS(fileSystem fs.FS, directory string, defines map[string]bool) ([][]*node, error) {
+	dirEntries, err := fs.ReadDir(fileSystem, directory)
+	if err != nil {
+		return nil, err
+	}
 
-			for p.Peek() != ' ' && p.Peek() != 0 {
-				p.Consume()
+	var files [][]*node
+	for _, entry := range dirEntries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == LurkFileExtension {
+			name := filepath.Join(directory, entry.Name())
+			content, err := fs.ReadFile(fileSystem, name)
+			if err != nil {
+				return nil, err
 			}
-
-			name := p.input[nameStart:p.pos]
-			if name == exprName {
-				depth := 1
-				for depth > 0 && p.Peek() != 0 {
-					if p.Peek() == '(' {
-						depth++
-					} else if p.Peek() == ')' {
-						depth--
-					}
-					if depth > 0 {
-						p.Consume()
-					}
-				}
-				p.Consume()
-				p.Consume()
-				expression += p.input[startPos:p.pos-1] + "\n" // Exclude the closing parenthesis
+			nodes, err := parse(&source{file: name, text: string(content)})
+			if err != nil {
+				return nil, err
 			}
-		} else if strings.HasPrefix(p.input[p.pos:], "!(def") {
-			startPos := p.pos
-			p.pos += 6 // Skip over "!(def"
-			nameStart := p.pos
-
-			for p.Peek() != ' ' && p.Peek() != 0 {
-				p.Consume()
+			nodes, err = expandConditionals(nodes, defines)
+			if err != nil {
+				return nil, err
 			}
-
-			name := p.input[nameStart:p.pos]
-			if name == exprName {
-				depth := 1
-				for depth > 0 && p.Peek() != 0 {
-					if p.Peek() == '(' {
-						depth++
-					} else if p.Peek() == ')' {
-						depth--
-					}
-					if depth > 0 {
-						p.Consume()
-					}
+			nodes, err = removeTests(nodes)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range nodes {
+				if args := n.args(); n.isMacro(Module) && len(args) == 2 && args[0].typ == atomNode {
+					setModule(args[1].children, args[0].value)
 				}
-				p.Consume()
-				p.Consume()
-				expression += p.input[startPos:p.pos-1] + "\n" // Exclude the closing parenthesis
 			}
-		} else if strings.HasPrefix(p.input[p.pos:], "!(defrec") {
-			startPos := p.pos
-			p.pos += 9 // Skip over "!(defrec"
-			nameStart := p.pos
+			files = append(files, nodes)
+		}
+	}
+	return files, nil
+}
 
-			for p.Peek() != ' ' && p.Peek() != 0 {
-				p.Consume()
+// extractModule returns the body of the module declared by
+// !(module name (body...)) in one of the files.
+func extractModule(files [][]*node, moduleName string) ([]*node, error) {
+	var (
+		moduleCount int
+		body        []*node
+	)
+	for _, nodes := range files {
+		for _, n := range nodes {
+			if !n.isMacro(Module) {
+				continue
 			}
-
-			name := p.input[nameStart:p.pos]
-			if name == exprName {
-				depth := 1
-				for depth > 0 && p.Peek() != 0 {
-					if p.Peek() == '(' {
-						depth++
-					} else if p.Peek() == ')' {
-						depth--
-					}
-					if depth > 0 {
-						p.Consume()
-					}
-				}
-				p.Consume()
-				p.Consume()
-				expression += p.input[startPos:p.pos-1] + "\n" // Exclude the closing parenthesis
+			args := n.args()
+			if len(args) != 2 || args[0].typ != atomNode || args[1].typ != listNode {
+				return nil, n.errorf(ErrInvalidMacro, "module must take the form !(module name (body))")
+			}
+			if args[0].value == moduleName {
+				moduleCount++
+				body = args[1].children
 			}
-		} else {
-			p.Consume()
 		}
 	}
 
-	return expression, nil
+	if moduleCount > 1 {
+		return nil, fmt.Errorf("found multiple modules named %s", moduleName)
+	} else if moduleCount == 0 {
+		return nil, fmt.Errorf("module %s not found", moduleName)
+	}
+	return body, nil
 }
 
-func macroExpandImport(lurkProgram string, dependencyDir *fsDirectory, dependencyChain []string) (string, error) {
-	var result string
-	p := NewParser(lurkProgram)
-
-	for p.Peek() != 0 {
-		if strings.HasPrefix(p.input[p.pos:], "!(import") {
-			p.pos += 9 // Skip over "!(import"
-			importPathStart := p.pos
+// setModule records the module the nodes are declared in.
+func setModule(nodes []*node, moduleName string) {
+	for _, n := range nodes {
+		n.module = moduleName
+		setModule(n.children, moduleName)
+	}
+}
 
-			for p.Peek() != ')' && p.Peek() != 0 {
-				p.Consume()
+// extractModuleExpression returns the def, defrec or defun macro in the
+// module body which defines the name.
+func extractModuleExpression(moduleBody []*node, exprName string) []*node {
+	var expressions []*node
+	for _, n := range moduleBody {
+		if n.isMacro(Def) || n.isMacro(Defrec) || n.isMacro(Defun) {
+			if args := n.args(); len(args) > 0 && args[0].value == exprName {
+				expressions = append(expressions, n)
 			}
+		}
+	}
+	return expressions
+}
 
-			pathAndModule := p.input[importPathStart:p.pos]
-
-			depChainCpy := make([]string, len(dependencyChain))
-			copy(depChainCpy, dependencyChain)
+// extractModuleExpressions returns the named expressions from the module
+// at the path.
+func extractModuleExpressions(dependencyDir *fsDirectory, defines map[string]bool, splits []string, exprNames []string) ([]*node, error) {
+	moduleDir, dir, moduleName, err := dependencyDir.moduleDir(splits[:len(splits)-1], splits[len(splits)-1])
+	if err != nil {
+		return nil, err
+	}
+	files, err := moduleDir.loadFiles(dir, defines)
+	if err != nil {
+		return nil, err
+	}
+	moduleBody, err := extractModule(files, moduleName)
+	if err != nil {
+		return nil, err
+	}
+	var expressions []*node
+	for _, exprName := range exprNames {
+		expression := extractModuleExpression(moduleBody, exprName)
+		if len(expression) == 0 {
+			return nil, fmt.Errorf("%s not found in module %s", exprName, moduleName)
+		}
+		expressions = append(expressions, expression...)
+	}
+	return expressions, nil
+}
 
-			for _, mod := range depChainCpy {
-				if mod == pathAndModule {
-					return "", fmt.Errorf("%w: %s", ErrCircularImports, strings.Join(depChainCpy, " -> "))
-				}
-			}
-			depChainCpy = append(depChainCpy, pathAndModule)
+// importSpec is the parsed body of an import macro.
+type importSpec struct {
+	// path is the path to the module or module expression.
+	path string
+	// symbols are the expressions to import from the module, if
+	// only some of them are imported.
+	symbols []string
+	// alias is the optional alias for the imported names.
+	alias string
+}
 
-			splits := strings.Split(pathAndModule, "/")
+// parseImport parses the arguments of an import macro. The arguments
+// take the forms:
+//
+//	path/module
+//	path/module/expression
+//	path/module (expression-a expression-b)
+//
+// Any of which may be followed by :as alias. Elements of the path may be
+// pinned to a version, as in path/module@v1.2.0, see moduleDir. An
+// expression is imported from a versioned module with the list form.
+func parseImport(n *node) (importSpec, error) {
+	var spec importSpec
+	args := n.args()
+	if len(args) == 0 || args[0].typ != atomNode {
+		return spec, errors.New("invalid import format")
+	}
+	spec.path = args[0].value
+	args = args[1:]
 
-			if len(splits) < 1 {
-				return "", fmt.Errorf("invalid import format")
+	if len(args) > 0 && args[0].typ == listNode {
+		for _, symbol := range args[0].args() {
+			if symbol.typ != atomNode {
+				return spec, errors.New("invalid import format")
 			}
+			spec.symbols = append(spec.symbols, symbol.value)
+		}
+		if len(spec.symbols) == 0 {
+			return spec, errors.New("empty import list")
+		}
+		args = args[1:]
+	}
 
-			// The last split is the module name, everything else is part of the directory.
-			var moduleContent string
-			secondPass := false
-			for {
-				moduleName := splits[len(splits)-1]
-				exprName := ""
-				dir := filepath.Join(append([]string{dependencyDir.path}, splits[:len(splits)-1]...)...)
-				if secondPass {
-					if len(splits) < 2 {
-						return "", errors.New("dependency file not found")
-					}
-					moduleName = splits[len(splits)-2]
-					exprName = splits[len(splits)-1]
-					dir = filepath.Join(append([]string{dependencyDir.path}, splits[:len(splits)-2]...)...)
-				}
-
-				// If there was only the module name without any directory, use dependencyDirectoryPath as the directory.
-				if (!secondPass && len(splits) == 1) || (secondPass && len(splits) == 2) {
-					dir = dependencyDir.path
-				}
-
-				// Load files
-				files, err := loadFilesFromFS(dependencyDir.fileSystem, dir)
-				if err != nil {
-					if secondPass {
-						return "", err
-					} else {
-						secondPass = true
-						continue
-					}
-				}
-				// Extract module content
-				moduleContent, err = extractModule(files, moduleName)
-				if err != nil {
-					return "", err
-				}
-
-				if secondPass {
-					moduleContent, err = extractModuleExpression(moduleContent, exprName)
-					if err != nil {
-						return "", err
-					}
-				}
+	switch {
+	case len(args) == 0:
+	case len(args) == 2 && args[0].value == ":as" && args[1].typ == atomNode:
+		if strings.ContainsAny(args[1].value, "./") {
+			return spec, fmt.Errorf("invalid import alias %s", args[1].value)
+		}
+		spec.alias = args[1].value
+	default:
+		return spec, errors.New("invalid import format")
+	}
+	return spec, nil
+}
 
-				break
-			}
+// expandImports recursively expands the import macros in the tree.
+// Each import is replaced by the body of the imported module.
+func expandImports(nodes []*node, dependencyDir *fsDirectory, defines map[string]bool, dependencyChain []string, budget *expansionBudget) ([]*node, error) {
+	return transformNodes(nodes, func(n *node) ([]*node, bool, error) {
+		if !n.isMacro(Import) {
+			return nil, false, nil
+		}
+		imported, err := expandImport(n, dependencyDir, defines, dependencyChain, budget)
+		if errors.Is(err, ErrExpansionLimit) {
+			return nil, false, n.wrapError(ErrLimitExceeded, err)
+		} else if err != nil {
+			return nil, false, n.wrapError(ErrImport, err)
+		}
+		return imported, true, nil
+	})
+}
 
-			// Before returning the expanded content, process imports within the moduleContent
-			expandedModuleContent, err := macroExpandImport(moduleContent, dependencyDir, depChainCpy)
-			if err != nil {
-				return "", err
-			}
+// expandImport returns the nodes imported by the import macro with any
+// imports within them expanded.
+func expandImport(n *node, dependencyDir *fsDirectory, defines map[string]bool, dependencyChain []string, budget *expansionBudget) ([]*node, error) {
+	spec, err := parseImport(n)
+	if err != nil {
+		return nil, err
+	}
+	depChain, err := extendDependencyChain(dependencyChain, spec.path)
+	if err != nil {
+		return nil, err
+	}
+	mod, err := loadImport(spec, dependencyDir, defines)
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.importModule(len(depChain), mod.nodes); err != nil {
+		return nil, err
+	}
 
-			p.ReadUntil(')')
-			p.Consume() // Consume the closing parenthesis after the import body
+	// Before returning the imported nodes, expand the imports within them.
+	imported, err := expandImports(mod.nodes, dependencyDir, defines, depChain, budget)
+	if err != nil {
+		return nil, err
+	}
+	if spec.alias != "" {
+		imported = aliasDefinitions(imported, spec.alias)
+	}
+	return imported, nil
+}
 
-			result += expandedModuleContent
-		} else {
-			result += string(p.Consume())
+// extendDependencyChain returns a copy of the chain of imports with
+// the path appended, or an error if the path is already in the chain.
+func extendDependencyChain(dependencyChain []string, pathAndModule string) ([]string, error) {
+	for _, mod := range dependencyChain {
+		if mod == pathAndModule {
+			return nil, fmt.Errorf("%w: %s", ErrCircularImports, strings.Join(dependencyChain, " -> "))
 		}
 	}
-	return result, nil
+	depChainCpy := make([]string, len(dependencyChain), len(dependencyChain)+1)
+	copy(depChainCpy, dependencyChain)
+	return append(depChainCpy, pathAndModule), nil
 }
 
-func macroExpandParam(lurkProgram string) string {
-	p := NewParser(lurkProgram)
-	result := ""
-
-	for p.Peek() != 0 {
-		if strings.HasPrefix(p.input[p.pos:], "!(param") {
-			p.pos += 8 // Skip over "!(param"
-			paramStart := p.pos
+// importedModule is the result of resolving an import.
+type importedModule struct {
+	// nodes are the imported module body or expressions.
+	nodes []*node
+	// module is the name of the module the nodes are imported from.
+	module string
+	// remote is the remote dependency the module is imported from, if any.
+	remote string
+}
 
-			for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-				p.Consume()
-			}
-			paramName := p.input[paramStart:p.pos]
+// loadImport loads the module or module expressions imported by the
+// import spec. The imports within them are not expanded.
+func loadImport(spec importSpec, dependencyDir *fsDirectory, defines map[string]bool) (*importedModule, error) {
+	splits := strings.Split(spec.path, "/")
 
-			if paramName == "nullifiers" {
-				// Skip over potential whitespace
-				for p.Peek() == ' ' {
-					p.Consume()
-				}
-				indexStart := p.pos
-				for p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				index := p.input[indexStart:p.pos]
-				idx, err := strconv.Atoi(index)
-				if err != nil {
-					return ""
-				}
-				expr := "(car "
-				for i := 0; i < idx; i++ {
-					expr += "(cdr "
-				}
-				result += fmt.Sprintf("%s(car (cdr public-params))", expr)
-				for i := 0; i < idx+1; i++ {
-					result += ")"
-				}
-			} else if paramName == "priv-in" {
-				// Skip over potential whitespace
-				for p.Peek() == ' ' {
-					p.Consume()
-				}
-				indexStart := p.pos
-				for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				index := p.input[indexStart:p.pos]
-				idx, err := strconv.Atoi(index)
-				if err != nil {
-					return ""
-				}
-				expr := "(car "
-				for i := 0; i < idx; i++ {
-					expr += "(cdr "
-				}
-				resultExp := fmt.Sprintf("%s(car private-params)", expr)
-				for i := 0; i < idx+1; i++ {
-					resultExp += ")"
-				}
+	// Imports from a remote dependency are loaded from its cached copy
+	// and standard library imports may be loaded from the embedded copy.
+	importDir, splits, err := dependencyDir.resolve(splits)
+	if err != nil {
+		return nil, err
+	}
+	mod := &importedModule{}
+	if importDir != dependencyDir && importDir != dependencyDir.stdlib {
+		mod.remote = strings.Split(spec.path, "/")[0]
+	}
 
-				if p.Peek() == ' ' {
-					// Consume whitespace and then check for sub-param
-					p.Consume()
-					subParamStart := p.pos
-					for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-						p.Consume()
-					}
-					subParam := p.input[subParamStart:p.pos]
-					if subExpr, ok := inputMap[subParam]; ok {
-						result += fmt.Sprintf(subExpr, resultExp)
-					} else {
-						result += resultExp
-					}
-				} else {
-					result += resultExp
-				}
+	// The last split is the module name, everything else is part of the directory.
+	if len(spec.symbols) > 0 {
+		// Only load the dependency directory once when importing
+		// several expressions from the module.
+		mod.module, _, _ = splitVersion(splits[len(splits)-1])
+		mod.nodes, err = extractModuleExpressions(importDir, defines, splits, spec.symbols)
+		if err != nil {
+			return nil, err
+		}
+		return mod, nil
+	}
 
-			} else if paramName == "priv-out" {
-				// Skip over potential whitespace
-				for p.Peek() == ' ' {
-					p.Consume()
-				}
-				indexStart := p.pos
-				for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				index := p.input[indexStart:p.pos]
-				idx, err := strconv.Atoi(index)
-				if err != nil {
-					return ""
-				}
-				expr := "(car "
-				for i := 0; i < idx; i++ {
-					expr += "(cdr "
-				}
-				resultExp := fmt.Sprintf("%s(car (cdr private-params))", expr)
-				//resultExp := fmt.Sprintf("%s(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params))))))))", expr)
-				for i := 0; i < idx+1; i++ {
-					resultExp += ")"
-				}
+	secondPass := false
+	for {
+		moduleName := splits[len(splits)-1]
+		exprName := ""
+		dirSplits := splits[:len(splits)-1]
+		if secondPass {
+			// The last split may instead be an expression in the module.
+			if len(splits) < 2 {
+				return nil, errors.New("dependency file not found")
+			}
+			moduleName = splits[len(splits)-2]
+			exprName = splits[len(splits)-1]
+			dirSplits = splits[:len(splits)-2]
+		}
 
-				if p.Peek() == ' ' {
-					// Consume whitespace and then check for sub-param
-					p.Consume()
-					subParamStart := p.pos
-					for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-						p.Consume()
-					}
-					subParam := p.input[subParamStart:p.pos]
-					if subExpr, ok := outputMap[subParam]; ok {
-						result += fmt.Sprintf(subExpr, resultExp)
-					} else {
-						result += resultExp
-					}
-				} else {
-					result += resultExp
-				}
-			} else if paramName == "pub-out" {
-				// Skip over potential whitespace
-				for p.Peek() == ' ' {
-					p.Consume()
-				}
-				indexStart := p.pos
-				for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				index := p.input[indexStart:p.pos]
-				idx, err := strconv.Atoi(index)
-				if err != nil {
-					return ""
-				}
-				expr := "(car "
-				for i := 0; i < idx; i++ {
-					expr += "(cdr "
-				}
-				//resultExp := fmt.Sprintf("%s(car (cdr private-params))", expr)
-				resultExp := fmt.Sprintf("%s(car (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params))))))))", expr)
-				for i := 0; i < idx+1; i++ {
-					resultExp += ")"
-				}
+		moduleDir, dir, moduleName, err := importDir.moduleDir(dirSplits, moduleName)
+		if errors.Is(err, ErrAmbiguousImport) {
+			return nil, err
+		}
+		var files [][]*node
+		if err == nil {
+			files, err = moduleDir.loadFiles(dir, defines)
+		}
+		var perr *PreprocessError
+		if errors.As(err, &perr) {
+			return nil, err
+		}
+		if err != nil {
+			if secondPass {
+				return nil, err
+			}
+			secondPass = true
+			continue
+		}
+		mod.module = moduleName
+		mod.nodes, err = extractModule(files, moduleName)
+		if err != nil {
+			return nil, err
+		}
+		if secondPass {
+			mod.nodes = extractModuleExpression(mod.nodes, exprName)
+			if len(mod.nodes) == 0 {
+				return nil, fmt.Errorf("%s not found in module %s", exprName, moduleName)
+			}
+		}
+		return mod, nil
+	}
+}
 
-				if p.Peek() == ' ' {
-					// Consume whitespace and then check for sub-param
-					p.Consume()
-					subParamStart := p.pos
-					for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-						p.Consume()
-					}
-					subParam := p.input[subParamStart:p.pos]
-					if subExpr, ok := pubOutMap[subParam]; ok {
-						result += fmt.Sprintf(subExpr, resultExp)
-					} else {
-						result += resultExp
-					}
-				} else {
-					result += resultExp
+// aliasDefinitions renames every name defined in the nodes, along with
+// all references to it, to alias.name. This allows modules that define
+// the same names to be imported into one program.
+func aliasDefinitions(nodes []*node, alias string) []*node {
+	names := make(map[string]bool)
+	var collect func(nodes []*node)
+	collect = func(nodes []*node) {
+		for _, n := range nodes {
+			if n.isMacro(Def) || n.isMacro(Defrec) || n.isMacro(Defun) {
+				if args := n.args(); len(args) > 0 && args[0].typ == atomNode {
+					names[args[0].value] = true
 				}
-			} else if substitution, found := paramMap[paramName]; found {
-				result += substitution
-			} else {
-				// In case the paramName is not found, let's just keep the original code
-				result += "!(param" + paramName + ")"
 			}
+			collect(n.children)
+		}
+	}
+	collect(nodes)
+	if len(names) == 0 {
+		return nodes
+	}
 
-			p.ReadUntil(')')
-			p.Consume() // Consume the closing parenthesis after the param body
-		} else {
-			result += string(p.Consume())
-		}
-	}
-	return result
-}
-
-func macroExpandList(lurkProgram string) string {
-	for strings.Contains(lurkProgram, "!(list") {
-		p := NewParser(lurkProgram)
-		result := ""
-
-		for p.Peek() != 0 {
-			if strings.HasPrefix(p.input[p.pos:], "!(list") {
-				p.pos += 7 // Skip over "!(list"
-				var elements []string
-
-				// Ensure we capture all elements and that we don't accidentally consume the closing parenthesis of !(list ... )
-				for p.Peek() != ')' && p.Peek() != 0 {
-					// Skip over potential whitespace
-					for p.Peek() == ' ' {
-						p.Consume()
-					}
-					var body string
-					if p.Peek() == '(' {
-						body = p.ParseSExpr() // Parse the s-expression if body starts with (
-					} else {
-						bodyStart := p.pos
-						for p.Peek() != ' ' && p.Peek() != ')' && p.Peek() != 0 {
-							p.Consume()
-						}
-						body = p.input[bodyStart:p.pos]
-					}
-
-					elements = append(elements, body)
-				}
+	ret, _ := transformNodes(nodes, func(n *node) ([]*node, bool, error) {
+		switch {
+		case n.typ == quoteNode:
+			// Quoted symbols are data rather than references.
+			return []*node{n}, true, nil
+		case n.typ == atomNode && names[n.value]:
+			cpy := *n
+			cpy.value = alias + "." + n.value
+			return []*node{&cpy}, true, nil
+		}
+		return nil, false, nil
+	})
+	return ret
+}
 
-				p.ReadUntil(')')
-				p.Consume() // Consume the closing parenthesis after the list body
+// expander expands the def, defrec, defun, assert, assert-eq, list,
+// param and check-sig macros.
+type expander struct {
+	// macros are the macros to expand. The other macros are left in
+	// place, with their arguments expanded. All macros are expanded if
+	// it is nil.
+	macros map[Macro]bool
+
+	// symbols are the symbols in the program and gensyms are the names
+	// generated for the bindings introduced by the expansions, keyed by
+	// their base name.
+	symbols map[string]bool
+	gensyms map[string]string
+
+	// layout is the layout the params are expanded against. The
+	// DefaultParamLayout is used if it is nil.
+	layout *ParamLayout
+}
 
-				if len(elements) > 0 {
-					result += buildConsList(elements)
-				} else {
-					result += "nil"
+func newExpander(nodes []*node, macros map[Macro]bool, layout *ParamLayout) *expander {
+	e := &expander{macros: macros, symbols: make(map[string]bool), layout: layout}
+	var collect func(nodes []*node)
+	collect = func(nodes []*node) {
+		for _, n := range nodes {
+			switch {
+			case n.typ == atomNode:
+				e.symbols[n.value] = true
+			case n.isMacro(Param):
+				// Only the index of a param is a symbol, the name and
+				// field are not.
+				if args := n.args(); len(args) > 1 {
+					collect(args[1:2])
 				}
-			} else {
-				result += string(p.Consume())
+			default:
+				collect(n.children)
 			}
 		}
-		lurkProgram = result
 	}
-	return lurkProgram
+	collect(nodes)
+	return e
 }
 
-// Recursively builds a cons list from the elements
-func buildConsList(elems []string) string {
-	if len(elems) == 0 {
-		return "nil"
+// gensym returns a name for a binding introduced by an expansion which
+// does not occur in the program, so the binding cannot capture or
+// shadow any of the program's bindings. The base name is used as is
+// unless it is taken, in which case a numeric suffix is added. The
+// same base name always returns the same name as the generated code
+// never encloses another expansion's bindings.
+func (e *expander) gensym(base string) string {
+	if name, ok := e.gensyms[base]; ok {
+		return name
+	}
+	name := base
+	for i := 1; e.symbols[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
 	}
-	if len(elems) == 1 {
-		return fmt.Sprintf("(cons %s nil)", elems[0])
+	if e.gensyms == nil {
+		e.gensyms = make(map[string]string)
 	}
+	e.gensyms[base] = name
+	return name
+}
 
-	return fmt.Sprintf("(cons %s %s)", elems[0], buildConsList(elems[1:]))
+// paramLayout returns the layout the params are expanded against.
+func (e *expander) paramLayout() *ParamLayout {
+	if e.layout == nil {
+		return DefaultParamLayout
+	}
+	return e.layout
 }
 
-func macroExpandAssert(lurkProgram string) string {
-	p := NewParser(lurkProgram)
-	result := ""
+// expands returns whether the node is a macro which is expanded.
+func (e *expander) expands(n *node) bool {
+	return n.typ == macroNode && (e.macros == nil || e.macros[Macro(n.value)])
+}
 
-	for p.Peek() != 0 {
-		if strings.HasPrefix(p.input[p.pos:], "!(assert") &&
-			!strings.HasPrefix(p.input[p.pos:], "!(assert-eq") {
-			p.pos += 9 // Skip over "!(assert"
-			var body string
-			if p.Peek() == '(' {
-				body = p.ParseSExpr() // Parse the s-expression if body starts with (
-			} else {
-				bodyStart := p.pos
-				for p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				body = p.input[bodyStart:p.pos]
+// expandMacros expands the macros in the sequence of nodes.
+//
+// The def, defrec, defun, assert and assert-eq macros are nested. They
+// wrap the nodes that follow them in the enclosing list so that, for
+// example, (a !(def x 1) b c) becomes (a (let ((x 1)) b c)).
+func (e *expander) expandMacros(nodes []*node) ([]*node, error) {
+	ret := make([]*node, 0, len(nodes))
+	for i, n := range nodes {
+		if e.expands(n) && Macro(n.value).IsNested() {
+			rest, err := e.expandMacros(nodes[i+1:])
+			if err != nil {
+				return nil, err
 			}
-			result += fmt.Sprintf("(if (eq %s nil) nil", body)
-			p.ReadUntil(')')
-			p.Consume() // Consume the closing parenthesis after the assert body
-		} else {
-			result += string(p.Consume())
+			expanded, err := e.expandNested(n, rest)
+			if err != nil {
+				return nil, err
+			}
+			return append(ret, expanded), nil
 		}
+		expanded, err := e.expandNode(n)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, expanded)
 	}
-	return result
+	return ret, nil
 }
 
-func macroExpandAssertEq(lurkProgram string) string {
-	p := NewParser(lurkProgram)
-	result := ""
+// expandNode expands the macros in the node. Nested macros are only
+// valid in a list and are handled by expandMacros.
+func (e *expander) expandNode(n *node) (*node, error) {
+	switch n.typ {
+	case listNode, quoteNode:
+		children, err := e.expandMacros(n.children)
+		if err != nil {
+			return nil, err
+		}
+		cpy := *n
+		cpy.children = children
+		return &cpy, nil
+	case macroNode:
+		if !e.expands(n) {
+			children, err := e.expandMacros(n.children)
+			if err != nil {
+				return nil, err
+			}
+			cpy := *n
+			cpy.children = children
+			return &cpy, nil
+		}
+		switch Macro(n.value) {
+		case List:
+			return e.expandList(n)
+		case Append:
+			return e.expandAppend(n)
+		case Splice:
+			return nil, n.errorf(ErrInvalidMacro, "splice is only valid in a list")
+		case Param:
+			return e.expandParam(n)
+		case CheckSig:
+			return e.expandCheckSig(n)
+		}
+		return nil, n.errorf(ErrInvalidMacro, "unexpected macro")
+	}
+	return n, nil
+}
 
-	for p.Peek() != 0 {
-		if strings.HasPrefix(p.input[p.pos:], "!(assert-eq") {
-			p.pos += 12 // Skip over "!(assert-eq"
+// expandNested expands a nested macro wrapping the rest of the nodes
+// in the enclosing list.
+func (e *expander) expandNested(n *node, rest []*node) (*node, error) {
+	args := n.args()
+	switch Macro(n.value) {
+	case Def, Defrec:
+		if len(args) != 2 || args[0].typ != atomNode {
+			return nil, n.errorf(ErrInvalidMacro, "expected a name and a value")
+		}
+		value, err := e.expandNode(args[1])
+		if err != nil {
+			return nil, err
+		}
+		let := "let"
+		if n.isMacro(Defrec) {
+			let = "letrec"
+		}
+		return newList(append([]*node{newAtom(let), newList(newList(args[0], value))}, rest...)...).withLocation(n), nil
 
-			var val1 string
-			if p.Peek() == '(' {
-				val1 = p.ParseSExpr() // Parse the s-expression if body starts with (
-			} else {
-				bodyStart := p.pos
-				for p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				val1 = p.input[bodyStart:p.pos]
-			}
+	case Defun:
+		if len(args) != 3 || args[0].typ != atomNode || args[1].typ != listNode {
+			return nil, n.errorf(ErrInvalidMacro, "expected a name, parameters and a body")
+		}
+		// A body starting with a list or macro, for example
+		// ((foo) (bar)) or (!(def x 1) x), is a sequence of
+		// nodes rather than a single expression.
+		var (
+			body []*node
+			err  error
+		)
+		if bodyArgs := args[2].args(); args[2].typ == listNode && len(bodyArgs) > 0 &&
+			(bodyArgs[0].typ == listNode || bodyArgs[0].typ == macroNode) {
+			body, err = e.expandMacros(args[2].children)
+		} else {
+			var expanded *node
+			expanded, err = e.expandNode(args[2])
+			body = []*node{expanded}
+		}
+		if err != nil {
+			return nil, err
+		}
+		lambda := newList(append([]*node{newAtom("lambda"), args[1]}, body...)...)
+		return newList(append([]*node{newAtom("letrec"), newList(newList(args[0], lambda))}, rest...)...).withLocation(n), nil
 
-			// Skip over potential whitespace
-			for p.Peek() == ' ' {
-				p.Consume()
-			}
+	case Assert:
+		failed, err := assertionFailure(n, 1)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := e.expandNode(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return newList(append([]*node{newAtom("if"), newList(newAtom("eq"), expr, newAtom("nil")), failed}, rest...)...).withLocation(n), nil
 
-			var val2 string
-			if p.Peek() == '(' {
-				val2 = p.ParseSExpr() // Parse the s-expression if body starts with (
-			} else {
-				bodyStart := p.pos
-				for p.Peek() != ')' && p.Peek() != 0 {
-					p.Consume()
-				}
-				val2 = p.input[bodyStart:p.pos]
-			}
+	case AssertEq:
+		failed, err := assertionFailure(n, 2)
+		if err != nil {
+			return nil, err
+		}
+		a, err := e.expandNode(args[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := e.expandNode(args[1])
+		if err != nil {
+			return nil, err
+		}
+		eq := newList(newAtom("eq"), a, b)
+		return newList(append([]*node{newAtom("if"), newList(newAtom("eq"), eq, newAtom("nil")), failed}, rest...)...).withLocation(n), nil
+	}
+	return nil, n.errorf(ErrInvalidMacro, "unexpected macro")
+}
 
-			result += fmt.Sprintf("(if (eq (eq %s %s) nil) nil", val1, val2)
-			p.ReadUntil(')')
-			p.Consume() // Consume the closing parenthesis after the assert-eq body
-		} else {
-			result += string(p.Consume())
-		}
-	}
-	return result
-}
-
-func macroExpandDef(lurkProgram string) string {
-	for strings.Contains(lurkProgram, "!(def ") {
-		p := NewParser(lurkProgram)
-		result := ""
-
-		for p.Peek() != 0 {
-			if strings.HasPrefix(p.input[p.pos:], "!(def") &&
-				!strings.HasPrefix(p.input[p.pos:], "!(defrec") &&
-				!strings.HasPrefix(p.input[p.pos:], "!(defun") {
-				p.pos += 6 // Skip over "!(def"
-				variableName := strings.TrimSpace(p.ReadUntil(' '))
-				p.Consume()
-				var body string
-				if p.Peek() == '(' {
-					body = p.ParseSExpr() // Parse the s-expression if body starts with (
-				} else {
-					bodyStart := p.pos
-					for p.Peek() != ')' && p.Peek() != 0 {
-						p.Consume()
-					}
-					body = p.input[bodyStart:p.pos]
-				}
-				result += fmt.Sprintf("(let ((%s %s))", variableName, body)
-				p.ReadUntil(')')
-				p.Consume() // Consume the closing parenthesis after the def body
-			} else {
-				result += string(p.Consume())
-			}
+// assertionFailure returns the value of the script when the assert
+// macro, which takes the number of expressions, fails. It is always nil
+// so that a failed assert never satisfies the script. If the assert has
+// a message following the expressions, such as
+// !(assert (> x 0) "x is positive"), the message is attached to the nil
+// and recorded in the source map so the failed assertion can be traced
+// from the failing branch.
+func assertionFailure(n *node, expressions int) (*node, error) {
+	args := n.args()
+	failed := newAtom("nil")
+	switch {
+	case len(args) == expressions:
+		return failed, nil
+	case len(args) == expressions+1:
+		msg := args[expressions]
+		if msg.typ != atomNode || !strings.HasPrefix(msg.value, "\"") {
+			return nil, n.errorf(ErrInvalidMacro, "expected a string message")
 		}
-		lurkProgram = result
-	}
-	return lurkProgram
-}
-
-func macroExpandDefrec(lurkProgram string) string {
-	for strings.Contains(lurkProgram, "!(defrec") {
-		p := NewParser(lurkProgram)
-		result := ""
-
-		for p.Peek() != 0 {
-			if strings.HasPrefix(p.input[p.pos:], "!(defrec") {
-				p.pos += 9 // Skip over "!(defrec"
-				variableName := strings.TrimSpace(p.ReadUntil(' '))
-				p.Consume()
-				var body string
-				if p.Peek() == '(' {
-					body = p.ParseSExpr() // Parse the s-expression if body starts with (
-				} else {
-					bodyStart := p.pos
-					for p.Peek() != ')' && p.Peek() != 0 {
-						p.Consume()
-					}
-					body = p.input[bodyStart:p.pos]
-				}
-				result += fmt.Sprintf("(letrec ((%s %s))", variableName, body)
-				p.ReadUntil(')')
-				p.Consume() // Consume the closing parenthesis after the defrec body
+		failed.message = strings.Trim(msg.value, "\"")
+		return failed.withLocation(msg), nil
+	case expressions == 1:
+		return nil, n.errorf(ErrInvalidMacro, "expected one expression and an optional message")
+	}
+	return nil, n.errorf(ErrInvalidMacro, "expected two expressions and an optional message")
+}
+
+// expandList expands !(list a b c) into (cons a (cons b (cons c nil))).
+// An element !(splice xs) inserts the elements of the list xs, so
+// !(list a !(splice xs) b) is (cons a (append xs (cons b nil))).
+func (e *expander) expandList(n *node) (*node, error) {
+	ret := newAtom("nil")
+	appended := false
+	args := n.args()
+	for i := len(args) - 1; i >= 0; i-- {
+		if args[i].isMacro(Splice) {
+			spliceArgs := args[i].args()
+			if len(spliceArgs) != 1 {
+				return nil, args[i].errorf(ErrInvalidMacro, "expected one list")
+			}
+			elems, err := e.expandNode(spliceArgs[0])
+			if err != nil {
+				return nil, err
+			}
+			if ret.typ == atomNode && ret.value == "nil" {
+				ret = elems
 			} else {
-				result += string(p.Consume())
+				ret = newList(newAtom(e.gensym("append")), elems, ret)
+				appended = true
 			}
+			continue
 		}
-		lurkProgram = result
-	}
-	return lurkProgram
-}
-
-func macroExpandDefun(lurkProgram string) string {
-	for strings.Contains(lurkProgram, "!(defun") {
-		p := NewParser(lurkProgram)
-		result := ""
-		for p.Peek() != 0 {
-			if strings.HasPrefix(p.input[p.pos:], "!(defun") {
-				p.pos += 8 // Skip over "!(defun"
-				name := strings.TrimSpace(p.ReadUntil('('))
-				params := p.ParseSExpr()
-
-				p.Consume()
-				body := p.ParseSExpr()
-				if len(body) >= 2 {
-					b := removeComments(body)
-					b = strings.ReplaceAll(b, " ", "")
-					b = strings.ReplaceAll(b, "\n", "")
-					b = strings.ReplaceAll(b, "\t", "")
-					b = removeComments(b)
-					if b[1] == '!' || b[1] == '(' {
-						body = strings.TrimPrefix(body, "(")
-						body = strings.TrimSuffix(body, ")")
-					}
-				}
+		elem, err := e.expandNode(args[i])
+		if err != nil {
+			return nil, err
+		}
+		ret = newList(newAtom("cons"), elem, ret)
+	}
+	if appended {
+		return e.withAppend(ret, n)
+	}
+	return ret.withLocation(n), nil
+}
 
-				result += fmt.Sprintf("(letrec ((%s (lambda %s %s)))", name, params, body)
-				p.ReadUntil(')')
-				p.Consume() // Consume the closing parenthesis after the defun body
-			} else {
-				result += string(p.Consume())
-			}
+// expandAppend expands !(append a b c) into the list of the elements of
+// the lists a, b and c. The last list is shared rather than copied.
+func (e *expander) expandAppend(n *node) (*node, error) {
+	args := n.args()
+	if len(args) == 0 {
+		return newAtom("nil").withLocation(n), nil
+	}
+	ret, err := e.expandNode(args[len(args)-1])
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 1 {
+		return ret, nil
+	}
+	for i := len(args) - 2; i >= 0; i-- {
+		list, err := e.expandNode(args[i])
+		if err != nil {
+			return nil, err
 		}
-		lurkProgram = result
+		ret = newList(newAtom(e.gensym("append")), list, ret)
 	}
-	return lurkProgram
+	return e.withAppend(ret, n)
 }
 
-// preProcess takes a lurk program string and expands all the macros
-func preProcess(lurkProgram string) (string, error) {
-	scanner := bufio.NewScanner(strings.NewReader(lurkProgram))
+// appendExpr concatenates two lists. The placeholders are the names of
+// the function and its parameters.
+const appendExpr = "(letrec ((%[1]s (lambda (%[2]s %[3]s) (if (eq %[2]s nil) %[3]s (cons (car %[2]s) (%[1]s (cdr %[2]s) %[3]s)))))))"
 
-	var (
-		openCount      = 0
-		parenthesesMap = make(map[int]int)
-		modifiedLines  []string
-	)
+// withAppend binds the append function generated for the macro around
+// the expression using it.
+func (e *expander) withAppend(expr *node, n *node) (*node, error) {
+	letrec, err := parseGenerated(fmt.Sprintf(appendExpr, e.gensym("append"), e.gensym("xs"), e.gensym("ys")), n)
+	if err != nil {
+		return nil, err
+	}
+	letrec.children = append(letrec.children, expr.withLocation(n))
+	return letrec, nil
+}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		var modifiedLine strings.Builder
-		for i, char := range line {
-			modifiedLine.WriteRune(char)
-			if char == '(' {
-				openCount++
-			} else if char == ')' {
-				openCount--
-				for c, p := range parenthesesMap {
-					if c == openCount {
-						for i := 0; i < p; i++ {
-							modifiedLine.WriteRune(')')
-						}
-						delete(parenthesesMap, c)
-					}
-				}
-			} else if char == '!' {
-				if macro, ok := IsMacro(line[i:]); ok && macro.IsNested() {
-					parenthesesMap[openCount-1]++
-				}
-			}
+// expandParam expands !(param name [index] [field]) into the expression
+// accessing the parameter in the public or private params. The index of
+// the list params may be an integer or a symbol, such as a loop variable,
+// in which case the item is looked up when the script is evaluated.
+func (e *expander) expandParam(n *node) (*node, error) {
+	var fields []string
+	for _, arg := range n.args() {
+		if arg.typ != atomNode {
+			return nil, n.errorf(ErrInvalidMacro, "invalid param")
 		}
-		modifiedLines = append(modifiedLines, modifiedLine.String())
+		fields = append(fields, arg.value)
 	}
-	var modifiedLine strings.Builder
-	for c, p := range parenthesesMap {
-		if c == -1 {
-			for i := 0; i < p; i++ {
-				modifiedLine.WriteRune(')')
-			}
-			delete(parenthesesMap, c)
-		}
+	if len(fields) == 0 {
+		return nil, n.errorf(ErrInvalidMacro, "missing param name")
 	}
-	modifiedLines = append(modifiedLines, modifiedLine.String())
-	lurkProgram = strings.Join(modifiedLines, "\n")
 
-	if err := scanner.Err(); err != nil {
-		return "", err
+	layout := e.paramLayout()
+	param, ok := layout.Params[fields[0]]
+	if !ok {
+		return nil, n.errorf(ErrUnknownParam, "no param named %s, expected one of %s", fields[0], strings.Join(layout.names(), ", "))
+	}
+	expr := param.expr()
+	if !param.List {
+		if len(fields) != 1 {
+			return nil, n.errorf(ErrInvalidMacro, "param %s takes no arguments", fields[0])
+		}
+		return parseGenerated(expr, n)
 	}
 
-	for _, macro := range []Macro{Def, Defrec, Defun, Assert, AssertEq, List, Param} {
-		lurkProgram = macro.Expand(lurkProgram)
+	if len(fields) < 2 {
+		return nil, n.errorf(ErrInvalidMacro, "param %s requires an index", fields[0])
+	}
+	if len(fields) > 3 || (len(param.Fields) == 0 && len(fields) > 2) {
+		return nil, n.errorf(ErrInvalidMacro, "invalid arguments for param %s", fields[0])
+	}
+	if idx, err := strconv.Atoi(fields[1]); err == nil && idx >= 0 {
+		expr = nthItemExpr(idx, expr)
+	} else if isIndexSymbol(fields[1]) {
+		expr = fmt.Sprintf(nthAccessorExpr, e.gensym("nth"), e.gensym("idx"), e.gensym("plist"), fields[1], expr)
+	} else {
+		return nil, n.errorf(ErrInvalidMacro, "invalid index %s for param %s, expected a non-negative integer or symbol", fields[1], fields[0])
+	}
+	if len(fields) == 3 {
+		fieldIdx, ok := param.Fields[fields[2]]
+		if !ok {
+			return nil, n.errorf(ErrUnknownParam, "param %s has no field %s, expected one of %s", fields[0], fields[2], strings.Join(param.fieldNames(), ", "))
+		}
+		expr = nthItemExpr(fieldIdx, expr)
 	}
 
-	return lurkProgram, nil
+	return parseGenerated(expr, n)
 }
 
-func removeComments(expression string) string {
-	var result strings.Builder
-	scanner := bufio.NewScanner(strings.NewReader(expression))
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if !strings.HasPrefix(strings.TrimSpace(line), ";;") {
-			result.WriteString(line + "\n")
+// checkSigExpr verifies a signature, a list of (rx ry s), against a
+// public key, a list of (x y), and the sighash. The placeholders are the
+// names the signature, public key and sighash are bound to.
+const checkSigExpr = `(eval (cons 'coproc_checksig (cons (car %[1]s) (cons (car (cdr %[1]s)) (cons (car (cdr (cdr %[1]s)))
+	(cons (car %[2]s) (cons (car (cdr %[2]s)) (cons %[3]s nil))))))))`
+
+// expandCheckSig expands !(check-sig [sig] [pubkey] [sighash]) into the
+// verification of the signature. The signature defaults to the
+// unlocking-params, the public key to the locking-params and the sighash
+// to the transaction's sighash, as in a basic transfer script.
+func (e *expander) expandCheckSig(n *node) (*node, error) {
+	args := n.args()
+	if len(args) > 3 {
+		return nil, n.errorf(ErrInvalidMacro, "expected at most a signature, public key and sighash")
+	}
+	sighash, ok := e.paramLayout().Params["sighash"]
+	if !ok && len(args) < 3 {
+		return nil, n.errorf(ErrInvalidMacro, "the param layout has no sighash, expected a sighash argument")
+	}
+	names := []string{e.gensym("sig"), e.gensym("pubkey"), e.gensym("sighash")}
+	var bindings []*node
+	for i, def := range []string{"unlocking-params", "locking-params", sighash.expr()} {
+		var (
+			arg *node
+			err error
+		)
+		if i < len(args) {
+			arg, err = e.expandNode(args[i])
+		} else {
+			arg, err = parseGenerated(def, n)
+		}
+		if err != nil {
+			return nil, err
 		}
+		bindings = append(bindings, newList(newAtom(names[i]), arg))
+	}
+	body, err := parseGenerated(fmt.Sprintf(checkSigExpr, names[0], names[1], names[2]), n)
+	if err != nil {
+		return nil, err
 	}
+	return newList(newAtom("let"), newList(bindings...), body).withLocation(n), nil
+}
 
-	return result.String()
+// parseGenerated parses an expression generated by a macro. The
+// expression is located at the macro rather than the generated source.
+func parseGenerated(expr string, n *node) (*node, error) {
+	nodes, err := parse(&source{text: expr})
+	if err != nil {
+		return nil, err
+	}
+	var relocate func(m *node)
+	relocate = func(m *node) {
+		m.src, m.pos, m.module = n.src, n.pos, n.module
+		for _, child := range m.children {
+			relocate(child)
+		}
+	}
+	relocate(nodes[0])
+	return nodes[0], nil
 }
@@ -5,6 +5,10 @@
 package macros_test
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
 	"github.com/project-illium/ilxd/zk/lurk/macros"
@@ -14,6 +18,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestMacroPreprocessor_Preprocess(t *testing.T) {
@@ -24,6 +29,34 @@ func TestMacroPreprocessor_Preprocess(t *testing.T) {
 	fmt.Println(lurkProgram)
 }
 
+func TestCheckSigMacro(t *testing.T) {
+	verify := "(eval (cons 'coproc_checksig (cons (car sig) (cons (car (cdr sig)) (cons (car (cdr (cdr sig))) (cons (car pubkey) (cons (car (cdr pubkey)) (cons sighash nil))))))))"
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"!(check-sig)", "(let ((sig unlocking-params) (pubkey locking-params) (sighash (car public-params))) " + verify + ")"},
+		{"!(check-sig (car sigs))", "(let ((sig (car sigs)) (pubkey locking-params) (sighash (car public-params))) " + verify + ")"},
+		{"!(check-sig keys !(param priv-in 0 locking-params))", "(let ((sig keys) (pubkey (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (car (car private-params))))))))))) (sighash (car public-params))) " + verify + ")"},
+		{"!(check-sig a b 5)", "(let ((sig a) (pubkey b) (sighash 5)) " + verify + ")"},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Truef(t, macros.IsValidLurk(lurkProgram), "Test %d should be valid", i)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	_, err = mp.Preprocess("!(check-sig a b c d)")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrInvalidMacro, perr.Type)
+	assert.Equal(t, "check-sig", perr.Macro)
+}
+
 func TestPreProcessValidParentheses(t *testing.T) {
 	type testVector struct {
 		input    string
@@ -45,8 +78,8 @@ func TestPreProcessValidParentheses(t *testing.T) {
 		{"!(assert t)", "(if (eq t nil) nil)"},
 		{"!(assert (+ x 5)) nil", "(if (eq (+ x 5) nil) nil nil)"},
 		{"!(assert t) nil", "(if (eq t nil) nil nil)"},
-		{"!(assert-eq x 3)", "(if (eq (eq x 3 ) nil) nil)"},
-		{"!(assert-eq x 3) t", "(if (eq (eq x 3 ) nil) nil t)"},
+		{"!(assert-eq x 3)", "(if (eq (eq x 3) nil) nil)"},
+		{"!(assert-eq x 3) t", "(if (eq (eq x 3) nil) nil t)"},
 		{"!(defun f (x) (!(assert t) 3))", "(letrec ((f (lambda (x) (if (eq t nil) nil 3)))))"},
 		{"(lambda (script-params unlocking-params input-index private-params public-params) !(assert-eq (+ x 5) 4) !(def z 5) !(assert t) t)", "(lambda (script-params unlocking-params input-index private-params public-params) (if (eq (eq (+ x 5) 4) nil) nil (let ((z 5)) (if (eq t nil) nil t))))"},
 		{"!(list 1 2 3 4)", "(cons 1 (cons 2 (cons 3 (cons 4 nil))))"},
@@ -79,6 +112,9 @@ func TestPreProcessValidParentheses(t *testing.T) {
 		{"!(param priv-out 3 state)", "(car (cdr (cdr (cdr (cdr (car (cdr (cdr (cdr (car (cdr private-params)))))))))))"},
 		{"!(param pub-out 4 commitment)", "(car (car (cdr (cdr (cdr (cdr (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params))))))))))))))"},
 		{"!(param pub-out 4 ciphertext)", "(car (cdr (car (cdr (cdr (cdr (cdr (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))))))))))"},
+		{"!(param nullifiers i)", "(letrec ((nth (lambda (idx plist) (if (= idx 0) (car plist) (nth (- idx 1) (cdr plist)))))) (nth i (car (cdr public-params))))"},
+		{"!(param priv-in idx amount)", "(car (letrec ((nth (lambda (idx_1 plist) (if (= idx_1 0) (car plist) (nth (- idx_1 1) (cdr plist)))))) (nth idx (car private-params))))"},
+		{"!(param pub-out i commitment)", "(car (letrec ((nth (lambda (idx plist) (if (= idx 0) (car plist) (nth (- idx 1) (cdr plist)))))) (nth i (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))))))"},
 	}
 
 	mp, err := macros.NewMacroPreprocessor()
@@ -125,7 +161,7 @@ func TestMacroImports(t *testing.T) {
 				(plus-two 10)
 			))`,
 			modules:  []module{{path: filepath.Join(tempDir, "mod.lurk"), file: mod1}},
-			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2))))(letrec ((plus-three (lambda (x) (+ x 3))))(let ((some-const 1234))(plus-two 10))))))))",
+			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2)))) (letrec ((plus-three (lambda (x) (+ x 3)))) (let ((some-const 1234)) (plus-two 10))))))))",
 		},
 		{
 			input: `!(defun my-func (y) (
@@ -133,7 +169,7 @@ func TestMacroImports(t *testing.T) {
 				(plus-two 10)
 			))`,
 			modules:  []module{{path: filepath.Join(tempDir, "mod.lurk"), file: mod1}},
-			expected: "(letrec ((my-func (lambda (y) (if (eq (<= (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))))) 30) nil) nil(plus-two 10))))))",
+			expected: "(letrec ((my-func (lambda (y) (if (eq (<= (car (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))))) 30) nil) nil (plus-two 10))))))",
 		},
 		{
 			input: `!(defun my-func (y) (
@@ -141,7 +177,7 @@ func TestMacroImports(t *testing.T) {
 				(plus-two 10)
 			))`,
 			modules:  []module{{path: filepath.Join(tempDir, "std", "mod.lurk"), file: mod1}},
-			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2))))(letrec ((plus-three (lambda (x) (+ x 3))))(let ((some-const 1234))(plus-two 10))))))))",
+			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2)))) (letrec ((plus-three (lambda (x) (+ x 3)))) (let ((some-const 1234)) (plus-two 10))))))))",
 		},
 		{
 			input: `!(defun my-func (y) (
@@ -149,7 +185,7 @@ func TestMacroImports(t *testing.T) {
 				(plus-two 10)
 			))`,
 			modules:  []module{{path: filepath.Join(tempDir, "mod.lurk"), file: mod1}},
-			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2))))(plus-two 10))))))",
+			expected: "(letrec ((my-func (lambda (y) (letrec ((plus-two (lambda (x) (+ x 2)))) (plus-two 10))))))",
 		},
 		{
 			input: `!(defun my-func (y) (
@@ -157,7 +193,7 @@ func TestMacroImports(t *testing.T) {
 				(+ some-const 21)
 			))`,
 			modules:  []module{{path: filepath.Join(tempDir, "mod.lurk"), file: mod1}},
-			expected: "(letrec ((my-func (lambda (y) (let ((some-const 1234))(+ some-const 21))))))",
+			expected: "(letrec ((my-func (lambda (y) (let ((some-const 1234)) (+ some-const 21))))))",
 		},
 	}
 
@@ -222,6 +258,154 @@ func TestCircularImports(t *testing.T) {
 	assert.True(t, errors.Is(err, macros.ErrCircularImports))
 }
 
+func TestPreprocessErrorLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		program  string
+		errType  macros.ErrorType
+		macro    string
+		line     int
+		column   int
+		expected string
+	}{
+		{
+			name:     "unclosed paren",
+			program:  "(let ((x 3))\n  (+ x\n    3)",
+			errType:  macros.ErrUnclosedParen,
+			line:     1,
+			column:   1,
+			expected: "<program>:1:1: unclosed parenthesis",
+		},
+		{
+			name:     "unclosed paren in macro",
+			program:  "!(defun plus-three (x)\n  (+ x 3)",
+			errType:  macros.ErrUnclosedParen,
+			macro:    "defun",
+			line:     1,
+			column:   1,
+			expected: "<program>:1:1: unclosed parenthesis in !(defun)",
+		},
+		{
+			name:     "unexpected paren",
+			program:  "(+ 1 2))",
+			errType:  macros.ErrUnexpectedParen,
+			line:     1,
+			column:   8,
+			expected: "<program>:1:8: unexpected closing parenthesis",
+		},
+		{
+			name:     "mismatched paren",
+			program:  "!(def x\n  (car y])",
+			errType:  macros.ErrMismatchedParen,
+			macro:    "def",
+			line:     2,
+			column:   9,
+			expected: "<program>:2:9: mismatch parenthesis in !(def)",
+		},
+		{
+			name:     "unknown macro",
+			program:  "(+ 1\n   !(foo x))",
+			errType:  macros.ErrUnknownMacro,
+			macro:    "foo",
+			line:     2,
+			column:   4,
+			expected: "<program>:2:4: unknown macro in !(foo)",
+		},
+		{
+			name:     "unknown param",
+			program:  "(+ 1\n   !(param fees))",
+			errType:  macros.ErrUnknownParam,
+			macro:    "param",
+			line:     2,
+			column:   4,
+			expected: "<program>:2:4: unknown param in !(param): no param named fees, expected one of coinbase, fee, locktime, locktime-precision, mint-amount, mint-id, nullifiers, priv-in, priv-out, pub-out, sighash, txo-root",
+		},
+		{
+			name:     "unknown param field",
+			program:  "!(param priv-out 0 amt)",
+			errType:  macros.ErrUnknownParam,
+			macro:    "param",
+			line:     1,
+			column:   1,
+			expected: "<program>:1:1: unknown param in !(param): param priv-out has no field amt, expected one of amount, asset-id, salt, script-hash, state",
+		},
+		{
+			name:     "invalid param index",
+			program:  "!(param nullifiers -1)",
+			errType:  macros.ErrInvalidMacro,
+			macro:    "param",
+			line:     1,
+			column:   1,
+			expected: "<program>:1:1: invalid macro in !(param): invalid index -1 for param nullifiers, expected a non-negative integer or symbol",
+		},
+		{
+			name:     "missing param index",
+			program:  "!(param priv-in)",
+			errType:  macros.ErrInvalidMacro,
+			macro:    "param",
+			line:     1,
+			column:   1,
+			expected: "<program>:1:1: invalid macro in !(param): param priv-in requires an index",
+		},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+
+	for _, test := range tests {
+		_, err := mp.Preprocess(test.program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), test.name)
+		assert.Equal(t, test.errType, perr.Type, test.name)
+		assert.Equal(t, test.macro, perr.Macro, test.name)
+		assert.Equal(t, test.line, perr.Line, test.name)
+		assert.Equal(t, test.column, perr.Column, test.name)
+		assert.Equal(t, test.expected, err.Error(), test.name)
+	}
+}
+
+func TestPreprocessErrorDependencyFile(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_error_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mod := "!(module math (\n\t!(defun plus-two (x) (+ x 2)\n))\n"
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	_, err = mp.Preprocess("!(import math)\n(plus-two 1)")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrUnclosedParen, perr.Type)
+	assert.Equal(t, "math.lurk", filepath.Base(perr.File))
+	assert.Equal(t, 1, perr.Line)
+	assert.Equal(t, 1, perr.Column)
+}
+
+func TestPreprocessErrorMissingImport(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_import_error_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	_, err = mp.Preprocess("(let ((x 1))\n  !(import math))")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrImport, perr.Type)
+	assert.Equal(t, "import", perr.Macro)
+	assert.Equal(t, 2, perr.Line)
+	assert.Equal(t, 3, perr.Column)
+}
+
 func TestWithStandardLib(t *testing.T) {
 	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib(), macros.RemoveComments())
 	assert.NoError(t, err)
@@ -236,6 +420,1269 @@ func TestWithStandardLib(t *testing.T) {
 	lurkProgram = strings.ReplaceAll(lurkProgram, "\t", "")
 	lurkProgram = strings.Join(strings.Fields(lurkProgram), " ")
 	assert.True(t, macros.IsValidLurk(lurkProgram))
-	expected := `(letrec ((my-func (lambda (y) (letrec ((checksig (lambda (sig pubkey sighash) (eval (cons 'coproc_checksig (cons (car sig) (cons (car (cdr sig)) (cons (car (cdr (cdr sig))) (cons (car pubkey) (cons (car (cdr pubkey)) (cons sighash nil)))))))) )))(check-sig 10))))))`
+	expected := `(letrec ((my-func (lambda (y) (letrec ((checksig (lambda (sig pubkey sighash) (eval (cons 'coproc_checksig (cons (car sig) (cons (car (cdr sig)) (cons (car (cdr (cdr sig))) (cons (car pubkey) (cons (car (cdr pubkey)) (cons sighash nil))))))))))) (check-sig 10))))))`
 	assert.Equal(t, expected, lurkProgram)
 }
+
+func TestWithStandardLibState(t *testing.T) {
+	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib(), macros.RemoveComments())
+	assert.NoError(t, err)
+
+	lurkProgram := `!(defun my-func (state) (
+				!(import std/state/get)
+				(get 1 state)
+			))`
+	lurkProgram, err = mp.Preprocess(lurkProgram)
+	assert.NoError(t, err)
+	lurkProgram = strings.Join(strings.Fields(lurkProgram), " ")
+	assert.True(t, macros.IsValidLurk(lurkProgram))
+	assert.Contains(t, lurkProgram, "(get (lambda (idx state)")
+	assert.NotContains(t, lurkProgram, "(set (lambda")
+}
+
+func TestConditionalMacros(t *testing.T) {
+	tests := []struct {
+		input    string
+		defines  []string
+		expected string
+	}{
+		{"!(ifdef DEBUG !(assert t)) t", nil, "t"},
+		{"!(ifdef DEBUG !(assert t)) t", []string{"DEBUG"}, "(if (eq t nil) nil t)"},
+		{"!(ifndef DEBUG !(assert t)) t", nil, "(if (eq t nil) nil t)"},
+		{"!(ifndef DEBUG !(assert t)) t", []string{"DEBUG"}, "t"},
+		{"(+ 1 !(ifdef TESTNET 2) !(ifndef TESTNET 3))", []string{"TESTNET"}, "(+ 1 2)"},
+		{"(+ 1 !(ifdef TESTNET 2) !(ifndef TESTNET 3))", nil, "(+ 1 3)"},
+		{"!(ifdef A !(ifdef B (+ 1 2)) (+ 3 4))", []string{"A"}, "(+ 3 4)"},
+		{"!(ifdef A !(ifdef B (+ 1 2)) (+ 3 4))", []string{"A", "B"}, "(+ 1 2) (+ 3 4)"},
+		{"!(ifdef A\n!(def x 1)\n) !(ifndef A\n!(def x 2)\n) x", []string{"A"}, "(let ((x 1)) x)"},
+		{"!(ifdef A\n!(def x 1)\n) !(ifndef A\n!(def x 2)\n) x", nil, "(let ((x 2)) x)"},
+	}
+
+	for i, test := range tests {
+		mp, err := macros.NewMacroPreprocessor(macros.WithDefines(test.defines...))
+		assert.NoError(t, err)
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	_, err = mp.Preprocess("(+ 1\n  !(ifdef))")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrInvalidMacro, perr.Type)
+	assert.Equal(t, 2, perr.Line)
+	assert.Equal(t, 3, perr.Column)
+
+	_, err = macros.NewMacroPreprocessor(macros.WithDefines("has space"))
+	assert.Error(t, err)
+}
+
+func TestConditionalMacroImports(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "conditional_import_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mod := `!(ifdef TESTNET
+		!(module params (
+			!(def max-locktime 10)
+		))
+	)
+	!(ifndef TESTNET
+		!(module params (
+			!(def max-locktime 1000)
+			!(import missing)
+		))
+	)
+	`
+	err = os.WriteFile(filepath.Join(tempDir, "params.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir), macros.WithDefines("TESTNET"))
+	assert.NoError(t, err)
+	lurkProgram, err := mp.Preprocess("!(import params)\nmax-locktime")
+	assert.NoError(t, err)
+	assert.Equal(t, "(let ((max-locktime 10)) max-locktime)", normalizeWhitespace(lurkProgram))
+
+	mp, err = macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+	_, err = mp.Preprocess("!(import params)\nmax-locktime")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrImport, perr.Type)
+	assert.Equal(t, "params.lurk", filepath.Base(perr.File))
+	assert.Equal(t, 9, perr.Line)
+}
+
+func normalizeWhitespace(s string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(s), " "), " )", ")")
+}
+
+func TestImportAlias(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "import_alias_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mod := `!(module math (
+			!(def two 2)
+			!(defun add (x) (+ x two))
+		))
+
+		!(module strings (
+			!(defun add (x) (cons x 'add))
+		))
+		`
+	err = os.WriteFile(filepath.Join(tempDir, "mod.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	lurkProgram, err := mp.Preprocess(`!(import math :as m)
+		!(import strings :as s)
+		(s.add (m.add 1))`)
+	assert.NoError(t, err)
+	assert.True(t, macros.IsValidLurk(lurkProgram))
+	expected := "(let ((m.two 2)) (letrec ((m.add (lambda (x) (+ x m.two)))) (letrec ((s.add (lambda (x) (cons x 'add)))) (s.add (m.add 1)))))"
+	assert.Equal(t, expected, normalizeWhitespace(lurkProgram))
+
+	lurkProgram, err = mp.Preprocess(`!(import math/add :as m)
+		(m.add 1)`)
+	assert.NoError(t, err)
+	assert.Equal(t, "(letrec ((m.add (lambda (x) (+ x two)))) (m.add 1))", normalizeWhitespace(lurkProgram))
+
+	for _, program := range []string{"!(import math :as)", "!(import math :alias m)", "!(import math :as m.n)"} {
+		_, err = mp.Preprocess(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrImport, perr.Type, program)
+	}
+}
+
+func TestSelectiveImports(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "selective_import_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(filepath.Join(tempDir, "std"), 0755)
+	assert.NoError(t, err)
+
+	mod := `!(module math (
+			!(defun plus-two (x) (+ x 2))
+			!(defun plus-three (x) (+ x 3))
+			!(def some-const 1234)
+		))
+		`
+	err = os.WriteFile(filepath.Join(tempDir, "std", "mod.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	lurkProgram, err := mp.Preprocess(`!(import std/math (plus-two some-const))
+		(plus-two some-const)`)
+	assert.NoError(t, err)
+	assert.True(t, macros.IsValidLurk(lurkProgram))
+	assert.Equal(t, "(letrec ((plus-two (lambda (x) (+ x 2)))) (let ((some-const 1234)) (plus-two some-const)))", normalizeWhitespace(lurkProgram))
+
+	lurkProgram, err = mp.Preprocess(`!(import std/math (plus-three) :as m)
+		(m.plus-three 1)`)
+	assert.NoError(t, err)
+	assert.Equal(t, "(letrec ((m.plus-three (lambda (x) (+ x 3)))) (m.plus-three 1))", normalizeWhitespace(lurkProgram))
+
+	for _, program := range []string{"!(import std/math (plus-two minus-two))", "!(import std/math ())", "!(import std/time (plus-two))"} {
+		_, err = mp.Preprocess(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrImport, perr.Type, program)
+	}
+}
+
+func TestBundle(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "bundle_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(filepath.Join(tempDir, "deps"), 0755)
+	assert.NoError(t, err)
+
+	dep := `!(module math (
+			!(defun plus-two (x) (+ x 2))
+		))
+		`
+	err = os.WriteFile(filepath.Join(tempDir, "deps", "math.lurk"), []byte(dep), 0644)
+	assert.NoError(t, err)
+
+	entry := `!(module counter (
+			!(import math)
+			!(defun increment (x) (plus-two x))
+		))
+		`
+	entryPath := filepath.Join(tempDir, "counter.lurk")
+	err = os.WriteFile(entryPath, []byte(entry), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(filepath.Join(tempDir, "deps")))
+	assert.NoError(t, err)
+
+	bundle, err := mp.Bundle(entryPath)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(bundle, ";; bundle: counter.lurk\n;; import: math\n"))
+	assert.NotContains(t, bundle, "!(import")
+	assert.Contains(t, bundle, "!(defun plus-two (x) (+ x 2))")
+
+	// The bundle can be imported without the original dependencies.
+	bundleDir := filepath.Join(tempDir, "bundle")
+	err = os.MkdirAll(bundleDir, 0755)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(bundleDir, "counter.lurk"), []byte(bundle), 0644)
+	assert.NoError(t, err)
+
+	mp, err = macros.NewMacroPreprocessor(macros.DependencyDir(bundleDir))
+	assert.NoError(t, err)
+	lurkProgram, err := mp.Preprocess("!(import counter)\n(increment 1)")
+	assert.NoError(t, err)
+	assert.Equal(t, "(letrec ((plus-two (lambda (x) (+ x 2)))) (letrec ((increment (lambda (x) (plus-two x)))) (increment 1)))", normalizeWhitespace(removeLurkComments(lurkProgram)))
+
+	// Entry files must declare a module.
+	err = os.WriteFile(entryPath, []byte("(+ 1 2)"), 0644)
+	assert.NoError(t, err)
+	_, err = mp.Bundle(entryPath)
+	assert.Error(t, err)
+}
+
+func removeLurkComments(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), ";;") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestPreprocessLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`!(def x "a)b")`, `(let ((x "a)b")))`},
+		{`!(def x #\()`, `(let ((x #\()))`},
+		{`!(def x #\)) x`, `(let ((x #\))) x)`},
+		{`!(assert (eq x "(")) t`, `(if (eq (eq x "(") nil) nil t)`},
+		{`!(assert-eq x "(")`, `(if (eq (eq x "(") nil) nil)`},
+		{`!(list "a b" "c)" 3)`, `(cons "a b" (cons "c)" (cons 3 nil)))`},
+		{`(eq x "!(def y 1)")`, `(eq x "!(def y 1)")`},
+		{`!(defun f (x) (cons x "))"))`, `(letrec ((f (lambda (x) (cons x "))")))))`},
+		{`!(def |a(b| 1) |a(b|`, `(let ((|a(b| 1)) |a(b|)`},
+		{`!(def x "say \"hi)\"") x`, `(let ((x "say \"hi)\"")) x)`},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoErrorf(t, err, "Test %d", i)
+		lurkProgram = strings.ReplaceAll(lurkProgram, "\n", "")
+		assert.Truef(t, macros.IsValidLurk(lurkProgram), "Test %d should be valid", i)
+		assert.Equalf(t, test.expected, lurkProgram, "Test %d not as expected", i)
+	}
+}
+
+func TestIsValidLurkLiterals(t *testing.T) {
+	assert.True(t, macros.IsValidLurk(`(eq x "(")`))
+	assert.True(t, macros.IsValidLurk(`(eq x #\))`))
+	assert.True(t, macros.IsValidLurk(`(eq x '|a)b|)`))
+	assert.True(t, macros.IsValidLurk("(eq x 1) ; (unclosed"))
+	assert.False(t, macros.IsValidLurk(`(eq x ")"`))
+	assert.False(t, macros.IsValidLurk(`(eq x "a") )`))
+}
+
+func TestPreprocessWithSourceMap(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_source_map_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mod := "!(module math (\n\t!(defun plus-two (x) (+ x 2))\n))\n"
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	program := "(lambda (priv pub)\n  !(import math)\n  !(assert (eq !(param fee) 10))\n  (plus-two 1))"
+	out, sourceMap, err := mp.PreprocessWithSourceMap(program)
+	assert.NoError(t, err)
+
+	expected, err := mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+
+	tests := []struct {
+		expr     string
+		expected macros.SourceLocation
+	}{
+		{
+			expr:     "(+ x 2)",
+			expected: macros.SourceLocation{File: "math.lurk", Module: "math", Line: 2, Column: 23},
+		},
+		{
+			expr:     "(car (cdr (cdr (cdr public-params))))",
+			expected: macros.SourceLocation{Line: 3, Column: 16},
+		},
+		{
+			expr:     "(plus-two 1)",
+			expected: macros.SourceLocation{Line: 4, Column: 3},
+		},
+	}
+	for _, test := range tests {
+		offset := strings.Index(out, test.expr)
+		assert.GreaterOrEqual(t, offset, 0)
+		loc, ok := sourceMap.Lookup(offset)
+		assert.True(t, ok)
+		assert.Equal(t, test.expected, loc)
+
+		loc, ok = sourceMap.LookupExpression(out, test.expr)
+		assert.True(t, ok)
+		assert.Equal(t, test.expected, loc)
+	}
+
+	// The let generated by the defun maps to the macro.
+	loc, ok := sourceMap.Lookup(strings.Index(out, "(letrec ((plus-two"))
+	assert.True(t, ok)
+	assert.Equal(t, macros.SourceLocation{File: "math.lurk", Module: "math", Line: 2, Column: 2}, loc)
+	assert.Equal(t, "math.lurk:2:2 (math)", loc.String())
+}
+
+func TestRemoveComments(t *testing.T) {
+	program := ";; Copyright (c) 2024 The illium developers\n" +
+		";; License: MIT\n" +
+		"\n" +
+		"(lambda (priv pub) ; trailing comment\n" +
+		"  #| block comment (with #| nested |# parens |#\n" +
+		"  ;;! keep me\n" +
+		"  (eq priv #|inline|# pub)) ; last"
+
+	tests := []struct {
+		opts     []macros.Option
+		expected string
+	}{
+		{
+			opts:     []macros.Option{macros.RemoveComments()},
+			expected: "(lambda (priv pub) (eq priv pub))",
+		},
+		{
+			opts:     []macros.Option{macros.RemoveComments(), macros.PreserveHeaderComments()},
+			expected: ";; Copyright (c) 2024 The illium developers\n;; License: MIT\n(lambda (priv pub) (eq priv pub))",
+		},
+		{
+			opts:     []macros.Option{macros.RemoveComments(), macros.PreserveComments(";;!")},
+			expected: "(lambda (priv pub) ;;! keep me\n(eq priv pub))",
+		},
+	}
+	for i, test := range tests {
+		mp, err := macros.NewMacroPreprocessor(test.opts...)
+		assert.NoError(t, err)
+		out, err := mp.Preprocess(program)
+		assert.NoErrorf(t, err, "test %d", i)
+		assert.Equalf(t, test.expected, out, "test %d", i)
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	out, err := mp.Preprocess("(eq #|a|# 1 1) #| b |#")
+	assert.NoError(t, err)
+	assert.Equal(t, "(eq #|a|# 1 1)\n#| b |#", out)
+	assert.True(t, macros.IsValidLurk(out))
+
+	_, err = mp.Preprocess("(eq 1\n  #| unclosed (eq 1 1))")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrUnclosedComment, perr.Type)
+	assert.Equal(t, 2, perr.Line)
+	assert.Equal(t, 3, perr.Column)
+	assert.False(t, macros.IsValidLurk("(eq 1 #| 1)"))
+
+	_, err = macros.NewMacroPreprocessor(macros.PreserveComments("keep"))
+	assert.Error(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	program := `(lambda (priv pub)   (let ((sighash (car pub)) (fee (car (cdr (cdr (cdr pub))))))
+	;; Check the fee
+	(if (eq (<= fee 100) nil) nil (letrec ((check (lambda (x) (eq x sighash)))) (check (car priv))))))`
+
+	expected := `(lambda (priv pub)
+  (let ((sighash (car pub)) (fee (car (cdr (cdr (cdr pub))))))
+    ;; Check the fee
+    (if
+      (eq (<= fee 100) nil)
+      nil
+      (letrec ((check (lambda (x) (eq x sighash)))) (check (car priv))))))
+`
+	out, err := macros.Format(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+
+	// Formatting is idempotent.
+	out2, err := macros.Format(out)
+	assert.NoError(t, err)
+	assert.Equal(t, out, out2)
+
+	out, err = macros.Format("!(def x 1)\n\n\n(+   x  1) ; add\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "!(def x 1)\n(+ x 1)\n; add\n", out)
+
+	_, err = macros.Format("(+ x 1")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrUnclosedParen, perr.Type)
+}
+
+func TestEliminateDeadCode(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_dead_code_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	mod := `!(module math (
+	!(def some-const 1234)
+	!(defun plus-two (x) (+ x 2))
+	!(defun plus-three (x) (+ (plus-two x) 1))
+	!(defun times-two (x) (* x 2))
+	!(defrec countdown (lambda (x) (if (= x 0) 0 (countdown (- x 1)))))
+))`
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte(mod), 0644)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		program  string
+		expected string
+	}{
+		{
+			program:  "(lambda (x) !(import math) (plus-three x))",
+			expected: "(lambda (x) (letrec ((plus-two (lambda (x) (+ x 2)))) (letrec ((plus-three (lambda (x) (+ (plus-two x) 1)))) (plus-three x))))",
+		},
+		{
+			// Definitions in the program itself are kept.
+			program:  "(lambda (x) !(import math) !(def unused 1) (times-two some-const))",
+			expected: "(lambda (x) (let ((some-const 1234)) (letrec ((times-two (lambda (x) (* x 2)))) (let ((unused 1)) (times-two some-const)))))",
+		},
+		{
+			// A recursive definition does not reference itself.
+			program:  "(lambda (x) !(import math) x)",
+			expected: "(lambda (x) x)",
+		},
+		{
+			// Quoted symbols may be evaluated so they are kept.
+			program:  "(lambda (x) !(import math/plus-two) (eval (cons 'plus-two (cons x nil))))",
+			expected: "(lambda (x) (letrec ((plus-two (lambda (x) (+ x 2)))) (eval (cons 'plus-two (cons x nil)))))",
+		},
+	}
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir), macros.RemoveComments(), macros.EliminateDeadCode())
+	assert.NoError(t, err)
+	for i, test := range tests {
+		out, err := mp.Preprocess(test.program)
+		assert.NoErrorf(t, err, "test %d", i)
+		assert.Equalf(t, test.expected, out, "test %d", i)
+	}
+}
+
+func TestLint(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_lint_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(filepath.Join(tempDir, "math"), 0755)
+	assert.NoError(t, err)
+
+	// math/plus-two may refer to either the module in math/plus-two.lurk
+	// or the expression in math.lurk.
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte("!(module math (\n!(defun plus-two (x) (+ x 2))\n))"), 0644)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "math", "plus-two.lurk"), []byte("!(module plus-two (\n!(def two 2)\n))"), 0644)
+	assert.NoError(t, err)
+
+	program := `(lambda (priv pub)
+  !(import math)
+  !(def unused 1)
+  !(def plus-two 3)
+  (let ((x 1) (x (+ x 1)))
+    !(assert-eq !(param txo-root) !(param priv-in 0 bogus))
+    !(assert nil)
+    !(assert (eq x !(param nope)))
+    (if nil !(assert t) (plus-two x))))`
+
+	diags, err := macros.Lint(program, macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+	assert.Equal(t, []macros.Diagnostic{
+		{Check: macros.LintUnusedDefinition, Line: 3, Column: 3, Message: "unused is defined but never used"},
+		{Check: macros.LintShadowedBinding, Line: 4, Column: 3, Message: "plus-two shadows an existing binding"},
+		{Check: macros.LintShadowedBinding, Line: 5, Column: 16, Message: "x shadows an existing binding"},
+		{Check: macros.LintUnknownParam, Line: 6, Column: 35, Message: "param priv-in has no field bogus, expected one of amount, asset-id, commitment-index, inclusion-proof, locking-params, salt, script, state, unlocking-params"},
+		{Check: macros.LintUnreachableAssert, Line: 8, Column: 5, Message: "assert is never evaluated"},
+		{Check: macros.LintUnknownParam, Line: 8, Column: 20, Message: "no param named nope, expected one of coinbase, fee, locktime, locktime-precision, mint-amount, mint-id, nullifiers, priv-in, priv-out, pub-out, sighash, txo-root"},
+		{Check: macros.LintUnreachableAssert, Line: 9, Column: 13, Message: "assert is never evaluated"},
+	}, diags)
+
+	diags, err = macros.Lint("(lambda (x) !(import math/plus-two) (plus-two x))", macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, macros.LintAmbiguousImport, diags[0].Check)
+	assert.Equal(t, "1:13: ambiguous-import: import math/plus-two resolves to multiple candidates: math/plus-two.lurk, math.lurk", diags[0].String())
+
+	// Definitions exported by a module are not unused.
+	diags, err = macros.Lint("!(module utils (\n!(def one 1)\n!(defun inc (x) (+ x one))\n))")
+	assert.NoError(t, err)
+	assert.Empty(t, diags)
+
+	_, err = macros.Lint("(lambda (x) !(import missing) x)", macros.DependencyDir(tempDir))
+	assert.Error(t, err)
+}
+
+func TestFoldConstants(t *testing.T) {
+	program := `(lambda (locking-params unlocking-params input-index private-params public-params)
+  !(assert (<= !(param locktime-precision) (+ 500 100)))
+  !(assert (>= !(param locktime) 10))
+  !(assert (= !(param mint-amount) (- 10 (* 2 3))))
+  !(assert (< 3 4))
+  (= !(param fee) '(+ 1 2)))`
+
+	mp, err := macros.NewMacroPreprocessor(macros.FoldConstants())
+	assert.NoError(t, err)
+	out, err := mp.Preprocess(program)
+	assert.NoError(t, err)
+
+	// The locktime, locktime-precision and mint-amount params share the
+	// accessor for their first six cdrs. The fee is only accessed once
+	// and the quoted expression is left as is.
+	expected := "(lambda (locking-params unlocking-params input-index private-params public-params) " +
+		"(let ((public-params-cddddddr (cdr (cdr (cdr (cdr (cdr (cdr public-params)))))))) " +
+		"(if (eq (<= (car (cdr (cdr (cdr public-params-cddddddr)))) 600) nil) nil " +
+		"(if (eq (>= (car (cdr (cdr public-params-cddddddr))) 10) nil) nil " +
+		"(if (eq (= (car public-params-cddddddr) 4) nil) nil " +
+		"(= (car (cdr (cdr (cdr public-params)))) '(+ 1 2)))))))"
+	assert.Equal(t, expected, out)
+
+	// Accessors are not hoisted if the parameter is rebound.
+	program = `(lambda (private-params public-params)
+  (let ((public-params (cdr public-params)))
+    (+ !(param locktime) !(param locktime) !(param locktime))))`
+	out, err = mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.NotContains(t, out, "public-params-c")
+}
+
+func TestExpansionCache(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_cache_test")
+	defer os.RemoveAll(tempDir)
+
+	depDir := filepath.Join(tempDir, "deps")
+	cacheDir := filepath.Join(tempDir, "cache")
+	err := os.MkdirAll(depDir, 0755)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(depDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ x 2))))"), 0644)
+	assert.NoError(t, err)
+
+	program := "(lambda (x) !(import math) (plus-two x))"
+	expected := "(lambda (x) (letrec ((plus-two (lambda (x) (+ x 2)))) (plus-two x)))"
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(depDir), macros.ExpansionCache(cacheDir))
+	assert.NoError(t, err)
+	out, err := mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+
+	entries, err := os.ReadDir(cacheDir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Tamper with the cached output to check that it is used by a new
+	// preprocessor sharing the cache directory.
+	entryPath := filepath.Join(cacheDir, entries[0].Name())
+	data, err := os.ReadFile(entryPath)
+	assert.NoError(t, err)
+	err = os.WriteFile(entryPath, []byte(strings.Replace(string(data), "plus-two x)))", "plus-two 1)))", 1)), 0644)
+	assert.NoError(t, err)
+
+	mp, err = macros.NewMacroPreprocessor(macros.DependencyDir(depDir), macros.ExpansionCache(cacheDir))
+	assert.NoError(t, err)
+	out, err = mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, "(lambda (x) (letrec ((plus-two (lambda (x) (+ x 2)))) (plus-two 1)))", out)
+
+	// Different options are cached separately.
+	mp2, err := macros.NewMacroPreprocessor(macros.DependencyDir(depDir), macros.ExpansionCache(cacheDir), macros.WithDefines("testnet"))
+	assert.NoError(t, err)
+	out, err = mp2.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+
+	// Changing a dependency invalidates the entry.
+	err = os.WriteFile(filepath.Join(depDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ 2 x))))"), 0644)
+	assert.NoError(t, err)
+	out, err = mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, "(lambda (x) (letrec ((plus-two (lambda (x) (+ 2 x)))) (plus-two x)))", out)
+
+	// As does adding a file to an imported directory.
+	err = os.WriteFile(filepath.Join(depDir, "other.lurk"), []byte("!(module math ())"), 0644)
+	assert.NoError(t, err)
+	_, err = mp.Preprocess(program)
+	assert.Error(t, err)
+}
+
+func TestDependencyGraph(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_graph_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(filepath.Join(tempDir, "utils"), 0755)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte(`!(module math (
+	!(import utils/strings (concat))
+	!(defun plus-two (x) (+ x 2))
+))`), 0644)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "utils", "strings.lurk"), []byte(`!(module strings (
+	!(defun concat (a b) (cons a b))
+))`), 0644)
+	assert.NoError(t, err)
+
+	program := "(lambda (x) !(import math :as m) !(import utils/strings/concat) (m.plus-two x))"
+	graph, err := macros.DependencyGraph(program, macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []macros.ImportGraphNode{
+		{},
+		{Path: "math", Module: "math", Files: []string{"math.lurk"}},
+		{Path: "utils/strings", Module: "strings", Files: []string{"utils/strings.lurk"}},
+		{Path: "utils/strings/concat", Module: "strings", Files: []string{"utils/strings.lurk"}},
+	}, graph.Nodes)
+	assert.Equal(t, []macros.ImportGraphEdge{
+		{From: "", To: "math", Alias: "m"},
+		{From: "", To: "utils/strings/concat"},
+		{From: "math", To: "utils/strings", Symbols: []string{"concat"}},
+	}, graph.Edges)
+	assert.Equal(t, []string{"math", "utils/strings", "utils/strings/concat"}, graph.Modules())
+
+	graph, err = macros.DependencyGraph("(lambda (x) x)")
+	assert.NoError(t, err)
+	assert.Len(t, graph.Nodes, 1)
+	assert.Empty(t, graph.Edges)
+
+	_, err = macros.DependencyGraph("(lambda (x) !(import missing) x)", macros.DependencyDir(tempDir))
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrImport, perr.Type)
+}
+
+func TestExpansionLimits(t *testing.T) {
+	tempDir := path.Join(os.TempDir(), "preprocess_limits_test")
+	defer os.RemoveAll(tempDir)
+
+	err := os.MkdirAll(tempDir, 0755)
+	assert.NoError(t, err)
+
+	// Each module imports the next twice so the expansion doubles with
+	// every level.
+	for i := 0; i < 30; i++ {
+		mod := fmt.Sprintf("!(module m%d (\n!(import m%d)\n!(import m%d)\n!(def x%d 1)\n))", i, i+1, i+1, i)
+		err = os.WriteFile(filepath.Join(tempDir, fmt.Sprintf("m%d.lurk", i)), []byte(mod), 0644)
+		assert.NoError(t, err)
+	}
+	err = os.WriteFile(filepath.Join(tempDir, "m30.lurk"), []byte("!(module m30 (!(def x30 1)))"), 0644)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		opts    []macros.Option
+		program string
+	}{
+		{
+			opts:    []macros.Option{macros.DependencyDir(tempDir)},
+			program: "!(import m0) x0",
+		},
+		{
+			opts:    []macros.Option{macros.DependencyDir(tempDir), macros.MaxImportDepth(5)},
+			program: "!(import m20) x20",
+		},
+		{
+			opts:    []macros.Option{macros.DependencyDir(tempDir), macros.MaxImports(100)},
+			program: "!(import m20) x20",
+		},
+		{
+			opts:    []macros.Option{macros.DependencyDir(tempDir), macros.MaxExpandedNodes(1000)},
+			program: "!(import m20) x20",
+		},
+		{
+			opts:    []macros.Option{macros.DependencyDir(tempDir), macros.MaxOutputSize(100)},
+			program: "!(import m25) x25",
+		},
+		{
+			opts:    nil,
+			program: strings.Repeat("(", 20000) + strings.Repeat(")", 20000),
+		},
+	}
+	for i, test := range tests {
+		mp, err := macros.NewMacroPreprocessor(test.opts...)
+		assert.NoError(t, err)
+		_, err = mp.Preprocess(test.program)
+		assert.ErrorIsf(t, err, macros.ErrExpansionLimit, "test %d", i)
+
+		var perr *macros.PreprocessError
+		assert.Truef(t, errors.As(err, &perr), "test %d", i)
+		assert.Equalf(t, macros.ErrLimitExceeded, perr.Type, "test %d", i)
+	}
+
+	// Within the limits the program is expanded.
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+	_, err = mp.Preprocess("!(import m28) x28")
+	assert.NoError(t, err)
+
+	_, err = macros.NewMacroPreprocessor(macros.MaxOutputSize(0))
+	assert.Error(t, err)
+}
+
+func TestAnalyze(t *testing.T) {
+	program := `(lambda (locking-params unlocking-params input-index private-params public-params)
+    !(import std/crypto/checksig)
+    !(def amt !(param priv-in 0 amount))
+    !(ifdef TESTNET !(assert (> amt 0)))
+    !(import std/crypto/checksig)
+    !(assert-eq amt !(param priv-out 0 amount))
+    !(check-sig)
+)`
+	analysis, err := macros.Analyze(program)
+	assert.NoError(t, err)
+	assert.Equal(t, []macros.MacroUsage{
+		{Macro: macros.Assert, Count: 1, Locations: []macros.SourceLocation{{Line: 4, Column: 21}}},
+		{Macro: macros.AssertEq, Count: 1, Locations: []macros.SourceLocation{{Line: 6, Column: 5}}},
+		{Macro: macros.CheckSig, Count: 1, Locations: []macros.SourceLocation{{Line: 7, Column: 5}}},
+		{Macro: macros.Def, Count: 1, Locations: []macros.SourceLocation{{Line: 3, Column: 5}}},
+		{Macro: macros.Ifdef, Count: 1, Locations: []macros.SourceLocation{{Line: 4, Column: 5}}},
+		{Macro: macros.Import, Count: 2, Locations: []macros.SourceLocation{{Line: 2, Column: 5}, {Line: 5, Column: 5}}},
+		{Macro: macros.Param, Count: 2, Locations: []macros.SourceLocation{{Line: 3, Column: 15}, {Line: 6, Column: 21}}},
+	}, analysis.Macros)
+	assert.Equal(t, []string{"std/crypto/checksig"}, analysis.Imports)
+	assert.Equal(t, 2, analysis.Count(macros.Param))
+	assert.Equal(t, 0, analysis.Count(macros.Defun))
+
+	_, err = macros.Analyze("!(unsupported x)")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrUnknownMacro, perr.Type)
+	assert.Equal(t, "unsupported", perr.Macro)
+}
+
+func TestPreprocessSteps(t *testing.T) {
+	program := `!(def x !(list 1 !(param fee)))
+!(defun f (y) (cons y x))
+!(assert-eq (car x) 1)
+(f !(param sighash))`
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	steps, err := mp.PreprocessSteps(program)
+	assert.NoError(t, err)
+
+	var passes []string
+	for _, step := range steps {
+		passes = append(passes, step.Pass)
+	}
+	assert.Equal(t, []string{"conditionals", "import", "def", "defrec", "defun", "assert", "list", "param", "check-sig"}, passes)
+
+	assert.Equal(t, "(let ((x !(list 1 !(param fee)))) !(defun f (y) (cons y x)) !(assert-eq (car x) 1) (f !(param sighash)))", normalizeWhitespace(steps[2].Program))
+	assert.Equal(t, "(let ((x !(list 1 !(param fee)))) (letrec ((f (lambda (y) (cons y x)))) !(assert-eq (car x) 1) (f !(param sighash))))", normalizeWhitespace(steps[4].Program))
+	assert.Equal(t, "(let ((x (cons 1 (cons !(param fee) nil)))) (letrec ((f (lambda (y) (cons y x)))) (if (eq (eq (car x) 1) nil) nil (f !(param sighash)))))", normalizeWhitespace(steps[6].Program))
+
+	expected, err := mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, steps[len(steps)-1].Program)
+
+	// The final step matches Preprocess for programs importing modules.
+	mp, err = macros.NewMacroPreprocessor(macros.WithStandardLib(), macros.RemoveComments(), macros.FoldConstants())
+	assert.NoError(t, err)
+	for _, script := range []string{"(lambda (locking-params unlocking-params input-index private-params public-params) !(import std/crypto/checksig) (checksig unlocking-params locking-params !(param sighash)))"} {
+		steps, err := mp.PreprocessSteps(script)
+		assert.NoError(t, err)
+		assert.Equal(t, "fold-constants", steps[len(steps)-1].Pass)
+		expected, err := mp.Preprocess(script)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, steps[len(steps)-1].Program)
+	}
+
+	// The steps before a failing pass are returned.
+	steps, err = mp.PreprocessSteps("!(def x 1) !(param nope)")
+	assert.Error(t, err)
+	assert.Len(t, steps, 7)
+}
+
+func TestWatch(t *testing.T) {
+	tempDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ x 2))))"), 0644)
+	assert.NoError(t, err)
+
+	events := make(chan macros.WatchEvent, 10)
+	w, err := macros.Watch(tempDir, func(event macros.WatchEvent) {
+		events <- event
+	}, macros.WatchInterval(10*time.Millisecond))
+	assert.NoError(t, err)
+	defer w.Close()
+
+	program, err := w.Add("main", "!(import math/plus-two) (plus-two 1)")
+	assert.NoError(t, err)
+	assert.Contains(t, program, "(+ x 2)")
+
+	next := func() macros.WatchEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for watch event")
+		}
+		return macros.WatchEvent{}
+	}
+
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ 2 x))))"), 0644)
+	assert.NoError(t, err)
+	event := next()
+	assert.Equal(t, "main", event.Entry)
+	assert.Equal(t, []string{"math.lurk"}, event.Changed)
+	assert.NoError(t, event.Err)
+	assert.Contains(t, event.Program, "(+ 2 x)")
+
+	// Errors are reported to the callback and the program is preprocessed
+	// again once they are fixed.
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ 2 x)))"), 0644)
+	assert.NoError(t, err)
+	event = next()
+	assert.Error(t, event.Err)
+
+	err = os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte("!(module math (!(defun plus-two (x) (+ x 2))))"), 0644)
+	assert.NoError(t, err)
+	event = next()
+	assert.NoError(t, event.Err)
+	assert.Contains(t, event.Program, "(+ x 2)")
+
+	// Changes to other files are ignored.
+	err = os.WriteFile(filepath.Join(tempDir, "notes.txt"), []byte("notes"), 0644)
+	assert.NoError(t, err)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event for %v", event.Changed)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	_, err = macros.Watch(filepath.Join(tempDir, "math.lurk"), func(macros.WatchEvent) {})
+	assert.Error(t, err)
+}
+
+func TestDependencyArchive(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"math.lurk":        "!(module math (!(defun plus-two (x) (+ x 2))))",
+		"crypto/hash.lurk": "!(module hash (!(defun double (x) (* x 2))))",
+	}
+	program := "!(import math/plus-two) !(import crypto/hash/double) (double (plus-two 1))"
+	expected := "(letrec ((plus-two (lambda (x) (+ x 2)))) (letrec ((double (lambda (x) (* x 2)))) (double (plus-two 1))))"
+
+	// A zip archive with a single top level directory.
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for name, content := range files {
+		w, err := zw.Create("std-v1/" + name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	zipPath := filepath.Join(tempDir, "std.lurkz")
+	assert.NoError(t, os.WriteFile(zipPath, zipBuf.Bytes(), 0644))
+
+	// A tar.gz archive with the modules at the root.
+	var tarBuf bytes.Buffer
+	gw := gzip.NewWriter(&tarBuf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: "./" + name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		assert.NoError(t, err)
+		_, err = tw.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gw.Close())
+	tarPath := filepath.Join(tempDir, "std.tar.gz")
+	assert.NoError(t, os.WriteFile(tarPath, tarBuf.Bytes(), 0644))
+
+	for _, archive := range []string{zipPath, tarPath} {
+		mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(archive))
+		assert.NoError(t, err)
+		lurkProgram, err := mp.Preprocess(program)
+		assert.NoError(t, err, archive)
+		assert.Equal(t, expected, normalizeWhitespace(lurkProgram), archive)
+	}
+
+	notArchive := filepath.Join(tempDir, "math.lurk")
+	assert.NoError(t, os.WriteFile(notArchive, []byte(files["math.lurk"]), 0644))
+	_, err := macros.NewMacroPreprocessor(macros.DependencyDir(notArchive))
+	assert.Error(t, err)
+}
+
+func TestHygienicExpansion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// The accessor function doesn't capture an index named nth.
+		{
+			"!(def nth 1) !(param priv-in nth)",
+			"(let ((nth 1)) (letrec ((nth_1 (lambda (idx plist) (if (= idx 0) (car plist) (nth_1 (- idx 1) (cdr plist)))))) (nth_1 nth (car private-params))))",
+		},
+		// The signature binding doesn't capture the public key argument
+		// and the names don't shadow the program's bindings.
+		{
+			"(lambda (sig pubkey) !(check-sig (car sig) (car pubkey)))",
+			"(lambda (sig pubkey) (let ((sig_1 (car sig)) (pubkey_1 (car pubkey)) (sighash (car public-params))) (eval (cons 'coproc_checksig (cons (car sig_1) (cons (car (cdr sig_1)) (cons (car (cdr (cdr sig_1))) (cons (car pubkey_1) (cons (car (cdr pubkey_1)) (cons sighash nil))))))))))",
+		},
+		// Generated names skip those already taken.
+		{
+			"!(def sig_1 1) (cons sig !(check-sig sig_1))",
+			"(let ((sig_1 1)) (cons sig (let ((sig_2 sig_1) (pubkey locking-params) (sighash (car public-params))) (eval (cons 'coproc_checksig (cons (car sig_2) (cons (car (cdr sig_2)) (cons (car (cdr (cdr sig_2))) (cons (car pubkey) (cons (car (cdr pubkey)) (cons sighash nil)))))))))))",
+		},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+}
+
+func TestListSplicing(t *testing.T) {
+	appendFn := "(letrec ((append (lambda (xs_1 ys) (if (eq xs_1 nil) ys (cons (car xs_1) (append (cdr xs_1) ys))))))"
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"!(list 1 !(splice xs) 2)", appendFn + " (cons 1 (append xs (cons 2 nil))))"},
+		{"!(list 1 !(splice xs))", "(cons 1 xs)"},
+		{"!(list !(splice xs) !(splice (cdr ys)))", "(letrec ((append (lambda (xs_1 ys_1) (if (eq xs_1 nil) ys_1 (cons (car xs_1) (append (cdr xs_1) ys_1)))))) (append xs (cdr ys)))"},
+		{"!(list !(splice !(list 1 2)) 3)", "(letrec ((append (lambda (xs ys) (if (eq xs nil) ys (cons (car xs) (append (cdr xs) ys)))))) (append (cons 1 (cons 2 nil)) (cons 3 nil)))"},
+		{"!(append a b c)", "(letrec ((append (lambda (xs ys) (if (eq xs nil) ys (cons (car xs) (append (cdr xs) ys)))))) (append a (append b c)))"},
+		{"!(append a)", "a"},
+		{"!(append)", "nil"},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Truef(t, macros.IsValidLurk(lurkProgram), "Test %d should be valid", i)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	for _, program := range []string{"(f !(splice xs))", "!(list !(splice))", "!(list !(splice a b))"} {
+		_, err = mp.Preprocess(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrInvalidMacro, perr.Type, program)
+		assert.Equal(t, "splice", perr.Macro, program)
+	}
+}
+
+func TestAssertMessages(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`!(assert (> x 0) "x is positive") t`, `(if (eq (> x 0) nil) nil t)`},
+		{`!(assert-eq a b "a equals b") t`, `(if (eq (eq a b) nil) nil t)`},
+		{`!(assert (> x 0)) t`, `(if (eq (> x 0) nil) nil t)`},
+	}
+
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	for _, program := range []string{`!(assert x t) t`, `!(assert-eq a b 'msg) t`, `!(assert x "a" "b") t`} {
+		_, err = mp.Preprocess(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrInvalidMacro, perr.Type, program)
+	}
+
+	// The message is carried in the source map at the failing branch.
+	program := `!(assert (> x 0) "x is positive") !(assert-eq a b) t`
+	out, sourceMap, err := mp.PreprocessWithSourceMap(program)
+	assert.NoError(t, err)
+	assert.Equal(t, `(if (eq (> x 0) nil) nil (if (eq (eq a b) nil) nil t))`, out)
+	msg, ok := sourceMap.AssertionMessage(strings.Index(out, "nil (if"))
+	assert.True(t, ok)
+	assert.Equal(t, "x is positive", msg)
+	_, ok = sourceMap.AssertionMessage(strings.Index(out, "nil t)"))
+	assert.False(t, ok)
+	loc, ok := sourceMap.Lookup(strings.Index(out, "nil (if"))
+	assert.True(t, ok)
+	assert.Equal(t, strings.Index(program, `"x is positive"`)+1, loc.Column)
+
+	// An assert which always fails is reported by lint regardless of the
+	// message.
+	diags, err := macros.Lint(`!(assert nil "unreachable") !(assert t) t`)
+	assert.NoError(t, err)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, macros.LintUnreachableAssert, diags[0].Check)
+}
+
+func TestUnitTests(t *testing.T) {
+	dir := t.TempDir()
+	module := `!(module math (
+    !(defun double (x) (* x 2))
+    !(test double-two (double 2) 4)
+    !(defun quadruple (x) (double (double x)))
+    !(test "quadruple" (quadruple 1) 4)
+))`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "math.lurk"), []byte(module), 0644))
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(dir))
+	assert.NoError(t, err)
+
+	tests, err := mp.DependencyTests()
+	assert.NoError(t, err)
+	assert.Len(t, tests, 2)
+	assert.Equal(t, "double-two", tests[0].Name)
+	assert.Equal(t, macros.SourceLocation{File: "math.lurk", Module: "math", Line: 3, Column: 5}, tests[0].Location)
+	assert.Equal(t, "(letrec ((double (lambda (x) (* x 2)))) (eq (double 2) 4))", normalizeWhitespace(tests[0].Program))
+	assert.Equal(t, "quadruple", tests[1].Name)
+	assert.Equal(t, "(letrec ((double (lambda (x) (* x 2)))) (letrec ((quadruple (lambda (x) (double (double x))))) (eq (quadruple 1) 4)))", normalizeWhitespace(tests[1].Program))
+
+	// Tests in a program may use its imports.
+	tests, err = mp.Tests(`!(import math/double) !(test imported (double 3) 6) (lambda (a b) t)`)
+	assert.NoError(t, err)
+	assert.Len(t, tests, 1)
+	assert.Equal(t, macros.SourceLocation{Line: 1, Column: 23}, tests[0].Location)
+	assert.Equal(t, "(letrec ((double (lambda (x) (* x 2)))) (eq (double 3) 6))", normalizeWhitespace(tests[0].Program))
+
+	// Tests are removed from the preprocessed program and from imported
+	// modules.
+	lurkProgram, err := mp.Preprocess(`!(import math) !(test imported (double 3) 6) (quadruple 1)`)
+	assert.NoError(t, err)
+	assert.Equal(t, "(letrec ((double (lambda (x) (* x 2)))) (letrec ((quadruple (lambda (x) (double (double x))))) (quadruple 1)))", normalizeWhitespace(lurkProgram))
+
+	for _, program := range []string{`(a !(test x 1 1))`, `!(test x 1)`, `!(test (x) 1 1)`} {
+		_, err = mp.Tests(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrInvalidMacro, perr.Type, program)
+		assert.Equal(t, "test", perr.Macro, program)
+	}
+	_, err = mp.Preprocess(`(a !(test x 1 1))`)
+	assert.Error(t, err)
+
+	// The standard library has tests.
+	mp, err = macros.NewMacroPreprocessor(macros.WithStandardLib())
+	assert.NoError(t, err)
+	tests, err = mp.DependencyTests()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tests)
+}
+
+func TestVersionedImports(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"math@v1.0.0/math.lurk":         "!(module math (!(defun double (x) (* x 2))))",
+		"math/v2.0.0/math.lurk":         "!(module math (!(defun double (x) (+ x x))))",
+		"lib@v1.0.0/crypto/hash.lurk":   "!(module hash (!(defun hash (x) (num (commit x)))))",
+		"conflict@v1.0.0/conflict.lurk": "!(module conflict (!(def x 1)))",
+		"conflict/v1.0.0/conflict.lurk": "!(module conflict (!(def x 2)))",
+	}
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, filepath.Dir(name)), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+	}
+
+	// An archive is matched by its manifest rather than its file name.
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for name, content := range map[string]string{
+		macros.LurkManifestName: `{"name": "math", "version": "v3.0.0"}`,
+		"math.lurk":             "!(module math (!(defun double (x) (* 2 x))))",
+	} {
+		w, err := zw.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "math-release.lurkz"), zipBuf.Bytes(), 0644))
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"!(import math@v1.0.0) (double 1)", "(letrec ((double (lambda (x) (* x 2)))) (double 1))"},
+		{"!(import math@v2.0.0 (double)) (double 1)", "(letrec ((double (lambda (x) (+ x x)))) (double 1))"},
+		{"!(import math@v3.0.0) (double 1)", "(letrec ((double (lambda (x) (* 2 x)))) (double 1))"},
+		{"!(import lib@v1.0.0/crypto/hash/hash) (hash 1)", "(letrec ((hash (lambda (x) (num (commit x))))) (hash 1))"},
+	}
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err, test.input)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	for _, program := range []string{"!(import conflict@v1.0.0) x", "!(import math@v4.0.0) (double 1)", "!(import math@) (double 1)"} {
+		_, err = mp.Preprocess(program)
+		var perr *macros.PreprocessError
+		assert.True(t, errors.As(err, &perr), program)
+		assert.Equal(t, macros.ErrImport, perr.Type, program)
+	}
+	_, err = mp.Preprocess("!(import conflict@v1.0.0) x")
+	assert.ErrorIs(t, err, macros.ErrAmbiguousImport)
+}
+
+func TestPreprocessAll(t *testing.T) {
+	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib())
+	assert.NoError(t, err)
+
+	var programs []string
+	for i := 0; i < 20; i++ {
+		programs = append(programs,
+			fmt.Sprintf("!(import std/collections/nth) (nth %d !(list 1 2 3))", i%3),
+			fmt.Sprintf("!(import std/state :as s) (s.get %d !(param priv-in 0 state))", i),
+			"!(import std/crypto) !(import std/inputs (script-hash)) (checksig a b c)",
+		)
+	}
+	outputs, err := mp.PreprocessAll(programs)
+	assert.NoError(t, err)
+	assert.Len(t, outputs, len(programs))
+	for i, program := range programs {
+		expected, err := mp.Preprocess(program)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, outputs[i], program)
+	}
+
+	// The error of the first failing program is returned.
+	_, err = mp.PreprocessAll([]string{"(+ 1 2)", "!(import std/missing) t", "!(unknown)"})
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrImport, perr.Type)
+	assert.Contains(t, err.Error(), "program 1")
+
+	outputs, err = mp.PreprocessAll(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, outputs)
+}
+
+func TestEmbeddedStdlib(t *testing.T) {
+	tempDir := t.TempDir()
+	mod := "!(module math (!(defun plus-two (x) (+ x 2))))"
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "math.lurk"), []byte(mod), 0644))
+	// A std directory in the dependency directory is not used.
+	assert.NoError(t, os.MkdirAll(filepath.Join(tempDir, "std"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "std", "mod.lurk"), []byte("!(module collections (!(def nth 1)))"), 0644))
+
+	program := "!(import std/collections/nth) !(import math/plus-two) (plus-two (nth 0 xs))"
+	std, err := macros.NewMacroPreprocessor(macros.WithStandardLib())
+	assert.NoError(t, err)
+	nth, err := std.Preprocess("!(import std/collections/nth) t")
+	assert.NoError(t, err)
+	expected := strings.TrimSuffix(normalizeWhitespace(nth), " t)") + " (letrec ((plus-two (lambda (x) (+ x 2)))) (plus-two (nth 0 xs))))"
+
+	mp, err := macros.NewMacroPreprocessor(macros.DependencyDir(tempDir), macros.WithEmbeddedStdlib())
+	assert.NoError(t, err)
+	lurkProgram, err := mp.Preprocess(program)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, normalizeWhitespace(lurkProgram))
+
+	graph, err := macros.DependencyGraph(program, macros.DependencyDir(tempDir), macros.WithEmbeddedStdlib())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"math/plus-two", "std/collections/nth"}, graph.Modules())
+
+	// Without a dependency directory only the standard library may be
+	// imported.
+	mp, err = macros.NewMacroPreprocessor(macros.WithEmbeddedStdlib())
+	assert.NoError(t, err)
+	_, err = mp.Preprocess("!(import std/collections/nth) (nth 0 xs)")
+	assert.NoError(t, err)
+	_, err = mp.Preprocess(program)
+	assert.ErrorContains(t, err, "dependency directory not set")
+}
+
+func TestParamLayout(t *testing.T) {
+	// A layout for an older circuit without the locktime params and
+	// without the state field of the inputs.
+	layout := `{
+	"version": 0,
+	"params": {
+		"sighash": {"source": "public", "index": 0},
+		"nullifiers": {"source": "public", "index": 1, "list": true},
+		"fee": {"source": "public", "index": 2},
+		"priv-in": {"source": "private", "index": 0, "list": true, "fields": {"amount": 0, "asset-id": 1, "salt": 2, "script": 3}}
+	}
+}`
+	path := filepath.Join(t.TempDir(), "layout.json")
+	assert.NoError(t, os.WriteFile(path, []byte(layout), 0644))
+	loaded, err := macros.LoadParamLayout(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, loaded.Version)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"!(param fee)", "(car (cdr (cdr public-params)))"},
+		{"!(param priv-in 1 script)", "(car (cdr (cdr (cdr (car (cdr (car private-params)))))))"},
+		{"!(param nullifiers 0)", "(car (car (cdr public-params)))"},
+	}
+	mp, err := macros.NewMacroPreprocessor(macros.WithParamLayout(loaded))
+	assert.NoError(t, err)
+	for i, test := range tests {
+		lurkProgram, err := mp.Preprocess(test.input)
+		assert.NoError(t, err)
+		assert.Equalf(t, test.expected, normalizeWhitespace(lurkProgram), "Test %d not as expected", i)
+	}
+
+	// The default layout is unchanged.
+	def, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	lurkProgram, err := def.Preprocess("!(param fee)")
+	assert.NoError(t, err)
+	assert.Equal(t, "(car (cdr (cdr (cdr public-params))))", normalizeWhitespace(lurkProgram))
+
+	_, err = mp.Preprocess("!(param locktime)")
+	var perr *macros.PreprocessError
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, macros.ErrUnknownParam, perr.Type)
+	assert.ErrorContains(t, err, "expected one of fee, nullifiers, priv-in, sighash")
+
+	_, err = mp.Preprocess("!(param priv-in 0 state)")
+	assert.ErrorContains(t, err, "expected one of amount, asset-id, salt, script")
+
+	for _, invalid := range []string{
+		`{"params": {}}`,
+		`{"params": {"fee": {"source": "secret", "index": 0}}}`,
+		`{"params": {"fee": {"source": "public", "index": -1}}}`,
+		`{"params": {"fee": {"source": "public", "index": 0, "fields": {"a": 0}}}}`,
+	} {
+		assert.NoError(t, os.WriteFile(path, []byte(invalid), 0644))
+		_, err := macros.LoadParamLayout(path)
+		assert.Error(t, err, invalid)
+	}
+}
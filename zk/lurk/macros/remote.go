@@ -0,0 +1,239 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// LockfileName is the conventional name of the lockfile.
+	LockfileName = "lurk.lock"
+
+	defaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+	// maxRemoteDependencySize is the maximum size of a remote
+	// dependency file in bytes.
+	maxRemoteDependencySize = 1 << 22
+
+	remoteFetchTimeout = time.Second * 30
+)
+
+// ErrDependencyHashMismatch is returned when the contents of a remote
+// dependency do not match the hash pinned in the lockfile.
+var ErrDependencyHashMismatch = errors.New("dependency hash mismatch")
+
+// LockedDependency is a remote lurk file pinned by the hash of its contents.
+type LockedDependency struct {
+	// URL is the location of the file. It must be either an https://
+	// or ipfs:// URL.
+	URL string `json:"url"`
+	// Sha256 is the hex encoded sha256 hash of the file.
+	Sha256 string `json:"sha256"`
+}
+
+// Lockfile pins the remote dependencies that may be imported by
+// a lurk program.
+type Lockfile struct {
+	// Dependencies maps the name used in import paths to the dependency.
+	Dependencies map[string]LockedDependency `json:"dependencies"`
+}
+
+// LoadLockfile loads the lockfile at the path.
+func LoadLockfile(path string) (*Lockfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var lockfile Lockfile
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("invalid lockfile %s: %w", path, err)
+	}
+	for name, dep := range lockfile.Dependencies {
+		if err := validateDependency(name, dep); err != nil {
+			return nil, err
+		}
+		// Hashes are compared and cached by their lower case encoding.
+		dep.Sha256 = strings.ToLower(dep.Sha256)
+		lockfile.Dependencies[name] = dep
+	}
+	return &lockfile, nil
+}
+
+// Save writes the lockfile to the path.
+func (l *Lockfile) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Pin downloads the file at the URL and adds it to the lockfile under
+// the name, pinned by the hash of the downloaded contents. The contents
+// should be reviewed before the lockfile is committed.
+func (l *Lockfile) Pin(name, rawURL string) error {
+	r := newRemoteResolver()
+	data, err := r.fetch(rawURL)
+	if err != nil {
+		return err
+	}
+	h := sha256.Sum256(data)
+	dep := LockedDependency{URL: rawURL, Sha256: hex.EncodeToString(h[:])}
+	if err := validateDependency(name, dep); err != nil {
+		return err
+	}
+	if l.Dependencies == nil {
+		l.Dependencies = make(map[string]LockedDependency)
+	}
+	l.Dependencies[name] = dep
+	return nil
+}
+
+func validateDependency(name string, dep LockedDependency) error {
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return fmt.Errorf("invalid dependency name %q", name)
+	}
+	u, err := url.Parse(dep.URL)
+	if err != nil {
+		return fmt.Errorf("dependency %s: %w", name, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "ipfs" {
+		return fmt.Errorf("dependency %s: unsupported url scheme %s", name, u.Scheme)
+	}
+	if h, err := hex.DecodeString(dep.Sha256); err != nil || len(h) != sha256.Size {
+		return fmt.Errorf("dependency %s: invalid sha256 hash", name)
+	}
+	return nil
+}
+
+// remoteResolver downloads the remote dependencies in the lockfile
+// and caches them on disk.
+type remoteResolver struct {
+	lockfile    *Lockfile
+	cacheDir    string
+	ipfsGateway string
+	client      *http.Client
+
+	mtx sync.Mutex
+}
+
+func newRemoteResolver() *remoteResolver {
+	return &remoteResolver{
+		ipfsGateway: defaultIPFSGateway,
+		client:      &http.Client{Timeout: remoteFetchTimeout},
+	}
+}
+
+// dependencyDir returns the directory containing the named dependency,
+// downloading it to the cache if it is not already there. The bool is
+// false if the name is not in the lockfile.
+func (r *remoteResolver) dependencyDir(name string) (*fsDirectory, bool, error) {
+	dep, ok := r.lockfile.Dependencies[name]
+	if !ok {
+		return nil, false, nil
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	dir := filepath.Join(r.cacheDir, name, dep.Sha256)
+	path := filepath.Join(dir, name+LurkFileExtension)
+	if data, err := os.ReadFile(path); err == nil && checkHash(data, dep.Sha256) == nil {
+		return &fsDirectory{fileSystem: os.DirFS(dir), path: "."}, true, nil
+	}
+
+	data, err := r.fetch(dep.URL)
+	if err != nil {
+		return nil, true, fmt.Errorf("fetching dependency %s: %w", name, err)
+	}
+	if err := checkHash(data, dep.Sha256); err != nil {
+		return nil, true, fmt.Errorf("dependency %s: %w", name, err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, true, err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return nil, true, err
+	}
+	return &fsDirectory{fileSystem: os.DirFS(dir), path: "."}, true, nil
+}
+
+// fetch downloads the file at the https:// or ipfs:// URL.
+func (r *remoteResolver) fetch(rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "https":
+	case "ipfs":
+		rawURL = r.ipfsGateway + u.Host + u.Path
+	default:
+		return nil, fmt.Errorf("unsupported url scheme %s", u.Scheme)
+	}
+
+	resp, err := r.client.Get(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected http status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteDependencySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRemoteDependencySize {
+		return nil, errors.New("dependency exceeds max size")
+	}
+	return data, nil
+}
+
+func checkHash(data []byte, expected string) error {
+	h := sha256.Sum256(data)
+	if e, err := hex.DecodeString(expected); err != nil || !bytes.Equal(h[:], e) {
+		return fmt.Errorf("%w: got %x, expected %s", ErrDependencyHashMismatch, h, expected)
+	}
+	return nil
+}
+
+// resolve returns the directory to load the import path from along with
+// the remainder of the path within it. If the first element of the path
+// is the name of a remote dependency, its cached directory is returned.
+// Standard library imports are loaded from the embedded standard library
+// if it is set.
+func (d *fsDirectory) resolve(splits []string) (*fsDirectory, []string, error) {
+	if d.stdlib != nil && len(splits) > 1 && splits[0] == "std" {
+		return d.stdlib, splits, nil
+	}
+	if d.remote != nil && len(splits) > 1 {
+		dir, ok, err := d.remote.dependencyDir(splits[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			return dir, splits[1:], nil
+		}
+	}
+	if d.fileSystem == nil {
+		return nil, nil, errors.New("dependency directory not set")
+	}
+	return d, splits, nil
+}
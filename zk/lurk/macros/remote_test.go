@@ -0,0 +1,123 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteImports(t *testing.T) {
+	mod := `!(module math (
+			!(defun plus-two (x) (+ x 2))
+		))
+		`
+	h := sha256.Sum256([]byte(mod))
+
+	requests := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/math.lurk", "/ipfs/bafymath":
+			w.Write([]byte(mod))
+		case "/tampered.lurk":
+			w.Write([]byte(strings.Replace(mod, "2", "3", -1)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	tempDir, err := os.MkdirTemp("", "remote_import_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	lockfile := &Lockfile{Dependencies: map[string]LockedDependency{
+		"remote-math": {URL: ts.URL + "/math.lurk", Sha256: hex.EncodeToString(h[:])},
+		"ipfs-math":   {URL: "ipfs://bafymath", Sha256: hex.EncodeToString(h[:])},
+		"tampered":    {URL: ts.URL + "/tampered.lurk", Sha256: hex.EncodeToString(h[:])},
+		"upper-math":  {URL: ts.URL + "/math.lurk", Sha256: strings.ToUpper(hex.EncodeToString(h[:]))},
+	}}
+	lockfilePath := filepath.Join(tempDir, LockfileName)
+	assert.NoError(t, lockfile.Save(lockfilePath))
+
+	cacheDir := filepath.Join(tempDir, "cache")
+	mp, err := NewMacroPreprocessor(RemoteImports(lockfilePath, cacheDir), IPFSGateway(ts.URL+"/ipfs"))
+	assert.NoError(t, err)
+	mp.depDir.remote.client = ts.Client()
+
+	expected := "(letrec ((plus-two (lambda (x) (+ x 2)))) (plus-two 10))"
+	for _, program := range []string{"!(import remote-math/math)\n(plus-two 10)", "!(import ipfs-math/math/plus-two)\n(plus-two 10)"} {
+		lurkProgram, err := mp.Preprocess(program)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, strings.ReplaceAll(strings.Join(strings.Fields(lurkProgram), " "), " )", ")"))
+	}
+	assert.Equal(t, 2, requests)
+
+	// The second import is served from the cache.
+	_, err = mp.Preprocess("!(import remote-math/math)\n(plus-two 10)")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	_, err = mp.Preprocess("!(import tampered/math)\n(plus-two 10)")
+	assert.True(t, errors.Is(err, ErrDependencyHashMismatch))
+
+	// Upper case hashes match the same contents.
+	_, err = mp.Preprocess("!(import upper-math/math)\n(plus-two 10)")
+	assert.NoError(t, err)
+
+	// Without a dependency directory local imports fail.
+	_, err = mp.Preprocess("!(import math)\n(plus-two 10)")
+	assert.Error(t, err)
+}
+
+func TestLoadLockfile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "lockfile_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	h := sha256.Sum256([]byte("test"))
+	tests := []struct {
+		name  string
+		dep   LockedDependency
+		valid bool
+	}{
+		{"math", LockedDependency{URL: "https://example.com/math.lurk", Sha256: hex.EncodeToString(h[:])}, true},
+		{"math", LockedDependency{URL: "ipfs://bafymath", Sha256: hex.EncodeToString(h[:])}, true},
+		{"math", LockedDependency{URL: "http://example.com/math.lurk", Sha256: hex.EncodeToString(h[:])}, false},
+		{"math", LockedDependency{URL: "https://example.com/math.lurk", Sha256: "1234"}, false},
+		{"../math", LockedDependency{URL: "https://example.com/math.lurk", Sha256: hex.EncodeToString(h[:])}, false},
+	}
+	path := filepath.Join(tempDir, LockfileName)
+	for i, test := range tests {
+		lockfile := &Lockfile{Dependencies: map[string]LockedDependency{test.name: test.dep}}
+		assert.NoError(t, lockfile.Save(path))
+		loaded, err := LoadLockfile(path)
+		if test.valid {
+			assert.NoErrorf(t, err, "test %d", i)
+			assert.Equal(t, lockfile, loaded)
+		} else {
+			assert.Errorf(t, err, "test %d", i)
+		}
+	}
+
+	// Hashes are loaded in lower case.
+	lockfile := &Lockfile{Dependencies: map[string]LockedDependency{
+		"math": {URL: "https://example.com/math.lurk", Sha256: strings.ToUpper(hex.EncodeToString(h[:]))},
+	}}
+	assert.NoError(t, lockfile.Save(path))
+	loaded, err := LoadLockfile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(h[:]), loaded.Dependencies["math"].Sha256)
+}
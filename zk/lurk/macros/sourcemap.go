@@ -0,0 +1,171 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SourceLocation is the location of an expression in the original
+// lurk source.
+type SourceLocation struct {
+	// File is the dependency file containing the expression. It is
+	// empty if the expression is in the program passed to Preprocess.
+	File string `json:"file,omitempty"`
+	// Module is the name of the module containing the expression, if
+	// it was imported.
+	Module string `json:"module,omitempty"`
+	// Line and Column are one-indexed.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// String returns the location in the form file:line:column (module).
+func (l SourceLocation) String() string {
+	file := l.File
+	if file == "" {
+		file = "<program>"
+	}
+	s := fmt.Sprintf("%s:%d:%d", file, l.Line, l.Column)
+	if l.Module != "" {
+		s += fmt.Sprintf(" (%s)", l.Module)
+	}
+	return s
+}
+
+// SourceMapping maps a range of the preprocessed program to the
+// expression it was expanded from.
+type SourceMapping struct {
+	// Start and End are the byte offsets of the expression in the
+	// preprocessed program. End is exclusive.
+	Start int `json:"start"`
+	End   int `json:"end"`
+	// Source is the location of the expression in the original source.
+	Source SourceLocation `json:"source"`
+	// Message is the message of the assert if the expression is the
+	// value a failed assert with a message returns.
+	Message string `json:"message,omitempty"`
+}
+
+// SourceMap maps the expressions in a preprocessed lurk program back
+// to the files, modules and lines they originated from. Expressions
+// generated by a macro map to the macro invocation.
+type SourceMap struct {
+	// Mappings are ordered by their start offset. The ranges are
+	// nested in the same way as the expressions.
+	Mappings []SourceMapping `json:"mappings"`
+}
+
+// Lookup returns the location of the innermost expression containing
+// the byte offset in the preprocessed program. The bool is false if
+// no expression contains the offset.
+func (m *SourceMap) Lookup(offset int) (SourceLocation, bool) {
+	var (
+		loc   SourceLocation
+		found bool
+		width int
+	)
+	// Mappings starting after the offset cannot contain it.
+	n := sort.Search(len(m.Mappings), func(i int) bool {
+		return m.Mappings[i].Start > offset
+	})
+	for _, mapping := range m.Mappings[:n] {
+		if offset < mapping.End && (!found || mapping.End-mapping.Start <= width) {
+			loc, found, width = mapping.Source, true, mapping.End-mapping.Start
+		}
+	}
+	return loc, found
+}
+
+// AssertionMessage returns the message of the assert whose failing
+// branch is at the byte offset in the preprocessed program. The bool is
+// false if there is no failing branch of an assert with a message at
+// the offset.
+func (m *SourceMap) AssertionMessage(offset int) (string, bool) {
+	for _, mapping := range m.Mappings {
+		if mapping.Start == offset && mapping.Message != "" {
+			return mapping.Message, true
+		}
+	}
+	return "", false
+}
+
+// LookupExpression returns the location of the first expression in the
+// preprocessed program which matches expr. This is useful to locate an
+// expression reported by the prover which is printed without an offset.
+func (m *SourceMap) LookupExpression(program, expr string) (SourceLocation, bool) {
+	nodes, err := parse(&source{text: expr})
+	if err != nil || len(nodes) != 1 {
+		return SourceLocation{}, false
+	}
+	want := serialize(stripComments(nodes, nil))
+	for _, mapping := range m.Mappings {
+		if mapping.End-mapping.Start == len(want) && program[mapping.Start:mapping.End] == want {
+			return mapping.Source, true
+		}
+	}
+	return SourceLocation{}, false
+}
+
+// sourceMapBuilder records the output range of each node written by
+// the serializer.
+type sourceMapBuilder struct {
+	mappings []SourceMapping
+
+	// lineStarts caches the offsets of the lines in each source.
+	lineStarts map[*source][]int
+}
+
+func (b *sourceMapBuilder) add(n *node, start, end int) {
+	if n.src == nil {
+		return
+	}
+	line, column := b.position(n.src, n.pos)
+	b.mappings = append(b.mappings, SourceMapping{
+		Start: start,
+		End:   end,
+		Source: SourceLocation{
+			File:   n.src.file,
+			Module: n.module,
+			Line:   line,
+			Column: column,
+		},
+		Message: n.message,
+	})
+}
+
+// position returns the one-indexed line and column of the offset in
+// the source.
+func (b *sourceMapBuilder) position(src *source, offset int) (int, int) {
+	if b.lineStarts == nil {
+		b.lineStarts = make(map[*source][]int)
+	}
+	starts, ok := b.lineStarts[src]
+	if !ok {
+		starts = []int{0}
+		for i := strings.IndexByte(src.text, '\n'); i >= 0; {
+			starts = append(starts, starts[len(starts)-1]+i+1)
+			i = strings.IndexByte(src.text[starts[len(starts)-1]:], '\n')
+		}
+		b.lineStarts[src] = starts
+	}
+	line := sort.Search(len(starts), func(i int) bool { return starts[i] > offset })
+	return line, offset - starts[line-1] + 1
+}
+
+// sourceMap returns the source map with the mappings ordered by their
+// start offset. Nodes are recorded once they are written, so enclosing
+// nodes are recorded after their children.
+func (b *sourceMapBuilder) sourceMap() *SourceMap {
+	sort.SliceStable(b.mappings, func(i, j int) bool {
+		if b.mappings[i].Start != b.mappings[j].Start {
+			return b.mappings[i].Start < b.mappings[j].Start
+		}
+		return b.mappings[i].End > b.mappings[j].End
+	})
+	return &SourceMap{Mappings: b.mappings}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+const (
+	// PassConditionals is the pass expanding the ifdef and ifndef macros.
+	// It also removes the test macros.
+	PassConditionals = "conditionals"
+	// PassEliminateDeadCode is the pass removing unused imported
+	// definitions when EliminateDeadCode is set.
+	PassEliminateDeadCode = "eliminate-dead-code"
+	// PassFoldConstants is the pass folding constant expressions when
+	// FoldConstants is set.
+	PassFoldConstants = "fold-constants"
+)
+
+// macroPasses are the macros expanded by each pass in the order they
+// are expanded. The pass is named after its first macro.
+var macroPasses = [][]Macro{
+	{Def},
+	{Defrec},
+	{Defun},
+	{Assert, AssertEq},
+	{List, Append, Splice},
+	{Param},
+	{CheckSig},
+}
+
+// ExpansionStep is the program after a pass of the preprocessor.
+type ExpansionStep struct {
+	// Pass is the name of the pass. The passes expanding macros are
+	// named after the macro, for example import or def. The assert pass
+	// expands both assert and assert-eq and the list pass expands list,
+	// append and splice.
+	Pass string `json:"pass"`
+	// Program is the program after the pass.
+	Program string `json:"program"`
+}
+
+// PreprocessSteps expands the macros in the lurk program like
+// Preprocess, one pass at a time, and returns the program after each
+// pass so that the intermediate states can be inspected. The passes
+// are, in order, conditionals, import, eliminate-dead-code if enabled,
+// def, defrec, defun, assert, list, param, check-sig and fold-constants
+// if enabled. The program after the last pass is the same as that
+// returned by Preprocess.
+//
+// Macros are left in place until their pass, so the intermediate
+// programs are not valid lurk. If a pass fails the steps up to that
+// pass are returned along with the error.
+func (p *MacroPreprocessor) PreprocessSteps(lurkProgram string) ([]ExpansionStep, error) {
+	var steps []ExpansionStep
+	_, err := p.expandSteps(lurkProgram, p.depDir, func(pass string, nodes []*node) {
+		steps = append(steps, ExpansionStep{
+			Pass:    pass,
+			Program: serialize(p.stripComments(nodes)),
+		})
+	})
+	if err != nil {
+		return steps, err
+	}
+	if len(steps) > 0 {
+		if err := p.limits.checkOutputSize(steps[len(steps)-1].Program); err != nil {
+			return steps, err
+		}
+	}
+	return steps, nil
+}
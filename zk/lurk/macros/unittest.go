@@ -0,0 +1,220 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// LurkTest is a unit test declared with !(test name expr expected).
+type LurkTest struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+	// Location is where the test is declared.
+	Location SourceLocation `json:"location"`
+	// Program is the preprocessed lurk program which evaluates to t
+	// if the test passes. It takes no parameters.
+	Program string `json:"program"`
+}
+
+// removeTests removes the !(test ...) macros from the program. Tests
+// may only be declared at the top level of a program or of a module
+// body. They are removed when a file is parsed so that they are never
+// part of a preprocessed program or an imported module.
+func removeTests(nodes []*node) ([]*node, error) {
+	return transformNodes(withoutTests(nodes), func(n *node) ([]*node, bool, error) {
+		if n.isMacro(Test) {
+			return nil, false, n.errorf(ErrInvalidMacro, "test is only valid at the top level of a program or module")
+		}
+		if !n.isMacro(Module) {
+			return nil, false, nil
+		}
+		args := n.args()
+		if len(args) != 2 || args[1].typ != listNode {
+			return nil, false, nil
+		}
+		body, err := removeTests(args[1].children)
+		if err != nil {
+			return nil, false, err
+		}
+		moduleBody := *args[1]
+		moduleBody.children = body
+		module := *n
+		module.children = make([]*node, len(n.children))
+		for i, child := range n.children {
+			if child == args[1] {
+				child = &moduleBody
+			}
+			module.children[i] = child
+		}
+		return []*node{&module}, true, nil
+	})
+}
+
+// withoutTests returns the nodes excluding the test macros.
+func withoutTests(nodes []*node) []*node {
+	ret := make([]*node, 0, len(nodes))
+	for _, n := range nodes {
+		if !n.isMacro(Test) {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// Tests returns the unit tests declared in the lurk program, which may
+// be a program or a file of modules. A test takes the form:
+//
+//	!(test name expr expected)
+//
+// and passes if expr evaluates to the same value as expected. Tests may
+// be declared at the top level of a program or of a module body, and
+// may use the definitions and imports which precede them there.
+//
+// The program of each test is expanded like Preprocess, with the
+// dependency directory and defines of the preprocessor.
+func (p *MacroPreprocessor) Tests(lurkProgram string) ([]LurkTest, error) {
+	return p.tests(&source{text: lurkProgram})
+}
+
+// DependencyTests returns the unit tests declared in all the lurk files
+// in the dependency directory, including those in subdirectories. With
+// WithStandardLib these are the tests of the standard library.
+func (p *MacroPreprocessor) DependencyTests() ([]LurkTest, error) {
+	if p.depDir == nil || p.depDir.fileSystem == nil {
+		return nil, errors.New("dependency directory not set")
+	}
+	root := p.depDir.path
+	if root == "" {
+		root = "."
+	}
+	var tests []LurkTest
+	err := fs.WalkDir(p.depDir.fileSystem, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(name) != LurkFileExtension {
+			return nil
+		}
+		content, err := fs.ReadFile(p.depDir.fileSystem, name)
+		if err != nil {
+			return err
+		}
+		fileTests, err := p.tests(&source{file: name, text: string(content)})
+		if err != nil {
+			return err
+		}
+		tests = append(tests, fileTests...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tests, nil
+}
+
+func (p *MacroPreprocessor) tests(src *source) ([]LurkTest, error) {
+	nodes, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err = expandConditionals(nodes, p.defines)
+	if err != nil {
+		return nil, err
+	}
+	// Check the tests are all declared where they are allowed.
+	if _, err := removeTests(nodes); err != nil {
+		return nil, err
+	}
+
+	tests, err := p.collectTests(nodes, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nodes {
+		if !n.isMacro(Module) {
+			continue
+		}
+		args := n.args()
+		if len(args) != 2 || args[0].typ != atomNode || args[1].typ != listNode {
+			return nil, n.errorf(ErrInvalidMacro, "module must take the form !(module name (body))")
+		}
+		moduleTests, err := p.collectTests(args[1].children, args[0].value)
+		if err != nil {
+			return nil, err
+		}
+		tests = append(tests, moduleTests...)
+	}
+	return tests, nil
+}
+
+// collectTests returns the tests in the sequence of nodes. Each test
+// program is the definitions and imports preceding the test followed
+// by (eq expr expected).
+func (p *MacroPreprocessor) collectTests(nodes []*node, module string) ([]LurkTest, error) {
+	var (
+		tests   []LurkTest
+		context []*node
+	)
+	for _, n := range nodes {
+		if n.isMacro(Def) || n.isMacro(Defrec) || n.isMacro(Defun) || n.isMacro(Import) {
+			context = append(context, n)
+			continue
+		}
+		if !n.isMacro(Test) {
+			continue
+		}
+		args := n.args()
+		if len(args) != 3 || args[0].typ != atomNode {
+			return nil, n.errorf(ErrInvalidMacro, "expected a name, an expression and the expected value")
+		}
+		program := make([]*node, len(context), len(context)+1)
+		copy(program, context)
+		program = append(program, newList(newAtom("eq"), args[1], args[2]).withLocation(n))
+
+		expanded, err := p.expandTest(program)
+		if err != nil {
+			return nil, n.wrapError(ErrInvalidMacro, err)
+		}
+		line, column := sourcePosition(n.src.text, n.pos)
+		tests = append(tests, LurkTest{
+			Name: strings.Trim(args[0].value, "\""),
+			Location: SourceLocation{
+				File:   n.src.file,
+				Module: module,
+				Line:   line,
+				Column: column,
+			},
+			Program: expanded,
+		})
+	}
+	return tests, nil
+}
+
+// expandTest expands the macros in the test program.
+func (p *MacroPreprocessor) expandTest(nodes []*node) (string, error) {
+	nodes, err := resolveImports(nodes, p.depDir, p.defines, p.newBudget())
+	if err != nil {
+		return "", err
+	}
+	if p.eliminateDeadCode {
+		nodes = eliminateDeadCode(nodes)
+	}
+	nodes, err = newExpander(nodes, nil, p.layout).expandMacros(nodes)
+	if err != nil {
+		return "", err
+	}
+	if p.foldConstants {
+		nodes = foldConstants(nodes)
+	}
+	output := serialize(p.stripComments(nodes))
+	if err := p.limits.checkOutputSize(output); err != nil {
+		return "", err
+	}
+	return output, nil
+}
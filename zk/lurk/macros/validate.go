@@ -43,15 +43,33 @@ func (c *customStack) Empty() bool {
 	return c.stack.Len() == 0
 }
 
+// IsValidLurk returns whether the brackets in the lurk program are
+// balanced. Brackets inside literals and comments are ignored.
 func IsValidLurk(s string) bool {
 	customStack := &customStack{
 		stack: list.New(),
 	}
 
-	for _, val := range s {
-
-		if val == '(' || val == '[' || val == '{' {
-			customStack.Push(string(val))
+	for i := 0; i < len(s); i++ {
+		if n := literalLen(s, i); n > 0 {
+			i += n - 1
+			continue
+		}
+		if s[i] == '#' && i+1 < len(s) && s[i+1] == '|' {
+			n, closed := blockCommentLen(s, i)
+			if !closed {
+				return false
+			}
+			i += n - 1
+			continue
+		}
+		val := s[i]
+		if val == ';' {
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		} else if val == '(' || val == '[' || val == '{' {
+			customStack.Push(string(rune(val)))
 		} else if val == ')' {
 			poppedValue, _ := customStack.Front()
 			if poppedValue != "(" {
@@ -0,0 +1,172 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// LurkManifestName is the name of the manifest file at the root of a
+// dependency archive.
+const LurkManifestName = "lurk.json"
+
+// ErrAmbiguousImport is returned when a versioned import matches more
+// than one versioned directory or archive.
+var ErrAmbiguousImport = errors.New("ambiguous import")
+
+// Manifest describes the library in a dependency archive. It allows
+// versioned imports to be resolved against archives regardless of
+// their file names.
+type Manifest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// splitVersion splits an import path element of the form name@version.
+// The bool is false if the element is not versioned.
+func splitVersion(elem string) (string, string, bool) {
+	return strings.Cut(elem, "@")
+}
+
+// moduleDir returns the dependency directory and the path within it of
+// the directory containing the module at the import path. The import
+// path is the directory elements followed by the module name.
+//
+// Any of the elements may be pinned to a version, as in
+// lib@v1.2.0/math or math@v1.2.0. A versioned directory element is
+// replaced by the versioned directory. A versioned module is loaded
+// from the files in its versioned directory. The versioned directory
+// of name@version is one of:
+//
+//	name@version/
+//	name/version/
+//	an archive whose manifest has the name and version
+//
+// If more than one of these exist the import is ambiguous and an error
+// is returned rather than picking one, so that the module a script
+// commits to does not depend on which copies happen to be installed.
+func (d *fsDirectory) moduleDir(dirSplits []string, module string) (*fsDirectory, string, string, error) {
+	var (
+		dir     = d
+		dirPath = d.path
+		err     error
+	)
+	for _, elem := range dirSplits {
+		name, version, ok := splitVersion(elem)
+		if !ok {
+			dirPath = filepath.Join(dirPath, elem)
+			continue
+		}
+		dir, dirPath, err = dir.versionedDir(dirPath, name, version)
+		if err != nil {
+			return nil, "", "", err
+		}
+	}
+	name, version, ok := splitVersion(module)
+	if ok {
+		dir, dirPath, err = dir.versionedDir(dirPath, name, version)
+		if err != nil {
+			return nil, "", "", err
+		}
+	}
+	return dir, dirPath, name, nil
+}
+
+// versionedDir returns the versioned directory of name@version within
+// the directory at the path.
+func (d *fsDirectory) versionedDir(dirPath, name, version string) (*fsDirectory, string, error) {
+	if name == "" || version == "" || strings.Contains(version, "@") {
+		return nil, "", fmt.Errorf("invalid versioned import %s@%s", name, version)
+	}
+	entries, err := fs.ReadDir(d.fileSystem, dirPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	type candidate struct {
+		dir  *fsDirectory
+		path string
+	}
+	var (
+		candidates []candidate
+		found      []string
+	)
+	for _, entry := range entries {
+		entryPath := filepath.Join(dirPath, entry.Name())
+		switch {
+		case entry.IsDir() && entry.Name() == name+"@"+version:
+			candidates = append(candidates, candidate{d, entryPath})
+			found = append(found, entryPath)
+		case entry.IsDir() && entry.Name() == name:
+			versions, err := fs.ReadDir(d.fileSystem, entryPath)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, v := range versions {
+				if v.IsDir() && v.Name() == version {
+					candidates = append(candidates, candidate{d, filepath.Join(entryPath, v.Name())})
+					found = append(found, filepath.Join(entryPath, v.Name()))
+				}
+			}
+		case !entry.IsDir() && isArchiveName(entry.Name()):
+			archive, manifest, err := d.openVersionedArchive(entryPath)
+			if err != nil {
+				return nil, "", err
+			}
+			if manifest != nil && manifest.Name == name && manifest.Version == version {
+				candidates = append(candidates, candidate{&fsDirectory{fileSystem: archive, path: "."}, "."})
+				found = append(found, entryPath)
+			}
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, "", fmt.Errorf("version %s of %s not found", version, name)
+	case 1:
+		return candidates[0].dir, candidates[0].path, nil
+	}
+	return nil, "", fmt.Errorf("%w %s@%s: found %s", ErrAmbiguousImport, name, version, strings.Join(found, ", "))
+}
+
+// openVersionedArchive opens the archive at the path in the dependency
+// directory and returns its manifest, or nil if it has none.
+func (d *fsDirectory) openVersionedArchive(archivePath string) (fs.FS, *Manifest, error) {
+	data, err := fs.ReadFile(d.fileSystem, archivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	archive, err := openArchiveData(archivePath, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err = fs.ReadFile(archive, LurkManifestName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return archive, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	manifest := new(Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, nil, fmt.Errorf("invalid manifest in archive %s: %w", archivePath, err)
+	}
+	return archive, manifest, nil
+}
+
+// isArchiveName returns whether the file name has the extension of a
+// dependency archive.
+func isArchiveName(name string) bool {
+	for _, ext := range []string{LurkArchiveExtension, ".zip", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
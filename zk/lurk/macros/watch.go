@@ -0,0 +1,275 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package macros
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultWatchInterval is the default interval at which Watch checks
+// the dependency directory for changes.
+const DefaultWatchInterval = 500 * time.Millisecond
+
+// WatchEvent is passed to the Watch callback each time an entry program
+// is preprocessed after a change.
+type WatchEvent struct {
+	// Entry is the name the program was registered under.
+	Entry string
+	// Program is the preprocessed program. It is empty if Err is set.
+	Program string
+	// Err is the error preprocessing the program, if any.
+	Err error
+	// Changed are the .lurk files, relative to the dependency directory,
+	// which were added, modified or removed. Registered files outside
+	// the directory are included as they were registered.
+	Changed []string
+}
+
+// Watcher monitors a dependency directory and preprocesses the
+// registered entry programs whenever a .lurk file changes.
+type Watcher struct {
+	dir      string
+	p        *MacroPreprocessor
+	callback func(WatchEvent)
+	interval time.Duration
+
+	entries map[string]watchEntry
+	hashes  map[string][32]byte
+	mtx     sync.Mutex
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// watchEntry is a registered program. If file is set the program is
+// read from the file each time it is preprocessed.
+type watchEntry struct {
+	program string
+	file    string
+}
+
+// Watch monitors the dependency directory for changes to .lurk files,
+// including those in subdirectories. When a file is added, modified or
+// removed the programs registered with Add and AddFile are preprocessed
+// again and the callback is called with the result for each of them.
+//
+// The directory is used as the DependencyDir. The options configure
+// the preprocessor and the WatchInterval. The directory is polled
+// rather than relying on file system notifications so the watcher
+// works the same on all platforms.
+//
+// The callback is called from the watcher's goroutine, one event at a
+// time. Close must be called to stop watching.
+func Watch(dir string, callback func(WatchEvent), opts ...Option) (*Watcher, error) {
+	if callback == nil {
+		return nil, errors.New("callback is nil")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New("watch path is not a directory")
+	}
+
+	cfg := config{watchInterval: DefaultWatchInterval}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	p, err := NewMacroPreprocessor(append(opts, DependencyDir(dir))...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		dir:      dir,
+		p:        p,
+		callback: callback,
+		interval: cfg.watchInterval,
+		entries:  make(map[string]watchEntry),
+		done:     make(chan struct{}),
+	}
+	w.hashes = w.snapshot()
+
+	w.wg.Add(1)
+	go w.run()
+	return w, nil
+}
+
+// WatchInterval sets the interval at which Watch checks the dependency
+// directory for changes. The default is DefaultWatchInterval. It has
+// no effect on the MacroPreprocessor.
+func WatchInterval(interval time.Duration) Option {
+	return func(cfg *config) error {
+		if interval <= 0 {
+			return errors.New("watch interval must be positive")
+		}
+		cfg.watchInterval = interval
+		return nil
+	}
+}
+
+// Add registers the program under the name, replacing any program
+// already registered under it, and returns the preprocessed program.
+// The program is registered even if it cannot be preprocessed so that
+// it is preprocessed again once the dependencies are fixed.
+func (w *Watcher) Add(name, program string) (string, error) {
+	return w.add(name, watchEntry{program: program})
+}
+
+// AddFile registers the program in the file under the file's path and
+// returns the preprocessed program. The file is read each time it is
+// preprocessed and is watched for changes along with the dependency
+// directory.
+func (w *Watcher) AddFile(path string) (string, error) {
+	return w.add(path, watchEntry{file: path})
+}
+
+// Remove unregisters the program with the name.
+func (w *Watcher) Remove(name string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if entry, ok := w.entries[name]; ok && entry.file != "" {
+		delete(w.hashes, entry.file)
+	}
+	delete(w.entries, name)
+}
+
+// Close stops watching the directory. The callback is not called after
+// Close returns.
+func (w *Watcher) Close() {
+	close(w.done)
+	w.wg.Wait()
+}
+
+func (w *Watcher) add(name string, entry watchEntry) (string, error) {
+	w.mtx.Lock()
+	w.entries[name] = entry
+	if entry.file != "" {
+		w.hashes[entry.file] = hashWatchedFile(entry.file)
+	}
+	w.mtx.Unlock()
+	return w.preprocess(entry)
+}
+
+func (w *Watcher) preprocess(entry watchEntry) (string, error) {
+	program := entry.program
+	if entry.file != "" {
+		data, err := os.ReadFile(entry.file)
+		if err != nil {
+			return "", err
+		}
+		program = string(data)
+	}
+	return w.p.Preprocess(program)
+}
+
+func (w *Watcher) run() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.poll()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// poll preprocesses the entries if any of the watched files changed
+// since the last poll.
+func (w *Watcher) poll() {
+	hashes := w.snapshot()
+
+	w.mtx.Lock()
+	var changed []string
+	for path, hash := range hashes {
+		if prev, ok := w.hashes[path]; !ok || prev != hash {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.hashes {
+		if _, ok := hashes[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	w.hashes = hashes
+	names := make([]string, 0, len(w.entries))
+	entries := make(map[string]watchEntry, len(w.entries))
+	for name, entry := range w.entries {
+		names = append(names, name)
+		entries[name] = entry
+	}
+	w.mtx.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+	sort.Strings(changed)
+	sort.Strings(names)
+	for _, name := range names {
+		select {
+		case <-w.done:
+			return
+		default:
+		}
+		program, err := w.preprocess(entries[name])
+		w.callback(WatchEvent{
+			Entry:   name,
+			Program: program,
+			Err:     err,
+			Changed: changed,
+		})
+	}
+}
+
+// snapshot returns the hashes of the .lurk files in the directory,
+// keyed by their path relative to it, and of the registered files,
+// keyed by the path they were registered with.
+func (w *Watcher) snapshot() map[string][32]byte {
+	hashes := make(map[string][32]byte)
+	filepath.WalkDir(w.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, LurkFileExtension) {
+			return nil
+		}
+		rel, err := filepath.Rel(w.dir, path)
+		if err != nil {
+			return nil
+		}
+		hashes[filepath.ToSlash(rel)] = hashWatchedFile(path)
+		return nil
+	})
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for _, entry := range w.entries {
+		if entry.file != "" {
+			hashes[entry.file] = hashWatchedFile(entry.file)
+		}
+	}
+	return hashes
+}
+
+// hashWatchedFile returns the hash of the file's contents, or the zero
+// hash if it cannot be read.
+func hashWatchedFile(path string) [32]byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return [32]byte{}
+	}
+	return sha256.Sum256(data)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+)
+
+// RunLurkTest evaluates the lurk unit test, declared with the
+// !(test name expr expected) macro, and returns an error if it
+// fails or cannot be evaluated.
+func RunLurkTest(test macros.LurkTest) error {
+	// The test program takes no parameters but Eval applies
+	// the program to the private and public params.
+	program := fmt.Sprintf("(lambda (private-params public-params) %s)", test.Program)
+	tag, output, _, err := Eval(program, Expr("nil"), Expr("nil"))
+	if err != nil {
+		return fmt.Errorf("test %s at %s: %w", test.Name, formatTestLocation(test.Location), err)
+	}
+	if tag != TagSym || !bytes.Equal(output, OutputTrue) {
+		return fmt.Errorf("test %s at %s failed", test.Name, formatTestLocation(test.Location))
+	}
+	return nil
+}
+
+// RunLurkTests preprocesses and evaluates the unit tests in the lurk
+// program, which may be a program or a file of modules, and returns
+// the errors of the tests which failed.
+func RunLurkTests(lurkProgram string, opts ...macros.Option) ([]error, error) {
+	mp, err := macros.NewMacroPreprocessor(opts...)
+	if err != nil {
+		return nil, err
+	}
+	tests, err := mp.Tests(lurkProgram)
+	if err != nil {
+		return nil, err
+	}
+	var failures []error
+	for _, test := range tests {
+		if err := RunLurkTest(test); err != nil {
+			failures = append(failures, err)
+		}
+	}
+	return failures, nil
+}
+
+func formatTestLocation(loc macros.SourceLocation) string {
+	if loc.File == "" {
+		return fmt.Sprintf("line %d, column %d", loc.Line, loc.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", loc.File, loc.Line, loc.Column)
+}
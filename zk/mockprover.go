@@ -0,0 +1,79 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+)
+
+// mockProverNetwork is the only network the mock prover may be
+// enabled on.
+const mockProverNetwork = "regtest"
+
+// ErrMockProverNotAllowed is returned when enabling the mock prover
+// on a network other than regtest.
+var ErrMockProverNotAllowed = errors.New("mock prover may only be enabled on regtest")
+
+var mockProver atomic.Bool
+
+// EnableMockProver switches proving to the mock prover. Rather than
+// creating real proofs, which can take minutes, the mock prover returns
+// fake proofs derived deterministically from the program and public
+// params, and the verifier accepts the fake proofs in place of real
+// ones. This lets the harness and integration tests exercise all the
+// code paths around proving without the cost.
+//
+// The networkName is the name of the network params and must be
+// regtest. Fake proofs are not sound so the mock prover must never be
+// used on a real network.
+func EnableMockProver(networkName string) error {
+	if networkName != mockProverNetwork {
+		return ErrMockProverNotAllowed
+	}
+	mockProver.Store(true)
+	return nil
+}
+
+// DisableMockProver switches proving back to the real prover.
+func DisableMockProver() {
+	mockProver.Store(false)
+}
+
+// MockProverEnabled returns whether the mock prover is enabled.
+func MockProverEnabled() bool {
+	return mockProver.Load()
+}
+
+// mockProof returns the fake proof of the program for the public
+// params. It is MockProofSize bytes long.
+func mockProof(lurkProgram string, publicParams string) []byte {
+	seed := sha256.New()
+	seed.Write([]byte("illium-mock-proof"))
+	seed.Write([]byte(lurkProgram))
+	seed.Write([]byte(publicParams))
+	sum := seed.Sum(nil)
+
+	proof := make([]byte, 0, MockProofSize+sha256.Size)
+	counter := make([]byte, 8)
+	for i := uint64(0); len(proof) < MockProofSize; i++ {
+		binary.BigEndian.PutUint64(counter, i)
+		h := sha256.Sum256(append(sum, counter...))
+		proof = append(proof, h[:]...)
+	}
+	return proof[:MockProofSize]
+}
+
+// mockSnark returns the fake proof of the circuit's public params.
+func mockSnark(publicParams interface{}) ([]byte, error) {
+	pub, err := json.Marshal(publicParams)
+	if err != nil {
+		return nil, err
+	}
+	return mockProof("", string(pub)), nil
+}
@@ -0,0 +1,39 @@
+package zk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMockProver(t *testing.T) {
+	assert.ErrorIs(t, EnableMockProver("mainnet"), ErrMockProverNotAllowed)
+	assert.False(t, MockProverEnabled())
+
+	assert.NoError(t, EnableMockProver("regtest"))
+	defer DisableMockProver()
+	assert.True(t, MockProverEnabled())
+
+	program := "(lambda (priv pub) t)"
+	proof, err := Prove(program, Expr("nil"), Expr("(cons 1 nil)"))
+	assert.NoError(t, err)
+	assert.Len(t, proof, MockProofSize)
+
+	proof2, err := Prove(program, Expr("(cons 2 nil)"), Expr("(cons 1 nil)"))
+	assert.NoError(t, err)
+	assert.Equal(t, proof, proof2)
+
+	valid, err := Verify(program, Expr("(cons 1 nil)"), proof)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = Verify(program, Expr("(cons 2 nil)"), proof)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	circuit := func(privateParams, publicParams interface{}) bool { return true }
+	snark, err := CreateSnark(circuit, nil, []byte{0x01})
+	assert.NoError(t, err)
+	snark2, err := CreateSnark(circuit, nil, []byte{0x01})
+	assert.NoError(t, err)
+	assert.Equal(t, snark, snark2)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	icrypto "github.com/project-illium/ilxd/crypto"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrNoMultisigKeys is returned when a multisig has no keys.
+	ErrNoMultisigKeys = errors.New("multisig has no keys")
+
+	// ErrInvalidThreshold is returned when the threshold is zero or
+	// greater than the number of keys.
+	ErrInvalidThreshold = errors.New("invalid multisig threshold")
+
+	// ErrDuplicateMultisigKey is returned when a key appears in a
+	// multisig more than once.
+	ErrDuplicateMultisigKey = errors.New("duplicate multisig key")
+
+	// ErrNotNovaKey is returned when a multisig key is not a Nova
+	// public key.
+	ErrNotNovaKey = errors.New("key is not a nova public key")
+
+	// ErrInvalidSignatureLen is returned when a signature is not 64 bytes.
+	ErrInvalidSignatureLen = errors.New("invalid signature len")
+
+	// ErrSignatureNoMatch is returned when a signature is not valid for
+	// any of the multisig keys.
+	ErrSignatureNoMatch = errors.New("signature does not match any key")
+
+	// ErrDuplicateSignature is returned when more than one signature
+	// matches the same key.
+	ErrDuplicateSignature = errors.New("duplicate signature for key")
+)
+
+// MultisigSignatureError identifies the signature which could not be
+// used to build the multisig unlocking params.
+type MultisigSignatureError struct {
+	// Index is the index of the signature in the slice passed in.
+	Index int
+	// Err is the reason the signature was rejected.
+	Err error
+}
+
+// Error returns the error as a string.
+func (e *MultisigSignatureError) Error() string {
+	return fmt.Sprintf("multisig signature %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MultisigSignatureError) Unwrap() error {
+	return e.Err
+}
+
+// SortMultisigKeys sorts the keys into the canonical order used in
+// the multisig locking params. This makes the locking params, and
+// hence the address, independent of the order the keys are provided in.
+func SortMultisigKeys(pubkeys []crypto.PubKey) error {
+	xy := make(map[crypto.PubKey][]byte, len(pubkeys))
+	for _, key := range pubkeys {
+		novaKey, ok := key.(*icrypto.NovaPublicKey)
+		if !ok {
+			return ErrNotNovaKey
+		}
+		x, y := novaKey.ToXY()
+		xy[key] = append(x, y...)
+	}
+	sort.SliceStable(pubkeys, func(i, j int) bool {
+		return bytes.Compare(xy[pubkeys[i]], xy[pubkeys[j]]) < 0
+	})
+	return nil
+}
+
+// MakeMultisigLockingParams returns the locking params of a multisig
+// requiring threshold signatures from the keys. Any number of Nova keys
+// may be used. They are sorted into canonical order so the same set of
+// keys always produces the same locking params.
+func MakeMultisigLockingParams(threshold int, pubkeys []crypto.PubKey) ([][]byte, error) {
+	if len(pubkeys) == 0 {
+		return nil, ErrNoMultisigKeys
+	}
+	if threshold < 1 || threshold > len(pubkeys) {
+		return nil, ErrInvalidThreshold
+	}
+	keys := make([]crypto.PubKey, len(pubkeys))
+	copy(keys, pubkeys)
+	if err := SortMultisigKeys(keys); err != nil {
+		return nil, err
+	}
+
+	thresholdBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(thresholdBytes, uint32(threshold))
+	params := [][]byte{thresholdBytes}
+	for i, key := range keys {
+		x, y := key.(*icrypto.NovaPublicKey).ToXY()
+		if i > 0 && bytes.Equal(x, params[len(params)-2]) && bytes.Equal(y, params[len(params)-1]) {
+			return nil, ErrDuplicateMultisigKey
+		}
+		params = append(params, x, y)
+	}
+	return params, nil
+}
+
+// MakeMultisigUnlockingParams returns the unlocking params of the
+// multisig script. The keys must be in the order they appear in the
+// locking params. The signatures may be in any order, each is matched
+// against the key it is valid for.
+//
+// If a signature cannot be used a *MultisigSignatureError is returned
+// identifying it.
+func MakeMultisigUnlockingParams(pubkeys []crypto.PubKey, sigs [][]byte, sigHash []byte) (string, error) {
+	if len(pubkeys) == 0 {
+		return "", ErrNoMultisigKeys
+	}
+
+	keySigs := make([][]byte, len(pubkeys))
+	for i, sig := range sigs {
+		if len(sig) != 64 {
+			return "", &MultisigSignatureError{Index: i, Err: ErrInvalidSignatureLen}
+		}
+		matched := false
+		for k, key := range pubkeys {
+			valid, err := key.Verify(sigHash, sig)
+			if err != nil {
+				return "", &MultisigSignatureError{Index: i, Err: err}
+			}
+			if !valid {
+				continue
+			}
+			if keySigs[k] != nil {
+				return "", &MultisigSignatureError{Index: i, Err: ErrDuplicateSignature}
+			}
+			keySigs[k] = sig
+			matched = true
+			break
+		}
+		if !matched {
+			return "", &MultisigSignatureError{Index: i, Err: ErrSignatureNoMatch}
+		}
+	}
+
+	// The script walks the key selector and signatures together so the
+	// signatures must be listed in key order.
+	var selector, sigList strings.Builder
+	closing := 0
+	for _, sig := range keySigs {
+		if sig == nil {
+			selector.WriteString("(cons 0 ")
+			continue
+		}
+		selector.WriteString("(cons 1 ")
+		sigRx, sigRy, sigS := icrypto.UnmarshalSignature(sig)
+		sigList.WriteString(fmt.Sprintf("(cons (cons 0x%x (cons 0x%x (cons 0x%x nil))) ", sigRx, sigRy, sigS))
+		closing++
+	}
+	selector.WriteString("nil")
+	selector.WriteString(strings.Repeat(")", len(keySigs)))
+	sigList.WriteString("nil")
+	sigList.WriteString(strings.Repeat(")", closing))
+
+	return "(cons " + selector.String() + " " + sigList.String() + ")", nil
+}
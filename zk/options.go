@@ -0,0 +1,71 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import "errors"
+
+// Option is configuration option function for the prover pool
+type Option func(cfg *config) error
+
+// ProverWorkers is the maximum number of proofs that will be created
+// concurrently. Proving is CPU and memory intensive so this bounds the
+// resources used no matter how many callers are proving at once.
+//
+// The default is 1.
+func ProverWorkers(n int) Option {
+	return func(cfg *config) error {
+		if n <= 0 {
+			return errors.New("prover workers must be greater than zero")
+		}
+		cfg.workers = n
+		return nil
+	}
+}
+
+// ProverQueueSize is the maximum number of proofs that may be waiting
+// for a worker. When the queue is full new proofs are rejected with
+// ErrProverQueueFull.
+//
+// The default is 100.
+func ProverQueueSize(n int) Option {
+	return func(cfg *config) error {
+		if n < 0 {
+			return errors.New("prover queue size must not be negative")
+		}
+		cfg.queueSize = n
+		return nil
+	}
+}
+
+// ProverBackend is the Prover used to create the snarks submitted to
+// the pool, such as a remote prover service. The pool still bounds the
+// number of proofs in flight. Lurk programs passed to Prove are always
+// proven locally.
+//
+// The default is to create the snarks locally.
+func ProverBackend(prover Prover) Option {
+	return func(cfg *config) error {
+		if _, ok := prover.(*SnarkProver); ok {
+			// The SnarkProver proves with the shared pool
+			// so it is the same as proving locally.
+			prover = nil
+		}
+		cfg.backend = prover
+		return nil
+	}
+}
+
+type config struct {
+	workers   int
+	queueSize int
+	backend   Prover
+}
+
+func defaultConfig() *config {
+	return &config{
+		workers:   defaultProverWorkers,
+		queueSize: defaultProverQueueSize,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// ParamsCacheDirName is the name of the directory in the data
+	// directory where the public parameters are cached.
+	ParamsCacheDirName = "zkparams"
+
+	// PublicParamsID identifies the circuit the public parameters are
+	// generated for. It must be changed whenever the circuit changes,
+	// such as the reduction count or the coprocessors, so that the
+	// parameters of a previous circuit are never loaded from the cache.
+	PublicParamsID = "supernova-rc10-and-or-xor-checksig-blake2s-sha256-v1"
+)
+
+// pinnedParamsHashes maps a PublicParamsID to the hex encoded sha256 hash
+// of its serialized public parameters. The parameters are deterministic so
+// every node generates the same file. The cache is only used for circuits
+// with a pinned hash. A hash stored next to the cache would only detect
+// accidental corruption, not a file which was deliberately replaced, so
+// without a pin the parameters are generated on every startup.
+var pinnedParamsHashes = map[string]string{}
+
+// ParamsCachePath returns the path of the cached public parameters of
+// the circuit in the data directory.
+func ParamsCachePath(dataDir string) string {
+	return filepath.Join(dataDir, ParamsCacheDirName, PublicParamsID+".params")
+}
+
+// verifyParamsCache checks the cached public parameters at the path
+// against the pinned hash and deletes them if they do not match so that
+// they are generated again. It returns whether a valid cache is present.
+func verifyParamsCache(path, pinned string) (bool, error) {
+	h, err := hashFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if h == pinned {
+		return true, nil
+	}
+
+	log.Warnf("Cached lurk public parameters at %s do not match the pinned hash. Regenerating.", path)
+	return false, removeIfExists(path)
+}
+
+// verifyGeneratedParams checks the newly written public parameters
+// against the pinned hash. Parameters which do not match are deleted so
+// that they are never loaded from the cache.
+func verifyGeneratedParams(path, pinned string) error {
+	h, err := hashFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		// Failing to cache the parameters is not fatal. They
+		// will be generated again on the next startup.
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if h != pinned {
+		log.Warnf("Generated lurk public parameters do not match the pinned hash %s", pinned)
+		return removeIfExists(path)
+	}
+	return nil
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyParamsCache(t *testing.T) {
+	path := ParamsCachePath(t.TempDir())
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+
+	// sha256("params")
+	pinned := "a20b52fae57cc7a99c9651f1b573950fd211823e3ace3bb9c273c06430f24cd3"
+
+	// No cache.
+	valid, err := verifyParamsCache(path, pinned)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// A cache matching the pinned hash is valid.
+	assert.NoError(t, os.WriteFile(path, []byte("params"), 0600))
+	assert.NoError(t, verifyGeneratedParams(path, pinned))
+	assert.FileExists(t, path)
+	valid, err = verifyParamsCache(path, pinned)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// A corrupted or replaced cache is deleted.
+	assert.NoError(t, os.WriteFile(path, []byte("parans"), 0600))
+	valid, err = verifyParamsCache(path, pinned)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+	assert.NoFileExists(t, path)
+
+	// Generated parameters which do not match the pinned hash are
+	// deleted so that they are never loaded.
+	assert.NoError(t, os.WriteFile(path, []byte("parans"), 0600))
+	assert.NoError(t, verifyGeneratedParams(path, pinned))
+	assert.NoFileExists(t, path)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"context"
+	"time"
+)
+
+// progressInterval is how often progress is reported while a proof is
+// being created.
+const progressInterval = time.Second
+
+// ProofStage is a stage of creating a proof.
+type ProofStage int
+
+const (
+	// StageQueued is reported when the proof is waiting for a worker
+	// in the prover pool.
+	StageQueued ProofStage = iota
+	// StageProving is reported when the proof starts and then
+	// periodically until it completes.
+	StageProving
+	// StageComplete is reported when the proof has been created or
+	// failed.
+	StageComplete
+)
+
+func (s ProofStage) String() string {
+	switch s {
+	case StageQueued:
+		return "queued"
+	case StageProving:
+		return "proving"
+	case StageComplete:
+		return "complete"
+	}
+	return "unknown"
+}
+
+// ProofProgress is a progress report of a proof.
+type ProofProgress struct {
+	Stage ProofStage
+	// Elapsed is the time since the proof was requested.
+	Elapsed time.Duration
+	// Err is the error creating the proof, if it failed. It is
+	// only set in the StageComplete report.
+	Err error
+}
+
+// ProgressFunc is called with the progress of a proof.
+type ProgressFunc func(progress ProofProgress)
+
+type progressKey struct{}
+
+// WithProgress returns a context which reports the progress of proofs
+// created with it, such as with CreateSnarkWithContext, to the
+// progress func. Proving can take a long time so this allows callers
+// to show that it is underway.
+//
+// The progress func is called from the goroutine creating the proof
+// and must not block.
+func WithProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, progress)
+}
+
+// WithProgressChan is WithProgress but sends the progress on the
+// channel. Reports are dropped if the channel is not ready to receive
+// them, except for the StageComplete report which is always sent.
+func WithProgressChan(ctx context.Context, ch chan<- ProofProgress) context.Context {
+	return WithProgress(ctx, func(progress ProofProgress) {
+		if progress.Stage == StageComplete {
+			ch <- progress
+			return
+		}
+		select {
+		case ch <- progress:
+		default:
+		}
+	})
+}
+
+// progressReporter reports the progress of a proof to the context's
+// progress func, if it has one.
+type progressReporter struct {
+	progress ProgressFunc
+	start    time.Time
+}
+
+func newProgressReporter(ctx context.Context) *progressReporter {
+	progress, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return &progressReporter{
+		progress: progress,
+		start:    time.Now(),
+	}
+}
+
+func (r *progressReporter) report(stage ProofStage, err error) {
+	if r.progress == nil {
+		return
+	}
+	r.progress(ProofProgress{
+		Stage:   stage,
+		Elapsed: time.Since(r.start),
+		Err:     err,
+	})
+}
+
+// whileProving reports the StageProving progress periodically until
+// the returned func is called.
+func (r *progressReporter) whileProving() func() {
+	r.report(StageProving, nil)
+	if r.progress == nil {
+		return func() {}
+	}
+	var (
+		done    = make(chan struct{})
+		stopped = make(chan struct{})
+	)
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.report(StageProving, nil)
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
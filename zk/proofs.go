@@ -16,7 +16,7 @@ package zk
 #cgo darwin LDFLAGS: -Lrust/target/release -Lrust/target/x86_64-apple-darwin/release -lillium_zk -lc++ -lssl -lcrypto -framework SystemConfiguration
 #include <stdlib.h>
 #include <stdint.h>
-void load_public_params();
+void load_public_params(const char* cache_path);
 int create_proof_ffi(
     const char* lurk_program,
     const char* private_params,
@@ -43,7 +43,10 @@ int eval_ffi(
 import "C"
 import (
 	"bytes"
+	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"sync"
 	"unsafe"
 )
@@ -65,13 +68,59 @@ func (p Expr) ToExpr() (string, error) {
 // LoadZKPublicParameters loads the lurk public parameters from disk
 // into memory or generates them if this is the first startup.
 func LoadZKPublicParameters() {
+	loadPublicParams("")
+}
+
+// LoadZKPublicParametersFromCache loads the lurk public parameters from
+// the parameter cache in the data directory. If they are not in the
+// cache they are generated and written to it so that later startups
+// do not need to generate them again. A cache which does not match the
+// pinned hash of the parameters is deleted and the parameters are
+// generated again. If no hash is pinned for the circuit the cache is
+// not used at all.
+func LoadZKPublicParametersFromCache(dataDir string) error {
+	pinned, ok := pinnedParamsHashes[PublicParamsID]
+	if !ok {
+		log.Warnf("No pinned hash for lurk public parameters %s. Not using the parameter cache.", PublicParamsID)
+		loadPublicParams("")
+		return nil
+	}
+	cachePath := ParamsCachePath(dataDir)
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0700); err != nil {
+		return err
+	}
+	valid, err := verifyParamsCache(cachePath, pinned)
+	if err != nil {
+		return err
+	}
+	loadPublicParams(cachePath)
+	if !valid {
+		return verifyGeneratedParams(cachePath, pinned)
+	}
+	return nil
+}
+
+func loadPublicParams(cachePath string) {
 	once.Do(func() {
 		log.Info("Loading lurk public parameters...")
-		C.load_public_params()
+		ccachePath := C.CString(cachePath)
+		defer C.free(unsafe.Pointer(ccachePath))
+		C.load_public_params(ccachePath)
 	})
 }
 
+// Prove creates a proof of the lurk program in the shared prover pool.
 func Prove(lurkProgram string, privateParams Parameters, publicParams Parameters) ([]byte, error) {
+	return ProveWithContext(context.Background(), lurkProgram, privateParams, publicParams)
+}
+
+// ProveWithContext is Prove but stops waiting for the shared prover pool
+// if the context is canceled.
+func ProveWithContext(ctx context.Context, lurkProgram string, privateParams Parameters, publicParams Parameters) ([]byte, error) {
+	return SharedProver().Prove(ctx, lurkProgram, privateParams, publicParams)
+}
+
+func prove(lurkProgram string, privateParams Parameters, publicParams Parameters) ([]byte, error) {
 	priv, err := privateParams.ToExpr()
 	if err != nil {
 		return nil, err
@@ -80,6 +129,9 @@ func Prove(lurkProgram string, privateParams Parameters, publicParams Parameters
 	if err != nil {
 		return nil, err
 	}
+	if MockProverEnabled() {
+		return mockProof(lurkProgram, pub), nil
+	}
 	proof, tag, output, err := createProof(lurkProgram, priv, pub)
 	if err != nil {
 		return nil, err
@@ -96,6 +148,9 @@ func Verify(lurkProgram string, publicParams Parameters, proof []byte) (bool, er
 	if err != nil {
 		return false, err
 	}
+	if MockProverEnabled() {
+		return bytes.Equal(proof, mockProof(lurkProgram, pub)), nil
+	}
 	tagBytes := make([]byte, 32)
 	tagBytes[len(tagBytes)-1] = byte(TagSym)
 	return verifyProof(lurkProgram, pub, proof, tagBytes, OutputTrue)
@@ -119,7 +174,7 @@ func createProof(lurkProgram, privateParams, publicParams string) ([]byte, Tag,
 	cpublicParams := C.CString(publicParams)
 
 	defer C.free(unsafe.Pointer(clurkProgram))
-	defer C.free(unsafe.Pointer(cprivateParams))
+	defer freeSecretCString(cprivateParams, len(privateParams))
 	defer C.free(unsafe.Pointer(cpublicParams))
 
 	// Fixme: set to actual proof size
@@ -204,7 +259,7 @@ func evaluate(lurkProgram, privateParams, publicParams string) (Tag, []byte, int
 	cpublicParams := C.CString(publicParams)
 
 	defer C.free(unsafe.Pointer(clurkProgram))
-	defer C.free(unsafe.Pointer(cprivateParams))
+	defer freeSecretCString(cprivateParams, len(privateParams))
 	defer C.free(unsafe.Pointer(cpublicParams))
 
 	// Fixme: set to actual proof size
@@ -242,3 +297,14 @@ func evaluate(lurkProgram, privateParams, publicParams string) (Tag, []byte, int
 
 	return tag, valOut, int(iter_out), nil
 }
+
+// freeSecretCString wipes a C string containing the private parameters,
+// the prover's witness, before freeing it so that the witness does not
+// linger in freed memory.
+func freeSecretCString(cstr *C.char, n int) {
+	buf := unsafe.Slice((*byte)(unsafe.Pointer(cstr)), n+1)
+	for i := range buf {
+		buf[i] = 0
+	}
+	C.free(unsafe.Pointer(cstr))
+}
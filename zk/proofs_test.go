@@ -17,6 +17,8 @@ import (
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/zk"
 	"github.com/project-illium/ilxd/zk/circparams"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"github.com/project-illium/ilxd/zk/scripts/ratelimit"
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
@@ -166,6 +168,43 @@ func TestEval(t *testing.T) {
 	assert.Equal(t, zk.OutputTrue, out)
 }
 
+func TestEvalFailedAssert(t *testing.T) {
+	mp, err := macros.NewMacroPreprocessor()
+	assert.NoError(t, err)
+	for _, program := range []string{
+		"(lambda (priv pub) !(assert (= priv pub)) t)",
+		`(lambda (priv pub) !(assert (= priv pub) "priv equals pub") t)`,
+		`(lambda (priv pub) !(assert-eq priv pub "priv equals pub") t)`,
+	} {
+		lurkProgram, err := mp.Preprocess(program)
+		assert.NoError(t, err)
+
+		tag, out, _, err := zk.Eval(lurkProgram, zk.Expr("3"), zk.Expr("2"))
+		assert.NoError(t, err)
+		assert.Equal(t, zk.TagNil, tag, program)
+		assert.Equal(t, zk.OutputFalse, out, program)
+
+		tag, out, _, err = zk.Eval(lurkProgram, zk.Expr("3"), zk.Expr("3"))
+		assert.NoError(t, err)
+		assert.Equal(t, zk.TagSym, tag, program)
+		assert.Equal(t, zk.OutputTrue, out, program)
+	}
+}
+
+func TestStandardLibLurkTests(t *testing.T) {
+	mp, err := macros.NewMacroPreprocessor(macros.WithStandardLib())
+	assert.NoError(t, err)
+	tests, err := mp.DependencyTests()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tests)
+
+	for _, test := range tests {
+		t.Run(test.Location.Module+"/"+test.Name, func(t *testing.T) {
+			assert.NoError(t, zk.RunLurkTest(test))
+		})
+	}
+}
+
 func TestTransactionProofValidation(t *testing.T) {
 	tests := []struct {
 		Name           string
@@ -1149,6 +1188,108 @@ func TestTransactionProofValidation(t *testing.T) {
 			ExpectedTag:    zk.TagNil,
 			ExpectedOutput: zk.OutputFalse,
 		},
+		{
+			Name:           "standard vault owner spend after lock valid",
+			Setup:          vaultTxSetup(false, -time.Minute),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard vault owner spend before lock invalid",
+			Setup:          vaultTxSetup(false, time.Hour),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard vault owner spend at max locktime precision valid",
+			Setup:          withLocktimePrecision(vaultTxSetup(false, -time.Minute), 600*time.Second),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard vault owner spend with imprecise locktime invalid",
+			Setup:          withLocktimePrecision(vaultTxSetup(false, -time.Minute), 601*time.Second),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard vault recovery spend before lock valid",
+			Setup:          vaultTxSetup(true, time.Hour),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard rate limited spend within limit valid",
+			Setup:          rateLimitedTxSetup(1, 1000, 400, false),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard rate limited spend over limit invalid",
+			Setup:          rateLimitedTxSetup(1, 1000, 1100, false),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard rate limited two inputs sharing change invalid",
+			Setup:          rateLimitedTxSetup(2, 1000, 400, false),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard rate limited spend in new period valid",
+			Setup:          rateLimitedTxSetup(1, 1000, 1000, true),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard rate limited spend at max locktime precision valid",
+			Setup:          withLocktimePrecision(rateLimitedTxSetup(1, 1000, 400, false), 600*time.Second),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard rate limited spend with imprecise locktime invalid",
+			Setup:          withLocktimePrecision(rateLimitedTxSetup(1, 1000, 400, false), 601*time.Second),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard decaying multisig 2 of 3 before decay valid",
+			Setup:          decayingMultisigTxSetup(2, time.Hour),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard decaying multisig 1 of 3 before decay invalid",
+			Setup:          decayingMultisigTxSetup(1, time.Hour),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard decaying multisig 1 of 3 after decay valid",
+			Setup:          decayingMultisigTxSetup(1, -time.Minute),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard decaying multisig 1 of 3 after decay at max locktime precision valid",
+			Setup:          withLocktimePrecision(decayingMultisigTxSetup(1, -time.Minute), 600*time.Second),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
+		{
+			Name:           "standard decaying multisig 1 of 3 after decay with imprecise locktime invalid",
+			Setup:          withLocktimePrecision(decayingMultisigTxSetup(1, -time.Minute), 601*time.Second),
+			ExpectedTag:    zk.TagNil,
+			ExpectedOutput: zk.OutputFalse,
+		},
+		{
+			Name:           "standard decaying multisig 2 of 3 with imprecise locktime valid",
+			Setup:          withLocktimePrecision(decayingMultisigTxSetup(2, -time.Minute), 601*time.Second),
+			ExpectedTag:    zk.TagSym,
+			ExpectedOutput: zk.OutputTrue,
+		},
 	}
 
 	for _, test := range tests {
@@ -1164,6 +1305,228 @@ func TestTransactionProofValidation(t *testing.T) {
 	}
 }
 
+// withLocktimePrecision returns the setup with the locktime precision of
+// the transaction replaced by precision.
+func withLocktimePrecision(setup func() ([]string, zk.Parameters, zk.Parameters, error), precision time.Duration) func() ([]string, zk.Parameters, zk.Parameters, error) {
+	return func() ([]string, zk.Parameters, zk.Parameters, error) {
+		programs, priv, pub, err := setup()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pub.(*circparams.PublicParams).LocktimePrecision = precision
+		return programs, priv, pub, nil
+	}
+}
+
+// vaultTxSetup returns the setup of a transaction spending from a vault
+// which is locked until lockedFor after the transaction's locktime.
+func vaultTxSetup(recovery bool, lockedFor time.Duration) func() ([]string, zk.Parameters, zk.Parameters, error) {
+	return func() ([]string, zk.Parameters, zk.Parameters, error) {
+		ownerSk, ownerPk, err := crypto.GenerateNovaKey(rand.Reader)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		recoverySk, recoveryPk, err := crypto.GenerateNovaKey(rand.Reader)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		locktime := time.Now()
+		lockingParams, err := zk.MakeVaultLockingParams(locktime.Add(lockedFor), ownerPk, recoveryPk)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts := defaultOpts()
+		opts.inLockingParams = map[int][][]byte{0: lockingParams}
+		opts.inScriptCommitments = map[int]types.ID{0: types.NewID(zk.VaultScriptCommitment())}
+		priv, pub, err := generateTxParams(1, 1, opts)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		var unlockingScript string
+		if recovery {
+			sig, err := recoverySk.Sign(pub.SigHash.Bytes())
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			unlockingScript, err = zk.MakeVaultRecoveryUnlockingParams(sig)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		} else {
+			sig, err := ownerSk.Sign(pub.SigHash.Bytes())
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			unlockingScript, err = zk.MakeVaultSpendUnlockingParams(sig)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		priv.Inputs[0].UnlockingParams = unlockingScript
+		priv.Inputs[0].Script = zk.VaultScript()
+
+		nullifer, err := types.CalculateNullifier(priv.Inputs[0].CommitmentIndex, priv.Inputs[0].Salt, zk.VaultScriptCommitment(), priv.Inputs[0].LockingParams...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pub.Nullifiers[0] = nullifer
+		pub.Locktime = locktime
+		pub.LocktimePrecision = 600
+		return []string{zk.StandardValidationProgram()}, priv, pub, nil
+	}
+}
+
+// rateLimitedTxSetup returns the setup of a transaction spending amount
+// from rate limited coins with a limit of 1000 per day, of which 600 has
+// already been spent in the current period. If newPeriod is true the
+// transaction's locktime is after the end of the current period. The
+// transaction spends numInputs identical rate limited coins which all
+// return their change to the first output.
+func rateLimitedTxSetup(numInputs int, limit, amount uint64, newPeriod bool) func() ([]string, zk.Parameters, zk.Parameters, error) {
+	return func() ([]string, zk.Parameters, zk.Parameters, error) {
+		sk, pk, err := crypto.GenerateNovaKey(rand.Reader)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		period := time.Hour * 24
+		lockingParams, err := zk.MakeRateLimitedLockingParams(period, limit, pk)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		lockingScript := types.LockingScript{
+			ScriptCommitment: types.NewID(zk.RateLimitedScriptCommitment()),
+			LockingParams:    lockingParams,
+		}
+		scriptHash, err := lockingScript.Hash()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		periodStart := time.Unix(time.Now().Unix(), 0).Add(-time.Hour)
+		locktime := periodStart.Add(time.Minute * 30)
+		if newPeriod {
+			locktime = periodStart.Add(period + time.Minute)
+		}
+		state := ratelimit.NewState(periodStart)
+		state, err = ratelimit.NextState(state, period, limit, periodStart, 600)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// Compute the successor state directly so that spends over the
+		// limit can be tested.
+		spent := uint64(600)
+		nextStart := periodStart
+		if newPeriod {
+			spent = 0
+			nextStart = locktime
+		}
+		next := ratelimit.StateSchema.NewState()
+		next.SetUint64("period-start", uint64(nextStart.Unix()))
+		next.SetUint64("period-spent", spent+amount)
+
+		opts := defaultOpts()
+		opts.inLockingParams = make(map[int][][]byte)
+		opts.inScriptCommitments = make(map[int]types.ID)
+		opts.inStates = make(map[int]types.State)
+		opts.inAmounts = make(map[int]types.Amount)
+		for i := 0; i < numInputs; i++ {
+			opts.inLockingParams[i] = lockingParams
+			opts.inScriptCommitments[i] = types.NewID(zk.RateLimitedScriptCommitment())
+			opts.inStates[i] = state
+			opts.inAmounts[i] = 1000000
+		}
+		opts.outAmounts = map[int]types.Amount{0: types.Amount(1000000 - amount)}
+		opts.outStates = map[int]types.State{0: next.State()}
+		opts.outScriptHashes = map[int]types.ID{0: scriptHash}
+		priv, pub, err := generateTxParams(numInputs, 1, opts)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		sig, err := sk.Sign(pub.SigHash.Bytes())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		unlockingScript, err := zk.MakeRateLimitedUnlockingParams(0, sig)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for i := range priv.Inputs {
+			priv.Inputs[i].UnlockingParams = unlockingScript
+			priv.Inputs[i].Script = zk.RateLimitedScript()
+
+			nullifer, err := types.CalculateNullifier(priv.Inputs[i].CommitmentIndex, priv.Inputs[i].Salt, zk.RateLimitedScriptCommitment(), priv.Inputs[i].LockingParams...)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			pub.Nullifiers[i] = nullifer
+		}
+		pub.Fee = types.Amount(uint64(numInputs-1)*1000000 + amount)
+		pub.Locktime = locktime
+		pub.LocktimePrecision = 600
+		return []string{zk.StandardValidationProgram()}, priv, pub, nil
+	}
+}
+
+// decayingMultisigTxSetup returns the setup of a transaction spending from
+// a 2 of 3 multisig, decaying to 1 of 3 after decaysIn from the
+// transaction's locktime, with numSigs signatures.
+func decayingMultisigTxSetup(numSigs int, decaysIn time.Duration) func() ([]string, zk.Parameters, zk.Parameters, error) {
+	return func() ([]string, zk.Parameters, zk.Parameters, error) {
+		var (
+			sks []lcrypto.PrivKey
+			pks []lcrypto.PubKey
+		)
+		for i := 0; i < 3; i++ {
+			sk, pk, err := crypto.GenerateNovaKey(rand.Reader)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			sks = append(sks, sk)
+			pks = append(pks, pk)
+		}
+		locktime := time.Now()
+		lockingParams, err := zk.MakeDecayingMultisigLockingParams(locktime.Add(decaysIn), 2, 1, pks)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts := defaultOpts()
+		opts.inLockingParams = map[int][][]byte{0: lockingParams}
+		opts.inScriptCommitments = map[int]types.ID{0: types.NewID(zk.DecayingMultisigScriptCommitment())}
+		priv, pub, err := generateTxParams(1, 1, opts)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		var sigs [][]byte
+		for _, sk := range sks[:numSigs] {
+			sig, err := sk.Sign(pub.SigHash.Bytes())
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			sigs = append(sigs, sig)
+		}
+		unlockingScript, err := zk.MakeDecayingMultisigUnlockingParams(pks, sigs, pub.SigHash.Bytes())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		priv.Inputs[0].UnlockingParams = unlockingScript
+		priv.Inputs[0].Script = zk.DecayingMultisigScript()
+
+		nullifer, err := types.CalculateNullifier(priv.Inputs[0].CommitmentIndex, priv.Inputs[0].Salt, zk.DecayingMultisigScriptCommitment(), priv.Inputs[0].LockingParams...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pub.Nullifiers[0] = nullifer
+		pub.Locktime = locktime
+		pub.LocktimePrecision = 600
+		return []string{zk.StandardValidationProgram()}, priv, pub, nil
+	}
+}
+
 type options struct {
 	inAssets            map[int]types.ID
 	outAssets           map[int]types.ID
@@ -1171,6 +1534,9 @@ type options struct {
 	outAmounts          map[int]types.Amount
 	inScriptCommitments map[int]types.ID
 	inLockingParams     map[int][][]byte
+	inStates            map[int]types.State
+	outStates           map[int]types.State
+	outScriptHashes     map[int]types.ID
 }
 
 func defaultOpts() *options {
@@ -1181,6 +1547,9 @@ func defaultOpts() *options {
 		outAmounts:          make(map[int]types.Amount),
 		inScriptCommitments: make(map[int]types.ID),
 		inLockingParams:     make(map[int][][]byte),
+		inStates:            make(map[int]types.State),
+		outStates:           make(map[int]types.State),
+		outScriptHashes:     make(map[int]types.ID),
 	}
 }
 
@@ -1259,6 +1628,12 @@ func generateTxParams(numInputs, numOutputs int, opts *options) (*circparams.Pri
 		if ok {
 			note.AssetID = assetID
 		}
+		if state, ok := opts.outStates[i]; ok {
+			note.State = state
+		}
+		if scriptHash, ok := opts.outScriptHashes[i]; ok {
+			note.ScriptHash = scriptHash
+		}
 
 		serializedNote, err := note.Serialize()
 		if err != nil {
@@ -1334,6 +1709,9 @@ func generateTxParams(numInputs, numOutputs int, opts *options) (*circparams.Pri
 		if ok {
 			note.AssetID = assetID
 		}
+		if state, ok := opts.inStates[i]; ok {
+			note.State = state
+		}
 
 		commitment, err := note.Commitment()
 		if err != nil {
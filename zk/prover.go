@@ -0,0 +1,166 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const (
+	defaultProverWorkers   = 1
+	defaultProverQueueSize = 100
+)
+
+var (
+	// ErrProverQueueFull is returned when a proof is submitted to a prover
+	// pool whose queue is full.
+	ErrProverQueueFull = errors.New("prover queue is full")
+
+	// ErrInvalidProof is returned when a prover backend returns a proof
+	// which does not verify.
+	ErrInvalidProof = errors.New("prover returned an invalid proof")
+)
+
+var (
+	sharedProver    *ProverPool
+	sharedProverMtx sync.RWMutex
+)
+
+// ProverPool bounds the number of proofs created concurrently. Callers
+// beyond the number of workers wait in a queue for a worker to become
+// free and callers beyond the size of the queue are rejected with
+// ErrProverQueueFull.
+//
+// Once a proof has started it runs to completion as the lurk library
+// cannot be interrupted, but callers whose context is canceled while
+// waiting give up their place without a proof being created.
+type ProverPool struct {
+	workers chan struct{}
+	queue   chan struct{}
+	backend Prover
+}
+
+// NewProverPool returns a new ProverPool.
+func NewProverPool(opts ...Option) (*ProverPool, error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return &ProverPool{
+		workers: make(chan struct{}, cfg.workers),
+		queue:   make(chan struct{}, cfg.workers+cfg.queueSize),
+		backend: cfg.backend,
+	}, nil
+}
+
+// CreateSnark creates the snark in the pool, with the pool's backend if
+// it has one. It returns the context's error if the context is canceled
+// before the proof is started.
+//
+// Proofs created by a backend are verified with the backend before they
+// are returned and ErrInvalidProof is returned if they do not verify.
+func (p *ProverPool) CreateSnark(ctx context.Context, circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return p.submit(ctx, func() ([]byte, error) {
+		var (
+			proof []byte
+			err   error
+		)
+		switch backend := p.backend.(type) {
+		case nil:
+			return createSnark(circuit, privateParams, publicParams)
+		case ContextProver:
+			proof, err = backend.CreateProofWithContext(ctx, circuit, privateParams, publicParams)
+		default:
+			proof, err = backend.CreateProof(circuit, privateParams, publicParams)
+		}
+		if err != nil {
+			return nil, err
+		}
+		valid, err := p.backend.VerifyProof(circuit, publicParams, proof)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, ErrInvalidProof
+		}
+		return proof, nil
+	})
+}
+
+// Prove creates the lurk proof in the pool. It returns the context's
+// error if the context is canceled before the proof is started.
+func (p *ProverPool) Prove(ctx context.Context, lurkProgram string, privateParams Parameters, publicParams Parameters) ([]byte, error) {
+	return p.submit(ctx, func() ([]byte, error) {
+		return prove(lurkProgram, privateParams, publicParams)
+	})
+}
+
+// submit takes a place in the queue, or fails if the queue is full, and
+// then waits for a free worker before creating the proof.
+func (p *ProverPool) submit(ctx context.Context, proveFunc func() ([]byte, error)) (proof []byte, err error) {
+	reporter := newProgressReporter(ctx)
+	defer func() { reporter.report(StageComplete, err) }()
+	reporter.report(StageQueued, nil)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	select {
+	case p.queue <- struct{}{}:
+	default:
+		return nil, ErrProverQueueFull
+	}
+	defer func() { <-p.queue }()
+
+	select {
+	case p.workers <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-p.workers }()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	stop := reporter.whileProving()
+	defer stop()
+	return proveFunc()
+}
+
+// ConfigureProver replaces the prover pool shared by CreateSnark and
+// Prove with one using the options. Proofs already in the previous
+// pool are unaffected.
+func ConfigureProver(opts ...Option) error {
+	pool, err := NewProverPool(opts...)
+	if err != nil {
+		return err
+	}
+	sharedProverMtx.Lock()
+	defer sharedProverMtx.Unlock()
+	sharedProver = pool
+	return nil
+}
+
+// SharedProver returns the prover pool shared by CreateSnark and Prove,
+// creating it with the default options if it has not been configured.
+func SharedProver() *ProverPool {
+	sharedProverMtx.RLock()
+	pool := sharedProver
+	sharedProverMtx.RUnlock()
+	if pool != nil {
+		return pool
+	}
+
+	sharedProverMtx.Lock()
+	defer sharedProverMtx.Unlock()
+	if sharedProver == nil {
+		sharedProver, _ = NewProverPool()
+	}
+	return sharedProver
+}
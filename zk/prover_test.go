@@ -0,0 +1,136 @@
+package zk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProverPool(t *testing.T) {
+	pool, err := NewProverPool(ProverWorkers(2), ProverQueueSize(10))
+	assert.NoError(t, err)
+
+	var (
+		running int32
+		maxRun  int32
+		wg      sync.WaitGroup
+	)
+	circuit := func(privateParams, publicParams interface{}) bool {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRun)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRun, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 10)
+		atomic.AddInt32(&running, -1)
+		return true
+	}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			proof, err := pool.CreateSnark(context.Background(), circuit, nil, nil)
+			assert.NoError(t, err)
+			assert.Len(t, proof, MockProofSize)
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, maxRun, int32(2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pool.CreateSnark(ctx, circuit, nil, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = NewProverPool(ProverWorkers(0))
+	assert.Error(t, err)
+
+	pool, err = NewProverPool(ProverQueueSize(0))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cap(pool.workers))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	blocking := func(privateParams, publicParams interface{}) bool {
+		close(started)
+		<-release
+		return true
+	}
+	done := make(chan error)
+	go func() {
+		_, err := pool.CreateSnark(context.Background(), blocking, nil, nil)
+		done <- err
+	}()
+	<-started
+	_, err = pool.CreateSnark(context.Background(), circuit, nil, nil)
+	assert.ErrorIs(t, err, ErrProverQueueFull)
+	close(release)
+	assert.NoError(t, <-done)
+}
+
+type backendProver struct {
+	calls   int
+	invalid bool
+}
+
+func (p *backendProver) CreateProof(circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	p.calls++
+	return []byte{0x01}, nil
+}
+
+func (p *backendProver) VerifyProof(circuit CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+	return !p.invalid, nil
+}
+
+func TestProverPoolBackend(t *testing.T) {
+	backend := &backendProver{}
+	pool, err := NewProverPool(ProverBackend(backend))
+	assert.NoError(t, err)
+
+	proof, err := pool.CreateSnark(context.Background(), func(privateParams, publicParams interface{}) bool { return false }, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01}, proof)
+	assert.Equal(t, 1, backend.calls)
+
+	// Proofs from the backend which do not verify are not returned
+	backend.invalid = true
+	_, err = pool.CreateSnark(context.Background(), func(privateParams, publicParams interface{}) bool { return false }, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidProof)
+
+	pool, err = NewProverPool(ProverBackend(DefaultProver()))
+	assert.NoError(t, err)
+	assert.Nil(t, pool.backend)
+}
+
+func TestProofProgress(t *testing.T) {
+	pool, err := NewProverPool(ProverWorkers(1))
+	assert.NoError(t, err)
+
+	var stages []ProofStage
+	ctx := WithProgress(context.Background(), func(progress ProofProgress) {
+		stages = append(stages, progress.Stage)
+	})
+	circuit := func(privateParams, publicParams interface{}) bool {
+		time.Sleep(progressInterval + time.Millisecond*100)
+		return true
+	}
+	_, err = pool.CreateSnark(ctx, circuit, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []ProofStage{StageQueued, StageProving, StageProving, StageComplete}, stages)
+
+	ch := make(chan ProofProgress, 10)
+	ctx = WithProgressChan(context.Background(), ch)
+	_, err = pool.CreateSnark(ctx, func(privateParams, publicParams interface{}) bool { return false }, nil, nil)
+	assert.Error(t, err)
+	var last ProofProgress
+	for len(ch) > 0 {
+		last = <-ch
+	}
+	assert.Equal(t, StageComplete, last.Stage)
+	assert.Error(t, last.Err)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"errors"
+	"fmt"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownCircuit is returned when a circuit is not in the registry.
+var ErrUnknownCircuit = errors.New("unknown circuit")
+
+// CircuitID identifies a circuit independently of its version.
+type CircuitID string
+
+const (
+	// StandardCircuitID is the circuit of standard, coinbase, treasury
+	// and mint transactions.
+	StandardCircuitID CircuitID = "standard"
+	// StakeCircuitID is the circuit of stake transactions.
+	StakeCircuitID CircuitID = "stake"
+)
+
+// Circuit is a version of a circuit.
+type Circuit struct {
+	ID      CircuitID
+	Version uint32
+	// Verifier is the circuit the proofs are verified against.
+	Verifier CircuitFunc
+	// Layout is the layout of the params passed to the scripts by
+	// the circuit. It may be nil if the circuit does not run scripts.
+	Layout *macros.ParamLayout
+}
+
+// CircuitRegistry holds the verifiers of the versions of the circuits.
+// When a circuit is upgraded both versions are registered and the
+// heights at which each is valid are set by the network params, so that
+// transactions proven with the legacy version remain valid during a
+// transition window which every node agrees on.
+type CircuitRegistry struct {
+	circuits map[CircuitID]map[uint32]*Circuit
+	mtx      sync.RWMutex
+}
+
+// NewCircuitRegistry returns a new, empty, CircuitRegistry.
+func NewCircuitRegistry() *CircuitRegistry {
+	return &CircuitRegistry{
+		circuits: make(map[CircuitID]map[uint32]*Circuit),
+		mtx:      sync.RWMutex{},
+	}
+}
+
+// Register adds the circuit version to the registry.
+func (r *CircuitRegistry) Register(circuit *Circuit) error {
+	if circuit.ID == "" {
+		return errors.New("circuit id is empty")
+	}
+	if circuit.Verifier == nil {
+		return fmt.Errorf("circuit %s v%d has no verifier", circuit.ID, circuit.Version)
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	versions, ok := r.circuits[circuit.ID]
+	if !ok {
+		versions = make(map[uint32]*Circuit)
+		r.circuits[circuit.ID] = versions
+	}
+	if _, ok := versions[circuit.Version]; ok {
+		return fmt.Errorf("circuit %s v%d is already registered", circuit.ID, circuit.Version)
+	}
+	versions[circuit.Version] = circuit
+	return nil
+}
+
+// Remove removes the circuit version from the registry.
+func (r *CircuitRegistry) Remove(id CircuitID, version uint32) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.circuits[id], version)
+	if len(r.circuits[id]) == 0 {
+		delete(r.circuits, id)
+	}
+}
+
+// Get returns the circuit version.
+func (r *CircuitRegistry) Get(id CircuitID, version uint32) (*Circuit, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	circuit, ok := r.circuits[id][version]
+	if !ok {
+		return nil, fmt.Errorf("%w %s v%d", ErrUnknownCircuit, id, version)
+	}
+	return circuit, nil
+}
+
+// Latest returns the latest version of the circuit.
+func (r *CircuitRegistry) Latest(id CircuitID) (*Circuit, error) {
+	versions, err := r.Versions(id)
+	if err != nil {
+		return nil, err
+	}
+	return versions[0], nil
+}
+
+// Versions returns the registered versions of the circuit, latest
+// first.
+func (r *CircuitRegistry) Versions(id CircuitID) ([]*Circuit, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if len(r.circuits[id]) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrUnknownCircuit, id)
+	}
+	versions := make([]*Circuit, 0, len(r.circuits[id]))
+	for _, circuit := range r.circuits[id] {
+		versions = append(versions, circuit)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+	return versions, nil
+}
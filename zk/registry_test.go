@@ -0,0 +1,36 @@
+package zk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCircuitRegistry(t *testing.T) {
+	verifier := func(privateParams, publicParams interface{}) bool { return true }
+
+	r := NewCircuitRegistry()
+	assert.NoError(t, r.Register(&Circuit{ID: StandardCircuitID, Version: 1, Verifier: verifier}))
+	assert.NoError(t, r.Register(&Circuit{ID: StandardCircuitID, Version: 2, Verifier: verifier}))
+	assert.Error(t, r.Register(&Circuit{ID: StandardCircuitID, Version: 2, Verifier: verifier}))
+	assert.Error(t, r.Register(&Circuit{ID: StakeCircuitID, Version: 1}))
+
+	latest, err := r.Latest(StandardCircuitID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), latest.Version)
+
+	versions, err := r.Versions(StandardCircuitID)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, uint32(1), versions[1].Version)
+
+	_, err = r.Get(StakeCircuitID, 1)
+	assert.ErrorIs(t, err, ErrUnknownCircuit)
+
+	r.Remove(StandardCircuitID, 1)
+	_, err = r.Get(StandardCircuitID, 1)
+	assert.ErrorIs(t, err, ErrUnknownCircuit)
+
+	r.Remove(StandardCircuitID, 2)
+	_, err = r.Latest(StandardCircuitID)
+	assert.ErrorIs(t, err, ErrUnknownCircuit)
+}
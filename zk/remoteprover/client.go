@@ -0,0 +1,222 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package remoteprover
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/remoteprover/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"os"
+	"time"
+)
+
+var _ zk.ContextProver = (*RemoteProver)(nil)
+
+const defaultTimeout = time.Minute * 10
+
+// Option is configuration option function for the RemoteProver
+type Option func(cfg *config) error
+
+// AuthToken is the authentication token of the prover service.
+func AuthToken(token string) Option {
+	return func(cfg *config) error {
+		cfg.authToken = token
+		return nil
+	}
+}
+
+// TLSCertFile is the path to the TLS certificate of the prover service.
+//
+// If this is not provided the system's root certificates are used.
+func TLSCertFile(certFile string) Option {
+	return func(cfg *config) error {
+		cert, err := os.ReadFile(certFile)
+		if err != nil {
+			return err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cert) {
+			return errors.New("no certificates found in the TLS cert file")
+		}
+		cfg.tlsConfig.RootCAs = pool
+		return nil
+	}
+}
+
+// ClientCert is the certificate and key used to authenticate to a
+// prover service which uses client certificate authentication.
+func ClientCert(certFile, keyFile string) Option {
+	return func(cfg *config) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		cfg.tlsConfig.Certificates = []tls.Certificate{cert}
+		return nil
+	}
+}
+
+// Insecure connects to the prover service without TLS. The params of
+// the proofs include private data so this should only be used when the
+// connection is otherwise secured, such as to localhost.
+func Insecure() Option {
+	return func(cfg *config) error {
+		cfg.insecure = true
+		return nil
+	}
+}
+
+// Timeout is the maximum time to wait for the prover service to create
+// a proof.
+//
+// The default is ten minutes.
+func Timeout(timeout time.Duration) Option {
+	return func(cfg *config) error {
+		cfg.timeout = timeout
+		return nil
+	}
+}
+
+// Circuits are the circuits which may be proven.
+//
+// The default is DefaultCircuits.
+func Circuits(circuits []Circuit) Option {
+	return func(cfg *config) error {
+		cfg.circuits = circuits
+		return nil
+	}
+}
+
+// DialOptions are additional options used to connect to the prover
+// service.
+func DialOptions(opts ...grpc.DialOption) Option {
+	return func(cfg *config) error {
+		cfg.dialOpts = append(cfg.dialOpts, opts...)
+		return nil
+	}
+}
+
+type config struct {
+	authToken string
+	tlsConfig *tls.Config
+	insecure  bool
+	timeout   time.Duration
+	circuits  []Circuit
+	dialOpts  []grpc.DialOption
+}
+
+// RemoteProver is a zk.Prover which creates proofs by forwarding them
+// to a prover service. Proofs are verified locally, both those returned
+// by the service and those passed to VerifyProof, as verification is
+// cheap compared to proving.
+type RemoteProver struct {
+	conn      *grpc.ClientConn
+	client    pb.ProverServiceClient
+	authToken string
+	timeout   time.Duration
+	circuits  []Circuit
+	verify    func(circuit zk.CircuitFunc, publicParams interface{}, proof []byte) (bool, error)
+}
+
+// NewRemoteProver returns a RemoteProver connected to the prover
+// service at the address.
+func NewRemoteProver(addr string, opts ...Option) (*RemoteProver, error) {
+	cfg := &config{
+		tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+		timeout:   defaultTimeout,
+		circuits:  DefaultCircuits,
+	}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	creds := credentials.NewTLS(cfg.tlsConfig)
+	if cfg.insecure {
+		creds = insecure.NewCredentials()
+	}
+	dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, cfg.dialOpts...)
+	conn, err := grpc.Dial(addr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteProver{
+		conn:      conn,
+		client:    pb.NewProverServiceClient(conn),
+		authToken: cfg.authToken,
+		timeout:   cfg.timeout,
+		circuits:  cfg.circuits,
+		verify:    zk.ValidateSnark,
+	}, nil
+}
+
+// CreateProof creates the proof on the prover service.
+func (p *RemoteProver) CreateProof(circuit zk.CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return p.CreateProofWithContext(context.Background(), circuit, privateParams, publicParams)
+}
+
+// CreateProofWithContext is CreateProof but stops waiting for the prover
+// service if the context is canceled. zk.ErrInvalidProof is returned if
+// the proof returned by the service does not verify against the public
+// params.
+func (p *RemoteProver) CreateProofWithContext(ctx context.Context, circuit zk.CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	c, err := circuitByFunc(p.circuits, circuit)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := json.Marshal(privateParams)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := json.Marshal(publicParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	if p.authToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, AuthenticationTokenKey, p.authToken)
+	}
+
+	resp, err := p.client.CreateProof(ctx, &pb.CreateProofRequest{
+		Circuit:       c.Name,
+		PrivateParams: priv,
+		PublicParams:  pub,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Proof) == 0 {
+		return nil, errors.New("prover service returned an empty proof")
+	}
+	valid, err := p.VerifyProof(circuit, publicParams, resp.Proof)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, zk.ErrInvalidProof
+	}
+	return resp.Proof, nil
+}
+
+// VerifyProof verifies the proof locally.
+func (p *RemoteProver) VerifyProof(circuit zk.CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+	return p.verify(circuit, publicParams, proof)
+}
+
+// Close closes the connection to the prover service.
+func (p *RemoteProver) Close() error {
+	return p.conn.Close()
+}
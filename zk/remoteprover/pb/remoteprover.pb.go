@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: remoteprover.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// circuit is the name of the circuit to prove, such as "standard"
+	// or "stake".
+	Circuit string `protobuf:"bytes,1,opt,name=circuit,proto3" json:"circuit,omitempty"`
+	// private_params are the JSON encoded private params of the
+	// circuit.
+	PrivateParams []byte `protobuf:"bytes,2,opt,name=private_params,json=privateParams,proto3" json:"private_params,omitempty"`
+	// public_params are the JSON encoded public params of the
+	// circuit.
+	PublicParams []byte `protobuf:"bytes,3,opt,name=public_params,json=publicParams,proto3" json:"public_params,omitempty"`
+}
+
+func (x *CreateProofRequest) Reset() {
+	*x = CreateProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remoteprover_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProofRequest) ProtoMessage() {}
+
+func (x *CreateProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remoteprover_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProofRequest.ProtoReflect.Descriptor instead.
+func (*CreateProofRequest) Descriptor() ([]byte, []int) {
+	return file_remoteprover_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateProofRequest) GetCircuit() string {
+	if x != nil {
+		return x.Circuit
+	}
+	return ""
+}
+
+func (x *CreateProofRequest) GetPrivateParams() []byte {
+	if x != nil {
+		return x.PrivateParams
+	}
+	return nil
+}
+
+func (x *CreateProofRequest) GetPublicParams() []byte {
+	if x != nil {
+		return x.PublicParams
+	}
+	return nil
+}
+
+type CreateProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *CreateProofResponse) Reset() {
+	*x = CreateProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remoteprover_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProofResponse) ProtoMessage() {}
+
+func (x *CreateProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remoteprover_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProofResponse.ProtoReflect.Descriptor instead.
+func (*CreateProofResponse) Descriptor() ([]byte, []int) {
+	return file_remoteprover_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateProofResponse) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+var File_remoteprover_proto protoreflect.FileDescriptor
+
+var file_remoteprover_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x7a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x32, 0x51, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x69, 0x6c, 0x6c, 0x69, 0x75,
+	0x6d, 0x2f, 0x69, 0x6c, 0x78, 0x64, 0x2f, 0x7a, 0x6b, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_remoteprover_proto_rawDescOnce sync.Once
+	file_remoteprover_proto_rawDescData = file_remoteprover_proto_rawDesc
+)
+
+func file_remoteprover_proto_rawDescGZIP() []byte {
+	file_remoteprover_proto_rawDescOnce.Do(func() {
+		file_remoteprover_proto_rawDescData = protoimpl.X.CompressGZIP(file_remoteprover_proto_rawDescData)
+	})
+	return file_remoteprover_proto_rawDescData
+}
+
+var file_remoteprover_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_remoteprover_proto_goTypes = []interface{}{
+	(*CreateProofRequest)(nil),  // 0: pb.CreateProofRequest
+	(*CreateProofResponse)(nil), // 1: pb.CreateProofResponse
+}
+var file_remoteprover_proto_depIdxs = []int32{
+	0, // 0: pb.ProverService.CreateProof:input_type -> pb.CreateProofRequest
+	1, // 1: pb.ProverService.CreateProof:output_type -> pb.CreateProofResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_remoteprover_proto_init() }
+func file_remoteprover_proto_init() {
+	if File_remoteprover_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_remoteprover_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remoteprover_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_remoteprover_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_remoteprover_proto_goTypes,
+		DependencyIndexes: file_remoteprover_proto_depIdxs,
+		MessageInfos:      file_remoteprover_proto_msgTypes,
+	}.Build()
+	File_remoteprover_proto = out.File
+	file_remoteprover_proto_rawDesc = nil
+	file_remoteprover_proto_goTypes = nil
+	file_remoteprover_proto_depIdxs = nil
+}
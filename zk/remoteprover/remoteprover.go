@@ -0,0 +1,85 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+// Package remoteprover delegates proving to an external prover service
+// over gRPC. This allows nodes and wallets without the resources to
+// create proofs themselves to use a more powerful machine they control.
+//
+// The prover service, defined in pb/remoteprover.proto, is run with a
+// Server and the node uses a RemoteProver as the backend of its prover.
+package remoteprover
+
+import (
+	"errors"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/stake"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"reflect"
+)
+
+// AuthenticationTokenKey is the metadata key the client sets to the
+// authentication token.
+const AuthenticationTokenKey = "AuthenticationToken"
+
+var (
+	// ErrUnknownCircuit is returned when proving a circuit which is
+	// not registered.
+	ErrUnknownCircuit = errors.New("unknown circuit")
+
+	// ErrInvalidAuthToken is returned when a request does not have
+	// the authentication token of the server.
+	ErrInvalidAuthToken = errors.New("invalid authentication token")
+
+	// ErrNoAuthentication is returned when creating a Server without
+	// an authentication token or TLS client authentication. The
+	// params of the proofs include the witness so the service must
+	// not accept requests from anyone who can reach it.
+	ErrNoAuthentication = errors.New("the prover service requires an authentication token or TLS client authentication")
+)
+
+// Circuit is a circuit which may be proven remotely. As the circuit
+// function cannot be sent to the prover service the circuit is sent by
+// name, along with its JSON encoded params, which are decoded into the
+// param types of the circuit by the service.
+type Circuit struct {
+	Name             string
+	Circuit          zk.CircuitFunc
+	NewPrivateParams func() interface{}
+	NewPublicParams  func() interface{}
+}
+
+// DefaultCircuits are the circuits which may be proven remotely.
+var DefaultCircuits = []Circuit{
+	{
+		Name:             "standard",
+		Circuit:          standard.StandardCircuit,
+		NewPrivateParams: func() interface{} { return &standard.PrivateParams{} },
+		NewPublicParams:  func() interface{} { return &standard.PublicParams{} },
+	},
+	{
+		Name:             "stake",
+		Circuit:          stake.StakeCircuit,
+		NewPrivateParams: func() interface{} { return &stake.PrivateParams{} },
+		NewPublicParams:  func() interface{} { return &stake.PublicParams{} },
+	},
+}
+
+func circuitByName(circuits []Circuit, name string) (Circuit, error) {
+	for _, c := range circuits {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return Circuit{}, ErrUnknownCircuit
+}
+
+func circuitByFunc(circuits []Circuit, circuit zk.CircuitFunc) (Circuit, error) {
+	ptr := reflect.ValueOf(circuit).Pointer()
+	for _, c := range circuits {
+		if reflect.ValueOf(c.Circuit).Pointer() == ptr {
+			return c, nil
+		}
+	}
+	return Circuit{}, ErrUnknownCircuit
+}
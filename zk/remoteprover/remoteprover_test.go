@@ -0,0 +1,98 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package remoteprover
+
+import (
+	"context"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+	"time"
+)
+
+type mockProver struct {
+	priv interface{}
+	pub  interface{}
+}
+
+func (p *mockProver) CreateProof(circuit zk.CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	p.priv, p.pub = privateParams, publicParams
+	return []byte{0x01, 0x02, 0x03}, nil
+}
+
+func (p *mockProver) VerifyProof(circuit zk.CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+	return true, nil
+}
+
+func TestRemoteProver(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	prover := &mockProver{}
+	server := grpc.NewServer()
+	proverServer, err := NewServer(prover, ServerAuthToken("letmein"))
+	assert.NoError(t, err)
+	proverServer.Register(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+
+	priv := &standard.PrivateParams{
+		Inputs: []standard.PrivateInput{
+			{CommitmentIndex: 5},
+		},
+	}
+	pub := &standard.PublicParams{
+		SigHash:  []byte{0x04},
+		Fee:      10,
+		Locktime: time.Unix(1000, 0).UTC(),
+	}
+
+	remote, err := NewRemoteProver("bufnet", Insecure(), AuthToken("letmein"), DialOptions(dialer))
+	assert.NoError(t, err)
+	defer remote.Close()
+
+	proof, err := remote.CreateProof(standard.StandardCircuit, priv, pub)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03}, proof)
+	assert.Equal(t, priv, prover.priv)
+	assert.Equal(t, pub, prover.pub)
+
+	_, err = remote.CreateProof(func(privateParams, publicParams interface{}) bool { return true }, priv, pub)
+	assert.ErrorIs(t, err, ErrUnknownCircuit)
+
+	// A proof from the service which does not verify is not returned
+	remote.verify = func(circuit zk.CircuitFunc, publicParams interface{}, proof []byte) (bool, error) {
+		assert.Equal(t, pub, publicParams)
+		assert.Equal(t, []byte{0x01, 0x02, 0x03}, proof)
+		return false, nil
+	}
+	_, err = remote.CreateProof(standard.StandardCircuit, priv, pub)
+	assert.ErrorIs(t, err, zk.ErrInvalidProof)
+	remote.verify = zk.ValidateSnark
+
+	unauthenticated, err := NewRemoteProver("bufnet", Insecure(), AuthToken("wrong"), DialOptions(dialer))
+	assert.NoError(t, err)
+	defer unauthenticated.Close()
+
+	_, err = unauthenticated.CreateProof(standard.StandardCircuit, priv, pub)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Without TLS there is no client certificate so only the token
+	// authenticates the request.
+	certServer, err := NewServer(prover, ClientCertAuth())
+	assert.NoError(t, err)
+	assert.ErrorIs(t, certServer.authenticate(context.Background()), ErrInvalidAuthToken)
+
+	_, err = NewServer(prover)
+	assert.ErrorIs(t, err, ErrNoAuthentication)
+}
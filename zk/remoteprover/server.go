@@ -0,0 +1,179 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package remoteprover
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"github.com/project-illium/ilxd/zk"
+	"github.com/project-illium/ilxd/zk/remoteprover/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"os"
+)
+
+var _ pb.ProverServiceServer = (*Server)(nil)
+
+// ServerOption is configuration option function for the Server
+type ServerOption func(cfg *serverConfig) error
+
+// ServerAuthToken is the token requests must be made with.
+func ServerAuthToken(token string) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.authToken = token
+		return nil
+	}
+}
+
+// ClientCertAuth accepts requests made over TLS with a client
+// certificate verified by the gRPC server, such as one using the
+// credentials from ServerTLSConfig.
+func ClientCertAuth() ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.clientCertAuth = true
+		return nil
+	}
+}
+
+// ServerCircuits are the circuits which may be proven.
+//
+// The default is DefaultCircuits.
+func ServerCircuits(circuits []Circuit) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.circuits = circuits
+		return nil
+	}
+}
+
+type serverConfig struct {
+	authToken      string
+	clientCertAuth bool
+	circuits       []Circuit
+}
+
+// Server is the prover service. It creates the proofs requested by
+// RemoteProvers with its prover.
+type Server struct {
+	pb.UnimplementedProverServiceServer
+	prover         zk.Prover
+	authToken      string
+	clientCertAuth bool
+	circuits       []Circuit
+}
+
+// NewServer returns a new Server which proves with the prover. Either
+// an authentication token or client certificate authentication must be
+// used as the requests contain the private params of the proofs.
+func NewServer(prover zk.Prover, opts ...ServerOption) (*Server, error) {
+	cfg := &serverConfig{
+		circuits: DefaultCircuits,
+	}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.authToken == "" && !cfg.clientCertAuth {
+		return nil, ErrNoAuthentication
+	}
+	return &Server{
+		prover:         prover,
+		authToken:      cfg.authToken,
+		clientCertAuth: cfg.clientCertAuth,
+		circuits:       cfg.circuits,
+	}, nil
+}
+
+// ServerTLSConfig returns the TLS config of a gRPC server using the
+// certificate and key which requires clients to present a certificate
+// signed by the certificate authority in the clientCAFile. If the
+// clientCAFile is empty clients are not asked for a certificate.
+func ServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if clientCAFile == "" {
+		return &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}, nil
+	}
+	ca, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("no certificates found in the client CA file")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// Register registers the prover service on the gRPC server.
+func (s *Server) Register(server *grpc.Server) {
+	pb.RegisterProverServiceServer(server, s)
+}
+
+// CreateProof creates a proof that the params are valid for the circuit.
+func (s *Server) CreateProof(ctx context.Context, req *pb.CreateProofRequest) (*pb.CreateProofResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	c, err := circuitByName(s.circuits, req.Circuit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	priv, pub := c.NewPrivateParams(), c.NewPublicParams()
+	if err := json.Unmarshal(req.PrivateParams, priv); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid private params: %s", err)
+	}
+	if err := json.Unmarshal(req.PublicParams, pub); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public params: %s", err)
+	}
+	proof, err := s.prover.CreateProof(c.Circuit, priv, pub)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.CreateProofResponse{Proof: proof}, nil
+}
+
+// authenticate accepts requests with the authentication token or, if
+// client certificate authentication is used, with a verified client
+// certificate.
+func (s *Server) authenticate(ctx context.Context) error {
+	if s.clientCertAuth {
+		if p, ok := peer.FromContext(ctx); ok {
+			if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+				return nil
+			}
+		}
+	}
+	if s.authToken == "" {
+		return ErrInvalidAuthToken
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrInvalidAuthToken
+	}
+	tokens := md.Get(AuthenticationTokenKey)
+	if len(tokens) == 0 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(s.authToken)) != 1 {
+		return ErrInvalidAuthToken
+	}
+	return nil
+}
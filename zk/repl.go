@@ -0,0 +1,226 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"io"
+	"strings"
+)
+
+// ErrReplDone is returned by Repl.Step once every step of the script
+// has been evaluated or a condition has failed.
+var ErrReplDone = errors.New("script evaluation finished")
+
+// ReplStep is a step of a script evaluated by the Repl.
+type ReplStep struct {
+	macros.DebugStep
+
+	// Tag and Output are the value of the step's expression.
+	Tag    Tag
+	Output []byte
+	// Iterations is the number of lurk iterations it took to
+	// evaluate the step from the start of the script.
+	Iterations int
+}
+
+// Failed returns whether the step is a condition which is not true,
+// in which case the script stops evaluating and returns nil.
+func (s *ReplStep) Failed() bool {
+	return s.Kind == macros.DebugCondition && s.Tag == TagNil
+}
+
+// String returns the step and its value as a string.
+func (s *ReplStep) String() string {
+	var sb strings.Builder
+	sb.WriteString(s.Kind.String())
+	if s.Name != "" {
+		sb.WriteString(" " + s.Name)
+	} else {
+		sb.WriteString(" " + s.Expr)
+	}
+	sb.WriteString(" = " + formatValue(s.Tag, s.Output))
+	if s.Failed() {
+		sb.WriteString(" (failed)")
+	}
+	return sb.String()
+}
+
+// Repl evaluates a preprocessed unlocking script step by step outside
+// of the prover. The script is applied to the locking params, unlocking
+// params, input index and private and public params exactly as the
+// validation program applies it, so a script author can see the value
+// of each definition and assert and find the one which fails without
+// generating proofs.
+type Repl struct {
+	steps []macros.DebugStep
+	pos   int
+
+	args          string
+	privateParams Parameters
+	publicParams  Parameters
+}
+
+// NewRepl returns a Repl for the preprocessed script. The locking and
+// unlocking params are lurk expressions of the params the script is
+// applied to.
+func NewRepl(script string, lockingParams, unlockingParams Parameters, inputIndex int, privateParams, publicParams Parameters) (*Repl, error) {
+	steps, err := macros.DebugSteps(script)
+	if err != nil {
+		return nil, err
+	}
+	locking, err := lockingParams.ToExpr()
+	if err != nil {
+		return nil, err
+	}
+	unlocking, err := unlockingParams.ToExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &Repl{
+		steps:         steps,
+		args:          fmt.Sprintf("%s %s %d private-params public-params", locking, unlocking, inputIndex),
+		privateParams: privateParams,
+		publicParams:  publicParams,
+	}, nil
+}
+
+// Steps returns the steps of the script.
+func (r *Repl) Steps() []macros.DebugStep {
+	return r.steps
+}
+
+// Step evaluates the next step of the script. ErrReplDone is returned
+// once the script has finished.
+func (r *Repl) Step() (*ReplStep, error) {
+	if r.pos >= len(r.steps) {
+		return nil, ErrReplDone
+	}
+	step := r.steps[r.pos]
+	tag, output, iterations, err := r.eval(step.Program())
+	if err != nil {
+		return nil, err
+	}
+	res := &ReplStep{
+		DebugStep:  step,
+		Tag:        tag,
+		Output:     output,
+		Iterations: iterations,
+	}
+	r.pos++
+	if res.Failed() {
+		r.pos = len(r.steps)
+	}
+	return res, nil
+}
+
+// Run evaluates the remaining steps of the script, stopping at the
+// first failed condition.
+func (r *Repl) Run() ([]*ReplStep, error) {
+	var steps []*ReplStep
+	for {
+		step, err := r.Step()
+		if errors.Is(err, ErrReplDone) {
+			return steps, nil
+		} else if err != nil {
+			return steps, err
+		}
+		steps = append(steps, step)
+	}
+}
+
+// Reset starts the evaluation again from the first step.
+func (r *Repl) Reset() {
+	r.pos = 0
+}
+
+// Eval evaluates the expression in the scope of the next step, with the
+// script params and the definitions made so far in scope.
+func (r *Repl) Eval(expr string) (Tag, []byte, error) {
+	if len(r.steps) == 0 {
+		return TagNil, nil, ErrReplDone
+	}
+	scope := r.steps[len(r.steps)-1].Scope
+	if r.pos < len(r.steps) {
+		scope = r.steps[r.pos].Scope
+	}
+	tag, output, _, err := r.eval(scope.Wrap(expr))
+	return tag, output, err
+}
+
+func (r *Repl) eval(program string) (Tag, []byte, int, error) {
+	wrapped := fmt.Sprintf("(lambda (private-params public-params) (%s %s))", program, r.args)
+	return Eval(wrapped, r.privateParams, r.publicParams)
+}
+
+// Serve runs an interactive session reading commands from in and
+// writing the results to out until in is closed. The commands are:
+//
+//	:step   evaluate the next step
+//	:run    evaluate the remaining steps
+//	:steps  list the steps of the script
+//	:reset  start again from the first step
+//
+// Any other input is evaluated as an expression in the scope of the
+// next step.
+func (r *Repl) Serve(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	fmt.Fprint(out, "> ")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+		case ":step":
+			step, err := r.Step()
+			if err != nil {
+				fmt.Fprintln(out, err)
+			} else {
+				fmt.Fprintln(out, step)
+			}
+		case ":run":
+			steps, err := r.Run()
+			for _, step := range steps {
+				fmt.Fprintln(out, step)
+			}
+			if err != nil {
+				fmt.Fprintln(out, err)
+			}
+		case ":steps":
+			for i, step := range r.steps {
+				marker := " "
+				if i == r.pos {
+					marker = ">"
+				}
+				fmt.Fprintf(out, "%s %d %s %s\n", marker, i, step.Kind, step.Expr)
+			}
+		case ":reset":
+			r.Reset()
+		default:
+			tag, output, err := r.Eval(line)
+			if err != nil {
+				fmt.Fprintln(out, err)
+			} else {
+				fmt.Fprintln(out, formatValue(tag, output))
+			}
+		}
+		fmt.Fprint(out, "> ")
+	}
+	return scanner.Err()
+}
+
+func formatValue(tag Tag, output []byte) string {
+	switch {
+	case tag == TagNil:
+		return "nil"
+	case tag == TagSym && bytes.Equal(output, OutputTrue):
+		return "t"
+	}
+	return fmt.Sprintf("%s 0x%s", tag, hex.EncodeToString(output))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"bytes"
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestRepl(t *testing.T) {
+	repl, err := NewRepl(VaultScript(), Expr("nil"), Expr("(cons 1 nil)"), 0, Expr("nil"), Expr("nil"))
+	assert.NoError(t, err)
+
+	steps := repl.Steps()
+	assert.Greater(t, len(steps), 1)
+	assert.Equal(t, macros.DebugResult, steps[len(steps)-1].Kind)
+	for _, step := range steps {
+		assert.True(t, macros.IsValidLurk(step.Program()))
+	}
+
+	var out bytes.Buffer
+	err = repl.Serve(strings.NewReader(":steps\n"), &out)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, len(steps)+1)
+	assert.True(t, strings.HasPrefix(lines[0], "> > 0 "))
+
+	step := &ReplStep{
+		DebugStep: macros.DebugStep{Kind: macros.DebugCondition, Expr: "(> x 1)"},
+		Tag:       TagNil,
+	}
+	assert.True(t, step.Failed())
+	assert.Equal(t, "condition (> x 1) = nil (failed)", step.String())
+
+	step = &ReplStep{
+		DebugStep: macros.DebugStep{Kind: macros.DebugBinding, Name: "x", Expr: "x"},
+		Tag:       TagNum,
+		Output:    []byte{0x05},
+	}
+	assert.False(t, step.Failed())
+	assert.Equal(t, "binding x = num 0x05", step.String())
+
+	_, err = NewRepl("(+ 1 2)", Expr("nil"), Expr("nil"), 0, Expr("nil"), Expr("nil"))
+	assert.Error(t, err)
+}
@@ -7,6 +7,9 @@ use std::{
     ffi::{CStr},
     error::Error,
     sync::Arc,
+    fs,
+    io::{BufReader, BufWriter},
+    path::PathBuf,
     ptr,
     slice,
 };
@@ -62,7 +65,15 @@ lazy_static! {
 
 
 #[no_mangle]
-pub extern "C" fn load_public_params() {
+pub extern "C" fn load_public_params(cache_path: *const c_char) {
+    if !cache_path.is_null() {
+        let c_str = unsafe { CStr::from_ptr(cache_path) };
+        if let Ok(path) = c_str.to_str() {
+            if !path.is_empty() {
+                let _ = PUBLIC_PARAMS_CACHE_PATH.set(PathBuf::from(path));
+            }
+        }
+    }
     let _ = get_public_params();
 }
 
@@ -254,8 +265,41 @@ pub extern "C" fn eval_ffi(
 
 static PUBLIC_PARAMS: OnceCell<Arc<PublicParams<Fr, MultiFrame<'static, Fr, MultiCoproc<Fr>>>>> = OnceCell::new();
 
+static PUBLIC_PARAMS_CACHE_PATH: OnceCell<PathBuf> = OnceCell::new();
+
 fn get_public_params() -> Arc<PublicParams<Fr, MultiFrame<'static, Fr, MultiCoproc<Fr>>>> {
-    PUBLIC_PARAMS.get_or_init(|| Arc::new(create_public_params())).clone()
+    PUBLIC_PARAMS.get_or_init(|| {
+        let path = match PUBLIC_PARAMS_CACHE_PATH.get() {
+            Some(path) => path,
+            None => return Arc::new(create_public_params()),
+        };
+        if let Some(pp) = read_public_params(path) {
+            return Arc::new(pp);
+        }
+        let pp = create_public_params();
+        // Failing to cache the params only means they will be
+        // generated again on the next startup.
+        let _ = write_public_params(path, &pp);
+        Arc::new(pp)
+    }).clone()
+}
+
+fn read_public_params(path: &PathBuf) -> Option<PublicParams<Fr, MultiFrame<'static, Fr, MultiCoproc<Fr>>>> {
+    let file = fs::File::open(path).ok()?;
+    bincode::deserialize_from(BufReader::new(file)).ok()
+}
+
+fn write_public_params(path: &PathBuf, pp: &PublicParams<Fr, MultiFrame<'static, Fr, MultiCoproc<Fr>>>) -> Result<(), Box<dyn Error>> {
+    // Write to a temporary file and rename it so that a crash while
+    // writing never leaves a partial file in the cache.
+    let tmp_path = path.with_extension("tmp");
+    {
+        let file = fs::File::create(&tmp_path)?;
+        let mut writer = BufWriter::new(file);
+        bincode::serialize_into(&mut writer, pp)?;
+    }
+    fs::rename(&tmp_path, path)?;
+    Ok(())
 }
 
 fn create_public_params() -> PublicParams<Fr, MultiFrame<'static, Fr, MultiCoproc<Fr>>> {
@@ -0,0 +1,42 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"github.com/project-illium/ilxd/zk/lurk/macros"
+)
+
+// PreprocessScript runs the macro preprocessor over the script source,
+// returning the lurk program exactly as it is committed to in a
+// LockingScript. Comments are removed so that the commitment does not
+// depend on them.
+//
+// Imports from the standard library always use the copy embedded in the
+// binary. If deps is not empty it is the path to the dependency directory,
+// or archive, which all other imports are loaded from.
+func PreprocessScript(scriptSource string, deps string) (string, error) {
+	opts := []macros.Option{macros.RemoveComments()}
+	if deps == "" {
+		opts = append(opts, macros.WithStandardLib())
+	} else {
+		opts = append(opts, macros.DependencyDir(deps), macros.WithEmbeddedStdlib())
+	}
+	mp, err := macros.NewMacroPreprocessor(opts...)
+	if err != nil {
+		return "", err
+	}
+	return mp.Preprocess(scriptSource)
+}
+
+// ComputeScriptCommitment preprocesses the script source and returns the
+// script commitment used in a LockingScript. See PreprocessScript for
+// the meaning of deps.
+func ComputeScriptCommitment(scriptSource string, deps string) ([]byte, error) {
+	script, err := PreprocessScript(scriptSource, deps)
+	if err != nil {
+		return nil, err
+	}
+	return LurkCommit(script)
+}
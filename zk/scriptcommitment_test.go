@@ -0,0 +1,48 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package zk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestComputeScriptCommitment(t *testing.T) {
+	data, err := vaultScriptLurk.ReadFile("lurk/vault.lurk")
+	assert.NoError(t, err)
+
+	script, err := PreprocessScript(string(data), "")
+	assert.NoError(t, err)
+	assert.Equal(t, VaultScript(), script)
+
+	commitment, err := ComputeScriptCommitment(string(data), "")
+	assert.NoError(t, err)
+	assert.Equal(t, VaultScriptCommitment(), commitment)
+
+	depDir := t.TempDir()
+	err = os.WriteFile(filepath.Join(depDir, "mod.lurk"), []byte(`!(module limits (
+		!(def max-locktime-precision 600)
+	))`), 0644)
+	assert.NoError(t, err)
+
+	src := `(lambda (locking-params unlocking-params input-index private-params public-params)
+		!(import limits/max-locktime-precision)
+		!(import std/crypto/checksig)
+		;; comments are not part of the commitment
+		(if (<= !(param locktime-precision) max-locktime-precision)
+			(checksig unlocking-params locking-params !(param sighash))
+			nil
+		)
+	)`
+	script, err = PreprocessScript(src, depDir)
+	assert.NoError(t, err)
+	assert.NotContains(t, script, "comments")
+	assert.Contains(t, script, "max-locktime-precision 600")
+
+	_, err = ComputeScriptCommitment(src, "")
+	assert.Error(t, err)
+}
@@ -6,11 +6,13 @@ package zk
 
 import (
 	"embed"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/project-illium/ilxd/zk/lurk/macros"
+	"time"
 )
 
 //go:embed lurk/basic_transfer.lurk
@@ -31,6 +33,21 @@ var timelockedMultisigScriptLurk embed.FS
 var timelockedMultisigScriptData string
 var timeLockedMultisigCommitment []byte
 
+//go:embed lurk/decaying_multisig.lurk
+var decayingMultisigScriptLurk embed.FS
+var decayingMultisigScriptData string
+var decayingMultisigCommitment []byte
+
+//go:embed lurk/vault.lurk
+var vaultScriptLurk embed.FS
+var vaultScriptData string
+var vaultScriptCommitment []byte
+
+//go:embed lurk/rate_limited.lurk
+var rateLimitedScriptLurk embed.FS
+var rateLimitedScriptData string
+var rateLimitedScriptCommitment []byte
+
 //go:embed lurk/standard_validation.lurk
 var standardValidationScriptLurk embed.FS
 var standardValidationScriptData string
@@ -96,6 +113,45 @@ func init() {
 		panic(err)
 	}
 
+	data, err = decayingMultisigScriptLurk.ReadFile("lurk/decaying_multisig.lurk")
+	if err != nil {
+		panic(err)
+	}
+	decayingMultisigScriptData, err = mp.Preprocess(string(data))
+	if err != nil {
+		panic(err)
+	}
+	decayingMultisigCommitment, err = LurkCommit(decayingMultisigScriptData)
+	if err != nil {
+		panic(err)
+	}
+
+	data, err = vaultScriptLurk.ReadFile("lurk/vault.lurk")
+	if err != nil {
+		panic(err)
+	}
+	vaultScriptData, err = mp.Preprocess(string(data))
+	if err != nil {
+		panic(err)
+	}
+	vaultScriptCommitment, err = LurkCommit(vaultScriptData)
+	if err != nil {
+		panic(err)
+	}
+
+	data, err = rateLimitedScriptLurk.ReadFile("lurk/rate_limited.lurk")
+	if err != nil {
+		panic(err)
+	}
+	rateLimitedScriptData, err = mp.Preprocess(string(data))
+	if err != nil {
+		panic(err)
+	}
+	rateLimitedScriptCommitment, err = LurkCommit(rateLimitedScriptData)
+	if err != nil {
+		panic(err)
+	}
+
 	data, err = standardValidationScriptLurk.ReadFile("lurk/standard_validation.lurk")
 	if err != nil {
 		panic(err)
@@ -160,6 +216,45 @@ func TimelockedMultisigScriptCommitment() []byte {
 	return ret
 }
 
+// DecayingMultisigScript returns the decaying multisig lurk script
+func DecayingMultisigScript() string {
+	return decayingMultisigScriptData
+}
+
+// DecayingMultisigScriptCommitment returns the script commitment hash
+// for the decaying multisig script.
+func DecayingMultisigScriptCommitment() []byte {
+	ret := make([]byte, len(decayingMultisigCommitment))
+	copy(ret, decayingMultisigCommitment)
+	return ret
+}
+
+// VaultScript returns the vault lurk script
+func VaultScript() string {
+	return vaultScriptData
+}
+
+// VaultScriptCommitment returns the script commitment hash
+// for the vault script.
+func VaultScriptCommitment() []byte {
+	ret := make([]byte, len(vaultScriptCommitment))
+	copy(ret, vaultScriptCommitment)
+	return ret
+}
+
+// RateLimitedScript returns the rate limited lurk script
+func RateLimitedScript() string {
+	return rateLimitedScriptData
+}
+
+// RateLimitedScriptCommitment returns the script commitment hash
+// for the rate limited script.
+func RateLimitedScriptCommitment() []byte {
+	ret := make([]byte, len(rateLimitedScriptCommitment))
+	copy(ret, rateLimitedScriptCommitment)
+	return ret
+}
+
 // StandardValidationProgram returns the standard validation lurk program script
 func StandardValidationProgram() string {
 	return standardValidationScriptData
@@ -186,48 +281,111 @@ func StakeValidationProgram() string {
 	return stakeValidationScriptData
 }
 
-func MakeMultisigUnlockingParams(pubkeys []crypto.PubKey, sigs [][]byte, sigHash []byte) (string, error) {
-	sigCpy := make([][]byte, len(sigs))
-	copy(sigCpy, sigs)
-
-	keySelector := "(cons "
-	for i, key := range pubkeys {
-		if len(sigs) > 0 {
-			valid, err := key.Verify(sigHash, sigs[0])
-			if err != nil {
-				return "", err
-			}
-			if valid {
-				keySelector += "1 "
-				sigs = sigs[1:]
-			} else {
-				keySelector += "0 "
-			}
-		} else {
-			keySelector += "0 "
-		}
-		if i == len(pubkeys)-1 {
-			keySelector += "nil"
-		} else {
-			keySelector += "(cons "
+// MakeDecayingMultisigLockingParams returns the locking params of a
+// multisig which requires threshold signatures before decayAt and
+// decayedThreshold signatures after it. The keys must be Nova public
+// keys.
+func MakeDecayingMultisigLockingParams(decayAt time.Time, threshold, decayedThreshold uint8, pubkeys []crypto.PubKey) ([][]byte, error) {
+	if decayedThreshold > threshold {
+		return nil, errors.New("decayed threshold is greater than threshold")
+	}
+	if int(threshold) > len(pubkeys) {
+		return nil, errors.New("threshold is greater than the number of keys")
+	}
+	decayAtBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(decayAtBytes, uint64(decayAt.Unix()))
+
+	params := [][]byte{decayAtBytes, {threshold}, {decayedThreshold}}
+	for _, key := range pubkeys {
+		novaKey, ok := key.(*icrypto.NovaPublicKey)
+		if !ok {
+			return nil, errors.New("key is not a nova public key")
 		}
+		x, y := novaKey.ToXY()
+		params = append(params, x, y)
 	}
-	for i := 0; i < len(pubkeys); i++ {
-		keySelector += ")"
+	return params, nil
+}
+
+// MakeDecayingMultisigUnlockingParams returns the unlocking params of
+// the decaying multisig script. They are the same before and after the
+// threshold decays, only the number of signatures required differs.
+func MakeDecayingMultisigUnlockingParams(pubkeys []crypto.PubKey, sigs [][]byte, sigHash []byte) (string, error) {
+	return MakeMultisigUnlockingParams(pubkeys, sigs, sigHash)
+}
+
+// MakeVaultLockingParams returns the locking params of a vault which the
+// owner's key may spend from after lockUntil and the recovery key may
+// spend from at any time. Both keys must be Nova public keys.
+func MakeVaultLockingParams(lockUntil time.Time, owner, recovery crypto.PubKey) ([][]byte, error) {
+	ownerKey, ok := owner.(*icrypto.NovaPublicKey)
+	if !ok {
+		return nil, errors.New("owner key is not a nova public key")
+	}
+	recoveryKey, ok := recovery.(*icrypto.NovaPublicKey)
+	if !ok {
+		return nil, errors.New("recovery key is not a nova public key")
 	}
+	lockUntilBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(lockUntilBytes, uint64(lockUntil.Unix()))
 
-	unlockignScript := "(cons " + keySelector + " "
-	for _, sig := range sigCpy {
-		unlockignScript += "(cons "
-		if len(sig) != 64 {
-			return "", errors.New("invalid signature len")
-		}
-		sigRx, sigRy, sigS := icrypto.UnmarshalSignature(sig)
-		unlockignScript += fmt.Sprintf("(cons 0x%x (cons 0x%x (cons 0x%x nil))) ", sigRx, sigRy, sigS)
+	ownerX, ownerY := ownerKey.ToXY()
+	recoveryX, recoveryY := recoveryKey.ToXY()
+	return [][]byte{lockUntilBytes, ownerX, ownerY, recoveryX, recoveryY}, nil
+}
+
+// MakeVaultSpendUnlockingParams returns the unlocking params to spend
+// from a vault with the owner's signature. The transaction's locktime
+// must be after the vault's lock until time.
+func MakeVaultSpendUnlockingParams(sig []byte) (string, error) {
+	return makeVaultUnlockingParams(0, sig)
+}
+
+// MakeVaultRecoveryUnlockingParams returns the unlocking params to spend
+// from a vault with the recovery key's signature.
+func MakeVaultRecoveryUnlockingParams(sig []byte) (string, error) {
+	return makeVaultUnlockingParams(1, sig)
+}
+
+func makeVaultUnlockingParams(spendPath int, sig []byte) (string, error) {
+	if len(sig) != 64 {
+		return "", errors.New("invalid signature len")
+	}
+	sigRx, sigRy, sigS := icrypto.UnmarshalSignature(sig)
+	return fmt.Sprintf("(cons %d (cons 0x%x (cons 0x%x (cons 0x%x nil))))", spendPath, sigRx, sigRy, sigS), nil
+}
+
+// MakeRateLimitedLockingParams returns the locking params of coins which
+// may be spent by the key, a Nova public key, up to the limit in each
+// period.
+func MakeRateLimitedLockingParams(period time.Duration, limit uint64, pubkey crypto.PubKey) ([][]byte, error) {
+	if period < time.Second {
+		return nil, errors.New("period must be at least one second")
+	}
+	novaKey, ok := pubkey.(*icrypto.NovaPublicKey)
+	if !ok {
+		return nil, errors.New("key is not a nova public key")
+	}
+	periodBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBytes, uint64(period/time.Second))
+	limitBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(limitBytes, limit)
+
+	x, y := novaKey.ToXY()
+	return [][]byte{periodBytes, limitBytes, x, y}, nil
+}
+
+// MakeRateLimitedUnlockingParams returns the unlocking params to spend
+// rate limited coins, returning the change to the output at the index.
+// Only one input in the transaction may spend from the same rate limited
+// script.
+func MakeRateLimitedUnlockingParams(changeIndex int, sig []byte) (string, error) {
+	if changeIndex < 0 {
+		return "", errors.New("invalid change index")
 	}
-	unlockignScript += "nil)"
-	for i := 0; i < len(sigCpy); i++ {
-		unlockignScript += ")"
+	if len(sig) != 64 {
+		return "", errors.New("invalid signature len")
 	}
-	return unlockignScript, nil
+	sigRx, sigRy, sigS := icrypto.UnmarshalSignature(sig)
+	return fmt.Sprintf("(cons %d (cons 0x%x (cons 0x%x (cons 0x%x nil))))", changeIndex, sigRx, sigRy, sigS), nil
 }
@@ -0,0 +1,61 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package decayingmultisig
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/project-illium/ilxd/zk/scripts/multisig"
+	"time"
+)
+
+var MockDecayingMultisigScriptCommitment = bytes.Repeat([]byte{0xad}, 32)
+
+type PrivateParams struct {
+	Signatures  [][]byte
+	SigBitField uint8
+}
+
+// DecayingMultisigScript expects the script params to be:
+// <decay-at> <threshold> <decayed-threshold> <pubkey1> <pubkey2> ...
+func DecayingMultisigScript(privateParams, publicParams interface{}) bool {
+	priv, ok := privateParams.(*PrivateParams)
+	if !ok {
+		return false
+	}
+	pub, ok := publicParams.(*standard.UnlockingScriptInputs)
+	if !ok {
+		return false
+	}
+	if len(pub.ScriptParams) < 4 {
+		return false
+	}
+	if len(pub.ScriptParams[0]) != 8 || len(pub.ScriptParams[1]) != 1 || len(pub.ScriptParams[2]) != 1 {
+		return false
+	}
+
+	threshold := pub.ScriptParams[1][0]
+	decayAt := int64(binary.BigEndian.Uint64(pub.ScriptParams[0]))
+	if !pub.PublicParams.Locktime.Before(time.Unix(decayAt, 0)) && pub.PublicParams.LocktimePrecision <= time.Second*600 {
+		threshold = pub.ScriptParams[2][0]
+	}
+
+	pubkeys := make([]crypto.PubKey, len(pub.ScriptParams)-3)
+	for i := 3; i < len(pub.ScriptParams); i++ {
+		key, err := crypto.UnmarshalPublicKey(pub.ScriptParams[i])
+		if err != nil {
+			return false
+		}
+		pubkeys[i-3] = key
+	}
+
+	valid, err := multisig.ValidateMultiSignature(threshold, pubkeys, priv.Signatures, priv.SigBitField, pub.PublicParams.SigHash)
+	if err != nil || !valid {
+		return false
+	}
+	return true
+}
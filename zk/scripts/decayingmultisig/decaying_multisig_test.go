@@ -0,0 +1,92 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package decayingmultisig
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDecayingMultisigScript(t *testing.T) {
+	privKey, pubKey, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	privKey2, pubKey2, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	pubKeyBytes, err := crypto.MarshalPublicKey(pubKey)
+	assert.NoError(t, err)
+	pubKey2Bytes, err := crypto.MarshalPublicKey(pubKey2)
+	assert.NoError(t, err)
+
+	decayAt := int64(1700000000)
+	decayAtBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(decayAtBytes, uint64(decayAt))
+
+	// Two of two signatures are needed until the decay time after which
+	// one signature is enough.
+	tests := []struct {
+		name     string
+		keys     []crypto.PrivKey
+		bitField uint8
+		locktime int64
+		valid    bool
+	}{
+		{
+			name:     "both signatures before decay",
+			keys:     []crypto.PrivKey{privKey, privKey2},
+			bitField: 0x03,
+			locktime: decayAt - 1,
+			valid:    true,
+		},
+		{
+			name:     "one signature before decay",
+			keys:     []crypto.PrivKey{privKey},
+			bitField: 0x01,
+			locktime: decayAt - 1,
+			valid:    false,
+		},
+		{
+			name:     "one signature at decay",
+			keys:     []crypto.PrivKey{privKey},
+			bitField: 0x01,
+			locktime: decayAt,
+			valid:    true,
+		},
+		{
+			name:     "other signature at decay",
+			keys:     []crypto.PrivKey{privKey2},
+			bitField: 0x02,
+			locktime: decayAt,
+			valid:    true,
+		},
+	}
+
+	for _, test := range tests {
+		pub, err := transactions.StandardPublicParams(&transactions.StandardTransaction{
+			Locktime: &transactions.Locktime{
+				Timestamp: test.locktime,
+			},
+		}, transactions.LocktimeSeconds(true))
+		assert.NoError(t, err, test.name)
+		sigs := make([][]byte, len(test.keys))
+		for i, key := range test.keys {
+			sigs[i], err = key.Sign(pub.SigHash)
+			assert.NoError(t, err, test.name)
+		}
+
+		valid := DecayingMultisigScript(&PrivateParams{
+			Signatures:  sigs,
+			SigBitField: test.bitField,
+		}, &standard.UnlockingScriptInputs{
+			PublicParams: *pub,
+			ScriptParams: [][]byte{decayAtBytes, {2}, {1}, pubKeyBytes, pubKey2Bytes},
+		})
+		assert.Equal(t, test.valid, valid, test.name)
+	}
+}
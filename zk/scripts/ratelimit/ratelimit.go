@@ -0,0 +1,145 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"time"
+)
+
+var MockRateLimitedScriptCommitment = bytes.Repeat([]byte{0xae}, 32)
+
+// ErrLimitExceeded is returned when a spend exceeds the limit of the
+// current period.
+var ErrLimitExceeded = errors.New("spend exceeds the period limit")
+
+// StateSchema is the layout of the note state of rate limited coins.
+var StateSchema *types.StateSchema
+
+func init() {
+	var err error
+	StateSchema, err = types.NewStateSchema(
+		types.StateField{Name: "period-start", Type: types.StateFieldUint64},
+		types.StateField{Name: "period-spent", Type: types.StateFieldUint64},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NewState returns the state of rate limited coins, with nothing spent,
+// in a period starting at periodStart.
+func NewState(periodStart time.Time) types.State {
+	state := StateSchema.NewState()
+	state.SetUint64("period-start", uint64(periodStart.Unix()))
+	return state.State()
+}
+
+// NextState returns the state of the change output of a spend of amount
+// from coins in the state. The locktime is the transaction's locktime.
+// If the locktime is after the end of the current period a new period
+// starts at the locktime.
+//
+// ErrLimitExceeded is returned if the spend exceeds the limit.
+func NextState(state types.State, period time.Duration, limit uint64, locktime time.Time, amount uint64) (types.State, error) {
+	typed, err := StateSchema.Decode(state)
+	if err != nil {
+		return nil, err
+	}
+	periodStart, err := typed.Uint64("period-start")
+	if err != nil {
+		return nil, err
+	}
+	periodSpent, err := typed.Uint64("period-spent")
+	if err != nil {
+		return nil, err
+	}
+
+	lt := uint64(locktime.Unix())
+	if lt >= periodStart+uint64(period/time.Second) {
+		periodStart = lt
+		periodSpent = 0
+	}
+	if amount > limit || periodSpent > limit-amount {
+		return nil, ErrLimitExceeded
+	}
+
+	next := StateSchema.NewState()
+	next.SetUint64("period-start", periodStart)
+	next.SetUint64("period-spent", periodSpent+amount)
+	return next.State(), nil
+}
+
+type PrivateParams struct {
+	ChangeIndex int
+	Signature   []byte
+}
+
+// RateLimitedScript expects the script params to be:
+// <period> <limit> <pubkey>
+//
+// Only one input in the transaction may spend from the script.
+func RateLimitedScript(privateParams, publicParams interface{}) bool {
+	priv, ok := privateParams.(*PrivateParams)
+	if !ok {
+		return false
+	}
+	pub, ok := publicParams.(*standard.UnlockingScriptInputs)
+	if !ok {
+		return false
+	}
+	if len(pub.ScriptParams) != 3 || len(pub.ScriptParams[0]) != 8 || len(pub.ScriptParams[1]) != 8 {
+		return false
+	}
+	if pub.PublicParams.LocktimePrecision > time.Second*600 {
+		return false
+	}
+	if priv.ChangeIndex < 0 || priv.ChangeIndex >= len(pub.PrivateParams.Outputs) ||
+		pub.InputIndex < 0 || pub.InputIndex >= len(pub.PrivateParams.Inputs) {
+		return false
+	}
+	input := pub.PrivateParams.Inputs[pub.InputIndex]
+	change := pub.PrivateParams.Outputs[priv.ChangeIndex]
+	// Only one input may spend from the script or they could share a
+	// change output and together exceed the limit.
+	for i, in := range pub.PrivateParams.Inputs {
+		if i != pub.InputIndex && in.ScriptHash == input.ScriptHash {
+			return false
+		}
+	}
+	if change.ScriptHash != input.ScriptHash || change.AssetID != input.AssetID || change.Amount > input.Amount {
+		return false
+	}
+
+	period := time.Duration(binary.BigEndian.Uint64(pub.ScriptParams[0])) * time.Second
+	limit := binary.BigEndian.Uint64(pub.ScriptParams[1])
+	next, err := NextState(input.State, period, limit, pub.PublicParams.Locktime, uint64(input.Amount-change.Amount))
+	if err != nil {
+		return false
+	}
+	expected, err := next.Serialize(false)
+	if err != nil {
+		return false
+	}
+	actual, err := change.State.Serialize(false)
+	if err != nil || !bytes.Equal(expected, actual) {
+		return false
+	}
+
+	pubkey, err := crypto.UnmarshalPublicKey(pub.ScriptParams[2])
+	if err != nil {
+		return false
+	}
+	valid, err := pubkey.Verify(pub.PublicParams.SigHash, priv.Signature)
+	if err != nil || !valid {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNextState(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	period := time.Hour * 24
+
+	state := NewState(start)
+	state, err := NextState(state, period, 1000, start.Add(time.Hour), 600)
+	assert.NoError(t, err)
+	typed, err := StateSchema.Decode(state)
+	assert.NoError(t, err)
+	periodStart, _ := typed.Uint64("period-start")
+	periodSpent, _ := typed.Uint64("period-spent")
+	assert.Equal(t, uint64(start.Unix()), periodStart)
+	assert.Equal(t, uint64(600), periodSpent)
+
+	// Over the limit in the same period.
+	_, err = NextState(state, period, 1000, start.Add(time.Hour*2), 401)
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+
+	// A new period resets the amount spent.
+	locktime := start.Add(period + time.Hour)
+	state, err = NextState(state, period, 1000, locktime, 1000)
+	assert.NoError(t, err)
+	typed, err = StateSchema.Decode(state)
+	assert.NoError(t, err)
+	periodStart, _ = typed.Uint64("period-start")
+	periodSpent, _ = typed.Uint64("period-spent")
+	assert.Equal(t, uint64(locktime.Unix()), periodStart)
+	assert.Equal(t, uint64(1000), periodSpent)
+
+	_, err = NextState(state, period, 1000, locktime.Add(period), 1001)
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+}
+
+func TestRateLimitedScript(t *testing.T) {
+	privKey, pubKey, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	pubKeyBytes, err := crypto.MarshalPublicKey(pubKey)
+	assert.NoError(t, err)
+
+	period := time.Hour * 24
+	periodBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBytes, uint64(period/time.Second))
+	limitBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(limitBytes, 1000)
+
+	start := time.Unix(1700000000, 0)
+	spent, err := NextState(NewState(start), period, 1000, start, 600)
+	assert.NoError(t, err)
+
+	// state builds the change state directly so that the script can be
+	// handed states that NextState would refuse to produce.
+	state := func(periodStart time.Time, periodSpent uint64) types.State {
+		s := StateSchema.NewState()
+		s.SetUint64("period-start", uint64(periodStart.Unix()))
+		s.SetUint64("period-spent", periodSpent)
+		return s.State()
+	}
+
+	tests := []struct {
+		name        string
+		inputState  types.State
+		locktime    time.Time
+		amount      types.Amount
+		changeState types.State
+		valid       bool
+	}{
+		{
+			name:        "spend at limit",
+			inputState:  NewState(start),
+			locktime:    start.Add(time.Hour),
+			amount:      1000,
+			changeState: state(start, 1000),
+			valid:       true,
+		},
+		{
+			name:        "spend over limit",
+			inputState:  NewState(start),
+			locktime:    start.Add(time.Hour),
+			amount:      1001,
+			changeState: state(start, 1001),
+			valid:       false,
+		},
+		{
+			name:        "remaining limit in period",
+			inputState:  spent,
+			locktime:    start.Add(period - time.Second),
+			amount:      400,
+			changeState: state(start, 1000),
+			valid:       true,
+		},
+		{
+			name:        "over remaining limit in period",
+			inputState:  spent,
+			locktime:    start.Add(period - time.Second),
+			amount:      401,
+			changeState: state(start, 1001),
+			valid:       false,
+		},
+		{
+			name:        "new period at period end",
+			inputState:  spent,
+			locktime:    start.Add(period),
+			amount:      1000,
+			changeState: state(start.Add(period), 1000),
+			valid:       true,
+		},
+		{
+			name:        "change state does not carry period forward",
+			inputState:  spent,
+			locktime:    start.Add(period),
+			amount:      1000,
+			changeState: state(start, 1600),
+			valid:       false,
+		},
+	}
+
+	for _, test := range tests {
+		pub, err := transactions.StandardPublicParams(&transactions.StandardTransaction{
+			Locktime: &transactions.Locktime{
+				Timestamp: test.locktime.Unix(),
+			},
+		}, transactions.LocktimeSeconds(true))
+		assert.NoError(t, err, test.name)
+		sig, err := privKey.Sign(pub.SigHash)
+		assert.NoError(t, err, test.name)
+
+		valid := RateLimitedScript(&PrivateParams{
+			ChangeIndex: 0,
+			Signature:   sig,
+		}, &standard.UnlockingScriptInputs{
+			PrivateParams: standard.PrivateParams{
+				Inputs: []standard.PrivateInput{
+					{SpendNote: types.SpendNote{Amount: 5000, State: test.inputState}},
+				},
+				Outputs: []standard.PrivateOutput{
+					{SpendNote: types.SpendNote{Amount: 5000 - test.amount, State: test.changeState}},
+				},
+			},
+			PublicParams: *pub,
+			ScriptParams: [][]byte{periodBytes, limitBytes, pubKeyBytes},
+		})
+		assert.Equal(t, test.valid, valid, test.name)
+	}
+}
+
+// TestRateLimitedScriptMultipleInputs checks that two inputs can't share
+// a change output to spend more than the limit in one period.
+func TestRateLimitedScriptMultipleInputs(t *testing.T) {
+	privKey, pubKey, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	pubKeyBytes, err := crypto.MarshalPublicKey(pubKey)
+	assert.NoError(t, err)
+
+	period := time.Hour * 24
+	periodBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBytes, uint64(period/time.Second))
+	limitBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(limitBytes, 100)
+
+	start := time.Unix(1700000000, 0)
+	pub, err := transactions.StandardPublicParams(&transactions.StandardTransaction{
+		Locktime: &transactions.Locktime{Timestamp: start.Add(time.Hour).Unix()},
+	}, transactions.LocktimeSeconds(true))
+	assert.NoError(t, err)
+	sig, err := privKey.Sign(pub.SigHash)
+	assert.NoError(t, err)
+
+	change, err := NextState(NewState(start), period, 100, start.Add(time.Hour), 50)
+	assert.NoError(t, err)
+	scriptHash := types.ID{0x01}
+	params := &standard.UnlockingScriptInputs{
+		PrivateParams: standard.PrivateParams{
+			Inputs: []standard.PrivateInput{
+				{SpendNote: types.SpendNote{ScriptHash: scriptHash, Amount: 1000, State: NewState(start)}},
+				{SpendNote: types.SpendNote{ScriptHash: scriptHash, Amount: 1000, State: NewState(start)}},
+			},
+			Outputs: []standard.PrivateOutput{
+				{SpendNote: types.SpendNote{ScriptHash: scriptHash, Amount: 950, State: change}},
+			},
+		},
+		PublicParams: *pub,
+		ScriptParams: [][]byte{periodBytes, limitBytes, pubKeyBytes},
+	}
+
+	// Each input on its own spends 50 of the limit of 100 but together
+	// they spend 1050.
+	for i := range params.PrivateParams.Inputs {
+		params.InputIndex = i
+		assert.False(t, RateLimitedScript(&PrivateParams{ChangeIndex: 0, Signature: sig}, params))
+	}
+
+	// With a single input the same spend is valid.
+	params.InputIndex = 0
+	params.PrivateParams.Inputs = params.PrivateParams.Inputs[:1]
+	assert.True(t, RateLimitedScript(&PrivateParams{ChangeIndex: 0, Signature: sig}, params))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package vault
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"time"
+)
+
+var MockVaultScriptCommitment = bytes.Repeat([]byte{0xac}, 32)
+
+// SpendPath selects the key a vault is spent with.
+type SpendPath uint8
+
+const (
+	// OwnerPath spends the vault with the owner's key once the
+	// timelock has expired.
+	OwnerPath SpendPath = 0
+	// RecoveryPath spends the vault with the recovery key at any time.
+	RecoveryPath SpendPath = 1
+)
+
+type PrivateParams struct {
+	SpendPath SpendPath
+	Signature []byte
+}
+
+// VaultScript expects the script params to be:
+// <lock-until> <owner-pubkey> <recovery-pubkey>
+func VaultScript(privateParams, publicParams interface{}) bool {
+	priv, ok := privateParams.(*PrivateParams)
+	if !ok {
+		return false
+	}
+	pub, ok := publicParams.(*standard.UnlockingScriptInputs)
+	if !ok {
+		return false
+	}
+	if len(pub.ScriptParams) != 3 {
+		return false
+	}
+	if len(pub.ScriptParams[0]) != 8 {
+		return false
+	}
+
+	var keyBytes []byte
+	switch priv.SpendPath {
+	case OwnerPath:
+		lockUntil := int64(binary.BigEndian.Uint64(pub.ScriptParams[0]))
+		if pub.PublicParams.Locktime.Before(time.Unix(lockUntil, 0)) {
+			return false
+		}
+		if pub.PublicParams.LocktimePrecision > time.Second*600 {
+			return false
+		}
+		keyBytes = pub.ScriptParams[1]
+	case RecoveryPath:
+		keyBytes = pub.ScriptParams[2]
+	default:
+		return false
+	}
+
+	pubkey, err := crypto.UnmarshalPublicKey(keyBytes)
+	if err != nil {
+		return false
+	}
+	valid, err := pubkey.Verify(pub.PublicParams.SigHash, priv.Signature)
+	if err != nil || !valid {
+		return false
+	}
+	return true
+}
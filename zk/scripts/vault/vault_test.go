@@ -0,0 +1,104 @@
+// Copyright (c) 2022 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package vault
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk/circuits/standard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVaultScript(t *testing.T) {
+	ownerKey, ownerPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	recoveryKey, recoveryPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	ownerPubBytes, err := crypto.MarshalPublicKey(ownerPub)
+	assert.NoError(t, err)
+	recoveryPubBytes, err := crypto.MarshalPublicKey(recoveryPub)
+	assert.NoError(t, err)
+
+	lockUntil := int64(1700000000)
+	lockUntilBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(lockUntilBytes, uint64(lockUntil))
+
+	tests := []struct {
+		name      string
+		spendPath SpendPath
+		key       crypto.PrivKey
+		locktime  int64
+		precision int64
+		valid     bool
+	}{
+		{
+			name:      "owner at lock until",
+			spendPath: OwnerPath,
+			key:       ownerKey,
+			locktime:  lockUntil,
+			valid:     true,
+		},
+		{
+			name:      "owner before lock until",
+			spendPath: OwnerPath,
+			key:       ownerKey,
+			locktime:  lockUntil - 1,
+			valid:     false,
+		},
+		{
+			name:      "owner with recovery key",
+			spendPath: OwnerPath,
+			key:       recoveryKey,
+			locktime:  lockUntil,
+			valid:     false,
+		},
+		{
+			name:      "recovery before lock until",
+			spendPath: RecoveryPath,
+			key:       recoveryKey,
+			locktime:  lockUntil - 1,
+			precision: 601,
+			valid:     true,
+		},
+		{
+			name:      "recovery with owner key",
+			spendPath: RecoveryPath,
+			key:       ownerKey,
+			locktime:  lockUntil,
+			valid:     false,
+		},
+		{
+			name:      "unknown spend path",
+			spendPath: 2,
+			key:       ownerKey,
+			locktime:  lockUntil,
+			valid:     false,
+		},
+	}
+
+	for _, test := range tests {
+		pub, err := transactions.StandardPublicParams(&transactions.StandardTransaction{
+			Locktime: &transactions.Locktime{
+				Timestamp: test.locktime,
+				Precision: test.precision,
+			},
+		}, transactions.LocktimeSeconds(true))
+		assert.NoError(t, err, test.name)
+		sig, err := test.key.Sign(pub.SigHash)
+		assert.NoError(t, err, test.name)
+
+		valid := VaultScript(&PrivateParams{
+			SpendPath: test.spendPath,
+			Signature: sig,
+		}, &standard.UnlockingScriptInputs{
+			PublicParams: *pub,
+			ScriptParams: [][]byte{lockUntilBytes, ownerPubBytes, recoveryPubBytes},
+		})
+		assert.Equal(t, test.valid, valid, test.name)
+	}
+}
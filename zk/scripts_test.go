@@ -6,11 +6,14 @@ package zk
 
 import (
 	"crypto/rand"
+	"encoding/binary"
+	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	icrypto "github.com/project-illium/ilxd/crypto"
 	"github.com/stretchr/testify/assert"
 	"regexp"
 	"testing"
+	"time"
 )
 
 func TestMakeMultisigUnlockingParams(t *testing.T) {
@@ -38,3 +41,148 @@ func TestMakeMultisigUnlockingParams(t *testing.T) {
 	expected := `(cons (cons 1 (cons 1 (cons 0 nil))) (cons (cons 0xe4f41e9e9c51a86e127a13af323ae286ed43d1df574b468d23c4216bceac0396 (cons 0xb38a1df6b53c293dfe51474edaca38af6636e4f351586656ab9c8409cfac4f36 (cons 0xb5bbac5280a1c2d6b0b89d43fdea193d73e3be95ddc25d6a1b21b114aba50d11 nil))) (cons (cons 0xb5bbac5280a1c2d6b0b89d43fdea193d73e3be95ddc25d6a1b21b114aba50d11 (cons 0xce6dccc121b5572a4599224cf7cf228f37a2a1e56267f1cb9e3bd317cfb45226 (cons 0xb5bbac5280a1c2d6b0b89d43fdea193d73e3be95ddc25d6a1b21b114aba50d11 nil))) nil)))`
 	assert.Equal(t, re.ReplaceAllString(expected, ""), re.ReplaceAllString(string(script), ""))
 }
+
+func TestMakeMultisigUnlockingParamsSigOrder(t *testing.T) {
+	var (
+		privs []crypto.PrivKey
+		pubs  []crypto.PubKey
+	)
+	for i := 0; i < 20; i++ {
+		priv, pub, err := icrypto.GenerateNovaKey(rand.Reader)
+		assert.NoError(t, err)
+		privs = append(privs, priv)
+		pubs = append(pubs, pub)
+	}
+
+	sigHash := make([]byte, 32)
+	rand.Read(sigHash)
+
+	sig3, err := privs[3].Sign(sigHash)
+	assert.NoError(t, err)
+	sig17, err := privs[17].Sign(sigHash)
+	assert.NoError(t, err)
+
+	inOrder, err := MakeMultisigUnlockingParams(pubs, [][]byte{sig3, sig17}, sigHash)
+	assert.NoError(t, err)
+	reversed, err := MakeMultisigUnlockingParams(pubs, [][]byte{sig17, sig3}, sigHash)
+	assert.NoError(t, err)
+	assert.Equal(t, inOrder, reversed)
+
+	_, otherPub, err := icrypto.GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+	_, err = MakeMultisigUnlockingParams([]crypto.PubKey{pubs[3], otherPub}, [][]byte{sig3, sig17}, sigHash)
+	var sigErr *MultisigSignatureError
+	assert.ErrorAs(t, err, &sigErr)
+	assert.Equal(t, 1, sigErr.Index)
+	assert.ErrorIs(t, err, ErrSignatureNoMatch)
+
+	_, err = MakeMultisigUnlockingParams(pubs, [][]byte{sig3, sig3}, sigHash)
+	assert.ErrorAs(t, err, &sigErr)
+	assert.Equal(t, 1, sigErr.Index)
+	assert.ErrorIs(t, err, ErrDuplicateSignature)
+
+	_, err = MakeMultisigUnlockingParams(pubs, [][]byte{sig3[:32]}, sigHash)
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+}
+
+func TestMakeMultisigLockingParams(t *testing.T) {
+	var pubs []crypto.PubKey
+	for i := 0; i < 5; i++ {
+		_, pub, err := icrypto.GenerateNovaKey(rand.Reader)
+		assert.NoError(t, err)
+		pubs = append(pubs, pub)
+	}
+
+	params, err := MakeMultisigLockingParams(3, pubs)
+	assert.NoError(t, err)
+	assert.Len(t, params, 11)
+	assert.Equal(t, uint32(3), binary.BigEndian.Uint32(params[0]))
+
+	reversed := []crypto.PubKey{pubs[4], pubs[3], pubs[2], pubs[1], pubs[0]}
+	params2, err := MakeMultisigLockingParams(3, reversed)
+	assert.NoError(t, err)
+	assert.Equal(t, params, params2)
+	assert.Equal(t, pubs[4], reversed[0])
+
+	_, err = MakeMultisigLockingParams(0, pubs)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+	_, err = MakeMultisigLockingParams(6, pubs)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+	_, err = MakeMultisigLockingParams(1, nil)
+	assert.ErrorIs(t, err, ErrNoMultisigKeys)
+	_, err = MakeMultisigLockingParams(2, []crypto.PubKey{pubs[0], pubs[1], pubs[0]})
+	assert.ErrorIs(t, err, ErrDuplicateMultisigKey)
+}
+
+func TestMakeVaultUnlockingParams(t *testing.T) {
+	priv, _, err := icrypto.GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+
+	sigHash := make([]byte, 32)
+	rand.Read(sigHash)
+	sig, err := priv.Sign(sigHash)
+	assert.NoError(t, err)
+
+	re := regexp.MustCompile(`0x[0-9a-fA-F]+`)
+	expected := `(cons %d (cons 0x (cons 0x (cons 0x nil))))`
+
+	script, err := MakeVaultSpendUnlockingParams(sig)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(expected, 0), re.ReplaceAllString(script, "0x"))
+
+	script, err = MakeVaultRecoveryUnlockingParams(sig)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(expected, 1), re.ReplaceAllString(script, "0x"))
+
+	_, err = MakeVaultSpendUnlockingParams(sig[:32])
+	assert.Error(t, err)
+}
+
+func TestMakeDecayingMultisigLockingParams(t *testing.T) {
+	_, pub1, err := icrypto.GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+	_, pub2, err := icrypto.GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+
+	decayAt := time.Unix(1700000000, 0)
+	params, err := MakeDecayingMultisigLockingParams(decayAt, 2, 1, []crypto.PubKey{pub1, pub2})
+	assert.NoError(t, err)
+	assert.Len(t, params, 7)
+	assert.Equal(t, uint64(decayAt.Unix()), binary.BigEndian.Uint64(params[0]))
+	assert.Equal(t, []byte{2}, params[1])
+	assert.Equal(t, []byte{1}, params[2])
+
+	_, err = MakeDecayingMultisigLockingParams(decayAt, 1, 2, []crypto.PubKey{pub1, pub2})
+	assert.Error(t, err)
+	_, err = MakeDecayingMultisigLockingParams(decayAt, 3, 1, []crypto.PubKey{pub1, pub2})
+	assert.Error(t, err)
+}
+
+func TestMakeRateLimitedParams(t *testing.T) {
+	priv, pub, err := icrypto.GenerateNovaKey(rand.Reader)
+	assert.NoError(t, err)
+
+	params, err := MakeRateLimitedLockingParams(time.Hour*24, 100000, pub)
+	assert.NoError(t, err)
+	assert.Len(t, params, 4)
+	assert.Equal(t, uint64(86400), binary.BigEndian.Uint64(params[0]))
+	assert.Equal(t, uint64(100000), binary.BigEndian.Uint64(params[1]))
+
+	_, err = MakeRateLimitedLockingParams(time.Millisecond, 100000, pub)
+	assert.Error(t, err)
+
+	sigHash := make([]byte, 32)
+	rand.Read(sigHash)
+	sig, err := priv.Sign(sigHash)
+	assert.NoError(t, err)
+
+	re := regexp.MustCompile(`0x[0-9a-fA-F]+`)
+	script, err := MakeRateLimitedUnlockingParams(2, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, `(cons 2 (cons 0x (cons 0x (cons 0x nil))))`, re.ReplaceAllString(script, "0x"))
+
+	_, err = MakeRateLimitedUnlockingParams(-1, sig)
+	assert.Error(t, err)
+	_, err = MakeRateLimitedUnlockingParams(0, sig[:32])
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@
 package zk
 
 import (
+	"context"
 	"crypto/rand"
 	"errors"
 	"fmt"
@@ -20,11 +21,26 @@ type CircuitFunc func(privateParams, publicParams interface{}) bool
 // CreateSnark is a placeholder for a function call to the rust lurk library. Right now
 // we do validate that the input parameters are valid, but we just return random bytes
 // instead of a proof. This obviously needs to be changed.
+//
+// The snark is created in the shared prover pool.
 func CreateSnark(circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return CreateSnarkWithContext(context.Background(), circuit, privateParams, publicParams)
+}
+
+// CreateSnarkWithContext is CreateSnark but stops waiting for the shared
+// prover pool if the context is canceled.
+func CreateSnarkWithContext(ctx context.Context, circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
+	return SharedProver().CreateSnark(ctx, circuit, privateParams, publicParams)
+}
+
+func createSnark(circuit CircuitFunc, privateParams, publicParams interface{}) ([]byte, error) {
 	valid := circuit(privateParams, publicParams)
 	if !valid {
 		return nil, errors.New("invalid parameters")
 	}
+	if MockProverEnabled() {
+		return mockSnark(publicParams)
+	}
 
 	proof := make([]byte, MockProofSize)
 	rand.Read(proof)
@@ -6,6 +6,7 @@ package zk
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -31,6 +32,16 @@ const (
 	TagCproc
 )
 
+var tagNames = []string{"nil", "cons", "sym", "fun", "num", "thunk", "str", "char", "comm", "u64", "key", "cproc"}
+
+// String returns the name of the tag.
+func (t Tag) String() string {
+	if int(t) < len(tagNames) {
+		return tagNames[t]
+	}
+	return fmt.Sprintf("tag(%d)", uint8(t))
+}
+
 // TagFromBytes returns a tag from a big endian byte slice
 func TagFromBytes(b []byte) (Tag, error) {
 	if len(b) < 1 {
@@ -0,0 +1,38 @@
+// Copyright (c) 2022 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/project-illium/ilxd/zk/remoteprover"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZKBench(t *testing.T) {
+	var out bytes.Buffer
+	bench := &ZKBench{out: &out}
+	parser := flags.NewNamedParser("ilxd zk", flags.Default)
+	parser.AddCommand("bench", "", "", bench)
+	_, err := parser.ParseArgs([]string{"bench", "-i", "1", "-i", "20", "-o", "3", "-n", "1"})
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 4)
+	assert.True(t, strings.HasPrefix(lines[1], "standard  1 "))
+	assert.True(t, strings.HasPrefix(lines[2], "standard  20"))
+	assert.True(t, strings.HasPrefix(lines[3], "stake "))
+
+	err = runZKCommand([]string{"bench", "-c", "stake", "-n", "0"})
+	assert.Error(t, err)
+}
+
+func TestZKServeRequiresAuthentication(t *testing.T) {
+	err := runZKCommand([]string{"serve", "--tlscert", "prover.cert", "--tlskey", "prover.key"})
+	assert.ErrorIs(t, err, remoteprover.ErrNoAuthentication)
+}